@@ -0,0 +1,135 @@
+// Package client is a Go client of the StrikePad API for internal services. The types and
+// operations in client_gen.go are generated from the OpenAPI document; run make clients after
+// changing the routes or DTOs.
+package client
+
+//go:generate go run ../cmd/clientgen -go client_gen.go -ts ../../fe/src/api/generated.ts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client calls the API at a base URL
+type Client struct {
+	httpClient *http.Client
+	header     http.Header
+	baseURL    string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// New returns a client of the API at baseURL, e.g. http://localhost:8080
+func New(baseURL string, options ...Option) *Client {
+	c := &Client{
+		httpClient: http.DefaultClient,
+		header:     make(http.Header),
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// WithHTTPClient sends the requests with httpClient instead of http.DefaultClient
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithHeader sets a header on every request
+func WithHeader(name, value string) Option {
+	return func(c *Client) {
+		c.header.Set(name, value)
+	}
+}
+
+// WithBearerToken authenticates every request with an access token
+func WithBearerToken(token string) Option {
+	return WithHeader("Authorization", "Bearer "+token)
+}
+
+// WithAPIKey authenticates every request with an API key
+func WithAPIKey(key string) Option {
+	return WithHeader("X-API-Key", key)
+}
+
+// WithAdminToken authenticates every request with the admin API token
+func WithAdminToken(token string) Option {
+	return WithHeader("X-Admin-Token", token)
+}
+
+// Error is returned for responses with an error status. Response holds the error response of the
+// API, and is nil when the body is not one.
+type Error struct {
+	Response   *ErrorResponse
+	StatusCode int
+}
+
+// Error returns the status and error code of the response
+func (e *Error) Error() string {
+	if e.Response == nil {
+		return fmt.Sprintf("unexpected status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("status %d: %s %s", e.StatusCode, e.Response.Code, e.Response.Message)
+}
+
+// do sends a request with body encoded as JSON, unless it is nil, and decodes the response into
+// result, unless it is nil
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, result any) error {
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return err
+	}
+	req.Header = c.header.Clone()
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		var response ErrorResponse
+		if json.NewDecoder(resp.Body).Decode(&response) == nil && response.Code != "" {
+			apiErr.Response = &response
+		}
+		return apiErr
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,1200 @@
+// Code generated by cmd/clientgen from the OpenAPI document. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// APIKeyCreatedResponse is the APIKeyCreatedResponse schema of the API
+type APIKeyCreatedResponse struct {
+	CreatedAt       time.Time  `json:"created_at"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt       *time.Time `json:"revoked_at,omitempty"`
+	CreatedByUserID string     `json:"created_by_user_id"`
+	ID              string     `json:"id"`
+	Key             string     `json:"key"`
+	KeyPrefix       string     `json:"key_prefix"`
+	Name            string     `json:"name"`
+}
+
+// APIKeyInfo is the APIKeyInfo schema of the API
+type APIKeyInfo struct {
+	CreatedAt       time.Time  `json:"created_at"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt       *time.Time `json:"revoked_at,omitempty"`
+	CreatedByUserID string     `json:"created_by_user_id"`
+	ID              string     `json:"id"`
+	KeyPrefix       string     `json:"key_prefix"`
+	Name            string     `json:"name"`
+}
+
+// APIKeyListResponse is the APIKeyListResponse schema of the API
+type APIKeyListResponse struct {
+	NextCursor     *string      `json:"next_cursor,omitempty"`
+	Page           *int64       `json:"page,omitempty"`
+	Total          *int64       `json:"total,omitempty"`
+	TotalEstimated *bool        `json:"total_estimated,omitempty"`
+	Data           []APIKeyInfo `json:"data"`
+	PerPage        int64        `json:"per_page"`
+	HasMore        bool         `json:"has_more"`
+}
+
+// AdminUserInfo is the AdminUserInfo schema of the API
+type AdminUserInfo struct {
+	CreatedAt     time.Time `json:"created_at"`
+	Email         *string   `json:"email,omitempty"`
+	DisplayName   string    `json:"display_name"`
+	ID            string    `json:"id"`
+	ProviderType  string    `json:"provider_type"`
+	Role          string    `json:"role"`
+	EmailVerified bool      `json:"email_verified"`
+	IsDisabled    bool      `json:"is_disabled"`
+}
+
+// AdminUserListResponse is the AdminUserListResponse schema of the API
+type AdminUserListResponse struct {
+	NextCursor     *string         `json:"next_cursor,omitempty"`
+	Page           *int64          `json:"page,omitempty"`
+	Total          *int64          `json:"total,omitempty"`
+	TotalEstimated *bool           `json:"total_estimated,omitempty"`
+	Data           []AdminUserInfo `json:"data"`
+	PerPage        int64           `json:"per_page"`
+	HasMore        bool            `json:"has_more"`
+}
+
+// AuditLogInfo is the AuditLogInfo schema of the API
+type AuditLogInfo struct {
+	CreatedAt time.Time         `json:"created_at"`
+	ActorID   *string           `json:"actor_id,omitempty"`
+	IPAddress *string           `json:"ip_address,omitempty"`
+	Metadata  map[string]string `json:"metadata"`
+	TargetID  *string           `json:"target_id,omitempty"`
+	UserAgent *string           `json:"user_agent,omitempty"`
+	Action    string            `json:"action"`
+	ID        string            `json:"id"`
+}
+
+// AuditLogListResponse is the AuditLogListResponse schema of the API
+type AuditLogListResponse struct {
+	NextCursor     *string        `json:"next_cursor,omitempty"`
+	Page           *int64         `json:"page,omitempty"`
+	Total          *int64         `json:"total,omitempty"`
+	TotalEstimated *bool          `json:"total_estimated,omitempty"`
+	Data           []AuditLogInfo `json:"data"`
+	PerPage        int64          `json:"per_page"`
+	HasMore        bool           `json:"has_more"`
+}
+
+// AuthResponse is the AuthResponse schema of the API
+type AuthResponse struct {
+	CreatedAt     time.Time `json:"created_at"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	AccessToken   string    `json:"access_token"`
+	DisplayName   string    `json:"display_name"`
+	Email         string    `json:"email"`
+	ID            string    `json:"id"`
+	RefreshToken  string    `json:"refresh_token"`
+	EmailVerified bool      `json:"email_verified"`
+}
+
+// AvatarResponse is the AvatarResponse schema of the API
+type AvatarResponse struct {
+	AvatarURL string `json:"avatar_url"`
+}
+
+// CSRFTokenResponse is the CSRFTokenResponse schema of the API
+type CSRFTokenResponse struct {
+	CSRFToken string `json:"csrf_token"`
+}
+
+// ComponentStatus is the ComponentStatus schema of the API
+type ComponentStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// ConfirmEmailChangeRequest is the ConfirmEmailChangeRequest schema of the API
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token"`
+}
+
+// CreateAPIKeyRequest is the CreateAPIKeyRequest schema of the API
+type CreateAPIKeyRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateInvitationRequest is the CreateInvitationRequest schema of the API
+type CreateInvitationRequest struct {
+	Email string `json:"email"`
+}
+
+// CreateWebhookRequest is the CreateWebhookRequest schema of the API
+type CreateWebhookRequest struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+}
+
+// DataExportResponse is the DataExportResponse schema of the API
+type DataExportResponse struct {
+	CreatedAt            time.Time  `json:"created_at"`
+	CompletedAt          *time.Time `json:"completed_at,omitempty"`
+	DownloadURL          *string    `json:"download_url,omitempty"`
+	DownloadURLExpiresAt *time.Time `json:"download_url_expires_at,omitempty"`
+	ExpiresAt            *time.Time `json:"expires_at,omitempty"`
+	SizeBytes            *int64     `json:"size_bytes,omitempty"`
+	ID                   string     `json:"id"`
+	Status               string     `json:"status"`
+}
+
+// DependencyHealth is the DependencyHealth schema of the API
+type DependencyHealth struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// EmailChangeRequest is the EmailChangeRequest schema of the API
+type EmailChangeRequest struct {
+	Password          *string `json:"password,omitempty"`
+	Email             string  `json:"email"`
+	SignOutEverywhere bool    `json:"sign_out_everywhere"`
+}
+
+// EmailChangeResponse is the EmailChangeResponse schema of the API
+type EmailChangeResponse struct {
+	ExpiresAt               *time.Time `json:"expires_at,omitempty"`
+	Email                   string     `json:"email"`
+	Completed               bool       `json:"completed"`
+	CurrentAddressConfirmed bool       `json:"current_address_confirmed"`
+	NewAddressConfirmed     bool       `json:"new_address_confirmed"`
+}
+
+// ErrorResponse is the ErrorResponse schema of the API
+type ErrorResponse struct {
+	Description *string           `json:"description,omitempty"`
+	Code        string            `json:"code"`
+	Message     string            `json:"message"`
+	Details     []ValidationError `json:"details,omitempty"`
+}
+
+// GoogleLoginRequest is the GoogleLoginRequest schema of the API
+type GoogleLoginRequest struct {
+	AccessToken *string `json:"access_token,omitempty"`
+	DeviceName  *string `json:"device_name,omitempty"`
+	IDToken     *string `json:"id_token,omitempty"`
+}
+
+// GoogleSignupRequest is the GoogleSignupRequest schema of the API
+type GoogleSignupRequest struct {
+	AccessToken *string `json:"access_token,omitempty"`
+	DeviceName  *string `json:"device_name,omitempty"`
+	IDToken     *string `json:"id_token,omitempty"`
+	InviteToken *string `json:"invite_token,omitempty"`
+}
+
+// HealthResponse is the HealthResponse schema of the API
+type HealthResponse struct {
+	Message      string             `json:"message"`
+	Status       string             `json:"status"`
+	Dependencies []DependencyHealth `json:"dependencies,omitempty"`
+}
+
+// IdentityInfo is the IdentityInfo schema of the API
+type IdentityInfo struct {
+	LinkedAt time.Time `json:"linked_at"`
+	Email    *string   `json:"email,omitempty"`
+	Provider string    `json:"provider"`
+}
+
+// InvitationInfo is the InvitationInfo schema of the API
+type InvitationInfo struct {
+	CreatedAt       time.Time `json:"created_at"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	Email           string    `json:"email"`
+	ID              string    `json:"id"`
+	InvitedByUserID string    `json:"invited_by_user_id"`
+}
+
+// LinkGoogleIdentityRequest is the LinkGoogleIdentityRequest schema of the API
+type LinkGoogleIdentityRequest struct {
+	AccessToken *string `json:"access_token,omitempty"`
+	IDToken     *string `json:"id_token,omitempty"`
+}
+
+// LoginAttemptInfo is the LoginAttemptInfo schema of the API
+type LoginAttemptInfo struct {
+	CreatedAt time.Time `json:"created_at"`
+	IPAddress *string   `json:"ip_address,omitempty"`
+	Method    *string   `json:"method,omitempty"`
+	Reason    *string   `json:"reason,omitempty"`
+	UserAgent *string   `json:"user_agent,omitempty"`
+	Result    string    `json:"result"`
+}
+
+// LoginAttemptListResponse is the LoginAttemptListResponse schema of the API
+type LoginAttemptListResponse struct {
+	NextCursor     *string            `json:"next_cursor,omitempty"`
+	Page           *int64             `json:"page,omitempty"`
+	Total          *int64             `json:"total,omitempty"`
+	TotalEstimated *bool              `json:"total_estimated,omitempty"`
+	Data           []LoginAttemptInfo `json:"data"`
+	PerPage        int64              `json:"per_page"`
+	HasMore        bool               `json:"has_more"`
+}
+
+// LoginRequest is the LoginRequest schema of the API
+type LoginRequest struct {
+	DeviceName *string `json:"device_name,omitempty"`
+	Email      string  `json:"email"`
+	Password   string  `json:"password"`
+}
+
+// LoginResponse is the LoginResponse schema of the API
+type LoginResponse struct {
+	ExpiresAt     time.Time `json:"expires_at"`
+	AvatarURL     *string   `json:"avatar_url"`
+	AccessToken   string    `json:"access_token"`
+	DisplayName   string    `json:"display_name"`
+	Email         string    `json:"email"`
+	ID            string    `json:"id"`
+	RefreshToken  string    `json:"refresh_token"`
+	EmailVerified bool      `json:"email_verified"`
+}
+
+// Message is the Message schema of the API
+type Message struct {
+	Message string `json:"message"`
+}
+
+// Notification is the Notification schema of the API
+type Notification struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Data      map[string]string `json:"data"`
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+}
+
+// OAuthRequest is the OAuthRequest schema of the API
+type OAuthRequest struct {
+	AccessToken  *string `json:"access_token,omitempty"`
+	Code         *string `json:"code,omitempty"`
+	CodeVerifier *string `json:"code_verifier,omitempty"`
+	DeviceName   *string `json:"device_name,omitempty"`
+	InviteToken  *string `json:"invite_token,omitempty"`
+}
+
+// OnboardingRequest is the OnboardingRequest schema of the API
+type OnboardingRequest struct {
+	IntendedUse *string `json:"intended_use,omitempty"`
+	Role        *string `json:"role,omitempty"`
+	TeamSize    *string `json:"team_size,omitempty"`
+	Version     *int64  `json:"version,omitempty"`
+}
+
+// OnboardingResponse is the OnboardingResponse schema of the API
+type OnboardingResponse struct {
+	IntendedUse *string    `json:"intended_use"`
+	Role        *string    `json:"role"`
+	TeamSize    *string    `json:"team_size"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Version     int64      `json:"version"`
+}
+
+// OutboxStatsResponse is the OutboxStatsResponse schema of the API
+type OutboxStatsResponse struct {
+	OldestPendingAt         *time.Time `json:"oldest_pending_at,omitempty"`
+	Failed                  int64      `json:"failed"`
+	OldestPendingAgeSeconds int64      `json:"oldest_pending_age_seconds"`
+	Pending                 int64      `json:"pending"`
+}
+
+// PrivacySettingsRequest is the PrivacySettingsRequest schema of the API
+type PrivacySettingsRequest struct {
+	Discoverable *bool  `json:"discoverable"`
+	Version      *int64 `json:"version,omitempty"`
+}
+
+// PrivacySettingsResponse is the PrivacySettingsResponse schema of the API
+type PrivacySettingsResponse struct {
+	Version      int64 `json:"version"`
+	Discoverable bool  `json:"discoverable"`
+}
+
+// SecuritySettingsResponse is the SecuritySettingsResponse schema of the API
+type SecuritySettingsResponse struct {
+	PasswordChangedAt *time.Time     `json:"password_changed_at,omitempty"`
+	ProviderType      string         `json:"provider_type"`
+	Identities        []IdentityInfo `json:"identities"`
+	HasPassword       bool           `json:"has_password"`
+}
+
+// SessionInfo is the SessionInfo schema of the API
+type SessionInfo struct {
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	DeviceName *string   `json:"device_name,omitempty"`
+	ID         string    `json:"id"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	Current    bool      `json:"current"`
+}
+
+// SessionListResponse is the SessionListResponse schema of the API
+type SessionListResponse struct {
+	NextCursor     *string       `json:"next_cursor,omitempty"`
+	Page           *int64        `json:"page,omitempty"`
+	Total          *int64        `json:"total,omitempty"`
+	TotalEstimated *bool         `json:"total_estimated,omitempty"`
+	Data           []SessionInfo `json:"data"`
+	PerPage        int64         `json:"per_page"`
+	HasMore        bool          `json:"has_more"`
+}
+
+// SignupRequest is the SignupRequest schema of the API
+type SignupRequest struct {
+	DeviceName  *string `json:"device_name,omitempty"`
+	InviteToken *string `json:"invite_token,omitempty"`
+	DisplayName string  `json:"display_name"`
+	Email       string  `json:"email"`
+	Password    string  `json:"password"`
+}
+
+// StatusResponse is the StatusResponse schema of the API
+type StatusResponse struct {
+	CheckedAt  time.Time         `json:"checked_at"`
+	Status     string            `json:"status"`
+	Components []ComponentStatus `json:"components"`
+}
+
+// UnlinkIdentityRequest is the UnlinkIdentityRequest schema of the API
+type UnlinkIdentityRequest struct {
+	Password *string `json:"password,omitempty"`
+}
+
+// UserSearchResponse is the UserSearchResponse schema of the API
+type UserSearchResponse struct {
+	NextCursor     *string            `json:"next_cursor,omitempty"`
+	Page           *int64             `json:"page,omitempty"`
+	Total          *int64             `json:"total,omitempty"`
+	TotalEstimated *bool              `json:"total_estimated,omitempty"`
+	Data           []UserSearchResult `json:"data"`
+	PerPage        int64              `json:"per_page"`
+	HasMore        bool               `json:"has_more"`
+}
+
+// UserSearchResult is the UserSearchResult schema of the API
+type UserSearchResult struct {
+	AvatarURL   *string `json:"avatar_url"`
+	DisplayName string  `json:"display_name"`
+	ID          string  `json:"id"`
+}
+
+// ValidationError is the ValidationError schema of the API
+type ValidationError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Tag     string `json:"tag"`
+	Value   string `json:"value"`
+}
+
+// WaitlistEntryInfo is the WaitlistEntryInfo schema of the API
+type WaitlistEntryInfo struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	InviteCode *string    `json:"invite_code,omitempty"`
+	InvitedAt  *time.Time `json:"invited_at,omitempty"`
+	Email      string     `json:"email"`
+	ID         string     `json:"id"`
+}
+
+// WaitlistInviteRequest is the WaitlistInviteRequest schema of the API
+type WaitlistInviteRequest struct {
+	Count int64 `json:"count"`
+}
+
+// WaitlistInviteResponse is the WaitlistInviteResponse schema of the API
+type WaitlistInviteResponse struct {
+	Invited []WaitlistEntryInfo `json:"invited"`
+}
+
+// WaitlistJoinRequest is the WaitlistJoinRequest schema of the API
+type WaitlistJoinRequest struct {
+	CaptchaToken *string `json:"captcha_token,omitempty"`
+	Email        string  `json:"email"`
+}
+
+// WaitlistListResponse is the WaitlistListResponse schema of the API
+type WaitlistListResponse struct {
+	NextCursor     *string             `json:"next_cursor,omitempty"`
+	Page           *int64              `json:"page,omitempty"`
+	Total          *int64              `json:"total,omitempty"`
+	TotalEstimated *bool               `json:"total_estimated,omitempty"`
+	Data           []WaitlistEntryInfo `json:"data"`
+	PerPage        int64               `json:"per_page"`
+	HasMore        bool                `json:"has_more"`
+}
+
+// WebhookCreatedResponse is the WebhookCreatedResponse schema of the API
+type WebhookCreatedResponse struct {
+	CreatedAt  time.Time `json:"created_at"`
+	ID         string    `json:"id"`
+	Secret     string    `json:"secret"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+}
+
+// WebhookInfo is the WebhookInfo schema of the API
+type WebhookInfo struct {
+	CreatedAt  time.Time `json:"created_at"`
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+}
+
+// WebhookListResponse is the WebhookListResponse schema of the API
+type WebhookListResponse struct {
+	NextCursor     *string       `json:"next_cursor,omitempty"`
+	Page           *int64        `json:"page,omitempty"`
+	Total          *int64        `json:"total,omitempty"`
+	TotalEstimated *bool         `json:"total_estimated,omitempty"`
+	Data           []WebhookInfo `json:"data"`
+	PerPage        int64         `json:"per_page"`
+	HasMore        bool          `json:"has_more"`
+}
+
+// GoogleCallbackParams are the query parameters of GoogleCallback
+type GoogleCallbackParams struct {
+	// Authorization code issued by Google
+	Code *string
+	// State passed to Google by the authorize endpoint
+	State *string
+	// Error reported by Google
+	Error *string
+}
+
+func (p *GoogleCallbackParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Code != nil {
+		values.Set("code", *p.Code)
+	}
+	if p.State != nil {
+		values.Set("state", *p.State)
+	}
+	if p.Error != nil {
+		values.Set("error", *p.Error)
+	}
+	return values
+}
+
+// ListAuditLogsParams are the query parameters of ListAuditLogs
+type ListAuditLogsParams struct {
+	// Only list entries of this action, such as auth.login_failed
+	Action *string
+	// Only list entries of the user or API key with this public ID
+	ActorID *string
+	// Only list entries about the resource with this public ID
+	TargetID *string
+	// Only list entries recorded at or after this RFC 3339 time
+	Since *string
+	// Only list entries recorded before this RFC 3339 time
+	Until *string
+	// Continue after the page that returned this next_cursor, instead of page
+	Cursor *string
+	// Page number, starting at 1
+	Page *int64
+	// Number of items per page
+	PerPage *int64
+	// Count the total number of items
+	IncludeTotal *bool
+}
+
+func (p *ListAuditLogsParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Action != nil {
+		values.Set("action", *p.Action)
+	}
+	if p.ActorID != nil {
+		values.Set("actor_id", *p.ActorID)
+	}
+	if p.TargetID != nil {
+		values.Set("target_id", *p.TargetID)
+	}
+	if p.Since != nil {
+		values.Set("since", *p.Since)
+	}
+	if p.Until != nil {
+		values.Set("until", *p.Until)
+	}
+	if p.Cursor != nil {
+		values.Set("cursor", *p.Cursor)
+	}
+	if p.Page != nil {
+		values.Set("page", strconv.FormatInt(*p.Page, 10))
+	}
+	if p.PerPage != nil {
+		values.Set("per_page", strconv.FormatInt(*p.PerPage, 10))
+	}
+	if p.IncludeTotal != nil {
+		values.Set("include_total", strconv.FormatBool(*p.IncludeTotal))
+	}
+	return values
+}
+
+// ListLoginsParams are the query parameters of ListLogins
+type ListLoginsParams struct {
+	// Continue after the page that returned this next_cursor, instead of page
+	Cursor *string
+	// Page number, starting at 1
+	Page *int64
+	// Number of items per page
+	PerPage *int64
+	// Count the total number of items
+	IncludeTotal *bool
+}
+
+func (p *ListLoginsParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Cursor != nil {
+		values.Set("cursor", *p.Cursor)
+	}
+	if p.Page != nil {
+		values.Set("page", strconv.FormatInt(*p.Page, 10))
+	}
+	if p.PerPage != nil {
+		values.Set("per_page", strconv.FormatInt(*p.PerPage, 10))
+	}
+	if p.IncludeTotal != nil {
+		values.Set("include_total", strconv.FormatBool(*p.IncludeTotal))
+	}
+	return values
+}
+
+// ListSessionsParams are the query parameters of ListSessions
+type ListSessionsParams struct {
+	// Continue after the page that returned this next_cursor, instead of page
+	Cursor *string
+	// Page number, starting at 1
+	Page *int64
+	// Number of items per page
+	PerPage *int64
+	// Count the total number of items
+	IncludeTotal *bool
+}
+
+func (p *ListSessionsParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Cursor != nil {
+		values.Set("cursor", *p.Cursor)
+	}
+	if p.Page != nil {
+		values.Set("page", strconv.FormatInt(*p.Page, 10))
+	}
+	if p.PerPage != nil {
+		values.Set("per_page", strconv.FormatInt(*p.PerPage, 10))
+	}
+	if p.IncludeTotal != nil {
+		values.Set("include_total", strconv.FormatBool(*p.IncludeTotal))
+	}
+	return values
+}
+
+// ListUserSessionsParams are the query parameters of ListUserSessions
+type ListUserSessionsParams struct {
+	// Continue after the page that returned this next_cursor, instead of page
+	Cursor *string
+	// Page number, starting at 1
+	Page *int64
+	// Number of items per page
+	PerPage *int64
+	// Count the total number of items
+	IncludeTotal *bool
+}
+
+func (p *ListUserSessionsParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Cursor != nil {
+		values.Set("cursor", *p.Cursor)
+	}
+	if p.Page != nil {
+		values.Set("page", strconv.FormatInt(*p.Page, 10))
+	}
+	if p.PerPage != nil {
+		values.Set("per_page", strconv.FormatInt(*p.PerPage, 10))
+	}
+	if p.IncludeTotal != nil {
+		values.Set("include_total", strconv.FormatBool(*p.IncludeTotal))
+	}
+	return values
+}
+
+// ListUsersParams are the query parameters of ListUsers
+type ListUsersParams struct {
+	// Field to sort by (id, created_at, display_name or email), prefixed with - for descending order; cursors are only accepted when sorting by id or created_at
+	Sort *string
+	// Only list users of this provider
+	ProviderType *string
+	// Only list users whose email starts with this prefix
+	EmailPrefix *string
+	// Continue after the page that returned this next_cursor, instead of page
+	Cursor *string
+	// Page number, starting at 1
+	Page *int64
+	// Number of items per page
+	PerPage *int64
+	// Count the total number of items
+	IncludeTotal *bool
+}
+
+func (p *ListUsersParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Sort != nil {
+		values.Set("sort", *p.Sort)
+	}
+	if p.ProviderType != nil {
+		values.Set("provider_type", *p.ProviderType)
+	}
+	if p.EmailPrefix != nil {
+		values.Set("email_prefix", *p.EmailPrefix)
+	}
+	if p.Cursor != nil {
+		values.Set("cursor", *p.Cursor)
+	}
+	if p.Page != nil {
+		values.Set("page", strconv.FormatInt(*p.Page, 10))
+	}
+	if p.PerPage != nil {
+		values.Set("per_page", strconv.FormatInt(*p.PerPage, 10))
+	}
+	if p.IncludeTotal != nil {
+		values.Set("include_total", strconv.FormatBool(*p.IncludeTotal))
+	}
+	return values
+}
+
+// ListWaitlistEntriesParams are the query parameters of ListWaitlistEntries
+type ListWaitlistEntriesParams struct {
+	// Page number, starting at 1
+	Page *int64
+	// Number of items per page
+	PerPage *int64
+	// Count the total number of items
+	IncludeTotal *bool
+}
+
+func (p *ListWaitlistEntriesParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Page != nil {
+		values.Set("page", strconv.FormatInt(*p.Page, 10))
+	}
+	if p.PerPage != nil {
+		values.Set("per_page", strconv.FormatInt(*p.PerPage, 10))
+	}
+	if p.IncludeTotal != nil {
+		values.Set("include_total", strconv.FormatBool(*p.IncludeTotal))
+	}
+	return values
+}
+
+// RevokeSessionByTokenParams are the query parameters of RevokeSessionByToken
+type RevokeSessionByTokenParams struct {
+	// The token from the link; it expires after 7 days
+	Token *string
+}
+
+func (p *RevokeSessionByTokenParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Token != nil {
+		values.Set("token", *p.Token)
+	}
+	return values
+}
+
+// SearchUsersParams are the query parameters of SearchUsers
+type SearchUsersParams struct {
+	// Start of the display name or email address, at least 2 characters
+	Q *string
+	// Number of users to return, 10 by default and at most 20
+	Limit *int64
+}
+
+func (p *SearchUsersParams) values() url.Values {
+	values := url.Values{}
+	if p == nil {
+		return values
+	}
+	if p.Q != nil {
+		values.Set("q", *p.Q)
+	}
+	if p.Limit != nil {
+		values.Set("limit", strconv.FormatInt(*p.Limit, 10))
+	}
+	return values
+}
+
+// CancelEmailChange calls DELETE /api/users/me/email: Cancel the pending email change of the current user
+func (c *Client) CancelEmailChange(ctx context.Context) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodDelete, "/api/users/me/email", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ConfirmEmailChange calls POST /api/auth/email-change/confirm: Confirm an email change with the token of a link emailed to the current or the new address
+func (c *Client) ConfirmEmailChange(ctx context.Context, body ConfirmEmailChangeRequest) (*EmailChangeResponse, error) {
+	var result EmailChangeResponse
+	if err := c.do(ctx, http.MethodPost, "/api/auth/email-change/confirm", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateAPIKey calls POST /api/admin/api-keys: Create an API key
+func (c *Client) CreateAPIKey(ctx context.Context, body CreateAPIKeyRequest) (*APIKeyCreatedResponse, error) {
+	var result APIKeyCreatedResponse
+	if err := c.do(ctx, http.MethodPost, "/api/admin/api-keys", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateInvitation calls POST /api/invitations: Invite an email address to sign up
+func (c *Client) CreateInvitation(ctx context.Context, body CreateInvitationRequest) (*InvitationInfo, error) {
+	var result InvitationInfo
+	if err := c.do(ctx, http.MethodPost, "/api/invitations", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateWebhook calls POST /api/admin/webhooks: Register a webhook endpoint
+func (c *Client) CreateWebhook(ctx context.Context, body CreateWebhookRequest) (*WebhookCreatedResponse, error) {
+	var result WebhookCreatedResponse
+	if err := c.do(ctx, http.MethodPost, "/api/admin/webhooks", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteAvatar calls DELETE /api/users/me/avatar: Remove the avatar of the current user
+func (c *Client) DeleteAvatar(ctx context.Context) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodDelete, "/api/users/me/avatar", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteWebhook calls DELETE /api/admin/webhooks/{id}: Delete a webhook endpoint
+func (c *Client) DeleteWebhook(ctx context.Context, id string) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodDelete, "/api/admin/webhooks/"+url.PathEscape(id), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DisableUser calls POST /api/admin/users/{id}/disable: Disable a user and end all of their sessions
+func (c *Client) DisableUser(ctx context.Context, id string) (*AdminUserInfo, error) {
+	var result AdminUserInfo
+	if err := c.do(ctx, http.MethodPost, "/api/admin/users/"+url.PathEscape(id)+"/disable", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EnableUser calls POST /api/admin/users/{id}/enable: Enable a disabled user
+func (c *Client) EnableUser(ctx context.Context, id string) (*AdminUserInfo, error) {
+	var result AdminUserInfo
+	if err := c.do(ctx, http.MethodPost, "/api/admin/users/"+url.PathEscape(id)+"/enable", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ForceLogout calls POST /api/admin/users/{id}/logout: End all sessions of a user
+func (c *Client) ForceLogout(ctx context.Context, id string) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodPost, "/api/admin/users/"+url.PathEscape(id)+"/logout", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCSRFToken calls GET /api/auth/csrf: Get the CSRF token for requests authenticated by session cookies
+func (c *Client) GetCSRFToken(ctx context.Context) (*CSRFTokenResponse, error) {
+	var result CSRFTokenResponse
+	if err := c.do(ctx, http.MethodGet, "/api/auth/csrf", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDataExport calls GET /api/users/me/exports/{id}: Get the status of a data export, with a temporary download link once it is ready
+func (c *Client) GetDataExport(ctx context.Context, id string) (*DataExportResponse, error) {
+	var result DataExportResponse
+	if err := c.do(ctx, http.MethodGet, "/api/users/me/exports/"+url.PathEscape(id), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetHealth calls GET /health: Check that the service and its database pools are up
+func (c *Client) GetHealth(ctx context.Context) (*HealthResponse, error) {
+	var result HealthResponse
+	if err := c.do(ctx, http.MethodGet, "/health", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetLiveness calls GET /health/live: Check that the process is up, without checking its dependencies
+func (c *Client) GetLiveness(ctx context.Context) (*HealthResponse, error) {
+	var result HealthResponse
+	if err := c.do(ctx, http.MethodGet, "/health/live", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetOnboarding calls GET /api/profile/onboarding: Get the onboarding state of the current user
+func (c *Client) GetOnboarding(ctx context.Context) (*OnboardingResponse, error) {
+	var result OnboardingResponse
+	if err := c.do(ctx, http.MethodGet, "/api/profile/onboarding", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetOutboxStats calls GET /api/admin/outbox: Get the state of the email outbox
+func (c *Client) GetOutboxStats(ctx context.Context) (*OutboxStatsResponse, error) {
+	var result OutboxStatsResponse
+	if err := c.do(ctx, http.MethodGet, "/api/admin/outbox", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPrivacySettings calls GET /api/users/me/privacy: Get the privacy settings of the current user
+func (c *Client) GetPrivacySettings(ctx context.Context) (*PrivacySettingsResponse, error) {
+	var result PrivacySettingsResponse
+	if err := c.do(ctx, http.MethodGet, "/api/users/me/privacy", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetReadiness calls GET /health/ready: Check that the service can serve requests
+func (c *Client) GetReadiness(ctx context.Context) (*HealthResponse, error) {
+	var result HealthResponse
+	if err := c.do(ctx, http.MethodGet, "/health/ready", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSecuritySettings calls GET /api/users/me/security: Get how the current user can sign in
+func (c *Client) GetSecuritySettings(ctx context.Context) (*SecuritySettingsResponse, error) {
+	var result SecuritySettingsResponse
+	if err := c.do(ctx, http.MethodGet, "/api/users/me/security", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetStatus calls GET /api/status: Get the public status of the service's components
+func (c *Client) GetStatus(ctx context.Context) (*StatusResponse, error) {
+	var result StatusResponse
+	if err := c.do(ctx, http.MethodGet, "/api/status", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GoogleCallback calls GET /api/auth/google/callback: Complete the Google authorization code flow and log in
+func (c *Client) GoogleCallback(ctx context.Context, params *GoogleCallbackParams) (*LoginResponse, error) {
+	var result LoginResponse
+	if err := c.do(ctx, http.MethodGet, "/api/auth/google/callback", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GoogleLogin calls POST /api/auth/google/login: Log in with a Google token
+func (c *Client) GoogleLogin(ctx context.Context, body GoogleLoginRequest) (*LoginResponse, error) {
+	var result LoginResponse
+	if err := c.do(ctx, http.MethodPost, "/api/auth/google/login", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GoogleSignup calls POST /api/auth/google/signup: Create an account with a Google token
+func (c *Client) GoogleSignup(ctx context.Context, body GoogleSignupRequest) (*AuthResponse, error) {
+	var result AuthResponse
+	if err := c.do(ctx, http.MethodPost, "/api/auth/google/signup", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// InviteWaitlistBatch calls POST /api/admin/waitlist/invite: Invite the oldest waitlist entries
+func (c *Client) InviteWaitlistBatch(ctx context.Context, body WaitlistInviteRequest) (*WaitlistInviteResponse, error) {
+	var result WaitlistInviteResponse
+	if err := c.do(ctx, http.MethodPost, "/api/admin/waitlist/invite", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// JoinWaitlist calls POST /api/waitlist: Join the waitlist
+func (c *Client) JoinWaitlist(ctx context.Context, body WaitlistJoinRequest) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodPost, "/api/waitlist", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// LinkGoogleIdentity calls POST /api/users/me/identities/google: Link a Google account to the current user
+func (c *Client) LinkGoogleIdentity(ctx context.Context, body LinkGoogleIdentityRequest) (*IdentityInfo, error) {
+	var result IdentityInfo
+	if err := c.do(ctx, http.MethodPost, "/api/users/me/identities/google", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListAPIKeys calls GET /api/admin/api-keys: List API keys
+func (c *Client) ListAPIKeys(ctx context.Context) (*APIKeyListResponse, error) {
+	var result APIKeyListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/admin/api-keys", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListAuditLogs calls GET /api/admin/audit-logs: List audit log entries, newest first
+func (c *Client) ListAuditLogs(ctx context.Context, params *ListAuditLogsParams) (*AuditLogListResponse, error) {
+	var result AuditLogListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/admin/audit-logs", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListLogins calls GET /api/users/me/logins: List the sign-ins to the current user's account, including refused ones, newest first
+func (c *Client) ListLogins(ctx context.Context, params *ListLoginsParams) (*LoginAttemptListResponse, error) {
+	var result LoginAttemptListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/users/me/logins", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListSessions calls GET /api/auth/sessions: List the active sessions of the current user, newest first
+func (c *Client) ListSessions(ctx context.Context, params *ListSessionsParams) (*SessionListResponse, error) {
+	var result SessionListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/auth/sessions", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListUserSessions calls GET /api/admin/users/{id}/sessions: List the active sessions of a user, newest first
+func (c *Client) ListUserSessions(ctx context.Context, id string, params *ListUserSessionsParams) (*SessionListResponse, error) {
+	var result SessionListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/admin/users/"+url.PathEscape(id)+"/sessions", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListUsers calls GET /api/admin/users: List users
+func (c *Client) ListUsers(ctx context.Context, params *ListUsersParams) (*AdminUserListResponse, error) {
+	var result AdminUserListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/admin/users", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListWaitlistEntries calls GET /api/admin/waitlist: List the waitlist entries
+func (c *Client) ListWaitlistEntries(ctx context.Context, params *ListWaitlistEntriesParams) (*WaitlistListResponse, error) {
+	var result WaitlistListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/admin/waitlist", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListWebhooks calls GET /api/admin/webhooks: List webhook endpoints
+func (c *Client) ListWebhooks(ctx context.Context) (*WebhookListResponse, error) {
+	var result WebhookListResponse
+	if err := c.do(ctx, http.MethodGet, "/api/admin/webhooks", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Login calls POST /api/auth/login: Log in with email and password
+func (c *Client) Login(ctx context.Context, body LoginRequest) (*LoginResponse, error) {
+	var result LoginResponse
+	if err := c.do(ctx, http.MethodPost, "/api/auth/login", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Logout calls POST /api/auth/logout: End the current session
+func (c *Client) Logout(ctx context.Context) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodPost, "/api/auth/logout", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ProviderLogin calls POST /api/auth/{provider}/login: Log in with a registered OAuth provider
+func (c *Client) ProviderLogin(ctx context.Context, provider string, body OAuthRequest) (*LoginResponse, error) {
+	var result LoginResponse
+	if err := c.do(ctx, http.MethodPost, "/api/auth/"+url.PathEscape(provider)+"/login", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ProviderSignup calls POST /api/auth/{provider}/signup: Create an account with a registered OAuth provider
+func (c *Client) ProviderSignup(ctx context.Context, provider string, body OAuthRequest) (*AuthResponse, error) {
+	var result AuthResponse
+	if err := c.do(ctx, http.MethodPost, "/api/auth/"+url.PathEscape(provider)+"/signup", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PurgeUser calls POST /api/admin/users/{id}/purge: Irreversibly erase the personal data of a user
+func (c *Client) PurgeUser(ctx context.Context, id string) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodPost, "/api/admin/users/"+url.PathEscape(id)+"/purge", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestDataExport calls POST /api/users/me/export: Start assembling an archive of the current user's data, or return the one in progress
+func (c *Client) RequestDataExport(ctx context.Context) (*DataExportResponse, error) {
+	var result DataExportResponse
+	if err := c.do(ctx, http.MethodPost, "/api/users/me/export", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestEmailChange calls POST /api/users/me/email: Change the current user's email address once both the current and the new address confirm it
+func (c *Client) RequestEmailChange(ctx context.Context, body EmailChangeRequest) (*EmailChangeResponse, error) {
+	var result EmailChangeResponse
+	if err := c.do(ctx, http.MethodPost, "/api/users/me/email", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RevokeAPIKey calls DELETE /api/admin/api-keys/{id}: Revoke an API key
+func (c *Client) RevokeAPIKey(ctx context.Context, id string) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodDelete, "/api/admin/api-keys/"+url.PathEscape(id), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RevokeSession calls DELETE /api/auth/sessions/{id}: Revoke one of the current user's sessions
+func (c *Client) RevokeSession(ctx context.Context, id string) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodDelete, "/api/auth/sessions/"+url.PathEscape(id), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RevokeSessionByToken calls GET /api/auth/sessions/revoke: Revoke the session named by the link in a new sign-in alert email
+func (c *Client) RevokeSessionByToken(ctx context.Context, params *RevokeSessionByTokenParams) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodGet, "/api/auth/sessions/revoke", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchUsers calls GET /api/users/search: Find users by the start of their display name or email address
+func (c *Client) SearchUsers(ctx context.Context, params *SearchUsersParams) (*UserSearchResponse, error) {
+	var result UserSearchResponse
+	if err := c.do(ctx, http.MethodGet, "/api/users/search", params.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Signup calls POST /api/auth/signup: Create an account with email and password
+func (c *Client) Signup(ctx context.Context, body SignupRequest) (*AuthResponse, error) {
+	var result AuthResponse
+	if err := c.do(ctx, http.MethodPost, "/api/auth/signup", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UnlinkIdentity calls DELETE /api/users/me/identities/{provider}: Unlink a provider account from the current user
+func (c *Client) UnlinkIdentity(ctx context.Context, provider string, body UnlinkIdentityRequest) (*Message, error) {
+	var result Message
+	if err := c.do(ctx, http.MethodDelete, "/api/users/me/identities/"+url.PathEscape(provider), nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateOnboarding calls PATCH /api/profile/onboarding: Update the onboarding state of the current user
+func (c *Client) UpdateOnboarding(ctx context.Context, body OnboardingRequest) (*OnboardingResponse, error) {
+	var result OnboardingResponse
+	if err := c.do(ctx, http.MethodPatch, "/api/profile/onboarding", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdatePrivacySettings calls PATCH /api/users/me/privacy: Change the privacy settings of the current user
+func (c *Client) UpdatePrivacySettings(ctx context.Context, body PrivacySettingsRequest) (*PrivacySettingsResponse, error) {
+	var result PrivacySettingsResponse
+	if err := c.do(ctx, http.MethodPatch, "/api/users/me/privacy", nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
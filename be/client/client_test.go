@@ -0,0 +1,121 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/client"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SendsJSONAndDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/auth/login", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"email": "user@example.com", "password": "secret"}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"42","email":"user@example.com","access_token":"token"}`))
+	}))
+	defer server.Close()
+
+	resp, err := client.New(server.URL+"/").Login(context.Background(), client.LoginRequest{
+		Email:    "user@example.com",
+		Password: "secret",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "42", resp.ID)
+	assert.Equal(t, "token", resp.AccessToken)
+}
+
+func TestClient_EncodesPathAndQuery(t *testing.T) {
+	var requestURI string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURI = r.RequestURI
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+	c := client.New(server.URL)
+
+	page, includeTotal := int64(2), true
+	_, err := c.ListUsers(context.Background(), &client.ListUsersParams{Page: &page, IncludeTotal: &includeTotal})
+	require.NoError(t, err)
+	assert.Equal(t, "/api/admin/users?include_total=true&page=2", requestURI)
+
+	_, err = c.ListUsers(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/admin/users", requestURI)
+
+	_, err = c.RevokeSession(context.Background(), "a/b")
+	require.NoError(t, err)
+	assert.Equal(t, "/api/auth/sessions/a%2Fb", requestURI)
+}
+
+func TestClient_SetsAuthenticationHeaders(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := client.New(server.URL,
+		client.WithBearerToken("access"),
+		client.WithAPIKey("key"),
+		client.WithAdminToken("admin"),
+		client.WithHeader("Accept-Language", "ja"),
+	)
+	_, err := c.GetOnboarding(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer access", header.Get("Authorization"))
+	assert.Equal(t, "key", header.Get("X-API-Key"))
+	assert.Equal(t, "admin", header.Get("X-Admin-Token"))
+	assert.Equal(t, "ja", header.Get("Accept-Language"))
+}
+
+func TestClient_ReturnsErrorResponses(t *testing.T) {
+	tests := []struct {
+		expected *client.ErrorResponse
+		name     string
+		body     string
+	}{
+		{
+			name:     "error response",
+			body:     `{"code":"AUTH_INVALID_CREDENTIALS","message":"Invalid email or password"}`,
+			expected: &client.ErrorResponse{Code: "AUTH_INVALID_CREDENTIALS", Message: "Invalid email or password"},
+		},
+		{
+			name: "other body",
+			body: "Bad Gateway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := client.New(server.URL).Logout(context.Background())
+
+			var apiErr *client.Error
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+			assert.Equal(t, tt.expected, apiErr.Response)
+		})
+	}
+}
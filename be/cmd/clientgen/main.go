@@ -0,0 +1,40 @@
+// Command clientgen writes the Go and TypeScript API clients generated from the OpenAPI document
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+
+	"strikepad-backend/internal/clientgen"
+	"strikepad-backend/internal/openapi"
+)
+
+func main() {
+	goOutput := flag.String("go", "client/client_gen.go", "file to write the Go client to, empty to skip it")
+	goPackage := flag.String("package", "client", "package of the Go client")
+	tsOutput := flag.String("ts", "../fe/src/api/generated.ts", "file to write the TypeScript client to, empty to skip it")
+	flag.Parse()
+
+	doc := openapi.Build(openapi.Routes)
+
+	if *goOutput != "" {
+		source, err := clientgen.Go(doc, *goPackage)
+		if err != nil {
+			slog.Error("Failed to generate Go client", "error", err)
+			os.Exit(1)
+		}
+		write(*goOutput, source)
+	}
+
+	if *tsOutput != "" {
+		write(*tsOutput, clientgen.TypeScript(doc))
+	}
+}
+
+func write(output string, source []byte) {
+	if err := os.WriteFile(output, source, 0o644); err != nil {
+		slog.Error("Failed to write client", "error", err, "output", output)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,165 @@
+// Command migrate manages the database schema independently of application deploys:
+//
+//	migrate [-env dev] up [N]       apply N pending migrations, or all of them
+//	migrate -dry-run up [N]         print the migrations up would apply, without applying them
+//	migrate [-env dev] down [N]     revert the last N applied migrations (1 by default)
+//	migrate [-env dev] status       list the applied and pending migrations
+//	migrate [-env dev] create NAME  write an empty migration file and update atlas.sum
+//
+// It runs from the be directory, next to atlas.hcl and the Atlas binary. The environment defaults
+// to APP_ENV, or dev. up and down hold the migration lock, as the server does on startup, so they
+// wait for any other runner to finish.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/migrations"
+)
+
+func main() {
+	env := flag.String("env", config.GetEnv("APP_ENV", "dev"), "environment of atlas.hcl to run against")
+	dryRun := flag.Bool("dry-run", false, "print the pending migrations and their statements instead of applying them")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(),
+			"Usage: migrate [-env ENV] [-dry-run] up [N] | down [N] | status | create NAME")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(context.Background(), *env, *dryRun, flag.Args()); err != nil {
+		slog.Error("Migration command failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, env string, dryRun bool, args []string) error {
+	if len(args) == 0 {
+		flag.Usage()
+		return fmt.Errorf("missing command")
+	}
+
+	runner, err := migrations.NewMigrationRunner(env)
+	if err != nil {
+		return err
+	}
+
+	switch command := args[0]; command {
+	case "up":
+		amount, err := amountArg(args, 0)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			return plan(ctx, runner, amount)
+		}
+		unlock, err := useLock(runner)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		result, err := runner.Up(ctx, amount)
+		if err != nil {
+			return err
+		}
+		for _, applied := range result.Applied {
+			fmt.Printf("applied %s\n", applied.Name)
+		}
+		fmt.Printf("%d migrations applied, now at version %s\n", len(result.Applied), result.Target)
+	case "down":
+		amount, err := amountArg(args, 1)
+		if err != nil {
+			return err
+		}
+		unlock, err := useLock(runner)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		result, err := runner.Down(ctx, amount)
+		if err != nil {
+			return err
+		}
+		for _, reverted := range result.Reverted {
+			fmt.Printf("reverted %s\n", reverted.Name)
+		}
+		fmt.Printf("%d migrations reverted, now at version %s\n", len(result.Reverted), result.Target)
+	case "status":
+		status, err := runner.GetMigrationStatus(ctx)
+		if err != nil {
+			return err
+		}
+		for _, revision := range status.Applied {
+			fmt.Printf("applied  %s_%s (%s)\n", revision.Version, revision.Description,
+				revision.ExecutedAt.Format(time.RFC3339))
+		}
+		for _, file := range status.Pending {
+			fmt.Printf("pending  %s\n", file.Name)
+		}
+		fmt.Printf("status %s, current version %s\n", status.Status, status.Current)
+	case "create":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: migrate create NAME")
+		}
+		path, err := migrations.CreateMigration(migrations.Dir, args[1], time.Now())
+		if err != nil {
+			return err
+		}
+		if err := runner.Hash(ctx); err != nil {
+			return err
+		}
+		fmt.Printf("created %s\n", path)
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+	return nil
+}
+
+// useLock makes runner hold the migration lock of the database, and returns the function closing
+// the connection of the lock
+func useLock(runner *migrations.MigrationRunner) (func(), error) {
+	locker, err := migrations.NewLockerFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	runner.WithLocker(locker)
+	return func() { _ = locker.Close() }, nil
+}
+
+// plan prints the migrations up would apply and their statements
+func plan(ctx context.Context, runner *migrations.MigrationRunner, amount uint64) error {
+	result, err := runner.Plan(ctx, amount)
+	if err != nil {
+		return err
+	}
+	for _, file := range result.Pending {
+		fmt.Printf("-- pending %s\n%s\n", file.Name, file.Content)
+	}
+	fmt.Printf("%d migrations pending, current version %s (dry run, nothing applied)\n",
+		len(result.Pending), result.Current)
+	return nil
+}
+
+// amountArg returns the optional number of migrations following the command, or defaultAmount
+func amountArg(args []string, defaultAmount uint64) (uint64, error) {
+	switch len(args) {
+	case 1:
+		return defaultAmount, nil
+	case 2:
+		amount, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil || amount == 0 {
+			return 0, fmt.Errorf("invalid number of migrations %q", args[1])
+		}
+		return amount, nil
+	default:
+		return 0, fmt.Errorf("usage: migrate %s [N]", args[0])
+	}
+}
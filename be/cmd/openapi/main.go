@@ -0,0 +1,31 @@
+// Command openapi writes the OpenAPI document of the API, for client code generation
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+
+	"strikepad-backend/internal/openapi"
+)
+
+func main() {
+	output := flag.String("o", "api/openapi.json", "file to write the document to, - for stdout")
+	flag.Parse()
+
+	spec, err := openapi.Spec()
+	if err != nil {
+		slog.Error("Failed to build OpenAPI document", "error", err)
+		os.Exit(1)
+	}
+
+	if *output == "-" {
+		_, err = os.Stdout.Write(spec)
+	} else {
+		err = os.WriteFile(*output, spec, 0o644)
+	}
+	if err != nil {
+		slog.Error("Failed to write OpenAPI document", "error", err, "output", *output)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,42 @@
+// Command seed inserts the seed data of an environment into the database of the DB_* variables:
+//
+//	seed [-env dev]     upsert the seed users and sessions
+//	seed -env test      upsert the e2e seed set and print its session tokens
+//
+// The environment defaults to APP_ENV, or dev. Seeding is idempotent; sessions get new tokens on
+// every run. There is no seed set for production.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/seeds"
+)
+
+func main() {
+	env := flag.String("env", config.GetEnv("APP_ENV", "dev"), "environment whose seed set is inserted")
+	flag.Parse()
+
+	seeder := seeds.NewSeeder(config.NewDatabase(nil), auth.NewPasswordHasher(), auth.NewJWTService(clock.New()))
+	result, err := seeder.Run(context.Background(), *env)
+	if err != nil {
+		slog.Error("Seeding failed", "error", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Seeded %d users with the password %s\n", result.Users, seeds.Password)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SESSION\tUSER\tACCESS TOKEN")
+	for _, session := range result.Sessions {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", session.PublicID, session.UserEmail, session.AccessToken)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// APIKeyPrefix marks strings as strikepad API keys, which makes leaked keys easy to search for
+	APIKeyPrefix = "sk_"
+	// apiKeyBytes is the amount of randomness in an API key
+	apiKeyBytes = 32
+	// apiKeyDisplayLength is how much of the key is kept in clear text to identify it
+	apiKeyDisplayLength = 11
+)
+
+// GenerateAPIKey returns a new random API key and the prefix stored to identify it
+func GenerateAPIKey() (key, displayPrefix string, err error) {
+	buf := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key = APIKeyPrefix + hex.EncodeToString(buf)
+	return key, key[:apiKeyDisplayLength], nil
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 hash under which an API key is stored.
+// API keys are long random strings, so a fast hash is enough and allows lookup by hash.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,32 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, displayPrefix, err := auth.GenerateAPIKey()
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, auth.APIKeyPrefix))
+	assert.True(t, strings.HasPrefix(key, displayPrefix))
+	assert.Len(t, key, len(auth.APIKeyPrefix)+64)
+	assert.Less(t, len(displayPrefix), len(key))
+
+	other, _, err := auth.GenerateAPIKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestHashAPIKey(t *testing.T) {
+	hash := auth.HashAPIKey("sk_test")
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, auth.HashAPIKey("sk_test"))
+	assert.NotEqual(t, hash, auth.HashAPIKey("sk_other"))
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // SHA-1 is what the Pwned Passwords range API is keyed by
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/config"
+)
+
+// defaultBreachAPIURL is the range endpoint of HaveIBeenPwned's Pwned Passwords API
+const defaultBreachAPIURL = "https://api.pwnedpasswords.com/range/"
+
+// hashPrefixLength is the number of hex characters of the SHA-1 hash sent to the API. The rest
+// stays in the process, so the API never learns which password was checked (k-anonymity).
+const hashPrefixLength = 5
+
+// BreachChecker reports whether a password appears in known data breaches
+type BreachChecker interface {
+	IsBreached(password string) (bool, error)
+}
+
+// NewBreachChecker returns a checker using the Pwned Passwords API when PASSWORD_BREACH_CHECK is
+// true, and a checker that reports no password as breached otherwise
+func NewBreachChecker() BreachChecker {
+	if !config.GetEnvBool("PASSWORD_BREACH_CHECK", false) {
+		return noopBreachChecker{}
+	}
+	timeout := time.Duration(config.GetEnvInt("PASSWORD_BREACH_CHECK_TIMEOUT_MS", 2000)) * time.Millisecond
+	return &PwnedPasswordsChecker{
+		httpClient: &http.Client{Timeout: timeout},
+		apiURL:     config.GetEnv("PASSWORD_BREACH_API_URL", defaultBreachAPIURL),
+		minCount:   config.GetEnvInt("PASSWORD_BREACH_MIN_COUNT", 1),
+		failOpen:   config.GetEnvBool("PASSWORD_BREACH_CHECK_FAIL_OPEN", true),
+	}
+}
+
+// noopBreachChecker is used when the breach check is disabled
+type noopBreachChecker struct{}
+
+func (noopBreachChecker) IsBreached(_ string) (bool, error) {
+	return false, nil
+}
+
+// PwnedPasswordsChecker checks passwords against the Pwned Passwords range API
+type PwnedPasswordsChecker struct {
+	httpClient *http.Client
+	apiURL     string
+	// minCount is the number of breaches a password must appear in to be rejected
+	minCount int
+	// failOpen accepts passwords when the API cannot be reached, so that an outage of the API does
+	// not block signups
+	failOpen bool
+}
+
+// IsBreached sends the first characters of the SHA-1 hash of password to the API and looks for
+// the rest of the hash among the hashes it returns
+func (c *PwnedPasswordsChecker) IsBreached(password string) (bool, error) {
+	breached, err := c.lookup(password)
+	if err != nil && c.failOpen {
+		slog.Warn("Password breach check failed, accepting the password", "error", err)
+		return false, nil
+	}
+	return breached, err
+}
+
+func (c *PwnedPasswordsChecker) lookup(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // see the import
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, c.apiURL+prefix, http.NoBody)
+	if err != nil {
+		return false, err
+	}
+	// Padding hides the number of matching hashes from observers of the response size
+	req.Header.Set("Add-Padding", "true")
+	req.Header.Set("User-Agent", "strikepad-backend")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to reach the Pwned Passwords API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("the Pwned Passwords API returned status %d", resp.StatusCode)
+	}
+
+	// Each line is the suffix of a hash and the number of breaches it appears in, as SUFFIX:COUNT.
+	// Padding lines have a count of 0.
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || lineSuffix != suffix {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return false, fmt.Errorf("invalid count in the Pwned Passwords response: %w", err)
+		}
+		return n > 0 && n >= c.minCount, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("failed to read the Pwned Passwords response: %w", err)
+	}
+	return false, nil
+}
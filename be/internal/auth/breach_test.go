@@ -0,0 +1,137 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The SHA-1 hash of breachedPassword, split into the prefix sent to the API and the suffix it returns
+const (
+	breachedPassword       = "Password123!"
+	breachedPasswordPrefix = "49EFE"
+	breachedPasswordSuffix = "F5F70D47ADC2DB2EB397FBEF5F7BC560E29"
+)
+
+func newPwnedPasswordsServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/range/"+breachedPasswordPrefix, r.URL.Path)
+		assert.Equal(t, "true", r.Header.Get("Add-Padding"))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewBreachChecker_Disabled(t *testing.T) {
+	t.Setenv("PASSWORD_BREACH_CHECK", "")
+
+	breached, err := auth.NewBreachChecker().IsBreached(breachedPassword)
+
+	assert.NoError(t, err)
+	assert.False(t, breached)
+}
+
+func TestPwnedPasswordsChecker_IsBreached(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		failOpen    string
+		minCount    string
+		status      int
+		expected    bool
+		expectError bool
+	}{
+		{
+			name:     "breached password",
+			body:     "0018A45C4D1DEF81644B54AB7F969B88D65:10\r\n" + breachedPasswordSuffix + ":3\r\n",
+			status:   http.StatusOK,
+			expected: true,
+		},
+		{
+			name:   "unknown password",
+			body:   "0018A45C4D1DEF81644B54AB7F969B88D65:10\r\n",
+			status: http.StatusOK,
+		},
+		{
+			name:   "padding entry",
+			body:   breachedPasswordSuffix + ":0\r\n",
+			status: http.StatusOK,
+		},
+		{
+			name:     "fewer breaches than the minimum",
+			body:     breachedPasswordSuffix + ":3\r\n",
+			minCount: "5",
+			status:   http.StatusOK,
+		},
+		{
+			name:   "API error fails open",
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name:        "API error fails closed",
+			failOpen:    "false",
+			status:      http.StatusServiceUnavailable,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newPwnedPasswordsServer(t, tt.status, tt.body)
+			t.Setenv("PASSWORD_BREACH_CHECK", "true")
+			t.Setenv("PASSWORD_BREACH_API_URL", server.URL+"/range/")
+			t.Setenv("PASSWORD_BREACH_CHECK_FAIL_OPEN", tt.failOpen)
+			t.Setenv("PASSWORD_BREACH_MIN_COUNT", tt.minCount)
+
+			breached, err := auth.NewBreachChecker().IsBreached(breachedPassword)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, breached)
+		})
+	}
+}
+
+func TestPwnedPasswordsChecker_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+	t.Setenv("PASSWORD_BREACH_CHECK", "true")
+	t.Setenv("PASSWORD_BREACH_API_URL", server.URL+"/range/")
+	t.Setenv("PASSWORD_BREACH_CHECK_TIMEOUT_MS", "20")
+	t.Setenv("PASSWORD_BREACH_CHECK_FAIL_OPEN", "false")
+
+	_, err := auth.NewBreachChecker().IsBreached(breachedPassword)
+
+	assert.Error(t, err)
+}
+
+type stubBreachChecker struct {
+	err      error
+	breached bool
+}
+
+func (s stubBreachChecker) IsBreached(_ string) (bool, error) {
+	return s.breached, s.err
+}
+
+func TestValidatePassword_BreachCheck(t *testing.T) {
+	assert.NoError(t, auth.ValidatePassword(breachedPassword, nil))
+	assert.NoError(t, auth.ValidatePassword(breachedPassword, stubBreachChecker{}))
+	assert.Equal(t, auth.ErrPasswordBreached, auth.ValidatePassword(breachedPassword, stubBreachChecker{breached: true}))
+	assert.Equal(t, auth.ErrPasswordTooShort, auth.ValidatePassword("short", stubBreachChecker{breached: true}))
+	assert.Error(t, auth.ValidatePassword(breachedPassword, stubBreachChecker{err: assert.AnError}))
+}
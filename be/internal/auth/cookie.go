@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/config"
+)
+
+const (
+	// AccessTokenCookieName is the cookie carrying the access token in cookie session mode
+	AccessTokenCookieName = "access_token"
+	// RefreshTokenCookieName is the cookie carrying the refresh token in cookie session mode
+	RefreshTokenCookieName = "refresh_token"
+	// refreshTokenCookiePath limits the refresh token cookie to the auth endpoints
+	refreshTokenCookiePath = "/api/auth"
+)
+
+// SessionCookies describes the HttpOnly cookies that carry the session tokens in cookie session
+// mode, so that browser clients never handle the tokens themselves
+type SessionCookies struct {
+	// Domain is the cookie domain; empty keeps the cookies on the API host
+	Domain string
+	// SameSite is the SameSite attribute of both cookies
+	SameSite http.SameSite
+	// Enabled turns cookie session mode on
+	Enabled bool
+	// Secure restricts the cookies to HTTPS
+	Secure bool
+}
+
+// SessionCookiesFromEnv reads AUTH_COOKIE_MODE, AUTH_COOKIE_SECURE, AUTH_COOKIE_SAMESITE (lax,
+// strict or none) and AUTH_COOKIE_DOMAIN
+func SessionCookiesFromEnv() SessionCookies {
+	cookies := SessionCookies{
+		Domain:   config.GetEnv("AUTH_COOKIE_DOMAIN", ""),
+		SameSite: http.SameSiteLaxMode,
+		Enabled:  config.GetEnvBool("AUTH_COOKIE_MODE", false),
+		Secure:   config.GetEnvBool("AUTH_COOKIE_SECURE", true),
+	}
+
+	switch sameSite := strings.ToLower(config.GetEnv("AUTH_COOKIE_SAMESITE", "lax")); sameSite {
+	case "lax":
+	case "strict":
+		cookies.SameSite = http.SameSiteStrictMode
+	case "none":
+		cookies.SameSite = http.SameSiteNoneMode
+		// Browsers drop SameSite=None cookies that are not Secure
+		cookies.Secure = true
+	default:
+		slog.Warn("Ignoring invalid AUTH_COOKIE_SAMESITE, using lax", "value", sameSite)
+	}
+
+	return cookies
+}
+
+// Cookies returns the cookies that store the token pair. Each expires with its token.
+func (s SessionCookies) Cookies(tokenPair *TokenPair) []*http.Cookie {
+	return []*http.Cookie{
+		s.cookie(AccessTokenCookieName, "/", tokenPair.AccessToken, tokenPair.AccessTokenExpiresAt),
+		s.cookie(RefreshTokenCookieName, refreshTokenCookiePath, tokenPair.RefreshToken, tokenPair.RefreshTokenExpiresAt),
+	}
+}
+
+// ClearCookies returns cookies that remove the session cookies from the browser
+func (s SessionCookies) ClearCookies() []*http.Cookie {
+	cookies := []*http.Cookie{
+		s.cookie(AccessTokenCookieName, "/", "", time.Unix(0, 0)),
+		s.cookie(RefreshTokenCookieName, refreshTokenCookiePath, "", time.Unix(0, 0)),
+	}
+	for _, cookie := range cookies {
+		cookie.MaxAge = -1
+	}
+	return cookies
+}
+
+func (s SessionCookies) cookie(name, path, value string, expires time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   s.Domain,
+		Expires:  expires,
+		Secure:   s.Secure,
+		HttpOnly: true,
+		SameSite: s.SameSite,
+	}
+}
@@ -0,0 +1,52 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionCookiesFromEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		sameSite string
+		secure   string
+		expected auth.SessionCookies
+	}{
+		{
+			name:     "defaults",
+			expected: auth.SessionCookies{Enabled: true, Secure: true, SameSite: http.SameSiteLaxMode},
+		},
+		{
+			name:     "strict over plain HTTP",
+			sameSite: "Strict",
+			secure:   "false",
+			expected: auth.SessionCookies{Enabled: true, SameSite: http.SameSiteStrictMode},
+		},
+		{
+			name:     "none is always secure",
+			sameSite: "none",
+			secure:   "false",
+			expected: auth.SessionCookies{Enabled: true, Secure: true, SameSite: http.SameSiteNoneMode},
+		},
+		{
+			name:     "invalid SameSite falls back to lax",
+			sameSite: "sometimes",
+			expected: auth.SessionCookies{Enabled: true, Secure: true, SameSite: http.SameSiteLaxMode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AUTH_COOKIE_MODE", "true")
+			t.Setenv("AUTH_COOKIE_SAMESITE", tt.sameSite)
+			t.Setenv("AUTH_COOKIE_SECURE", tt.secure)
+			t.Setenv("AUTH_COOKIE_DOMAIN", "")
+
+			assert.Equal(t, tt.expected, auth.SessionCookiesFromEnv())
+		})
+	}
+}
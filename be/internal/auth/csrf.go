@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+)
+
+const (
+	// CSRFCookieName is the cookie holding the CSRF token. It is readable by scripts, which copy
+	// it into CSRFHeaderName.
+	CSRFCookieName = "csrf_token"
+	// CSRFHeaderName is the request header that must repeat the CSRF cookie
+	CSRFHeaderName = "X-CSRF-Token"
+	// csrfTokenBytes is the amount of randomness in a CSRF token
+	csrfTokenBytes = 32
+)
+
+// GenerateCSRFToken returns a new random CSRF token
+func GenerateCSRFToken() (string, error) {
+	buf := make([]byte, csrfTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate CSRF token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// IsCSRFToken reports whether token has the format of a token from GenerateCSRFToken
+func IsCSRFToken(token string) bool {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	return err == nil && len(decoded) == csrfTokenBytes
+}
+
+// CSRFTokensMatch reports whether the CSRF header repeats the CSRF cookie, in constant time
+func CSRFTokensMatch(cookieToken, headerToken string) bool {
+	if !IsCSRFToken(cookieToken) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookieToken), []byte(headerToken)) == 1
+}
@@ -0,0 +1,27 @@
+package auth_test
+
+import (
+	"testing"
+
+	"strikepad-backend/internal/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSRFToken(t *testing.T) {
+	token, err := auth.GenerateCSRFToken()
+	require.NoError(t, err)
+	other, err := auth.GenerateCSRFToken()
+	require.NoError(t, err)
+
+	assert.True(t, auth.IsCSRFToken(token))
+	assert.NotEqual(t, token, other)
+	assert.False(t, auth.IsCSRFToken("short"))
+	assert.False(t, auth.IsCSRFToken(token+"!"))
+
+	assert.True(t, auth.CSRFTokensMatch(token, token))
+	assert.False(t, auth.CSRFTokensMatch(token, other))
+	assert.False(t, auth.CSRFTokensMatch(token, ""))
+	assert.False(t, auth.CSRFTokensMatch("", ""))
+}
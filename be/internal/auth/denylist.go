@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/clock"
+)
+
+const (
+	revokedSessionKeyPrefix = "revoked:session:"
+	revokedUserKeyPrefix    = "revoked:user:"
+)
+
+// Denylist records revoked sessions and users so that stateless access tokens issued for them are
+// rejected before they expire. Entries are kept for the lifetime of an access token.
+type Denylist struct {
+	cache cache.Cache
+	// clock is the clock of the JWT service, which the revocation times are compared with
+	clock clock.Clock
+	ttl   time.Duration
+}
+
+// NewDenylist stores revocations in c, which must be shared by all instances (Redis). When c is
+// nil revocations are kept in memory, which only works for a single instance.
+func NewDenylist(c cache.Cache, jwtService *JWTService) *Denylist {
+	if c == nil {
+		if jwtService.Stateless() {
+			slog.Warn("REDIS_URL is not set, stateless token revocations are not shared between instances")
+		}
+		c = cache.NewMemoryCache()
+	}
+	return &Denylist{cache: c, clock: jwtService.clock, ttl: jwtService.AccessTokenTTL()}
+}
+
+// RevokeSession rejects access tokens issued for the session with the public ID
+func (d *Denylist) RevokeSession(ctx context.Context, sessionID string) error {
+	if err := d.cache.Set(ctx, revokedSessionKeyPrefix+sessionID, []byte("1"), d.ttl); err != nil {
+		return fmt.Errorf("failed to revoke session %s: %w", sessionID, err)
+	}
+	return nil
+}
+
+// RevokeUser rejects access tokens issued to the user up to now
+func (d *Denylist) RevokeUser(ctx context.Context, userID uint) error {
+	now := strconv.FormatInt(d.clock.Now().Unix(), 10)
+	key := revokedUserKeyPrefix + strconv.FormatUint(uint64(userID), 10)
+	if err := d.cache.Set(ctx, key, []byte(now), d.ttl); err != nil {
+		return fmt.Errorf("failed to revoke sessions of user %d: %w", userID, err)
+	}
+	return nil
+}
+
+// IsRevoked reports whether the token's session or all of its user's sessions were revoked after
+// it was issued
+func (d *Denylist) IsRevoked(ctx context.Context, claims *JWTClaims) (bool, error) {
+	if claims.Session != nil {
+		_, err := d.cache.Get(ctx, revokedSessionKeyPrefix+claims.Session.ID)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, cache.ErrMiss) {
+			return false, err
+		}
+	}
+
+	revokedAt, err := d.cache.Get(ctx, revokedUserKeyPrefix+strconv.FormatUint(uint64(claims.UserID), 10))
+	if errors.Is(err, cache.ErrMiss) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	revokedAtUnix, err := strconv.ParseInt(string(revokedAt), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid user revocation time %q: %w", revokedAt, err)
+	}
+	// Tokens carry whole seconds, so a token issued in the second of the revocation is rejected too
+	return claims.IssuedAt == nil || claims.IssuedAt.Unix() <= revokedAtUnix, nil
+}
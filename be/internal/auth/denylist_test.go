@@ -0,0 +1,77 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/clock"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTService_SessionClaims(t *testing.T) {
+	t.Setenv("JWT_SECRET_KEY", "test-secret-key-for-testing")
+	t.Setenv("STATELESS_ACCESS_TOKENS", "true")
+	t.Setenv("STATELESS_ACCESS_TOKEN_TTL_SECONDS", "")
+	jwtService := auth.NewJWTService(clock.New())
+
+	assert.True(t, jwtService.Stateless())
+	assert.Equal(t, 5*time.Minute, jwtService.AccessTokenTTL())
+
+	session := &auth.SessionClaims{ID: "ses_8h2m4q0x9d7c3v1k5b6n", UserPublicID: "usr_5k2d9x0q7m3hv8c1b4na", Role: "admin"}
+	tokenPair, err := jwtService.GenerateSessionTokenPair(1, session)
+	require.NoError(t, err)
+
+	accessClaims, err := jwtService.ValidateAccessToken(tokenPair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, session, accessClaims.Session)
+
+	refreshClaims, err := jwtService.ValidateRefreshToken(tokenPair.RefreshToken)
+	require.NoError(t, err)
+	assert.Nil(t, refreshClaims.Session)
+}
+
+func TestDenylist(t *testing.T) {
+	t.Setenv("STATELESS_ACCESS_TOKENS", "true")
+	denylist := auth.NewDenylist(cache.NewMemoryCache(), auth.NewJWTService(clock.New()))
+	ctx := context.Background()
+
+	issuedBefore := &auth.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+		Session:          &auth.SessionClaims{ID: "ses_8h2m4q0x9d7c3v1k5b6n"},
+		UserID:           1,
+	}
+	otherSession := &auth.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+		Session:          &auth.SessionClaims{ID: "ses_000000000000000000aa"},
+		UserID:           1,
+	}
+
+	revoked, err := denylist.IsRevoked(ctx, issuedBefore)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.NoError(t, denylist.RevokeSession(ctx, "ses_8h2m4q0x9d7c3v1k5b6n"))
+	revoked, err = denylist.IsRevoked(ctx, issuedBefore)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = denylist.IsRevoked(ctx, otherSession)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// Revoking the user rejects tokens issued up to now, but not later ones
+	require.NoError(t, denylist.RevokeUser(ctx, 1))
+	revoked, err = denylist.IsRevoked(ctx, otherSession)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	otherSession.IssuedAt = jwt.NewNumericDate(time.Now().Add(2 * time.Second))
+	revoked, err = denylist.IsRevoked(ctx, otherSession)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
@@ -1,23 +1,68 @@
 package auth
 
-import "errors"
+import "strikepad-backend/internal/errors"
 
+// The errors of this package are AppErrors, so that handlers can return them as API errors
 var (
 	// ErrPasswordTooShort is returned when password is shorter than minimum length
-	ErrPasswordTooShort = errors.New("password must be at least 8 characters long")
+	ErrPasswordTooShort = errors.New(errors.ErrCodePasswordTooShort, "password must be at least 8 characters long")
 	// ErrPasswordTooLong is returned when password exceeds maximum length
-	ErrPasswordTooLong = errors.New("password must be at most 128 characters long")
+	ErrPasswordTooLong = errors.New(errors.ErrCodePasswordTooLong, "password must be at most 128 characters long")
+	// ErrPasswordBreached is returned when a password appears in known data breaches
+	ErrPasswordBreached = errors.New(errors.ErrCodePasswordBreached, "password has appeared in a data breach")
 
 	// ErrInvalidEmail is returned when email format is invalid
-	ErrInvalidEmail = errors.New("invalid email format")
+	ErrInvalidEmail = errors.New(errors.ErrCodeEmailInvalid, "invalid email format")
 	// ErrEmailRequired is returned when email is missing
-	ErrEmailRequired = errors.New("email is required")
+	ErrEmailRequired = errors.New(errors.ErrCodeEmailRequired, "email is required")
 
 	// ErrUserAlreadyExists is returned when attempting to create a user that already exists
-	ErrUserAlreadyExists = errors.New("user with this email already exists")
+	ErrUserAlreadyExists = errors.New(errors.ErrCodeUserExists, "user with this email already exists")
 	// ErrUserNotFound is returned when requested user does not exist
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound = errors.New(errors.ErrCodeUserNotFound, "user not found")
 
 	// ErrInvalidCredentials is returned when login credentials are incorrect
-	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrInvalidCredentials = errors.New(errors.ErrCodeInvalidCredentials, "invalid email or password")
+	// ErrAccountDisabled is returned when a disabled user tries to log in
+	ErrAccountDisabled = errors.New(errors.ErrCodeAccountDisabled, "account is disabled")
+
+	// ErrInvalidGoogleToken is returned when a token presented for Google signup cannot be verified
+	ErrInvalidGoogleToken = errors.New(errors.ErrCodeInvalidRequest, "invalid Google token")
+
+	// ErrIdentityAlreadyLinked is returned when a provider account already belongs to a user
+	ErrIdentityAlreadyLinked = errors.New(errors.ErrCodeIdentityLinked, "provider account is already linked to a user")
+	// ErrLastLoginMethod is returned when removing a login method would leave the user unable to sign in
+	ErrLastLoginMethod = errors.New(errors.ErrCodeLastLoginMethod, "cannot remove the last login method")
+	// ErrStepUpRequired is returned when a sensitive action needs the user to authenticate again
+	ErrStepUpRequired = errors.New(errors.ErrCodeStepUpRequired, "recent authentication required")
+	// ErrInvitationRequired is returned when registration is closed and no invitation was presented
+	ErrInvitationRequired = errors.New(errors.ErrCodeInvitationRequired, "registration requires an invitation")
+	// ErrInvalidInvitation is returned when an invite token is invalid, expired, already used,
+	// or was issued for another email address
+	ErrInvalidInvitation = errors.New(errors.ErrCodeInvitationInvalid, "invalid or expired invitation")
+
+	// ErrTokenExpired is returned when an otherwise valid token is past its expiry,
+	// which tells clients to refresh it instead of logging in again
+	ErrTokenExpired = errors.New(errors.ErrCodeTokenExpired, "token has expired")
+	// ErrTokenInvalid is returned when a token is malformed, or its session has ended or does not
+	// match it, which tells clients to log in again
+	ErrTokenInvalid = errors.New(errors.ErrCodeTokenInvalid, "token is invalid")
+	// ErrSessionNotOwned is returned when a user acts on a session of another user
+	ErrSessionNotOwned = errors.New(errors.ErrCodeForbidden, "session belongs to another user")
+	// ErrInvalidSessionRevokeToken is returned when the revoke link of a new sign-in alert is
+	// invalid or has expired
+	ErrInvalidSessionRevokeToken = errors.New(errors.ErrCodeTokenInvalid, "invalid or expired session revoke link")
+	// ErrInvalidDataExportToken is returned when the download link of a data export is invalid or
+	// has expired
+	ErrInvalidDataExportToken = errors.New(errors.ErrCodeTokenInvalid, "invalid or expired data export link")
+	// ErrInvalidEmailChangeToken is returned when the confirmation link of an email change is
+	// invalid, has expired, or belongs to a change that was replaced or cancelled
+	ErrInvalidEmailChangeToken = errors.New(errors.ErrCodeTokenInvalid, "invalid or expired email change link")
+
+	// ErrSessionLimitReached is returned when a user who already has the maximum number of sessions
+	// signs in and the limit policy rejects new sessions
+	ErrSessionLimitReached = errors.New(errors.ErrCodeSessionLimit, "maximum number of sessions reached")
+
+	// ErrInvalidAPIKey is returned when an API key is unknown or revoked
+	ErrInvalidAPIKey = errors.New(errors.ErrCodeUnauthorized, "invalid API key")
 )
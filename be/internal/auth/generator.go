@@ -0,0 +1,30 @@
+package auth
+
+import "strikepad-backend/internal/publicid"
+
+// TokenGenerator generates the random identifiers of sessions and tokens. Services take it from
+// the container, so that tests can make the identifiers predictable.
+type TokenGenerator interface {
+	// PublicID generates a public ID with the prefix, see publicid.New
+	PublicID(prefix string) string
+}
+
+// NewTokenGenerator returns a generator of random identifiers
+func NewTokenGenerator() TokenGenerator {
+	return randomTokenGenerator{}
+}
+
+type randomTokenGenerator struct{}
+
+// PublicID generates a random public ID with the prefix
+func (randomTokenGenerator) PublicID(prefix string) string {
+	return publicid.New(prefix)
+}
+
+// TokenGeneratorFunc adapts a function to a TokenGenerator
+type TokenGeneratorFunc func(prefix string) string
+
+// PublicID returns f(prefix)
+func (f TokenGeneratorFunc) PublicID(prefix string) string {
+	return f(prefix)
+}
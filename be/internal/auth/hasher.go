@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"strikepad-backend/internal/config"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password hashing algorithms selected by PASSWORD_HASH_ALGORITHM
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+// argon2idPrefix starts argon2id hashes, which are encoded in the PHC string format:
+// $argon2id$v=19$m=<memory KiB>,t=<iterations>,p=<parallelism>$<base64 salt>$<base64 key>
+const argon2idPrefix = "$argon2id$"
+
+// Argon2Params are the parameters of argon2id hashes
+type Argon2Params struct {
+	// Memory is the memory used in KiB
+	Memory      uint32
+	Iterations  uint32
+	SaltLength  uint32
+	KeyLength   uint32
+	Parallelism uint8
+}
+
+// DefaultArgon2Params follow the second recommended option of RFC 9106 with a 64 MiB memory cost
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	SaltLength:  16,
+	KeyLength:   32,
+	Parallelism: 2,
+}
+
+// PasswordHasher hashes passwords with the configured algorithm and parameters. It verifies the
+// hashes of every supported algorithm, and reports the hashes that use other ones so that they
+// can be replaced when the user next logs in.
+type PasswordHasher struct {
+	algorithm  string
+	argon2     Argon2Params
+	bcryptCost int
+}
+
+// NewPasswordHasher returns a hasher using PASSWORD_HASH_ALGORITHM (bcrypt by default), with a cost
+// of PASSWORD_BCRYPT_COST for bcrypt, and PASSWORD_ARGON2_MEMORY_KIB, PASSWORD_ARGON2_ITERATIONS and
+// PASSWORD_ARGON2_PARALLELISM for argon2id
+func NewPasswordHasher() *PasswordHasher {
+	algorithm := strings.ToLower(config.GetEnv("PASSWORD_HASH_ALGORITHM", AlgorithmBcrypt))
+	if algorithm != AlgorithmBcrypt && algorithm != AlgorithmArgon2id {
+		panic("Invalid PASSWORD_HASH_ALGORITHM: " + algorithm)
+	}
+
+	bcryptCost := config.GetEnvInt("PASSWORD_BCRYPT_COST", DefaultCost)
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		panic(fmt.Sprintf("Invalid PASSWORD_BCRYPT_COST: %d", bcryptCost))
+	}
+
+	params := DefaultArgon2Params
+	params.Memory = uint32(envUint("PASSWORD_ARGON2_MEMORY_KIB", 32, uint64(params.Memory)))
+	params.Iterations = uint32(envUint("PASSWORD_ARGON2_ITERATIONS", 32, uint64(params.Iterations)))
+	params.Parallelism = uint8(envUint("PASSWORD_ARGON2_PARALLELISM", 8, uint64(params.Parallelism)))
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+		panic("Invalid PASSWORD_ARGON2_* parameters: they must be positive")
+	}
+
+	return &PasswordHasher{algorithm: algorithm, bcryptCost: bcryptCost, argon2: params}
+}
+
+// envUint returns the environment variable key as an unsigned integer of bits bits, or
+// defaultValue when it is not set or not such an integer
+func envUint(key string, bits int, defaultValue uint64) uint64 {
+	value, err := strconv.ParseUint(config.GetEnv(key, ""), 10, bits)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// Hash returns the hash of password with the configured algorithm
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	if h.algorithm == AlgorithmArgon2id {
+		return hashArgon2id(password, h.argon2)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+	return string(hash), err
+}
+
+// Verify reports whether password matches hash, whatever algorithm hash uses
+func (h *PasswordHasher) Verify(password, hash string) bool {
+	return CheckPasswordHash(password, hash)
+}
+
+// NeedsRehash reports whether hash was made with another algorithm or other parameters than the
+// configured ones
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	if h.algorithm == AlgorithmArgon2id {
+		params, _, _, err := decodeArgon2id(hash)
+		return err != nil || params != h.argon2
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.bcryptCost
+}
+
+// hashArgon2id returns the argon2id hash of password with a random salt, in the PHC string format
+func hashArgon2id(password string, params Argon2Params) (string, error) {
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt,
+		params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version,
+		params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// decodeArgon2id returns the parameters, salt and key of an argon2id hash
+func decodeArgon2id(hash string) (params Argon2Params, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || !strings.HasPrefix(hash, argon2idPrefix) {
+		return params, nil, nil, fmt.Errorf("not an argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2 parameters: %w", err)
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2 salt: %w", err)
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2 key: %w", err)
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
+
+// checkArgon2id reports whether password matches an argon2id hash
+func checkArgon2id(password, hash string) bool {
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false
+	}
+	candidate := argon2.IDKey([]byte(password), salt,
+		params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	return subtle.ConstantTimeCompare(candidate, key) == 1
+}
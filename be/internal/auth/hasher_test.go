@@ -0,0 +1,125 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// useCheapArgon2 configures argon2id with parameters small enough for tests
+func useCheapArgon2(t *testing.T) {
+	t.Helper()
+	t.Setenv("PASSWORD_HASH_ALGORITHM", auth.AlgorithmArgon2id)
+	t.Setenv("PASSWORD_ARGON2_MEMORY_KIB", "1024")
+	t.Setenv("PASSWORD_ARGON2_ITERATIONS", "1")
+	t.Setenv("PASSWORD_ARGON2_PARALLELISM", "1")
+}
+
+func TestPasswordHasher_Bcrypt(t *testing.T) {
+	t.Setenv("PASSWORD_HASH_ALGORITHM", "")
+	t.Setenv("PASSWORD_BCRYPT_COST", "5")
+	hasher := auth.NewPasswordHasher()
+
+	hash, err := hasher.Hash(testPasswordConst)
+	require.NoError(t, err)
+
+	cost, err := bcrypt.Cost([]byte(hash))
+	require.NoError(t, err)
+	assert.Equal(t, 5, cost)
+	assert.True(t, hasher.Verify(testPasswordConst, hash))
+	assert.False(t, hasher.Verify("wrong password", hash))
+	assert.False(t, hasher.NeedsRehash(hash))
+}
+
+func TestPasswordHasher_Argon2id(t *testing.T) {
+	useCheapArgon2(t)
+	hasher := auth.NewPasswordHasher()
+
+	hash, err := hasher.Hash(testPasswordConst)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"), hash)
+	assert.True(t, hasher.Verify(testPasswordConst, hash))
+	assert.True(t, auth.CheckPasswordHash(testPasswordConst, hash))
+	assert.False(t, hasher.Verify("wrong password", hash))
+	assert.False(t, hasher.NeedsRehash(hash))
+
+	other, err := hasher.Hash(testPasswordConst)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "Two hashes of the same password should be different due to salt")
+}
+
+func TestPasswordHasher_NeedsRehash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte(testPasswordConst), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	useCheapArgon2(t)
+	argon2Hash, err := auth.NewPasswordHasher().Hash(testPasswordConst)
+	require.NoError(t, err)
+
+	tests := []struct {
+		env      map[string]string
+		name     string
+		hash     string
+		expected bool
+	}{
+		{
+			name:     "bcrypt hash with the configured cost",
+			env:      map[string]string{"PASSWORD_HASH_ALGORITHM": auth.AlgorithmBcrypt, "PASSWORD_BCRYPT_COST": "4"},
+			hash:     string(bcryptHash),
+			expected: false,
+		},
+		{
+			name:     "bcrypt hash with an outdated cost",
+			env:      map[string]string{"PASSWORD_HASH_ALGORITHM": auth.AlgorithmBcrypt, "PASSWORD_BCRYPT_COST": "5"},
+			hash:     string(bcryptHash),
+			expected: true,
+		},
+		{
+			name:     "argon2id hash when bcrypt is configured",
+			env:      map[string]string{"PASSWORD_HASH_ALGORITHM": auth.AlgorithmBcrypt},
+			hash:     argon2Hash,
+			expected: true,
+		},
+		{
+			name:     "bcrypt hash when argon2id is configured",
+			env:      map[string]string{},
+			hash:     string(bcryptHash),
+			expected: true,
+		},
+		{
+			name:     "argon2id hash with outdated parameters",
+			env:      map[string]string{"PASSWORD_ARGON2_ITERATIONS": "2"},
+			hash:     argon2Hash,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			assert.Equal(t, tt.expected, auth.NewPasswordHasher().NeedsRehash(tt.hash))
+		})
+	}
+}
+
+func TestNewPasswordHasher_InvalidConfig(t *testing.T) {
+	t.Setenv("PASSWORD_HASH_ALGORITHM", "md5")
+	assert.Panics(t, func() { auth.NewPasswordHasher() })
+
+	t.Setenv("PASSWORD_HASH_ALGORITHM", auth.AlgorithmBcrypt)
+	t.Setenv("PASSWORD_BCRYPT_COST", "40")
+	assert.Panics(t, func() { auth.NewPasswordHasher() })
+}
+
+func TestCheckPasswordHash_MalformedArgon2id(t *testing.T) {
+	assert.False(t, auth.CheckPasswordHash(testPasswordConst, "$argon2id$v=19$m=1024,t=1,p=1$not base64!$"))
+	assert.False(t, auth.CheckPasswordHash(testPasswordConst, "$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$a2V5"))
+}
@@ -1,24 +1,66 @@
 package auth
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
 	"time"
 
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/config"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	// defaultAccessTokenTTL is the access token lifetime when sessions are checked on every request
+	defaultAccessTokenTTL = time.Hour
+	// defaultStatelessAccessTokenTTL is the access token lifetime in stateless mode, which bounds
+	// how long a refreshed-away token stays usable
+	defaultStatelessAccessTokenTTL = 5 * time.Minute
+	// refreshTokenTTL is the refresh token lifetime
+	refreshTokenTTL = 30 * 24 * time.Hour
+	// SessionRevokeTokenTTL is how long the revoke link of a new sign-in alert works
+	SessionRevokeTokenTTL = 7 * 24 * time.Hour
+)
+
+// The types of the tokens that confirm an email change, one emailed to each address
+const (
+	// EmailChangeOldToken is emailed to the user's current address
+	EmailChangeOldToken = "email_change_old"
+	// EmailChangeNewToken is emailed to the requested address
+	EmailChangeNewToken = "email_change_new"
+)
+
 // JWTClaims represents the claims structure for JWT tokens
 type JWTClaims struct {
 	jwt.RegisteredClaims
-	Type   string `json:"type"`
-	UserID uint   `json:"user_id"`
+	// Session is only embedded in access tokens issued in stateless mode
+	Session *SessionClaims `json:"session,omitempty"`
+	Type    string         `json:"type"`
+	UserID  uint           `json:"user_id"`
+}
+
+// SessionClaims describe the session and user an access token was issued for, so that the token
+// can be validated without loading the session
+type SessionClaims struct {
+	ID            string `json:"sid"`
+	UserPublicID  string `json:"uid"`
+	Role          string `json:"role"`
+	AuthTime      int64  `json:"auth_time"`
+	EmailVerified bool   `json:"email_verified"`
 }
 
 // JWTService handles JWT token operations
 type JWTService struct {
-	secretKey []byte
+	// clock issues tokens and checks their expiry
+	clock          clock.Clock
+	secretKey      []byte
+	accessTokenTTL time.Duration
+	stateless      bool
 }
 
 // TokenPair represents access and refresh tokens
@@ -29,28 +71,58 @@ type TokenPair struct {
 	RefreshToken          string    `json:"refresh_token"`
 }
 
-// NewJWTService creates a new JWT service
-func NewJWTService() *JWTService {
+// HashToken returns the hex-encoded SHA-256 hash under which sessions store their tokens, so that
+// the sessions table holds no usable bearer tokens. Tokens are signed and long, so a fast hash is
+// enough and allows lookup by hash.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewJWTService creates a new JWT service that tells the time by clk
+func NewJWTService(clk clock.Clock) *JWTService {
 	secretKey := os.Getenv("JWT_SECRET_KEY")
 	if secretKey == "" {
 		secretKey = "your-secret-key-change-this-in-production" // Default for development
 	}
 
-	return &JWTService{
-		secretKey: []byte(secretKey),
+	service := &JWTService{
+		clock:          clk,
+		secretKey:      []byte(secretKey),
+		accessTokenTTL: defaultAccessTokenTTL,
+		stateless:      config.GetEnvBool("STATELESS_ACCESS_TOKENS", false),
+	}
+	if service.stateless {
+		ttl := config.GetEnvInt("STATELESS_ACCESS_TOKEN_TTL_SECONDS", int(defaultStatelessAccessTokenTTL.Seconds()))
+		service.accessTokenTTL = time.Duration(max(ttl, 1)) * time.Second
 	}
+	return service
+}
+
+// Stateless reports whether access tokens carry session claims and are validated without
+// loading the session (STATELESS_ACCESS_TOKENS)
+func (j *JWTService) Stateless() bool {
+	return j.stateless
+}
+
+// AccessTokenTTL returns the lifetime of access tokens
+func (j *JWTService) AccessTokenTTL() time.Duration {
+	return j.accessTokenTTL
 }
 
 // GenerateTokenPair generates both access and refresh tokens
 func (j *JWTService) GenerateTokenPair(userID uint) (*TokenPair, error) {
-	// Generate access token (1 hour)
-	accessToken, accessExpiresAt, err := j.generateToken(userID, "access", time.Hour)
+	return j.GenerateSessionTokenPair(userID, nil)
+}
+
+// GenerateSessionTokenPair generates both tokens, embedding session in the access token when it is not nil
+func (j *JWTService) GenerateSessionTokenPair(userID uint, session *SessionClaims) (*TokenPair, error) {
+	accessToken, accessExpiresAt, err := j.generateToken(userID, "access", "", j.accessTokenTTL, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
-	// Generate refresh token (30 days)
-	refreshToken, refreshExpiresAt, err := j.generateToken(userID, "refresh", 30*24*time.Hour)
+	refreshToken, refreshExpiresAt, err := j.generateToken(userID, "refresh", "", refreshTokenTTL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -63,20 +135,76 @@ func (j *JWTService) GenerateTokenPair(userID uint) (*TokenPair, error) {
 	}, nil
 }
 
-// generateToken generates a JWT token with specified type and duration
-func (j *JWTService) generateToken(userID uint, tokenType string, duration time.Duration) (string, time.Time, error) {
-	now := time.Now()
+// GenerateSessionRevokeToken generates a token that revokes one of the user's sessions without
+// signing in, for the link in new sign-in alerts. The session's public ID is the token ID.
+func (j *JWTService) GenerateSessionRevokeToken(userID uint, sessionPublicID string) (string, error) {
+	token, _, err := j.generateToken(userID, "session_revoke", sessionPublicID, SessionRevokeTokenTTL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate session revoke token: %w", err)
+	}
+	return token, nil
+}
+
+// GenerateInviteToken generates the signup token emailed with an invitation. The invitation's
+// public ID is the token ID; the token carries no user.
+func (j *JWTService) GenerateInviteToken(invitationPublicID string, ttl time.Duration) (string, time.Time, error) {
+	token, expiresAt, err := j.generateToken(0, "invite", invitationPublicID, ttl, nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate invite token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// GenerateDataExportToken generates the token of a temporary link that downloads a data export
+// of the user. The export's public ID is the token ID.
+func (j *JWTService) GenerateDataExportToken(
+	userID uint, exportPublicID string, ttl time.Duration,
+) (string, time.Time, error) {
+	token, expiresAt, err := j.generateToken(userID, "data_export", exportPublicID, ttl, nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate data export token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// GenerateEmailChangeToken generates the token of the link that confirms the user's email change
+// from one of the addresses, given by tokenType: EmailChangeOldToken or EmailChangeNewToken. The
+// nonce of the requested change is the token ID.
+func (j *JWTService) GenerateEmailChangeToken(
+	userID uint, tokenType, nonce string, ttl time.Duration,
+) (string, time.Time, error) {
+	if tokenType != EmailChangeOldToken && tokenType != EmailChangeNewToken {
+		return "", time.Time{}, fmt.Errorf("invalid email change token type %q", tokenType)
+	}
+	token, expiresAt, err := j.generateToken(userID, tokenType, nonce, ttl, nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate email change token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// generateToken generates a JWT token with specified type, ID and duration
+func (j *JWTService) generateToken(
+	userID uint,
+	tokenType string,
+	tokenID string,
+	duration time.Duration,
+	session *SessionClaims,
+) (string, time.Time, error) {
+	now := j.clock.Now()
 	expiresAt := now.Add(duration)
 
 	claims := JWTClaims{
-		UserID: userID,
-		Type:   tokenType,
+		UserID:  userID,
+		Type:    tokenType,
+		Session: session,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "strikepad-backend",
 			Subject:   strconv.FormatUint(uint64(userID), 10),
+			ID:        tokenID,
 		},
 	}
 
@@ -96,9 +224,12 @@ func (j *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return j.secretKey, nil
-	})
+	}, jwt.WithTimeFunc(j.clock.Now))
 
 	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
 		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
 
@@ -141,3 +272,61 @@ func (j *JWTService) ValidateRefreshToken(tokenString string) (*JWTClaims, error
 
 	return claims, nil
 }
+
+// ValidateSessionRevokeToken validates a token from GenerateSessionRevokeToken. The session to
+// revoke is the claims' ID.
+func (j *JWTService) ValidateSessionRevokeToken(tokenString string) (*JWTClaims, error) {
+	claims, err := j.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Type != "session_revoke" || claims.ID == "" {
+		return nil, fmt.Errorf("token is not a session revoke token")
+	}
+
+	return claims, nil
+}
+
+// ValidateInviteToken validates a token from GenerateInviteToken. The invitation is the claims' ID.
+func (j *JWTService) ValidateInviteToken(tokenString string) (*JWTClaims, error) {
+	claims, err := j.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Type != "invite" || claims.ID == "" {
+		return nil, fmt.Errorf("token is not an invite token")
+	}
+
+	return claims, nil
+}
+
+// ValidateDataExportToken validates a token from GenerateDataExportToken. The export is the claims' ID.
+func (j *JWTService) ValidateDataExportToken(tokenString string) (*JWTClaims, error) {
+	claims, err := j.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Type != "data_export" || claims.ID == "" {
+		return nil, fmt.Errorf("token is not a data export token")
+	}
+
+	return claims, nil
+}
+
+// ValidateEmailChangeToken validates a token from GenerateEmailChangeToken. The claims' type tells
+// which address confirmed the change; the nonce of the change is the claims' ID.
+func (j *JWTService) ValidateEmailChangeToken(tokenString string) (*JWTClaims, error) {
+	claims, err := j.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if (claims.Type != EmailChangeOldToken && claims.Type != EmailChangeNewToken) || claims.ID == "" {
+		return nil, fmt.Errorf("token is not an email change token")
+	}
+
+	return claims, nil
+}
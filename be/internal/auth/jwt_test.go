@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -19,7 +22,7 @@ type JWTServiceTestSuite struct {
 func (suite *JWTServiceTestSuite) SetupTest() {
 	// Set a test secret key for consistency
 	os.Setenv("JWT_SECRET_KEY", "test-secret-key-for-testing")
-	suite.jwtService = auth.NewJWTService()
+	suite.jwtService = auth.NewJWTService(clock.New())
 }
 
 func (suite *JWTServiceTestSuite) TearDownTest() {
@@ -55,7 +58,7 @@ func (suite *JWTServiceTestSuite) TestNewJWTService() {
 			}
 
 			// Create service
-			service := auth.NewJWTService()
+			service := auth.NewJWTService(clock.New())
 
 			// Assert
 			assert.NotNil(t, service)
@@ -289,54 +292,170 @@ func (suite *JWTServiceTestSuite) TestValidateRefreshToken() {
 	}
 }
 
+func (suite *JWTServiceTestSuite) TestValidateSessionRevokeToken() {
+	revokeToken, err := suite.jwtService.GenerateSessionRevokeToken(42, "ses_7d2k9m4q0x8h3v1c5b6n")
+	assert.NoError(suite.T(), err)
+	tokenPair, err := suite.jwtService.GenerateTokenPair(42)
+	assert.NoError(suite.T(), err)
+
+	claims, err := suite.jwtService.ValidateSessionRevokeToken(revokeToken)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(42), claims.UserID)
+	assert.Equal(suite.T(), "ses_7d2k9m4q0x8h3v1c5b6n", claims.ID)
+	assert.WithinDuration(suite.T(), time.Now().Add(auth.SessionRevokeTokenTTL), claims.ExpiresAt.Time, time.Minute)
+
+	_, err = suite.jwtService.ValidateSessionRevokeToken(tokenPair.AccessToken)
+	assert.Error(suite.T(), err)
+	_, err = suite.jwtService.ValidateAccessToken(revokeToken)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *JWTServiceTestSuite) TestValidateInviteToken() {
+	inviteToken, expiresAt, err := suite.jwtService.GenerateInviteToken("inv_3m8q1x5k9d2v7h0c4b6n", 72*time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.WithinDuration(suite.T(), time.Now().Add(72*time.Hour), expiresAt, time.Minute)
+	revokeToken, err := suite.jwtService.GenerateSessionRevokeToken(42, "ses_7d2k9m4q0x8h3v1c5b6n")
+	assert.NoError(suite.T(), err)
+
+	claims, err := suite.jwtService.ValidateInviteToken(inviteToken)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "inv_3m8q1x5k9d2v7h0c4b6n", claims.ID)
+
+	_, err = suite.jwtService.ValidateInviteToken(revokeToken)
+	assert.Error(suite.T(), err)
+	_, err = suite.jwtService.ValidateSessionRevokeToken(inviteToken)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *JWTServiceTestSuite) TestValidateDataExportToken() {
+	exportToken, expiresAt, err := suite.jwtService.GenerateDataExportToken(42, "exp_9c4m1x7k2d5v8h0q3b6n", 15*time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.WithinDuration(suite.T(), time.Now().Add(15*time.Minute), expiresAt, time.Minute)
+	tokenPair, err := suite.jwtService.GenerateTokenPair(42)
+	assert.NoError(suite.T(), err)
+
+	claims, err := suite.jwtService.ValidateDataExportToken(exportToken)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(42), claims.UserID)
+	assert.Equal(suite.T(), "exp_9c4m1x7k2d5v8h0q3b6n", claims.ID)
+
+	_, err = suite.jwtService.ValidateDataExportToken(tokenPair.AccessToken)
+	assert.Error(suite.T(), err)
+	_, err = suite.jwtService.ValidateAccessToken(exportToken)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *JWTServiceTestSuite) TestValidateEmailChangeToken() {
+	oldToken, expiresAt, err := suite.jwtService.GenerateEmailChangeToken(42, auth.EmailChangeOldToken, "3q2-7wBxR8eKc1vZpL0yNg", 24*time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.WithinDuration(suite.T(), time.Now().Add(24*time.Hour), expiresAt, time.Minute)
+	newToken, _, err := suite.jwtService.GenerateEmailChangeToken(42, auth.EmailChangeNewToken, "3q2-7wBxR8eKc1vZpL0yNg", 24*time.Hour)
+	assert.NoError(suite.T(), err)
+	_, _, err = suite.jwtService.GenerateEmailChangeToken(42, "invite", "3q2-7wBxR8eKc1vZpL0yNg", 24*time.Hour)
+	assert.Error(suite.T(), err)
+	tokenPair, err := suite.jwtService.GenerateTokenPair(42)
+	assert.NoError(suite.T(), err)
+
+	claims, err := suite.jwtService.ValidateEmailChangeToken(oldToken)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(42), claims.UserID)
+	assert.Equal(suite.T(), auth.EmailChangeOldToken, claims.Type)
+	assert.Equal(suite.T(), "3q2-7wBxR8eKc1vZpL0yNg", claims.ID)
+	claims, err = suite.jwtService.ValidateEmailChangeToken(newToken)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), auth.EmailChangeNewToken, claims.Type)
+
+	_, err = suite.jwtService.ValidateEmailChangeToken(tokenPair.AccessToken)
+	assert.Error(suite.T(), err)
+	_, err = suite.jwtService.ValidateAccessToken(newToken)
+	assert.Error(suite.T(), err)
+}
+
 func (suite *JWTServiceTestSuite) TestTokenExpiration() {
-	// Test with a very short duration to test expiration
+	issuedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 	testCases := []struct {
-		name         string
-		userID       uint
-		waitDuration time.Duration
-		expectValid  bool
+		name           string
+		elapsed        time.Duration
+		accessExpired  bool
+		refreshExpired bool
 	}{
+		{name: "Just issued", elapsed: 0},
+		{name: "Just before the access token expires", elapsed: time.Hour - time.Second},
+		{name: "When the access token expires", elapsed: time.Hour, accessExpired: true},
 		{
-			name:         "Token not yet expired",
-			userID:       1,
-			waitDuration: 0,
-			expectValid:  true,
+			name: "Just before the refresh token expires", elapsed: 30*24*time.Hour - time.Second,
+			accessExpired: true,
+		},
+		{
+			name: "When the refresh token expires", elapsed: 30 * 24 * time.Hour,
+			accessExpired: true, refreshExpired: true,
 		},
-		// Note: We can't easily test actual expiration without waiting or mocking time
-		// In a real test environment, you might use libraries like testify/mock to mock time
 	}
 
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
-			// Generate token
-			tokenPair, err := suite.jwtService.GenerateTokenPair(tc.userID)
-			assert.NoError(t, err)
-
-			// Wait if specified
-			if tc.waitDuration > 0 {
-				time.Sleep(tc.waitDuration)
-			}
-
-			// Validate token
-			claims, err := suite.jwtService.ValidateAccessToken(tokenPair.AccessToken)
-
-			if tc.expectValid {
+			clk := clock.NewFake(issuedAt)
+			jwtService := auth.NewJWTService(clk)
+			tokenPair, err := jwtService.GenerateTokenPair(1)
+			require.NoError(t, err)
+			assert.Equal(t, issuedAt.Add(time.Hour), tokenPair.AccessTokenExpiresAt)
+			assert.Equal(t, issuedAt.Add(30*24*time.Hour), tokenPair.RefreshTokenExpiresAt)
+
+			clk.Advance(tc.elapsed)
+
+			_, err = jwtService.ValidateAccessToken(tokenPair.AccessToken)
+			if tc.accessExpired {
+				assert.ErrorIs(t, err, auth.ErrTokenExpired)
+			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, claims)
-				assert.Equal(t, tc.userID, claims.UserID)
+			}
+			_, err = jwtService.ValidateRefreshToken(tokenPair.RefreshToken)
+			if tc.refreshExpired {
+				assert.ErrorIs(t, err, auth.ErrTokenExpired)
 			} else {
-				assert.Error(t, err)
-				assert.Nil(t, claims)
+				assert.NoError(t, err)
 			}
 		})
 	}
 }
 
+func (suite *JWTServiceTestSuite) TestTokenNotYetValid() {
+	clk := clock.NewFake(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	jwtService := auth.NewJWTService(clk)
+	tokenPair, err := jwtService.GenerateTokenPair(1)
+	require.NoError(suite.T(), err)
+
+	// A token from a clock that is ahead is rejected until its issue time
+	clk.Advance(-time.Minute)
+	_, err = jwtService.ValidateAccessToken(tokenPair.AccessToken)
+	assert.ErrorIs(suite.T(), err, jwt.ErrTokenNotValidYet)
+}
+
+func (suite *JWTServiceTestSuite) TestValidateExpiredToken() {
+	claims := auth.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		},
+		Type:   "access",
+		UserID: 123,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret-key-for-testing"))
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.jwtService.ValidateAccessToken(token)
+	assert.ErrorIs(suite.T(), err, auth.ErrTokenExpired)
+
+	// Tokens that fail for other reasons are not reported as expired
+	_, err = suite.jwtService.ValidateAccessToken("invalid.token.format")
+	assert.Error(suite.T(), err)
+	assert.NotErrorIs(suite.T(), err, auth.ErrTokenExpired)
+}
+
 func (suite *JWTServiceTestSuite) TestTokenWithDifferentSigningKey() {
 	// Create another JWT service with different secret
 	os.Setenv("JWT_SECRET_KEY", "different-secret-key")
-	differentJWTService := auth.NewJWTService()
+	differentJWTService := auth.NewJWTService(clock.New())
 
 	// Generate token with original service
 	userID := uint(123)
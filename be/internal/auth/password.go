@@ -1,6 +1,10 @@
 package auth
 
 import (
+	"strings"
+
+	"strikepad-backend/internal/errors"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -9,25 +13,43 @@ const (
 	DefaultCost = bcrypt.DefaultCost
 )
 
-// HashPassword generates a bcrypt hash of the password
+// HashPassword generates a bcrypt hash of the password with the default cost. Services hash with
+// a PasswordHasher, which follows the configured algorithm.
 func HashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), DefaultCost)
 	return string(bytes), err
 }
 
-// CheckPasswordHash compares a password with its hash
+// CheckPasswordHash compares a password with its bcrypt or argon2id hash
 func CheckPasswordHash(password, hash string) bool {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		return checkArgon2id(password, hash)
+	}
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
 
-// ValidatePassword validates password requirements
-func ValidatePassword(password string) error {
+// ValidatePassword validates password requirements. When breach checkers are given, passwords
+// they report as breached are rejected too.
+func ValidatePassword(password string, breachCheckers ...BreachChecker) error {
 	if len(password) < 8 {
 		return ErrPasswordTooShort
 	}
 	if len(password) > 128 {
 		return ErrPasswordTooLong
 	}
+
+	for _, checker := range breachCheckers {
+		if checker == nil {
+			continue
+		}
+		breached, err := checker.IsBreached(password)
+		if err != nil {
+			return errors.Wrap(errors.ErrCodeInternalError, err, "failed to check password against breaches")
+		}
+		if breached {
+			return ErrPasswordBreached
+		}
+	}
 	return nil
 }
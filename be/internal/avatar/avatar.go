@@ -0,0 +1,116 @@
+// Package avatar validates uploaded avatar images and turns them into square PNGs of a fixed size
+package avatar
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	// Size is the width and height in pixels of processed avatars
+	Size = 256
+	// MaxDimension is the widest or tallest image accepted, so that decoding a small file cannot
+	// allocate a huge image
+	MaxDimension = 4096
+	// ContentType is the content type of processed avatars
+	ContentType = "image/png"
+)
+
+var (
+	// ErrUnsupportedImage is returned for uploads that are not JPEG, PNG or GIF images
+	ErrUnsupportedImage = errors.New("avatar must be a JPEG, PNG or GIF image")
+	// ErrImageTooLarge is returned for images wider or taller than MaxDimension
+	ErrImageTooLarge = fmt.Errorf("avatar must be at most %dx%d pixels", MaxDimension, MaxDimension)
+)
+
+var decoders = map[string]func([]byte) (image.Image, error){
+	"image/jpeg": func(b []byte) (image.Image, error) { return jpeg.Decode(bytes.NewReader(b)) },
+	"image/png":  func(b []byte) (image.Image, error) { return png.Decode(bytes.NewReader(b)) },
+	"image/gif":  func(b []byte) (image.Image, error) { return gif.Decode(bytes.NewReader(b)) },
+}
+
+// Process checks that data is a JPEG, PNG or GIF image by its content rather than its declared
+// type, crops it to a centered square and scales it to Size. The result is re-encoded as PNG,
+// which drops any metadata such as EXIF location.
+func Process(data []byte) ([]byte, error) {
+	decode, ok := decoders[http.DetectContentType(data)]
+	if !ok {
+		return nil, ErrUnsupportedImage
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	if cfg.Width < 1 || cfg.Height < 1 {
+		return nil, ErrUnsupportedImage
+	}
+	if cfg.Width > MaxDimension || cfg.Height > MaxDimension {
+		return nil, ErrImageTooLarge
+	}
+
+	img, err := decode(data)
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, resize(cropSquare(img), Size)); err != nil {
+		return nil, fmt.Errorf("failed to encode avatar: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// cropSquare returns the largest square in the center of img
+func cropSquare(img image.Image) image.Image {
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	x := bounds.Min.X + (bounds.Dx()-side)/2
+	y := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	square := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(square, square.Bounds(), img, image.Point{X: x, Y: y}, draw.Src)
+	return square
+}
+
+// resize scales the square img to size x size. Each output pixel is the average of the source
+// pixels it covers, which keeps downscaled photos smooth; upscaling repeats pixels.
+func resize(img image.Image, size int) *image.RGBA {
+	src := image.NewRGBA(img.Bounds())
+	draw.Draw(src, src.Bounds(), img, img.Bounds().Min, draw.Src)
+	side := src.Bounds().Dx()
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		y0, y1 := y*side/size, max((y+1)*side/size, y*side/size+1)
+		for x := 0; x < size; x++ {
+			x0, x1 := x*side/size, max((x+1)*side/size, x*side/size+1)
+
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint32(p[0])
+					g += uint32(p[1])
+					b += uint32(p[2])
+					a += uint32(p[3])
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
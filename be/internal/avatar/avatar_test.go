@@ -0,0 +1,75 @@
+package avatar
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// The left quarter is red so cropping can be checked
+			if x < width/4 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+	return img
+}
+
+func TestProcess(t *testing.T) {
+	var pngData, jpegData, gifData bytes.Buffer
+	require.NoError(t, png.Encode(&pngData, testImage(800, 400)))
+	require.NoError(t, jpeg.Encode(&jpegData, testImage(100, 300), nil))
+	require.NoError(t, gif.Encode(&gifData, testImage(40, 40), nil))
+
+	for name, data := range map[string][]byte{"png": pngData.Bytes(), "jpeg": jpegData.Bytes(), "gif": gifData.Bytes()} {
+		t.Run(name, func(t *testing.T) {
+			processed, err := Process(data)
+			require.NoError(t, err)
+
+			img, format, err := image.Decode(bytes.NewReader(processed))
+			require.NoError(t, err)
+			assert.Equal(t, "png", format)
+			assert.Equal(t, image.Rect(0, 0, Size, Size), img.Bounds())
+		})
+	}
+
+	t.Run("crops to the center", func(t *testing.T) {
+		processed, err := Process(pngData.Bytes())
+		require.NoError(t, err)
+		img, err := png.Decode(bytes.NewReader(processed))
+		require.NoError(t, err)
+
+		// The red quarter of the 800x400 image lies outside the centered 400x400 square
+		r, _, b, _ := img.At(0, Size/2).RGBA()
+		assert.Zero(t, r)
+		assert.NotZero(t, b)
+	})
+}
+
+func TestProcessRejects(t *testing.T) {
+	var large bytes.Buffer
+	require.NoError(t, png.Encode(&large, image.NewGray(image.Rect(0, 0, MaxDimension+1, 1))))
+	_, err := Process(large.Bytes())
+	assert.ErrorIs(t, err, ErrImageTooLarge)
+
+	_, err = Process([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+
+	var truncated bytes.Buffer
+	require.NoError(t, png.Encode(&truncated, testImage(10, 10)))
+	_, err = Process(truncated.Bytes()[:truncated.Len()/2])
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+}
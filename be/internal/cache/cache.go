@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/config"
+)
+
+// ErrMiss is returned by Get when the key is not cached
+var ErrMiss = errors.New("cache miss")
+
+// Cache is a shared key-value store with per-key expiry
+type Cache interface {
+	// Get returns the value stored under key, or ErrMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set stores value under key for ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the keys; missing keys are ignored
+	Delete(ctx context.Context, keys ...string) error
+	// Increment adds one to the counter under key and returns the new count. A new counter
+	// expires after ttl; incrementing does not extend it.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+}
+
+// New returns a Redis cache when REDIS_URL is set, and nil otherwise so callers can skip caching.
+// It panics if REDIS_URL cannot be parsed.
+func New() Cache {
+	rawURL := config.GetEnv("REDIS_URL", "")
+	if rawURL == "" {
+		return nil
+	}
+
+	redisCache, err := NewRedisCache(rawURL)
+	if err != nil {
+		panic("Invalid REDIS_URL: " + err.Error())
+	}
+	slog.Info("Redis cache enabled", "addr", redisCache.addr)
+	return redisCache
+}
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MemoryCache is an in-process Cache. Entries are not shared between instances, so it only
+// suits single-instance deployments and tests.
+type MemoryCache struct {
+	entries map[string]memoryEntry
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+type memoryEntry struct {
+	expiresAt time.Time
+	value     []byte
+}
+
+// NewMemoryCache creates an empty in-process cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]memoryEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value stored under key, or ErrMiss if it is missing or expired
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, ErrMiss
+	}
+	return entry.value, nil
+}
+
+// Set stores a copy of value under key for ttl
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = memoryEntry{
+		expiresAt: c.now().Add(ttl),
+		value:     append([]byte(nil), value...),
+	}
+	return nil
+}
+
+// Increment adds one to the counter under key, which is stored as a decimal number like Redis does
+func (c *MemoryCache) Increment(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		entry = memoryEntry{expiresAt: c.now().Add(ttl)}
+	}
+	count := int64(0)
+	if len(entry.value) > 0 {
+		var err error
+		if count, err = strconv.ParseInt(string(entry.value), 10, 64); err != nil {
+			return 0, fmt.Errorf("value of %s is not a counter: %w", key, err)
+		}
+	}
+	count++
+	entry.value = []byte(strconv.FormatInt(count, 10))
+	c.entries[key] = entry
+	return count, nil
+}
+
+// Delete removes the keys
+func (c *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	return nil
+}
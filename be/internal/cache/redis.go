@@ -0,0 +1,282 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// incrementScript increments KEYS[1] and sets its expiry of ARGV[1] milliseconds when it is new
+const incrementScript = `local count = redis.call('INCR', KEYS[1])
+if count == 1 then redis.call('PEXPIRE', KEYS[1], ARGV[1]) end
+return count`
+
+const (
+	defaultRedisPort    = "6379"
+	redisTimeout        = 500 * time.Millisecond
+	redisMaxIdleConns   = 16
+	redisMaxReplyLength = 16 << 20
+)
+
+// RedisError is an error reply from the Redis server. The connection stays usable after one.
+type RedisError string
+
+func (e RedisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// RedisCache is a Cache backed by a Redis server. It speaks the RESP protocol directly and keeps
+// a small pool of idle connections.
+type RedisCache struct {
+	tlsConfig *tls.Config
+	idle      chan *redisConn
+	addr      string
+	username  string
+	password  string
+	db        int
+}
+
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewRedisCache creates a cache for a redis:// or rediss:// (TLS) URL of the form
+// redis://[[user]:password@]host[:port][/db]. No connection is made until the cache is used.
+func NewRedisCache(rawURL string) (*RedisCache, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "redis" && u.Scheme != "rediss" {
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, errors.New("missing host")
+	}
+
+	c := &RedisCache{
+		idle: make(chan *redisConn, redisMaxIdleConns),
+		addr: u.Host,
+	}
+	if u.Port() == "" {
+		c.addr = net.JoinHostPort(u.Hostname(), defaultRedisPort)
+	}
+	if u.Scheme == "rediss" {
+		c.tlsConfig = &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12}
+	}
+	if u.User != nil {
+		c.username = u.User.Username()
+		c.password, _ = u.User.Password()
+	}
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		if c.db, err = strconv.Atoi(db); err != nil || c.db < 0 {
+			return nil, fmt.Errorf("invalid database %q", db)
+		}
+	}
+	return c, nil
+}
+
+// Get returns the value stored under key, or ErrMiss
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	reply, err := c.do(ctx, "GET", key)
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, ErrMiss
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("redis: unexpected GET reply %T", reply)
+	}
+	return value, nil
+}
+
+// Set stores value under key for ttl, rounded up to the millisecond
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ms := max(ttl.Milliseconds(), 1)
+	_, err := c.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(ms, 10))
+	return err
+}
+
+// Delete removes the keys
+func (c *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := c.do(ctx, append([]string{"DEL"}, keys...)...)
+	return err
+}
+
+// Increment adds one to the counter under key and, when it is new, makes it expire after ttl
+// rounded up to the millisecond. Both happen in one script, so a counter never outlives its ttl.
+func (c *RedisCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	ms := max(ttl.Milliseconds(), 1)
+	reply, err := c.do(ctx, "EVAL", incrementScript, "1", key, strconv.FormatInt(ms, 10))
+	if err != nil {
+		return 0, err
+	}
+	count, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("redis: unexpected EVAL reply %T", reply)
+	}
+	return count, nil
+}
+
+// Close closes the idle connections of the pool. The cache stays usable: connections in use are
+// returned to the pool as usual, and new ones are dialed when needed.
+func (c *RedisCache) Close() error {
+	for {
+		select {
+		case rc := <-c.idle:
+			_ = rc.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// do sends one command and reads its reply. Connections that fail at the network level are
+// closed rather than returned to the pool.
+func (c *RedisCache) do(ctx context.Context, args ...string) (interface{}, error) {
+	rc, err := c.conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("redis: failed to connect: %w", err)
+	}
+
+	reply, err := rc.roundTrip(ctx, args)
+	var redisErr RedisError
+	if err != nil && !errors.As(err, &redisErr) {
+		_ = rc.conn.Close()
+		return nil, fmt.Errorf("redis: %s failed: %w", args[0], err)
+	}
+
+	select {
+	case c.idle <- rc:
+	default:
+		_ = rc.conn.Close()
+	}
+	return reply, err
+}
+
+// conn takes an idle connection from the pool or dials, authenticates and selects the database
+func (c *RedisCache) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case rc := <-c.idle:
+		return rc, nil
+	default:
+	}
+
+	dialer := &net.Dialer{Timeout: redisTimeout}
+	var conn net.Conn
+	var err error
+	if c.tlsConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: c.tlsConfig}).DialContext(ctx, "tcp", c.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", c.addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &redisConn{conn: conn, reader: bufio.NewReader(conn)}
+	if c.password != "" {
+		args := []string{"AUTH", c.password}
+		if c.username != "" {
+			args = []string{"AUTH", c.username, c.password}
+		}
+		if _, err := rc.roundTrip(ctx, args); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	if c.db != 0 {
+		if _, err := rc.roundTrip(ctx, []string{"SELECT", strconv.Itoa(c.db)}); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return rc, nil
+}
+
+// roundTrip writes a command as a RESP array of bulk strings and reads the reply
+func (rc *redisConn) roundTrip(ctx context.Context, args []string) (interface{}, error) {
+	deadline := time.Now().Add(redisTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := rc.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := io.WriteString(rc.conn, b.String()); err != nil {
+		return nil, err
+	}
+	return readReply(rc.reader)
+}
+
+// readReply parses one RESP2 reply: a simple string, error, integer, bulk string ([]byte, or nil
+// when missing) or array
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || !strings.HasSuffix(line, "\r\n") {
+		return nil, fmt.Errorf("malformed reply %q", line)
+	}
+	kind, payload := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, RedisError(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(payload)
+		if err != nil || n > redisMaxReplyLength {
+			return nil, fmt.Errorf("invalid bulk length %q", payload)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	case '*':
+		n, err := strconv.Atoi(payload)
+		if err != nil || n > redisMaxReplyLength {
+			return nil, fmt.Errorf("invalid array length %q", payload)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			if items[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unknown reply type %q", kind)
+	}
+}
@@ -0,0 +1,92 @@
+package captcha
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/config"
+)
+
+// defaultVerifyURL is Cloudflare Turnstile's siteverify endpoint. hCaptcha and reCAPTCHA expose
+// the same form-encoded API, so they can be used by setting CAPTCHA_VERIFY_URL.
+const defaultVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+// ErrVerificationFailed is returned when a captcha token is missing or rejected by the provider
+var ErrVerificationFailed = errors.New("captcha verification failed")
+
+// Verifier checks a captcha token submitted by a client
+type Verifier interface {
+	Verify(token, remoteIP string) error
+}
+
+// NewVerifier returns a siteverify-based verifier when CAPTCHA_SECRET_KEY is set,
+// and a verifier that accepts every request otherwise
+func NewVerifier() Verifier {
+	secret := config.GetEnv("CAPTCHA_SECRET_KEY", "")
+	if secret == "" {
+		return noopVerifier{}
+	}
+
+	return &SiteVerifyVerifier{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		secret:     secret,
+		verifyURL:  config.GetEnv("CAPTCHA_VERIFY_URL", defaultVerifyURL),
+	}
+}
+
+// noopVerifier is used when captcha protection is disabled
+type noopVerifier struct{}
+
+func (noopVerifier) Verify(_, _ string) error {
+	return nil
+}
+
+// SiteVerifyVerifier verifies tokens against a provider's siteverify endpoint
+type SiteVerifyVerifier struct {
+	httpClient *http.Client
+	secret     string
+	verifyURL  string
+}
+
+// Verify sends the token to the provider and reports whether it was accepted
+func (v *SiteVerifyVerifier) Verify(token, remoteIP string) error {
+	if strings.TrimSpace(token) == "" {
+		return fmt.Errorf("%w: token is empty", ErrVerificationFailed)
+	}
+
+	form := url.Values{
+		"secret":   {v.secret},
+		"response": {token},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	resp, err := v.httpClient.PostForm(v.verifyURL, form)
+	if err != nil {
+		return fmt.Errorf("failed to reach captcha provider: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("captcha provider returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		ErrorCodes []string `json:"error-codes"`
+		Success    bool     `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode captcha response: %w", err)
+	}
+
+	if !result.Success {
+		return fmt.Errorf("%w: %s", ErrVerificationFailed, strings.Join(result.ErrorCodes, ","))
+	}
+	return nil
+}
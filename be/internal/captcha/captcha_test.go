@@ -0,0 +1,57 @@
+package captcha
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVerifier(t *testing.T) {
+	t.Setenv("CAPTCHA_SECRET_KEY", "")
+	assert.IsType(t, noopVerifier{}, NewVerifier())
+	assert.NoError(t, NewVerifier().Verify("", ""))
+
+	t.Setenv("CAPTCHA_SECRET_KEY", "secret")
+	assert.IsType(t, &SiteVerifyVerifier{}, NewVerifier())
+}
+
+func TestSiteVerifyVerifier_Verify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("secret") == "secret" && r.PostForm.Get("response") == "good-token" {
+			_, _ = w.Write([]byte(`{"success":true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	verifier := &SiteVerifyVerifier{
+		httpClient: server.Client(),
+		secret:     "secret",
+		verifyURL:  server.URL,
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		expectError bool
+	}{
+		{name: "accepted token", token: "good-token"},
+		{name: "rejected token", token: "bad-token", expectError: true},
+		{name: "empty token", token: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifier.Verify(tt.token, "203.0.113.1")
+			if tt.expectError {
+				assert.ErrorIs(t, err, ErrVerificationFailed)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
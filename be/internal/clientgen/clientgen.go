@@ -0,0 +1,148 @@
+// Package clientgen generates the API clients from the OpenAPI document: a Go client package for
+// internal services and a TypeScript client for the frontend. Both are checked in and kept up to
+// date by cmd/clientgen.
+package clientgen
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"strikepad-backend/internal/openapi"
+)
+
+// header starts every generated file
+const header = "Code generated by cmd/clientgen from the OpenAPI document. DO NOT EDIT."
+
+// operation is an endpoint of the document, as the clients call it
+type operation struct {
+	// body is the schema of the request body, nil when there is none
+	body *openapi.Schema
+	// response is the schema of the success response body, nil when there is none
+	response *openapi.Schema
+	// name is the operation ID
+	name    string
+	method  string
+	path    string
+	summary string
+	// segments are the segments of path; path parameters are named by {name} segments
+	segments   []string
+	pathParams []string
+	query      []openapi.Parameter
+}
+
+// operations returns the operations of doc sorted by name. Operations that only redirect, such as
+// the start of a browser sign-in flow, cannot be called by an API client and are left out, and so
+// are file uploads, whose multipart bodies the clients do not build, event streams, which are
+// read with EventSource, and file downloads, which are fetched from their links.
+func operations(doc *openapi.Document) []operation {
+	var ops []operation
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			status, response, ok := successResponse(op)
+			if !ok || (status >= http.StatusMultipleChoices && status < http.StatusBadRequest) {
+				continue
+			}
+			if op.RequestBody != nil && op.RequestBody.Content["application/json"].Schema == nil {
+				continue
+			}
+			if openapi.IsEventStream(op) {
+				continue
+			}
+			if response == nil && len(op.Responses[strconv.Itoa(status)].Content) > 0 {
+				continue
+			}
+
+			o := operation{
+				name:     op.OperationID,
+				method:   strings.ToUpper(method),
+				path:     path,
+				summary:  op.Summary,
+				segments: strings.Split(path, "/"),
+				response: response,
+			}
+			if op.RequestBody != nil {
+				o.body = op.RequestBody.Content["application/json"].Schema
+			}
+			for _, parameter := range op.Parameters {
+				switch parameter.In {
+				case "path":
+					o.pathParams = append(o.pathParams, parameter.Name)
+				case "query":
+					o.query = append(o.query, parameter)
+				}
+			}
+			ops = append(ops, o)
+		}
+	}
+
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].name < ops[j].name
+	})
+	return ops
+}
+
+// successResponse returns the lowest non-error status of op and the schema of its body
+func successResponse(op *openapi.Operation) (int, *openapi.Schema, bool) {
+	best := 0
+	for key := range op.Responses {
+		status, err := strconv.Atoi(key)
+		if err != nil || status >= http.StatusBadRequest {
+			continue
+		}
+		if best == 0 || status < best {
+			best = status
+		}
+	}
+	if best == 0 {
+		return 0, nil, false
+	}
+	return best, op.Responses[strconv.Itoa(best)].Content["application/json"].Schema, true
+}
+
+// sortedSchemaNames returns the names of the component schemas of doc in order
+func sortedSchemaNames(doc *openapi.Document) []string {
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// refName returns the name of the component schema referenced by ref
+func refName(ref string) string {
+	return strings.TrimPrefix(ref, "#/components/schemas/")
+}
+
+// isRequired reports whether name is a required property of schema
+func isRequired(schema *openapi.Schema, name string) bool {
+	for _, required := range schema.Required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedProperties returns the property names of schema in order
+func sortedProperties(schema *openapi.Schema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// upperFirst returns s with its first letter in upper case
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
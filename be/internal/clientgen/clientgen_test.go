@@ -0,0 +1,85 @@
+package clientgen_test
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"strikepad-backend/internal/clientgen"
+	"strikepad-backend/internal/openapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Gadget struct {
+	Name  string   `json:"name" validate:"required"`
+	Tags  []string `json:"tags,omitempty"`
+	Count int      `json:"count"`
+}
+
+type GadgetList struct {
+	Gadgets []Gadget `json:"gadgets"`
+}
+
+var gadgetRoutes = []openapi.Route{
+	{
+		Method: http.MethodPut, Path: "/api/gadgets/:id", OperationID: "updateGadget",
+		Summary:   "Update a gadget",
+		Request:   Gadget{},
+		Responses: map[int]any{http.StatusOK: Gadget{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/gadgets", OperationID: "listGadgets",
+		Summary:   "List gadgets",
+		Query:     []openapi.Parameter{{Name: "page", In: "query", Schema: &openapi.Schema{Type: "integer"}}},
+		Responses: map[int]any{http.StatusOK: GadgetList{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/gadgets/authorize", OperationID: "authorizeGadget",
+		Summary:   "Redirect to the gadget provider",
+		Responses: map[int]any{http.StatusFound: nil},
+	},
+}
+
+// Schemas are named after their package and type, so the test types become ClientgenGadget and
+// ClientgenGadgetList
+func TestGo(t *testing.T) {
+	source, err := clientgen.Go(openapi.Build(gadgetRoutes), "gadgets")
+	require.NoError(t, err)
+
+	code := string(source)
+	assert.Contains(t, code, "package gadgets")
+	assert.Contains(t, code, "Tags  []string `json:\"tags,omitempty\"`")
+	assert.Contains(t, code, "Gadgets []ClientgenGadget `json:\"gadgets\"`")
+	assert.Contains(t, code, "func (c *Client) UpdateGadget(ctx context.Context, id string, body ClientgenGadget) (*ClientgenGadget, error)")
+	assert.Contains(t, code, `"/api/gadgets/"+url.PathEscape(id)`)
+	assert.Contains(t, code, "func (c *Client) ListGadgets(ctx context.Context, params *ListGadgetsParams) (*ClientgenGadgetList, error)")
+	assert.Contains(t, code, `values.Set("page", strconv.FormatInt(*p.Page, 10))`)
+	assert.NotContains(t, code, "AuthorizeGadget", "redirects cannot be called by the client")
+}
+
+func TestTypeScript(t *testing.T) {
+	code := string(clientgen.TypeScript(openapi.Build(gadgetRoutes)))
+
+	assert.Contains(t, code, "export interface ClientgenGadget {\n  count: number;\n  name: string;\n  tags?: string[];\n}")
+	assert.Contains(t, code, "updateGadget: (id: string, body: ClientgenGadget) =>\n"+
+		"    request<ClientgenGadget>({method: 'PUT', path: `/api/gadgets/${encodeURIComponent(id)}`, body}),")
+	assert.Contains(t, code, "listGadgets: (query?: ListGadgetsParams) =>")
+	assert.NotContains(t, code, "authorizeGadget")
+}
+
+func TestClients_AreUpToDate(t *testing.T) {
+	doc := openapi.Build(openapi.Routes)
+
+	goSource, err := clientgen.Go(doc, "client")
+	require.NoError(t, err)
+	committedGo, err := os.ReadFile("../../client/client_gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(committedGo), string(goSource), "client/client_gen.go is out of date, run make clients")
+
+	committedTS, err := os.ReadFile("../../../fe/src/api/generated.ts")
+	require.NoError(t, err)
+	assert.Equal(t, string(committedTS), string(clientgen.TypeScript(doc)),
+		"fe/src/api/generated.ts is out of date, run make clients")
+}
@@ -0,0 +1,299 @@
+package clientgen
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"strikepad-backend/internal/openapi"
+)
+
+// goInitialisms are the words written in upper case in Go names
+var goInitialisms = map[string]bool{
+	"api": true, "csrf": true, "id": true, "ids": true, "ip": true, "json": true, "oauth": true,
+	"http": true, "ttl": true, "ua": true, "uri": true, "url": true,
+}
+
+// goField is a struct field of a generated type
+type goField struct {
+	name string
+	expr string
+	tag  string
+	// layout orders the fields the way fieldalignment does, so that no padding or pointer bytes are wasted
+	layout int
+}
+
+// Field layouts, in the order fieldalignment places them on 64-bit platforms
+const (
+	layoutTime = iota
+	layoutInterface
+	layoutPointer
+	layoutString
+	layoutSlice
+	layoutNumber
+	layoutBool
+)
+
+// Go returns the source of the types and operations of the Go client in package pkg. The client
+// core (Client and its do method) is written by hand in the package.
+func Go(doc *openapi.Document, pkg string) ([]byte, error) {
+	ops := operations(doc)
+	var body strings.Builder
+	g := &goWriter{b: &body}
+	for _, name := range sortedSchemaNames(doc) {
+		g.writeType(name, doc.Components.Schemas[name])
+	}
+	for _, op := range ops {
+		if len(op.query) > 0 {
+			g.writeParams(op)
+		}
+	}
+	for _, op := range ops {
+		g.writeMethod(op)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s\n\npackage %s\n\nimport (\n", header, pkg)
+	for _, imp := range g.imports() {
+		fmt.Fprintf(&b, "\t%q\n", imp)
+	}
+	b.WriteString(")\n")
+	b.WriteString(body.String())
+
+	return format.Source([]byte(b.String()))
+}
+
+// goWriter writes Go declarations and records the packages they use
+type goWriter struct {
+	b    *strings.Builder
+	uses map[string]bool
+}
+
+func (g *goWriter) use(pkg string) {
+	if g.uses == nil {
+		g.uses = make(map[string]bool)
+	}
+	g.uses[pkg] = true
+}
+
+func (g *goWriter) imports() []string {
+	imports := []string{"context", "net/http"}
+	for pkg := range g.uses {
+		imports = append(imports, pkg)
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+func (g *goWriter) writeType(name string, schema *openapi.Schema) {
+	fmt.Fprintf(g.b, "\n// %s is the %s schema of the API\n", name, name)
+	if schema.Type != "object" || len(schema.Properties) == 0 {
+		expr, _ := g.typeExpr(schema, true)
+		fmt.Fprintf(g.b, "type %s %s\n", name, expr)
+		return
+	}
+
+	fields := make([]goField, 0, len(schema.Properties))
+	for _, property := range sortedProperties(schema) {
+		required := isRequired(schema, property)
+		expr, layout := g.typeExpr(schema.Properties[property], required)
+		tag := property
+		if !required {
+			tag += ",omitempty"
+		}
+		fields = append(fields, goField{
+			name:   goName(property),
+			expr:   expr,
+			tag:    fmt.Sprintf("`json:%q`", tag),
+			layout: layout,
+		})
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].layout < fields[j].layout
+	})
+
+	fmt.Fprintf(g.b, "type %s struct {\n", name)
+	for _, field := range fields {
+		fmt.Fprintf(g.b, "\t%s %s %s\n", field.name, field.expr, field.tag)
+	}
+	g.b.WriteString("}\n")
+}
+
+// typeExpr returns the Go type of schema and its field layout. Values that may be missing or null
+// are pointers, except for slices and maps.
+func (g *goWriter) typeExpr(schema *openapi.Schema, required bool) (string, int) {
+	if schema.Ref != "" {
+		return "*" + refName(schema.Ref), layoutPointer
+	}
+
+	var expr string
+	var layout int
+	switch schema.Type {
+	case "string":
+		expr, layout = "string", layoutString
+		if schema.Format == "date-time" {
+			g.use("time")
+			expr, layout = "time.Time", layoutTime
+		}
+	case "integer":
+		expr, layout = "int64", layoutNumber
+		if schema.Format == "int32" {
+			expr = "int32"
+		}
+	case "number":
+		expr, layout = "float64", layoutNumber
+	case "boolean":
+		expr, layout = "bool", layoutBool
+	case "array":
+		return "[]" + g.elementExpr(schema.Items), layoutSlice
+	case "object":
+		if schema.AdditionalProperties == nil {
+			return "map[string]any", layoutPointer
+		}
+		return "map[string]" + g.elementExpr(schema.AdditionalProperties), layoutPointer
+	default:
+		return "any", layoutInterface
+	}
+
+	if !required || schema.Nullable {
+		return "*" + expr, layoutPointer
+	}
+	return expr, layout
+}
+
+// elementExpr returns the Go type of the elements of slices and maps, which hold structs by value
+func (g *goWriter) elementExpr(schema *openapi.Schema) string {
+	expr, _ := g.typeExpr(schema, true)
+	return strings.TrimPrefix(expr, "*")
+}
+
+// writeParams writes the struct holding the query parameters of op, and its encoding
+func (g *goWriter) writeParams(op operation) {
+	g.use("net/url")
+	name := paramsName(op)
+	fmt.Fprintf(g.b, "\n// %s are the query parameters of %s\n", name, upperFirst(op.name))
+	fmt.Fprintf(g.b, "type %s struct {\n", name)
+	for _, parameter := range op.query {
+		expr, _ := g.typeExpr(parameter.Schema, false)
+		if parameter.Description != "" {
+			fmt.Fprintf(g.b, "\t// %s\n", parameter.Description)
+		}
+		fmt.Fprintf(g.b, "\t%s %s\n", goName(parameter.Name), expr)
+	}
+	g.b.WriteString("}\n")
+
+	fmt.Fprintf(g.b, "\nfunc (p *%s) values() url.Values {\n\tvalues := url.Values{}\n", name)
+	g.b.WriteString("\tif p == nil {\n\t\treturn values\n\t}\n")
+	for _, parameter := range op.query {
+		field := "p." + goName(parameter.Name)
+		var value string
+		switch parameter.Schema.Type {
+		case "integer":
+			g.use("strconv")
+			value = fmt.Sprintf("strconv.FormatInt(*%s, 10)", field)
+			if parameter.Schema.Format == "int32" {
+				value = fmt.Sprintf("strconv.FormatInt(int64(*%s), 10)", field)
+			}
+		case "boolean":
+			g.use("strconv")
+			value = fmt.Sprintf("strconv.FormatBool(*%s)", field)
+		default:
+			value = "*" + field
+		}
+		fmt.Fprintf(g.b, "\tif %s != nil {\n\t\tvalues.Set(%q, %s)\n\t}\n", field, parameter.Name, value)
+	}
+	g.b.WriteString("\treturn values\n}\n")
+}
+
+// writeMethod writes the Client method calling op
+func (g *goWriter) writeMethod(op operation) {
+	name := upperFirst(op.name)
+	args := []string{"ctx context.Context"}
+	for _, param := range op.pathParams {
+		args = append(args, goParamName(param)+" string")
+	}
+	body := "nil"
+	if op.body != nil {
+		expr, _ := g.typeExpr(op.body, true)
+		args = append(args, "body "+strings.TrimPrefix(expr, "*"))
+		body = "body"
+	}
+	query := "nil"
+	if len(op.query) > 0 {
+		args = append(args, "params *"+paramsName(op))
+		query = "params.values()"
+	}
+
+	fmt.Fprintf(g.b, "\n// %s calls %s %s: %s\n", name, op.method, op.path, op.summary)
+	call := fmt.Sprintf("c.do(ctx, %s, %s, %s, %s", goMethod(op.method), g.pathExpr(op), query, body)
+	if op.response == nil {
+		fmt.Fprintf(g.b, "func (c *Client) %s(%s) error {\n\treturn %s, nil)\n}\n", name, strings.Join(args, ", "), call)
+		return
+	}
+
+	result, _ := g.typeExpr(op.response, true)
+	result = strings.TrimPrefix(result, "*")
+	fmt.Fprintf(g.b, "func (c *Client) %s(%s) (*%s, error) {\n", name, strings.Join(args, ", "), result)
+	fmt.Fprintf(g.b, "\tvar result %s\n\tif err := %s, &result); err != nil {\n\t\treturn nil, err\n\t}\n", result, call)
+	g.b.WriteString("\treturn &result, nil\n}\n")
+}
+
+// pathExpr returns the expression building the path of op from its path parameters
+func (g *goWriter) pathExpr(op operation) string {
+	if len(op.pathParams) == 0 {
+		return fmt.Sprintf("%q", op.path)
+	}
+
+	g.use("net/url")
+	var parts []string
+	literal := ""
+	for i, segment := range op.segments {
+		if i > 0 {
+			literal += "/"
+		}
+		param, ok := strings.CutPrefix(segment, "{")
+		if !ok {
+			literal += segment
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%q", literal), "url.PathEscape("+goParamName(strings.TrimSuffix(param, "}"))+")")
+		literal = ""
+	}
+	if literal != "" {
+		parts = append(parts, fmt.Sprintf("%q", literal))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// goMethod returns the net/http constant of an HTTP method
+func goMethod(method string) string {
+	return "http.Method" + upperFirst(strings.ToLower(method))
+}
+
+// paramsName returns the name of the struct holding the query parameters of op
+func paramsName(op operation) string {
+	return upperFirst(op.name) + "Params"
+}
+
+// goName converts a snake_case name to an exported Go name
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if goInitialisms[word] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		b.WriteString(upperFirst(word))
+	}
+	return b.String()
+}
+
+// goParamName converts a snake_case name to an unexported Go name
+func goParamName(name string) string {
+	exported := goName(name)
+	if goInitialisms[strings.ToLower(exported)] {
+		return strings.ToLower(exported)
+	}
+	return strings.ToLower(exported[:1]) + exported[1:]
+}
@@ -0,0 +1,153 @@
+package clientgen
+
+import (
+	"fmt"
+	"strings"
+
+	"strikepad-backend/internal/openapi"
+)
+
+// typeScriptRuntime declares the request function the TypeScript client is built on, so that
+// the generated file does not depend on an HTTP library
+const typeScriptRuntime = `
+export interface ApiRequest {
+  method: 'GET' | 'POST' | 'PUT' | 'PATCH' | 'DELETE';
+  // path starts at the server root, e.g. /api/auth/login
+  path: string;
+  query?: object;
+  body?: unknown;
+}
+
+// Requester sends a request and resolves to its JSON response body
+export type Requester = <T>(request: ApiRequest) => Promise<T>;
+`
+
+// TypeScript returns the source of the TypeScript client: an interface per schema, and
+// createClient returning a function per operation that sends its request through a Requester.
+func TypeScript(doc *openapi.Document) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s\n\n/* eslint-disable */\n", header)
+
+	for _, name := range sortedSchemaNames(doc) {
+		writeTypeScriptType(&b, name, doc.Components.Schemas[name])
+	}
+
+	ops := operations(doc)
+	for _, op := range ops {
+		if len(op.query) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\nexport interface %s {\n", paramsName(op))
+		for _, parameter := range op.query {
+			if parameter.Description != "" {
+				fmt.Fprintf(&b, "  /** %s */\n", parameter.Description)
+			}
+			fmt.Fprintf(&b, "  %s?: %s;\n", parameter.Name, typeScriptExpr(parameter.Schema))
+		}
+		b.WriteString("}\n")
+	}
+
+	b.WriteString(typeScriptRuntime)
+	b.WriteString("\nexport const createClient = (request: Requester) => ({\n")
+	for _, op := range ops {
+		writeTypeScriptOperation(&b, op)
+	}
+	b.WriteString("});\n\nexport type ApiClient = ReturnType<typeof createClient>;\n")
+
+	return []byte(b.String())
+}
+
+func writeTypeScriptType(b *strings.Builder, name string, schema *openapi.Schema) {
+	if schema.Type != "object" || len(schema.Properties) == 0 {
+		fmt.Fprintf(b, "\nexport type %s = %s;\n", name, typeScriptExpr(schema))
+		return
+	}
+
+	fmt.Fprintf(b, "\nexport interface %s {\n", name)
+	for _, property := range sortedProperties(schema) {
+		optional := "?"
+		if isRequired(schema, property) {
+			optional = ""
+		}
+		fmt.Fprintf(b, "  %s%s: %s;\n", property, optional, typeScriptExpr(schema.Properties[property]))
+	}
+	b.WriteString("}\n")
+}
+
+// typeScriptExpr returns the TypeScript type of schema
+func typeScriptExpr(schema *openapi.Schema) string {
+	var expr string
+	switch {
+	case schema.Ref != "":
+		expr = refName(schema.Ref)
+	case len(schema.Enum) > 0:
+		literals := make([]string, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			literals = append(literals, "'"+value+"'")
+		}
+		expr = strings.Join(literals, " | ")
+	case schema.Type == "string":
+		expr = "string"
+	case schema.Type == "integer" || schema.Type == "number":
+		expr = "number"
+	case schema.Type == "boolean":
+		expr = "boolean"
+	case schema.Type == "array":
+		item := typeScriptExpr(schema.Items)
+		if strings.Contains(item, " ") {
+			item = "(" + item + ")"
+		}
+		expr = item + "[]"
+	case schema.Type == "object" && schema.AdditionalProperties != nil:
+		expr = "Record<string, " + typeScriptExpr(schema.AdditionalProperties) + ">"
+	case schema.Type == "object":
+		expr = "Record<string, unknown>"
+	default:
+		expr = "unknown"
+	}
+
+	if schema.Nullable {
+		expr += " | null"
+	}
+	return expr
+}
+
+func writeTypeScriptOperation(b *strings.Builder, op operation) {
+	var args, fields []string
+	for _, param := range op.pathParams {
+		args = append(args, param+": string")
+	}
+	if op.body != nil {
+		args = append(args, "body: "+typeScriptExpr(op.body))
+		fields = append(fields, "body")
+	}
+	if len(op.query) > 0 {
+		args = append(args, "query?: "+paramsName(op))
+		fields = append(fields, "query")
+	}
+
+	path := "'" + op.path + "'"
+	if len(op.pathParams) > 0 {
+		segments := make([]string, len(op.segments))
+		for i, segment := range op.segments {
+			if param, ok := strings.CutPrefix(segment, "{"); ok {
+				segment = "${encodeURIComponent(" + strings.TrimSuffix(param, "}") + ")}"
+			}
+			segments[i] = segment
+		}
+		path = "`" + strings.Join(segments, "/") + "`"
+	}
+
+	response := "void"
+	if op.response != nil {
+		response = typeScriptExpr(op.response)
+	}
+
+	fmt.Fprintf(b, "  /** %s */\n", op.summary)
+	fmt.Fprintf(b, "  %s: (%s) =>\n", op.name, strings.Join(args, ", "))
+	fmt.Fprintf(b, "    request<%s>({method: '%s', path: %s", response, op.method, path)
+	for _, field := range fields {
+		b.WriteString(", " + field)
+	}
+	b.WriteString("}),\n")
+}
@@ -0,0 +1,31 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/clock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := clock.NewFake(start)
+
+	assert.Equal(t, start, c.Now())
+	assert.Equal(t, start, c.Now(), "a fake clock stands still")
+
+	c.Advance(time.Hour)
+	assert.Equal(t, start.Add(time.Hour), c.Now())
+
+	c.Set(start)
+	assert.Equal(t, start, c.Now())
+}
+
+func TestNew(t *testing.T) {
+	before := time.Now()
+	now := clock.New().Now()
+	assert.False(t, now.Before(before))
+	assert.False(t, now.After(time.Now()))
+}
@@ -1,40 +1,144 @@
 package config
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"log"
-	"os"
+	"strings"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
 )
 
-func NewDatabase() *gorm.DB {
+// ReplicaResolverName names the dbresolver configuration of the read replicas. Queries only go to
+// the replicas when they ask for it with dbresolver.Use(ReplicaResolverName); everything else,
+// and every write, goes to the primary.
+const ReplicaResolverName = "replicas"
+
+// Connection pool defaults, for a replica of the server next to a few others sharing the
+// database's connection limit
+const (
+	defaultMaxOpenConns           = 25
+	defaultMaxIdleConns           = 10
+	defaultConnMaxLifetimeSeconds = 30 * 60
+	defaultConnMaxIdleTimeSeconds = 5 * 60
+)
+
+// Replica is the connection pool of a read replica
+type Replica struct {
+	DB   *sql.DB
+	Name string
+}
+
+// Replicas are the read replicas of the database, configured by DB_REPLICA_DSNS
+type Replicas []Replica
+
+// DatabaseDSN returns the connection string of the database configured by the DB_* variables
+func DatabaseDSN() string {
 	host := GetEnv("DB_HOST", "localhost")
 	port := GetEnv("DB_PORT", "5432")
 	user := GetEnv("DB_USER", "postgres")
 	password := GetEnv("DB_PASSWORD", "password")
 	dbname := GetEnv("DB_NAME", "strikepad")
 
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		host, port, user, password, dbname)
+}
+
+// NewReplicas opens a connection pool to each read replica of DB_REPLICA_DSNS, a comma-separated
+// list of connection strings. The replicas are not pinged, so that one being down does not keep
+// the server from starting; the health checks report it instead.
+func NewReplicas() Replicas {
+	var replicas Replicas
+	for i, dsn := range strings.Split(GetEnv("DB_REPLICA_DSNS", ""), ",") {
+		dsn = strings.TrimSpace(dsn)
+		if dsn == "" {
+			continue
+		}
+		db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{DisableAutomaticPing: true})
+		if err != nil {
+			log.Fatal("Failed to open read replica:", err)
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			log.Fatal("Failed to open read replica:", err)
+		}
+		ConfigurePool(sqlDB)
+		replicas = append(replicas, Replica{Name: fmt.Sprintf("replica_%d", i+1), DB: sqlDB})
+	}
+	return replicas
+}
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+// NewDatabase connects to the primary database, and routes the queries that opt in to replicas
+func NewDatabase(replicas Replicas) *gorm.DB {
+	db, err := gorm.Open(postgres.Open(DatabaseDSN()), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		// Report driver-specific constraint violations as gorm.ErrDuplicatedKey
+		TranslateError: true,
 	})
 
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	ConfigurePool(sqlDB)
+	if err := UseReplicas(db, replicas); err != nil {
+		log.Fatal("Failed to configure read replicas:", err)
+	}
 
 	return db
 }
 
-// GetEnv retrieves an environment variable or returns a default value if not set or empty
-func GetEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// ConfigurePool sizes the connection pool db: at most DB_MAX_OPEN_CONNS connections (25 by
+// default), DB_MAX_IDLE_CONNS of them kept idle (10), each closed after
+// DB_CONN_MAX_LIFETIME_SECONDS (30 minutes) or DB_CONN_MAX_IDLE_TIME_SECONDS idle (5 minutes).
+// Zero or negative values remove the limits, except that no connection is kept idle.
+func ConfigurePool(db *sql.DB) {
+	db.SetMaxOpenConns(GetEnvInt("DB_MAX_OPEN_CONNS", defaultMaxOpenConns))
+	db.SetMaxIdleConns(GetEnvInt("DB_MAX_IDLE_CONNS", defaultMaxIdleConns))
+	maxLifetime := GetEnvInt("DB_CONN_MAX_LIFETIME_SECONDS", defaultConnMaxLifetimeSeconds)
+	db.SetConnMaxLifetime(time.Duration(maxLifetime) * time.Second)
+	maxIdleTime := GetEnvInt("DB_CONN_MAX_IDLE_TIME_SECONDS", defaultConnMaxIdleTimeSeconds)
+	db.SetConnMaxIdleTime(time.Duration(maxIdleTime) * time.Second)
+}
+
+// UseReplicas registers replicas with db under ReplicaResolverName. It does nothing when there are
+// no replicas, so queries asking for them run on the primary.
+func UseReplicas(db *gorm.DB, replicas Replicas) error {
+	if len(replicas) == 0 {
+		return nil
+	}
+
+	dialectors := make([]gorm.Dialector, 0, len(replicas))
+	for _, replica := range replicas {
+		dialectors = append(dialectors, postgres.New(postgres.Config{Conn: replica.DB}))
+	}
+	return db.Use(dbresolver.Register(dbresolver.Config{
+		Replicas: dialectors,
+		Policy:   dbresolver.RandomPolicy{},
+	}, ReplicaResolverName))
+}
+
+// PingDatabases pings the primary and each replica, and returns the error of each pool by name
+// (primary, replica_1, ...), nil for the pools that answered
+func PingDatabases(ctx context.Context, db *gorm.DB, replicas Replicas) map[string]error {
+	results := make(map[string]error, len(replicas)+1)
+
+	sqlDB, err := db.DB()
+	if err == nil {
+		err = sqlDB.PingContext(ctx)
+	}
+	results["primary"] = err
+
+	for _, replica := range replicas {
+		results[replica.Name] = replica.DB.PingContext(ctx)
 	}
-	return defaultValue
+	return results
 }
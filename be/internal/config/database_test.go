@@ -1,13 +1,18 @@
 package config_test
 
 import (
+	"context"
+	"errors"
 	"os"
 	"testing"
 
 	"strikepad-backend/internal/config"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 type DatabaseConfigTestSuite struct {
@@ -280,6 +285,68 @@ func (suite *DatabaseConfigTestSuite) TestNewDatabase() {
 	suite.T().Skip("NewDatabase requires actual database connection - testing DSN construction via getEnv instead")
 }
 
+func (suite *DatabaseConfigTestSuite) TestConfigurePool() {
+	testCases := []struct {
+		env             map[string]string
+		name            string
+		expectedMaxOpen int
+	}{
+		{name: "defaults", env: map[string]string{}, expectedMaxOpen: 25},
+		{name: "configured", env: map[string]string{"DB_MAX_OPEN_CONNS": "50"}, expectedMaxOpen: 50},
+		{name: "unlimited", env: map[string]string{"DB_MAX_OPEN_CONNS": "0"}, expectedMaxOpen: 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			for key, value := range tc.env {
+				suite.T().Setenv(key, value)
+			}
+			sqlDB, _, err := sqlmock.New()
+			suite.Require().NoError(err)
+			defer sqlDB.Close()
+
+			config.ConfigurePool(sqlDB)
+
+			suite.Equal(tc.expectedMaxOpen, sqlDB.Stats().MaxOpenConnections)
+		})
+	}
+}
+
+func (suite *DatabaseConfigTestSuite) TestPingDatabases() {
+	primaryDB, primary, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	suite.Require().NoError(err)
+	replicaDB, replica, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	suite.Require().NoError(err)
+
+	// Opening the database pings it once
+	primary.ExpectPing()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: primaryDB}), &gorm.Config{})
+	suite.Require().NoError(err)
+	replicas := config.Replicas{{Name: "replica_1", DB: replicaDB}}
+	replica.ExpectPing()
+	suite.Require().NoError(config.UseReplicas(db, replicas))
+
+	primary.ExpectPing()
+	replica.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+	results := config.PingDatabases(context.Background(), db, replicas)
+
+	suite.NoError(results["primary"])
+	suite.EqualError(results["replica_1"], "connection refused")
+	suite.NoError(primary.ExpectationsWereMet())
+	suite.NoError(replica.ExpectationsWereMet())
+}
+
+func (suite *DatabaseConfigTestSuite) TestUseReplicas_WithoutReplicas() {
+	sqlDB, _, err := sqlmock.New()
+	suite.Require().NoError(err)
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(config.UseReplicas(db, nil))
+	suite.Empty(db.Config.Plugins)
+}
+
 func TestDatabaseConfigTestSuite(t *testing.T) {
 	suite.Run(t, new(DatabaseConfigTestSuite))
 }
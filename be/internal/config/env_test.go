@@ -195,6 +195,61 @@ func (suite *EnvConfigTestSuite) TestGetEnvCommonDefaults() {
 	}
 }
 
+func (suite *EnvConfigTestSuite) TestGetEnvBool() {
+	testCases := []struct {
+		envValue     *string // nil means env var is not set
+		name         string
+		defaultValue bool
+		expected     bool
+	}{
+		{name: "not set uses default", envValue: nil, defaultValue: true, expected: true},
+		{name: "true value", envValue: stringPtr("true"), defaultValue: false, expected: true},
+		{name: "numeric true value", envValue: stringPtr("1"), defaultValue: false, expected: true},
+		{name: "false value", envValue: stringPtr("false"), defaultValue: true, expected: false},
+		{name: "unparsable value uses default", envValue: stringPtr("maybe"), defaultValue: true, expected: true},
+		{name: "empty value uses default", envValue: stringPtr(""), defaultValue: false, expected: false},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			os.Unsetenv("TEST_KEY")
+			if tc.envValue != nil {
+				os.Setenv("TEST_KEY", *tc.envValue)
+			}
+
+			result := config.GetEnvBool("TEST_KEY", tc.defaultValue)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func (suite *EnvConfigTestSuite) TestGetEnvInt() {
+	testCases := []struct {
+		envValue     *string // nil means env var is not set
+		name         string
+		defaultValue int
+		expected     int
+	}{
+		{name: "not set uses default", envValue: nil, defaultValue: 100, expected: 100},
+		{name: "integer value", envValue: stringPtr("25"), defaultValue: 100, expected: 25},
+		{name: "zero value", envValue: stringPtr("0"), defaultValue: 100, expected: 0},
+		{name: "unparsable value uses default", envValue: stringPtr("ten"), defaultValue: 100, expected: 100},
+		{name: "empty value uses default", envValue: stringPtr(""), defaultValue: 100, expected: 100},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			os.Unsetenv("TEST_KEY")
+			if tc.envValue != nil {
+				os.Setenv("TEST_KEY", *tc.envValue)
+			}
+
+			result := config.GetEnvInt("TEST_KEY", tc.defaultValue)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func TestEnvConfigTestSuite(t *testing.T) {
 	suite.Run(t, new(EnvConfigTestSuite))
 }
@@ -0,0 +1,14 @@
+package config
+
+// SandboxMode reports whether SANDBOX_MODE is enabled. In sandbox mode Google sign-in and email
+// delivery are replaced by deterministic fakes, so full flows run without external credentials.
+// It panics when sandbox mode is enabled with APP_ENV=production.
+func SandboxMode() bool {
+	if !GetEnvBool("SANDBOX_MODE", false) {
+		return false
+	}
+	if GetEnv("APP_ENV", "") == "production" {
+		panic("SANDBOX_MODE must not be enabled in production")
+	}
+	return true
+}
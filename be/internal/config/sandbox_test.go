@@ -0,0 +1,21 @@
+package config_test
+
+import (
+	"testing"
+
+	"strikepad-backend/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandboxMode(t *testing.T) {
+	t.Setenv("APP_ENV", "dev")
+	t.Setenv("SANDBOX_MODE", "")
+	assert.False(t, config.SandboxMode())
+
+	t.Setenv("SANDBOX_MODE", "true")
+	assert.True(t, config.SandboxMode())
+
+	t.Setenv("APP_ENV", "production")
+	assert.Panics(t, func() { config.SandboxMode() })
+}
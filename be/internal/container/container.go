@@ -2,50 +2,306 @@ package container
 
 import (
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/captcha"
+	"strikepad-backend/internal/clock"
 	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/featureflags"
 	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/oauth"
 	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/router"
+	"strikepad-backend/internal/seeds"
 	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/storage"
+	"strikepad-backend/internal/webhook"
 
 	"go.uber.org/dig"
 )
 
 func BuildContainer() *dig.Container {
 	container := dig.New()
+	provideLifecycle(container)
 
+	if err := container.Provide(config.NewReplicas); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(config.NewDatabase); err != nil {
 		panic(err)
 	}
 	if err := container.Provide(repository.NewUserRepository); err != nil {
 		panic(err)
 	}
+	if err := container.Provide(cache.New); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(repository.NewSessionRepository); err != nil {
 		panic(err)
 	}
+	if err := container.Decorate(repository.NewCachedSessionRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewProfileRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewWaitlistRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewIdentityRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewAPIKeyRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewInvitationRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewIdempotencyKeyRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewOutboxRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewWebhookRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewNotificationRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewDataExportRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewUserPurgeRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewAuditLogRepository); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(repository.NewTxManager); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(clock.New); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(auth.NewTokenGenerator); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(auth.NewJWTService); err != nil {
 		panic(err)
 	}
+	if err := container.Provide(auth.NewDenylist); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(auth.NewPasswordHasher); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(auth.NewBreachChecker); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(captcha.NewVerifier); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(mailer.NewMailer); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(storage.New); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(featureflags.New); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(events.NewBus); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(webhook.NewClient); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(oauth.NewGoogleService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(oauth.NewGitHubProvider); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(oauth.NewMicrosoftProvider); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(oauth.NewAppleProvider); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(oauth.NewDefaultRegistry); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(service.NewHealthService); err != nil {
 		panic(err)
 	}
+	if err := container.Provide(service.NewStatusService); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(service.NewAPIService); err != nil {
 		panic(err)
 	}
 	if err := container.Provide(service.NewAuthService); err != nil {
 		panic(err)
 	}
+	if err := container.Provide(service.NewLoginAlertService); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(service.NewSessionService); err != nil {
 		panic(err)
 	}
+	if err := container.Provide(service.NewAdminService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewIdentityService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewEmailChangeService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewAPIKeyService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewInvitationService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewIdempotencyService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewProfileService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewUserService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewWaitlistService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewOutboxService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewWebhookService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewNotificationService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewDataExportService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewUserPurgeService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewAuditService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(service.NewDevEmailService); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(seeds.NewSeeder); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(handler.NewHealthHandler); err != nil {
 		panic(err)
 	}
+	if err := container.Provide(handler.NewStatusHandler); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(handler.NewAPIHandler); err != nil {
 		panic(err)
 	}
 	if err := container.Provide(handler.NewAuthHandler); err != nil {
 		panic(err)
 	}
+	if err := container.Provide(handler.NewAdminHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewIdentityHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewEmailChangeHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewAPIKeyHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewInvitationHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewProfileHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewUserHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewWaitlistHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewOutboxHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewWebhookHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewNotificationHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewDataExportHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewUserPurgeHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewAuditLogHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewDevEmailHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewUploadHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewCSRFHandler); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(handler.NewOpenAPIHandler); err != nil {
+		panic(err)
+	}
+
+	// Handlers add their routes to the registrar; a new handler only needs an entry here
+	routeProviders := []any{
+		func(h handler.HealthHandlerInterface) router.RouteProvider { return h },
+		func(h *handler.APIHandler) router.RouteProvider { return h },
+		func(h handler.StatusHandlerInterface) router.RouteProvider { return h },
+		func(h handler.OpenAPIHandlerInterface) router.RouteProvider { return h },
+		func(h handler.AuthHandlerInterface) router.RouteProvider { return h },
+		func(h handler.CSRFHandlerInterface) router.RouteProvider { return h },
+		func(h handler.IdentityHandlerInterface) router.RouteProvider { return h },
+		func(h handler.EmailChangeHandlerInterface) router.RouteProvider { return h },
+		func(h handler.ProfileHandlerInterface) router.RouteProvider { return h },
+		func(h handler.UserHandlerInterface) router.RouteProvider { return h },
+		func(h handler.WaitlistHandlerInterface) router.RouteProvider { return h },
+		func(h handler.OutboxHandlerInterface) router.RouteProvider { return h },
+		func(h handler.AdminHandlerInterface) router.RouteProvider { return h },
+		func(h handler.UserPurgeHandlerInterface) router.RouteProvider { return h },
+		func(h handler.APIKeyHandlerInterface) router.RouteProvider { return h },
+		func(h handler.InvitationHandlerInterface) router.RouteProvider { return h },
+		func(h handler.WebhookHandlerInterface) router.RouteProvider { return h },
+		func(h handler.NotificationHandlerInterface) router.RouteProvider { return h },
+		func(h handler.DataExportHandlerInterface) router.RouteProvider { return h },
+		func(h handler.AuditLogHandlerInterface) router.RouteProvider { return h },
+		func(h handler.DevEmailHandlerInterface) router.RouteProvider { return h },
+		func(h handler.UploadHandlerInterface) router.RouteProvider { return h },
+	}
+	for _, provider := range routeProviders {
+		if err := container.Provide(provider, dig.Group(router.RoutesGroup)); err != nil {
+			panic(err)
+		}
+	}
+	if err := container.Provide(router.NewRegistrar); err != nil {
+		panic(err)
+	}
 
 	return container
 }
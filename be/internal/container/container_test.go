@@ -1,25 +1,48 @@
 package container_test
 
 import (
+	"context"
 	"testing"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/config"
 	"strikepad-backend/internal/container"
 	"strikepad-backend/internal/handler"
 	"strikepad-backend/internal/repository"
 	"strikepad-backend/internal/service"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/dig"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
-// buildTestContainer creates a container without database dependencies for testing
+// newMockDatabase returns a database backed by sqlmock, which answers the pings of health checks
+func newMockDatabase() (*gorm.DB, error) {
+	sqlDB, _, err := sqlmock.New()
+	if err != nil {
+		return nil, err
+	}
+	return gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+}
+
+// buildTestContainer creates a container without a real database for testing
 func buildTestContainer() *dig.Container {
 	container := dig.New()
 
-	// Only provide non-database-dependent components
+	// Only provide components that need no database, or just ping it
+	if err := container.Provide(newMockDatabase); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(func() config.Replicas { return nil }); err != nil {
+		panic(err)
+	}
+	if err := container.Provide(func() cache.Cache { return nil }); err != nil {
+		panic(err)
+	}
 	if err := container.Provide(service.NewHealthService); err != nil {
 		panic(err)
 	}
@@ -85,7 +108,7 @@ func (suite *ContainerTestSuite) TestBuildContainer() {
 				assert.NotNil(t, healthHandler, "HealthHandler should not be nil")
 
 				// Test actual functionality
-				healthResponse := healthSvc.GetHealth()
+				healthResponse := healthSvc.GetHealth(context.Background())
 				assert.NotNil(t, healthResponse, "Health response should not be nil")
 				assert.Equal(t, "ok", healthResponse.Status, "Health status should be ok")
 			},
@@ -171,7 +194,7 @@ func (suite *ContainerTestSuite) TestBuildContainer() {
 					apiSvc service.APIService,
 				) {
 					// Test HealthService functionality
-					healthResponse := healthSvc.GetHealth()
+					healthResponse := healthSvc.GetHealth(context.Background())
 					assert.NotNil(t, healthResponse, "Health response should not be nil")
 					assert.Equal(t, "ok", healthResponse.Status, "Health status should be ok")
 					assert.NotEmpty(t, healthResponse.Message, "Health message should not be empty")
@@ -209,7 +232,7 @@ func (suite *ContainerTestSuite) TestContainerProvides() {
 			invokeFunc: func(hs service.HealthServiceInterface) {
 				assert.NotNil(suite.T(), hs, "HealthService should not be nil")
 				// Test basic functionality
-				response := hs.GetHealth()
+				response := hs.GetHealth(context.Background())
 				assert.NotNil(suite.T(), response, "Health response should not be nil")
 			},
 			expectError: false,
@@ -384,14 +407,14 @@ func (suite *ContainerTestSuite) TestContainerErrorScenarios() {
 			name:        "Container creation consistency",
 			description: "Multiple BuildContainer calls should create independent containers",
 			testFunction: func(t *testing.T) {
-				c1 := container.BuildContainer()
-				c2 := container.BuildContainer()
+				c1 := buildTestContainer()
+				c2 := buildTestContainer()
 
 				assert.NotNil(t, c1, "First container should not be nil")
 				assert.NotNil(t, c2, "Second container should not be nil")
 				assert.NotEqual(t, c1, c2, "Containers should be different instances")
 
-				// Test that they resolve different instances
+				// Test that they resolve different instances; the health service needs the mock database
 				var svc1, svc2 service.HealthServiceInterface
 
 				err1 := c1.Invoke(func(hs service.HealthServiceInterface) {
@@ -437,7 +460,7 @@ func (suite *ContainerTestSuite) TestContainerErrorScenarios() {
 				for i := 0; i < 3; i++ {
 					err := c.Invoke(func(hs service.HealthServiceInterface) {
 						assert.NotNil(t, hs, "Service should not be nil in iteration %d", i+1)
-						response := hs.GetHealth()
+						response := hs.GetHealth(context.Background())
 						assert.Equal(t, "ok", response.Status, "Status should be consistent")
 					})
 					assert.NoError(t, err, "Invocation %d should succeed", i+1)
@@ -0,0 +1,168 @@
+package container
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/featureflags"
+	"strikepad-backend/internal/lifecycle"
+	"strikepad-backend/internal/service"
+
+	"go.uber.org/dig"
+	"gorm.io/gorm"
+)
+
+// provideLifecycle provides the lifecycle, and decorates the components that hold resources or
+// run in the background so that they append their hooks to it when they are constructed. A
+// component is constructed after its dependencies, so it is also stopped before them.
+func provideLifecycle(container *dig.Container) {
+	if err := container.Provide(lifecycle.New); err != nil {
+		panic(err)
+	}
+
+	decorators := []any{
+		closeReplicasOnStop,
+		closeDatabaseOnStop,
+		closeCacheOnStop,
+		warmUpStatus,
+		runOutboxDispatcher,
+		runIdempotencyKeyPurge,
+		runDataExportWorker,
+		runUserPurge,
+		refreshFeatureFlags,
+	}
+	for _, decorator := range decorators {
+		if err := container.Decorate(decorator); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Lifecycle returns the lifecycle of the components in container. The components with startup or
+// background work are constructed here if nothing else needed them yet, so that their hooks are
+// appended either way.
+func Lifecycle(container *dig.Container) (*lifecycle.Lifecycle, error) {
+	var lc *lifecycle.Lifecycle
+	err := container.Invoke(func(
+		l *lifecycle.Lifecycle,
+		_ service.StatusServiceInterface,
+		_ service.OutboxServiceInterface,
+		_ service.IdempotencyServiceInterface,
+		_ service.DataExportServiceInterface,
+		_ service.UserPurgeServiceInterface,
+		_ featureflags.Flags,
+	) {
+		lc = l
+	})
+	return lc, err
+}
+
+// closeReplicasOnStop closes the connection pools of the read replicas
+func closeReplicasOnStop(replicas config.Replicas, lc *lifecycle.Lifecycle) config.Replicas {
+	lc.Append(lifecycle.Hook{
+		Name: "read replicas",
+		OnStop: func(context.Context) error {
+			var errs []error
+			for _, replica := range replicas {
+				errs = append(errs, replica.DB.Close())
+			}
+			return stderrors.Join(errs...)
+		},
+	})
+	return replicas
+}
+
+// closeDatabaseOnStop closes the connection pool of the primary database
+func closeDatabaseOnStop(db *gorm.DB, lc *lifecycle.Lifecycle) *gorm.DB {
+	lc.Append(lifecycle.Hook{
+		Name: "database",
+		OnStop: func(context.Context) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.Close()
+		},
+	})
+	return db
+}
+
+// closeCacheOnStop closes the idle connections of the cache, if it holds any
+func closeCacheOnStop(c cache.Cache, lc *lifecycle.Lifecycle) cache.Cache {
+	if closer, ok := c.(io.Closer); ok {
+		lc.Append(lifecycle.Hook{
+			Name:   "cache",
+			OnStop: func(context.Context) error { return closer.Close() },
+		})
+	}
+	return c
+}
+
+// warmUpStatus probes the components once on startup, so that the first status page request is
+// answered from the cache
+func warmUpStatus(
+	statusService service.StatusServiceInterface, lc *lifecycle.Lifecycle,
+) service.StatusServiceInterface {
+	lc.Append(lifecycle.Hook{
+		Name: "status cache warmup",
+		OnStart: func(context.Context) error {
+			statusService.GetStatus()
+			return nil
+		},
+	})
+	return statusService
+}
+
+// runOutboxDispatcher delivers outbox messages in the background, unless OUTBOX_DISPATCHER_ENABLED
+// is false. Several instances may run dispatchers; each message is claimed by only one of them.
+func runOutboxDispatcher(
+	outboxService service.OutboxServiceInterface, lc *lifecycle.Lifecycle,
+) service.OutboxServiceInterface {
+	if config.GetEnvBool("OUTBOX_DISPATCHER_ENABLED", true) {
+		lc.Append(lifecycle.Background("outbox dispatcher", outboxService.Run))
+	}
+	return outboxService
+}
+
+// runIdempotencyKeyPurge deletes expired idempotency keys in the background
+func runIdempotencyKeyPurge(
+	idempotencyService service.IdempotencyServiceInterface, lc *lifecycle.Lifecycle,
+) service.IdempotencyServiceInterface {
+	lc.Append(lifecycle.Background("idempotency key purge", idempotencyService.Run))
+	return idempotencyService
+}
+
+// runDataExportWorker assembles requested data exports and deletes expired ones in the background,
+// unless DATA_EXPORT_WORKER_ENABLED is false. Several instances may run workers; each export is
+// claimed by only one of them.
+func runDataExportWorker(
+	dataExportService service.DataExportServiceInterface, lc *lifecycle.Lifecycle,
+) service.DataExportServiceInterface {
+	if config.GetEnvBool("DATA_EXPORT_WORKER_ENABLED", true) {
+		lc.Append(lifecycle.Background("data export worker", dataExportService.Run))
+	}
+	return dataExportService
+}
+
+// runUserPurge erases the personal data of users deleted longer than the retention window ago in
+// the background, unless USER_PURGE_ENABLED is false. Instances purging at the same time skip the
+// users another one purged first.
+func runUserPurge(
+	userPurgeService service.UserPurgeServiceInterface, lc *lifecycle.Lifecycle,
+) service.UserPurgeServiceInterface {
+	if config.GetEnvBool("USER_PURGE_ENABLED", true) {
+		lc.Append(lifecycle.Background("user purge", userPurgeService.Run))
+	}
+	return userPurgeService
+}
+
+// refreshFeatureFlags fetches the flags in the background when they come from a server
+func refreshFeatureFlags(flags featureflags.Flags, lc *lifecycle.Lifecycle) featureflags.Flags {
+	if unleash, ok := flags.(*featureflags.Unleash); ok {
+		lc.Append(lifecycle.Background("feature flag refresh", unleash.Run))
+	}
+	return flags
+}
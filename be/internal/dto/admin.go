@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"time"
+)
+
+// AdminUserInfo represents a user as shown to administrators
+type AdminUserInfo struct {
+	CreatedAt     time.Time `json:"created_at"`
+	ID            string    `json:"id" example:"usr_5k2d9x0q7m3hv8c1b4na"`
+	Email         string    `json:"email,omitempty"`
+	DisplayName   string    `json:"display_name"`
+	ProviderType  string    `json:"provider_type"`
+	Role          string    `json:"role"`
+	EmailVerified bool      `json:"email_verified"`
+	IsDisabled    bool      `json:"is_disabled"`
+}
+
+// AdminUserListResponse represents a page of users returned to administrators
+type AdminUserListResponse ListResponse[AdminUserInfo]
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// CreateAPIKeyRequest represents the request payload for issuing an API key
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" validate:"required,max=100" example:"billing-worker"`
+}
+
+// APIKeyInfo represents an API key as seen by administrators. The key itself is never included.
+type APIKeyInfo struct {
+	CreatedAt       time.Time  `json:"created_at"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt       *time.Time `json:"revoked_at,omitempty"`
+	ID              string     `json:"id" example:"key_8h2m4q0x9d7c3v1k5b6n"`
+	Name            string     `json:"name" example:"billing-worker"`
+	KeyPrefix       string     `json:"key_prefix" example:"sk_3f9a1c2b"`
+	CreatedByUserID string     `json:"created_by_user_id" example:"usr_5k2d9x0q7m3hv8c1b4na"`
+}
+
+// APIKeyCreatedResponse represents a newly issued API key. The key is only ever returned here.
+type APIKeyCreatedResponse struct {
+	Key string `json:"key" example:"sk_3f9a1c2b..."`
+	APIKeyInfo
+}
+
+// APIKeyListResponse represents all issued API keys
+type APIKeyListResponse ListResponse[APIKeyInfo]
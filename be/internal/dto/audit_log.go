@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+)
+
+// AuditLogInfo represents an audit log entry as seen by administrators. Actor and target IDs are
+// public IDs of users, API keys or sessions.
+type AuditLogInfo struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Metadata  map[string]string `json:"metadata"`
+	ID        string            `json:"id" example:"aud_3m8q1x5k9d2v7h0c4b6n"`
+	Action    string            `json:"action" example:"auth.login_succeeded"`
+	ActorID   string            `json:"actor_id,omitempty" example:"usr_5k2d9x0q7m3hv8c1b4na"`
+	TargetID  string            `json:"target_id,omitempty" example:"ses_8h3v1c5b6n7d2k9m4q0x"`
+	IPAddress string            `json:"ip_address,omitempty" example:"203.0.113.7"`
+	UserAgent string            `json:"user_agent,omitempty"`
+}
+
+// AuditLogListResponse represents a page of audit log entries, newest first
+type AuditLogListResponse ListResponse[AuditLogInfo]
+
+// LoginAttemptInfo represents a sign-in to a user's account as seen by the user. Result is succeeded,
+// or failed with the error code the attempt was refused with as Reason.
+type LoginAttemptInfo struct {
+	CreatedAt time.Time `json:"created_at"`
+	Result    string    `json:"result" example:"failed"`
+	Method    string    `json:"method,omitempty" example:"password"`
+	Reason    string    `json:"reason,omitempty" example:"E100"`
+	IPAddress string    `json:"ip_address,omitempty" example:"203.0.113.7"`
+	UserAgent string    `json:"user_agent,omitempty"`
+}
+
+// LoginAttemptListResponse represents a page of sign-in attempts, newest first
+type LoginAttemptListResponse ListResponse[LoginAttemptInfo]
@@ -2,36 +2,60 @@ package dto
 
 import "time"
 
-// SignupRequest represents the request payload for user signup
+// SignupRequest represents the request payload for user signup. InviteToken comes from an
+// invitation email and is required when registration is closed.
 type SignupRequest struct {
 	Email       string `json:"email" validate:"required,email,max=255" example:"user@example.com"`
 	Password    string `json:"password" validate:"required,min=8,max=128,password_complex" example:"Password123!"`
 	DisplayName string `json:"display_name" validate:"required,min=1,max=100" example:"John Doe"`
+	DeviceName  string `json:"device_name,omitempty" validate:"omitempty,max=100" example:"Work laptop"`
+	InviteToken string `json:"invite_token,omitempty" example:"eyJhbGciOiJIUzI1NiIs..."`
 }
 
-// GoogleSignupRequest represents the request payload for Google OAuth signup
+// GoogleSignupRequest represents the request payload for Google OAuth signup.
+// Either an ID token (preferred) or an access token must be provided.
 type GoogleSignupRequest struct {
-	AccessToken string `json:"access_token" validate:"required" example:"ya29.a0ARrdaM..."`
+	AccessToken string `json:"access_token,omitempty" validate:"required_without=IDToken" example:"ya29.a0ARrdaM..."`
+	IDToken     string `json:"id_token,omitempty" validate:"required_without=AccessToken" example:"eyJhbGciOiJSUzI1..."`
+	DeviceName  string `json:"device_name,omitempty" validate:"omitempty,max=100" example:"Work laptop"`
+	InviteToken string `json:"invite_token,omitempty" example:"eyJhbGciOiJIUzI1NiIs..."`
 }
 
-// SignupResponse represents the response payload for user signup
+// SignupResponse represents the response payload for user signup. ID is the user's public ID;
+// UserID is the internal ID, used to start the session and never serialized.
 type SignupResponse struct {
 	CreatedAt     time.Time `json:"created_at" example:"2025-01-27T10:15:30Z"`
+	ID            string    `json:"id" example:"usr_5k2d9x0q7m3hv8c1b4na"`
 	Email         string    `json:"email" example:"user@example.com"`
 	DisplayName   string    `json:"display_name" example:"John Doe"`
-	ID            uint      `json:"id" example:"1"`
+	UserID        uint      `json:"-"`
 	EmailVerified bool      `json:"email_verified" example:"false"`
 }
 
 // LoginRequest represents the request payload for user login
 type LoginRequest struct {
-	Email    string `json:"email" validate:"required,email,max=255" example:"user@example.com"`
-	Password string `json:"password" validate:"required,min=1,max=128" example:"password123"`
+	Email      string `json:"email" validate:"required,email,max=255" example:"user@example.com"`
+	Password   string `json:"password" validate:"required,min=1,max=128" example:"password123"`
+	DeviceName string `json:"device_name,omitempty" validate:"omitempty,max=100" example:"Work laptop"`
 }
 
-// GoogleLoginRequest represents the request payload for Google OAuth login
+// GoogleLoginRequest represents the request payload for Google OAuth login.
+// Either an ID token (preferred) or an access token must be provided.
 type GoogleLoginRequest struct {
-	AccessToken string `json:"access_token" validate:"required" example:"ya29.a0ARrdaM..."`
+	AccessToken string `json:"access_token,omitempty" validate:"required_without=IDToken" example:"ya29.a0ARrdaM..."`
+	IDToken     string `json:"id_token,omitempty" validate:"required_without=AccessToken" example:"eyJhbGciOiJSUzI1..."`
+	DeviceName  string `json:"device_name,omitempty" validate:"omitempty,max=100" example:"Work laptop"`
+}
+
+// OAuthRequest represents the request payload for signup or login with a registered OAuth provider.
+// Either an authorization code (with its PKCE verifier, if one was used) or an access token must be provided.
+// InviteToken is only used by signup.
+type OAuthRequest struct {
+	AccessToken  string `json:"access_token,omitempty" validate:"required_without=Code" example:"gho_16C7e42F292c..."`
+	Code         string `json:"code,omitempty" validate:"required_without=AccessToken" example:"4/0AX4XfWh..."`
+	CodeVerifier string `json:"code_verifier,omitempty" validate:"omitempty,max=128" example:"dBjftJeZ4CVP-mB92K27..."`
+	DeviceName   string `json:"device_name,omitempty" validate:"omitempty,max=100" example:"Work laptop"`
+	InviteToken  string `json:"invite_token,omitempty" example:"eyJhbGciOiJIUzI1NiIs..."`
 }
 
 // LoginResponse represents the response payload for user login
@@ -42,12 +66,16 @@ type LoginResponse struct {
 	UserInfo     `json:",inline"`
 }
 
-// UserInfo represents basic user information
+// UserInfo represents basic user information. ID is the user's public ID;
+// UserID is the internal ID, used to start the session and never serialized.
+// AvatarURL is null until the user uploads an avatar.
 type UserInfo struct {
-	Email         string `json:"email"`
-	DisplayName   string `json:"display_name"`
-	ID            uint   `json:"id"`
-	EmailVerified bool   `json:"email_verified"`
+	AvatarURL     *string `json:"avatar_url"`
+	ID            string  `json:"id" example:"usr_5k2d9x0q7m3hv8c1b4na"`
+	Email         string  `json:"email"`
+	DisplayName   string  `json:"display_name"`
+	UserID        uint    `json:"-"`
+	EmailVerified bool    `json:"email_verified"`
 }
 
 // ErrorResponse represents a unified error response structure
@@ -58,6 +86,18 @@ type ErrorResponse struct {
 	Details     []ValidationError `json:"details,omitempty"`
 }
 
+// ProblemDetails is an ErrorResponse as an RFC 7807 problem, written instead of the ErrorResponse
+// when PROBLEM_JSON_ENABLED is set. Code and Details are extension members.
+type ProblemDetails struct {
+	Type     string            `json:"type" example:"https://docs.example.com/errors/E103"`
+	Title    string            `json:"title"`
+	Detail   string            `json:"detail,omitempty"`
+	Instance string            `json:"instance,omitempty" example:"/api/auth/logout"`
+	Code     string            `json:"code"`
+	Details  []ValidationError `json:"details,omitempty"`
+	Status   int               `json:"status"`
+}
+
 // ValidationError represents a single validation error
 type ValidationError struct {
 	Field   string `json:"field"`
@@ -74,8 +114,35 @@ type AuthResponse struct {
 	SignupResponse `json:",inline"`
 }
 
+// SessionInfo represents an active session of the current user
+type SessionInfo struct {
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	DeviceName *string   `json:"device_name,omitempty"`
+	ID         string    `json:"id" example:"ses_0vd3m8k2q9x7h1c5b4nz"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	Current    bool      `json:"current"`
+}
+
+// SessionListResponse represents the response payload for listing sessions
+type SessionListResponse ListResponse[SessionInfo]
+
+// CSRFTokenResponse carries the CSRF token that browser clients send in the X-CSRF-Token header
+type CSRFTokenResponse struct {
+	CSRFToken string `json:"csrf_token"`
+}
+
 // HealthResponse represents the health check response
 type HealthResponse struct {
-	Status  string `json:"status"`
+	Status  string `json:"status" example:"ok"`
 	Message string `json:"message"`
+	// Dependencies are the results of checking each dependency of the service
+	Dependencies []DependencyHealth `json:"dependencies,omitempty"`
+}
+
+// DependencyHealth represents the health of a dependency of the service
+type DependencyHealth struct {
+	Name   string `json:"name" example:"database"`
+	Status string `json:"status" example:"ok"`
 }
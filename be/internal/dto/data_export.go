@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// DataExportResponse represents an export of the user's data. Once the export is ready,
+// DownloadURL is a temporary link to the archive; poll the export again for a fresh link.
+type DataExportResponse struct {
+	CreatedAt            time.Time  `json:"created_at"`
+	CompletedAt          *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt            *time.Time `json:"expires_at,omitempty"`
+	DownloadURLExpiresAt *time.Time `json:"download_url_expires_at,omitempty"`
+	SizeBytes            *int64     `json:"size_bytes,omitempty" example:"20480"`
+	DownloadURL          *string    `json:"download_url,omitempty"`
+	ID                   string     `json:"id" example:"exp_9c4m1x7k2d5v8h0q3b6n"`
+	Status               string     `json:"status" example:"ready"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// DevEmail represents an email captured by the sandbox mailer
+type DevEmail struct {
+	SentAt  time.Time `json:"sent_at" example:"2025-01-27T10:15:30Z"`
+	From    string    `json:"from" example:"StrikePad <no-reply@localhost>"`
+	To      string    `json:"to" example:"alice@sandbox.test"`
+	Subject string    `json:"subject" example:"Verify your email address"`
+	Text    string    `json:"text"`
+	HTML    string    `json:"html,omitempty"`
+	Links   []string  `json:"links"`
+}
+
+// DevEmailListResponse represents the captured emails, newest first
+type DevEmailListResponse ListResponse[DevEmail]
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// EmailChangeRequest represents the request payload for changing the current user's email address.
+// Users with a password confirm it; users without one must have signed in recently.
+type EmailChangeRequest struct {
+	Email             string `json:"email" validate:"required,email,max=255" example:"new@example.com"`
+	Password          string `json:"password,omitempty" example:"Password123!"`
+	SignOutEverywhere bool   `json:"sign_out_everywhere" example:"true"`
+}
+
+// ConfirmEmailChangeRequest represents the request payload for confirming an email change with the
+// token of an emailed link
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" validate:"required" example:"eyJhbGciOiJIUzI1NiIs..."`
+}
+
+// EmailChangeResponse represents an email change. The current address confirmed it already when
+// the user has none or it was never verified. Completed is true once both addresses confirmed it
+// and the account uses the new address.
+type EmailChangeResponse struct {
+	ExpiresAt               *time.Time `json:"expires_at,omitempty" example:"2025-01-28T10:15:30Z"`
+	Email                   string     `json:"email" example:"new@example.com"`
+	CurrentAddressConfirmed bool       `json:"current_address_confirmed" example:"false"`
+	NewAddressConfirmed     bool       `json:"new_address_confirmed" example:"true"`
+	Completed               bool       `json:"completed" example:"false"`
+}
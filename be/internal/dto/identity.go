@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// LinkGoogleIdentityRequest represents the request payload for linking a Google account to the current user.
+// Either an ID token (preferred) or an access token must be provided.
+type LinkGoogleIdentityRequest struct {
+	AccessToken string `json:"access_token,omitempty" validate:"required_without=IDToken" example:"ya29.a0ARrdaM..."`
+	IDToken     string `json:"id_token,omitempty" validate:"required_without=AccessToken" example:"eyJhbGciOiJSUzI1..."`
+}
+
+// IdentityInfo represents a provider account linked to the current user
+type IdentityInfo struct {
+	LinkedAt time.Time `json:"linked_at" example:"2025-01-27T10:15:30Z"`
+	Provider string    `json:"provider" example:"google"`
+	Email    string    `json:"email,omitempty" example:"user@gmail.com"`
+}
+
+// UnlinkIdentityRequest represents the request payload for removing a linked provider account.
+// Users with a password confirm it; users without one must have signed in recently.
+type UnlinkIdentityRequest struct {
+	Password string `json:"password,omitempty" example:"Password123!"`
+}
+
+// SecuritySettingsResponse represents how the current user can sign in
+type SecuritySettingsResponse struct {
+	PasswordChangedAt *time.Time     `json:"password_changed_at,omitempty" example:"2025-01-27T10:15:30Z"`
+	ProviderType      string         `json:"provider_type" example:"email"`
+	Identities        []IdentityInfo `json:"identities"`
+	HasPassword       bool           `json:"has_password" example:"true"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// CreateInvitationRequest represents the request payload for inviting someone to sign up
+type CreateInvitationRequest struct {
+	Email string `json:"email" validate:"required,email,max=255" example:"friend@example.com"`
+}
+
+// InvitationInfo represents an invitation as seen by the admin who sent it. The invite token
+// is only ever emailed.
+type InvitationInfo struct {
+	ExpiresAt       time.Time `json:"expires_at"`
+	CreatedAt       time.Time `json:"created_at"`
+	ID              string    `json:"id" example:"inv_3m8q1x5k9d2v7h0c4b6n"`
+	Email           string    `json:"email" example:"friend@example.com"`
+	InvitedByUserID string    `json:"invited_by_user_id" example:"usr_5k2d9x0q7m3hv8c1b4na"`
+}
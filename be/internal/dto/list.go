@@ -0,0 +1,24 @@
+package dto
+
+import "strikepad-backend/internal/pagination"
+
+// ListResponse is the envelope of every list response: the items in data, next to the pagination
+// metadata. List response types are defined on it, e.g. type AdminUserListResponse
+// ListResponse[AdminUserInfo], so that each has a name of its own in the API documentation.
+type ListResponse[T any] struct {
+	Data []T `json:"data"`
+	pagination.Meta
+}
+
+// NewListResponse returns the envelope of items, a page of a list described by meta
+func NewListResponse[T any](items []T, meta pagination.Meta) ListResponse[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return ListResponse[T]{Data: items, Meta: meta}
+}
+
+// NewCompleteListResponse returns the envelope of items, a list returned whole as a single page
+func NewCompleteListResponse[T any](items []T) ListResponse[T] {
+	return NewListResponse(items, pagination.Complete(len(items)))
+}
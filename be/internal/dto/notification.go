@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+// Notification is an event shown to the current user, sent on the notification stream. Data
+// describes it and depends on the type, e.g. session_id for session.revoked.
+type Notification struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Data      map[string]string `json:"data"`
+	ID        string            `json:"id" example:"ntf_3m8q1x5k9d2v7h0c4b6n"`
+	Type      string            `json:"type" example:"session.revoked"`
+}
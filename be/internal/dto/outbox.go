@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// OutboxStatsResponse represents the depth of the outbox queue
+type OutboxStatsResponse struct {
+	OldestPendingAt         *time.Time `json:"oldest_pending_at,omitempty" example:"2025-01-27T10:15:30Z"`
+	Pending                 int64      `json:"pending" example:"3"`
+	Failed                  int64      `json:"failed" example:"0"`
+	OldestPendingAgeSeconds int64      `json:"oldest_pending_age_seconds" example:"12"`
+}
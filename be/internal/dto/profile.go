@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// OnboardingRequest represents the request payload for recording onboarding answers.
+// Every field is optional; omitted fields keep their previously recorded value.
+type OnboardingRequest struct {
+	Role        *string `json:"role,omitempty" validate:"omitempty,oneof=engineer designer manager founder student other"`
+	TeamSize    *string `json:"team_size,omitempty" validate:"omitempty,oneof=1 2-10 11-50 51-200 201+"`
+	IntendedUse *string `json:"intended_use,omitempty" validate:"omitempty,oneof=personal work education other"`
+	// Version is the version of the answers the change was made to, unless sent in If-Match
+	Version *uint `json:"version,omitempty"`
+}
+
+// OnboardingResponse represents the recorded onboarding answers of a user
+type OnboardingResponse struct {
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Role        *string    `json:"role"`
+	TeamSize    *string    `json:"team_size"`
+	IntendedUse *string    `json:"intended_use"`
+	// Version changes whenever the answers are recorded; it is 0 before the first answer
+	Version uint `json:"version"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// StatusResponse represents the public status of the service and its components
+type StatusResponse struct {
+	CheckedAt  time.Time         `json:"checked_at" example:"2025-01-27T10:15:30Z"`
+	Status     string            `json:"status" example:"operational"`
+	Components []ComponentStatus `json:"components"`
+}
+
+// ComponentStatus represents the status of a single component
+type ComponentStatus struct {
+	Name   string `json:"name" example:"database"`
+	Status string `json:"status" example:"operational"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+// UserSearchResult is a user found by the user search. It only carries what is needed to pick the
+// user, and never their email address.
+type UserSearchResult struct {
+	AvatarURL   *string `json:"avatar_url"`
+	ID          string  `json:"id" example:"usr_5k2d9x0q7m3hv8c1b4na"`
+	DisplayName string  `json:"display_name"`
+}
+
+// UserSearchResponse represents the users matching a user search
+type UserSearchResponse ListResponse[UserSearchResult]
+
+// PrivacySettingsRequest represents the request payload for changing privacy settings
+type PrivacySettingsRequest struct {
+	// Discoverable lets other users find the user through the user search
+	Discoverable *bool `json:"discoverable" validate:"required"`
+	// Version is the version of the settings the change was made to, unless sent in If-Match
+	Version *uint `json:"version,omitempty"`
+}
+
+// PrivacySettingsResponse represents the privacy settings of the current user
+type PrivacySettingsResponse struct {
+	// Version changes whenever the user is changed
+	Version      uint `json:"version"`
+	Discoverable bool `json:"discoverable"`
+}
+
+// AvatarResponse represents the avatar of the current user after an upload
+type AvatarResponse struct {
+	AvatarURL string `json:"avatar_url"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+)
+
+// WaitlistJoinRequest represents the request payload for joining the waitlist
+type WaitlistJoinRequest struct {
+	Email        string `json:"email" validate:"required,email,max=255" example:"user@example.com"`
+	CaptchaToken string `json:"captcha_token,omitempty" example:"0.zrSnRHO7h0HwSjSCU8oyzbjEtD8p..."`
+}
+
+// WaitlistEntryInfo represents a waitlist entry as seen by administrators
+type WaitlistEntryInfo struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	InvitedAt  *time.Time `json:"invited_at,omitempty"`
+	InviteCode *string    `json:"invite_code,omitempty"`
+	ID         string     `json:"id" example:"wle_3c9x0m2k7q4d8h1v5b6n"`
+	Email      string     `json:"email"`
+}
+
+// WaitlistListResponse represents a page of waitlist entries
+type WaitlistListResponse ListResponse[WaitlistEntryInfo]
+
+// WaitlistInviteRequest represents the request payload for inviting a batch of waitlist entries
+type WaitlistInviteRequest struct {
+	Count int `json:"count" validate:"required,min=1,max=500" example:"50"`
+}
+
+// WaitlistInviteResponse represents the entries invited in a batch, with their invite codes
+type WaitlistInviteResponse struct {
+	Invited []WaitlistEntryInfo `json:"invited"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// CreateWebhookRequest represents the request payload for registering a webhook endpoint
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" validate:"required,url,max=2048" example:"https://hooks.example.com/strikepad"`
+	EventTypes []string `json:"event_types" validate:"required,min=1,dive,required" example:"user.created,session.revoked"`
+}
+
+// WebhookInfo represents a webhook endpoint as seen by administrators. The secret is never included.
+type WebhookInfo struct {
+	CreatedAt  time.Time `json:"created_at"`
+	ID         string    `json:"id" example:"whk_7d2k9m4q0x8h3v1c5b6n"`
+	URL        string    `json:"url" example:"https://hooks.example.com/strikepad"`
+	EventTypes []string  `json:"event_types" example:"user.created,session.revoked"`
+}
+
+// WebhookCreatedResponse represents a newly registered webhook endpoint. The signing secret is
+// only ever returned here.
+type WebhookCreatedResponse struct {
+	Secret string `json:"secret" example:"whsec_9f2c..."`
+	WebhookInfo
+}
+
+// WebhookListResponse represents all registered webhook endpoints
+type WebhookListResponse ListResponse[WebhookInfo]
@@ -0,0 +1,83 @@
+package errors
+
+// AppError is an error with an API error code. Services return AppErrors for failures that
+// clients should see, and the HTTP error handler writes them as an ErrorResponse with the
+// message and HTTP status of the code.
+type AppError struct {
+	// cause is the underlying error; it is logged but never sent to clients
+	cause error
+	// Code selects the message and HTTP status of the response
+	Code ErrorCode
+	// Description replaces the default description of the code in the response when set
+	Description string
+	// message is returned by Error; it is logged but never sent to clients
+	message string
+	// Details lists the fields that failed validation
+	Details []FieldError
+}
+
+// FieldError describes a request field that failed validation
+type FieldError struct {
+	Field   string
+	Tag     string
+	Value   string
+	Message string
+}
+
+// New returns an AppError with code. message describes the failure in logs.
+func New(code ErrorCode, message string) *AppError {
+	return &AppError{Code: code, message: message}
+}
+
+// Wrap returns an AppError with code caused by cause, such as a repository error behind an
+// internal error. The cause is kept for errors.Is, errors.As and logs.
+func Wrap(code ErrorCode, cause error, message string) *AppError {
+	return &AppError{Code: code, message: message, cause: cause}
+}
+
+// NewValidation returns an AppError with code listing the fields that failed validation
+func NewValidation(code ErrorCode, details ...FieldError) *AppError {
+	return &AppError{Code: code, Details: details}
+}
+
+// Error returns the message of the error, or the message of its code when it has none,
+// followed by the cause
+func (e *AppError) Error() string {
+	message := e.message
+	if message == "" {
+		message = GetErrorInfo(e.Code, DefaultLanguage).Message
+	}
+	if e.cause != nil {
+		return message + ": " + e.cause.Error()
+	}
+	return message
+}
+
+// Unwrap returns the cause of the error
+func (e *AppError) Unwrap() error {
+	return e.cause
+}
+
+// WithDescription returns a copy of e whose response carries description
+func (e *AppError) WithDescription(description string) *AppError {
+	described := *e
+	described.Description = description
+	return &described
+}
+
+// Is reports whether target is an AppError with the same code and message, so that the copies
+// made by WithDescription still match the error they were made from
+func (e *AppError) Is(target error) bool {
+	t, ok := target.(*AppError)
+	return ok && t.Code == e.Code && t.message == e.message
+}
+
+// Info returns the error information of the response for e in lang. A description set with
+// WithDescription is not translated.
+func (e *AppError) Info(lang string) ErrorInfo {
+	info := GetErrorInfo(e.Code, lang)
+	if e.Description != "" {
+		info.Description = e.Description
+	}
+	return info
+}
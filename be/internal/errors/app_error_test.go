@@ -0,0 +1,64 @@
+package errors_test
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"strikepad-backend/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppError_Error(t *testing.T) {
+	cause := stderrors.New("connection refused")
+
+	assert.Equal(t, "user not found", errors.New(errors.ErrCodeUserNotFound, "user not found").Error())
+	assert.Equal(t, "User not found", errors.New(errors.ErrCodeUserNotFound, "").Error())
+	assert.Equal(t, "failed to find user: connection refused",
+		errors.Wrap(errors.ErrCodeInternalError, cause, "failed to find user").Error())
+	assert.Equal(t, "Validation failed", errors.NewValidation(errors.ErrCodeValidationFailed).Error())
+}
+
+func TestAppError_CauseChain(t *testing.T) {
+	cause := stderrors.New("connection refused")
+	err := fmt.Errorf("signup: %w", errors.Wrap(errors.ErrCodeInternalError, cause, "failed to create user"))
+
+	assert.ErrorIs(t, err, cause)
+	var appErr *errors.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, errors.ErrCodeInternalError, appErr.Code)
+	assert.Equal(t, http.StatusInternalServerError, appErr.Info(errors.LangEnglish).HTTPStatus)
+}
+
+func TestAppError_Is(t *testing.T) {
+	errNotFound := errors.New(errors.ErrCodeUserNotFound, "user not found")
+
+	assert.ErrorIs(t, errNotFound.WithDescription("No user with this ID"), errNotFound)
+	assert.NotErrorIs(t, errors.New(errors.ErrCodeUserNotFound, "other"), errNotFound)
+	assert.NotErrorIs(t, errors.New(errors.ErrCodeNotFound, "user not found"), errNotFound)
+}
+
+func TestAppError_Info(t *testing.T) {
+	err := errors.New(errors.ErrCodeNotFound, "no such page")
+	assert.Equal(t, errors.GetErrorInfo(errors.ErrCodeNotFound, errors.LangEnglish), err.Info(errors.LangEnglish))
+
+	described := err.WithDescription("Page not found")
+	assert.Equal(t, "Page not found", described.Info(errors.LangEnglish).Description)
+	assert.Equal(t, http.StatusNotFound, described.Info(errors.LangEnglish).HTTPStatus)
+	assert.Empty(t, err.Description, "WithDescription must not modify the original error")
+}
+
+func TestNewValidation(t *testing.T) {
+	details := []errors.FieldError{
+		{Field: "email", Tag: "required", Message: "email is required"},
+		{Field: "password", Tag: "min", Value: "short", Message: "password must be at least 8 characters"},
+	}
+
+	err := errors.NewValidation(errors.ErrCodeValidationFailed, details...)
+
+	assert.Equal(t, errors.ErrCodeValidationFailed, err.Code)
+	assert.Equal(t, details, err.Details)
+	assert.Equal(t, http.StatusBadRequest, err.Info(errors.LangEnglish).HTTPStatus)
+}
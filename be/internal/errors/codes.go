@@ -7,13 +7,17 @@ type ErrorCode string
 
 const (
 	// General error codes (E001-E099)
-	ErrCodeInternalError    ErrorCode = "E001"
-	ErrCodeInvalidRequest   ErrorCode = "E002"
-	ErrCodeValidationFailed ErrorCode = "E003"
-	ErrCodeNotFound         ErrorCode = "E004"
-	ErrCodeUnauthorized     ErrorCode = "E005"
-	ErrCodeForbidden        ErrorCode = "E006"
-	ErrCodeConflict         ErrorCode = "E007"
+	ErrCodeInternalError        ErrorCode = "E001"
+	ErrCodeInvalidRequest       ErrorCode = "E002"
+	ErrCodeValidationFailed     ErrorCode = "E003"
+	ErrCodeNotFound             ErrorCode = "E004"
+	ErrCodeUnauthorized         ErrorCode = "E005"
+	ErrCodeForbidden            ErrorCode = "E006"
+	ErrCodeConflict             ErrorCode = "E007"
+	ErrCodeTooManyRequests      ErrorCode = "E008"
+	ErrCodeMethodNotAllowed     ErrorCode = "E009"
+	ErrCodeIdempotencyKeyReused ErrorCode = "E010"
+	ErrCodePreconditionRequired ErrorCode = "E011"
 
 	// Authentication error codes (E100-E199)
 	ErrCodeInvalidCredentials ErrorCode = "E100"
@@ -31,11 +35,19 @@ const (
 	ErrCodePasswordComplexity  ErrorCode = "E205"
 	ErrCodeDisplayNameRequired ErrorCode = "E206"
 	ErrCodeDisplayNameTooLong  ErrorCode = "E207"
+	ErrCodePasswordBreached    ErrorCode = "E208"
 
 	// Business logic error codes (E300-E399)
-	ErrCodeEmailNotVerified ErrorCode = "E300"
-	ErrCodeAccountDisabled  ErrorCode = "E301"
-	ErrCodeAccountDeleted   ErrorCode = "E302"
+	ErrCodeEmailNotVerified   ErrorCode = "E300"
+	ErrCodeAccountDisabled    ErrorCode = "E301"
+	ErrCodeAccountDeleted     ErrorCode = "E302"
+	ErrCodeCaptchaFailed      ErrorCode = "E303"
+	ErrCodeIdentityLinked     ErrorCode = "E304"
+	ErrCodeLastLoginMethod    ErrorCode = "E305"
+	ErrCodeStepUpRequired     ErrorCode = "E306"
+	ErrCodeInvitationRequired ErrorCode = "E307"
+	ErrCodeInvitationInvalid  ErrorCode = "E308"
+	ErrCodeSessionLimit       ErrorCode = "E309"
 )
 
 // ErrorInfo contains error information including code, message, description, and HTTP status
@@ -91,6 +103,30 @@ func getGeneralErrors() map[ErrorCode]ErrorInfo {
 			Description: "The request conflicts with the current state of the resource",
 			HTTPStatus:  http.StatusConflict,
 		},
+		ErrCodeTooManyRequests: {
+			Code:        ErrCodeTooManyRequests,
+			Message:     "Too many requests",
+			Description: "Too many requests were sent in a short period, please retry later",
+			HTTPStatus:  http.StatusTooManyRequests,
+		},
+		ErrCodeMethodNotAllowed: {
+			Code:        ErrCodeMethodNotAllowed,
+			Message:     "Method not allowed",
+			Description: "The request method is not supported by this endpoint",
+			HTTPStatus:  http.StatusMethodNotAllowed,
+		},
+		ErrCodeIdempotencyKeyReused: {
+			Code:        ErrCodeIdempotencyKeyReused,
+			Message:     "Idempotency key reused",
+			Description: "The Idempotency-Key was already used for a different request",
+			HTTPStatus:  http.StatusUnprocessableEntity,
+		},
+		ErrCodePreconditionRequired: {
+			Code:        ErrCodePreconditionRequired,
+			Message:     "Precondition required",
+			Description: "The request must name the version it changes in an If-Match header or a version field",
+			HTTPStatus:  http.StatusPreconditionRequired,
+		},
 	}
 }
 
@@ -181,6 +217,12 @@ func getValidationErrors() map[ErrorCode]ErrorInfo {
 			Description: "Display name must be at most 100 characters long",
 			HTTPStatus:  http.StatusBadRequest,
 		},
+		ErrCodePasswordBreached: {
+			Code:        ErrCodePasswordBreached,
+			Message:     "Password has appeared in a data breach",
+			Description: "This password is known to attackers from a data breach; choose a different password",
+			HTTPStatus:  http.StatusBadRequest,
+		},
 	}
 }
 
@@ -205,11 +247,54 @@ func getBusinessLogicErrors() map[ErrorCode]ErrorInfo {
 			Description: "This account has been deleted",
 			HTTPStatus:  http.StatusForbidden,
 		},
+		ErrCodeCaptchaFailed: {
+			Code:        ErrCodeCaptchaFailed,
+			Message:     "Captcha verification failed",
+			Description: "The captcha challenge was missing or could not be verified",
+			HTTPStatus:  http.StatusBadRequest,
+		},
+		ErrCodeIdentityLinked: {
+			Code:        ErrCodeIdentityLinked,
+			Message:     "Identity already linked",
+			Description: "This provider account is already linked to a user",
+			HTTPStatus:  http.StatusConflict,
+		},
+		ErrCodeLastLoginMethod: {
+			Code:        ErrCodeLastLoginMethod,
+			Message:     "Last login method",
+			Description: "The account must keep at least one way to sign in",
+			HTTPStatus:  http.StatusConflict,
+		},
+		ErrCodeStepUpRequired: {
+			Code:        ErrCodeStepUpRequired,
+			Message:     "Reauthentication required",
+			Description: "Confirm your password or sign in again to perform this action",
+			HTTPStatus:  http.StatusForbidden,
+		},
+		ErrCodeInvitationRequired: {
+			Code:        ErrCodeInvitationRequired,
+			Message:     "Invitation required",
+			Description: "Registration is by invitation only; sign up with the link from your invitation email",
+			HTTPStatus:  http.StatusForbidden,
+		},
+		ErrCodeInvitationInvalid: {
+			Code:        ErrCodeInvitationInvalid,
+			Message:     "Invalid invitation",
+			Description: "The invitation is invalid, has expired, has already been used, or was sent to another email address",
+			HTTPStatus:  http.StatusBadRequest,
+		},
+		ErrCodeSessionLimit: {
+			Code:        ErrCodeSessionLimit,
+			Message:     "Session limit reached",
+			Description: "You are signed in on the maximum number of devices; sign out on one of them to sign in here",
+			HTTPStatus:  http.StatusConflict,
+		},
 	}
 }
 
-// GetErrorInfo returns error information for a given error code
-func GetErrorInfo(code ErrorCode) ErrorInfo {
+// GetErrorInfo returns error information for a given error code, with the message and description
+// in lang. Languages without a catalog, and codes missing from the catalog of lang, get English.
+func GetErrorInfo(code ErrorCode, lang string) ErrorInfo {
 	errorMap := make(map[ErrorCode]ErrorInfo)
 
 	// Merge all error categories
@@ -227,7 +312,7 @@ func GetErrorInfo(code ErrorCode) ErrorInfo {
 	}
 
 	if info, exists := errorMap[code]; exists {
-		return info
+		return localize(info, lang)
 	}
 
 	// Return default error if code not found
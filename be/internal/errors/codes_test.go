@@ -244,7 +244,7 @@ func (suite *ErrorCodesTestSuite) TestGetErrorInfo_AllErrorCodes() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			result := errors.GetErrorInfo(tt.code)
+			result := errors.GetErrorInfo(tt.code, errors.LangEnglish)
 
 			// Basic validation
 			assert.Equal(suite.T(), tt.expectedCode, result.Code)
@@ -321,7 +321,7 @@ func (suite *ErrorCodesTestSuite) TestGetErrorInfo_EdgeCases() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			result := errors.GetErrorInfo(tt.code)
+			result := errors.GetErrorInfo(tt.code, errors.LangEnglish)
 
 			assert.Equal(suite.T(), tt.expectedCode, result.Code, tt.description)
 			assert.Equal(suite.T(), tt.expectedStatus, result.HTTPStatus, tt.description)
@@ -348,6 +348,10 @@ func (suite *ErrorCodesTestSuite) TestGetErrorInfo_AllDefinedErrorsValidation()
 		{errors.ErrCodeUnauthorized, "general", []string{"unauthorized"}, 401, 401},
 		{errors.ErrCodeForbidden, "general", []string{"forbidden"}, 403, 403},
 		{errors.ErrCodeConflict, "general", []string{"conflict"}, 409, 409},
+		{errors.ErrCodeTooManyRequests, "general", []string{"too many", "requests"}, 429, 429},
+		{errors.ErrCodeMethodNotAllowed, "general", []string{"method", "not allowed"}, 405, 405},
+		{errors.ErrCodeIdempotencyKeyReused, "general", []string{"idempotency", "different request"}, 422, 422},
+		{errors.ErrCodePreconditionRequired, "general", []string{"if-match", "version"}, 428, 428},
 
 		// Authentication errors (typically 401/404/409)
 		{errors.ErrCodeInvalidCredentials, "authentication", []string{"credentials"}, 401, 401},
@@ -370,11 +374,18 @@ func (suite *ErrorCodesTestSuite) TestGetErrorInfo_AllDefinedErrorsValidation()
 		{errors.ErrCodeEmailNotVerified, "business", []string{"email", "verified"}, 403, 403},
 		{errors.ErrCodeAccountDisabled, "business", []string{"account", "disabled"}, 403, 403},
 		{errors.ErrCodeAccountDeleted, "business", []string{"account", "deleted"}, 403, 403},
+		{errors.ErrCodeCaptchaFailed, "business", []string{"captcha"}, 400, 400},
+		{errors.ErrCodeIdentityLinked, "business", []string{"identity", "linked"}, 409, 409},
+		{errors.ErrCodeLastLoginMethod, "business", []string{"login method", "sign in"}, 409, 409},
+		{errors.ErrCodeStepUpRequired, "business", []string{"reauthentication", "password"}, 403, 403},
+		{errors.ErrCodeInvitationRequired, "business", []string{"invitation", "required"}, 403, 403},
+		{errors.ErrCodeInvitationInvalid, "business", []string{"invitation", "expired"}, 400, 400},
+		{errors.ErrCodeSessionLimit, "business", []string{"session", "sign out"}, 409, 409},
 	}
 
 	for _, tt := range errorCodeTests {
 		suite.Run(string(tt.code), func() {
-			result := errors.GetErrorInfo(tt.code)
+			result := errors.GetErrorInfo(tt.code, errors.LangEnglish)
 
 			// Basic validation
 			assert.Equal(suite.T(), tt.code, result.Code)
@@ -414,7 +425,7 @@ func (suite *ErrorCodesTestSuite) TestErrorInfo_StructureAndSerialization() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			result := errors.GetErrorInfo(tt.code)
+			result := errors.GetErrorInfo(tt.code, errors.LangEnglish)
 
 			// Verify structure has all required fields populated
 			assert.NotEmpty(suite.T(), result.Code, "Code should not be empty")
@@ -518,7 +529,7 @@ func TestGetErrorInfo_DirectIntegration(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := errors.GetErrorInfo(tt.code)
+			result := errors.GetErrorInfo(tt.code, errors.LangEnglish)
 
 			assert.Equal(t, tt.expectedCode, result.Code)
 			assert.Equal(t, tt.expectedMessage, result.Message)
@@ -0,0 +1,35 @@
+package errors
+
+// Languages of the error message catalogs
+const (
+	LangEnglish  = "en"
+	LangJapanese = "ja"
+
+	// DefaultLanguage is used when the client accepts none of the supported languages
+	DefaultLanguage = LangEnglish
+)
+
+// SupportedLanguages lists the languages with an error message catalog, the default first
+var SupportedLanguages = []string{LangEnglish, LangJapanese}
+
+// localizedMessage is the translated message and description of an error code
+type localizedMessage struct {
+	Message     string
+	Description string
+}
+
+// messageCatalogs holds the translations of the error messages by language. English is the
+// language of the error definitions themselves, so it has no catalog.
+var messageCatalogs = map[string]map[ErrorCode]localizedMessage{
+	LangJapanese: japaneseMessages,
+}
+
+// localize returns info with its message and description translated to lang, when the
+// catalog of lang has them
+func localize(info ErrorInfo, lang string) ErrorInfo {
+	if translated, ok := messageCatalogs[lang][info.Code]; ok {
+		info.Message = translated.Message
+		info.Description = translated.Description
+	}
+	return info
+}
@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageCatalogs_CoverAllCodes(t *testing.T) {
+	definitions := []map[ErrorCode]ErrorInfo{
+		getGeneralErrors(), getAuthenticationErrors(), getValidationErrors(), getBusinessLogicErrors(),
+	}
+
+	for lang, catalog := range messageCatalogs {
+		for _, errorMap := range definitions {
+			for code := range errorMap {
+				translated, ok := catalog[code]
+				if assert.True(t, ok, "%s catalog is missing %s", lang, code) {
+					assert.NotEmpty(t, translated.Message, "%s message of %s", lang, code)
+					assert.NotEmpty(t, translated.Description, "%s description of %s", lang, code)
+				}
+			}
+		}
+	}
+}
+
+func TestGetErrorInfo_Localized(t *testing.T) {
+	japanese := GetErrorInfo(ErrCodeUserExists, LangJapanese)
+	assert.Equal(t, ErrCodeUserExists, japanese.Code)
+	assert.Equal(t, "ユーザーは既に存在します", japanese.Message)
+	assert.Equal(t, http.StatusConflict, japanese.HTTPStatus)
+
+	assert.Equal(t, GetErrorInfo(ErrCodeUserExists, LangEnglish), GetErrorInfo(ErrCodeUserExists, "fr"))
+	assert.Equal(t, "Unknown error", GetErrorInfo(ErrorCode("UNKNOWN"), LangJapanese).Message)
+}
+
+func TestAppError_InfoKeepsDescription(t *testing.T) {
+	err := New(ErrCodeNotFound, "no such page").WithDescription("Page not found")
+
+	info := err.Info(LangJapanese)
+	assert.Equal(t, "リソースが見つかりません", info.Message)
+	assert.Equal(t, "Page not found", info.Description)
+}
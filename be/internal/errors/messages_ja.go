@@ -0,0 +1,43 @@
+package errors
+
+// japaneseMessages is the Japanese error message catalog. Codes missing from it fall back to English.
+var japaneseMessages = map[ErrorCode]localizedMessage{
+	ErrCodeInternalError:        {"サーバー内部エラー", "サーバーで予期しないエラーが発生しました"},
+	ErrCodeInvalidRequest:       {"不正なリクエスト", "リクエストの形式が正しくありません"},
+	ErrCodeValidationFailed:     {"入力内容に誤りがあります", "1つ以上の項目が検証に失敗しました"},
+	ErrCodeNotFound:             {"リソースが見つかりません", "リクエストされたリソースは見つかりませんでした"},
+	ErrCodeUnauthorized:         {"認証されていません", "このリソースにアクセスするには認証が必要です"},
+	ErrCodeForbidden:            {"アクセスが拒否されました", "このリソースにアクセスする権限がありません"},
+	ErrCodeConflict:             {"競合が発生しました", "リクエストがリソースの現在の状態と競合しています"},
+	ErrCodeTooManyRequests:      {"リクエストが多すぎます", "短時間に多くのリクエストが送信されました。しばらくしてから再試行してください"},
+	ErrCodeMethodNotAllowed:     {"許可されていないメソッドです", "このエンドポイントはリクエストのメソッドに対応していません"},
+	ErrCodeIdempotencyKeyReused: {"冪等キーが再利用されました", "このIdempotency-Keyは別のリクエストで既に使用されています"},
+	ErrCodePreconditionRequired: {"前提条件が必要です", "変更するバージョンをIf-Matchヘッダーまたはversionフィールドで指定してください"},
+
+	ErrCodeInvalidCredentials: {"認証情報が正しくありません", "メールアドレスまたはパスワードが正しくありません"},
+	ErrCodeUserNotFound:       {"ユーザーが見つかりません", "指定されたメールアドレスのユーザーは見つかりませんでした"},
+	ErrCodeUserExists:         {"ユーザーは既に存在します", "このメールアドレスのユーザーは既に登録されています"},
+	ErrCodeTokenExpired:       {"トークンの有効期限が切れています", "認証トークンの有効期限が切れています"},
+	ErrCodeTokenInvalid:       {"トークンが無効です", "認証トークンが無効か、形式が正しくありません"},
+
+	ErrCodeEmailRequired:       {"メールアドレスは必須です", "メールアドレスを入力してください"},
+	ErrCodeEmailInvalid:        {"メールアドレスの形式が正しくありません", "メールアドレスの形式が正しくありません"},
+	ErrCodePasswordRequired:    {"パスワードは必須です", "パスワードを入力してください"},
+	ErrCodePasswordTooShort:    {"パスワードが短すぎます", "パスワードは8文字以上で入力してください"},
+	ErrCodePasswordTooLong:     {"パスワードが長すぎます", "パスワードは128文字以下で入力してください"},
+	ErrCodePasswordComplexity:  {"パスワードの要件を満たしていません", "パスワードには小文字、大文字、記号をそれぞれ1文字以上含めてください"},
+	ErrCodeDisplayNameRequired: {"表示名は必須です", "表示名を入力してください"},
+	ErrCodeDisplayNameTooLong:  {"表示名が長すぎます", "表示名は100文字以下で入力してください"},
+	ErrCodePasswordBreached:    {"このパスワードは漏洩しています", "過去のデータ漏洩で流出したパスワードです。別のパスワードを設定してください"},
+
+	ErrCodeEmailNotVerified:   {"メールアドレスが確認されていません", "この操作を行うにはメールアドレスの確認が必要です"},
+	ErrCodeAccountDisabled:    {"アカウントが無効です", "このアカウントは無効化されています"},
+	ErrCodeAccountDeleted:     {"アカウントは削除されています", "このアカウントは削除されています"},
+	ErrCodeCaptchaFailed:      {"CAPTCHAの検証に失敗しました", "CAPTCHAの応答がないか、検証できませんでした"},
+	ErrCodeIdentityLinked:     {"既に連携されているアカウントです", "このプロバイダーのアカウントは既に別のユーザーと連携されています"},
+	ErrCodeLastLoginMethod:    {"最後のログイン方法です", "アカウントには少なくとも1つのログイン方法が必要です"},
+	ErrCodeStepUpRequired:     {"再認証が必要です", "この操作を行うにはパスワードを確認するか、再度ログインしてください"},
+	ErrCodeInvitationRequired: {"招待が必要です", "招待制のため、招待メールのリンクから登録してください"},
+	ErrCodeInvitationInvalid:  {"無効な招待です", "招待が無効か、期限切れか、使用済みか、別のメールアドレス宛てです"},
+	ErrCodeSessionLimit:       {"セッション数の上限に達しました", "ログインできる端末数の上限に達しています。いずれかの端末でログアウトしてから再度ログインしてください"},
+}
@@ -0,0 +1,123 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"strikepad-backend/internal/publicid"
+)
+
+// Event types
+const (
+	// UserCreated is published when an account is registered
+	UserCreated = "user.created"
+	// UserDeleted is published when an account is deleted
+	UserDeleted = "user.deleted"
+	// SessionRevoked is published when a user revokes one of their sessions
+	SessionRevoked = "session.revoked"
+)
+
+// All subscribes a handler to every event type
+const All = "*"
+
+// ErrUnknownType is returned for event types that are never published
+var ErrUnknownType = errors.New("unknown event type")
+
+// Types lists the event types that can be published, e.g. to validate webhook subscriptions
+var Types = []string{UserCreated, UserDeleted, SessionRevoked}
+
+// IsType reports whether eventType is a known event type
+func IsType(eventType string) bool {
+	return slices.Contains(Types, eventType)
+}
+
+// Event is a change in the domain that other parts of the system, and webhook endpoints, may react to
+type Event struct {
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+}
+
+// New creates an event of the given type that occurred now
+func New(eventType string, data any) Event {
+	return Event{
+		ID:         publicid.New(publicid.PrefixEvent),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC().Truncate(time.Second),
+		Data:       data,
+	}
+}
+
+// UserData is the data of user events. IDs are public IDs.
+type UserData struct {
+	ID           string `json:"id"`
+	Email        string `json:"email,omitempty"`
+	DisplayName  string `json:"display_name"`
+	ProviderType string `json:"provider_type"`
+}
+
+// SessionData is the data of session events. IDs are public IDs.
+type SessionData struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// Handler reacts to an event. Handlers run on the publisher's goroutine, so they should be quick
+// and hand slow work, such as network calls, to the outbox.
+type Handler func(ctx context.Context, event Event)
+
+// Bus delivers published events to the handlers subscribed to their type
+type Bus interface {
+	Publish(ctx context.Context, event Event)
+	Subscribe(eventType string, handler Handler)
+}
+
+// MemoryBus is an in-process Bus. Events are lost if the process exits while they are handled,
+// so handlers that must not miss events record them durably, e.g. in the outbox.
+type MemoryBus struct {
+	handlers map[string][]Handler
+	mu       sync.RWMutex
+}
+
+// NewBus creates a new in-process event bus
+func NewBus() Bus {
+	return &MemoryBus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// Subscribe registers handler for events of eventType, or for all events when eventType is All
+func (b *MemoryBus) Subscribe(eventType string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish calls the handlers subscribed to the event's type, then those subscribed to all events.
+// A panicking handler is logged and does not affect the publisher or the other handlers.
+func (b *MemoryBus) Publish(ctx context.Context, event Event) {
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers[event.Type])+len(b.handlers[All]))
+	handlers = append(handlers, b.handlers[event.Type]...)
+	handlers = append(handlers, b.handlers[All]...)
+	b.mu.RUnlock()
+
+	slog.Debug("Publishing event", "event_id", event.ID, "type", event.Type, "handlers", len(handlers))
+	for _, handler := range handlers {
+		call(ctx, handler, event)
+	}
+}
+
+func call(ctx context.Context, handler Handler, event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Event handler panicked", "event_id", event.ID, "type", event.Type, "panic", r)
+		}
+	}()
+	handler(ctx, event)
+}
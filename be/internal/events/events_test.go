@@ -0,0 +1,60 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/publicid"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	event := events.New(events.UserCreated, events.UserData{ID: "usr_5k2d9x0q7m3hv8c1b4na"})
+
+	assert.NoError(t, publicid.Validate(publicid.PrefixEvent, event.ID))
+	assert.Equal(t, events.UserCreated, event.Type)
+	assert.False(t, event.OccurredAt.IsZero())
+	assert.Equal(t, events.UserData{ID: "usr_5k2d9x0q7m3hv8c1b4na"}, event.Data)
+}
+
+func TestMemoryBus_Publish(t *testing.T) {
+	bus := events.NewBus()
+	var received []string
+	bus.Subscribe(events.UserCreated, func(_ context.Context, event events.Event) {
+		received = append(received, "user.created handler: "+event.Type)
+	})
+	bus.Subscribe(events.SessionRevoked, func(_ context.Context, event events.Event) {
+		received = append(received, "session.revoked handler: "+event.Type)
+	})
+	bus.Subscribe(events.All, func(_ context.Context, event events.Event) {
+		received = append(received, "all handler: "+event.Type)
+	})
+
+	bus.Publish(context.Background(), events.New(events.UserCreated, nil))
+
+	assert.Equal(t, []string{"user.created handler: user.created", "all handler: user.created"}, received)
+}
+
+func TestMemoryBus_PublishRecoversFromPanics(t *testing.T) {
+	bus := events.NewBus()
+	called := false
+	bus.Subscribe(events.UserCreated, func(context.Context, events.Event) {
+		panic("boom")
+	})
+	bus.Subscribe(events.UserCreated, func(context.Context, events.Event) {
+		called = true
+	})
+
+	assert.NotPanics(t, func() {
+		bus.Publish(context.Background(), events.New(events.UserCreated, nil))
+	})
+	assert.True(t, called)
+}
+
+func TestIsType(t *testing.T) {
+	assert.True(t, events.IsType(events.SessionRevoked))
+	assert.False(t, events.IsType("pad.updated"))
+	assert.False(t, events.IsType(events.All))
+}
@@ -0,0 +1,74 @@
+// Package featureflags decides which features are enabled for a user, so that risky features can
+// be rolled out gradually and turned off without a deploy. Flags come from the environment, from a
+// JSON file, or from an Unleash-compatible server.
+package featureflags
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/config"
+)
+
+const (
+	// BackendEnv reads the flags from FEATURE_FLAGS and is the default
+	BackendEnv = "env"
+	// BackendFile reads the flags from the JSON file at FEATURE_FLAGS_FILE
+	BackendFile = "file"
+	// BackendUnleash fetches the flags from the Unleash-compatible server at UNLEASH_URL
+	BackendUnleash = "unleash"
+)
+
+// Flags tells whether features are enabled
+type Flags interface {
+	// IsEnabled reports whether the feature named key is enabled for the user with the public ID.
+	// userID is empty for anonymous requests, which are left out of partial rollouts. Unknown
+	// features are disabled.
+	IsEnabled(ctx context.Context, key string, userID string) bool
+}
+
+// New returns the flags selected by FEATURE_FLAGS_BACKEND. When the selected backend cannot be
+// used, every feature is disabled.
+func New() Flags {
+	backend := strings.ToLower(config.GetEnv("FEATURE_FLAGS_BACKEND", BackendEnv))
+	switch backend {
+	case BackendEnv:
+		flags, err := ParseEnv(config.GetEnv("FEATURE_FLAGS", ""))
+		if err != nil {
+			return unconfiguredBackend(backend, err.Error())
+		}
+		return flags
+	case BackendFile:
+		path := config.GetEnv("FEATURE_FLAGS_FILE", "")
+		if path == "" {
+			return unconfiguredBackend(backend, "FEATURE_FLAGS_FILE is empty")
+		}
+		flags, err := LoadFile(path)
+		if err != nil {
+			return unconfiguredBackend(backend, err.Error())
+		}
+		return flags
+	case BackendUnleash:
+		rawURL := config.GetEnv("UNLEASH_URL", "")
+		if rawURL == "" {
+			return unconfiguredBackend(backend, "UNLEASH_URL is empty")
+		}
+		return NewUnleash(UnleashConfig{
+			URL:             rawURL,
+			APIToken:        config.GetEnv("UNLEASH_API_TOKEN", ""),
+			AppName:         config.GetEnv("UNLEASH_APP_NAME", "strikepad-backend"),
+			RefreshInterval: time.Duration(config.GetEnvInt("UNLEASH_REFRESH_SECONDS", 15)) * time.Second,
+		})
+	default:
+		return unconfiguredBackend(backend, "unknown backend")
+	}
+}
+
+// unconfiguredBackend disables every feature when the selected backend cannot be used
+func unconfiguredBackend(backend, reason string) Flags {
+	slog.Warn("Feature flag backend is not configured, all features are disabled",
+		"backend", backend, "reason", reason)
+	return &Static{}
+}
@@ -0,0 +1,87 @@
+package featureflags
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flags.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"passkeys": {"enabled": true}}`), 0o600))
+
+	tests := []struct {
+		env         map[string]string
+		name        string
+		wantUnleash bool
+		wantEnabled bool
+	}{
+		{name: "default", env: map[string]string{"FEATURE_FLAGS": "passkeys"}, wantEnabled: true},
+		{name: "file", env: map[string]string{"FEATURE_FLAGS_BACKEND": "file", "FEATURE_FLAGS_FILE": path}, wantEnabled: true},
+		{name: "file without path", env: map[string]string{"FEATURE_FLAGS_BACKEND": "file"}},
+		{name: "invalid env", env: map[string]string{"FEATURE_FLAGS": "passkeys=many"}},
+		{name: "unleash", env: map[string]string{"FEATURE_FLAGS_BACKEND": "unleash", "UNLEASH_URL": "http://unleash/api"}, wantUnleash: true},
+		{name: "unleash without URL", env: map[string]string{"FEATURE_FLAGS_BACKEND": "unleash"}},
+		{name: "unknown", env: map[string]string{"FEATURE_FLAGS_BACKEND": "launchdarkly"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"FEATURE_FLAGS_BACKEND", "FEATURE_FLAGS", "FEATURE_FLAGS_FILE", "UNLEASH_URL"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			flags := New()
+
+			if tt.wantUnleash {
+				assert.IsType(t, &Unleash{}, flags)
+				return
+			}
+			assert.IsType(t, &Static{}, flags)
+			assert.Equal(t, tt.wantEnabled, flags.IsEnabled(context.Background(), "passkeys", "usr_5k2d9x0q7m3hv8c1b4na"))
+		})
+	}
+}
+
+func TestParseEnv(t *testing.T) {
+	flags, err := ParseEnv(" passkeys , new_editor=0,beta=100")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	assert.True(t, flags.IsEnabled(ctx, "passkeys", ""))
+	assert.False(t, flags.IsEnabled(ctx, "new_editor", "usr_5k2d9x0q7m3hv8c1b4na"))
+	assert.True(t, flags.IsEnabled(ctx, "beta", ""))
+	assert.False(t, flags.IsEnabled(ctx, "unknown", "usr_5k2d9x0q7m3hv8c1b4na"))
+
+	_, err = ParseEnv("passkeys=101")
+	assert.Error(t, err)
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "flags.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"passkeys": {"enabled": true, "rollout": 0, "users": ["usr_5k2d9x0q7m3hv8c1b4na"]},
+		"new_editor": {"enabled": false}
+	}`), 0o600))
+
+	flags, err := LoadFile(path)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	assert.True(t, flags.IsEnabled(ctx, "passkeys", "usr_5k2d9x0q7m3hv8c1b4na"))
+	assert.False(t, flags.IsEnabled(ctx, "passkeys", "usr_0123456789abcdefghjk"))
+	assert.False(t, flags.IsEnabled(ctx, "new_editor", "usr_5k2d9x0q7m3hv8c1b4na"))
+
+	invalid := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"passkeys": {"enabled": true, "rollout": 150}}`), 0o600))
+	_, err = LoadFile(invalid)
+	assert.Error(t, err)
+
+	_, err = LoadFile(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package featureflags
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// inRollout reports whether the user falls within the first percentage of users for the group.
+// Anonymous users only fall within a full rollout. Users are bucketed like Unleash does, so that a
+// rollout keeps the same users when it moves between the static backends and an Unleash server.
+func inRollout(groupID, userID string, percentage int) bool {
+	if percentage >= 100 {
+		return true
+	}
+	if percentage <= 0 || userID == "" {
+		return false
+	}
+	return normalizedValue(groupID, userID) <= percentage
+}
+
+// normalizedValue maps the user to a bucket between 1 and 100 for the group
+func normalizedValue(groupID, userID string) int {
+	return int(murmur3([]byte(groupID+":"+userID), 0)%100) + 1
+}
+
+// murmur3 is the 32-bit x86 variant of MurmurHash3
+func murmur3(data []byte, seed uint32) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+
+	h := seed
+	blocks := len(data) / 4
+	for i := 0; i < blocks; i++ {
+		k := binary.LittleEndian.Uint32(data[i*4:])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+
+	tail := data[blocks*4:]
+	var k uint32
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
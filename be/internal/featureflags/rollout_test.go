@@ -0,0 +1,41 @@
+package featureflags
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMurmur3(t *testing.T) {
+	assert.Equal(t, uint32(0), murmur3(nil, 0))
+	assert.Equal(t, uint32(0x248bfa47), murmur3([]byte("hello"), 0))
+	assert.Equal(t, uint32(0x2e4ff723), murmur3([]byte("The quick brown fox jumps over the lazy dog"), 0))
+}
+
+func TestInRollout(t *testing.T) {
+	t.Run("anonymous users only get full rollouts", func(t *testing.T) {
+		assert.True(t, inRollout("passkeys", "", 100))
+		assert.False(t, inRollout("passkeys", "", 99))
+	})
+
+	t.Run("a larger rollout keeps its users", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			userID := fmt.Sprintf("usr_%d", i)
+			if inRollout("passkeys", userID, 10) {
+				assert.True(t, inRollout("passkeys", userID, 50), userID)
+			}
+			assert.False(t, inRollout("passkeys", userID, 0), userID)
+		}
+	})
+
+	t.Run("users are spread over the buckets", func(t *testing.T) {
+		enabled := 0
+		for i := 0; i < 10000; i++ {
+			if inRollout("passkeys", fmt.Sprintf("usr_%d", i), 25) {
+				enabled++
+			}
+		}
+		assert.InDelta(t, 2500, enabled, 250)
+	})
+}
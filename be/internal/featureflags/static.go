@@ -0,0 +1,103 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Flag is the rollout of a feature
+type Flag struct {
+	// Users lists the public IDs of users the feature is enabled for whatever the rollout
+	Users []string `json:"users,omitempty"`
+	// Rollout is the percentage of users the feature is enabled for, 100 when omitted
+	Rollout *int `json:"rollout,omitempty"`
+	// Enabled turns the feature on; when false, nobody has it
+	Enabled bool `json:"enabled"`
+}
+
+// Static holds flags that only change on restart, read from the environment or a file
+type Static struct {
+	flags map[string]Flag
+}
+
+// NewStatic creates flags from their rollouts by feature name
+func NewStatic(flags map[string]Flag) *Static {
+	return &Static{flags: flags}
+}
+
+// ParseEnv parses a comma-separated list of feature names, such as FEATURE_FLAGS. A name enables
+// the feature for everyone, and name=N for N percent of users, e.g. "passkeys=10,new_editor".
+func ParseEnv(value string) (*Static, error) {
+	flags := make(map[string]Flag)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, percentage, hasRollout := strings.Cut(item, "=")
+		flag := Flag{Enabled: true}
+		if hasRollout {
+			rollout, err := parseRollout(percentage)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rollout of feature %s: %w", name, err)
+			}
+			flag.Rollout = &rollout
+		}
+		flags[strings.TrimSpace(name)] = flag
+	}
+	return NewStatic(flags), nil
+}
+
+// LoadFile reads flags from a JSON file mapping feature names to their rollout, e.g.
+// {"passkeys": {"enabled": true, "rollout": 10, "users": ["usr_5k2d9x0q7m3hv8c1b4na"]}}
+func LoadFile(path string) (*Static, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feature flags: %w", err)
+	}
+
+	var flags map[string]Flag
+	if err := json.Unmarshal(content, &flags); err != nil {
+		return nil, fmt.Errorf("failed to parse feature flags: %w", err)
+	}
+	for name, flag := range flags {
+		if flag.Rollout != nil && (*flag.Rollout < 0 || *flag.Rollout > 100) {
+			return nil, fmt.Errorf("invalid rollout of feature %s: %d is not a percentage", name, *flag.Rollout)
+		}
+	}
+	return NewStatic(flags), nil
+}
+
+// IsEnabled reports whether the feature named key is enabled for the user. Users are assigned to
+// a partial rollout by a hash of the feature name and their ID, so that each user keeps the same
+// answer and a larger rollout only adds users.
+func (s *Static) IsEnabled(_ context.Context, key string, userID string) bool {
+	flag, ok := s.flags[key]
+	if !ok || !flag.Enabled {
+		return false
+	}
+	if userID != "" && slices.Contains(flag.Users, userID) {
+		return true
+	}
+	if flag.Rollout == nil {
+		return true
+	}
+	return inRollout(key, userID, *flag.Rollout)
+}
+
+// parseRollout parses a percentage between 0 and 100
+func parseRollout(value string) (int, error) {
+	rollout, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	if rollout < 0 || rollout > 100 {
+		return 0, fmt.Errorf("%d is not a percentage", rollout)
+	}
+	return rollout, nil
+}
@@ -0,0 +1,218 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Unleash strategies that are evaluated. Features whose strategies are all of other kinds, or carry
+// constraints, are disabled.
+const (
+	strategyDefault       = "default"
+	strategyUserWithID    = "userWithId"
+	strategyFlexible      = "flexibleRollout"
+	strategyGradualUserID = "gradualRolloutUserId"
+)
+
+// UnleashConfig configures the Unleash backend
+type UnleashConfig struct {
+	// URL is the base URL of the Unleash API, e.g. https://unleash.example.com/api
+	URL string
+	// APIToken is a client (server-side SDK) token
+	APIToken string
+	// AppName identifies the application to the server
+	AppName string
+	// RefreshInterval is how often the features are fetched again
+	RefreshInterval time.Duration
+}
+
+// Unleash evaluates flags fetched from an Unleash-compatible server, like its server-side SDKs:
+// the features are fetched in the background and evaluated locally, so checking a flag never waits
+// on the network. Until the first fetch succeeds every feature is disabled; when a fetch fails the
+// last features fetched are kept.
+type Unleash struct {
+	httpClient *http.Client
+	features   map[string]unleashFeature
+	config     UnleashConfig
+	etag       string
+	mu         sync.RWMutex
+}
+
+// unleashFeature is a feature of the client features API
+type unleashFeature struct {
+	Name       string            `json:"name"`
+	Strategies []unleashStrategy `json:"strategies"`
+	Enabled    bool              `json:"enabled"`
+}
+
+// unleashStrategy is an activation strategy of a feature
+type unleashStrategy struct {
+	Parameters  map[string]any    `json:"parameters"`
+	Name        string            `json:"name"`
+	Constraints []json.RawMessage `json:"constraints"`
+}
+
+// NewUnleash creates the Unleash backend. Run fetches the features.
+func NewUnleash(config UnleashConfig) *Unleash {
+	return &Unleash{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		config:     config,
+	}
+}
+
+// Run fetches the features right away and then every refresh interval until ctx is canceled
+func (u *Unleash) Run(ctx context.Context) {
+	ticker := time.NewTicker(u.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.Refresh(ctx); err != nil {
+			slog.Error("Failed to fetch feature flags", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh fetches the features from the server
+func (u *Unleash) Refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		strings.TrimSuffix(u.config.URL, "/")+"/client/features", http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create feature flags request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("UNLEASH-APPNAME", u.config.AppName)
+	if u.config.APIToken != "" {
+		req.Header.Set("Authorization", u.config.APIToken)
+	}
+	u.mu.RLock()
+	if u.etag != "" {
+		req.Header.Set("If-None-Match", u.etag)
+	}
+	u.mu.RUnlock()
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach feature flag server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("feature flag server returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Features []unleashFeature `json:"features"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("failed to decode feature flags: %w", err)
+	}
+	features := make(map[string]unleashFeature, len(body.Features))
+	for _, feature := range body.Features {
+		features[feature.Name] = feature
+	}
+
+	u.mu.Lock()
+	u.features = features
+	u.etag = resp.Header.Get("ETag")
+	u.mu.Unlock()
+	return nil
+}
+
+// IsEnabled reports whether the feature named key is enabled for the user: it is enabled and one of
+// its strategies matches, or it has no strategies
+func (u *Unleash) IsEnabled(_ context.Context, key string, userID string) bool {
+	u.mu.RLock()
+	feature, ok := u.features[key]
+	u.mu.RUnlock()
+	if !ok || !feature.Enabled {
+		return false
+	}
+	if len(feature.Strategies) == 0 {
+		return true
+	}
+	for _, strategy := range feature.Strategies {
+		if strategy.matches(feature.Name, userID) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether the strategy enables the feature for the user
+func (s unleashStrategy) matches(feature, userID string) bool {
+	if len(s.Constraints) > 0 {
+		return false
+	}
+
+	switch s.Name {
+	case strategyDefault:
+		return true
+	case strategyUserWithID:
+		if userID == "" {
+			return false
+		}
+		for _, id := range strings.Split(s.parameter("userIds"), ",") {
+			if strings.TrimSpace(id) == userID {
+				return true
+			}
+		}
+		return false
+	case strategyFlexible:
+		stickiness := s.parameter("stickiness")
+		if !slices.Contains([]string{"", "default", "userId"}, stickiness) {
+			return false
+		}
+		return inRollout(s.groupID(feature), userID, s.percentage("rollout"))
+	case strategyGradualUserID:
+		return inRollout(s.groupID(feature), userID, s.percentage("percentage"))
+	default:
+		return false
+	}
+}
+
+// parameter returns a parameter of the strategy as a string. Servers send them as strings, but
+// some send numbers.
+func (s unleashStrategy) parameter(name string) string {
+	switch value := s.Parameters[name].(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// percentage returns a percentage parameter of the strategy, 0 when it is missing or invalid
+func (s unleashStrategy) percentage(name string) int {
+	percentage, err := parseRollout(s.parameter(name))
+	if err != nil {
+		return 0
+	}
+	return percentage
+}
+
+// groupID returns the group users are bucketed in, the feature name by default
+func (s unleashStrategy) groupID(feature string) string {
+	if groupID := s.parameter("groupId"); groupID != "" {
+		return groupID
+	}
+	return feature
+}
@@ -0,0 +1,92 @@
+package featureflags
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const unleashFeatures = `{"version": 2, "features": [
+	{"name": "passkeys", "enabled": true, "strategies": [
+		{"name": "userWithId", "parameters": {"userIds": "usr_5k2d9x0q7m3hv8c1b4na, usr_0123456789abcdefghjk"}},
+		{"name": "flexibleRollout", "parameters": {"rollout": "0", "stickiness": "default", "groupId": "passkeys"}}
+	]},
+	{"name": "new_editor", "enabled": true, "strategies": []},
+	{"name": "beta", "enabled": true, "strategies": [{"name": "gradualRolloutUserId", "parameters": {"percentage": 100}}]},
+	{"name": "constrained", "enabled": true, "strategies": [
+		{"name": "default", "constraints": [{"contextName": "environment", "operator": "IN", "values": ["prod"]}]}
+	]},
+	{"name": "remote_address", "enabled": true, "strategies": [{"name": "remoteAddress", "parameters": {"IPs": "127.0.0.1"}}]},
+	{"name": "killed", "enabled": false, "strategies": [{"name": "default"}]}
+]}`
+
+func TestUnleash(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/client/features", r.URL.Path)
+		assert.Equal(t, "client-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "strikepad-backend", r.Header.Get("UNLEASH-APPNAME"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(unleashFeatures))
+	}))
+	defer server.Close()
+
+	unleash := NewUnleash(UnleashConfig{URL: server.URL + "/api/", APIToken: "client-token", AppName: "strikepad-backend"})
+	ctx := context.Background()
+	assert.False(t, unleash.IsEnabled(ctx, "new_editor", ""), "features are disabled until fetched")
+
+	require.NoError(t, unleash.Refresh(ctx))
+	require.NoError(t, unleash.Refresh(ctx))
+	assert.Equal(t, 2, requests)
+
+	tests := []struct {
+		name     string
+		key      string
+		userID   string
+		expected bool
+	}{
+		{name: "listed user", key: "passkeys", userID: "usr_0123456789abcdefghjk", expected: true},
+		{name: "user outside the rollout", key: "passkeys", userID: "usr_9c4m1x7k2d5v8h0q3b6n"},
+		{name: "anonymous user", key: "passkeys"},
+		{name: "no strategies", key: "new_editor", expected: true},
+		{name: "numeric percentage", key: "beta", userID: "usr_9c4m1x7k2d5v8h0q3b6n", expected: true},
+		{name: "constraints are not evaluated", key: "constrained", userID: "usr_9c4m1x7k2d5v8h0q3b6n"},
+		{name: "unsupported strategy", key: "remote_address", userID: "usr_9c4m1x7k2d5v8h0q3b6n"},
+		{name: "disabled feature", key: "killed", userID: "usr_9c4m1x7k2d5v8h0q3b6n"},
+		{name: "unknown feature", key: "unknown", userID: "usr_9c4m1x7k2d5v8h0q3b6n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, unleash.IsEnabled(ctx, tt.key, tt.userID))
+		})
+	}
+}
+
+func TestUnleash_RefreshKeepsFeaturesOnError(t *testing.T) {
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(unleashFeatures))
+	}))
+	defer server.Close()
+
+	unleash := NewUnleash(UnleashConfig{URL: server.URL + "/api"})
+	ctx := context.Background()
+	require.NoError(t, unleash.Refresh(ctx))
+
+	fail = true
+	assert.Error(t, unleash.Refresh(ctx))
+	assert.True(t, unleash.IsEnabled(ctx, "new_editor", ""))
+}
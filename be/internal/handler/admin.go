@@ -0,0 +1,165 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultUserPageSize = 20
+	maxUserPageSize     = 100
+
+	defaultSessionPageSize = 50
+	maxSessionPageSize     = 200
+)
+
+// AdminHandler handles the user administration endpoints of the admin API
+type AdminHandler struct {
+	adminService service.AdminServiceInterface
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(adminService service.AdminServiceInterface) AdminHandlerInterface {
+	return &AdminHandler{
+		adminService: adminService,
+	}
+}
+
+// ListUsers returns a page of users, filtered and sorted by the query parameters. Sorted by ID or
+// creation time, pages may follow a cursor.
+func (h *AdminHandler) ListUsers(c echo.Context) error {
+	params, err := pagination.ParseCursorQuery(c.QueryParams(), defaultUserPageSize, maxUserPageSize)
+	if err != nil {
+		return invalidPaginationError(err)
+	}
+
+	response, err := h.adminService.ListUsers(repository.ListParams{
+		Params:       params,
+		Sort:         c.QueryParam("sort"),
+		ProviderType: c.QueryParam("provider_type"),
+		EmailPrefix:  c.QueryParam("email_prefix"),
+	})
+	if err != nil {
+		if stderrors.Is(err, repository.ErrInvalidSort) {
+			return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid sort").WithDescription(
+				"sort must be one of id, created_at, display_name or email, optionally prefixed with -")
+		}
+		if stderrors.Is(err, repository.ErrCursorSort) {
+			return invalidPaginationError(repository.ErrCursorSort)
+		}
+		if stderrors.Is(err, pagination.ErrInvalidCursor) {
+			return invalidPaginationError(pagination.ErrInvalidCursor)
+		}
+		slog.Error("Failed to list users", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListUserSessions returns a page of the active sessions of the user in the path, newest first
+func (h *AdminHandler) ListUserSessions(c echo.Context) error {
+	userID := c.Param("id")
+	if err := publicid.Validate(publicid.PrefixUser, userID); err != nil {
+		return errInvalidUserID
+	}
+
+	params, err := pagination.ParseCursorQuery(c.QueryParams(), defaultSessionPageSize, maxSessionPageSize)
+	if err != nil {
+		return invalidPaginationError(err)
+	}
+
+	page, err := h.adminService.ListUserSessions(userID, params)
+	if err != nil {
+		if stderrors.Is(err, pagination.ErrInvalidCursor) {
+			return invalidPaginationError(pagination.ErrInvalidCursor)
+		}
+		return adminUserError(err, "listing sessions")
+	}
+
+	infos := make([]dto.SessionInfo, 0, len(page.Items))
+	for i := range page.Items {
+		session := &page.Items[i]
+		infos = append(infos, dto.SessionInfo{
+			ID:         session.PublicID,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastActivityAt(),
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			DeviceName: session.DeviceName,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.SessionListResponse(dto.NewListResponse(infos, page.Meta(params))))
+}
+
+// DisableUser disables the user in the path and ends all of their sessions
+func (h *AdminHandler) DisableUser(c echo.Context) error {
+	return h.setUserDisabled(c, true)
+}
+
+// EnableUser re-enables the user in the path
+func (h *AdminHandler) EnableUser(c echo.Context) error {
+	return h.setUserDisabled(c, false)
+}
+
+// setUserDisabled changes whether the user in the path may log in
+func (h *AdminHandler) setUserDisabled(c echo.Context, disabled bool) error {
+	userID := c.Param("id")
+	if err := publicid.Validate(publicid.PrefixUser, userID); err != nil {
+		return errInvalidUserID
+	}
+
+	response, err := h.adminService.SetUserDisabled(userID, disabled)
+	if err != nil {
+		return adminUserError(err, "changing disabled state")
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ForceLogout ends all sessions of the user in the path
+func (h *AdminHandler) ForceLogout(c echo.Context) error {
+	userID := c.Param("id")
+	if err := publicid.Validate(publicid.PrefixUser, userID); err != nil {
+		return errInvalidUserID
+	}
+
+	if err := h.adminService.ForceLogout(userID); err != nil {
+		return adminUserError(err, "forcing logout")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "User logged out from all sessions",
+	})
+}
+
+// errInvalidUserID rejects a user ID path parameter that is not a user public ID
+var errInvalidUserID = errors.New(errors.ErrCodeInvalidRequest, "invalid user ID").
+	WithDescription("Invalid user ID")
+
+// adminUserError maps errors from admin actions on a single user to the errors for the client
+func adminUserError(err error, action string) error {
+	if stderrors.Is(err, auth.ErrUserNotFound) {
+		return errors.Wrap(errors.ErrCodeNotFound, err, "user not found").
+			WithDescription("No user found with the provided ID")
+	}
+	if stderrors.Is(err, repository.ErrStaleVersion) {
+		return errors.Wrap(errors.ErrCodeConflict, err, "stale user").
+			WithDescription("The user was changed by another request, please retry")
+	}
+
+	slog.Error("Internal error while "+action, "error", err)
+	return err
+}
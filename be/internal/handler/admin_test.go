@@ -0,0 +1,240 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAdminHandler_ListUsers(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		query          string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:           "lists users",
+			query:          "?page=2&per_page=10&include_total=true&sort=-created_at&email_prefix=a",
+			expectCall:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid include_total",
+			query:          "?include_total=sometimes",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "invalid page",
+			query:          "?page=0",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "per_page too large",
+			query:          "?per_page=1000",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "invalid sort",
+			query:          "?sort=password_hash",
+			expectCall:     true,
+			serviceErr:     repository.ErrInvalidSort,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "cursor with page",
+			query:          "?page=2&cursor=usr_5k2d9x0q7m3hv8c1b4na",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "cursor with a sort that has no keyset",
+			query:          "?sort=email&cursor=usr_5k2d9x0q7m3hv8c1b4na",
+			expectCall:     true,
+			serviceErr:     repository.ErrCursorSort,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "unknown cursor",
+			query:          "?cursor=usr_5k2d9x0q7m3hv8c1b4na",
+			expectCall:     true,
+			serviceErr:     pagination.ErrInvalidCursor,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "internal error",
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminService := mocks.NewMockAdminServiceInterface(t)
+			adminHandler := handler.NewAdminHandler(adminService)
+			if tt.expectCall {
+				var response *dto.AdminUserListResponse
+				if tt.serviceErr == nil {
+					response = &dto.AdminUserListResponse{
+						Data: []dto.AdminUserInfo{{ID: "usr_5k2d9x0q7m3hv8c1b4na"}},
+						Meta: pagination.Meta{Page: 2, PerPage: 10},
+					}
+				}
+				adminService.EXPECT().ListUsers(mock.AnythingOfType("repository.ListParams")).
+					Return(response, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/users"+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			serve(c, adminHandler.ListUsers)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_ListUserSessions(t *testing.T) {
+	adminService := mocks.NewMockAdminServiceInterface(t)
+	adminHandler := handler.NewAdminHandler(adminService)
+	params := pagination.Params{Page: 1, PerPage: 1, Cursor: "ses_5k2d9x0q7m3hv8c1b4na"}
+	adminService.EXPECT().ListUserSessions("usr_5k2d9x0q7m3hv8c1b4na", params).
+		Return(&pagination.Page[model.UserSession]{
+			Items:      []model.UserSession{{ID: 3, PublicID: "ses_0vd3m8k2q9x7h1c5b4nz", UserID: 1}},
+			HasMore:    true,
+			NextCursor: "ses_0vd3m8k2q9x7h1c5b4nz",
+		}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/admin/users/usr_5k2d9x0q7m3hv8c1b4na/sessions?per_page=1&cursor=ses_5k2d9x0q7m3hv8c1b4na", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("usr_5k2d9x0q7m3hv8c1b4na")
+
+	serve(c, adminHandler.ListUserSessions)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var response dto.SessionListResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, "ses_0vd3m8k2q9x7h1c5b4nz", response.Data[0].ID)
+	assert.True(t, response.HasMore)
+	assert.Equal(t, "ses_0vd3m8k2q9x7h1c5b4nz", response.NextCursor)
+}
+
+func TestAdminHandler_DisableUser(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		id             string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:           "disables user",
+			id:             "usr_5k2d9x0q7m3hv8c1b4na",
+			expectCall:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid user ID",
+			id:             "abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "numeric user ID",
+			id:             "1",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "user not found",
+			id:             "usr_5k2d9x0q7m3hv8c1b4na",
+			expectCall:     true,
+			serviceErr:     auth.ErrUserNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "E004",
+		},
+		{
+			name:           "internal error",
+			id:             "usr_5k2d9x0q7m3hv8c1b4na",
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminService := mocks.NewMockAdminServiceInterface(t)
+			adminHandler := handler.NewAdminHandler(adminService)
+			if tt.expectCall {
+				var info *dto.AdminUserInfo
+				if tt.serviceErr == nil {
+					info = &dto.AdminUserInfo{ID: tt.id, IsDisabled: true}
+				}
+				adminService.EXPECT().SetUserDisabled(tt.id, true).Return(info, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/users/"+tt.id+"/disable", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			serve(c, adminHandler.DisableUser)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_ForceLogout(t *testing.T) {
+	adminService := mocks.NewMockAdminServiceInterface(t)
+	adminHandler := handler.NewAdminHandler(adminService)
+	adminService.EXPECT().ForceLogout("usr_0000000000000000000z").Return(auth.ErrUserNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/users/usr_0000000000000000000z/logout", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("usr_0000000000000000000z")
+
+	serve(c, adminHandler.ForceLogout)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
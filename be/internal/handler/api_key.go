@@ -0,0 +1,94 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/validator"
+
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHandler handles the admin endpoints that manage API keys
+type APIKeyHandler struct {
+	apiKeyService service.APIKeyServiceInterface
+	validator     *validator.Validator
+}
+
+// NewAPIKeyHandler creates a new API key handler
+func NewAPIKeyHandler(apiKeyService service.APIKeyServiceInterface) APIKeyHandlerInterface {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+		validator:     validator.New(),
+	}
+}
+
+// CreateKey issues a new API key on behalf of the authenticated admin
+func (h *APIKeyHandler) CreateKey(c echo.Context) error {
+	session, ok := c.Get("session").(*model.UserSession)
+	if !ok || session == nil {
+		slog.Error("Failed to get session from JWT token")
+		return errMissingSession
+	}
+
+	var req dto.CreateAPIKeyRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for API key creation", "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "API key creation")
+	}
+
+	response, err := h.apiKeyService.CreateKey(&session.User, &req)
+	if err != nil {
+		slog.Error("Failed to create API key", "error", err, "user_id", session.UserID)
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListKeys returns all issued API keys without the keys themselves
+func (h *APIKeyHandler) ListKeys(c echo.Context) error {
+	response, err := h.apiKeyService.ListKeys()
+	if err != nil {
+		slog.Error("Failed to list API keys", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// RevokeKey revokes the API key in the path
+func (h *APIKeyHandler) RevokeKey(c echo.Context) error {
+	keyID := c.Param("id")
+	if err := publicid.Validate(publicid.PrefixAPIKey, keyID); err != nil {
+		return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid API key ID").WithDescription("Invalid API key ID")
+	}
+
+	if err := h.apiKeyService.RevokeKey(keyID); err != nil {
+		if stderrors.Is(err, repository.ErrAPIKeyNotFound) {
+			return errors.Wrap(errors.ErrCodeNotFound, err, "API key not found").
+				WithDescription("No API key found with the provided ID")
+		}
+
+		slog.Error("Failed to revoke API key", "error", err, "api_key_id", keyID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "API key revoked",
+	})
+}
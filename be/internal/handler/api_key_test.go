@@ -0,0 +1,171 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyHandler_CreateKey(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		body           string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:           "issues key",
+			body:           `{"name":"worker"}`,
+			expectCall:     true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing name",
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E003",
+		},
+		{
+			name:           "internal error",
+			body:           `{"name":"worker"}`,
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyService := mocks.NewMockAPIKeyServiceInterface(t)
+			apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+			session := &model.UserSession{UserID: 1, User: model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}}
+			if tt.expectCall {
+				var response *dto.APIKeyCreatedResponse
+				if tt.serviceErr == nil {
+					response = &dto.APIKeyCreatedResponse{Key: "sk_key", APIKeyInfo: dto.APIKeyInfo{ID: "key_8h2m4q0x9d7c3v1k5b6n", Name: "worker"}}
+				}
+				apiKeyService.EXPECT().CreateKey(&session.User, &dto.CreateAPIKeyRequest{Name: "worker"}).
+					Return(response, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/api-keys", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("session", session)
+
+			serve(c, apiKeyHandler.CreateKey)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+				return
+			}
+
+			var response dto.APIKeyCreatedResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "sk_key", response.Key)
+			assert.Equal(t, "key_8h2m4q0x9d7c3v1k5b6n", response.ID)
+		})
+	}
+}
+
+func TestAPIKeyHandler_ListKeys(t *testing.T) {
+	apiKeyService := mocks.NewMockAPIKeyServiceInterface(t)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+	apiKeyService.EXPECT().ListKeys().
+		Return(&dto.APIKeyListResponse{Data: []dto.APIKeyInfo{{ID: "key_8h2m4q0x9d7c3v1k5b6n", Name: "worker"}}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/api-keys", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	serve(c, apiKeyHandler.ListKeys)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"key"`)
+}
+
+func TestAPIKeyHandler_RevokeKey(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		id             string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:           "revokes key",
+			id:             "key_8h2m4q0x9d7c3v1k5b6n",
+			expectCall:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid key ID",
+			id:             "abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "user ID instead of key ID",
+			id:             "usr_5k2d9x0q7m3hv8c1b4na",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "key not found",
+			id:             "key_8h2m4q0x9d7c3v1k5b6n",
+			expectCall:     true,
+			serviceErr:     repository.ErrAPIKeyNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "E004",
+		},
+		{
+			name:           "internal error",
+			id:             "key_8h2m4q0x9d7c3v1k5b6n",
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyService := mocks.NewMockAPIKeyServiceInterface(t)
+			apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+			if tt.expectCall {
+				apiKeyService.EXPECT().RevokeKey(tt.id).Return(tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/admin/api-keys/"+tt.id, http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			serve(c, apiKeyHandler.RevokeKey)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultAuditLogPageSize = 50
+	maxAuditLogPageSize     = 200
+	defaultLoginPageSize    = 20
+	maxLoginPageSize        = 100
+)
+
+// AuditLogHandler handles the admin endpoint listing the audit log, and the endpoint listing the
+// sign-ins of the current user
+type AuditLogHandler struct {
+	auditService service.AuditServiceInterface
+}
+
+// NewAuditLogHandler creates a new audit log handler
+func NewAuditLogHandler(auditService service.AuditServiceInterface) AuditLogHandlerInterface {
+	return &AuditLogHandler{
+		auditService: auditService,
+	}
+}
+
+// ListAuditLogs returns a page of audit log entries, newest first, filtered by the query parameters
+// action, actor_id, target_id, and since and until (RFC 3339 times). The page is selected by page
+// or by cursor, the next_cursor of the previous page.
+func (h *AuditLogHandler) ListAuditLogs(c echo.Context) error {
+	params, err := pagination.ParseCursorQuery(c.QueryParams(), defaultAuditLogPageSize, maxAuditLogPageSize)
+	if err != nil {
+		return invalidPaginationError(err)
+	}
+
+	since, err := parseTimeQuery(c, "since")
+	if err != nil {
+		return invalidAuditLogQueryError(err, "since must be an RFC 3339 time")
+	}
+	until, err := parseTimeQuery(c, "until")
+	if err != nil {
+		return invalidAuditLogQueryError(err, "until must be an RFC 3339 time")
+	}
+
+	response, err := h.auditService.ListLogs(repository.AuditLogListParams{
+		Params:   params,
+		Action:   c.QueryParam("action"),
+		ActorID:  c.QueryParam("actor_id"),
+		TargetID: c.QueryParam("target_id"),
+		Since:    since,
+		Until:    until,
+	})
+	if err != nil {
+		if stderrors.Is(err, service.ErrUnknownAuditAction) {
+			return invalidAuditLogQueryError(err, "action is not a recorded audit action")
+		}
+		if stderrors.Is(err, pagination.ErrInvalidCursor) {
+			return invalidPaginationError(pagination.ErrInvalidCursor)
+		}
+		slog.Error("Failed to list audit logs", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListLogins returns a page of the sign-ins to the authenticated user's account, newest first,
+// including refused ones, so that users can spot activity that was not theirs. The page is selected
+// by page or by cursor, the next_cursor of the previous page.
+func (h *AuditLogHandler) ListLogins(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	params, err := pagination.ParseCursorQuery(c.QueryParams(), defaultLoginPageSize, maxLoginPageSize)
+	if err != nil {
+		return invalidPaginationError(err)
+	}
+
+	response, err := h.auditService.ListLogins(userID, params)
+	if err != nil {
+		if stderrors.Is(err, pagination.ErrInvalidCursor) {
+			return invalidPaginationError(pagination.ErrInvalidCursor)
+		}
+		slog.Error("Failed to list logins", "error", err, "user_id", userID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// parseTimeQuery parses the RFC 3339 time in the query parameter name, which may be absent
+func parseTimeQuery(c echo.Context, name string) (*time.Time, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// invalidAuditLogQueryError rejects an audit log filter that cannot be applied because of err
+func invalidAuditLogQueryError(err error, description string) error {
+	return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid audit log query").WithDescription(description)
+}
@@ -0,0 +1,264 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// allowAudit returns an audit service that accepts any entry
+func allowAudit() *mocks.MockAuditServiceInterface {
+	auditService := new(mocks.MockAuditServiceInterface)
+	auditService.On("Record", mock.Anything).Maybe()
+	auditService.On("RecordLoginFailure", mock.Anything, mock.Anything).Maybe()
+	return auditService
+}
+
+func TestAuditLogHandler_ListAuditLogs(t *testing.T) {
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		serviceErr     error
+		validateParams func(repository.AuditLogListParams) bool
+		name           string
+		query          string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:       "lists entries with filters",
+			query:      "?action=auth.login_failed&actor_id=usr_5k2d9x0q7m3hv8c1b4na&since=2026-10-01T00:00:00Z&per_page=20",
+			expectCall: true,
+			validateParams: func(params repository.AuditLogListParams) bool {
+				return params.Action == service.AuditActionLoginFailed &&
+					params.ActorID == "usr_5k2d9x0q7m3hv8c1b4na" &&
+					params.Since != nil && params.Since.Equal(since) &&
+					params.Until == nil &&
+					params.PerPage == 20
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid since",
+			query:          "?since=yesterday",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "per_page too large",
+			query:          "?per_page=1000",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:       "continues after a cursor",
+			query:      "?cursor=aud_3m8q1x5k9d2v7h0c4b6n",
+			expectCall: true,
+			validateParams: func(params repository.AuditLogListParams) bool {
+				return params.Cursor == "aud_3m8q1x5k9d2v7h0c4b6n"
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "cursor with page",
+			query:          "?cursor=aud_3m8q1x5k9d2v7h0c4b6n&page=2",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "unknown cursor",
+			query:          "?cursor=aud_0000000000000000000",
+			expectCall:     true,
+			serviceErr:     fmt.Errorf("failed to list audit logs: %w", pagination.ErrInvalidCursor),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "unknown action",
+			query:          "?action=pad.created",
+			expectCall:     true,
+			serviceErr:     service.ErrUnknownAuditAction,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "internal error",
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditService := mocks.NewMockAuditServiceInterface(t)
+			if tt.expectCall {
+				var response *dto.AuditLogListResponse
+				if tt.serviceErr == nil {
+					response = &dto.AuditLogListResponse{
+						Data: []dto.AuditLogInfo{{ID: "aud_3m8q1x5k9d2v7h0c4b6n", Action: service.AuditActionLoginFailed}},
+					}
+				}
+				var matcher any = mock.Anything
+				if tt.validateParams != nil {
+					matcher = mock.MatchedBy(tt.validateParams)
+				}
+				auditService.On("ListLogs", matcher).Return(response, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/audit-logs"+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			serve(echo.New().NewContext(req, rec), handler.NewAuditLogHandler(auditService).ListAuditLogs)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
+
+func TestAuditLogHandler_ListLogins(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		query          string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{name: "lists sign-ins", query: "?per_page=10", expectCall: true, expectedStatus: http.StatusOK},
+		{name: "per_page too large", query: "?per_page=1000", expectedStatus: http.StatusBadRequest, expectedCode: "E002"},
+		{
+			name:           "unknown cursor",
+			query:          "?cursor=aud_0000000000000000000",
+			expectCall:     true,
+			serviceErr:     fmt.Errorf("failed to list logins: %w", pagination.ErrInvalidCursor),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "internal error",
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditService := mocks.NewMockAuditServiceInterface(t)
+			if tt.expectCall {
+				var response *dto.LoginAttemptListResponse
+				if tt.serviceErr == nil {
+					response = &dto.LoginAttemptListResponse{
+						Data: []dto.LoginAttemptInfo{{Result: "failed", Method: "password", Reason: "E100"}},
+					}
+				}
+				auditService.EXPECT().ListLogins(uint(1), mock.AnythingOfType("pagination.Params")).
+					Return(response, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/logins"+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("user_id", uint(1))
+
+			serve(c, handler.NewAuditLogHandler(auditService).ListLogins)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Login_RecordsAudit(t *testing.T) {
+	tests := []struct {
+		loginErr       error
+		validateEntry  func(service.AuditEntry) bool
+		name           string
+		expectedStatus int
+	}{
+		{
+			name: "successful login",
+			validateEntry: func(entry service.AuditEntry) bool {
+				return entry.Action == service.AuditActionLoginSucceeded &&
+					entry.ActorID == "usr_5k2d9x0q7m3hv8c1b4na" &&
+					entry.IPAddress == "203.0.113.7" &&
+					entry.Metadata["method"] == "password"
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "wrong password",
+			loginErr: auth.ErrInvalidCredentials,
+			validateEntry: func(entry service.AuditEntry) bool {
+				return entry.Action == service.AuditActionLoginFailed &&
+					entry.ActorID == "" &&
+					entry.Metadata["method"] == "password" &&
+					entry.Metadata["reason"] == "E100"
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authService := new(mocks.MockAuthServiceInterface)
+			sessionService := new(mocks.MockSessionServiceInterface)
+			auditService := mocks.NewMockAuditServiceInterface(t)
+			if tt.loginErr != nil {
+				authService.On("Login", mock.Anything).Return(nil, tt.loginErr)
+			} else {
+				authService.On("Login", mock.Anything).Return(&dto.UserInfo{
+					ID: "usr_5k2d9x0q7m3hv8c1b4na", UserID: 1, Email: "test@example.com",
+				}, nil)
+				sessionService.On("CreateSession", uint(1), mock.Anything).Return(&auth.TokenPair{
+					AccessToken: "access", RefreshToken: "refresh", AccessTokenExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+			}
+			if tt.loginErr != nil {
+				auditService.On("RecordLoginFailure", mock.MatchedBy(tt.validateEntry), "test@example.com").Once()
+			} else {
+				auditService.On("Record", mock.MatchedBy(tt.validateEntry)).Once()
+			}
+
+			body, _ := json.Marshal(dto.LoginRequest{Email: "Test@Example.com", Password: "Password123!"})
+			req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderXRealIP, "203.0.113.7")
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			if err := handler.NewAuthHandler(authService, sessionService, auditService).Login(c); err != nil {
+				handler.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -1,14 +1,21 @@
 package handler
 
 import (
+	stderrors "errors"
 	"log/slog"
 	"net/http"
 
 	"strikepad-backend/internal/service"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/config"
 	"strikepad-backend/internal/dto"
 	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
 	"strikepad-backend/internal/validator"
 
 	"github.com/labstack/echo/v4"
@@ -17,50 +24,107 @@ import (
 type AuthHandler struct {
 	authService    service.AuthServiceInterface
 	sessionService service.SessionServiceInterface
+	auditService   service.AuditServiceInterface
 	validator      *validator.Validator
+	// countryHeader is the request header in which the reverse proxy reports the client's country
+	countryHeader string
+	cookies       auth.SessionCookies
 }
 
 func NewAuthHandler(
 	authService service.AuthServiceInterface,
 	sessionService service.SessionServiceInterface,
+	auditService service.AuditServiceInterface,
 ) AuthHandlerInterface {
 	return &AuthHandler{
 		authService:    authService,
 		sessionService: sessionService,
+		auditService:   auditService,
 		validator:      validator.New(),
+		cookies:        auth.SessionCookiesFromEnv(),
+		countryHeader:  config.GetEnv("LOGIN_COUNTRY_HEADER", ""),
 	}
 }
 
-// handleValidationError handles validation errors and returns appropriate JSON response
-func (h *AuthHandler) handleValidationError(c echo.Context, err error, operation string) error {
-	slog.Warn("Validation failed for "+operation, "error", err)
-	if ve, ok := err.(validator.ValidationErrors); ok {
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeValidationFailed)
-
-		// Convert validator errors to our format
-		var validationErrors []dto.ValidationError
-		for _, validatorErr := range ve.Errors {
-			validationErrors = append(validationErrors, dto.ValidationError{
-				Field:   validatorErr.Field,
-				Tag:     validatorErr.Tag,
-				Value:   validatorErr.Value,
-				Message: validatorErr.Message,
-			})
-		}
+// setSessionCookies stores the session tokens in HttpOnly cookies in cookie session mode
+func (h *AuthHandler) setSessionCookies(c echo.Context, tokenPair *auth.TokenPair) {
+	if !h.cookies.Enabled {
+		return
+	}
+	for _, cookie := range h.cookies.Cookies(tokenPair) {
+		c.SetCookie(cookie)
+	}
+}
 
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: errorInfo.Description,
-			Details:     validationErrors,
-		})
+// sessionMetadata collects client information about the request that is creating a session. The
+// country is only read from LOGIN_COUNTRY_HEADER, which clients must not be able to set themselves.
+func (h *AuthHandler) sessionMetadata(c echo.Context, deviceName string) service.SessionMetadata {
+	metadata := service.SessionMetadata{
+		IPAddress:  c.RealIP(),
+		UserAgent:  c.Request().UserAgent(),
+		DeviceName: deviceName,
 	}
-	errorInfo := errors.GetErrorInfo(errors.ErrCodeValidationFailed)
-	return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-		Code:        string(errorInfo.Code),
-		Message:     errorInfo.Message,
-		Description: err.Error(),
-	})
+	if h.countryHeader != "" {
+		metadata.Country = c.Request().Header.Get(h.countryHeader)
+	}
+	return metadata
+}
+
+// Sign-in methods recorded in the audit log; OAuth providers are recorded by name
+const (
+	loginMethodPassword = "password"
+	loginMethodGoogle   = "google"
+)
+
+// auditLogin records a successful sign-in with method as done by the user
+func (h *AuthHandler) auditLogin(c echo.Context, method string, userInfo *dto.UserInfo) {
+	entry := middleware.AuditEntry(c, service.AuditActionLoginSucceeded)
+	entry.ActorID = userInfo.ID
+	entry.Metadata = map[string]string{"method": method}
+	h.auditService.Record(entry)
+}
+
+// auditLoginFailure records a refused sign-in with method and the error code it was refused with.
+// email is the address a password sign-in tried, empty for other methods. It is recorded normalized,
+// with the user who has it as the actor, so that the entry is listed to that user.
+func (h *AuthHandler) auditLoginFailure(c echo.Context, method, email string, err error) {
+	reason := string(errors.ErrCodeInternalError)
+	var appErr *errors.AppError
+	if stderrors.As(err, &appErr) {
+		reason = string(appErr.Code)
+	}
+
+	entry := middleware.AuditEntry(c, service.AuditActionLoginFailed)
+	entry.Metadata = map[string]string{"method": method, "reason": reason}
+	if email == "" {
+		h.auditService.Record(entry)
+		return
+	}
+	h.auditService.RecordLoginFailure(entry, auth.NormalizeEmail(email))
+}
+
+// errInvalidBody rejects request bodies that cannot be bound to the request DTO
+var errInvalidBody = errors.New(errors.ErrCodeInvalidRequest, "invalid request body")
+
+// errMissingUserID is returned when the JWT middleware did not store the authenticated user
+var errMissingUserID = errors.New(errors.ErrCodeUnauthorized, "user ID not found in context").
+	WithDescription("Invalid token: user ID not found")
+
+// errMissingSession is returned when the JWT middleware did not store the authenticated session
+var errMissingSession = errors.New(errors.ErrCodeUnauthorized, "session not found in context").
+	WithDescription("Invalid token: session not found")
+
+// errSessionCreation is returned when a session cannot be started after a successful sign-in
+var errSessionCreation = errors.New(errors.ErrCodeInternalError, "failed to create session").
+	WithDescription("Failed to create session")
+
+// sessionCreationError returns the error for the client when a session cannot be started after a
+// successful sign-in: the session limit is reported as such, other failures are internal
+func sessionCreationError(err error) error {
+	if stderrors.Is(err, auth.ErrSessionLimitReached) {
+		return auth.ErrSessionLimitReached
+	}
+	return errSessionCreation
 }
 
 // Signup handles user registration
@@ -70,73 +134,20 @@ func (h *AuthHandler) Signup(c echo.Context) error {
 	// Bind request body
 	if err := c.Bind(&req); err != nil {
 		slog.Warn("Invalid request body for signup", "error", err)
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidRequest)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: errorInfo.Description,
-		})
+		return errInvalidBody
 	}
 
 	// Validate request using validator
 	if err := h.validator.Validate(&req); err != nil {
-		return h.handleValidationError(c, err, "signup")
+		return validationError(err, "signup")
 	}
 
-	// Call service
-	response, err := h.authService.Signup(&req)
+	// Create the user and its first session together
+	response, tokenPair, err := h.authService.SignupWithSession(
+		c.Request().Context(), &req, h.sessionMetadata(c, req.DeviceName),
+	)
 	if err != nil {
-		// Handle specific errors
-		switch err {
-		case auth.ErrInvalidEmail:
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeEmailInvalid)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		case auth.ErrPasswordTooShort:
-			errorInfo := errors.GetErrorInfo(errors.ErrCodePasswordTooShort)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		case auth.ErrPasswordTooLong:
-			errorInfo := errors.GetErrorInfo(errors.ErrCodePasswordTooLong)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		case auth.ErrUserAlreadyExists:
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeUserExists)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		default:
-			slog.Error("Internal error during signup", "error", err)
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		}
-	}
-
-	// Create session and generate tokens
-	tokenPair, err := h.sessionService.CreateSession(response.ID)
-	if err != nil {
-		slog.Error("Failed to create session after signup", "error", err, "user_id", response.ID)
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: "Failed to create session",
-		})
+		return err
 	}
 
 	// Create response with tokens
@@ -147,7 +158,8 @@ func (h *AuthHandler) Signup(c echo.Context) error {
 		ExpiresAt:      tokenPair.AccessTokenExpiresAt,
 	}
 
-	slog.Info("User signup successful", "user_id", response.ID, "email", response.Email)
+	slog.Info("User signup successful", "user_id", response.UserID, "email", response.Email)
+	h.setSessionCookies(c, tokenPair)
 	return c.JSON(http.StatusCreated, signupResponse)
 }
 
@@ -158,52 +170,26 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	// Bind request body
 	if err := c.Bind(&req); err != nil {
 		slog.Warn("Invalid request body for login", "error", err)
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidRequest)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: errorInfo.Description,
-		})
+		return errInvalidBody
 	}
 
 	// Validate request using validator
 	if err := h.validator.Validate(&req); err != nil {
-		return h.handleValidationError(c, err, "login")
+		return validationError(err, "login")
 	}
 
 	// Call service
 	userInfo, err := h.authService.Login(&req)
 	if err != nil {
-		// Handle specific errors
-		switch err {
-		case auth.ErrInvalidCredentials:
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidCredentials)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		default:
-			slog.Error("Internal error during login", "error", err)
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		}
+		h.auditLoginFailure(c, loginMethodPassword, req.Email, err)
+		return err
 	}
 
 	// Create session and generate tokens
-	tokenPair, err := h.sessionService.CreateSession(userInfo.ID)
+	tokenPair, err := h.sessionService.CreateSession(userInfo.UserID, h.sessionMetadata(c, req.DeviceName))
 	if err != nil {
-		slog.Error("Failed to create session after login", "error", err, "user_id", userInfo.ID)
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: "Failed to create session",
-		})
+		slog.Error("Failed to create session after login", "error", err, "user_id", userInfo.UserID)
+		return sessionCreationError(err)
 	}
 
 	// Create response with tokens
@@ -214,7 +200,9 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		ExpiresAt:    tokenPair.AccessTokenExpiresAt,
 	}
 
-	slog.Info("User login successful", "user_id", userInfo.ID, "email", userInfo.Email)
+	slog.Info("User login successful", "user_id", userInfo.UserID, "email", userInfo.Email)
+	h.auditLogin(c, loginMethodPassword, userInfo)
+	h.setSessionCookies(c, tokenPair)
 	return c.JSON(http.StatusOK, loginResponse)
 }
 
@@ -225,51 +213,41 @@ func (h *AuthHandler) GoogleSignup(c echo.Context) error {
 	// Bind request body
 	if err := c.Bind(&req); err != nil {
 		slog.Warn("Invalid request body for Google signup", "error", err)
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidRequest)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: errorInfo.Description,
-		})
+		return errInvalidBody
 	}
 
 	// Validate request using validator
 	if err := h.validator.Validate(&req); err != nil {
-		return h.handleValidationError(c, err, "Google signup")
+		return validationError(err, "Google signup")
 	}
 
 	// Call service
 	response, err := h.authService.GoogleSignup(&req)
 	if err != nil {
-		// Handle specific errors
-		switch err.Error() {
-		case "invalid access token":
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidRequest)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: "Invalid Google access token",
-			})
-		case auth.ErrUserAlreadyExists.Error():
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeUserExists)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		default:
-			slog.Error("Internal error during Google signup", "error", err)
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
+		if stderrors.Is(err, auth.ErrInvalidGoogleToken) {
+			return auth.ErrInvalidGoogleToken.WithDescription("Invalid Google token")
 		}
+		return err
+	}
+
+	// Create session and generate tokens
+	tokenPair, err := h.sessionService.CreateSession(response.UserID, h.sessionMetadata(c, req.DeviceName))
+	if err != nil {
+		slog.Error("Failed to create session after Google signup", "error", err, "user_id", response.UserID)
+		return sessionCreationError(err)
+	}
+
+	// Create response with tokens
+	signupResponse := dto.AuthResponse{
+		SignupResponse: *response,
+		AccessToken:    tokenPair.AccessToken,
+		RefreshToken:   tokenPair.RefreshToken,
+		ExpiresAt:      tokenPair.AccessTokenExpiresAt,
 	}
 
-	slog.Info("Google user signup successful", "user_id", response.ID, "email", response.Email)
-	return c.JSON(http.StatusCreated, response)
+	slog.Info("Google user signup successful", "user_id", response.UserID, "email", response.Email)
+	h.setSessionCookies(c, tokenPair)
+	return c.JSON(http.StatusCreated, signupResponse)
 }
 
 // GoogleLogin handles user authentication using Google OAuth
@@ -279,44 +257,241 @@ func (h *AuthHandler) GoogleLogin(c echo.Context) error {
 	// Bind request body
 	if err := c.Bind(&req); err != nil {
 		slog.Warn("Invalid request body for Google login", "error", err)
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidRequest)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: errorInfo.Description,
-		})
+		return errInvalidBody
 	}
 
 	// Validate request using validator
 	if err := h.validator.Validate(&req); err != nil {
-		return h.handleValidationError(c, err, "Google login")
+		return validationError(err, "Google login")
 	}
 
 	// Call service
 	userInfo, err := h.authService.GoogleLogin(&req)
 	if err != nil {
-		// Handle specific errors
-		switch err {
-		case auth.ErrInvalidCredentials:
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidCredentials)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: "Invalid Google credentials",
-			})
-		default:
-			slog.Error("Internal error during Google login", "error", err)
-			errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-				Code:        string(errorInfo.Code),
-				Message:     errorInfo.Message,
-				Description: errorInfo.Description,
-			})
-		}
+		h.auditLoginFailure(c, loginMethodGoogle, "", err)
+		return describeCredentialsError(err, "Invalid Google credentials")
+	}
+
+	// Create session and generate tokens
+	tokenPair, err := h.sessionService.CreateSession(userInfo.UserID, h.sessionMetadata(c, req.DeviceName))
+	if err != nil {
+		slog.Error("Failed to create session after Google login", "error", err, "user_id", userInfo.UserID)
+		return sessionCreationError(err)
+	}
+
+	// Create response with tokens
+	loginResponse := dto.LoginResponse{
+		UserInfo:     *userInfo,
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresAt:    tokenPair.AccessTokenExpiresAt,
 	}
 
-	slog.Info("Google user login successful", "user_id", userInfo.ID, "email", userInfo.Email)
-	return c.JSON(http.StatusOK, userInfo)
+	slog.Info("Google user login successful", "user_id", userInfo.UserID, "email", userInfo.Email)
+	h.auditLogin(c, loginMethodGoogle, userInfo)
+	h.setSessionCookies(c, tokenPair)
+	return c.JSON(http.StatusOK, loginResponse)
+}
+
+// googleStateCookie holds the signed authorization state between GoogleAuthorize and GoogleCallback
+const googleStateCookie = "google_oauth_state"
+
+// GoogleAuthorize starts the server-side Google authorization code flow by redirecting to Google
+func (h *AuthHandler) GoogleAuthorize(c echo.Context) error {
+	authorization, err := h.authService.GoogleAuthorize()
+	if err != nil {
+		return err
+	}
+
+	c.SetCookie(&http.Cookie{
+		Name:     googleStateCookie,
+		Value:    authorization.StateToken,
+		Path:     "/api/auth/google",
+		MaxAge:   int(authorization.ExpiresIn.Seconds()),
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return c.Redirect(http.StatusFound, authorization.URL)
+}
+
+// GoogleCallback completes the Google authorization code flow and creates a session
+func (h *AuthHandler) GoogleCallback(c echo.Context) error {
+	// The state cookie is single use regardless of the outcome
+	stateCookie, cookieErr := c.Cookie(googleStateCookie)
+	c.SetCookie(&http.Cookie{
+		Name:     googleStateCookie,
+		Path:     "/api/auth/google",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if googleErr := c.QueryParam("error"); googleErr != "" {
+		slog.Warn("Google authorization was not granted", "error", googleErr)
+		return auth.ErrInvalidCredentials.WithDescription("Google authorization was not granted")
+	}
+
+	code := c.QueryParam("code")
+	state := c.QueryParam("state")
+	if code == "" || state == "" || cookieErr != nil || stateCookie.Value == "" {
+		slog.Warn("Incomplete Google callback", "has_code", code != "", "has_state", state != "",
+			"has_cookie", cookieErr == nil)
+		return errors.New(errors.ErrCodeInvalidRequest, "incomplete Google callback").
+			WithDescription("Missing authorization code or state")
+	}
+
+	// Call service
+	userInfo, err := h.authService.GoogleCallback(code, state, stateCookie.Value)
+	if err != nil {
+		h.auditLoginFailure(c, loginMethodGoogle, "", err)
+		return describeCredentialsError(err, "Invalid Google credentials")
+	}
+
+	// Create session and generate tokens
+	tokenPair, err := h.sessionService.CreateSession(userInfo.UserID, h.sessionMetadata(c, ""))
+	if err != nil {
+		slog.Error("Failed to create session after Google callback", "error", err, "user_id", userInfo.UserID)
+		return sessionCreationError(err)
+	}
+
+	loginResponse := dto.LoginResponse{
+		UserInfo:     *userInfo,
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresAt:    tokenPair.AccessTokenExpiresAt,
+	}
+
+	slog.Info("Google authorization code login successful", "user_id", userInfo.UserID, "email", userInfo.Email)
+	h.auditLogin(c, loginMethodGoogle, userInfo)
+	h.setSessionCookies(c, tokenPair)
+	return c.JSON(http.StatusOK, loginResponse)
+}
+
+// ProviderSignup handles user registration using the OAuth provider named in the path
+func (h *AuthHandler) ProviderSignup(c echo.Context) error {
+	provider := c.Param("provider")
+	var req dto.OAuthRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for OAuth signup", "provider", provider, "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "OAuth signup")
+	}
+
+	// Call service
+	response, err := h.authService.ProviderSignup(provider, &req)
+	if err != nil {
+		return describeCredentialsError(err, "Invalid OAuth credentials")
+	}
+
+	// Create session and generate tokens
+	tokenPair, err := h.sessionService.CreateSession(response.UserID, h.sessionMetadata(c, req.DeviceName))
+	if err != nil {
+		slog.Error("Failed to create session after OAuth signup", "error", err, "user_id", response.UserID)
+		return sessionCreationError(err)
+	}
+
+	signupResponse := dto.AuthResponse{
+		SignupResponse: *response,
+		AccessToken:    tokenPair.AccessToken,
+		RefreshToken:   tokenPair.RefreshToken,
+		ExpiresAt:      tokenPair.AccessTokenExpiresAt,
+	}
+
+	slog.Info("OAuth user signup successful", "provider", provider, "user_id", response.UserID, "email", response.Email)
+	h.setSessionCookies(c, tokenPair)
+	return c.JSON(http.StatusCreated, signupResponse)
+}
+
+// ProviderLogin handles user authentication using the OAuth provider named in the path
+func (h *AuthHandler) ProviderLogin(c echo.Context) error {
+	provider := c.Param("provider")
+	var req dto.OAuthRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for OAuth login", "provider", provider, "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "OAuth login")
+	}
+
+	// Call service
+	userInfo, err := h.authService.ProviderLogin(provider, &req)
+	if err != nil {
+		h.auditLoginFailure(c, provider, "", err)
+		return describeCredentialsError(err, "Invalid OAuth credentials")
+	}
+
+	// Create session and generate tokens
+	tokenPair, err := h.sessionService.CreateSession(userInfo.UserID, h.sessionMetadata(c, req.DeviceName))
+	if err != nil {
+		slog.Error("Failed to create session after OAuth login", "error", err, "user_id", userInfo.UserID)
+		return sessionCreationError(err)
+	}
+
+	loginResponse := dto.LoginResponse{
+		UserInfo:     *userInfo,
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresAt:    tokenPair.AccessTokenExpiresAt,
+	}
+
+	slog.Info("OAuth user login successful", "provider", provider, "user_id", userInfo.UserID, "email", userInfo.Email)
+	h.auditLogin(c, provider, userInfo)
+	h.setSessionCookies(c, tokenPair)
+	return c.JSON(http.StatusOK, loginResponse)
+}
+
+// LinkGoogleIdentity links a Google account to the authenticated user
+func (h *AuthHandler) LinkGoogleIdentity(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	var req dto.LinkGoogleIdentityRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for Google identity linking", "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "Google identity linking")
+	}
+
+	// Call service
+	identity, err := h.authService.LinkGoogleIdentity(userID, &req)
+	if err != nil {
+		return describeCredentialsError(err, "Invalid Google credentials")
+	}
+
+	slog.Info("Google identity link successful", "user_id", userID)
+	return c.JSON(http.StatusCreated, identity)
+}
+
+// describeCredentialsError gives the invalid credentials error of a provider sign-in a description
+// naming the provider; other errors are returned unchanged
+func describeCredentialsError(err error, description string) error {
+	if stderrors.Is(err, auth.ErrInvalidCredentials) {
+		return auth.ErrInvalidCredentials.WithDescription(description)
+	}
+	return err
 }
 
 // Logout handles user logout
@@ -325,39 +500,149 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 	userID, ok := c.Get("user_id").(uint)
 	if !ok {
 		slog.Error("Failed to get user ID from JWT token")
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: "Invalid token: user ID not found",
-		})
+		return errMissingUserID
 	}
 
 	accessToken, ok := c.Get("access_token").(string)
 	if !ok {
 		slog.Error("Failed to get access token from context")
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: "Failed to get token information",
-		})
+		return errors.New(errors.ErrCodeInternalError, "access token not found in context").
+			WithDescription("Failed to get token information")
 	}
 
-	// Call session service to logout using JWT user_id
+	// Call session service to logout using JWT user_id. Logging out of a session that has already
+	// ended, e.g. by a concurrent logout, succeeds, so that clients can retry.
 	err := h.sessionService.Logout(userID, accessToken)
-	if err != nil {
+	alreadySignedOut := stderrors.Is(err, repository.ErrSessionNotFound)
+	if stderrors.Is(err, auth.ErrSessionNotOwned) {
+		slog.Warn("Logout of a session of another user", "user_id", userID)
+		return auth.ErrSessionNotOwned
+	}
+	if err != nil && !alreadySignedOut {
 		slog.Error("Failed to logout user", "error", err, "user_id", userID)
-		errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError)
-		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
-			Code:        string(errorInfo.Code),
-			Message:     errorInfo.Message,
-			Description: "Logout failed",
+		return errors.New(errors.ErrCodeInternalError, "logout failed").WithDescription("Logout failed")
+	}
+
+	if h.cookies.Enabled {
+		for _, cookie := range h.cookies.ClearCookies() {
+			c.SetCookie(cookie)
+		}
+	}
+
+	if alreadySignedOut {
+		slog.Info("Session is already signed out", "user_id", userID)
+		return c.JSON(http.StatusOK, map[string]string{
+			"message": "Session is already signed out",
 		})
 	}
 
 	slog.Info("User logout successful", "user_id", userID)
+	h.auditService.Record(middleware.AuditEntry(c, service.AuditActionLogout))
 	return c.JSON(http.StatusOK, map[string]string{
 		"message": "Logout successful",
 	})
 }
+
+// ListSessions returns a page of the active sessions of the authenticated user, newest first
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	params, err := pagination.ParseCursorQuery(c.QueryParams(), defaultSessionPageSize, maxSessionPageSize)
+	if err != nil {
+		return invalidPaginationError(err)
+	}
+
+	page, err := h.sessionService.ListActiveSessions(userID, params)
+	if err != nil {
+		if stderrors.Is(err, pagination.ErrInvalidCursor) {
+			return invalidPaginationError(pagination.ErrInvalidCursor)
+		}
+		slog.Error("Failed to list sessions", "error", err, "user_id", userID)
+		return err
+	}
+
+	var currentSessionID string
+	if current, ok := c.Get("session").(*model.UserSession); ok && current != nil {
+		currentSessionID = current.PublicID
+	}
+
+	infos := make([]dto.SessionInfo, 0, len(page.Items))
+	for i := range page.Items {
+		session := &page.Items[i]
+		infos = append(infos, dto.SessionInfo{
+			ID:         session.PublicID,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastActivityAt(),
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			DeviceName: session.DeviceName,
+			Current:    session.PublicID == currentSessionID,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.SessionListResponse(dto.NewListResponse(infos, page.Meta(params))))
+}
+
+// RevokeSession invalidates one of the authenticated user's sessions
+func (h *AuthHandler) RevokeSession(c echo.Context) error {
+	session, ok := c.Get("session").(*model.UserSession)
+	if !ok || session == nil {
+		slog.Error("Failed to get session from JWT token")
+		return errMissingSession
+	}
+	userID := session.UserID
+
+	sessionID := c.Param("id")
+	if err := publicid.Validate(publicid.PrefixSession, sessionID); err != nil {
+		slog.Warn("Invalid session ID for revoke", "id", c.Param("id"), "error", err)
+		return errors.New(errors.ErrCodeInvalidRequest, "invalid session ID").WithDescription("Invalid session ID")
+	}
+
+	if err := h.sessionService.RevokeSession(&session.User, sessionID); err != nil {
+		if stderrors.Is(err, repository.ErrSessionNotFound) {
+			return errors.New(errors.ErrCodeNotFound, "session not found").WithDescription("Session not found")
+		}
+
+		slog.Error("Failed to revoke session", "error", err, "user_id", userID, "session_id", sessionID)
+		return err
+	}
+
+	slog.Info("Session revoke successful", "user_id", userID, "session_id", sessionID)
+	entry := middleware.AuditEntry(c, service.AuditActionSessionRevoked)
+	entry.TargetID = sessionID
+	h.auditService.Record(entry)
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Session revoked successfully",
+	})
+}
+
+// RevokeSessionByToken handles the one-click revoke link of a new sign-in alert email. The token in
+// the link authenticates the request, so that the session can be ended from any device.
+func (h *AuthHandler) RevokeSessionByToken(c echo.Context) error {
+	user, sessionID, err := h.sessionService.RevokeSessionByToken(c.QueryParam("token"))
+	if err != nil {
+		if stderrors.Is(err, repository.ErrSessionNotFound) {
+			return c.JSON(http.StatusOK, map[string]string{
+				"message": "Session is already signed out",
+			})
+		}
+		if !stderrors.Is(err, auth.ErrInvalidSessionRevokeToken) {
+			slog.Error("Failed to revoke session from login alert", "error", err)
+		}
+		return err
+	}
+
+	slog.Info("Session revoked from login alert", "user_id", user.ID, "session_id", sessionID)
+	entry := middleware.AuditEntry(c, service.AuditActionSessionRevoked)
+	entry.ActorID = user.PublicID
+	entry.TargetID = sessionID
+	entry.Metadata = map[string]string{"via": "login_alert"}
+	h.auditService.Record(entry)
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Session revoked successfully",
+	})
+}
@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthHandler_CookieMode(t *testing.T) {
+	tokenPair := &auth.TokenPair{
+		AccessToken:           "test-access-token",
+		RefreshToken:          "test-refresh-token",
+		AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+	login := func(t *testing.T) *httptest.ResponseRecorder {
+		authService := new(mocks.MockAuthServiceInterface)
+		sessionService := new(mocks.MockSessionServiceInterface)
+		authService.On("Login", mock.Anything).Return(&dto.UserInfo{ID: "usr_5k2d9x0q7m3hv8c1b4na", UserID: 1}, nil)
+		sessionService.On("CreateSession", uint(1), mock.Anything).Return(tokenPair, nil)
+
+		e := echo.New()
+		body := `{"email":"test@example.com","password":"Password123!"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, handler.NewAuthHandler(authService, sessionService, allowAudit()).Login(e.NewContext(req, rec)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		return rec
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv("AUTH_COOKIE_MODE", "false")
+
+		assert.Empty(t, login(t).Result().Cookies())
+	})
+
+	t.Run("login sets HttpOnly cookies", func(t *testing.T) {
+		t.Setenv("AUTH_COOKIE_MODE", "true")
+		t.Setenv("AUTH_COOKIE_SAMESITE", "strict")
+
+		cookies := login(t).Result().Cookies()
+		require.Len(t, cookies, 2)
+		assert.Equal(t, auth.AccessTokenCookieName, cookies[0].Name)
+		assert.Equal(t, "test-access-token", cookies[0].Value)
+		assert.Equal(t, "/", cookies[0].Path)
+		assert.Equal(t, auth.RefreshTokenCookieName, cookies[1].Name)
+		assert.Equal(t, "test-refresh-token", cookies[1].Value)
+		assert.Equal(t, "/api/auth", cookies[1].Path)
+		for _, cookie := range cookies {
+			assert.True(t, cookie.HttpOnly)
+			assert.True(t, cookie.Secure)
+			assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+		}
+	})
+
+	t.Run("logout clears cookies", func(t *testing.T) {
+		t.Setenv("AUTH_COOKIE_MODE", "true")
+		sessionService := new(mocks.MockSessionServiceInterface)
+		sessionService.On("Logout", uint(1), "test-access-token").Return(nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", uint(1))
+		c.Set("access_token", "test-access-token")
+
+		require.NoError(t, handler.NewAuthHandler(new(mocks.MockAuthServiceInterface), sessionService, allowAudit()).Logout(c))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 2)
+		for _, cookie := range cookies {
+			assert.Empty(t, cookie.Value)
+			assert.Equal(t, -1, cookie.MaxAge)
+		}
+		sessionService.AssertExpectations(t)
+	})
+}
@@ -6,9 +6,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"strikepad-backend/internal/auth"
 	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/oauth"
+	"strikepad-backend/internal/service"
 	"strikepad-backend/internal/service/mocks"
 
 	"github.com/labstack/echo/v4"
@@ -16,53 +19,86 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// serve runs h the way the server does, writing the error it returns with the HTTP error handler
+func serve(c echo.Context, h echo.HandlerFunc) {
+	if err := h(c); err != nil {
+		HTTPErrorHandler(err, c)
+	}
+}
+
+// allowAudit returns an audit service that accepts any entry
+func allowAudit() *mocks.MockAuditServiceInterface {
+	auditService := new(mocks.MockAuditServiceInterface)
+	auditService.On("Record", mock.Anything).Maybe()
+	return auditService
+}
+
 func TestAuthHandler_GoogleSignup(t *testing.T) {
 	tests := []struct {
 		requestBody    map[string]interface{}
-		setupMocks     func(*mocks.MockAuthServiceInterface)
+		setupMocks     func(*mocks.MockAuthServiceInterface, *mocks.MockSessionServiceInterface)
 		name           string
 		expectedStatus int
-		expectError    bool
+		expectTokens   bool
 	}{
 		{
-			name: "successful Google signup",
+			name: "successful Google signup creates session",
 			requestBody: map[string]interface{}{
 				"access_token": "valid_google_token",
+				"device_name":  "Work laptop",
 			},
-			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, mockSession *mocks.MockSessionServiceInterface) {
 				mockService.On("GoogleSignup", mock.AnythingOfType("*dto.GoogleSignupRequest")).Return(
 					&dto.SignupResponse{
-						ID:            1,
+						ID:            "usr_5k2d9x0q7m3hv8c1b4na",
+						UserID:        1,
 						Email:         "test@example.com",
 						DisplayName:   "Test User",
 						EmailVerified: true,
 					}, nil)
+				mockSession.On("CreateSession", uint(1), mock.MatchedBy(func(metadata service.SessionMetadata) bool {
+					return metadata.DeviceName == "Work laptop"
+				})).Return(&auth.TokenPair{
+					AccessToken:          "access",
+					RefreshToken:         "refresh",
+					AccessTokenExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
 			},
 			expectedStatus: http.StatusCreated,
-			expectError:    false,
+			expectTokens:   true,
 		},
 		{
 			name: "invalid request body",
 			requestBody: map[string]interface{}{
 				"invalid_field": "value",
 			},
-			setupMocks: func(_ *mocks.MockAuthServiceInterface) {
+			setupMocks: func(_ *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
 				// No service call expected
 			},
 			expectedStatus: http.StatusBadRequest,
-			expectError:    true,
 		},
 		{
 			name: "user already exists",
 			requestBody: map[string]interface{}{
 				"access_token": "valid_google_token",
 			},
-			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
 				mockService.On("GoogleSignup", mock.AnythingOfType("*dto.GoogleSignupRequest")).Return(
 					nil, auth.ErrUserAlreadyExists)
 			},
 			expectedStatus: http.StatusConflict,
-			expectError:    true,
+		},
+		{
+			name: "session creation fails",
+			requestBody: map[string]interface{}{
+				"access_token": "valid_google_token",
+			},
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, mockSession *mocks.MockSessionServiceInterface) {
+				mockService.On("GoogleSignup", mock.AnythingOfType("*dto.GoogleSignupRequest")).Return(
+					&dto.SignupResponse{ID: "usr_5k2d9x0q7m3hv8c1b4na", UserID: 1, Email: "test@example.com"}, nil)
+				mockSession.On("CreateSession", uint(1), mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
 		},
 	}
 
@@ -71,11 +107,8 @@ func TestAuthHandler_GoogleSignup(t *testing.T) {
 			// Setup
 			mockService := &mocks.MockAuthServiceInterface{}
 			mockSessionService := &mocks.MockSessionServiceInterface{}
-			handler := NewAuthHandler(mockService, mockSessionService)
-
-			if tt.setupMocks != nil {
-				tt.setupMocks(mockService)
-			}
+			handler := NewAuthHandler(mockService, mockSessionService, allowAudit())
+			tt.setupMocks(mockService, mockSessionService)
 
 			// Create request
 			body, _ := json.Marshal(tt.requestBody)
@@ -85,13 +118,20 @@ func TestAuthHandler_GoogleSignup(t *testing.T) {
 			c := echo.New().NewContext(req, rec)
 
 			// Execute
-			err := handler.GoogleSignup(c)
+			serve(c, handler.GoogleSignup)
 
 			// Assert
-			assert.NoError(t, err) // Echo handlers don't return errors for HTTP errors
 			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectTokens {
+				var response dto.AuthResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "access", response.AccessToken)
+				assert.Equal(t, "refresh", response.RefreshToken)
+				assert.Equal(t, "usr_5k2d9x0q7m3hv8c1b4na", response.ID)
+			}
 
 			mockService.AssertExpectations(t)
+			mockSessionService.AssertExpectations(t)
 		})
 	}
 }
@@ -99,50 +139,66 @@ func TestAuthHandler_GoogleSignup(t *testing.T) {
 func TestAuthHandler_GoogleLogin(t *testing.T) {
 	tests := []struct {
 		requestBody    map[string]interface{}
-		setupMocks     func(*mocks.MockAuthServiceInterface)
+		setupMocks     func(*mocks.MockAuthServiceInterface, *mocks.MockSessionServiceInterface)
 		name           string
 		expectedStatus int
-		expectError    bool
+		expectTokens   bool
 	}{
 		{
-			name: "successful Google login",
+			name: "successful Google login creates session",
 			requestBody: map[string]interface{}{
 				"access_token": "valid_google_token",
 			},
-			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, mockSession *mocks.MockSessionServiceInterface) {
 				mockService.On("GoogleLogin", mock.AnythingOfType("*dto.GoogleLoginRequest")).Return(
 					&dto.UserInfo{
-						ID:            1,
+						ID:            "usr_5k2d9x0q7m3hv8c1b4na",
+						UserID:        1,
 						Email:         "test@example.com",
 						DisplayName:   "Test User",
 						EmailVerified: true,
 					}, nil)
+				mockSession.On("CreateSession", uint(1), mock.Anything).Return(&auth.TokenPair{
+					AccessToken:          "access",
+					RefreshToken:         "refresh",
+					AccessTokenExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectError:    false,
+			expectTokens:   true,
 		},
 		{
 			name: "invalid credentials",
 			requestBody: map[string]interface{}{
 				"access_token": "invalid_token",
 			},
-			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
 				mockService.On("GoogleLogin", mock.AnythingOfType("*dto.GoogleLoginRequest")).Return(
 					nil, auth.ErrInvalidCredentials)
 			},
 			expectedStatus: http.StatusUnauthorized,
-			expectError:    true,
 		},
 		{
 			name: "missing access token",
 			requestBody: map[string]interface{}{
 				"access_token": "",
 			},
-			setupMocks: func(_ *mocks.MockAuthServiceInterface) {
+			setupMocks: func(_ *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
 				// Validation should fail before service call
 			},
 			expectedStatus: http.StatusBadRequest,
-			expectError:    true,
+		},
+		{
+			name: "session creation fails",
+			requestBody: map[string]interface{}{
+				"access_token": "valid_google_token",
+			},
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, mockSession *mocks.MockSessionServiceInterface) {
+				mockService.On("GoogleLogin", mock.AnythingOfType("*dto.GoogleLoginRequest")).Return(
+					&dto.UserInfo{ID: "usr_5k2d9x0q7m3hv8c1b4na", UserID: 1, Email: "test@example.com"}, nil)
+				mockSession.On("CreateSession", uint(1), mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
 		},
 	}
 
@@ -151,11 +207,8 @@ func TestAuthHandler_GoogleLogin(t *testing.T) {
 			// Setup
 			mockService := &mocks.MockAuthServiceInterface{}
 			mockSessionService := &mocks.MockSessionServiceInterface{}
-			handler := NewAuthHandler(mockService, mockSessionService)
-
-			if tt.setupMocks != nil {
-				tt.setupMocks(mockService)
-			}
+			handler := NewAuthHandler(mockService, mockSessionService, allowAudit())
+			tt.setupMocks(mockService, mockSessionService)
 
 			// Create request
 			body, _ := json.Marshal(tt.requestBody)
@@ -165,13 +218,178 @@ func TestAuthHandler_GoogleLogin(t *testing.T) {
 			c := echo.New().NewContext(req, rec)
 
 			// Execute
-			err := handler.GoogleLogin(c)
+			serve(c, handler.GoogleLogin)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectTokens {
+				var response dto.LoginResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "access", response.AccessToken)
+				assert.Equal(t, "refresh", response.RefreshToken)
+				assert.Equal(t, "test@example.com", response.Email)
+			}
+
+			mockService.AssertExpectations(t)
+			mockSessionService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_GoogleAuthorize(t *testing.T) {
+	tests := []struct {
+		setupMocks     func(*mocks.MockAuthServiceInterface)
+		name           string
+		expectedStatus int
+		expectCookie   bool
+	}{
+		{
+			name: "redirects to Google and stores state",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+				mockService.On("GoogleAuthorize").Return(&oauth.GoogleAuthorization{
+					URL:        "https://accounts.google.com/o/oauth2/v2/auth?state=abc",
+					StateToken: "signed-state",
+					ExpiresIn:  10 * time.Minute,
+				}, nil)
+			},
+			expectedStatus: http.StatusFound,
+			expectCookie:   true,
+		},
+		{
+			name: "code flow not configured",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+				mockService.On("GoogleAuthorize").Return(nil, oauth.ErrCodeFlowNotConfigured)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockService := &mocks.MockAuthServiceInterface{}
+			mockSessionService := &mocks.MockSessionServiceInterface{}
+			handler := NewAuthHandler(mockService, mockSessionService, allowAudit())
+			tt.setupMocks(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/auth/google/authorize", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			// Execute
+			serve(c, handler.GoogleAuthorize)
 
 			// Assert
-			assert.NoError(t, err) // Echo handlers don't return errors for HTTP errors
 			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectCookie {
+				assert.Equal(t, "https://accounts.google.com/o/oauth2/v2/auth?state=abc", rec.Header().Get("Location"))
+				cookies := rec.Result().Cookies()
+				assert.Len(t, cookies, 1)
+				assert.Equal(t, googleStateCookie, cookies[0].Name)
+				assert.Equal(t, "signed-state", cookies[0].Value)
+				assert.True(t, cookies[0].HttpOnly)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_GoogleCallback(t *testing.T) {
+	tests := []struct {
+		setupMocks     func(*mocks.MockAuthServiceInterface, *mocks.MockSessionServiceInterface)
+		name           string
+		query          string
+		cookie         string
+		expectedStatus int
+	}{
+		{
+			name:   "successful callback creates session",
+			query:  "?code=auth-code&state=abc",
+			cookie: "signed-state",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, mockSession *mocks.MockSessionServiceInterface) {
+				mockService.On("GoogleCallback", "auth-code", "abc", "signed-state").Return(&dto.UserInfo{
+					ID:     "usr_5k2d9x0q7m3hv8c1b4na",
+					UserID: 1,
+					Email:  "test@example.com",
+				}, nil)
+				mockSession.On("CreateSession", uint(1), mock.Anything).Return(&auth.TokenPair{
+					AccessToken:  "access",
+					RefreshToken: "refresh",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing state cookie",
+			query:          "?code=auth-code&state=abc",
+			setupMocks:     func(_ *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing code",
+			query:          "?state=abc",
+			cookie:         "signed-state",
+			setupMocks:     func(_ *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "user denied consent",
+			query:          "?error=access_denied&state=abc",
+			cookie:         "signed-state",
+			setupMocks:     func(_ *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "state mismatch",
+			query:  "?code=auth-code&state=forged",
+			cookie: "signed-state",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				mockService.On("GoogleCallback", "auth-code", "forged", "signed-state").
+					Return(nil, auth.ErrInvalidCredentials)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "email registered with another provider",
+			query:  "?code=auth-code&state=abc",
+			cookie: "signed-state",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				mockService.On("GoogleCallback", "auth-code", "abc", "signed-state").
+					Return(nil, auth.ErrUserAlreadyExists)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockService := &mocks.MockAuthServiceInterface{}
+			mockSessionService := &mocks.MockSessionServiceInterface{}
+			handler := NewAuthHandler(mockService, mockSessionService, allowAudit())
+			tt.setupMocks(mockService, mockSessionService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/auth/google/callback"+tt.query, http.NoBody)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: googleStateCookie, Value: tt.cookie})
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			// Execute
+			serve(c, handler.GoogleCallback)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			// The state cookie is always cleared
+			cookies := rec.Result().Cookies()
+			assert.Len(t, cookies, 1)
+			assert.Equal(t, -1, cookies[0].MaxAge)
 
 			mockService.AssertExpectations(t)
+			mockSessionService.AssertExpectations(t)
 		})
 	}
 }
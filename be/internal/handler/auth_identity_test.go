@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthHandler_LinkGoogleIdentity(t *testing.T) {
+	tests := []struct {
+		requestBody    map[string]interface{}
+		setupMocks     func(*mocks.MockAuthServiceInterface)
+		name           string
+		expectedCode   string
+		expectedStatus int
+		authenticated  bool
+	}{
+		{
+			name:          "links Google account",
+			requestBody:   map[string]interface{}{"id_token": "google-id-token"},
+			authenticated: true,
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+				mockService.On("LinkGoogleIdentity", uint(1), mock.AnythingOfType("*dto.LinkGoogleIdentityRequest")).
+					Return(&dto.IdentityInfo{Provider: "google", Email: "user@gmail.com"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing credentials",
+			requestBody:    map[string]interface{}{},
+			authenticated:  true,
+			setupMocks:     func(_ *mocks.MockAuthServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E003",
+		},
+		{
+			name:          "account already linked",
+			requestBody:   map[string]interface{}{"id_token": "google-id-token"},
+			authenticated: true,
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+				mockService.On("LinkGoogleIdentity", uint(1), mock.Anything).Return(nil, auth.ErrIdentityAlreadyLinked)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "E304",
+		},
+		{
+			name:          "invalid Google token",
+			requestBody:   map[string]interface{}{"access_token": "expired"},
+			authenticated: true,
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface) {
+				mockService.On("LinkGoogleIdentity", uint(1), mock.Anything).Return(nil, auth.ErrInvalidCredentials)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "E100",
+		},
+		{
+			name:           "unauthenticated",
+			requestBody:    map[string]interface{}{"id_token": "google-id-token"},
+			setupMocks:     func(_ *mocks.MockAuthServiceInterface) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "E005",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mocks.MockAuthServiceInterface{}
+			handler := NewAuthHandler(mockService, &mocks.MockSessionServiceInterface{}, allowAudit())
+			tt.setupMocks(mockService)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/api/users/me/identities/google", bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			if tt.authenticated {
+				c.Set("user_id", uint(1))
+			}
+
+			serve(c, handler.LinkGoogleIdentity)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
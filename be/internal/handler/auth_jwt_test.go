@@ -3,16 +3,23 @@ package handler_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"strikepad-backend/internal/auth"
 	"strikepad-backend/internal/dto"
 	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
 	authmocks "strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/factory"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -27,7 +34,7 @@ type AuthJWTHandlerTestSuite struct {
 func (suite *AuthJWTHandlerTestSuite) SetupTest() {
 	suite.mockAuthSvc = new(authmocks.MockAuthServiceInterface)
 	suite.mockSessionSvc = new(authmocks.MockSessionServiceInterface)
-	suite.authHandler = handler.NewAuthHandler(suite.mockAuthSvc, suite.mockSessionSvc)
+	suite.authHandler = handler.NewAuthHandler(suite.mockAuthSvc, suite.mockSessionSvc, allowAudit())
 	suite.echo = echo.New()
 }
 
@@ -127,6 +134,19 @@ func (suite *AuthJWTHandlerTestSuite) TestLogout() {
 				Message: "Internal server error",
 			},
 		},
+		{
+			name: "Session already signed out",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(456))
+				c.Set("access_token", "signed-out-token")
+			},
+			mockSetup: func() {
+				suite.mockSessionSvc.On("Logout", uint(456), "signed-out-token").
+					Return(fmt.Errorf("session not found: %w", repository.ErrSessionNotFound))
+			},
+			expectedStatus: http.StatusOK,
+			expectedMsg:    "Session is already signed out",
+		},
 		{
 			name: "Session does not belong to user",
 			setupContext: func(c echo.Context) {
@@ -134,7 +154,116 @@ func (suite *AuthJWTHandlerTestSuite) TestLogout() {
 				c.Set("access_token", "other-user-token")
 			},
 			mockSetup: func() {
-				suite.mockSessionSvc.On("Logout", uint(789), "other-user-token").Return(errors.New("session does not belong to user"))
+				suite.mockSessionSvc.On("Logout", uint(789), "other-user-token").Return(auth.ErrSessionNotOwned)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E006",
+				Message: "Forbidden",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			// Setup mocks
+			tc.mockSetup()
+
+			// Create HTTP request and response recorder
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+
+			// Setup context
+			tc.setupContext(c)
+
+			// Execute
+			serve(c, suite.authHandler.Logout)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.expectedError != nil {
+				var errorResponse dto.ErrorResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedError.Code, errorResponse.Code)
+				assert.Equal(t, tc.expectedError.Message, errorResponse.Message)
+			} else if tc.expectedMsg != "" {
+				var response map[string]string
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMsg, response["message"])
+			}
+		})
+	}
+}
+
+func (suite *AuthJWTHandlerTestSuite) TestListSessions() {
+	deviceName := "Work laptop"
+	testCases := []struct {
+		setupContext    func(c echo.Context)
+		mockSetup       func()
+		expectedError   *dto.ErrorResponse
+		validateSuccess func(t *testing.T, response dto.SessionListResponse)
+		name            string
+		expectedStatus  int
+	}{
+		{
+			name: "Success",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(123))
+				c.Set("session", &model.UserSession{ID: 2, PublicID: "ses_0vd3m8k2q9x7h1c5b4nz", UserID: 123})
+			},
+			mockSetup: func() {
+				suite.mockSessionSvc.On("ListActiveSessions", uint(123), mock.Anything).
+					Return(&pagination.Page[model.UserSession]{Items: []model.UserSession{
+						{ID: 1, PublicID: "ses_5k2d9x0q7m3hv8c1b4na", UserID: 123, IPAddress: "203.0.113.1", UserAgent: "curl/8.0", DeviceName: &deviceName},
+						{ID: 2, PublicID: "ses_0vd3m8k2q9x7h1c5b4nz", UserID: 123, IPAddress: "203.0.113.2", UserAgent: "Mozilla/5.0"},
+					}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateSuccess: func(t *testing.T, response dto.SessionListResponse) {
+				assert.Len(t, response.Data, 2)
+				assert.Equal(t, "ses_5k2d9x0q7m3hv8c1b4na", response.Data[0].ID)
+				assert.Equal(t, "203.0.113.1", response.Data[0].IPAddress)
+				assert.Equal(t, &deviceName, response.Data[0].DeviceName)
+				assert.False(t, response.Data[0].Current)
+				assert.True(t, response.Data[1].Current)
+			},
+		},
+		{
+			name: "No sessions returns empty list",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(124))
+			},
+			mockSetup: func() {
+				suite.mockSessionSvc.On("ListActiveSessions", uint(124), mock.Anything).
+					Return(&pagination.Page[model.UserSession]{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateSuccess: func(t *testing.T, response dto.SessionListResponse) {
+				assert.NotNil(t, response.Data)
+				assert.Empty(t, response.Data)
+			},
+		},
+		{
+			name:           "Missing user ID",
+			setupContext:   func(_ echo.Context) {},
+			mockSetup:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E005",
+				Message: "Unauthorized",
+			},
+		},
+		{
+			name: "Session service error",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(456))
+			},
+			mockSetup: func() {
+				suite.mockSessionSvc.On("ListActiveSessions", uint(456), mock.Anything).Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError: &dto.ErrorResponse{
@@ -150,7 +279,7 @@ func (suite *AuthJWTHandlerTestSuite) TestLogout() {
 			tc.mockSetup()
 
 			// Create HTTP request and response recorder
-			req := httptest.NewRequest(http.MethodPost, "/auth/logout", http.NoBody)
+			req := httptest.NewRequest(http.MethodGet, "/auth/sessions", http.NoBody)
 			rec := httptest.NewRecorder()
 			c := suite.echo.NewContext(req, rec)
 
@@ -158,21 +287,138 @@ func (suite *AuthJWTHandlerTestSuite) TestLogout() {
 			tc.setupContext(c)
 
 			// Execute
-			err := suite.authHandler.Logout(c)
+			serve(c, suite.authHandler.ListSessions)
 
 			// Assert
-			assert.NoError(t, err)
 			assert.Equal(t, tc.expectedStatus, rec.Code)
 
 			if tc.expectedError != nil {
 				var errorResponse dto.ErrorResponse
-				err = json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectedError.Code, errorResponse.Code)
 				assert.Equal(t, tc.expectedError.Message, errorResponse.Message)
-			} else if tc.expectedMsg != "" {
+			} else if tc.validateSuccess != nil {
+				var response dto.SessionListResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				tc.validateSuccess(t, response)
+			}
+		})
+	}
+}
+
+func (suite *AuthJWTHandlerTestSuite) TestRevokeSession() {
+	session := factory.NewSession().ForUser(factory.NewUser().WithID(123).Build()).Build()
+
+	testCases := []struct {
+		setupContext   func(c echo.Context)
+		mockSetup      func()
+		expectedError  *dto.ErrorResponse
+		name           string
+		sessionID      string
+		expectedMsg    string
+		expectedStatus int
+	}{
+		{
+			name:      "Success",
+			sessionID: "ses_000000000000000000aa",
+			setupContext: func(c echo.Context) {
+				c.Set("session", session)
+			},
+			mockSetup: func() {
+				suite.mockSessionSvc.On("RevokeSession", &session.User, "ses_000000000000000000aa").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedMsg:    "Session revoked successfully",
+		},
+		{
+			name:      "Invalid session ID",
+			sessionID: "10",
+			setupContext: func(c echo.Context) {
+				c.Set("session", session)
+			},
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E002",
+				Message: "Invalid request",
+			},
+		},
+		{
+			name:           "Missing session",
+			sessionID:      "ses_000000000000000000aa",
+			setupContext:   func(_ echo.Context) {},
+			mockSetup:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E005",
+				Message: "Unauthorized",
+			},
+		},
+		{
+			name:      "Session not found",
+			sessionID: "ses_000000000000000000bb",
+			setupContext: func(c echo.Context) {
+				c.Set("session", session)
+			},
+			mockSetup: func() {
+				suite.mockSessionSvc.On("RevokeSession", &session.User, "ses_000000000000000000bb").
+					Return(fmt.Errorf("failed to revoke session: %w", repository.ErrSessionNotFound))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E004",
+				Message: "Resource not found",
+			},
+		},
+		{
+			name:      "Session service error",
+			sessionID: "ses_000000000000000000cc",
+			setupContext: func(c echo.Context) {
+				c.Set("session", session)
+			},
+			mockSetup: func() {
+				suite.mockSessionSvc.On("RevokeSession", &session.User, "ses_000000000000000000cc").Return(errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E001",
+				Message: "Internal server error",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			// Setup mocks
+			tc.mockSetup()
+
+			// Create HTTP request and response recorder
+			req := httptest.NewRequest(http.MethodDelete, "/auth/sessions/"+tc.sessionID, http.NoBody)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.sessionID)
+
+			// Setup context
+			tc.setupContext(c)
+
+			// Execute
+			serve(c, suite.authHandler.RevokeSession)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.expectedError != nil {
+				var errorResponse dto.ErrorResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedError.Code, errorResponse.Code)
+				assert.Equal(t, tc.expectedError.Message, errorResponse.Message)
+			} else {
 				var response map[string]string
-				err = json.Unmarshal(rec.Body.Bytes(), &response)
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectedMsg, response["message"])
 			}
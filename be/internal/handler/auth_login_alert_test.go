@@ -0,0 +1,115 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthHandler_RevokeSessionByToken(t *testing.T) {
+	user := &model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}
+
+	tests := []struct {
+		user            *model.User
+		revokeErr       error
+		name            string
+		expectedMessage string
+		expectedStatus  int
+		expectAudit     bool
+	}{
+		{
+			name:            "revokes the session",
+			user:            user,
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Session revoked successfully",
+			expectAudit:     true,
+		},
+		{
+			name:            "session already signed out",
+			revokeErr:       repository.ErrSessionNotFound,
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Session is already signed out",
+		},
+		{
+			name:           "invalid link",
+			revokeErr:      auth.ErrInvalidSessionRevokeToken,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionService := new(mocks.MockSessionServiceInterface)
+			sessionService.On("RevokeSessionByToken", "revoke-token").
+				Return(tt.user, "ses_7d2k9m4q0x8h3v1c5b6n", tt.revokeErr).Once()
+			auditService := mocks.NewMockAuditServiceInterface(t)
+			if tt.expectAudit {
+				auditService.On("Record", mock.MatchedBy(func(entry service.AuditEntry) bool {
+					return entry.Action == service.AuditActionSessionRevoked &&
+						entry.ActorID == "usr_5k2d9x0q7m3hv8c1b4na" &&
+						entry.TargetID == "ses_7d2k9m4q0x8h3v1c5b6n" &&
+						entry.Metadata["via"] == "login_alert"
+				})).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/auth/sessions/revoke?token=revoke-token", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			h := handler.NewAuthHandler(new(mocks.MockAuthServiceInterface), sessionService, auditService)
+			if err := h.RevokeSessionByToken(c); err != nil {
+				handler.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedMessage != "" {
+				var response map[string]string
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedMessage, response["message"])
+			}
+			sessionService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_Login_CountryHeader(t *testing.T) {
+	t.Setenv("LOGIN_COUNTRY_HEADER", "CF-IPCountry")
+
+	authService := new(mocks.MockAuthServiceInterface)
+	authService.On("Login", mock.Anything).Return(&dto.UserInfo{ID: "usr_5k2d9x0q7m3hv8c1b4na", UserID: 1}, nil)
+	sessionService := new(mocks.MockSessionServiceInterface)
+	sessionService.On("CreateSession", uint(1), service.SessionMetadata{
+		IPAddress: "203.0.113.7",
+		UserAgent: "login-test/1.0",
+		Country:   "DE",
+	}).Return(&auth.TokenPair{AccessTokenExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+
+	body, _ := json.Marshal(dto.LoginRequest{Email: "test@example.com", Password: "Password123!"})
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXRealIP, "203.0.113.7")
+	req.Header.Set("User-Agent", "login-test/1.0")
+	req.Header.Set("CF-IPCountry", "DE")
+	rec := httptest.NewRecorder()
+
+	err := handler.NewAuthHandler(authService, sessionService, allowAudit()).Login(echo.New().NewContext(req, rec))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	sessionService.AssertExpectations(t)
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/oauth"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newProviderContext(path, provider string, body map[string]interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("provider")
+	c.SetParamValues(provider)
+	return c, rec
+}
+
+func TestAuthHandler_ProviderSignup(t *testing.T) {
+	tests := []struct {
+		requestBody    map[string]interface{}
+		setupMocks     func(*mocks.MockAuthServiceInterface, *mocks.MockSessionServiceInterface)
+		name           string
+		provider       string
+		expectedCode   string
+		expectedStatus int
+	}{
+		{
+			name:        "successful signup creates session",
+			provider:    "github",
+			requestBody: map[string]interface{}{"code": "auth-code", "code_verifier": "verifier"},
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, mockSession *mocks.MockSessionServiceInterface) {
+				mockService.On("ProviderSignup", "github", mock.MatchedBy(func(req *dto.OAuthRequest) bool {
+					return req.Code == "auth-code" && req.CodeVerifier == "verifier"
+				})).Return(&dto.SignupResponse{ID: "usr_5k2d9x0q7m3hv8c1b4na", UserID: 1, Email: "octocat@example.com"}, nil)
+				mockSession.On("CreateSession", uint(1), mock.Anything).Return(&auth.TokenPair{
+					AccessToken:  "access",
+					RefreshToken: "refresh",
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "missing code and access token",
+			provider:    "github",
+			requestBody: map[string]interface{}{},
+			setupMocks: func(_ *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				// No service call expected
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E003",
+		},
+		{
+			name:        "unknown provider",
+			provider:    "myspace",
+			requestBody: map[string]interface{}{"access_token": "token"},
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				mockService.On("ProviderSignup", "myspace", mock.Anything).Return(nil, oauth.ErrUnknownProvider)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "E004",
+		},
+		{
+			name:        "user already exists",
+			provider:    "github",
+			requestBody: map[string]interface{}{"access_token": "token"},
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				mockService.On("ProviderSignup", "github", mock.Anything).Return(nil, auth.ErrUserAlreadyExists)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "E102",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mocks.MockAuthServiceInterface{}
+			mockSessionService := &mocks.MockSessionServiceInterface{}
+			handler := NewAuthHandler(mockService, mockSessionService, allowAudit())
+			tt.setupMocks(mockService, mockSessionService)
+
+			c, rec := newProviderContext("/api/auth/"+tt.provider+"/signup", tt.provider, tt.requestBody)
+
+			serve(c, handler.ProviderSignup)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+
+			mockService.AssertExpectations(t)
+			mockSessionService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthHandler_ProviderLogin(t *testing.T) {
+	tests := []struct {
+		setupMocks     func(*mocks.MockAuthServiceInterface, *mocks.MockSessionServiceInterface)
+		name           string
+		expectedStatus int
+	}{
+		{
+			name: "successful login creates session",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, mockSession *mocks.MockSessionServiceInterface) {
+				mockService.On("ProviderLogin", "microsoft", mock.Anything).
+					Return(&dto.UserInfo{ID: "usr_5k2d9x0q7m3hv8c1b4na", UserID: 1, Email: "user@example.com"}, nil)
+				mockSession.On("CreateSession", uint(1), mock.Anything).Return(&auth.TokenPair{
+					AccessToken:  "access",
+					RefreshToken: "refresh",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "invalid credentials",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				mockService.On("ProviderLogin", "microsoft", mock.Anything).Return(nil, auth.ErrInvalidCredentials)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "provider not configured",
+			setupMocks: func(mockService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				mockService.On("ProviderLogin", "microsoft", mock.Anything).Return(nil, oauth.ErrProviderNotConfigured)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mocks.MockAuthServiceInterface{}
+			mockSessionService := &mocks.MockSessionServiceInterface{}
+			handler := NewAuthHandler(mockService, mockSessionService, allowAudit())
+			tt.setupMocks(mockService, mockSessionService)
+
+			c, rec := newProviderContext("/api/auth/microsoft/login", "microsoft",
+				map[string]interface{}{"access_token": "token"})
+
+			serve(c, handler.ProviderLogin)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockService.AssertExpectations(t)
+			mockSessionService.AssertExpectations(t)
+		})
+	}
+}
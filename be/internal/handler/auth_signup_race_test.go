@@ -0,0 +1,131 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	repomocks "strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// racingUserRepository is an in-memory user store that enforces unique emails like the database index.
+// FindByEmail blocks until every concurrent signup has checked, so all of them race to Create.
+type racingUserRepository struct {
+	repository.UserRepository
+	users   map[string]*model.User
+	checked sync.WaitGroup
+	mu      sync.Mutex
+}
+
+func newRacingUserRepository(signups int) *racingUserRepository {
+	repo := &racingUserRepository{users: make(map[string]*model.User)}
+	repo.checked.Add(signups)
+	return repo
+}
+
+func (r *racingUserRepository) FindByEmail(email string) (*model.User, error) {
+	r.mu.Lock()
+	user, found := r.users[email]
+	r.mu.Unlock()
+
+	r.checked.Done()
+	r.checked.Wait()
+
+	if !found {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+func (r *racingUserRepository) Create(user *model.User) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[*user.Email]; exists {
+		return nil, repository.ErrDuplicateEmail
+	}
+	user.ID = uint(len(r.users) + 1)
+	user.CreatedAt = time.Now()
+	r.users[*user.Email] = user
+	return user, nil
+}
+
+// passthroughTxManager runs units of work directly against fixed repositories
+type passthroughTxManager struct {
+	repos *repository.Repositories
+}
+
+func (m *passthroughTxManager) WithTransaction(_ context.Context, fn func(repos *repository.Repositories) error) error {
+	return fn(m.repos)
+}
+
+func TestAuthHandler_SignupConcurrentDuplicates(t *testing.T) {
+	const signups = 8
+
+	userRepo := newRacingUserRepository(signups)
+	sessionRepo := &repomocks.MockSessionRepository{}
+	sessionRepo.On("Create", mock.MatchedBy(func(session *model.UserSession) bool {
+		return session.UserID == 1
+	})).Return(nil).Once()
+	txManager := &passthroughTxManager{repos: &repository.Repositories{Users: userRepo, Sessions: sessionRepo}}
+	authService := service.NewAuthService(
+		userRepo, nil, nil, txManager, nil, auth.NewJWTService(clock.New()), auth.NewPasswordHasher(), nil,
+		events.NewBus(), clock.New(), auth.NewTokenGenerator(),
+	)
+	authHandler := handler.NewAuthHandler(authService, &mocks.MockSessionServiceInterface{}, allowAudit())
+	e := echo.New()
+
+	body, _ := json.Marshal(dto.SignupRequest{
+		Email:       "race@example.com",
+		Password:    "Password123!",
+		DisplayName: "Race User",
+	})
+
+	recorders := make([]*httptest.ResponseRecorder, signups)
+	var wg sync.WaitGroup
+	for i := range recorders {
+		recorders[i] = httptest.NewRecorder()
+		wg.Add(1)
+		go func(rec *httptest.ResponseRecorder) {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodPost, "/api/auth/signup", bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			serve(e.NewContext(req, rec), authHandler.Signup)
+		}(recorders[i])
+	}
+	wg.Wait()
+
+	created := 0
+	for _, rec := range recorders {
+		if rec.Code == http.StatusCreated {
+			created++
+			continue
+		}
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		var response dto.ErrorResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "E102", response.Code)
+	}
+	assert.Equal(t, 1, created)
+	sessionRepo.AssertExpectations(t)
+}
@@ -31,7 +31,7 @@ type AuthHandlerTestSuite struct {
 func (suite *AuthHandlerTestSuite) SetupTest() {
 	suite.mockService = new(mocks.MockAuthServiceInterface)
 	suite.mockSessionService = new(mocks.MockSessionServiceInterface)
-	suite.authHandler = handler.NewAuthHandler(suite.mockService, suite.mockSessionService)
+	suite.authHandler = handler.NewAuthHandler(suite.mockService, suite.mockSessionService, allowAudit())
 	suite.echo = echo.New()
 }
 
@@ -60,30 +60,28 @@ func (suite *AuthHandlerTestSuite) TestSignup() {
 			},
 			mockSetup: func() {
 				expectedResponse := &dto.SignupResponse{
-					ID:            1,
+					ID:            "usr_5k2d9x0q7m3hv8c1b4na",
+					UserID:        1,
 					Email:         "test@example.com",
 					DisplayName:   "Test User",
 					EmailVerified: false,
 					CreatedAt:     time.Now(),
 				}
-				suite.mockService.On("Signup", mock.MatchedBy(func(req *dto.SignupRequest) bool {
-					return req.Email == "test@example.com" &&
-						req.Password == "Password123!" &&
-						req.DisplayName == "Test User"
-				})).Return(expectedResponse, nil)
-
-				// Mock session creation for successful signup
 				expectedTokenPair := &auth.TokenPair{
 					AccessToken:           "test-access-token",
 					RefreshToken:          "test-refresh-token",
 					AccessTokenExpiresAt:  time.Now().Add(time.Hour),
 					RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
 				}
-				suite.mockSessionService.On("CreateSession", uint(1)).Return(expectedTokenPair, nil)
+				suite.mockService.On("SignupWithSession", mock.Anything, mock.MatchedBy(func(req *dto.SignupRequest) bool {
+					return req.Email == "test@example.com" &&
+						req.Password == "Password123!" &&
+						req.DisplayName == "Test User"
+				}), mock.AnythingOfType("service.SessionMetadata")).Return(expectedResponse, expectedTokenPair, nil)
 			},
 			expectedStatus: http.StatusCreated,
 			expectedData: &dto.SignupResponse{
-				ID:            1,
+				ID:            "usr_5k2d9x0q7m3hv8c1b4na",
 				Email:         "test@example.com",
 				DisplayName:   "Test User",
 				EmailVerified: false,
@@ -168,7 +166,8 @@ func (suite *AuthHandlerTestSuite) TestSignup() {
 				DisplayName: "Test User",
 			},
 			mockSetup: func() {
-				suite.mockService.On("Signup", mock.AnythingOfType("*dto.SignupRequest")).Return(nil, auth.ErrUserAlreadyExists)
+				suite.mockService.On("SignupWithSession", mock.Anything, mock.AnythingOfType("*dto.SignupRequest"), mock.Anything).
+					Return(nil, nil, auth.ErrUserAlreadyExists)
 			},
 			expectedStatus: http.StatusConflict,
 			expectedError: &dto.ErrorResponse{
@@ -185,7 +184,8 @@ func (suite *AuthHandlerTestSuite) TestSignup() {
 				DisplayName: "Test User",
 			},
 			mockSetup: func() {
-				suite.mockService.On("Signup", mock.AnythingOfType("*dto.SignupRequest")).Return(nil, assert.AnError)
+				suite.mockService.On("SignupWithSession", mock.Anything, mock.AnythingOfType("*dto.SignupRequest"), mock.Anything).
+					Return(nil, nil, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError: &dto.ErrorResponse{
@@ -215,15 +215,14 @@ func (suite *AuthHandlerTestSuite) TestSignup() {
 			c := suite.echo.NewContext(req, rec)
 
 			// Execute
-			err := suite.authHandler.Signup(c)
+			serve(c, suite.authHandler.Signup)
 
 			// Assert
-			assert.NoError(suite.T(), err, tt.description)
 			assert.Equal(suite.T(), tt.expectedStatus, rec.Code, tt.description)
 
 			if tt.expectedError != nil {
 				var errorResponse dto.ErrorResponse
-				err = json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
 				assert.NoError(suite.T(), err)
 				assert.Equal(suite.T(), tt.expectedError.Code, errorResponse.Code, tt.description)
 				assert.Equal(suite.T(), tt.expectedError.Message, errorResponse.Message, tt.description)
@@ -234,7 +233,7 @@ func (suite *AuthHandlerTestSuite) TestSignup() {
 
 			if tt.expectedData != nil {
 				var response dto.SignupResponse
-				err = json.Unmarshal(rec.Body.Bytes(), &response)
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
 				assert.NoError(suite.T(), err)
 				assert.Equal(suite.T(), tt.expectedData.ID, response.ID, tt.description)
 				assert.Equal(suite.T(), tt.expectedData.Email, response.Email, tt.description)
@@ -265,7 +264,8 @@ func (suite *AuthHandlerTestSuite) TestLogin() {
 			},
 			mockSetup: func() {
 				expectedResponse := &dto.UserInfo{
-					ID:            1,
+					ID:            "usr_5k2d9x0q7m3hv8c1b4na",
+					UserID:        1,
 					Email:         "test@example.com",
 					DisplayName:   "Test User",
 					EmailVerified: false,
@@ -281,11 +281,11 @@ func (suite *AuthHandlerTestSuite) TestLogin() {
 					AccessTokenExpiresAt:  time.Now().Add(time.Hour),
 					RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
 				}
-				suite.mockSessionService.On("CreateSession", uint(1)).Return(expectedTokenPair, nil)
+				suite.mockSessionService.On("CreateSession", uint(1), mock.AnythingOfType("service.SessionMetadata")).Return(expectedTokenPair, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedData: &dto.UserInfo{
-				ID:            1,
+				ID:            "usr_5k2d9x0q7m3hv8c1b4na",
 				Email:         "test@example.com",
 				DisplayName:   "Test User",
 				EmailVerified: false,
@@ -333,6 +333,41 @@ func (suite *AuthHandlerTestSuite) TestLogin() {
 			},
 			description: "should return unauthorized for invalid credentials",
 		},
+		{
+			name: "account disabled",
+			requestBody: dto.LoginRequest{
+				Email:    "test@example.com",
+				Password: "Password123!",
+			},
+			mockSetup: func() {
+				suite.mockService.On("Login", mock.AnythingOfType("*dto.LoginRequest")).Return(nil, auth.ErrAccountDisabled)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E301",
+				Message: "Account disabled",
+			},
+			description: "should return forbidden for accounts disabled by an admin",
+		},
+		{
+			name: "session limit reached",
+			requestBody: dto.LoginRequest{
+				Email:    "test@example.com",
+				Password: "Password123!",
+			},
+			mockSetup: func() {
+				suite.mockService.On("Login", mock.AnythingOfType("*dto.LoginRequest")).
+					Return(&dto.UserInfo{UserID: 1, Email: "test@example.com"}, nil)
+				suite.mockSessionService.On("CreateSession", uint(1), mock.AnythingOfType("service.SessionMetadata")).
+					Return(nil, auth.ErrSessionLimitReached)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError: &dto.ErrorResponse{
+				Code:    "E309",
+				Message: "Session limit reached",
+			},
+			description: "should return conflict when the user has the maximum number of sessions",
+		},
 		{
 			name: "internal server error",
 			requestBody: dto.LoginRequest{
@@ -370,15 +405,14 @@ func (suite *AuthHandlerTestSuite) TestLogin() {
 			c := suite.echo.NewContext(req, rec)
 
 			// Execute
-			err := suite.authHandler.Login(c)
+			serve(c, suite.authHandler.Login)
 
 			// Assert
-			assert.NoError(suite.T(), err, tt.description)
 			assert.Equal(suite.T(), tt.expectedStatus, rec.Code, tt.description)
 
 			if tt.expectedError != nil {
 				var errorResponse dto.ErrorResponse
-				err = json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
 				assert.NoError(suite.T(), err)
 				assert.Equal(suite.T(), tt.expectedError.Code, errorResponse.Code, tt.description)
 				assert.Equal(suite.T(), tt.expectedError.Message, errorResponse.Message, tt.description)
@@ -389,7 +423,7 @@ func (suite *AuthHandlerTestSuite) TestLogin() {
 
 			if tt.expectedData != nil {
 				var response dto.UserInfo
-				err = json.Unmarshal(rec.Body.Bytes(), &response)
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
 				assert.NoError(suite.T(), err)
 				assert.Equal(suite.T(), tt.expectedData.ID, response.ID, tt.description)
 				assert.Equal(suite.T(), tt.expectedData.Email, response.Email, tt.description)
@@ -402,7 +436,7 @@ func (suite *AuthHandlerTestSuite) TestLogin() {
 
 func (suite *AuthHandlerTestSuite) TestNewAuthHandler() {
 	// Test that NewAuthHandler creates a valid handler
-	h := handler.NewAuthHandler(suite.mockService, suite.mockSessionService)
+	h := handler.NewAuthHandler(suite.mockService, suite.mockSessionService, allowAudit())
 	assert.NotNil(suite.T(), h)
 }
 
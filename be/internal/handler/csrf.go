@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CSRFHandler issues the CSRF tokens that browser clients using session cookies send with
+// state-changing requests
+type CSRFHandler struct{}
+
+// NewCSRFHandler creates a new CSRF handler
+func NewCSRFHandler() CSRFHandlerInterface {
+	return &CSRFHandler{}
+}
+
+// IssueToken sets the CSRF cookie and returns its token. A valid token already held by the client
+// is returned unchanged, so that tabs sharing the cookie keep working.
+func (h *CSRFHandler) IssueToken(c echo.Context) error {
+	if cookie, err := c.Cookie(auth.CSRFCookieName); err == nil && auth.IsCSRFToken(cookie.Value) {
+		return c.JSON(http.StatusOK, dto.CSRFTokenResponse{CSRFToken: cookie.Value})
+	}
+
+	token, err := auth.GenerateCSRFToken()
+	if err != nil {
+		slog.Error("Failed to generate CSRF token", "error", err)
+		return err
+	}
+
+	// Not HttpOnly: the client reads the cookie to copy it into the X-CSRF-Token header
+	c.SetCookie(&http.Cookie{
+		Name:     auth.CSRFCookieName,
+		Value:    token,
+		Path:     "/",
+		Secure:   c.IsTLS() || c.Scheme() == "https",
+		SameSite: http.SameSiteStrictMode,
+	})
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	return c.JSON(http.StatusOK, dto.CSRFTokenResponse{CSRFToken: token})
+}
@@ -0,0 +1,66 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSRFHandler_IssueToken(t *testing.T) {
+	existing, err := auth.GenerateCSRFToken()
+	require.NoError(t, err)
+
+	tests := []struct {
+		cookie       *http.Cookie
+		name         string
+		expectCookie bool
+		expectSame   bool
+	}{
+		{name: "new client", expectCookie: true},
+		{name: "existing token", cookie: &http.Cookie{Name: auth.CSRFCookieName, Value: existing}, expectSame: true},
+		{name: "malformed token", cookie: &http.Cookie{Name: auth.CSRFCookieName, Value: "forged"}, expectCookie: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/auth/csrf", nil)
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, handler.NewCSRFHandler().IssueToken(c))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var response dto.CSRFTokenResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.True(t, auth.IsCSRFToken(response.CSRFToken))
+			if tt.expectSame {
+				assert.Equal(t, existing, response.CSRFToken)
+			}
+
+			cookies := rec.Result().Cookies()
+			if !tt.expectCookie {
+				assert.Empty(t, cookies)
+				return
+			}
+			require.Len(t, cookies, 1)
+			assert.Equal(t, auth.CSRFCookieName, cookies[0].Name)
+			assert.Equal(t, response.CSRFToken, cookies[0].Value)
+			assert.False(t, cookies[0].HttpOnly)
+			assert.Equal(t, http.SameSiteStrictMode, cookies[0].SameSite)
+			assert.Equal(t, "no-store", rec.Header().Get(echo.HeaderCacheControl))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DataExportHandler handles exports of the current user's data
+type DataExportHandler struct {
+	dataExportService service.DataExportServiceInterface
+	auditService      service.AuditServiceInterface
+}
+
+// NewDataExportHandler creates a new data export handler
+func NewDataExportHandler(
+	dataExportService service.DataExportServiceInterface,
+	auditService service.AuditServiceInterface,
+) DataExportHandlerInterface {
+	return &DataExportHandler{
+		dataExportService: dataExportService,
+		auditService:      auditService,
+	}
+}
+
+// RequestExport starts assembling an archive of the authenticated user's data. The archive is
+// built in the background; poll the returned export until it is ready to download.
+func (h *DataExportHandler) RequestExport(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	response, err := h.dataExportService.RequestExport(userID)
+	if err != nil {
+		slog.Error("Failed to request data export", "error", err, "user_id", userID)
+		return err
+	}
+
+	entry := middleware.AuditEntry(c, service.AuditActionDataExportRequested)
+	entry.Metadata = map[string]string{"export_id": response.ID}
+	h.auditService.Record(entry)
+	return c.JSON(http.StatusAccepted, response)
+}
+
+// GetExport returns the status of one of the authenticated user's exports, with a temporary
+// download link once it is ready
+func (h *DataExportHandler) GetExport(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	response, err := h.dataExportService.GetExport(userID, c.Param("id"))
+	if err != nil {
+		if stderrors.Is(err, repository.ErrDataExportNotFound) {
+			return errors.Wrap(errors.ErrCodeNotFound, err, "data export not found")
+		}
+		slog.Error("Failed to get data export", "error", err, "user_id", userID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// Download returns the archive of an export through its temporary download link. The token in the
+// link authenticates the request, so that the archive can be fetched by a plain browser download.
+func (h *DataExportHandler) Download(c echo.Context) error {
+	archive, err := h.dataExportService.Download(c.Request().Context(), c.Param("id"), c.QueryParam("token"))
+	if err != nil {
+		if stderrors.Is(err, auth.ErrInvalidDataExportToken) {
+			return err
+		}
+		if stderrors.Is(err, repository.ErrDataExportNotFound) {
+			return errors.Wrap(errors.ErrCodeNotFound, err, "data export not found")
+		}
+		slog.Error("Failed to download data export", "error", err, "export_id", c.Param("id"))
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="`+archive.Filename+`"`)
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	c.Response().Header().Set("X-Content-Type-Options", "nosniff")
+	return c.Blob(http.StatusOK, "application/zip", archive.Body)
+}
@@ -0,0 +1,124 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataExportHandler_RequestExport(t *testing.T) {
+	dataExportService := mocks.NewMockDataExportServiceInterface(t)
+	dataExportService.EXPECT().RequestExport(uint(1)).Return(&dto.DataExportResponse{
+		CreatedAt: time.Now(),
+		ID:        "exp_9c4m1x7k2d5v8h0q3b6n",
+		Status:    model.DataExportStatusPending,
+	}, nil).Once()
+	auditService := mocks.NewMockAuditServiceInterface(t)
+	auditService.On("Record", mock.MatchedBy(func(entry service.AuditEntry) bool {
+		return entry.Action == service.AuditActionDataExportRequested &&
+			entry.Metadata["export_id"] == "exp_9c4m1x7k2d5v8h0q3b6n"
+	})).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users/me/export", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user_id", uint(1))
+
+	serve(c, handler.NewDataExportHandler(dataExportService, auditService).RequestExport)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	var response dto.DataExportResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, model.DataExportStatusPending, response.Status)
+}
+
+func TestDataExportHandler_GetExport(t *testing.T) {
+	tests := []struct {
+		err            error
+		name           string
+		expectedStatus int
+	}{
+		{name: "found", expectedStatus: http.StatusOK},
+		{name: "not found", err: repository.ErrDataExportNotFound, expectedStatus: http.StatusNotFound},
+		{name: "database error", err: assert.AnError, expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataExportService := mocks.NewMockDataExportServiceInterface(t)
+			var response *dto.DataExportResponse
+			if tt.err == nil {
+				response = &dto.DataExportResponse{ID: "exp_9c4m1x7k2d5v8h0q3b6n", Status: model.DataExportStatusReady}
+			}
+			dataExportService.EXPECT().GetExport(uint(1), "exp_9c4m1x7k2d5v8h0q3b6n").Return(response, tt.err).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/exports/exp_9c4m1x7k2d5v8h0q3b6n", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("user_id", uint(1))
+			c.SetParamNames("id")
+			c.SetParamValues("exp_9c4m1x7k2d5v8h0q3b6n")
+
+			serve(c, handler.NewDataExportHandler(dataExportService, nil).GetExport)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestDataExportHandler_Download(t *testing.T) {
+	tests := []struct {
+		err            error
+		name           string
+		expectedStatus int
+	}{
+		{name: "downloads the archive", expectedStatus: http.StatusOK},
+		{name: "invalid link", err: auth.ErrInvalidDataExportToken, expectedStatus: http.StatusUnauthorized},
+		{name: "expired export", err: repository.ErrDataExportNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataExportService := mocks.NewMockDataExportServiceInterface(t)
+			var archive *service.DataExportArchive
+			if tt.err == nil {
+				archive = &service.DataExportArchive{Filename: "strikepad-export-exp_1.zip", Body: []byte("archive")}
+			}
+			dataExportService.EXPECT().Download(mock.Anything, "exp_9c4m1x7k2d5v8h0q3b6n", "export-token").
+				Return(archive, tt.err).Once()
+
+			req := httptest.NewRequest(http.MethodGet,
+				"/api/exports/exp_9c4m1x7k2d5v8h0q3b6n/download?token=export-token", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("exp_9c4m1x7k2d5v8h0q3b6n")
+
+			if err := handler.NewDataExportHandler(dataExportService, nil).Download(c); err != nil {
+				handler.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.err == nil {
+				assert.Equal(t, "archive", rec.Body.String())
+				assert.Equal(t, "application/zip", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, `attachment; filename="strikepad-export-exp_1.zip"`,
+					rec.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal(t, "no-store", rec.Header().Get(echo.HeaderCacheControl))
+			}
+		})
+	}
+}
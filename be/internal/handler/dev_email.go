@@ -0,0 +1,39 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DevEmailHandler handles the development endpoint listing emails captured by the sandbox mailer
+type DevEmailHandler struct {
+	devEmailService service.DevEmailServiceInterface
+}
+
+// NewDevEmailHandler creates a new dev email handler
+func NewDevEmailHandler(devEmailService service.DevEmailServiceInterface) DevEmailHandlerInterface {
+	return &DevEmailHandler{
+		devEmailService: devEmailService,
+	}
+}
+
+// ListEmails returns the captured emails, newest first, optionally only those sent to ?to=
+func (h *DevEmailHandler) ListEmails(c echo.Context) error {
+	response, err := h.devEmailService.ListEmails(c.QueryParam("to"))
+	if err != nil {
+		if stderrors.Is(err, service.ErrEmailCaptureDisabled) {
+			return errors.Wrap(errors.ErrCodeNotFound, err, "email capture disabled").
+				WithDescription("Emails are only captured in sandbox mode (SANDBOX_MODE=true)")
+		}
+		slog.Error("Failed to list captured emails", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
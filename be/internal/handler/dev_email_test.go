@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevEmailHandler_ListEmails(t *testing.T) {
+	tests := []struct {
+		response       *dto.DevEmailListResponse
+		listErr        error
+		name           string
+		expectedCode   string
+		expectedStatus int
+	}{
+		{
+			name: "captured emails",
+			response: &dto.DevEmailListResponse{Data: []dto.DevEmail{{
+				To: "alice@sandbox.test", Subject: "Welcome", Links: []string{"https://app.example.com"},
+			}}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "capture disabled",
+			listErr:        service.ErrEmailCaptureDisabled,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "E004",
+		},
+		{
+			name:           "unexpected error",
+			listErr:        assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewMockDevEmailServiceInterface(t)
+			mockService.EXPECT().ListEmails("alice@sandbox.test").Return(tt.response, tt.listErr).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/internal/dev/emails?to=alice@sandbox.test", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			serve(c, handler.NewDevEmailHandler(mockService).ListEmails)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+				return
+			}
+
+			var response dto.DevEmailListResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.response.Data[0].Links, response.Data[0].Links)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/validator"
+
+	"github.com/labstack/echo/v4"
+)
+
+// EmailChangeHandler handles changes of the current user's email address
+type EmailChangeHandler struct {
+	emailChangeService service.EmailChangeServiceInterface
+	auditService       service.AuditServiceInterface
+	validator          *validator.Validator
+}
+
+// NewEmailChangeHandler creates a new email change handler
+func NewEmailChangeHandler(
+	emailChangeService service.EmailChangeServiceInterface,
+	auditService service.AuditServiceInterface,
+) EmailChangeHandlerInterface {
+	return &EmailChangeHandler{
+		emailChangeService: emailChangeService,
+		auditService:       auditService,
+		validator:          validator.New(),
+	}
+}
+
+// RequestEmailChange emails links confirming the change of the authenticated user's email address
+// to the current and the requested address. The change takes effect once both were opened.
+func (h *EmailChangeHandler) RequestEmailChange(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	session, hasSession := c.Get("session").(*model.UserSession)
+	if !ok || !hasSession {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	var req dto.EmailChangeRequest
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for email change", "error", err)
+		return errInvalidBody
+	}
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "email change")
+	}
+
+	proof := service.StepUpProof{AuthenticatedAt: session.CreatedAt, Password: req.Password}
+	response, err := h.emailChangeService.RequestEmailChange(userID, &req, proof)
+	if err != nil {
+		// Application errors, such as a missing step-up or a registered address, keep their code
+		var appErr *errors.AppError
+		if stderrors.As(err, &appErr) {
+			return err
+		}
+		if stderrors.Is(err, repository.ErrStaleVersion) {
+			return errEmailChangeConflict
+		}
+		slog.Error("Failed to request email change", "error", err, "user_id", userID)
+		return err
+	}
+
+	entry := middleware.AuditEntry(c, service.AuditActionEmailChangeRequested)
+	entry.Metadata = map[string]string{"sign_out_everywhere": strconv.FormatBool(req.SignOutEverywhere)}
+	h.auditService.Record(entry)
+	return c.JSON(http.StatusAccepted, response)
+}
+
+// ConfirmEmailChange confirms an email change with the token of a link emailed to the current or
+// the requested address. The token authenticates the request, so that the link can be opened on
+// any device.
+func (h *EmailChangeHandler) ConfirmEmailChange(c echo.Context) error {
+	var req dto.ConfirmEmailChangeRequest
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for email change confirmation", "error", err)
+		return errInvalidBody
+	}
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "email change confirmation")
+	}
+
+	user, response, err := h.emailChangeService.ConfirmEmailChange(req.Token)
+	if err != nil {
+		// An invalid link or an address registered in the meantime keep their code
+		var appErr *errors.AppError
+		if stderrors.As(err, &appErr) {
+			return err
+		}
+		if stderrors.Is(err, repository.ErrStaleVersion) {
+			return errEmailChangeConflict
+		}
+		slog.Error("Failed to confirm email change", "error", err)
+		return err
+	}
+
+	if response.Completed {
+		entry := middleware.AuditEntry(c, service.AuditActionEmailChanged)
+		entry.ActorID = user.PublicID
+		h.auditService.Record(entry)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// CancelEmailChange drops the authenticated user's pending email change
+func (h *EmailChangeHandler) CancelEmailChange(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	if err := h.emailChangeService.CancelEmailChange(userID); err != nil {
+		switch {
+		case stderrors.Is(err, service.ErrEmailChangeNotFound):
+			return errors.Wrap(errors.ErrCodeNotFound, err, "no pending email change").
+				WithDescription("No email change is pending")
+		case stderrors.Is(err, repository.ErrStaleVersion):
+			return errEmailChangeConflict
+		default:
+			slog.Error("Failed to cancel email change", "error", err, "user_id", userID)
+			return err
+		}
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Email change cancelled",
+	})
+}
+
+// errEmailChangeConflict answers a request that lost a race with another change of the user
+var errEmailChangeConflict = errors.New(errors.ErrCodeConflict, "email change raced").
+	WithDescription("The user was changed at the same time, try again")
@@ -0,0 +1,161 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailChangeHandler_RequestEmailChange(t *testing.T) {
+	signedInAt := time.Now().Add(-time.Minute)
+	tests := []struct {
+		err            error
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{name: "emails both addresses", body: `{"email":"new@example.com","password":"Password123!"}`, expectedStatus: http.StatusAccepted},
+		{name: "invalid email", body: `{"email":"not-an-email"}`, expectedStatus: http.StatusBadRequest},
+		{
+			name: "without step-up", body: `{"email":"new@example.com"}`, err: auth.ErrStepUpRequired,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "registered address", body: `{"email":"new@example.com"}`, err: auth.ErrUserAlreadyExists,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "concurrent change", body: `{"email":"new@example.com"}`, err: repository.ErrStaleVersion,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emailChangeService := mocks.NewMockEmailChangeServiceInterface(t)
+			auditService := mocks.NewMockAuditServiceInterface(t)
+			if tt.expectedStatus != http.StatusBadRequest {
+				var response *dto.EmailChangeResponse
+				if tt.err == nil {
+					response = &dto.EmailChangeResponse{Email: "new@example.com"}
+					auditService.On("Record", mock.MatchedBy(func(entry service.AuditEntry) bool {
+						return entry.Action == service.AuditActionEmailChangeRequested
+					})).Once()
+				}
+				emailChangeService.EXPECT().RequestEmailChange(uint(1), mock.AnythingOfType("*dto.EmailChangeRequest"),
+					mock.MatchedBy(func(proof service.StepUpProof) bool { return proof.AuthenticatedAt.Equal(signedInAt) })).
+					Return(response, tt.err).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/users/me/email", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("user_id", uint(1))
+			c.Set("session", &model.UserSession{UserID: 1, CreatedAt: signedInAt})
+
+			if err := handler.NewEmailChangeHandler(emailChangeService, auditService).RequestEmailChange(c); err != nil {
+				handler.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestEmailChangeHandler_ConfirmEmailChange(t *testing.T) {
+	tests := []struct {
+		err            error
+		response       *dto.EmailChangeResponse
+		name           string
+		expectedStatus int
+	}{
+		{
+			name: "first confirmation", response: &dto.EmailChangeResponse{Email: "new@example.com", NewAddressConfirmed: true},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "completes the change", response: &dto.EmailChangeResponse{Email: "new@example.com", Completed: true},
+			expectedStatus: http.StatusOK,
+		},
+		{name: "invalid link", err: auth.ErrInvalidEmailChangeToken, expectedStatus: http.StatusUnauthorized},
+		{name: "database error", err: assert.AnError, expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emailChangeService := mocks.NewMockEmailChangeServiceInterface(t)
+			var user *model.User
+			if tt.err == nil {
+				user = &model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}
+			}
+			emailChangeService.EXPECT().ConfirmEmailChange("change-token").Return(user, tt.response, tt.err).Once()
+			auditService := mocks.NewMockAuditServiceInterface(t)
+			if tt.response != nil && tt.response.Completed {
+				auditService.On("Record", mock.MatchedBy(func(entry service.AuditEntry) bool {
+					return entry.Action == service.AuditActionEmailChanged && entry.ActorID == "usr_5k2d9x0q7m3hv8c1b4na"
+				})).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/auth/email-change/confirm",
+				strings.NewReader(`{"token":"change-token"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			if err := handler.NewEmailChangeHandler(emailChangeService, auditService).ConfirmEmailChange(c); err != nil {
+				handler.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.response != nil {
+				var response dto.EmailChangeResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, *tt.response, response)
+			}
+		})
+	}
+}
+
+func TestEmailChangeHandler_CancelEmailChange(t *testing.T) {
+	tests := []struct {
+		err            error
+		name           string
+		expectedStatus int
+	}{
+		{name: "cancelled", expectedStatus: http.StatusOK},
+		{name: "nothing pending", err: service.ErrEmailChangeNotFound, expectedStatus: http.StatusNotFound},
+		{name: "database error", err: assert.AnError, expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emailChangeService := mocks.NewMockEmailChangeServiceInterface(t)
+			emailChangeService.EXPECT().CancelEmailChange(uint(1)).Return(tt.err).Once()
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/email", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("user_id", uint(1))
+
+			serve(c, handler.NewEmailChangeHandler(emailChangeService, nil).CancelEmailChange)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
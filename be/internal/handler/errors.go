@@ -0,0 +1,101 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+)
+
+// httpStatusCodes maps the statuses of Echo's HTTP errors to error codes
+var httpStatusCodes = map[int]errors.ErrorCode{
+	http.StatusBadRequest:       errors.ErrCodeInvalidRequest,
+	http.StatusUnauthorized:     errors.ErrCodeUnauthorized,
+	http.StatusForbidden:        errors.ErrCodeForbidden,
+	http.StatusNotFound:         errors.ErrCodeNotFound,
+	http.StatusMethodNotAllowed: errors.ErrCodeMethodNotAllowed,
+	http.StatusConflict:         errors.ErrCodeConflict,
+	http.StatusTooManyRequests:  errors.ErrCodeTooManyRequests,
+}
+
+// HTTPErrorHandler writes the errors returned by handlers and middleware as an ErrorResponse.
+// AppErrors get the message and status of their code and list their field details. Echo's HTTP
+// errors, such as unknown routes, keep their status with the matching code. Any other error,
+// including a recovered panic, is an internal error whose details are only logged.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		slog.Warn("Error after the response was sent", "error", err, "path", c.Path())
+		return
+	}
+
+	errorInfo := errorInfoFor(err, middleware.GetLanguageFromContext(c))
+	if errorInfo.HTTPStatus >= http.StatusInternalServerError {
+		slog.Error("Request failed", "error", err, "method", c.Request().Method, "path", c.Path())
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(errorInfo.HTTPStatus)
+	} else {
+		err = writeErrorResponse(c, errorInfo, fieldErrorsOf(err))
+	}
+	if err != nil {
+		slog.Error("Failed to write error response", "error", err)
+	}
+}
+
+// writeErrorResponse writes an ErrorResponse with errorInfo listing the fields that failed validation
+func writeErrorResponse(c echo.Context, errorInfo errors.ErrorInfo, fieldErrors []errors.FieldError) error {
+	response := dto.ErrorResponse{
+		Code:        string(errorInfo.Code),
+		Message:     errorInfo.Message,
+		Description: errorInfo.Description,
+	}
+	for _, fieldErr := range fieldErrors {
+		response.Details = append(response.Details, dto.ValidationError{
+			Field:   fieldErr.Field,
+			Tag:     fieldErr.Tag,
+			Value:   fieldErr.Value,
+			Message: fieldErr.Message,
+		})
+	}
+	return c.JSON(errorInfo.HTTPStatus, response)
+}
+
+// fieldErrorsOf returns the fields that failed validation when err is an AppError
+func fieldErrorsOf(err error) []errors.FieldError {
+	var appErr *errors.AppError
+	if stderrors.As(err, &appErr) {
+		return appErr.Details
+	}
+	return nil
+}
+
+// errorInfoFor returns the error information of the response for err in lang
+func errorInfoFor(err error, lang string) errors.ErrorInfo {
+	var appErr *errors.AppError
+	if stderrors.As(err, &appErr) {
+		return appErr.Info(lang)
+	}
+
+	var httpErr *echo.HTTPError
+	if stderrors.As(err, &httpErr) {
+		code, ok := httpStatusCodes[httpErr.Code]
+		switch {
+		case ok:
+		case httpErr.Code >= http.StatusInternalServerError:
+			code = errors.ErrCodeInternalError
+		default:
+			code = errors.ErrCodeInvalidRequest
+		}
+		errorInfo := errors.GetErrorInfo(code, lang)
+		errorInfo.HTTPStatus = httpErr.Code
+		return errorInfo
+	}
+
+	return errors.GetErrorInfo(errors.ErrCodeInternalError, lang)
+}
@@ -0,0 +1,149 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/handler"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serve runs h the way the server does, writing the error it returns with the HTTP error handler
+func serve(c echo.Context, h echo.HandlerFunc) {
+	if err := h(c); err != nil {
+		handler.HTTPErrorHandler(err, c)
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		err                 error
+		name                string
+		expectedCode        string
+		expectedDescription string
+		expectedStatus      int
+	}{
+		{
+			name:                "app error",
+			err:                 auth.ErrInvalidCredentials,
+			expectedStatus:      http.StatusUnauthorized,
+			expectedCode:        "E100",
+			expectedDescription: "The provided email or password is incorrect",
+		},
+		{
+			name:                "wrapped app error with description",
+			err:                 fmt.Errorf("login: %w", auth.ErrInvalidCredentials.WithDescription("Invalid Google credentials")),
+			expectedStatus:      http.StatusUnauthorized,
+			expectedCode:        "E100",
+			expectedDescription: "Invalid Google credentials",
+		},
+		{
+			name:                "echo HTTP error",
+			err:                 echo.ErrNotFound,
+			expectedStatus:      http.StatusNotFound,
+			expectedCode:        "E004",
+			expectedDescription: "The requested resource was not found",
+		},
+		{
+			name:                "echo HTTP error without matching code",
+			err:                 echo.ErrStatusRequestEntityTooLarge,
+			expectedStatus:      http.StatusRequestEntityTooLarge,
+			expectedCode:        "E002",
+			expectedDescription: "The request format is invalid or malformed",
+		},
+		{
+			name:                "app error with a cause",
+			err:                 errors.Wrap(errors.ErrCodeInternalError, fmt.Errorf("connection refused"), "failed to find user"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedCode:        "E001",
+			expectedDescription: "An unexpected error occurred on the server",
+		},
+		{
+			name:                "unexpected error",
+			err:                 fmt.Errorf("connection refused"),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedCode:        "E001",
+			expectedDescription: "An unexpected error occurred on the server",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/test", nil)
+			rec := httptest.NewRecorder()
+
+			handler.HTTPErrorHandler(tt.err, echo.New().NewContext(req, rec))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			var response dto.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedCode, response.Code)
+			assert.Equal(t, tt.expectedDescription, response.Description)
+			assert.NotContains(t, rec.Body.String(), "connection refused")
+		})
+	}
+}
+
+func TestHTTPErrorHandler_ValidationDetails(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/api/test", nil)
+	rec := httptest.NewRecorder()
+	err := errors.NewValidation(errors.ErrCodeValidationFailed, errors.FieldError{
+		Field:   "email",
+		Tag:     "email",
+		Value:   "not-an-email",
+		Message: "email must be a valid email address",
+	})
+
+	handler.HTTPErrorHandler(err, echo.New().NewContext(req, rec))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	var response dto.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "E003", response.Code)
+	assert.Equal(t, []dto.ValidationError{{
+		Field:   "email",
+		Tag:     "email",
+		Value:   "not-an-email",
+		Message: "email must be a valid email address",
+	}}, response.Details)
+}
+
+func TestHTTPErrorHandler_RecoveredPanic(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
+	e.Use(middleware.Recover())
+	e.GET("/panic", func(c echo.Context) error {
+		panic("boom")
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	var response dto.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, string(errors.ErrCodeInternalError), response.Code)
+	assert.NotContains(t, rec.Body.String(), "boom")
+}
+
+func TestHTTPErrorHandler_CommittedResponse(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/test", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	require.NoError(t, c.String(http.StatusOK, "partial"))
+
+	handler.HTTPErrorHandler(auth.ErrInvalidCredentials, c)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "partial", rec.Body.String())
+}
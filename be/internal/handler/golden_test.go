@@ -0,0 +1,129 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/serializer"
+	"strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/golden"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestAuthResponses_Golden pins the wire format of the auth endpoints, including error bodies.
+// Run with -update to accept an intended change.
+func TestAuthResponses_Golden(t *testing.T) {
+	createdAt := time.Date(2025, 1, 27, 10, 15, 30, 0, time.UTC)
+	tokenPair := &auth.TokenPair{
+		AccessToken:           "test-access-token",
+		RefreshToken:          "test-refresh-token",
+		AccessTokenExpiresAt:  createdAt.Add(time.Hour),
+		RefreshTokenExpiresAt: createdAt.Add(7 * 24 * time.Hour),
+	}
+
+	tests := []struct {
+		mockSetup      func(authService *mocks.MockAuthServiceInterface, sessionService *mocks.MockSessionServiceInterface)
+		name           string
+		path           string
+		body           string
+		expectedStatus int
+	}{
+		{
+			name: "signup",
+			path: "/api/auth/signup",
+			body: `{"email":"test@example.com","password":"Password123!","display_name":"Test User"}`,
+			mockSetup: func(authService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				authService.On("SignupWithSession", mock.Anything, mock.Anything, mock.Anything).Return(&dto.SignupResponse{
+					CreatedAt:   createdAt,
+					ID:          "usr_5k2d9x0q7m3hv8c1b4na",
+					Email:       "test@example.com",
+					DisplayName: "Test User",
+					UserID:      1,
+				}, tokenPair, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "signup_validation_error",
+			path:           "/api/auth/signup",
+			body:           `{"email":"not-an-email","password":"short","display_name":""}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "signup_user_exists",
+			path: "/api/auth/signup",
+			body: `{"email":"test@example.com","password":"Password123!","display_name":"Test User"}`,
+			mockSetup: func(authService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				authService.On("SignupWithSession", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, auth.ErrUserAlreadyExists)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "signup_malformed_body",
+			path:           "/api/auth/signup",
+			body:           `{"email":`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "login",
+			path: "/api/auth/login",
+			body: `{"email":"test@example.com","password":"Password123!"}`,
+			mockSetup: func(authService *mocks.MockAuthServiceInterface, sessionService *mocks.MockSessionServiceInterface) {
+				authService.On("Login", mock.Anything).Return(&dto.UserInfo{
+					ID:            "usr_5k2d9x0q7m3hv8c1b4na",
+					Email:         "test@example.com",
+					DisplayName:   "Test User",
+					UserID:        1,
+					EmailVerified: true,
+				}, nil)
+				sessionService.On("CreateSession", uint(1), mock.Anything).Return(tokenPair, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "login_invalid_credentials",
+			path: "/api/auth/login",
+			body: `{"email":"test@example.com","password":"WrongPassword456!"}`,
+			mockSetup: func(authService *mocks.MockAuthServiceInterface, _ *mocks.MockSessionServiceInterface) {
+				authService.On("Login", mock.Anything).Return(nil, auth.ErrInvalidCredentials)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authService := new(mocks.MockAuthServiceInterface)
+			sessionService := new(mocks.MockSessionServiceInterface)
+			if tt.mockSetup != nil {
+				tt.mockSetup(authService, sessionService)
+			}
+			authHandler := handler.NewAuthHandler(authService, sessionService, allowAudit())
+
+			e := echo.New()
+			e.JSONSerializer = serializer.NewJSONSerializer()
+			e.HTTPErrorHandler = handler.HTTPErrorHandler
+			e.POST("/api/auth/signup", authHandler.Signup)
+			e.POST("/api/auth/login", authHandler.Login)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			golden.AssertJSON(t, "auth_"+tt.name, rec.Body.Bytes())
+			authService.AssertExpectations(t)
+			sessionService.AssertExpectations(t)
+		})
+	}
+}
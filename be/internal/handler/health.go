@@ -5,6 +5,8 @@ import (
 
 	"strikepad-backend/internal/service"
 
+	"strikepad-backend/internal/dto"
+
 	"github.com/labstack/echo/v4"
 )
 
@@ -18,7 +20,31 @@ func NewHealthHandler(healthService service.HealthServiceInterface) HealthHandle
 	}
 }
 
+// Check reports the health of the service and its database pools. It answers 503 when the primary
+// database is unreachable, and 200 otherwise, including when the service is degraded.
 func (h *HealthHandler) Check(c echo.Context) error {
-	result := h.healthService.GetHealth()
+	result := h.healthService.GetHealth(c.Request().Context())
+	if result.Status == service.HealthStatusUnavailable {
+		return c.JSON(http.StatusServiceUnavailable, result)
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// Live reports that the process is up and serving HTTP, without checking any dependency, so that a
+// database outage does not get every pod restarted
+func (h *HealthHandler) Live(c echo.Context) error {
+	return c.JSON(http.StatusOK, &dto.HealthResponse{
+		Status:  service.HealthStatusOK,
+		Message: "Server is alive",
+	})
+}
+
+// Ready reports whether the service can serve requests, answering 503 when it cannot so that
+// load balancers and rollouts stop sending it traffic
+func (h *HealthHandler) Ready(c echo.Context) error {
+	result := h.healthService.GetReadiness(c.Request().Context())
+	if result.Status != service.HealthStatusOK {
+		return c.JSON(http.StatusServiceUnavailable, result)
+	}
 	return c.JSON(http.StatusOK, result)
 }
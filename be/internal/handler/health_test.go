@@ -6,11 +6,13 @@ import (
 	"testing"
 
 	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/service"
 
 	"strikepad-backend/internal/service/mocks"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"strikepad-backend/internal/dto"
 )
@@ -62,6 +64,38 @@ func TestHealthHandler_Check(t *testing.T) {
 			expectedCallCount: 1,
 			description:       "should return ready status with custom message",
 		},
+		{
+			name: "degraded service",
+			mockResponse: &dto.HealthResponse{
+				Status:  service.HealthStatusDegraded,
+				Message: "A read replica is unreachable; reads fall back to the primary",
+				Dependencies: []dto.DependencyHealth{
+					{Name: "database", Status: service.HealthStatusOK},
+					{Name: "replica_1", Status: service.HealthStatusDown},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedInBody: []string{
+				`"status":"degraded"`,
+				`"dependencies":[{"name":"database","status":"ok"},{"name":"replica_1","status":"down"}]`,
+			},
+			checkMockCalls:    true,
+			expectedCallCount: 1,
+			description:       "should still answer 200 when only a replica is down",
+		},
+		{
+			name: "database unavailable",
+			mockResponse: &dto.HealthResponse{
+				Status:       service.HealthStatusUnavailable,
+				Message:      "The database is unreachable",
+				Dependencies: []dto.DependencyHealth{{Name: "database", Status: service.HealthStatusDown}},
+			},
+			expectedStatus:    http.StatusServiceUnavailable,
+			expectedInBody:    []string{`"status":"unavailable"`, `"message":"The database is unreachable"`},
+			checkMockCalls:    true,
+			expectedCallCount: 1,
+			description:       "should answer 503 when the primary database is down",
+		},
 		{
 			name: "minimal response",
 			mockResponse: &dto.HealthResponse{
@@ -81,7 +115,7 @@ func TestHealthHandler_Check(t *testing.T) {
 			// Setup
 			mockService := &mocks.MockHealthServiceInterface{}
 			hd := handler.NewHealthHandler(mockService)
-			mockService.On("GetHealth").Return(tt.mockResponse)
+			mockService.On("GetHealth", mock.Anything).Return(tt.mockResponse)
 
 			// Create request
 			e := echo.New()
@@ -107,7 +141,7 @@ func TestHealthHandler_Check(t *testing.T) {
 
 			// Additional mock verification if requested
 			if tt.checkMockCalls {
-				mockService.AssertCalled(t, "GetHealth")
+				mockService.AssertCalled(t, "GetHealth", mock.Anything)
 				mockService.AssertNumberOfCalls(t, "GetHealth", tt.expectedCallCount)
 			}
 		})
@@ -122,3 +156,72 @@ func TestHealthHandler_NewHealthHandler(t *testing.T) {
 	assert.NotNil(t, hd, "Handler should not be nil")
 	assert.NotNil(t, hd, "Handler should be properly initialized")
 }
+
+func TestHealthHandler_Live(t *testing.T) {
+	mockService := &mocks.MockHealthServiceInterface{}
+	hd := handler.NewHealthHandler(mockService)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/health/live", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	err := hd.Live(e.NewContext(req, rec))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok","message":"Server is alive"}`, rec.Body.String())
+	// Liveness never checks dependencies
+	mockService.AssertExpectations(t)
+}
+
+func TestHealthHandler_Ready(t *testing.T) {
+	tests := []struct {
+		mockResponse   *dto.HealthResponse
+		name           string
+		expectedStatus int
+	}{
+		{
+			name: "ready",
+			mockResponse: &dto.HealthResponse{
+				Status:  service.HealthStatusOK,
+				Message: "Server is ready",
+				Dependencies: []dto.DependencyHealth{
+					{Name: "database", Status: service.HealthStatusOK},
+					{Name: "migrations", Status: service.HealthStatusOK},
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "migrations pending",
+			mockResponse: &dto.HealthResponse{
+				Status:  service.HealthStatusUnavailable,
+				Message: "Server is not ready",
+				Dependencies: []dto.DependencyHealth{
+					{Name: "database", Status: service.HealthStatusOK},
+					{Name: "migrations", Status: service.HealthStatusDown},
+				},
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &mocks.MockHealthServiceInterface{}
+			mockService.On("GetReadiness", mock.Anything).Return(tt.mockResponse)
+			hd := handler.NewHealthHandler(mockService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/health/ready", http.NoBody)
+			rec := httptest.NewRecorder()
+
+			err := hd.Ready(e.NewContext(req, rec))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"status":"`+tt.mockResponse.Status+`"`)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IdentityHandler handles requests for the provider accounts linked to the current user
+type IdentityHandler struct {
+	identityService service.IdentityServiceInterface
+}
+
+// NewIdentityHandler creates a new identity handler
+func NewIdentityHandler(identityService service.IdentityServiceInterface) IdentityHandlerInterface {
+	return &IdentityHandler{
+		identityService: identityService,
+	}
+}
+
+// UnlinkIdentity removes the account at the provider in the path from the authenticated user
+func (h *IdentityHandler) UnlinkIdentity(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	session, hasSession := c.Get("session").(*model.UserSession)
+	if !ok || !hasSession {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	var req dto.UnlinkIdentityRequest
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for identity unlinking", "error", err)
+		return errInvalidBody
+	}
+
+	provider := strings.ToLower(c.Param("provider"))
+	proof := service.StepUpProof{AuthenticatedAt: session.CreatedAt, Password: req.Password}
+	if err := h.identityService.UnlinkIdentity(userID, provider, proof); err != nil {
+		switch {
+		case stderrors.Is(err, repository.ErrIdentityNotFound):
+			return errors.Wrap(errors.ErrCodeNotFound, err, "identity not found").
+				WithDescription("No account is linked at this provider")
+		case stderrors.Is(err, auth.ErrStepUpRequired):
+			return auth.ErrStepUpRequired
+		case stderrors.Is(err, auth.ErrLastLoginMethod):
+			return auth.ErrLastLoginMethod
+		default:
+			slog.Error("Internal error during identity unlinking", "error", err, "user_id", userID)
+			return err
+		}
+	}
+
+	slog.Info("Identity unlink successful", "user_id", userID, "provider", provider)
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Account unlinked successfully",
+	})
+}
+
+// GetSecuritySettings returns the sign-in methods of the authenticated user and when their password last changed
+func (h *IdentityHandler) GetSecuritySettings(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	response, err := h.identityService.GetSecuritySettings(userID)
+	if err != nil {
+		slog.Error("Failed to get security settings", "error", err, "user_id", userID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,176 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIdentityHandler_UnlinkIdentity(t *testing.T) {
+	sessionCreatedAt := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		serviceErr     error
+		name           string
+		body           string
+		expectedCode   string
+		expectedStatus int
+		authenticated  bool
+	}{
+		{
+			name:           "unlinks account",
+			body:           `{"password":"Password123!"}`,
+			authenticated:  true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "no account linked",
+			authenticated:  true,
+			serviceErr:     repository.ErrIdentityNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "E004",
+		},
+		{
+			name:           "step-up authentication required",
+			authenticated:  true,
+			serviceErr:     auth.ErrStepUpRequired,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "E306",
+		},
+		{
+			name:           "last login method",
+			authenticated:  true,
+			serviceErr:     auth.ErrLastLoginMethod,
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "E305",
+		},
+		{
+			name:           "internal error",
+			authenticated:  true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+		{
+			name:           "unauthenticated",
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "E005",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityService := mocks.NewMockIdentityServiceInterface(t)
+			identityHandler := handler.NewIdentityHandler(identityService)
+			if tt.authenticated {
+				identityService.EXPECT().UnlinkIdentity(uint(1), "github", mock.MatchedBy(func(proof service.StepUpProof) bool {
+					return proof.AuthenticatedAt.Equal(sessionCreatedAt)
+				})).Return(tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/identities/GitHub", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("provider")
+			c.SetParamValues("GitHub")
+			if tt.authenticated {
+				c.Set("user_id", uint(1))
+				c.Set("session", &model.UserSession{UserID: 1, CreatedAt: sessionCreatedAt})
+			}
+
+			serve(c, identityHandler.UnlinkIdentity)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
+
+func TestIdentityHandler_GetSecuritySettings(t *testing.T) {
+	passwordChangedAt := time.Now().Add(-time.Hour).UTC()
+
+	tests := []struct {
+		serviceErr     error
+		name           string
+		expectedCode   string
+		expectedStatus int
+		authenticated  bool
+	}{
+		{
+			name:           "returns settings",
+			authenticated:  true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "internal error",
+			authenticated:  true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+		{
+			name:           "unauthenticated",
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "E005",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identityService := mocks.NewMockIdentityServiceInterface(t)
+			identityHandler := handler.NewIdentityHandler(identityService)
+			if tt.authenticated {
+				var settings *dto.SecuritySettingsResponse
+				if tt.serviceErr == nil {
+					settings = &dto.SecuritySettingsResponse{
+						PasswordChangedAt: &passwordChangedAt,
+						ProviderType:      "email",
+						Identities:        []dto.IdentityInfo{},
+						HasPassword:       true,
+					}
+				}
+				identityService.EXPECT().GetSecuritySettings(uint(1)).Return(settings, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/me/security", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			if tt.authenticated {
+				c.Set("user_id", uint(1))
+			}
+
+			serve(c, identityHandler.GetSecuritySettings)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+				return
+			}
+
+			var response dto.SecuritySettingsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.True(t, response.HasPassword)
+			assert.True(t, passwordChangedAt.Equal(*response.PasswordChangedAt))
+		})
+	}
+}
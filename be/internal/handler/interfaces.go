@@ -1,6 +1,10 @@
 package handler
 
-import "github.com/labstack/echo/v4"
+import (
+	"strikepad-backend/internal/router"
+
+	"github.com/labstack/echo/v4"
+)
 
 // AuthHandlerInterface defines the interface for authentication handlers
 type AuthHandlerInterface interface {
@@ -8,10 +12,158 @@ type AuthHandlerInterface interface {
 	Login(c echo.Context) error
 	GoogleSignup(c echo.Context) error
 	GoogleLogin(c echo.Context) error
+	GoogleAuthorize(c echo.Context) error
+	GoogleCallback(c echo.Context) error
+	ProviderSignup(c echo.Context) error
+	ProviderLogin(c echo.Context) error
+	LinkGoogleIdentity(c echo.Context) error
 	Logout(c echo.Context) error
+	ListSessions(c echo.Context) error
+	RevokeSession(c echo.Context) error
+	RevokeSessionByToken(c echo.Context) error
+	Routes() []router.Route
+}
+
+// IdentityHandlerInterface defines the interface for linked identity handlers
+type IdentityHandlerInterface interface {
+	UnlinkIdentity(c echo.Context) error
+	GetSecuritySettings(c echo.Context) error
+	Routes() []router.Route
+}
+
+// EmailChangeHandlerInterface defines the interface for email change handlers
+type EmailChangeHandlerInterface interface {
+	RequestEmailChange(c echo.Context) error
+	ConfirmEmailChange(c echo.Context) error
+	CancelEmailChange(c echo.Context) error
+	Routes() []router.Route
+}
+
+// AdminHandlerInterface defines the interface for admin handlers
+type AdminHandlerInterface interface {
+	ListUsers(c echo.Context) error
+	ListUserSessions(c echo.Context) error
+	DisableUser(c echo.Context) error
+	EnableUser(c echo.Context) error
+	ForceLogout(c echo.Context) error
+	Routes() []router.Route
+}
+
+// APIKeyHandlerInterface defines the interface for API key management handlers
+type APIKeyHandlerInterface interface {
+	CreateKey(c echo.Context) error
+	ListKeys(c echo.Context) error
+	RevokeKey(c echo.Context) error
+	Routes() []router.Route
+}
+
+// InvitationHandlerInterface defines the interface for invitation handlers
+type InvitationHandlerInterface interface {
+	CreateInvitation(c echo.Context) error
+	Routes() []router.Route
+}
+
+// WebhookHandlerInterface defines the interface for webhook management handlers
+type WebhookHandlerInterface interface {
+	CreateWebhook(c echo.Context) error
+	ListWebhooks(c echo.Context) error
+	DeleteWebhook(c echo.Context) error
+	Routes() []router.Route
 }
 
 // HealthHandlerInterface defines the interface for health handlers
 type HealthHandlerInterface interface {
 	Check(c echo.Context) error
+	Live(c echo.Context) error
+	Ready(c echo.Context) error
+	Routes() []router.Route
+}
+
+// StatusHandlerInterface defines the interface for status handlers
+type StatusHandlerInterface interface {
+	GetStatus(c echo.Context) error
+	Routes() []router.Route
+}
+
+// ProfileHandlerInterface defines the interface for profile handlers
+type ProfileHandlerInterface interface {
+	GetOnboarding(c echo.Context) error
+	UpdateOnboarding(c echo.Context) error
+	Routes() []router.Route
+}
+
+// UserHandlerInterface defines the interface for user handlers
+type UserHandlerInterface interface {
+	SearchUsers(c echo.Context) error
+	GetPrivacySettings(c echo.Context) error
+	UpdatePrivacySettings(c echo.Context) error
+	UploadAvatar(c echo.Context) error
+	DeleteAvatar(c echo.Context) error
+	Routes() []router.Route
+}
+
+// NotificationHandlerInterface defines the interface for notification handlers
+type NotificationHandlerInterface interface {
+	Stream(c echo.Context) error
+	Routes() []router.Route
+}
+
+// DataExportHandlerInterface defines the interface for data export handlers
+type DataExportHandlerInterface interface {
+	RequestExport(c echo.Context) error
+	GetExport(c echo.Context) error
+	Download(c echo.Context) error
+	Routes() []router.Route
+}
+
+// UserPurgeHandlerInterface defines the interface for user purge handlers
+type UserPurgeHandlerInterface interface {
+	PurgeUser(c echo.Context) error
+	Routes() []router.Route
+}
+
+// UploadHandlerInterface defines the interface for the handler serving uploaded files
+type UploadHandlerInterface interface {
+	ServeFile(c echo.Context) error
+	Routes() []router.Route
+}
+
+// WaitlistHandlerInterface defines the interface for waitlist handlers
+type WaitlistHandlerInterface interface {
+	Join(c echo.Context) error
+	ListEntries(c echo.Context) error
+	InviteBatch(c echo.Context) error
+	Routes() []router.Route
+}
+
+// OutboxHandlerInterface defines the interface for outbox handlers
+type OutboxHandlerInterface interface {
+	GetStats(c echo.Context) error
+	Routes() []router.Route
+}
+
+// DevEmailHandlerInterface defines the interface for dev email handlers
+type DevEmailHandlerInterface interface {
+	ListEmails(c echo.Context) error
+	Routes() []router.Route
+}
+
+// CSRFHandlerInterface defines the interface for CSRF token handlers
+type CSRFHandlerInterface interface {
+	IssueToken(c echo.Context) error
+	Routes() []router.Route
+}
+
+// OpenAPIHandlerInterface defines the interface for API documentation handlers
+type OpenAPIHandlerInterface interface {
+	Spec(c echo.Context) error
+	SwaggerUI(c echo.Context) error
+	Routes() []router.Route
+}
+
+// AuditLogHandlerInterface defines the interface for audit log handlers
+type AuditLogHandlerInterface interface {
+	ListAuditLogs(c echo.Context) error
+	ListLogins(c echo.Context) error
+	Routes() []router.Route
 }
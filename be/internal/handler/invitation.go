@@ -0,0 +1,63 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/validator"
+
+	"github.com/labstack/echo/v4"
+)
+
+// InvitationHandler handles the endpoints that invite people to sign up
+type InvitationHandler struct {
+	invitationService service.InvitationServiceInterface
+	validator         *validator.Validator
+}
+
+// NewInvitationHandler creates a new invitation handler
+func NewInvitationHandler(invitationService service.InvitationServiceInterface) InvitationHandlerInterface {
+	return &InvitationHandler{
+		invitationService: invitationService,
+		validator:         validator.New(),
+	}
+}
+
+// CreateInvitation emails an invite token to the requested address on behalf of the authenticated admin
+func (h *InvitationHandler) CreateInvitation(c echo.Context) error {
+	session, ok := c.Get("session").(*model.UserSession)
+	if !ok || session == nil {
+		slog.Error("Failed to get session from JWT token")
+		return errMissingSession
+	}
+
+	var req dto.CreateInvitationRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for invitation", "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "invitation")
+	}
+
+	response, err := h.invitationService.CreateInvitation(&session.User, &req)
+	if err != nil {
+		// Application errors, such as an already registered email address, keep their code
+		var appErr *errors.AppError
+		if !stderrors.As(err, &appErr) {
+			slog.Error("Failed to create invitation", "error", err, "user_id", session.UserID)
+		}
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,87 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitationHandler_CreateInvitation(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		body           string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:           "sends invitation",
+			body:           `{"email":"friend@example.com"}`,
+			expectCall:     true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "invalid email",
+			body:           `{"email":"not-an-email"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "already registered",
+			body:           `{"email":"friend@example.com"}`,
+			expectCall:     true,
+			serviceErr:     auth.ErrUserAlreadyExists,
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "E102",
+		},
+		{
+			name:           "internal error",
+			body:           `{"email":"friend@example.com"}`,
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitationService := mocks.NewMockInvitationServiceInterface(t)
+			session := &model.UserSession{UserID: 1, User: model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}}
+			if tt.expectCall {
+				var response *dto.InvitationInfo
+				if tt.serviceErr == nil {
+					response = &dto.InvitationInfo{ID: "inv_3m8q1x5k9d2v7h0c4b6n", Email: "friend@example.com"}
+				}
+				invitationService.EXPECT().
+					CreateInvitation(&session.User, &dto.CreateInvitationRequest{Email: "friend@example.com"}).
+					Return(response, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/invitations", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("session", session)
+
+			serve(c, handler.NewInvitationHandler(invitationService).CreateInvitation)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	echo "github.com/labstack/echo/v4"
 
+	router "strikepad-backend/internal/router"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +23,282 @@ func (_m *MockAuthHandlerInterface) EXPECT() *MockAuthHandlerInterface_Expecter
 	return &MockAuthHandlerInterface_Expecter{mock: &_m.Mock}
 }
 
+// GoogleAuthorize provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) GoogleAuthorize(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GoogleAuthorize")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_GoogleAuthorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleAuthorize'
+type MockAuthHandlerInterface_GoogleAuthorize_Call struct {
+	*mock.Call
+}
+
+// GoogleAuthorize is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) GoogleAuthorize(c interface{}) *MockAuthHandlerInterface_GoogleAuthorize_Call {
+	return &MockAuthHandlerInterface_GoogleAuthorize_Call{Call: _e.mock.On("GoogleAuthorize", c)}
+}
+
+func (_c *MockAuthHandlerInterface_GoogleAuthorize_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_GoogleAuthorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleAuthorize_Call) Return(_a0 error) *MockAuthHandlerInterface_GoogleAuthorize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleAuthorize_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_GoogleAuthorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GoogleCallback provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) GoogleCallback(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GoogleCallback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_GoogleCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleCallback'
+type MockAuthHandlerInterface_GoogleCallback_Call struct {
+	*mock.Call
+}
+
+// GoogleCallback is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) GoogleCallback(c interface{}) *MockAuthHandlerInterface_GoogleCallback_Call {
+	return &MockAuthHandlerInterface_GoogleCallback_Call{Call: _e.mock.On("GoogleCallback", c)}
+}
+
+func (_c *MockAuthHandlerInterface_GoogleCallback_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_GoogleCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleCallback_Call) Return(_a0 error) *MockAuthHandlerInterface_GoogleCallback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleCallback_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_GoogleCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GoogleLogin provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) GoogleLogin(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GoogleLogin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_GoogleLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleLogin'
+type MockAuthHandlerInterface_GoogleLogin_Call struct {
+	*mock.Call
+}
+
+// GoogleLogin is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) GoogleLogin(c interface{}) *MockAuthHandlerInterface_GoogleLogin_Call {
+	return &MockAuthHandlerInterface_GoogleLogin_Call{Call: _e.mock.On("GoogleLogin", c)}
+}
+
+func (_c *MockAuthHandlerInterface_GoogleLogin_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_GoogleLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleLogin_Call) Return(_a0 error) *MockAuthHandlerInterface_GoogleLogin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleLogin_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_GoogleLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GoogleSignup provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) GoogleSignup(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GoogleSignup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_GoogleSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleSignup'
+type MockAuthHandlerInterface_GoogleSignup_Call struct {
+	*mock.Call
+}
+
+// GoogleSignup is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) GoogleSignup(c interface{}) *MockAuthHandlerInterface_GoogleSignup_Call {
+	return &MockAuthHandlerInterface_GoogleSignup_Call{Call: _e.mock.On("GoogleSignup", c)}
+}
+
+func (_c *MockAuthHandlerInterface_GoogleSignup_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_GoogleSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleSignup_Call) Return(_a0 error) *MockAuthHandlerInterface_GoogleSignup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_GoogleSignup_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_GoogleSignup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkGoogleIdentity provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) LinkGoogleIdentity(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkGoogleIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_LinkGoogleIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkGoogleIdentity'
+type MockAuthHandlerInterface_LinkGoogleIdentity_Call struct {
+	*mock.Call
+}
+
+// LinkGoogleIdentity is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) LinkGoogleIdentity(c interface{}) *MockAuthHandlerInterface_LinkGoogleIdentity_Call {
+	return &MockAuthHandlerInterface_LinkGoogleIdentity_Call{Call: _e.mock.On("LinkGoogleIdentity", c)}
+}
+
+func (_c *MockAuthHandlerInterface_LinkGoogleIdentity_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_LinkGoogleIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_LinkGoogleIdentity_Call) Return(_a0 error) *MockAuthHandlerInterface_LinkGoogleIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_LinkGoogleIdentity_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_LinkGoogleIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) ListSessions(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockAuthHandlerInterface_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) ListSessions(c interface{}) *MockAuthHandlerInterface_ListSessions_Call {
+	return &MockAuthHandlerInterface_ListSessions_Call{Call: _e.mock.On("ListSessions", c)}
+}
+
+func (_c *MockAuthHandlerInterface_ListSessions_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_ListSessions_Call) Return(_a0 error) *MockAuthHandlerInterface_ListSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_ListSessions_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function with given fields: c
 func (_m *MockAuthHandlerInterface) Login(c echo.Context) error {
 	ret := _m.Called(c)
@@ -67,6 +345,283 @@ func (_c *MockAuthHandlerInterface_Login_Call) RunAndReturn(run func(echo.Contex
 	return _c
 }
 
+// Logout provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) Logout(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthHandlerInterface_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) Logout(c interface{}) *MockAuthHandlerInterface_Logout_Call {
+	return &MockAuthHandlerInterface_Logout_Call{Call: _e.mock.On("Logout", c)}
+}
+
+func (_c *MockAuthHandlerInterface_Logout_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_Logout_Call) Return(_a0 error) *MockAuthHandlerInterface_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_Logout_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderLogin provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) ProviderLogin(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderLogin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_ProviderLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderLogin'
+type MockAuthHandlerInterface_ProviderLogin_Call struct {
+	*mock.Call
+}
+
+// ProviderLogin is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) ProviderLogin(c interface{}) *MockAuthHandlerInterface_ProviderLogin_Call {
+	return &MockAuthHandlerInterface_ProviderLogin_Call{Call: _e.mock.On("ProviderLogin", c)}
+}
+
+func (_c *MockAuthHandlerInterface_ProviderLogin_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_ProviderLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_ProviderLogin_Call) Return(_a0 error) *MockAuthHandlerInterface_ProviderLogin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_ProviderLogin_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_ProviderLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderSignup provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) ProviderSignup(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderSignup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_ProviderSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderSignup'
+type MockAuthHandlerInterface_ProviderSignup_Call struct {
+	*mock.Call
+}
+
+// ProviderSignup is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) ProviderSignup(c interface{}) *MockAuthHandlerInterface_ProviderSignup_Call {
+	return &MockAuthHandlerInterface_ProviderSignup_Call{Call: _e.mock.On("ProviderSignup", c)}
+}
+
+func (_c *MockAuthHandlerInterface_ProviderSignup_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_ProviderSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_ProviderSignup_Call) Return(_a0 error) *MockAuthHandlerInterface_ProviderSignup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_ProviderSignup_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_ProviderSignup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) RevokeSession(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockAuthHandlerInterface_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) RevokeSession(c interface{}) *MockAuthHandlerInterface_RevokeSession_Call {
+	return &MockAuthHandlerInterface_RevokeSession_Call{Call: _e.mock.On("RevokeSession", c)}
+}
+
+func (_c *MockAuthHandlerInterface_RevokeSession_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_RevokeSession_Call) Return(_a0 error) *MockAuthHandlerInterface_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_RevokeSession_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSessionByToken provides a mock function with given fields: c
+func (_m *MockAuthHandlerInterface) RevokeSessionByToken(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSessionByToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_RevokeSessionByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSessionByToken'
+type MockAuthHandlerInterface_RevokeSessionByToken_Call struct {
+	*mock.Call
+}
+
+// RevokeSessionByToken is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockAuthHandlerInterface_Expecter) RevokeSessionByToken(c interface{}) *MockAuthHandlerInterface_RevokeSessionByToken_Call {
+	return &MockAuthHandlerInterface_RevokeSessionByToken_Call{Call: _e.mock.On("RevokeSessionByToken", c)}
+}
+
+func (_c *MockAuthHandlerInterface_RevokeSessionByToken_Call) Run(run func(c echo.Context)) *MockAuthHandlerInterface_RevokeSessionByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_RevokeSessionByToken_Call) Return(_a0 error) *MockAuthHandlerInterface_RevokeSessionByToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_RevokeSessionByToken_Call) RunAndReturn(run func(echo.Context) error) *MockAuthHandlerInterface_RevokeSessionByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Routes provides a mock function with no fields
+func (_m *MockAuthHandlerInterface) Routes() []router.Route {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Routes")
+	}
+
+	var r0 []router.Route
+	if rf, ok := ret.Get(0).(func() []router.Route); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]router.Route)
+		}
+	}
+
+	return r0
+}
+
+// MockAuthHandlerInterface_Routes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Routes'
+type MockAuthHandlerInterface_Routes_Call struct {
+	*mock.Call
+}
+
+// Routes is a helper method to define mock.On call
+func (_e *MockAuthHandlerInterface_Expecter) Routes() *MockAuthHandlerInterface_Routes_Call {
+	return &MockAuthHandlerInterface_Routes_Call{Call: _e.mock.On("Routes")}
+}
+
+func (_c *MockAuthHandlerInterface_Routes_Call) Run(run func()) *MockAuthHandlerInterface_Routes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_Routes_Call) Return(_a0 []router.Route) *MockAuthHandlerInterface_Routes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthHandlerInterface_Routes_Call) RunAndReturn(run func() []router.Route) *MockAuthHandlerInterface_Routes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Signup provides a mock function with given fields: c
 func (_m *MockAuthHandlerInterface) Signup(c echo.Context) error {
 	ret := _m.Called(c)
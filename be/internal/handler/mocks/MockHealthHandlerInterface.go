@@ -5,6 +5,8 @@ package mocks
 import (
 	echo "github.com/labstack/echo/v4"
 
+	router "strikepad-backend/internal/router"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -67,6 +69,145 @@ func (_c *MockHealthHandlerInterface_Check_Call) RunAndReturn(run func(echo.Cont
 	return _c
 }
 
+// Live provides a mock function with given fields: c
+func (_m *MockHealthHandlerInterface) Live(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Live")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHealthHandlerInterface_Live_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Live'
+type MockHealthHandlerInterface_Live_Call struct {
+	*mock.Call
+}
+
+// Live is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockHealthHandlerInterface_Expecter) Live(c interface{}) *MockHealthHandlerInterface_Live_Call {
+	return &MockHealthHandlerInterface_Live_Call{Call: _e.mock.On("Live", c)}
+}
+
+func (_c *MockHealthHandlerInterface_Live_Call) Run(run func(c echo.Context)) *MockHealthHandlerInterface_Live_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockHealthHandlerInterface_Live_Call) Return(_a0 error) *MockHealthHandlerInterface_Live_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHealthHandlerInterface_Live_Call) RunAndReturn(run func(echo.Context) error) *MockHealthHandlerInterface_Live_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ready provides a mock function with given fields: c
+func (_m *MockHealthHandlerInterface) Ready(c echo.Context) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHealthHandlerInterface_Ready_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ready'
+type MockHealthHandlerInterface_Ready_Call struct {
+	*mock.Call
+}
+
+// Ready is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *MockHealthHandlerInterface_Expecter) Ready(c interface{}) *MockHealthHandlerInterface_Ready_Call {
+	return &MockHealthHandlerInterface_Ready_Call{Call: _e.mock.On("Ready", c)}
+}
+
+func (_c *MockHealthHandlerInterface_Ready_Call) Run(run func(c echo.Context)) *MockHealthHandlerInterface_Ready_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockHealthHandlerInterface_Ready_Call) Return(_a0 error) *MockHealthHandlerInterface_Ready_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHealthHandlerInterface_Ready_Call) RunAndReturn(run func(echo.Context) error) *MockHealthHandlerInterface_Ready_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Routes provides a mock function with no fields
+func (_m *MockHealthHandlerInterface) Routes() []router.Route {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Routes")
+	}
+
+	var r0 []router.Route
+	if rf, ok := ret.Get(0).(func() []router.Route); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]router.Route)
+		}
+	}
+
+	return r0
+}
+
+// MockHealthHandlerInterface_Routes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Routes'
+type MockHealthHandlerInterface_Routes_Call struct {
+	*mock.Call
+}
+
+// Routes is a helper method to define mock.On call
+func (_e *MockHealthHandlerInterface_Expecter) Routes() *MockHealthHandlerInterface_Routes_Call {
+	return &MockHealthHandlerInterface_Routes_Call{Call: _e.mock.On("Routes")}
+}
+
+func (_c *MockHealthHandlerInterface_Routes_Call) Run(run func()) *MockHealthHandlerInterface_Routes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHealthHandlerInterface_Routes_Call) Return(_a0 []router.Route) *MockHealthHandlerInterface_Routes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHealthHandlerInterface_Routes_Call) RunAndReturn(run func() []router.Route) *MockHealthHandlerInterface_Routes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockHealthHandlerInterface creates a new instance of MockHealthHandlerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockHealthHandlerInterface(t interface {
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// defaultNotificationHeartbeat is how often an idle stream sends a comment, so that proxies
+	// do not close it and clients notice dropped connections
+	defaultNotificationHeartbeat = 15 * time.Second
+	// notificationRetryMillis is how long clients wait before reconnecting a dropped stream
+	notificationRetryMillis = 5000
+)
+
+// NotificationHandler handles the notification stream of the current user
+type NotificationHandler struct {
+	notificationService service.NotificationServiceInterface
+	heartbeat           time.Duration
+}
+
+// NewNotificationHandler creates a new notification handler. Idle streams send a heartbeat every
+// NOTIFICATION_HEARTBEAT_SECONDS.
+func NewNotificationHandler(notificationService service.NotificationServiceInterface) NotificationHandlerInterface {
+	heartbeat := time.Duration(config.GetEnvInt("NOTIFICATION_HEARTBEAT_SECONDS", 0)) * time.Second
+	if heartbeat <= 0 {
+		heartbeat = defaultNotificationHeartbeat
+	}
+	return &NotificationHandler{
+		notificationService: notificationService,
+		heartbeat:           heartbeat,
+	}
+}
+
+// Stream sends the notifications of the authenticated user as Server-Sent Events until the client
+// disconnects. Each event's ID is the notification ID: a client reconnecting with it in the
+// Last-Event-ID header, or the cursor query parameter, first gets the notifications it missed.
+// The stream ends after notifying that its own session was revoked.
+func (h *NotificationHandler) Stream(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+	var sessionID string
+	if session, ok := c.Get("session").(*model.UserSession); ok {
+		sessionID = session.PublicID
+	}
+
+	cursor := c.Request().Header.Get("Last-Event-ID")
+	if cursor == "" {
+		cursor = c.QueryParam("cursor")
+	}
+
+	// Subscribe before replaying, so that nothing created in between is missed
+	notifications, unsubscribe := h.notificationService.Subscribe(userID)
+	defer unsubscribe()
+	missed, err := h.notificationService.ListSince(userID, cursor)
+	if err != nil {
+		slog.Error("Failed to list missed notifications", "error", err, "user_id", userID)
+		return err
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	// Keep reverse proxies such as nginx from buffering the stream
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(res, "retry: %d\n\n", notificationRetryMillis); err != nil {
+		return nil
+	}
+	res.Flush()
+
+	sent := make(map[string]bool, len(missed))
+	for _, notification := range missed {
+		sent[notification.ID] = true
+		if done, err := writeNotification(res, notification, sessionID); done || err != nil {
+			return nil
+		}
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case notification := <-notifications:
+			if sent[notification.ID] {
+				continue
+			}
+			if done, err := writeNotification(res, notification, sessionID); done || err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// writeNotification sends notification as an event, and reports whether the stream should end
+// because it notified that the stream's own session was revoked
+func writeNotification(res *echo.Response, notification dto.Notification, sessionID string) (bool, error) {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return false, err
+	}
+	_, err = fmt.Fprintf(res, "id: %s\nevent: %s\ndata: %s\n\n", notification.ID, notification.Type, data)
+	if err != nil {
+		return false, err
+	}
+	res.Flush()
+
+	revoked := notification.Type == service.NotificationSessionRevoked &&
+		sessionID != "" && notification.Data["session_id"] == sessionID
+	return revoked, nil
+}
@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationHandler_Stream(t *testing.T) {
+	t.Run("replays missed notifications and sends new ones", func(t *testing.T) {
+		notificationService := mocks.NewMockNotificationServiceInterface(t)
+		notifications := make(chan dto.Notification, 2)
+		unsubscribed := false
+		notificationService.EXPECT().Subscribe(uint(1)).
+			Return((<-chan dto.Notification)(notifications), func() { unsubscribed = true }).Once()
+		notificationService.EXPECT().ListSince(uint(1), "ntf_1").Return([]dto.Notification{
+			{ID: "ntf_2", Type: service.NotificationSessionRevoked, Data: map[string]string{"session_id": "ses_other"}},
+		}, nil).Once()
+		// Created while replaying: sent once
+		notifications <- dto.Notification{ID: "ntf_2", Type: service.NotificationSessionRevoked}
+		notifications <- dto.Notification{
+			ID: "ntf_3", Type: service.NotificationSessionRevoked, Data: map[string]string{"session_id": "ses_other"},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req := httptest.NewRequest(http.MethodGet, "/api/notifications/stream", nil).WithContext(ctx)
+		req.Header.Set("Last-Event-ID", "ntf_1")
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", uint(1))
+		c.Set("session", &model.UserSession{PublicID: "ses_current"})
+
+		done := make(chan error)
+		go func() { done <- handler.NewNotificationHandler(notificationService).Stream(c) }()
+		require.Eventually(t, func() bool { return len(notifications) == 0 }, time.Second, time.Millisecond)
+		cancel()
+		require.NoError(t, <-done)
+
+		assert.True(t, unsubscribed)
+		assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "retry: 5000\n\n"+
+			"id: ntf_2\nevent: session.revoked\n"+
+			`data: {"created_at":"0001-01-01T00:00:00Z","data":{"session_id":"ses_other"},"id":"ntf_2","type":"session.revoked"}`+"\n\n"+
+			"id: ntf_3\nevent: session.revoked\n"+
+			`data: {"created_at":"0001-01-01T00:00:00Z","data":{"session_id":"ses_other"},"id":"ntf_3","type":"session.revoked"}`+"\n\n",
+			rec.Body.String())
+	})
+
+	t.Run("ends when its own session is revoked", func(t *testing.T) {
+		notificationService := mocks.NewMockNotificationServiceInterface(t)
+		notifications := make(chan dto.Notification, 1)
+		notificationService.EXPECT().Subscribe(uint(1)).Return((<-chan dto.Notification)(notifications), func() {}).Once()
+		notificationService.EXPECT().ListSince(uint(1), "").Return([]dto.Notification{}, nil).Once()
+		notifications <- dto.Notification{
+			ID: "ntf_1", Type: service.NotificationSessionRevoked, Data: map[string]string{"session_id": "ses_current"},
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/notifications/stream", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", uint(1))
+		c.Set("session", &model.UserSession{PublicID: "ses_current"})
+
+		serve(c, handler.NewNotificationHandler(notificationService).Stream)
+		assert.Contains(t, rec.Body.String(), "id: ntf_1\n")
+	})
+
+	t.Run("sends heartbeats", func(t *testing.T) {
+		t.Setenv("NOTIFICATION_HEARTBEAT_SECONDS", "1")
+		notificationService := mocks.NewMockNotificationServiceInterface(t)
+		notificationService.EXPECT().Subscribe(uint(1)).Return(make(chan dto.Notification), func() {}).Once()
+		notificationService.EXPECT().ListSince(uint(1), "ntf_1").Return([]dto.Notification{}, nil).Once()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+		defer cancel()
+		req := httptest.NewRequest(http.MethodGet, "/api/notifications/stream?cursor=ntf_1", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", uint(1))
+
+		serve(c, handler.NewNotificationHandler(notificationService).Stream)
+		assert.Equal(t, "retry: 5000\n\n: heartbeat\n\n", rec.Body.String())
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		notificationService := mocks.NewMockNotificationServiceInterface(t)
+		notificationService.EXPECT().Subscribe(uint(1)).Return(make(chan dto.Notification), func() {}).Once()
+		notificationService.EXPECT().ListSince(uint(1), "ntf_1").Return(nil, assert.AnError).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/notifications/stream?cursor=ntf_1", nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.Set("user_id", uint(1))
+
+		serve(c, handler.NewNotificationHandler(notificationService).Stream)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
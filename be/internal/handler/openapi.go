@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/openapi"
+
+	"github.com/labstack/echo/v4"
+)
+
+// swaggerUIPolicy relaxes the API's Content-Security-Policy for the Swagger UI page, which loads
+// its assets from unpkg and starts them with an inline script
+const swaggerUIPolicy = "default-src 'none'; script-src https://unpkg.com 'unsafe-inline'; " +
+	"style-src https://unpkg.com; img-src 'self' data: https://unpkg.com; connect-src 'self'; frame-ancestors 'none'"
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>StrikePad API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/api/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`
+
+// OpenAPIHandler serves the OpenAPI document of the API and the Swagger UI
+type OpenAPIHandler struct{}
+
+// NewOpenAPIHandler creates a new OpenAPI handler
+func NewOpenAPIHandler() OpenAPIHandlerInterface {
+	return &OpenAPIHandler{}
+}
+
+// Spec returns the OpenAPI document, for client code generation
+func (h *OpenAPIHandler) Spec(c echo.Context) error {
+	spec, err := openapi.Spec()
+	if err != nil {
+		slog.Error("Failed to build OpenAPI document", "error", err)
+		return err
+	}
+
+	return c.JSONBlob(http.StatusOK, spec)
+}
+
+// SwaggerUI returns the Swagger UI page browsing the OpenAPI document
+func (h *OpenAPIHandler) SwaggerUI(c echo.Context) error {
+	c.Response().Header().Set("Content-Security-Policy", swaggerUIPolicy)
+	return c.HTML(http.StatusOK, swaggerUIPage)
+}
@@ -0,0 +1,45 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/handler"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIHandler_Spec(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil)
+	rec := httptest.NewRecorder()
+
+	require.NoError(t, handler.NewOpenAPIHandler().Spec(e.NewContext(req, rec)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+	var document struct {
+		Paths   map[string]any `json:"paths"`
+		OpenAPI string         `json:"openapi"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &document))
+	assert.Equal(t, "3.0.3", document.OpenAPI)
+	assert.Contains(t, document.Paths, "/api/auth/login")
+	assert.Contains(t, document.Paths, "/api/auth/sessions/{id}")
+}
+
+func TestOpenAPIHandler_SwaggerUI(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil)
+	rec := httptest.NewRecorder()
+
+	require.NoError(t, handler.NewOpenAPIHandler().SwaggerUI(e.NewContext(req, rec)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `url: "/api/openapi.json"`)
+	assert.Contains(t, rec.Header().Get("Content-Security-Policy"), "script-src https://unpkg.com")
+}
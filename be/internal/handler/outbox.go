@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OutboxHandler handles the admin endpoint for monitoring the outbox
+type OutboxHandler struct {
+	outboxService service.OutboxServiceInterface
+}
+
+// NewOutboxHandler creates a new outbox handler
+func NewOutboxHandler(outboxService service.OutboxServiceInterface) OutboxHandlerInterface {
+	return &OutboxHandler{
+		outboxService: outboxService,
+	}
+}
+
+// GetStats returns the depth of the outbox queue
+func (h *OutboxHandler) GetStats(c echo.Context) error {
+	response, err := h.outboxService.Stats()
+	if err != nil {
+		slog.Error("Failed to get outbox stats", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxHandler_GetStats(t *testing.T) {
+	tests := []struct {
+		stats          *dto.OutboxStatsResponse
+		statsErr       error
+		name           string
+		expectedCode   string
+		expectedStatus int
+	}{
+		{
+			name:           "queue depth",
+			stats:          &dto.OutboxStatsResponse{Pending: 4, Failed: 1, OldestPendingAgeSeconds: 90},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "database error",
+			statsErr:       errors.New("connection lost"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewMockOutboxServiceInterface(t)
+			mockService.EXPECT().Stats().Return(tt.stats, tt.statsErr).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/outbox", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			serve(c, handler.NewOutboxHandler(mockService).GetStats)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+				return
+			}
+
+			var response dto.OutboxStatsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, *tt.stats, response)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/validator"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProfileHandler struct {
+	profileService service.ProfileServiceInterface
+	validator      *validator.Validator
+}
+
+func NewProfileHandler(profileService service.ProfileServiceInterface) ProfileHandlerInterface {
+	return &ProfileHandler{
+		profileService: profileService,
+		validator:      validator.New(),
+	}
+}
+
+// GetOnboarding returns the onboarding answers of the authenticated user
+func (h *ProfileHandler) GetOnboarding(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	response, err := h.profileService.GetOnboarding(userID)
+	if err != nil {
+		slog.Error("Failed to get onboarding answers", "error", err, "user_id", userID)
+		return err
+	}
+
+	c.Response().Header().Set("ETag", versionETag(response.Version))
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateOnboarding records onboarding answers for the authenticated user. The change must name the
+// version of the answers it was made to, as a version field or as the ETag in If-Match.
+func (h *ProfileHandler) UpdateOnboarding(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	var req dto.OnboardingRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for onboarding", "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "onboarding")
+	}
+
+	if ifMatch := c.Request().Header.Get("If-Match"); ifMatch != "" {
+		version, err := parseVersionETag(ifMatch)
+		if err != nil {
+			return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid If-Match").
+				WithDescription("If-Match must be the ETag of the onboarding answers")
+		}
+		req.Version = &version
+	}
+	if req.Version == nil {
+		return errors.New(errors.ErrCodePreconditionRequired, "onboarding version missing")
+	}
+
+	response, err := h.profileService.UpdateOnboarding(userID, &req)
+	if stderrors.Is(err, repository.ErrStaleVersion) {
+		return errors.Wrap(errors.ErrCodeConflict, err, "stale onboarding answers").
+			WithDescription("The onboarding answers were changed since they were read")
+	}
+	if err != nil {
+		slog.Error("Failed to record onboarding answers", "error", err, "user_id", userID)
+		return err
+	}
+
+	c.Response().Header().Set("ETag", versionETag(response.Version))
+	return c.JSON(http.StatusOK, response)
+}
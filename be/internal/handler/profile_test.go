@@ -0,0 +1,245 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfileHandlerTestSuite struct {
+	suite.Suite
+	profileHandler handler.ProfileHandlerInterface
+	mockProfileSvc *mocks.MockProfileServiceInterface
+	echo           *echo.Echo
+}
+
+func (suite *ProfileHandlerTestSuite) SetupTest() {
+	suite.mockProfileSvc = mocks.NewMockProfileServiceInterface(suite.T())
+	suite.profileHandler = handler.NewProfileHandler(suite.mockProfileSvc)
+	suite.echo = echo.New()
+}
+
+func (suite *ProfileHandlerTestSuite) TestGetOnboarding() {
+	role := "engineer"
+	testCases := []struct {
+		setupContext   func(c echo.Context)
+		mockSetup      func()
+		expectedError  *dto.ErrorResponse
+		name           string
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			mockSetup: func() {
+				suite.mockProfileSvc.EXPECT().GetOnboarding(uint(1)).
+					Return(&dto.OnboardingResponse{Role: &role}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing user ID",
+			setupContext:   func(c echo.Context) {},
+			mockSetup:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  &dto.ErrorResponse{Code: "E005", Message: "Unauthorized"},
+		},
+		{
+			name: "Service error",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			mockSetup: func() {
+				suite.mockProfileSvc.EXPECT().GetOnboarding(uint(1)).Return(nil, assert.AnError).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  &dto.ErrorResponse{Code: "E001", Message: "Internal server error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/profile/onboarding", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			tc.setupContext(c)
+
+			serve(c, suite.profileHandler.GetOnboarding)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedError != nil {
+				var errorResponse dto.ErrorResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedError.Code, errorResponse.Code)
+				assert.Equal(t, tc.expectedError.Message, errorResponse.Message)
+			} else {
+				var response dto.OnboardingResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, &role, response.Role)
+			}
+		})
+	}
+}
+
+func (suite *ProfileHandlerTestSuite) TestUpdateOnboarding() {
+	teamSize := "11-50"
+	testCases := []struct {
+		setupContext   func(c echo.Context)
+		mockSetup      func()
+		expectedError  *dto.ErrorResponse
+		name           string
+		requestBody    string
+		ifMatch        string
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody: `{"team_size":"11-50","version":3}`,
+			mockSetup: func() {
+				suite.mockProfileSvc.EXPECT().UpdateOnboarding(uint(1), mock.MatchedBy(func(req *dto.OnboardingRequest) bool {
+					return req.TeamSize != nil && *req.TeamSize == teamSize && req.Role == nil && *req.Version == 3
+				})).Return(&dto.OnboardingResponse{TeamSize: &teamSize, Version: 4}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Version in If-Match",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody: `{"team_size":"11-50"}`,
+			ifMatch:     `"0"`,
+			mockSetup: func() {
+				suite.mockProfileSvc.EXPECT().UpdateOnboarding(uint(1), mock.MatchedBy(func(req *dto.OnboardingRequest) bool {
+					return req.Version != nil && *req.Version == 0
+				})).Return(&dto.OnboardingResponse{TeamSize: &teamSize, Version: 1}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Weak If-Match",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody:    `{"team_size":"11-50"}`,
+			ifMatch:        `W/"abc"`,
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &dto.ErrorResponse{Code: "E002", Message: "Invalid request"},
+		},
+		{
+			name: "Without a version",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody:    `{"team_size":"11-50"}`,
+			mockSetup:      func() {},
+			expectedStatus: http.StatusPreconditionRequired,
+			expectedError:  &dto.ErrorResponse{Code: "E011", Message: "Precondition required"},
+		},
+		{
+			name: "Stale version",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody: `{"team_size":"11-50","version":2}`,
+			mockSetup: func() {
+				suite.mockProfileSvc.EXPECT().UpdateOnboarding(uint(1), mock.Anything).
+					Return(nil, repository.ErrStaleVersion).Once()
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  &dto.ErrorResponse{Code: "E007", Message: "Conflict"},
+		},
+		{
+			name:           "Missing user ID",
+			setupContext:   func(c echo.Context) {},
+			requestBody:    `{"team_size":"11-50"}`,
+			mockSetup:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  &dto.ErrorResponse{Code: "E005", Message: "Unauthorized"},
+		},
+		{
+			name: "Invalid JSON",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody:    `{"team_size":`,
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &dto.ErrorResponse{Code: "E002", Message: "Invalid request"},
+		},
+		{
+			name: "Unknown role",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody:    `{"role":"astronaut"}`,
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &dto.ErrorResponse{Code: "E003", Message: "Validation failed"},
+		},
+		{
+			name: "Service error",
+			setupContext: func(c echo.Context) {
+				c.Set("user_id", uint(1))
+			},
+			requestBody: `{"team_size":"11-50","version":3}`,
+			mockSetup: func() {
+				suite.mockProfileSvc.EXPECT().UpdateOnboarding(uint(1), mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  &dto.ErrorResponse{Code: "E001", Message: "Internal server error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/profile/onboarding", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			tc.setupContext(c)
+
+			serve(c, suite.profileHandler.UpdateOnboarding)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedError != nil {
+				var errorResponse dto.ErrorResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedError.Code, errorResponse.Code)
+				assert.Equal(t, tc.expectedError.Message, errorResponse.Message)
+			} else {
+				assert.NotEmpty(t, rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestProfileHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfileHandlerTestSuite))
+}
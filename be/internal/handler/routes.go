@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"net/http"
+	"os"
+	"strings"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/router"
+	"strikepad-backend/internal/storage"
+
+	"github.com/labstack/echo/v4"
+)
+
+// The routes of each handler. Keep them in sync with the route table of the OpenAPI document in
+// internal/openapi.
+
+// Routes returns the health check routes. Kubernetes probes: liveness restarts a stuck process,
+// readiness takes a pod that cannot serve (database unreachable, migrations pending, cache
+// unreachable) out of rotation.
+func (h *HealthHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/health", Handler: h.Check},
+		{Method: http.MethodGet, Path: "/health/live", Handler: h.Live},
+		{Method: http.MethodGet, Path: "/health/ready", Handler: h.Ready},
+	}
+}
+
+// Routes returns the test endpoint route
+func (h *APIHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/test", Handler: h.Test},
+	}
+}
+
+// Routes returns the public status page route (cached, rate-limited per IP)
+func (h *StatusHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodGet, Path: "/api/status", Handler: h.GetStatus,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(30, 10)},
+		},
+	}
+}
+
+// Routes returns the API documentation routes: the OpenAPI document for client code generation,
+// and the Swagger UI (off in production unless SWAGGER_ENABLED is set)
+func (h *OpenAPIHandler) Routes() []router.Route {
+	routes := []router.Route{
+		{Method: http.MethodGet, Path: "/api/openapi.json", Handler: h.Spec},
+	}
+	if config.GetEnvBool("SWAGGER_ENABLED", os.Getenv("APP_ENV") != "production") {
+		routes = append(routes, router.Route{Method: http.MethodGet, Path: "/swagger/*", Handler: h.SwaggerUI})
+	}
+	return routes
+}
+
+// Routes returns the authentication routes
+func (h *AuthHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodPost, Path: "/api/auth/signup", Handler: h.Signup, Idempotent: true},
+		{Method: http.MethodPost, Path: "/api/auth/login", Handler: h.Login},
+		{Method: http.MethodPost, Path: "/api/auth/google/signup", Handler: h.GoogleSignup, Idempotent: true},
+		{Method: http.MethodPost, Path: "/api/auth/google/login", Handler: h.GoogleLogin},
+		{Method: http.MethodGet, Path: "/api/auth/google/authorize", Handler: h.GoogleAuthorize},
+		{Method: http.MethodGet, Path: "/api/auth/google/callback", Handler: h.GoogleCallback},
+		{Method: http.MethodPost, Path: "/api/auth/:provider/signup", Handler: h.ProviderSignup, Idempotent: true},
+		{Method: http.MethodPost, Path: "/api/auth/:provider/login", Handler: h.ProviderLogin},
+		// One-click revoke link of new sign-in alert emails (the token in the link authenticates it)
+		{
+			Method: http.MethodGet, Path: "/api/auth/sessions/revoke", Handler: h.RevokeSessionByToken,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(10, 5)},
+		},
+		{Method: http.MethodPost, Path: "/api/auth/logout", Handler: h.Logout, Access: router.User},
+		{Method: http.MethodGet, Path: "/api/auth/sessions", Handler: h.ListSessions, Access: router.User},
+		{Method: http.MethodDelete, Path: "/api/auth/sessions/:id", Handler: h.RevokeSession, Access: router.User},
+		{
+			Method: http.MethodPost, Path: "/api/users/me/identities/google", Handler: h.LinkGoogleIdentity,
+			Access: router.User,
+		},
+	}
+}
+
+// Routes returns the CSRF token route, for browser clients authenticated by session cookies
+func (h *CSRFHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/auth/csrf", Handler: h.IssueToken},
+	}
+}
+
+// Routes returns the linked identity and security settings routes. The settings carry an ETag, as
+// clients poll them.
+func (h *IdentityHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodDelete, Path: "/api/users/me/identities/:provider", Handler: h.UnlinkIdentity,
+			Access: router.User,
+		},
+		{
+			Method: http.MethodGet, Path: "/api/users/me/security", Handler: h.GetSecuritySettings, Access: router.User,
+			Middleware: []echo.MiddlewareFunc{middleware.ETag()},
+		},
+	}
+}
+
+// Routes returns the email change routes: requests are rate-limited per IP, as each one sends
+// emails, and so are confirmations (the token in the emailed link authenticates them)
+func (h *EmailChangeHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodPost, Path: "/api/users/me/email", Handler: h.RequestEmailChange, Access: router.User,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(5, 2)},
+		},
+		{Method: http.MethodDelete, Path: "/api/users/me/email", Handler: h.CancelEmailChange, Access: router.User},
+		{
+			Method: http.MethodPost, Path: "/api/auth/email-change/confirm", Handler: h.ConfirmEmailChange,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(10, 5)},
+		},
+	}
+}
+
+// Routes returns the profile routes. The onboarding state carries an ETag, as clients poll it.
+func (h *ProfileHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodGet, Path: "/api/profile/onboarding", Handler: h.GetOnboarding, Access: router.User,
+			Middleware: []echo.MiddlewareFunc{middleware.ETag()},
+		},
+		{Method: http.MethodPatch, Path: "/api/profile/onboarding", Handler: h.UpdateOnboarding, Access: router.User},
+	}
+}
+
+// Routes returns the user search route (rate-limited per IP, as typeahead sends a request per
+// keystroke), the privacy settings routes (with an ETag, as clients poll them) and the avatar
+// routes (uploads rate-limited per IP, as each one decodes an image)
+func (h *UserHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodGet, Path: "/api/users/search", Handler: h.SearchUsers, Access: router.User,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(120, 30)},
+		},
+		{
+			Method: http.MethodGet, Path: "/api/users/me/privacy", Handler: h.GetPrivacySettings, Access: router.User,
+			Middleware: []echo.MiddlewareFunc{middleware.ETag()},
+		},
+		{Method: http.MethodPatch, Path: "/api/users/me/privacy", Handler: h.UpdatePrivacySettings, Access: router.User},
+		{
+			Method: http.MethodPost, Path: "/api/users/me/avatar", Handler: h.UploadAvatar, Access: router.User,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(10, 5)},
+		},
+		{Method: http.MethodDelete, Path: "/api/users/me/avatar", Handler: h.DeleteAvatar, Access: router.User},
+	}
+}
+
+// Routes returns the notification stream route
+func (h *NotificationHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/notifications/stream", Handler: h.Stream, Access: router.User},
+	}
+}
+
+// Routes returns the data export routes (requests rate-limited per IP, as each one assembles an
+// archive) and the download link route (the token in the link authenticates it)
+func (h *DataExportHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodPost, Path: "/api/users/me/export", Handler: h.RequestExport, Access: router.User,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(5, 2)},
+		},
+		{Method: http.MethodGet, Path: "/api/users/me/exports/:id", Handler: h.GetExport, Access: router.User},
+		{
+			Method: http.MethodGet, Path: "/api/exports/:id/download", Handler: h.Download,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(10, 5)},
+		},
+	}
+}
+
+// Routes returns the route serving uploaded files, when they are stored on local disk under a
+// public URL path of this server
+func (h *UploadHandler) Routes() []router.Route {
+	local, ok := h.storage.(*storage.LocalStorage)
+	if !ok || !strings.HasPrefix(local.PublicURL(), "/") {
+		return nil
+	}
+	return []router.Route{
+		{Method: http.MethodGet, Path: local.PublicURL() + "/*", Handler: h.ServeFile},
+	}
+}
+
+// Routes returns the public waitlist route (rate-limited per IP) and its admin routes
+func (h *WaitlistHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodPost, Path: "/api/waitlist", Handler: h.Join, Idempotent: true,
+			Middleware: []echo.MiddlewareFunc{middleware.RateLimitByIP(5, 5)},
+		},
+		{Method: http.MethodGet, Path: "/api/admin/waitlist", Handler: h.ListEntries, Access: router.Admin},
+		{Method: http.MethodPost, Path: "/api/admin/waitlist/invite", Handler: h.InviteBatch, Access: router.Admin},
+	}
+}
+
+// Routes returns the outbox statistics route
+func (h *OutboxHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/admin/outbox", Handler: h.GetStats, Access: router.Admin},
+	}
+}
+
+// Routes returns the user administration routes
+func (h *AdminHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/admin/users", Handler: h.ListUsers, Access: router.AdminOrAPIKey},
+		{
+			Method: http.MethodGet, Path: "/api/admin/users/:id/sessions", Handler: h.ListUserSessions,
+			Access: router.AdminOrAPIKey,
+		},
+		{
+			Method: http.MethodPost, Path: "/api/admin/users/:id/disable", Handler: h.DisableUser,
+			Access: router.AdminOrAPIKey,
+		},
+		{
+			Method: http.MethodPost, Path: "/api/admin/users/:id/enable", Handler: h.EnableUser,
+			Access: router.AdminOrAPIKey,
+		},
+		{
+			Method: http.MethodPost, Path: "/api/admin/users/:id/logout", Handler: h.ForceLogout,
+			Access: router.AdminOrAPIKey,
+		},
+	}
+}
+
+// Routes returns the admin route purging the personal data of a user
+func (h *UserPurgeHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodPost, Path: "/api/admin/users/:id/purge", Handler: h.PurgeUser,
+			Access: router.AdminOrAPIKey,
+		},
+	}
+}
+
+// Routes returns the API key management routes
+func (h *APIKeyHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/admin/api-keys", Handler: h.ListKeys, Access: router.Admin},
+		{
+			Method: http.MethodPost, Path: "/api/admin/api-keys", Handler: h.CreateKey, Access: router.Admin,
+			Idempotent: true,
+		},
+		{Method: http.MethodDelete, Path: "/api/admin/api-keys/:id", Handler: h.RevokeKey, Access: router.Admin},
+	}
+}
+
+// Routes returns the signup invitation route
+func (h *InvitationHandler) Routes() []router.Route {
+	return []router.Route{
+		{
+			Method: http.MethodPost, Path: "/api/invitations", Handler: h.CreateInvitation, Access: router.Admin,
+			Middleware: []echo.MiddlewareFunc{requireVerifiedEmail()}, Idempotent: true,
+		},
+	}
+}
+
+// Routes returns the webhook endpoint management routes
+func (h *WebhookHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/admin/webhooks", Handler: h.ListWebhooks, Access: router.Admin},
+		{
+			Method: http.MethodPost, Path: "/api/admin/webhooks", Handler: h.CreateWebhook, Access: router.Admin,
+			Idempotent: true,
+		},
+		{Method: http.MethodDelete, Path: "/api/admin/webhooks/:id", Handler: h.DeleteWebhook, Access: router.Admin},
+	}
+}
+
+// Routes returns the audit log routes
+func (h *AuditLogHandler) Routes() []router.Route {
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/admin/audit-logs", Handler: h.ListAuditLogs, Access: router.AdminOrAPIKey},
+		{Method: http.MethodGet, Path: "/api/users/me/logins", Handler: h.ListLogins, Access: router.User},
+	}
+}
+
+// Routes returns the development endpoints, which are never registered in production
+func (h *DevEmailHandler) Routes() []router.Route {
+	if os.Getenv("APP_ENV") == "production" {
+		return nil
+	}
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/internal/dev/emails", Handler: h.ListEmails},
+	}
+}
+
+// requireVerifiedEmail returns the middleware of write routes that users with an unverified email
+// address are refused, when REQUIRE_VERIFIED_EMAIL is set
+func requireVerifiedEmail() echo.MiddlewareFunc {
+	return middleware.RequireVerifiedEmail(config.GetEnvBool("REQUIRE_VERIFIED_EMAIL", false))
+}
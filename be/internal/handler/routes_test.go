@@ -0,0 +1,153 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/openapi"
+	"strikepad-backend/internal/router"
+	"strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/storage"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutes_AreDocumented(t *testing.T) {
+	t.Setenv("SWAGGER_ENABLED", "true")
+	t.Setenv("APP_ENV", "dev")
+
+	providers := []router.RouteProvider{
+		handler.NewHealthHandler(nil),
+		handler.NewAPIHandler(nil),
+		handler.NewStatusHandler(nil),
+		handler.NewOpenAPIHandler(),
+		handler.NewAuthHandler(nil, nil, nil),
+		handler.NewCSRFHandler(),
+		handler.NewIdentityHandler(nil),
+		handler.NewEmailChangeHandler(nil, nil),
+		handler.NewProfileHandler(nil),
+		handler.NewUserHandler(nil),
+		handler.NewWaitlistHandler(nil),
+		handler.NewOutboxHandler(nil),
+		handler.NewAdminHandler(nil),
+		handler.NewUserPurgeHandler(nil),
+		handler.NewAPIKeyHandler(nil),
+		handler.NewInvitationHandler(nil),
+		handler.NewWebhookHandler(nil),
+		handler.NewAuditLogHandler(nil),
+		handler.NewDevEmailHandler(nil),
+		handler.NewNotificationHandler(nil),
+		handler.NewDataExportHandler(nil, nil),
+		handler.NewUploadHandler(storage.NewLocalStorage("uploads", "/uploads")),
+	}
+	// Endpoints that are not part of the API described to clients
+	undocumented := map[string]bool{
+		"GET /api/test":            true,
+		"GET /api/openapi.json":    true,
+		"GET /swagger/*":           true,
+		"GET /internal/dev/emails": true,
+		"GET /uploads/*":           true,
+	}
+
+	registered := make(map[string]bool)
+	for _, provider := range providers {
+		for _, route := range provider.Routes() {
+			key := route.Method + " " + route.Path
+			assert.False(t, registered[key], "%s is registered twice", key)
+			registered[key] = true
+			if !undocumented[key] {
+				assert.NotNil(t, route.Handler, key)
+			}
+		}
+	}
+
+	documented := make(map[string]bool)
+	for _, route := range openapi.Routes {
+		documented[route.Method+" "+route.Path] = true
+	}
+	for key := range registered {
+		if !undocumented[key] {
+			assert.True(t, documented[key], "%s is not documented in internal/openapi", key)
+		}
+	}
+	for key := range documented {
+		assert.True(t, registered[key], "%s is documented but not registered", key)
+	}
+}
+
+func TestRoutes_Production(t *testing.T) {
+	t.Setenv("APP_ENV", "production")
+
+	assert.Empty(t, handler.NewDevEmailHandler(nil).Routes())
+	assert.Equal(t, []string{"/api/openapi.json"}, routePaths(handler.NewOpenAPIHandler().Routes()))
+
+	t.Setenv("SWAGGER_ENABLED", "true")
+	assert.Equal(t, []string{"/api/openapi.json", "/swagger/*"}, routePaths(handler.NewOpenAPIHandler().Routes()))
+}
+
+func TestRoutes_RequireVerifiedEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		enforce        string
+		expectedCode   string
+		emailVerified  bool
+		expectedStatus int
+	}{
+		{name: "unverified user", enforce: "true", expectedStatus: http.StatusForbidden, expectedCode: "E300"},
+		{name: "verified user", enforce: "true", emailVerified: true, expectedStatus: http.StatusBadRequest},
+		{name: "enforcement disabled", enforce: "false", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("REQUIRE_VERIFIED_EMAIL", tt.enforce)
+			sessionService := new(mocks.MockSessionServiceInterface)
+			sessionService.On("ValidateAccessToken", "access-token").Return(&model.UserSession{
+				UserID: 1,
+				User:   model.User{ID: 1, Role: model.RoleAdmin, EmailVerified: tt.emailVerified},
+			}, nil)
+			sessionService.On("TouchSession", mock.Anything).Return()
+
+			e := echo.New()
+			e.HTTPErrorHandler = handler.HTTPErrorHandler
+			router.NewRegistrar(router.RegistrarParams{
+				SessionService:     sessionService,
+				APIKeyService:      mocks.NewMockAPIKeyServiceInterface(t),
+				AuditService:       allowAudit(),
+				IdempotencyService: mocks.NewMockIdempotencyServiceInterface(t),
+				Providers:          []router.RouteProvider{handler.NewInvitationHandler(nil)},
+			}).Register(e)
+
+			// An empty body gets past the middleware only to be rejected by the handler
+			req := httptest.NewRequest(http.MethodPost, "/api/invitations", strings.NewReader("{}"))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer access-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
+
+func routePaths(routes []router.Route) []string {
+	paths := make([]string, 0, len(routes))
+	for _, route := range routes {
+		paths = append(paths, route.Path)
+	}
+	return paths
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// statusCacheControl lets CDNs and browsers reuse a status response for as long as the service caches it
+const statusCacheControl = "public, max-age=30"
+
+type StatusHandler struct {
+	statusService service.StatusServiceInterface
+}
+
+func NewStatusHandler(statusService service.StatusServiceInterface) StatusHandlerInterface {
+	return &StatusHandler{
+		statusService: statusService,
+	}
+}
+
+// GetStatus returns the public status of the service's components
+func (h *StatusHandler) GetStatus(c echo.Context) error {
+	result := h.statusService.GetStatus()
+	c.Response().Header().Set("Cache-Control", statusCacheControl)
+	return c.JSON(http.StatusOK, result)
+}
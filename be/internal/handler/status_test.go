@@ -0,0 +1,42 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusHandler_GetStatus(t *testing.T) {
+	mockService := mocks.NewMockStatusServiceInterface(t)
+	mockService.EXPECT().GetStatus().Return(&dto.StatusResponse{
+		CheckedAt: time.Now(),
+		Status:    "degraded",
+		Components: []dto.ComponentStatus{
+			{Name: "database", Status: "outage"},
+		},
+	}).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/status", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := handler.NewStatusHandler(mockService).GetStatus(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, max-age=30", rec.Header().Get("Cache-Control"))
+
+	var response dto.StatusResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "degraded", response.Status)
+	assert.Equal(t, []dto.ComponentStatus{{Name: "database", Status: "outage"}}, response.Components)
+}
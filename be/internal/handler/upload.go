@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/storage"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UploadHandler serves uploaded files stored on local disk. Files in an object store are served
+// by the store itself.
+type UploadHandler struct {
+	storage storage.Storage
+}
+
+// NewUploadHandler creates a new upload handler
+func NewUploadHandler(store storage.Storage) UploadHandlerInterface {
+	return &UploadHandler{
+		storage: store,
+	}
+}
+
+// ServeFile returns the uploaded file under the key in the path. Private files are not found.
+func (h *UploadHandler) ServeFile(c echo.Context) error {
+	local, ok := h.storage.(*storage.LocalStorage)
+	key := c.Param("*")
+	var path string
+	err := storage.ErrNotFound
+	if ok && !storage.IsPrivate(key) {
+		path, err = local.Path(key)
+	}
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeNotFound, err, "upload not found")
+	}
+
+	// Uploads are user content: never let the browser sniff them into something executable
+	c.Response().Header().Set("X-Content-Type-Options", "nosniff")
+	c.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=86400")
+	if err := c.File(path); err != nil {
+		return errors.Wrap(errors.ErrCodeNotFound, err, "upload not found")
+	}
+	return nil
+}
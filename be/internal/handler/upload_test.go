@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/storage"
+	"strikepad-backend/internal/storage/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadHandler_ServeFile(t *testing.T) {
+	store := storage.NewLocalStorage(t.TempDir(), "/uploads")
+	require.NoError(t, store.Put(context.Background(), "avatars/usr_1.png", []byte("image"), "image/png"))
+	require.NoError(t, store.Put(context.Background(), "private/exports/exp_1.zip", []byte("archive"), "application/zip"))
+
+	e := echo.New()
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
+	uploadHandler := handler.NewUploadHandler(store)
+	for _, route := range uploadHandler.Routes() {
+		e.Add(route.Method, route.Path, route.Handler)
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedBody   string
+		expectedStatus int
+	}{
+		{name: "existing file", path: "/uploads/avatars/usr_1.png", expectedStatus: http.StatusOK, expectedBody: "image"},
+		{name: "missing file", path: "/uploads/avatars/usr_2.png", expectedStatus: http.StatusNotFound},
+		{name: "private file", path: "/uploads/private/exports/exp_1.zip", expectedStatus: http.StatusNotFound},
+		{name: "outside the storage", path: "/uploads/../go.mod", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+				assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+			}
+		})
+	}
+}
+
+func TestUploadHandler_Routes(t *testing.T) {
+	assert.Len(t, handler.NewUploadHandler(storage.NewLocalStorage(t.TempDir(), "/uploads")).Routes(), 1)
+	assert.Empty(t, handler.NewUploadHandler(storage.NewLocalStorage(t.TempDir(), "https://cdn.example.com")).Routes())
+	assert.Empty(t, handler.NewUploadHandler(mocks.NewMockStorage(t)).Routes())
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	stderrors "errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"strikepad-backend/internal/avatar"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/validator"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UserHandler handles requests about users made by the current user
+type UserHandler struct {
+	userService service.UserServiceInterface
+	validator   *validator.Validator
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(userService service.UserServiceInterface) UserHandlerInterface {
+	return &UserHandler{
+		userService: userService,
+		validator:   validator.New(),
+	}
+}
+
+// SearchUsers returns the users whose display name or email address starts with the q query
+// parameter, up to the limit query parameter
+func (h *UserHandler) SearchUsers(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	limit := 0
+	if value := c.QueryParam("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return errors.New(errors.ErrCodeInvalidRequest, "invalid limit").
+				WithDescription("limit must be a positive integer")
+		}
+		limit = parsed
+	}
+
+	response, err := h.userService.SearchUsers(userID, c.QueryParam("q"), limit)
+	if err != nil {
+		if stderrors.Is(err, service.ErrSearchQueryTooShort) {
+			return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid search query").WithDescription(err.Error())
+		}
+		slog.Error("Failed to search users", "error", err, "user_id", userID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetPrivacySettings returns the privacy settings of the authenticated user
+func (h *UserHandler) GetPrivacySettings(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	response, err := h.userService.GetPrivacySettings(userID)
+	if err != nil {
+		slog.Error("Failed to get privacy settings", "error", err, "user_id", userID)
+		return err
+	}
+
+	c.Response().Header().Set("ETag", versionETag(response.Version))
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdatePrivacySettings changes the privacy settings of the authenticated user
+func (h *UserHandler) UpdatePrivacySettings(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	var req dto.PrivacySettingsRequest
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for privacy settings", "error", err)
+		return errInvalidBody
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "privacy settings")
+	}
+
+	if ifMatch := c.Request().Header.Get("If-Match"); ifMatch != "" {
+		version, err := parseVersionETag(ifMatch)
+		if err != nil {
+			return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid If-Match").
+				WithDescription("If-Match must be the ETag of the privacy settings")
+		}
+		req.Version = &version
+	}
+	if req.Version == nil {
+		return errors.New(errors.ErrCodePreconditionRequired, "privacy settings version missing")
+	}
+
+	response, err := h.userService.UpdatePrivacySettings(userID, &req)
+	if stderrors.Is(err, repository.ErrStaleVersion) {
+		return errors.Wrap(errors.ErrCodeConflict, err, "stale privacy settings").
+			WithDescription("The privacy settings were changed since they were read")
+	}
+	if err != nil {
+		slog.Error("Failed to update privacy settings", "error", err, "user_id", userID)
+		return err
+	}
+
+	c.Response().Header().Set("ETag", versionETag(response.Version))
+	return c.JSON(http.StatusOK, response)
+}
+
+// UploadAvatar replaces the avatar of the authenticated user with the image in the avatar field of
+// a multipart form. Uploads are bounded by the request body limit (MAX_REQUEST_BODY_BYTES).
+func (h *UserHandler) UploadAvatar(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	data, err := readFormFile(c, "avatar")
+	if err != nil {
+		slog.Warn("Invalid avatar upload", "error", err)
+		return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid avatar upload").
+			WithDescription("avatar must be uploaded as a file in a multipart/form-data request")
+	}
+
+	response, err := h.userService.UploadAvatar(c.Request().Context(), userID, data)
+	if err != nil {
+		if stderrors.Is(err, avatar.ErrUnsupportedImage) || stderrors.Is(err, avatar.ErrImageTooLarge) {
+			return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid avatar").WithDescription(err.Error())
+		}
+		if stderrors.Is(err, repository.ErrStaleVersion) {
+			return errAvatarConflict
+		}
+		slog.Error("Failed to upload avatar", "error", err, "user_id", userID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteAvatar removes the avatar of the authenticated user
+func (h *UserHandler) DeleteAvatar(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		slog.Error("Failed to get user ID from JWT token")
+		return errMissingUserID
+	}
+
+	if err := h.userService.DeleteAvatar(c.Request().Context(), userID); err != nil {
+		if stderrors.Is(err, repository.ErrStaleVersion) {
+			return errAvatarConflict
+		}
+		slog.Error("Failed to delete avatar", "error", err, "user_id", userID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Avatar deleted successfully",
+	})
+}
+
+// errAvatarConflict answers an avatar change that lost a race with another change of the user
+var errAvatarConflict = errors.New(errors.ErrCodeConflict, "avatar changed concurrently").
+	WithDescription("The user was changed at the same time, try again")
+
+// versionETag is the strong ETag of a resource at version
+func versionETag(version uint) string {
+	return `"` + strconv.FormatUint(uint64(version), 10) + `"`
+}
+
+// parseVersionETag returns the version named by an ETag from versionETag. Weak ETags are refused,
+// as If-Match compares strongly.
+func parseVersionETag(etag string) (uint, error) {
+	if len(etag) < 2 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		return 0, stderrors.New("not a version ETag")
+	}
+	version, err := strconv.ParseUint(etag[1:len(etag)-1], 10, 0)
+	if err != nil {
+		return 0, err
+	}
+	return uint(version), nil
+}
+
+// readFormFile reads the file uploaded in the named field of a multipart form
+func readFormFile(c echo.Context, name string) ([]byte, error) {
+	header, err := c.FormFile(name)
+	if err != nil {
+		return nil, err
+	}
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(file)
+}
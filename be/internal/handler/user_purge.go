@@ -0,0 +1,46 @@
+package handler
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UserPurgeHandler handles the admin endpoint erasing the personal data of a user
+type UserPurgeHandler struct {
+	userPurgeService service.UserPurgeServiceInterface
+}
+
+// NewUserPurgeHandler creates a new user purge handler
+func NewUserPurgeHandler(userPurgeService service.UserPurgeServiceInterface) UserPurgeHandlerInterface {
+	return &UserPurgeHandler{
+		userPurgeService: userPurgeService,
+	}
+}
+
+// PurgeUser irreversibly erases the personal data of the user in the path, without waiting for the
+// retention window. Users that were not deleted yet are deleted and logged out.
+func (h *UserPurgeHandler) PurgeUser(c echo.Context) error {
+	userID := c.Param("id")
+	if err := publicid.Validate(publicid.PrefixUser, userID); err != nil {
+		return errInvalidUserID
+	}
+
+	if err := h.userPurgeService.PurgeUser(c.Request().Context(), userID); err != nil {
+		if stderrors.Is(err, repository.ErrUserAlreadyPurged) {
+			return errors.Wrap(errors.ErrCodeConflict, err, "user already purged").
+				WithDescription("The user's personal data was already purged")
+		}
+		return adminUserError(err, "purging user")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "User's personal data purged",
+	})
+}
@@ -0,0 +1,54 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserPurgeHandler_PurgeUser(t *testing.T) {
+	tests := []struct {
+		err            error
+		name           string
+		userID         string
+		expectedStatus int
+	}{
+		{name: "purges the user", userID: "usr_5k2d9x0q7m3hv8c1b4na", expectedStatus: http.StatusOK},
+		{name: "invalid user ID", userID: "42", expectedStatus: http.StatusBadRequest},
+		{
+			name: "user not found", userID: "usr_5k2d9x0q7m3hv8c1b4na", err: auth.ErrUserNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "already purged", userID: "usr_5k2d9x0q7m3hv8c1b4na", err: repository.ErrUserAlreadyPurged,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userPurgeService := mocks.NewMockUserPurgeServiceInterface(t)
+			if tt.expectedStatus != http.StatusBadRequest {
+				userPurgeService.EXPECT().PurgeUser(mock.Anything, tt.userID).Return(tt.err).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/users/"+tt.userID+"/purge", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.userID)
+
+			serve(c, handler.NewUserPurgeHandler(userPurgeService).PurgeUser)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/avatar"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserHandler_SearchUsers(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		query          string
+		expectedCode   string
+		expectedLimit  int
+		expectedStatus int
+		callsService   bool
+		authenticated  bool
+	}{
+		{
+			name:           "returns matches",
+			query:          "?q=jo&limit=5",
+			authenticated:  true,
+			callsService:   true,
+			expectedLimit:  5,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "query too short",
+			query:          "?q=j",
+			authenticated:  true,
+			callsService:   true,
+			serviceErr:     service.ErrSearchQueryTooShort,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "invalid limit",
+			query:          "?q=jo&limit=many",
+			authenticated:  true,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "internal error",
+			query:          "?q=jo",
+			authenticated:  true,
+			callsService:   true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+		{
+			name:           "unauthenticated",
+			query:          "?q=jo",
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "E005",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userService := mocks.NewMockUserServiceInterface(t)
+			userHandler := handler.NewUserHandler(userService)
+			if tt.callsService {
+				var response *dto.UserSearchResponse
+				if tt.serviceErr == nil {
+					response = &dto.UserSearchResponse{Data: []dto.UserSearchResult{{ID: "usr_2", DisplayName: "John"}}}
+				}
+				userService.EXPECT().SearchUsers(uint(1), mock.Anything, tt.expectedLimit).
+					Return(response, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users/search"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			if tt.authenticated {
+				c.Set("user_id", uint(1))
+			}
+
+			serve(c, userHandler.SearchUsers)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			} else {
+				var response dto.UserSearchResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "usr_2", response.Data[0].ID)
+			}
+		})
+	}
+}
+
+func TestUserHandler_UpdatePrivacySettings(t *testing.T) {
+	tests := []struct {
+		serviceErr      error
+		name            string
+		body            string
+		ifMatch         string
+		expectedCode    string
+		expectedStatus  int
+		expectedVersion uint
+		callsService    bool
+	}{
+		{
+			name:            "updates settings",
+			body:            `{"discoverable":false,"version":3}`,
+			callsService:    true,
+			expectedVersion: 3,
+			expectedStatus:  http.StatusOK,
+		},
+		{
+			name:            "version in If-Match",
+			body:            `{"discoverable":false,"version":2}`,
+			ifMatch:         `"3"`,
+			callsService:    true,
+			expectedVersion: 3,
+			expectedStatus:  http.StatusOK,
+		},
+		{
+			name:           "missing field",
+			body:           `{"version":3}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E003",
+		},
+		{
+			name:           "missing version",
+			body:           `{"discoverable":false}`,
+			expectedStatus: http.StatusPreconditionRequired,
+			expectedCode:   "E011",
+		},
+		{
+			name:           "weak ETag in If-Match",
+			body:           `{"discoverable":false}`,
+			ifMatch:        `W/"3"`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "stale version",
+			body:           `{"discoverable":false,"version":2}`,
+			callsService:   true,
+			serviceErr:     fmt.Errorf("failed to save privacy settings: %w", repository.ErrStaleVersion),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "E007",
+		},
+		{
+			name:           "internal error",
+			body:           `{"discoverable":true,"version":3}`,
+			callsService:   true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userService := mocks.NewMockUserServiceInterface(t)
+			userHandler := handler.NewUserHandler(userService)
+			if tt.callsService {
+				var response *dto.PrivacySettingsResponse
+				if tt.serviceErr == nil {
+					response = &dto.PrivacySettingsResponse{Version: 4}
+				}
+				userService.EXPECT().UpdatePrivacySettings(uint(1), mock.AnythingOfType("*dto.PrivacySettingsRequest")).
+					RunAndReturn(func(_ uint, req *dto.PrivacySettingsRequest) (*dto.PrivacySettingsResponse, error) {
+						if tt.expectedVersion != 0 {
+							assert.Equal(t, tt.expectedVersion, *req.Version)
+						}
+						return response, tt.serviceErr
+					}).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/users/me/privacy", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("user_id", uint(1))
+
+			serve(c, userHandler.UpdatePrivacySettings)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			} else {
+				assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestUserHandler_UploadAvatar(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		field          string
+		expectedCode   string
+		expectedStatus int
+		callsService   bool
+	}{
+		{
+			name:           "uploads the avatar",
+			field:          "avatar",
+			callsService:   true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing file",
+			field:          "picture",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "not an image",
+			field:          "avatar",
+			callsService:   true,
+			serviceErr:     avatar.ErrUnsupportedImage,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "concurrent change",
+			field:          "avatar",
+			callsService:   true,
+			serviceErr:     fmt.Errorf("failed to save avatar URL: %w", repository.ErrStaleVersion),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "E007",
+		},
+		{
+			name:           "internal error",
+			field:          "avatar",
+			callsService:   true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userService := mocks.NewMockUserServiceInterface(t)
+			userHandler := handler.NewUserHandler(userService)
+			if tt.callsService {
+				var response *dto.AvatarResponse
+				if tt.serviceErr == nil {
+					response = &dto.AvatarResponse{AvatarURL: "/uploads/avatars/usr_1.png?v=1"}
+				}
+				userService.EXPECT().UploadAvatar(mock.Anything, uint(1), []byte("image data")).
+					Return(response, tt.serviceErr).Once()
+			}
+
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			part, err := form.CreateFormFile(tt.field, "me.png")
+			assert.NoError(t, err)
+			_, _ = part.Write([]byte("image data"))
+			assert.NoError(t, form.Close())
+
+			req := httptest.NewRequest(http.MethodPost, "/api/users/me/avatar", &body)
+			req.Header.Set(echo.HeaderContentType, form.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("user_id", uint(1))
+
+			serve(c, userHandler.UploadAvatar)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			} else {
+				var response dto.AvatarResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "/uploads/avatars/usr_1.png?v=1", response.AvatarURL)
+			}
+		})
+	}
+}
+
+func TestUserHandler_DeleteAvatar(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		expectedStatus int
+	}{
+		{name: "deletes the avatar", expectedStatus: http.StatusOK},
+		{
+			name:           "concurrent change",
+			serviceErr:     fmt.Errorf("failed to clear avatar URL: %w", repository.ErrStaleVersion),
+			expectedStatus: http.StatusConflict,
+		},
+		{name: "internal error", serviceErr: assert.AnError, expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userService := mocks.NewMockUserServiceInterface(t)
+			userService.EXPECT().DeleteAvatar(mock.Anything, uint(1)).Return(tt.serviceErr).Once()
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/users/me/avatar", nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("user_id", uint(1))
+
+			serve(c, handler.NewUserHandler(userService).DeleteAvatar)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
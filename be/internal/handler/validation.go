@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"log/slog"
+
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/validator"
+)
+
+// validationError returns the error for the client when a request of operation fails validation,
+// listing the fields that failed
+func validationError(err error, operation string) error {
+	slog.Warn("Validation failed for "+operation, "error", err)
+	if ve, ok := err.(validator.ValidationErrors); ok {
+		// Convert validator errors to our format
+		fieldErrors := make([]errors.FieldError, 0, len(ve.Errors))
+		for _, validatorErr := range ve.Errors {
+			fieldErrors = append(fieldErrors, errors.FieldError{
+				Field:   validatorErr.Field,
+				Tag:     validatorErr.Tag,
+				Value:   validatorErr.Value,
+				Message: validatorErr.Message,
+			})
+		}
+
+		return errors.NewValidation(errors.ErrCodeValidationFailed, fieldErrors...)
+	}
+	return errors.Wrap(errors.ErrCodeValidationFailed, err, "validation failed").WithDescription(err.Error())
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/captcha"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/validator"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultWaitlistPageSize = 50
+	maxWaitlistPageSize     = 200
+)
+
+type WaitlistHandler struct {
+	waitlistService service.WaitlistServiceInterface
+	validator       *validator.Validator
+}
+
+func NewWaitlistHandler(waitlistService service.WaitlistServiceInterface) WaitlistHandlerInterface {
+	return &WaitlistHandler{
+		waitlistService: waitlistService,
+		validator:       validator.New(),
+	}
+}
+
+// Join adds the submitted email address to the waitlist
+func (h *WaitlistHandler) Join(c echo.Context) error {
+	var req dto.WaitlistJoinRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for waitlist", "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "waitlist")
+	}
+
+	if err := h.waitlistService.Join(&req, c.RealIP()); err != nil {
+		if stderrors.Is(err, captcha.ErrVerificationFailed) {
+			slog.Warn("Captcha verification failed for waitlist", "ip", c.RealIP())
+			return errors.New(errors.ErrCodeCaptchaFailed, "captcha verification failed for waitlist")
+		}
+
+		slog.Error("Failed to join waitlist", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "You're on the waitlist",
+	})
+}
+
+// ListEntries returns a page of waitlist entries for administrators
+func (h *WaitlistHandler) ListEntries(c echo.Context) error {
+	params, err := pagination.ParseQuery(c.QueryParams(), defaultWaitlistPageSize, maxWaitlistPageSize)
+	if err != nil {
+		return invalidPaginationError(err)
+	}
+
+	response, err := h.waitlistService.ListEntries(params)
+	if err != nil {
+		slog.Error("Failed to list waitlist", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// InviteBatch mints invite codes for the oldest pending waitlist entries
+func (h *WaitlistHandler) InviteBatch(c echo.Context) error {
+	var req dto.WaitlistInviteRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for waitlist invite", "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "waitlist invite")
+	}
+
+	response, err := h.waitlistService.InviteBatch(req.Count)
+	if err != nil {
+		slog.Error("Failed to invite waitlist batch", "error", err, "count", req.Count)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// invalidPaginationError rejects the page, per_page, cursor or include_total query parameters of a
+// list with err, which describes the problem to the client
+func invalidPaginationError(err error) error {
+	return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid pagination").WithDescription(err.Error())
+}
@@ -0,0 +1,216 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/captcha"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type WaitlistHandlerTestSuite struct {
+	suite.Suite
+	waitlistHandler handler.WaitlistHandlerInterface
+	mockWaitlistSvc *mocks.MockWaitlistServiceInterface
+	echo            *echo.Echo
+}
+
+func (suite *WaitlistHandlerTestSuite) SetupTest() {
+	suite.mockWaitlistSvc = mocks.NewMockWaitlistServiceInterface(suite.T())
+	suite.waitlistHandler = handler.NewWaitlistHandler(suite.mockWaitlistSvc)
+	suite.echo = echo.New()
+}
+
+func (suite *WaitlistHandlerTestSuite) TestJoin() {
+	testCases := []struct {
+		mockSetup      func()
+		expectedError  *dto.ErrorResponse
+		name           string
+		requestBody    string
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"email":"user@example.com","captcha_token":"token"}`,
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().Join(mock.MatchedBy(func(req *dto.WaitlistJoinRequest) bool {
+					return req.Email == "user@example.com" && req.CaptchaToken == "token"
+				}), mock.AnythingOfType("string")).Return(nil).Once()
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "Invalid email",
+			requestBody:    `{"email":"not-an-email"}`,
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &dto.ErrorResponse{Code: "E003", Message: "Validation failed"},
+		},
+		{
+			name:        "Captcha failure",
+			requestBody: `{"email":"user@example.com"}`,
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().Join(mock.Anything, mock.Anything).
+					Return(fmt.Errorf("failed to verify captcha: %w", captcha.ErrVerificationFailed)).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &dto.ErrorResponse{Code: "E303", Message: "Captcha verification failed"},
+		},
+		{
+			name:        "Service error",
+			requestBody: `{"email":"user@example.com"}`,
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().Join(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  &dto.ErrorResponse{Code: "E001", Message: "Internal server error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/waitlist", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+
+			serve(c, suite.waitlistHandler.Join)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedError != nil {
+				var errorResponse dto.ErrorResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &errorResponse)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedError.Code, errorResponse.Code)
+				assert.Equal(t, tc.expectedError.Message, errorResponse.Message)
+			}
+		})
+	}
+}
+
+func (suite *WaitlistHandlerTestSuite) TestListEntries() {
+	testCases := []struct {
+		mockSetup      func()
+		name           string
+		query          string
+		expectedStatus int
+	}{
+		{
+			name:  "Default paging",
+			query: "",
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().ListEntries(pagination.Params{Page: 1, PerPage: 50}).
+					Return(&dto.WaitlistListResponse{Data: []dto.WaitlistEntryInfo{}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Custom paging",
+			query: "?page=3&per_page=10&include_total=true",
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().ListEntries(pagination.Params{Page: 3, PerPage: 10, IncludeTotal: true}).
+					Return(&dto.WaitlistListResponse{Data: []dto.WaitlistEntryInfo{}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Page size too large",
+			query:          "?per_page=1000",
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Negative page",
+			query:          "?page=-1",
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Service error",
+			query: "",
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().ListEntries(pagination.Params{Page: 1, PerPage: 50}).Return(nil, assert.AnError).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/waitlist"+tc.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+
+			serve(c, suite.waitlistHandler.ListEntries)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (suite *WaitlistHandlerTestSuite) TestInviteBatch() {
+	code := "ABCDEFGHIJKLMNOP"
+	testCases := []struct {
+		mockSetup      func()
+		name           string
+		requestBody    string
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"count":1}`,
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().InviteBatch(1).Return(&dto.WaitlistInviteResponse{
+					Invited: []dto.WaitlistEntryInfo{{ID: "wle_3c9x0m2k7q4d8h1v5b6n", Email: "a@example.com", InviteCode: &code}},
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Count out of range",
+			requestBody:    `{"count":0}`,
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Service error",
+			requestBody: `{"count":5}`,
+			mockSetup: func() {
+				suite.mockWaitlistSvc.EXPECT().InviteBatch(5).Return(nil, assert.AnError).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/waitlist/invite", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+
+			serve(c, suite.waitlistHandler.InviteBatch)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestWaitlistHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(WaitlistHandlerTestSuite))
+}
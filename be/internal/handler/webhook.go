@@ -0,0 +1,100 @@
+package handler
+
+import (
+	stderrors "errors"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/validator"
+	"strikepad-backend/internal/webhook"
+
+	"github.com/labstack/echo/v4"
+)
+
+// WebhookHandler handles the admin endpoints that manage webhook endpoints
+type WebhookHandler struct {
+	webhookService service.WebhookServiceInterface
+	validator      *validator.Validator
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(webhookService service.WebhookServiceInterface) WebhookHandlerInterface {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		validator:      validator.New(),
+	}
+}
+
+// CreateWebhook registers a webhook endpoint on behalf of the authenticated admin
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	session, ok := c.Get("session").(*model.UserSession)
+	if !ok || session == nil {
+		slog.Error("Failed to get session from JWT token")
+		return errMissingSession
+	}
+
+	var req dto.CreateWebhookRequest
+
+	// Bind request body
+	if err := c.Bind(&req); err != nil {
+		slog.Warn("Invalid request body for webhook creation", "error", err)
+		return errInvalidBody
+	}
+
+	// Validate request using validator
+	if err := h.validator.Validate(&req); err != nil {
+		return validationError(err, "webhook creation")
+	}
+
+	response, err := h.webhookService.CreateEndpoint(&session.User, &req)
+	if err != nil {
+		if stderrors.Is(err, webhook.ErrInvalidURL) || stderrors.Is(err, events.ErrUnknownType) {
+			return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid webhook").WithDescription(err.Error())
+		}
+
+		slog.Error("Failed to create webhook endpoint", "error", err, "user_id", session.UserID)
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListWebhooks returns all registered webhook endpoints without their secrets
+func (h *WebhookHandler) ListWebhooks(c echo.Context) error {
+	response, err := h.webhookService.ListEndpoints()
+	if err != nil {
+		slog.Error("Failed to list webhook endpoints", "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteWebhook removes the webhook endpoint in the path
+func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
+	webhookID := c.Param("id")
+	if err := publicid.Validate(publicid.PrefixWebhook, webhookID); err != nil {
+		return errors.Wrap(errors.ErrCodeInvalidRequest, err, "invalid webhook ID").WithDescription("Invalid webhook ID")
+	}
+
+	if err := h.webhookService.DeleteEndpoint(webhookID); err != nil {
+		if stderrors.Is(err, repository.ErrWebhookNotFound) {
+			return errors.Wrap(errors.ErrCodeNotFound, err, "webhook not found").
+				WithDescription("No webhook found with the provided ID")
+		}
+
+		slog.Error("Failed to delete webhook endpoint", "error", err, "webhook_id", webhookID)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Webhook deleted",
+	})
+}
@@ -0,0 +1,180 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/webhook"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookHandler_CreateWebhook(t *testing.T) {
+	request := &dto.CreateWebhookRequest{URL: "https://hooks.example.com/strikepad", EventTypes: []string{"user.created"}}
+
+	tests := []struct {
+		serviceErr     error
+		name           string
+		body           string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:           "registers endpoint",
+			body:           `{"url":"https://hooks.example.com/strikepad","event_types":["user.created"]}`,
+			expectCall:     true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing event types",
+			body:           `{"url":"https://hooks.example.com/strikepad"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E003",
+		},
+		{
+			name:           "plain HTTP URL",
+			body:           `{"url":"https://hooks.example.com/strikepad","event_types":["user.created"]}`,
+			expectCall:     true,
+			serviceErr:     webhook.ErrInvalidURL,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "unknown event type",
+			body:           `{"url":"https://hooks.example.com/strikepad","event_types":["user.created"]}`,
+			expectCall:     true,
+			serviceErr:     fmt.Errorf("%w: user.renamed", events.ErrUnknownType),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "internal error",
+			body:           `{"url":"https://hooks.example.com/strikepad","event_types":["user.created"]}`,
+			expectCall:     true,
+			serviceErr:     assert.AnError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookService := mocks.NewMockWebhookServiceInterface(t)
+			webhookHandler := handler.NewWebhookHandler(webhookService)
+			session := &model.UserSession{UserID: 1, User: model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}}
+			if tt.expectCall {
+				var response *dto.WebhookCreatedResponse
+				if tt.serviceErr == nil {
+					response = &dto.WebhookCreatedResponse{
+						Secret:      "whsec_test",
+						WebhookInfo: dto.WebhookInfo{ID: "whk_7d2k9m4q0x8h3v1c5b6n", URL: request.URL, EventTypes: request.EventTypes},
+					}
+				}
+				webhookService.EXPECT().CreateEndpoint(&session.User, request).Return(response, tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/webhooks", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Set("session", session)
+
+			serve(c, webhookHandler.CreateWebhook)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+				return
+			}
+
+			var response dto.WebhookCreatedResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "whsec_test", response.Secret)
+			assert.Equal(t, "whk_7d2k9m4q0x8h3v1c5b6n", response.ID)
+		})
+	}
+}
+
+func TestWebhookHandler_ListWebhooks(t *testing.T) {
+	webhookService := mocks.NewMockWebhookServiceInterface(t)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	webhookService.EXPECT().ListEndpoints().
+		Return(&dto.WebhookListResponse{Data: []dto.WebhookInfo{{ID: "whk_7d2k9m4q0x8h3v1c5b6n"}}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/admin/webhooks", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	serve(c, webhookHandler.ListWebhooks)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"secret"`)
+}
+
+func TestWebhookHandler_DeleteWebhook(t *testing.T) {
+	tests := []struct {
+		serviceErr     error
+		name           string
+		id             string
+		expectedCode   string
+		expectedStatus int
+		expectCall     bool
+	}{
+		{
+			name:           "deletes endpoint",
+			id:             "whk_7d2k9m4q0x8h3v1c5b6n",
+			expectCall:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "API key ID instead of webhook ID",
+			id:             "key_8h2m4q0x9d7c3v1k5b6n",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+		{
+			name:           "endpoint not found",
+			id:             "whk_7d2k9m4q0x8h3v1c5b6n",
+			expectCall:     true,
+			serviceErr:     repository.ErrWebhookNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "E004",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookService := mocks.NewMockWebhookServiceInterface(t)
+			webhookHandler := handler.NewWebhookHandler(webhookService)
+			if tt.expectCall {
+				webhookService.EXPECT().DeleteEndpoint(tt.id).Return(tt.serviceErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/admin/webhooks/"+tt.id, http.NoBody)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			serve(c, webhookHandler.DeleteWebhook)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedCode != "" {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package lifecycle starts and stops the long-lived parts of the application in order. Components
+// append hooks as they are constructed, so a component's hooks come after those of its
+// dependencies: hooks start in the order they were appended and stop in reverse, and nothing is
+// stopped while something that depends on it still runs.
+package lifecycle
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// Hook is run when the application starts and stops. Either function may be nil.
+type Hook struct {
+	// OnStart must not block; long-running work belongs in a goroutine, see Background
+	OnStart func(ctx context.Context) error
+	// OnStop must return by the deadline of ctx
+	OnStop func(ctx context.Context) error
+	// Name identifies the hook in logs and errors
+	Name string
+}
+
+// Lifecycle holds the hooks of the application
+type Lifecycle struct {
+	hooks []Hook
+	// started is the number of hooks whose OnStart succeeded
+	started int
+	mu      sync.Mutex
+}
+
+// New creates an empty lifecycle
+func New() *Lifecycle {
+	return &Lifecycle{}
+}
+
+// Append adds hook, to be started after the hooks appended before it and stopped before them
+func (l *Lifecycle) Append(hook Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, hook)
+}
+
+// Start runs the OnStart functions in order. When one fails, the hooks started so far are stopped
+// and its error is returned.
+func (l *Lifecycle) Start(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for l.started < len(l.hooks) {
+		hook := l.hooks[l.started]
+		if hook.OnStart != nil {
+			if err := hook.OnStart(ctx); err != nil {
+				startErr := fmt.Errorf("failed to start %s: %w", hook.Name, err)
+				return stderrors.Join(startErr, l.stop(ctx))
+			}
+		}
+		slog.Debug("Started", "hook", hook.Name)
+		l.started++
+	}
+	return nil
+}
+
+// Stop runs the OnStop functions of the started hooks in reverse order. Every hook is stopped even
+// when some fail; their errors are joined.
+func (l *Lifecycle) Stop(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stop(ctx)
+}
+
+func (l *Lifecycle) stop(ctx context.Context) error {
+	var errs []error
+	for ; l.started > 0; l.started-- {
+		hook := l.hooks[l.started-1]
+		if hook.OnStop == nil {
+			continue
+		}
+		if err := hook.OnStop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", hook.Name, err))
+			continue
+		}
+		slog.Debug("Stopped", "hook", hook.Name)
+	}
+	return stderrors.Join(errs...)
+}
+
+// Background returns a hook that runs run in a goroutine from start until stop. Stopping cancels
+// the context passed to run and waits for it to return.
+func Background(name string, run func(ctx context.Context)) Hook {
+	var (
+		cancel context.CancelFunc
+		done   chan struct{}
+	)
+
+	return Hook{
+		Name: name,
+		OnStart: func(context.Context) error {
+			// Not derived from the start context, which may end once the application has started
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			done = make(chan struct{})
+			go func() {
+				defer close(done)
+				run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package lifecycle_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/lifecycle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHook returns a hook that appends its start and stop to calls, failing with the given errors
+func recordingHook(name string, calls *[]string, startErr, stopErr error) lifecycle.Hook {
+	return lifecycle.Hook{
+		Name: name,
+		OnStart: func(context.Context) error {
+			*calls = append(*calls, "start "+name)
+			return startErr
+		},
+		OnStop: func(context.Context) error {
+			*calls = append(*calls, "stop "+name)
+			return stopErr
+		},
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	t.Run("stops in reverse order", func(t *testing.T) {
+		var calls []string
+		lc := lifecycle.New()
+		lc.Append(recordingHook("database", &calls, nil, nil))
+		lc.Append(lifecycle.Hook{Name: "no-op"})
+		lc.Append(recordingHook("server", &calls, nil, nil))
+
+		require.NoError(t, lc.Start(context.Background()))
+		require.NoError(t, lc.Stop(context.Background()))
+
+		assert.Equal(t, []string{"start database", "start server", "stop server", "stop database"}, calls)
+	})
+
+	t.Run("failed start stops the hooks started so far", func(t *testing.T) {
+		var calls []string
+		lc := lifecycle.New()
+		lc.Append(recordingHook("database", &calls, nil, nil))
+		lc.Append(recordingHook("worker", &calls, errFailed, nil))
+		lc.Append(recordingHook("server", &calls, nil, nil))
+
+		err := lc.Start(context.Background())
+
+		assert.ErrorIs(t, err, errFailed)
+		assert.ErrorContains(t, err, "failed to start worker")
+		assert.Equal(t, []string{"start database", "start worker", "stop database"}, calls)
+		// Nothing is left to stop
+		require.NoError(t, lc.Stop(context.Background()))
+		assert.Len(t, calls, 3)
+	})
+
+	t.Run("failed stop stops the other hooks", func(t *testing.T) {
+		var calls []string
+		lc := lifecycle.New()
+		lc.Append(recordingHook("database", &calls, nil, nil))
+		lc.Append(recordingHook("cache", &calls, nil, errFailed))
+
+		require.NoError(t, lc.Start(context.Background()))
+		err := lc.Stop(context.Background())
+
+		assert.ErrorIs(t, err, errFailed)
+		assert.ErrorContains(t, err, "failed to stop cache")
+		assert.Equal(t, []string{"start database", "start cache", "stop cache", "stop database"}, calls)
+	})
+}
+
+func TestBackground(t *testing.T) {
+	t.Run("runs until stopped", func(t *testing.T) {
+		running := make(chan struct{})
+		stopped := false
+		hook := lifecycle.Background("worker", func(ctx context.Context) {
+			close(running)
+			<-ctx.Done()
+			stopped = true
+		})
+
+		require.NoError(t, hook.OnStart(context.Background()))
+		<-running
+		require.NoError(t, hook.OnStop(context.Background()))
+
+		assert.True(t, stopped)
+	})
+
+	t.Run("stop deadline", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		hook := lifecycle.Background("stuck worker", func(context.Context) { <-release })
+
+		require.NoError(t, hook.OnStart(context.Background()))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, hook.OnStop(ctx), context.DeadlineExceeded)
+	})
+}
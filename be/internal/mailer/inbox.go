@@ -0,0 +1,62 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// inboxCapacity is how many emails an InboxMailer keeps; older ones are dropped
+const inboxCapacity = 200
+
+// CapturedEmail is an email kept by an InboxMailer
+type CapturedEmail struct {
+	SentAt time.Time
+	From   string
+	Message
+}
+
+// Inbox is implemented by mailers that keep the emails they are given
+type Inbox interface {
+	Emails() []CapturedEmail
+}
+
+// InboxMailer keeps emails in a local in-memory inbox instead of sending them. It is used in
+// sandbox mode so that signup and other email flows can be completed without a provider.
+type InboxMailer struct {
+	from   string
+	emails []CapturedEmail
+	mu     sync.Mutex
+}
+
+// NewInboxMailer creates a mailer with an empty inbox
+func NewInboxMailer(from string) *InboxMailer {
+	return &InboxMailer{from: from}
+}
+
+// Send adds the message to the inbox
+func (m *InboxMailer) Send(_ context.Context, msg Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.emails = append(m.emails, CapturedEmail{SentAt: time.Now(), From: m.from, Message: msg})
+	if len(m.emails) > inboxCapacity {
+		m.emails = m.emails[len(m.emails)-inboxCapacity:]
+	}
+
+	slog.Info("Email captured in sandbox inbox (not sent)", "to", msg.To, "subject", msg.Subject)
+	return nil
+}
+
+// Emails returns the captured emails, oldest first
+func (m *InboxMailer) Emails() []CapturedEmail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]CapturedEmail(nil), m.emails...)
+}
@@ -0,0 +1,40 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxMailer_Send(t *testing.T) {
+	m := NewInboxMailer("StrikePad <no-reply@localhost>")
+	ctx := context.Background()
+
+	require.NoError(t, m.Send(ctx, Message{To: "user@example.com", Subject: "Welcome", Text: "Hello"}))
+	assert.ErrorIs(t, m.Send(ctx, Message{To: "not an address", Subject: "Welcome", Text: "Hello"}), ErrInvalidMessage)
+
+	emails := m.Emails()
+	require.Len(t, emails, 1)
+	assert.Equal(t, "StrikePad <no-reply@localhost>", emails[0].From)
+	assert.Equal(t, "user@example.com", emails[0].To)
+	assert.Equal(t, "Welcome", emails[0].Subject)
+	assert.False(t, emails[0].SentAt.IsZero())
+}
+
+func TestInboxMailer_DropsOldestEmails(t *testing.T) {
+	m := NewInboxMailer(defaultFrom)
+
+	for i := range inboxCapacity + 5 {
+		require.NoError(t, m.Send(context.Background(), Message{
+			To: "user@example.com", Subject: fmt.Sprintf("Email %d", i), Text: "Hello",
+		}))
+	}
+
+	emails := m.Emails()
+	assert.Len(t, emails, inboxCapacity)
+	assert.Equal(t, "Email 5", emails[0].Subject)
+	assert.Equal(t, fmt.Sprintf("Email %d", inboxCapacity+4), emails[len(emails)-1].Subject)
+}
@@ -0,0 +1,31 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogMailer writes emails to the log instead of sending them, for development
+type LogMailer struct {
+	from string
+}
+
+// NewLogMailer creates a new log mailer
+func NewLogMailer(from string) *LogMailer {
+	return &LogMailer{from: from}
+}
+
+// Send logs the message
+func (m *LogMailer) Send(_ context.Context, msg Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	slog.Info("Email (not sent, MAIL_PROVIDER=log)",
+		"from", m.from,
+		"to", msg.To,
+		"subject", msg.Subject,
+		"text", msg.Text,
+	)
+	return nil
+}
@@ -0,0 +1,129 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/awssig"
+	"strikepad-backend/internal/config"
+)
+
+const (
+	// ProviderLog only logs emails and is the default, for development
+	ProviderLog = "log"
+	// ProviderSMTP sends emails through an SMTP server
+	ProviderSMTP = "smtp"
+	// ProviderSES sends emails through the Amazon SES v2 API
+	ProviderSES = "ses"
+	// ProviderSendGrid sends emails through the SendGrid v3 API
+	ProviderSendGrid = "sendgrid"
+
+	defaultFrom        = "StrikePad <no-reply@localhost>"
+	defaultMaxAttempts = 3
+	defaultRetryDelay  = time.Second
+)
+
+var (
+	// ErrInvalidMessage is returned for messages that cannot be sent as they are
+	ErrInvalidMessage = errors.New("invalid email message")
+	// ErrRejected is returned when the provider permanently refuses a message, so retrying will not help
+	ErrRejected = errors.New("email rejected by provider")
+)
+
+// Message is an email to a single recipient. HTML is optional.
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+	HTML    string `json:"html,omitempty"`
+}
+
+// Validate checks that the message has a valid recipient and a subject that is safe to put in a header
+func (m Message) Validate() error {
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return fmt.Errorf("%w: invalid recipient: %v", ErrInvalidMessage, err)
+	}
+	if strings.TrimSpace(m.Subject) == "" || strings.ContainsAny(m.Subject, "\r\n") {
+		return fmt.Errorf("%w: subject must be a single non-empty line", ErrInvalidMessage)
+	}
+	if m.Text == "" {
+		return fmt.Errorf("%w: text body is empty", ErrInvalidMessage)
+	}
+	return nil
+}
+
+// Mailer sends emails
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer returns the mailer selected by MAIL_PROVIDER, retrying transient failures up to
+// MAIL_MAX_ATTEMPTS times. When no provider is selected, or the selected one is not configured,
+// emails are only logged. In sandbox mode emails are kept in an InboxMailer.
+func NewMailer() Mailer {
+	from := config.GetEnv("MAIL_FROM", defaultFrom)
+	if _, err := mail.ParseAddress(from); err != nil {
+		panic("Invalid MAIL_FROM address: " + err.Error())
+	}
+
+	if config.SandboxMode() {
+		return NewInboxMailer(from)
+	}
+
+	var m Mailer
+	provider := strings.ToLower(config.GetEnv("MAIL_PROVIDER", ProviderLog))
+	switch provider {
+	case ProviderLog:
+		return NewLogMailer(from)
+	case ProviderSMTP:
+		host := config.GetEnv("SMTP_HOST", "")
+		if host == "" {
+			return unconfiguredProvider(provider, from, "SMTP_HOST is empty")
+		}
+		m = NewSMTPMailer(host, config.GetEnvInt("SMTP_PORT", 587),
+			config.GetEnv("SMTP_USERNAME", ""), config.GetEnv("SMTP_PASSWORD", ""), from)
+	case ProviderSES:
+		credentials := awssig.CredentialsFromEnv()
+		if credentials.Empty() {
+			return unconfiguredProvider(provider, from, "AWS credentials are empty")
+		}
+		m = NewSESMailer(config.GetEnv("SES_REGION", "us-east-1"), credentials, from)
+	case ProviderSendGrid:
+		apiKey := config.GetEnv("SENDGRID_API_KEY", "")
+		if apiKey == "" {
+			return unconfiguredProvider(provider, from, "SENDGRID_API_KEY is empty")
+		}
+		m = NewSendGridMailer(apiKey, from)
+	default:
+		return unconfiguredProvider(provider, from, "unknown provider")
+	}
+
+	return NewRetryingMailer(m, config.GetEnvInt("MAIL_MAX_ATTEMPTS", defaultMaxAttempts), defaultRetryDelay)
+}
+
+// unconfiguredProvider falls back to logging emails when the selected provider cannot be used
+func unconfiguredProvider(provider, from, reason string) Mailer {
+	slog.Warn("Email provider is not configured, emails will only be logged", "provider", provider, "reason", reason)
+	return NewLogMailer(from)
+}
+
+// checkResponse maps the status of a provider API response to an error. Client errors other
+// than 429 are permanent; rate limiting and server errors may succeed on retry.
+func checkResponse(provider string, resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %s returned status %d: %s", ErrRejected, provider, resp.StatusCode, body)
+	}
+	return fmt.Errorf("%s returned status %d: %s", provider, resp.StatusCode, body)
+}
@@ -0,0 +1,108 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMailer(t *testing.T) {
+	tests := []struct {
+		env      map[string]string
+		expected interface{}
+		name     string
+	}{
+		{
+			name:     "log by default",
+			env:      map[string]string{},
+			expected: &LogMailer{},
+		},
+		{
+			name:     "smtp",
+			env:      map[string]string{"MAIL_PROVIDER": "smtp", "SMTP_HOST": "smtp.example.com"},
+			expected: &RetryingMailer{},
+		},
+		{
+			name:     "smtp without host",
+			env:      map[string]string{"MAIL_PROVIDER": "smtp"},
+			expected: &LogMailer{},
+		},
+		{
+			name: "ses",
+			env: map[string]string{
+				"MAIL_PROVIDER":         "SES",
+				"AWS_ACCESS_KEY_ID":     "AKIDEXAMPLE",
+				"AWS_SECRET_ACCESS_KEY": "secret",
+			},
+			expected: &RetryingMailer{},
+		},
+		{
+			name:     "ses without credentials",
+			env:      map[string]string{"MAIL_PROVIDER": "ses"},
+			expected: &LogMailer{},
+		},
+		{
+			name:     "sendgrid",
+			env:      map[string]string{"MAIL_PROVIDER": "sendgrid", "SENDGRID_API_KEY": "sg-key"},
+			expected: &RetryingMailer{},
+		},
+		{
+			name:     "sandbox mode",
+			env:      map[string]string{"SANDBOX_MODE": "true", "MAIL_PROVIDER": "sendgrid", "SENDGRID_API_KEY": "sg-key"},
+			expected: &InboxMailer{},
+		},
+		{
+			name:     "unknown provider",
+			env:      map[string]string{"MAIL_PROVIDER": "carrier-pigeon"},
+			expected: &LogMailer{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{
+				"MAIL_PROVIDER", "MAIL_FROM", "SMTP_HOST", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "SENDGRID_API_KEY",
+				"SANDBOX_MODE",
+			} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			assert.IsType(t, tt.expected, NewMailer())
+		})
+	}
+}
+
+func TestNewMailerInvalidFrom(t *testing.T) {
+	t.Setenv("MAIL_FROM", "not an address")
+
+	assert.Panics(t, func() { NewMailer() })
+}
+
+func TestMessage_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         Message
+		expectError bool
+	}{
+		{name: "valid", msg: Message{To: "user@example.com", Subject: "Hello", Text: "Hello"}},
+		{name: "invalid recipient", msg: Message{To: "user", Subject: "Hello", Text: "Hello"}, expectError: true},
+		{
+			name:        "header injection in subject",
+			msg:         Message{To: "user@example.com", Subject: "Hello\r\nBcc: x@example.com", Text: "Hello"},
+			expectError: true,
+		},
+		{name: "empty subject", msg: Message{To: "user@example.com", Text: "Hello"}, expectError: true},
+		{name: "empty text", msg: Message{To: "user@example.com", Subject: "Hello"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.Validate()
+			if tt.expectError {
+				assert.ErrorIs(t, err, ErrInvalidMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
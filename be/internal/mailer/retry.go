@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// RetryingMailer retries failed sends with exponential backoff. Invalid and rejected
+// messages are not retried.
+type RetryingMailer struct {
+	next        Mailer
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+// NewRetryingMailer creates a mailer that makes up to maxAttempts attempts, waiting baseDelay
+// after the first failure and doubling the wait after each further one
+func NewRetryingMailer(next Mailer, maxAttempts int, baseDelay time.Duration) *RetryingMailer {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &RetryingMailer{
+		next:        next,
+		maxAttempts: maxAttempts,
+		baseDelay:   baseDelay,
+	}
+}
+
+// Send sends the message, retrying transient failures
+func (m *RetryingMailer) Send(ctx context.Context, msg Message) error {
+	delay := m.baseDelay
+	for attempt := 1; ; attempt++ {
+		err := m.next.Send(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, ErrInvalidMessage) || errors.Is(err, ErrRejected) || attempt >= m.maxAttempts {
+			return fmt.Errorf("failed to send email after %d attempt(s): %w", attempt, err)
+		}
+
+		slog.Warn("Failed to send email, retrying", "error", err, "attempt", attempt, "retry_in", delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("failed to send email after %d attempt(s): %w", attempt, ctx.Err())
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,84 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMailer returns err from its first failures calls to Send
+type fakeMailer struct {
+	err      error
+	failures int
+	calls    int
+}
+
+func (m *fakeMailer) Send(_ context.Context, _ Message) error {
+	m.calls++
+	if m.calls <= m.failures {
+		return m.err
+	}
+	return nil
+}
+
+func TestRetryingMailer_Send(t *testing.T) {
+	transient := errors.New("connection reset")
+
+	tests := []struct {
+		err           error
+		name          string
+		failures      int
+		expectedCalls int
+		expectError   bool
+	}{
+		{name: "first attempt succeeds", expectedCalls: 1},
+		{name: "transient failure is retried", err: transient, failures: 2, expectedCalls: 3},
+		{name: "gives up after max attempts", err: transient, failures: 5, expectedCalls: 3, expectError: true},
+		{
+			name:          "rejection is not retried",
+			err:           fmt.Errorf("%w: mailbox unavailable", ErrRejected),
+			failures:      5,
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		{
+			name:          "invalid message is not retried",
+			err:           fmt.Errorf("%w: invalid recipient", ErrInvalidMessage),
+			failures:      5,
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &fakeMailer{err: tt.err, failures: tt.failures}
+			m := NewRetryingMailer(next, 3, time.Millisecond)
+
+			err := m.Send(context.Background(), Message{})
+
+			assert.Equal(t, tt.expectedCalls, next.calls)
+			if tt.expectError {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRetryingMailer_SendCanceled(t *testing.T) {
+	next := &fakeMailer{err: errors.New("connection reset"), failures: 5}
+	m := NewRetryingMailer(next, 3, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := m.Send(ctx, Message{})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, next.calls)
+}
@@ -0,0 +1,100 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"time"
+)
+
+const defaultSendGridURL = "https://api.sendgrid.com/v3/mail/send"
+
+// SendGridMailer sends emails through the SendGrid v3 mail send API
+type SendGridMailer struct {
+	httpClient *http.Client
+	apiKey     string
+	from       string
+	endpoint   string
+}
+
+// NewSendGridMailer creates a new SendGrid mailer
+func NewSendGridMailer(apiKey, from string) *SendGridMailer {
+	return &SendGridMailer{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		apiKey:     apiKey,
+		from:       from,
+		endpoint:   defaultSendGridURL,
+	}
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+}
+
+type sendGridRequest struct {
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	Content          []sendGridContent         `json:"content"`
+}
+
+// Send posts the message to SendGrid
+func (m *SendGridMailer) Send(ctx context.Context, msg Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	sender, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("%w: invalid sender: %v", ErrInvalidMessage, err)
+	}
+	recipient, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("%w: invalid recipient: %v", ErrInvalidMessage, err)
+	}
+
+	payload := sendGridRequest{
+		From:    sendGridAddress{Email: sender.Address, Name: sender.Name},
+		Subject: msg.Subject,
+		Personalizations: []sendGridPersonalization{
+			{To: []sendGridAddress{{Email: recipient.Address, Name: recipient.Name}}},
+		},
+		Content: []sendGridContent{{Type: "text/plain", Value: msg.Text}},
+	}
+	if msg.HTML != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/html", Value: msg.HTML})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode SendGrid request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create SendGrid request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach SendGrid: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return checkResponse("SendGrid", resp)
+}
@@ -0,0 +1,86 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridMailer_Send(t *testing.T) {
+	var received sendGridRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sg-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch received.Personalizations[0].To[0].Email {
+		case "busy@example.com":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "down@example.com":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+
+	newMailer := func(apiKey string) *SendGridMailer {
+		m := NewSendGridMailer(apiKey, "StrikePad <no-reply@example.com>")
+		m.httpClient = server.Client()
+		m.endpoint = server.URL
+		return m
+	}
+
+	tests := []struct {
+		expectedErr error
+		name        string
+		apiKey      string
+		to          string
+		expectError bool
+	}{
+		{name: "accepted", apiKey: "sg-key", to: "Test User <user@example.com>"},
+		{name: "invalid API key", apiKey: "wrong", to: "user@example.com", expectError: true, expectedErr: ErrRejected},
+		{name: "rate limited", apiKey: "sg-key", to: "busy@example.com", expectError: true},
+		{name: "server error", apiKey: "sg-key", to: "down@example.com", expectError: true},
+		{name: "invalid recipient", apiKey: "sg-key", to: "not-an-email", expectError: true, expectedErr: ErrInvalidMessage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newMailer(tt.apiKey).Send(context.Background(), Message{
+				To:      tt.to,
+				Subject: "Hello",
+				Text:    "Hello in text",
+				HTML:    "<p>Hello in HTML</p>",
+			})
+
+			if !tt.expectError {
+				require.NoError(t, err)
+				assert.Equal(t, sendGridAddress{Email: "no-reply@example.com", Name: "StrikePad"}, received.From)
+				assert.Equal(t, sendGridAddress{Email: "user@example.com", Name: "Test User"},
+					received.Personalizations[0].To[0])
+				assert.Equal(t, []sendGridContent{
+					{Type: "text/plain", Value: "Hello in text"},
+					{Type: "text/html", Value: "<p>Hello in HTML</p>"},
+				}, received.Content)
+				return
+			}
+
+			assert.Error(t, err)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NotErrorIs(t, err, ErrRejected)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"strikepad-backend/internal/awssig"
+)
+
+// SESMailer sends emails through the Amazon SES v2 SendEmail API
+type SESMailer struct {
+	httpClient  *http.Client
+	credentials awssig.Credentials
+	region      string
+	from        string
+	endpoint    string
+}
+
+// NewSESMailer creates a new SES mailer for the region
+func NewSESMailer(region string, credentials awssig.Credentials, from string) *SESMailer {
+	return &SESMailer{
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		credentials: credentials,
+		region:      region,
+		from:        from,
+		endpoint:    "https://email." + region + ".amazonaws.com/v2/email/outbound-emails",
+	}
+}
+
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+type sesBody struct {
+	Text *sesContent `json:"Text,omitempty"`
+	HTML *sesContent `json:"Html,omitempty"`
+}
+
+type sesDestination struct {
+	ToAddresses []string `json:"ToAddresses"`
+}
+
+type sesSimpleMessage struct {
+	Body    sesBody    `json:"Body"`
+	Subject sesContent `json:"Subject"`
+}
+
+type sesEmailContent struct {
+	Simple sesSimpleMessage `json:"Simple"`
+}
+
+type sesRequest struct {
+	Content          sesEmailContent `json:"Content"`
+	FromEmailAddress string          `json:"FromEmailAddress"`
+	Destination      sesDestination  `json:"Destination"`
+}
+
+// Send posts the message to SES
+func (m *SESMailer) Send(ctx context.Context, msg Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	simple := sesSimpleMessage{
+		Subject: sesContent{Data: msg.Subject, Charset: "UTF-8"},
+		Body:    sesBody{Text: &sesContent{Data: msg.Text, Charset: "UTF-8"}},
+	}
+	if msg.HTML != "" {
+		simple.Body.HTML = &sesContent{Data: msg.HTML, Charset: "UTF-8"}
+	}
+	payload := sesRequest{
+		FromEmailAddress: m.from,
+		Destination:      sesDestination{ToAddresses: []string{msg.To}},
+		Content:          sesEmailContent{Simple: simple},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode SES request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create SES request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	awssig.Sign(req, body, m.region, "ses", m.credentials, time.Now())
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach SES: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return checkResponse("SES", resp)
+}
@@ -0,0 +1,63 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/awssig"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSESMailer_Send(t *testing.T) {
+	var received sesRequest
+	var authorization, securityToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		securityToken = r.Header.Get("X-Amz-Security-Token")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if received.Destination.ToAddresses[0] == "blocked@example.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Email address is not verified."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"MessageId":"0100018c"}`))
+	}))
+	defer server.Close()
+
+	m := NewSESMailer("eu-west-1", awssig.Credentials{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		SessionToken:    "session-token",
+	}, "StrikePad <no-reply@example.com>")
+	m.httpClient = server.Client()
+	m.endpoint = server.URL + "/v2/email/outbound-emails"
+
+	err := m.Send(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Hello",
+		Text:    "Hello in text",
+		HTML:    "<p>Hello in HTML</p>",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"))
+	assert.Contains(t, authorization, "/eu-west-1/ses/aws4_request")
+	assert.Contains(t, authorization, "SignedHeaders=content-type;host;x-amz-date;x-amz-security-token")
+	assert.Equal(t, "session-token", securityToken)
+	assert.Equal(t, "StrikePad <no-reply@example.com>", received.FromEmailAddress)
+	assert.Equal(t, "Hello", received.Content.Simple.Subject.Data)
+	assert.Equal(t, "Hello in text", received.Content.Simple.Body.Text.Data)
+	assert.Equal(t, "<p>Hello in HTML</p>", received.Content.Simple.Body.HTML.Data)
+
+	err = m.Send(context.Background(), Message{To: "blocked@example.com", Subject: "Hello", Text: "Hello"})
+	assert.ErrorIs(t, err, ErrRejected)
+}
@@ -0,0 +1,187 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+)
+
+// smtpsPort is the port for SMTP over implicit TLS; other ports upgrade with STARTTLS when offered
+const smtpsPort = 465
+
+// SMTPMailer sends emails through an SMTP server
+type SMTPMailer struct {
+	auth smtp.Auth
+	host string
+	addr string
+	from string
+}
+
+// NewSMTPMailer creates a new SMTP mailer. Authentication is skipped when username is empty.
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPMailer{
+		auth: auth,
+		host: host,
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		from: from,
+	}
+}
+
+// Send delivers the message to the SMTP server
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	sender, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("%w: invalid sender: %v", ErrInvalidMessage, err)
+	}
+	recipient, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("%w: invalid recipient: %v", ErrInvalidMessage, err)
+	}
+
+	body, err := buildMIMEMessage(m.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	conn, err := m.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return smtpError("start SMTP session", err)
+	}
+	defer client.Close()
+
+	if _, isTLS := conn.(*tls.Conn); !isTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}); err != nil {
+				return smtpError("start TLS", err)
+			}
+		}
+	}
+	if m.auth != nil {
+		if err := client.Auth(m.auth); err != nil {
+			return smtpError("authenticate", err)
+		}
+	}
+
+	if err := client.Mail(sender.Address); err != nil {
+		return smtpError("set sender", err)
+	}
+	if err := client.Rcpt(recipient.Address); err != nil {
+		return smtpError("set recipient", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return smtpError("start message data", err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return smtpError("write message data", err)
+	}
+	if err := w.Close(); err != nil {
+		return smtpError("finish message data", err)
+	}
+	return client.Quit()
+}
+
+func (m *SMTPMailer) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if _, port, _ := net.SplitHostPort(m.addr); port == strconv.Itoa(smtpsPort) {
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config:    &tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12},
+		}
+		return tlsDialer.DialContext(ctx, "tcp", m.addr)
+	}
+	return dialer.DialContext(ctx, "tcp", m.addr)
+}
+
+// smtpError marks permanent (5xx) SMTP replies as rejections
+func smtpError(action string, err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return fmt.Errorf("%w: failed to %s: %v", ErrRejected, action, err)
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
+
+// buildMIMEMessage formats the message as a MIME email, with text and HTML alternatives
+// when the message has an HTML body
+func buildMIMEMessage(from string, msg Message, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from + "\r\n")
+	buf.WriteString("To: " + msg.To + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("UTF-8", msg.Subject) + "\r\n")
+	buf.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if msg.HTML == "" {
+		if err := writePart(&buf, "text/plain", msg.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	boundary, err := randomBoundary()
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString("Content-Type: multipart/alternative; boundary=\"" + boundary + "\"\r\n\r\n")
+	buf.WriteString("--" + boundary + "\r\n")
+	if err := writePart(&buf, "text/plain", msg.Text); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\r\n--" + boundary + "\r\n")
+	if err := writePart(&buf, "text/html", msg.HTML); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\r\n--" + boundary + "--\r\n")
+	return buf.Bytes(), nil
+}
+
+// writePart writes the headers and quoted-printable body of a single MIME part
+func writePart(buf *bytes.Buffer, contentType, content string) error {
+	buf.WriteString("Content-Type: " + contentType + "; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(buf)
+	if _, err := w.Write([]byte(content)); err != nil {
+		return fmt.Errorf("failed to encode message body: %w", err)
+	}
+	return w.Close()
+}
+
+func randomBoundary() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate MIME boundary: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
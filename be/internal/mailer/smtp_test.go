@@ -0,0 +1,153 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts one SMTP session, answering every command with replies[command]
+// or 250, and returns the received message data on the channel
+func fakeSMTPServer(t *testing.T, replies map[string]string) (string, <-chan string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	data := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 fake.example ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+			if reply, ok := replies[command]; ok {
+				_ = tp.PrintfLine("%s", reply)
+				continue
+			}
+
+			switch command {
+			case "EHLO":
+				_ = tp.PrintfLine("250 fake.example")
+			case "DATA":
+				_ = tp.PrintfLine("354 Go ahead")
+				body, _ := tp.ReadDotBytes()
+				data <- string(body)
+				_ = tp.PrintfLine("250 OK")
+			case "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				return
+			default:
+				_ = tp.PrintfLine("250 OK")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), data
+}
+
+func newTestSMTPMailer(t *testing.T, addr string) *SMTPMailer {
+	t.Helper()
+
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	m := NewSMTPMailer(host, 0, "", "", "StrikePad <no-reply@example.com>")
+	m.addr = net.JoinHostPort(host, port)
+	return m
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	addr, data := fakeSMTPServer(t, nil)
+	m := newTestSMTPMailer(t, addr)
+
+	err := m.Send(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Hello",
+		Text:    "Hello in text",
+		HTML:    "<p>Hello in HTML</p>",
+	})
+	require.NoError(t, err)
+
+	select {
+	case body := <-data:
+		assert.Contains(t, body, "From: StrikePad <no-reply@example.com>\n")
+		assert.Contains(t, body, "To: user@example.com\n")
+		assert.Contains(t, body, "Subject: Hello\n")
+		assert.Contains(t, body, "Content-Type: multipart/alternative")
+		assert.Contains(t, body, "Hello in text")
+		assert.Contains(t, body, "<p>Hello in HTML</p>")
+	case <-time.After(time.Second):
+		t.Fatal("message data was not received")
+	}
+}
+
+func TestSMTPMailer_SendRejected(t *testing.T) {
+	addr, _ := fakeSMTPServer(t, map[string]string{"RCPT": "550 Mailbox unavailable"})
+	m := newTestSMTPMailer(t, addr)
+
+	err := m.Send(context.Background(), Message{To: "user@example.com", Subject: "Hello", Text: "Hello"})
+
+	assert.ErrorIs(t, err, ErrRejected)
+}
+
+func TestSMTPMailer_SendTemporaryFailure(t *testing.T) {
+	addr, _ := fakeSMTPServer(t, map[string]string{"RCPT": "451 Try again later"})
+	m := newTestSMTPMailer(t, addr)
+
+	err := m.Send(context.Background(), Message{To: "user@example.com", Subject: "Hello", Text: "Hello"})
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrRejected)
+}
+
+func TestBuildMIMEMessage(t *testing.T) {
+	now := time.Date(2025, 1, 27, 10, 15, 30, 0, time.UTC)
+
+	t.Run("text only", func(t *testing.T) {
+		body, err := buildMIMEMessage("no-reply@example.com", Message{
+			To:      "user@example.com",
+			Subject: "Grüße",
+			Text:    "Hello",
+		}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "From: no-reply@example.com\r\n"+
+			"To: user@example.com\r\n"+
+			"Subject: =?UTF-8?q?Gr=C3=BC=C3=9Fe?=\r\n"+
+			"Date: Mon, 27 Jan 2025 10:15:30 +0000\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n"+
+			"Content-Transfer-Encoding: quoted-printable\r\n\r\n"+
+			"Hello", string(body))
+	})
+
+	t.Run("text and HTML", func(t *testing.T) {
+		body, err := buildMIMEMessage("no-reply@example.com", Message{
+			To:      "user@example.com",
+			Subject: "Hello",
+			Text:    "Hello",
+			HTML:    "<p>Hello</p>",
+		}, now)
+
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "Content-Type: multipart/alternative; boundary=")
+		assert.Contains(t, string(body), "Content-Type: text/plain; charset=UTF-8")
+		assert.Contains(t, string(body), "Content-Type: text/html; charset=UTF-8")
+	})
+}
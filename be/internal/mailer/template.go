@@ -0,0 +1,99 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+const (
+	// TemplateVerifyEmail asks a user to confirm their email address; its data is VerifyEmailData
+	TemplateVerifyEmail = "verify_email"
+	// TemplatePasswordReset sends a password reset link; its data is PasswordResetData
+	TemplatePasswordReset = "password_reset"
+	// TemplateNewLogin alerts a user to a sign-in from a new device or country; its data is NewLoginData
+	TemplateNewLogin = "new_login"
+	// TemplateInvitation invites someone to create an account; its data is InvitationData
+	TemplateInvitation = "invitation"
+	// TemplateEmailChange asks the current or the new address to confirm an email change; its data is
+	// EmailChangeData
+	TemplateEmailChange = "email_change"
+)
+
+// VerifyEmailData is the data of the verify_email template
+type VerifyEmailData struct {
+	DisplayName    string
+	VerifyURL      string
+	ExpiresInHours int
+}
+
+// PasswordResetData is the data of the password_reset template
+type PasswordResetData struct {
+	DisplayName      string
+	ResetURL         string
+	ExpiresInMinutes int
+}
+
+// NewLoginData is the data of the new_login template. NewDevice is false when only the country is new.
+type NewLoginData struct {
+	DisplayName   string
+	Device        string
+	Country       string
+	IPAddress     string
+	Time          string
+	RevokeURL     string
+	ExpiresInDays int
+	NewDevice     bool
+}
+
+// InvitationData is the data of the invitation template
+type InvitationData struct {
+	InvitedBy     string
+	SignupURL     string
+	ExpiresInDays int
+}
+
+// EmailChangeData is the data of the email_change template. CurrentAddress is true in the email to
+// the address being replaced.
+type EmailChangeData struct {
+	DisplayName    string
+	NewEmail       string
+	ConfirmURL     string
+	ExpiresInHours int
+	CurrentAddress bool
+}
+
+// Each email has a <name>.txt template, which also defines the <name>.subject template,
+// and a <name>.html template
+//
+//go:embed templates
+var templateFS embed.FS
+
+var (
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html"))
+)
+
+// NewMessage renders the named email template with data into a message for the recipient
+func NewMessage(to, name string, data interface{}) (Message, error) {
+	var subject, text, html bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&subject, name+".subject", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render subject of email %q: %w", name, err)
+	}
+	if err := textTemplates.ExecuteTemplate(&text, name+".txt", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render text of email %q: %w", name, err)
+	}
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render HTML of email %q: %w", name, err)
+	}
+
+	return Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessage(t *testing.T) {
+	t.Run("verify email", func(t *testing.T) {
+		msg, err := NewMessage("user@example.com", TemplateVerifyEmail, VerifyEmailData{
+			DisplayName:    "Test User",
+			VerifyURL:      "https://strikepad.example/verify?token=abc&x=1",
+			ExpiresInHours: 24,
+		})
+
+		require.NoError(t, err)
+		assert.NoError(t, msg.Validate())
+		assert.Equal(t, "user@example.com", msg.To)
+		assert.Equal(t, "Verify your StrikePad email address", msg.Subject)
+		assert.Regexp(t, `^Hi Test User,\n`, msg.Text)
+		assert.Contains(t, msg.Text, "https://strikepad.example/verify?token=abc&x=1")
+		assert.Contains(t, msg.Text, "24 hours")
+		assert.Contains(t, msg.HTML, `href="https://strikepad.example/verify?token=abc&amp;x=1"`)
+	})
+
+	t.Run("password reset", func(t *testing.T) {
+		msg, err := NewMessage("user@example.com", TemplatePasswordReset, PasswordResetData{
+			DisplayName:      "<b>Test</b>",
+			ResetURL:         "https://strikepad.example/reset?token=abc",
+			ExpiresInMinutes: 30,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Reset your StrikePad password", msg.Subject)
+		assert.Contains(t, msg.Text, "30 minutes")
+		assert.Contains(t, msg.HTML, "Hi &lt;b&gt;Test&lt;/b&gt;,")
+	})
+
+	t.Run("new login", func(t *testing.T) {
+		msg, err := NewMessage("user@example.com", TemplateNewLogin, NewLoginData{
+			DisplayName:   "Test User",
+			Device:        "Firefox on Linux",
+			Country:       "DE",
+			IPAddress:     "203.0.113.7",
+			Time:          "Fri, 16 Oct 2026 09:30:00 UTC",
+			RevokeURL:     "https://api.strikepad.example/api/auth/sessions/revoke?token=abc",
+			ExpiresInDays: 7,
+			NewDevice:     true,
+		})
+
+		require.NoError(t, err)
+		assert.NoError(t, msg.Validate())
+		assert.Equal(t, "New sign-in to your StrikePad account", msg.Subject)
+		assert.Contains(t, msg.Text, "from a device we have not seen before")
+		assert.Contains(t, msg.Text, "Device: Firefox on Linux\nCountry: DE\nIP address: 203.0.113.7\n")
+		assert.Contains(t, msg.Text, "7 days")
+		assert.Contains(t, msg.HTML, `href="https://api.strikepad.example/api/auth/sessions/revoke?token=abc"`)
+	})
+
+	t.Run("invitation", func(t *testing.T) {
+		msg, err := NewMessage("friend@example.com", TemplateInvitation, InvitationData{
+			InvitedBy:     "Test User",
+			SignupURL:     "https://strikepad.example/signup?invite_token=abc",
+			ExpiresInDays: 7,
+		})
+
+		require.NoError(t, err)
+		assert.NoError(t, msg.Validate())
+		assert.Equal(t, "Test User invited you to StrikePad", msg.Subject)
+		assert.Contains(t, msg.Text, "https://strikepad.example/signup?invite_token=abc")
+		assert.Contains(t, msg.Text, "7 days")
+		assert.Contains(t, msg.HTML, `href="https://strikepad.example/signup?invite_token=abc"`)
+	})
+
+	t.Run("email change", func(t *testing.T) {
+		msg, err := NewMessage("old@example.com", TemplateEmailChange, EmailChangeData{
+			DisplayName:    "Test User",
+			NewEmail:       "new@example.com",
+			ConfirmURL:     "https://strikepad.example/confirm-email-change?token=abc",
+			ExpiresInHours: 24,
+			CurrentAddress: true,
+		})
+
+		require.NoError(t, err)
+		assert.NoError(t, msg.Validate())
+		assert.Equal(t, "Confirm the change of your StrikePad email address", msg.Subject)
+		assert.Contains(t, msg.Text, "from this address to new@example.com")
+		assert.Contains(t, msg.Text, "24 hours")
+		assert.Contains(t, msg.HTML, `href="https://strikepad.example/confirm-email-change?token=abc"`)
+
+		msg, err = NewMessage("new@example.com", TemplateEmailChange, EmailChangeData{
+			DisplayName:    "Test User",
+			NewEmail:       "new@example.com",
+			ConfirmURL:     "https://strikepad.example/confirm-email-change?token=def",
+			ExpiresInHours: 24,
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, msg.Text, "to this address")
+		assert.NotContains(t, msg.Text, "from this address")
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := NewMessage("user@example.com", "unknown", nil)
+
+		assert.Error(t, err)
+	})
+}
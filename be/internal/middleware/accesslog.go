@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/config"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AccessLogConfig controls which requests are written to the access log. Failed and slow requests
+// are always logged; other requests are logged with the sample rate of their route group.
+type AccessLogConfig struct {
+	// RouteSampleRates overrides SampleRate for requests whose path starts with a prefix.
+	// The longest matching prefix wins.
+	RouteSampleRates map[string]float64
+	// SlowThreshold is the latency from which a request counts as slow
+	SlowThreshold time.Duration
+	// SampleRate is the fraction (0 to 1) of fast, successful requests that are logged
+	SampleRate float64
+}
+
+// AccessLogConfigFromEnv reads the sampling rules from ACCESS_LOG_SAMPLE_PERCENT, ACCESS_LOG_SLOW_MS
+// and ACCESS_LOG_ROUTE_SAMPLE_PERCENT (comma-separated prefix=percent pairs, e.g. "/health=0,/api/status=5")
+func AccessLogConfigFromEnv() AccessLogConfig {
+	accessLogConfig := AccessLogConfig{
+		SampleRate:       percentToRate(config.GetEnvInt("ACCESS_LOG_SAMPLE_PERCENT", 100)),
+		SlowThreshold:    time.Duration(config.GetEnvInt("ACCESS_LOG_SLOW_MS", 1000)) * time.Millisecond,
+		RouteSampleRates: make(map[string]float64),
+	}
+
+	for _, rule := range strings.Split(config.GetEnv("ACCESS_LOG_ROUTE_SAMPLE_PERCENT", ""), ",") {
+		prefix, percent, found := strings.Cut(strings.TrimSpace(rule), "=")
+		if !found {
+			continue
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(percent))
+		if err != nil {
+			slog.Warn("Ignoring invalid access log sampling rule", "rule", rule)
+			continue
+		}
+		accessLogConfig.RouteSampleRates[strings.TrimSpace(prefix)] = percentToRate(value)
+	}
+
+	return accessLogConfig
+}
+
+// percentToRate converts a percentage to a rate, clamped to [0, 1]
+func percentToRate(percent int) float64 {
+	return float64(min(max(percent, 0), 100)) / 100
+}
+
+// AccessLog writes one structured log entry per request, subject to the sampling rules in accessLogConfig
+func AccessLog(accessLogConfig AccessLogConfig) echo.MiddlewareFunc {
+	// Match longer prefixes first
+	prefixes := make([]string, 0, len(accessLogConfig.RouteSampleRates))
+	for prefix := range accessLogConfig.RouteSampleRates {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+
+	sampleRate := func(path string) float64 {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(path, prefix) {
+				return accessLogConfig.RouteSampleRates[prefix]
+			}
+		}
+		return accessLogConfig.SampleRate
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+			latency := time.Since(start)
+
+			req := c.Request()
+			status := c.Response().Status
+			slow := accessLogConfig.SlowThreshold > 0 && latency >= accessLogConfig.SlowThreshold
+
+			level := slog.LevelInfo
+			switch {
+			case status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case status >= http.StatusBadRequest || slow:
+				level = slog.LevelWarn
+			default:
+				if rand.Float64() >= sampleRate(req.URL.Path) {
+					return nil
+				}
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.String("route", c.Path()),
+				slog.Int("status", status),
+				slog.Duration("latency", latency),
+				slog.String("remote_ip", c.RealIP()),
+				slog.Int64("bytes_out", c.Response().Size),
+			}
+			if slow {
+				attrs = append(attrs, slog.Bool("slow", true))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			slog.LogAttrs(req.Context(), level, "HTTP request", attrs...)
+			return nil
+		}
+	}
+}
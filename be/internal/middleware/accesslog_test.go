@@ -0,0 +1,134 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureLogs redirects the default logger to a buffer for the duration of the test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func TestAccessLog(t *testing.T) {
+	accessLogConfig := middleware.AccessLogConfig{
+		SampleRate:       0,
+		SlowThreshold:    50 * time.Millisecond,
+		RouteSampleRates: map[string]float64{"/api": 1, "/api/status": 0},
+	}
+
+	e := echo.New()
+	e.Use(middleware.AccessLog(accessLogConfig))
+	e.GET("/health", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.GET("/api/test", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.GET("/api/status", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.GET("/api/status/slow", func(c echo.Context) error {
+		time.Sleep(60 * time.Millisecond)
+		return c.NoContent(http.StatusOK)
+	})
+	e.GET("/fail", func(_ echo.Context) error {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "down")
+	})
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedLevel string
+		expectedCode  int
+		expectLogged  bool
+	}{
+		{name: "fast request sampled out by default rate", path: "/health", expectedCode: http.StatusOK},
+		{
+			name:          "route group with full sampling",
+			path:          "/api/test",
+			expectedCode:  http.StatusOK,
+			expectLogged:  true,
+			expectedLevel: "INFO",
+		},
+		{name: "longest prefix wins", path: "/api/status", expectedCode: http.StatusOK},
+		{
+			name:          "slow request always logged",
+			path:          "/api/status/slow",
+			expectedCode:  http.StatusOK,
+			expectLogged:  true,
+			expectedLevel: "WARN",
+		},
+		{
+			name:          "client error always logged",
+			path:          "/missing",
+			expectedCode:  http.StatusNotFound,
+			expectLogged:  true,
+			expectedLevel: "WARN",
+		},
+		{
+			name:          "server error always logged",
+			path:          "/fail",
+			expectedCode:  http.StatusServiceUnavailable,
+			expectLogged:  true,
+			expectedLevel: "ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := captureLogs(t)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+			if !tt.expectLogged {
+				assert.Empty(t, logs.String())
+				return
+			}
+
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+			assert.Equal(t, tt.expectedLevel, entry["level"])
+			assert.Equal(t, tt.path, entry["path"])
+			assert.Equal(t, float64(tt.expectedCode), entry["status"])
+		})
+	}
+}
+
+func TestAccessLogConfigFromEnv(t *testing.T) {
+	t.Setenv("ACCESS_LOG_SAMPLE_PERCENT", "10")
+	t.Setenv("ACCESS_LOG_SLOW_MS", "250")
+	t.Setenv("ACCESS_LOG_ROUTE_SAMPLE_PERCENT", "/health=0, /api/auth=150,invalid,/api/status=x")
+
+	accessLogConfig := middleware.AccessLogConfigFromEnv()
+
+	assert.InDelta(t, 0.1, accessLogConfig.SampleRate, 1e-9)
+	assert.Equal(t, 250*time.Millisecond, accessLogConfig.SlowThreshold)
+	assert.Equal(t, map[string]float64{"/health": 0, "/api/auth": 1}, accessLogConfig.RouteSampleRates)
+}
+
+func TestAccessLogConfigFromEnvDefaults(t *testing.T) {
+	for _, key := range []string{"ACCESS_LOG_SAMPLE_PERCENT", "ACCESS_LOG_SLOW_MS", "ACCESS_LOG_ROUTE_SAMPLE_PERCENT"} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+
+	accessLogConfig := middleware.AccessLogConfigFromEnv()
+
+	assert.InDelta(t, 1.0, accessLogConfig.SampleRate, 1e-9)
+	assert.Equal(t, time.Second, accessLogConfig.SlowThreshold)
+	assert.Empty(t, accessLogConfig.RouteSampleRates)
+}
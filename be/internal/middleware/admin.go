@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"log/slog"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminTokenHeader is the request header carrying the admin API token
+const AdminTokenHeader = "X-Admin-Token"
+
+// RequireAdminToken protects admin endpoints with a shared API token.
+// When token is empty the admin endpoints are disabled and every request is rejected.
+func RequireAdminToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			provided := c.Request().Header.Get(AdminTokenHeader)
+			if provided == "" {
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: errorInfo.Message,
+				})
+			}
+
+			if token == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				slog.Warn("Rejected admin request", "ip", c.RealIP(), "path", c.Path())
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeForbidden, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: errorInfo.Message,
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// AdminMiddleware allows only users with the admin role. It must run after JWTMiddleware,
+// which stores the session and its user in the context. Requests authenticated with an
+// API key by APIKeyMiddleware are also allowed, since only admins can issue keys.
+func AdminMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, ok := GetAPIKeyFromContext(c); ok {
+				return next(c)
+			}
+
+			session, ok := c.Get("session").(*model.UserSession)
+			if !ok || session == nil || !session.User.IsAdmin() {
+				slog.Warn("Rejected admin request from non-admin user", "ip", c.RealIP(), "path", c.Path())
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeForbidden, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: errorInfo.Message,
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
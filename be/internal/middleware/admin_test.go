@@ -0,0 +1,115 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireAdminToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		configured     string
+		provided       string
+		expectedStatus int
+		expectNext     bool
+	}{
+		{name: "valid token", configured: "secret", provided: "secret", expectedStatus: http.StatusOK, expectNext: true},
+		{name: "wrong token", configured: "secret", provided: "guess", expectedStatus: http.StatusForbidden},
+		{name: "missing token", configured: "secret", provided: "", expectedStatus: http.StatusUnauthorized},
+		{name: "admin disabled", configured: "", provided: "anything", expectedStatus: http.StatusForbidden},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/waitlist", http.NoBody)
+			if tt.provided != "" {
+				req.Header.Set(middleware.AdminTokenHeader, tt.provided)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			nextCalled := false
+			next := func(c echo.Context) error {
+				nextCalled = true
+				return c.String(http.StatusOK, "success")
+			}
+
+			err := middleware.RequireAdminToken(tt.configured)(next)(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectNext, nextCalled)
+		})
+	}
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	tests := []struct {
+		session        *model.UserSession
+		apiKey         *model.APIKey
+		name           string
+		expectedStatus int
+		expectNext     bool
+	}{
+		{
+			name:           "admin user",
+			session:        factory.NewSession().ForUser(factory.NewUser().Admin().Build()).Build(),
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+		},
+		{
+			name:           "regular user",
+			session:        factory.NewSession().ForUser(factory.NewUser().WithID(2).Build()).Build(),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "no session",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "API key",
+			apiKey:         &model.APIKey{ID: 5, Name: "worker"},
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+		},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/users", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tt.session != nil {
+				c.Set("session", tt.session)
+			}
+			if tt.apiKey != nil {
+				c.Set("api_key", tt.apiKey)
+			}
+
+			nextCalled := false
+			next := func(c echo.Context) error {
+				nextCalled = true
+				return c.String(http.StatusOK, "success")
+			}
+
+			err := middleware.AdminMiddleware()(next)(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectNext, nextCalled)
+			if !tt.expectNext {
+				assert.Contains(t, rec.Body.String(), `"code":"E006"`)
+			}
+		})
+	}
+}
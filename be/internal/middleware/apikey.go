@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"log/slog"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHeader is the request header carrying an API key
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyMiddleware authenticates service-to-service requests with an API key in the X-API-Key header.
+// Requests without the header fall back to Bearer token authentication with JWTMiddleware.
+// An authenticated key is stored in the context as "api_key"; no user is associated with it.
+func APIKeyMiddleware(
+	apiKeyService service.APIKeyServiceInterface,
+	sessionService service.SessionServiceInterface,
+) echo.MiddlewareFunc {
+	jwtMiddleware := JWTMiddleware(sessionService)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withJWT := jwtMiddleware(next)
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(APIKeyHeader)
+			if key == "" {
+				return withJWT(c)
+			}
+
+			apiKey, err := apiKeyService.Authenticate(key)
+			if err != nil {
+				slog.Warn("Invalid API key", "error", err, "ip", c.RealIP())
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: "Invalid API key",
+				})
+			}
+
+			c.Set("api_key", apiKey)
+
+			return next(c)
+		}
+	}
+}
+
+// GetAPIKeyFromContext extracts the authenticated API key from echo context
+func GetAPIKeyFromContext(c echo.Context) (*model.APIKey, bool) {
+	apiKey, ok := c.Get("api_key").(*model.APIKey)
+	return apiKey, ok
+}
@@ -0,0 +1,97 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	servicemocks "strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	tests := []struct {
+		setupMocks     func(*servicemocks.MockAPIKeyServiceInterface, *servicemocks.MockSessionServiceInterface)
+		name           string
+		apiKey         string
+		bearer         string
+		expectedStatus int
+		expectNext     bool
+		expectAPIKey   bool
+	}{
+		{
+			name:   "valid API key",
+			apiKey: "sk_valid",
+			setupMocks: func(apiKeyService *servicemocks.MockAPIKeyServiceInterface, _ *servicemocks.MockSessionServiceInterface) {
+				apiKeyService.EXPECT().Authenticate("sk_valid").Return(&model.APIKey{ID: 5, Name: "worker"}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+			expectAPIKey:   true,
+		},
+		{
+			name:   "invalid API key",
+			apiKey: "sk_invalid",
+			setupMocks: func(apiKeyService *servicemocks.MockAPIKeyServiceInterface, _ *servicemocks.MockSessionServiceInterface) {
+				apiKeyService.EXPECT().Authenticate("sk_invalid").Return(nil, auth.ErrInvalidAPIKey).Once()
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "falls back to Bearer token",
+			bearer: "valid-access-token",
+			setupMocks: func(_ *servicemocks.MockAPIKeyServiceInterface, sessionService *servicemocks.MockSessionServiceInterface) {
+				session := &model.UserSession{ID: 1, UserID: 123}
+				sessionService.On("ValidateAccessToken", "valid-access-token").Return(session, nil).Once()
+				sessionService.On("TouchSession", session).Return().Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+		},
+		{
+			name: "no credentials",
+			setupMocks: func(_ *servicemocks.MockAPIKeyServiceInterface, _ *servicemocks.MockSessionServiceInterface) {
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyService := servicemocks.NewMockAPIKeyServiceInterface(t)
+			sessionService := &servicemocks.MockSessionServiceInterface{}
+			tt.setupMocks(apiKeyService, sessionService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/users", http.NoBody)
+			if tt.apiKey != "" {
+				req.Header.Set(middleware.APIKeyHeader, tt.apiKey)
+			}
+			if tt.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.bearer)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			nextCalled := false
+			next := func(c echo.Context) error {
+				nextCalled = true
+				return c.String(http.StatusOK, "success")
+			}
+
+			err := middleware.APIKeyMiddleware(apiKeyService, sessionService)(next)(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectNext, nextCalled)
+			_, hasAPIKey := middleware.GetAPIKeyFromContext(c)
+			assert.Equal(t, tt.expectAPIKey, hasAPIKey)
+			sessionService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AuditEntry starts an audit log entry for action from the request: the client's IP address and
+// user agent, and the actor authenticated by APIKeyMiddleware or JWTMiddleware, if any
+func AuditEntry(c echo.Context, action string) service.AuditEntry {
+	return service.AuditEntry{
+		Action:    action,
+		ActorID:   actorID(c),
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+}
+
+// actorID returns the public ID of the API key or user authenticated by APIKeyMiddleware or
+// JWTMiddleware, or "" for anonymous requests
+func actorID(c echo.Context) string {
+	if apiKey, ok := GetAPIKeyFromContext(c); ok {
+		return apiKey.PublicID
+	}
+	if session, ok := c.Get("session").(*model.UserSession); ok && session != nil {
+		return session.User.PublicID
+	}
+	return ""
+}
+
+// AuditAdminActions records every successful change made through the admin endpoints it protects,
+// with the route and the ID in the path as target. Reads are not recorded. It must run after the
+// authentication middleware, so that the actor is known.
+func AuditAdminActions(auditService service.AuditServiceInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+
+			method := c.Request().Method
+			if err != nil || method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions {
+				return err
+			}
+			status := c.Response().Status
+			if status >= http.StatusBadRequest {
+				return nil
+			}
+
+			entry := AuditEntry(c, service.AuditActionAdmin)
+			entry.TargetID = c.Param("id")
+			entry.Metadata = map[string]string{
+				"method": method,
+				"route":  c.Path(),
+				"status": strconv.Itoa(status),
+			}
+			auditService.Record(entry)
+			return nil
+		}
+	}
+}
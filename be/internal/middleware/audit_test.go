@@ -0,0 +1,122 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditEntry(t *testing.T) {
+	tests := []struct {
+		session         *model.UserSession
+		apiKey          *model.APIKey
+		name            string
+		expectedActorID string
+	}{
+		{
+			name:            "user session",
+			session:         factory.NewSession().ForUser(factory.NewUser().Admin().Build()).Build(),
+			expectedActorID: factory.UserPublicID,
+		},
+		{
+			name:            "API key",
+			apiKey:          &model.APIKey{ID: 5, PublicID: "key_0123456789abcdefghjk", Name: "worker"},
+			expectedActorID: "key_0123456789abcdefghjk",
+		},
+		{
+			name: "anonymous",
+		},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/auth/login", http.NoBody)
+			req.Header.Set(echo.HeaderXRealIP, "203.0.113.7")
+			req.Header.Set("User-Agent", "audit-test/1.0")
+			c := e.NewContext(req, httptest.NewRecorder())
+			if tt.session != nil {
+				c.Set("session", tt.session)
+			}
+			if tt.apiKey != nil {
+				c.Set("api_key", tt.apiKey)
+			}
+
+			entry := middleware.AuditEntry(c, service.AuditActionLogout)
+
+			assert.Equal(t, service.AuditActionLogout, entry.Action)
+			assert.Equal(t, tt.expectedActorID, entry.ActorID)
+			assert.Equal(t, "203.0.113.7", entry.IPAddress)
+			assert.Equal(t, "audit-test/1.0", entry.UserAgent)
+		})
+	}
+}
+
+func TestAuditAdminActions(t *testing.T) {
+	tests := []struct {
+		handler      echo.HandlerFunc
+		name         string
+		method       string
+		expectRecord bool
+	}{
+		{
+			name:         "successful change",
+			method:       http.MethodPost,
+			handler:      func(c echo.Context) error { return c.NoContent(http.StatusOK) },
+			expectRecord: true,
+		},
+		{
+			name:    "read",
+			method:  http.MethodGet,
+			handler: func(c echo.Context) error { return c.NoContent(http.StatusOK) },
+		},
+		{
+			name:    "rejected change",
+			method:  http.MethodPost,
+			handler: func(c echo.Context) error { return c.NoContent(http.StatusNotFound) },
+		},
+		{
+			name:    "failed change",
+			method:  http.MethodPost,
+			handler: func(echo.Context) error { return echo.ErrInternalServerError },
+		},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/admin/users/usr_0123456789abcdefghjk/disable", http.NoBody)
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.SetPath("/api/admin/users/:id/disable")
+			c.SetParamNames("id")
+			c.SetParamValues("usr_0123456789abcdefghjk")
+			c.Set("session", factory.NewSession().ForUser(factory.NewUser().Admin().Build()).Build())
+
+			auditService := new(mocks.MockAuditServiceInterface)
+			if tt.expectRecord {
+				auditService.On("Record", mock.MatchedBy(func(entry service.AuditEntry) bool {
+					return entry.Action == service.AuditActionAdmin &&
+						entry.ActorID == factory.UserPublicID &&
+						entry.TargetID == "usr_0123456789abcdefghjk" &&
+						entry.Metadata["route"] == "/api/admin/users/:id/disable" &&
+						entry.Metadata["method"] == http.MethodPost &&
+						entry.Metadata["status"] == "200"
+				})).Once()
+			}
+
+			_ = middleware.AuditAdminActions(auditService)(tt.handler)(c)
+
+			auditService.AssertExpectations(t)
+		})
+	}
+}
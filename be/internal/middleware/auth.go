@@ -1,27 +1,39 @@
 package middleware
 
 import (
+	stderrors "errors"
 	"log/slog"
 	"strings"
 
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
 	"strikepad-backend/internal/errors"
 	"strikepad-backend/internal/service"
 
 	"github.com/labstack/echo/v4"
 )
 
-// JWTMiddleware handles JWT token authentication
+// JWTMiddleware handles JWT token authentication. In cookie session mode (AUTH_COOKIE_MODE) the
+// access token is read from the access token cookie when the Authorization header is missing.
+// 401 responses of the route carry a WWW-Authenticate Bearer challenge.
 func JWTMiddleware(sessionService service.SessionServiceInterface) echo.MiddlewareFunc {
+	sessionCookies := auth.SessionCookiesFromEnv()
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			addBearerChallenge(c)
+
 			// Get Authorization header
 			authHeader := c.Request().Header.Get("Authorization")
 			if authHeader == "" {
+				if cookie, err := c.Cookie(auth.AccessTokenCookieName); err == nil && sessionCookies.Enabled && cookie.Value != "" {
+					return authenticate(c, next, sessionService, cookie.Value)
+				}
+
 				slog.Warn("Missing authorization header")
-				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized)
-				return c.JSON(errorInfo.HTTPStatus, map[string]string{
-					"code":    string(errorInfo.Code),
-					"message": errorInfo.Message,
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: errorInfo.Message,
 				})
 			}
 
@@ -29,34 +41,54 @@ func JWTMiddleware(sessionService service.SessionServiceInterface) echo.Middlewa
 			tokenParts := strings.Split(authHeader, " ")
 			if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
 				slog.Warn("Invalid authorization header format")
-				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized)
-				return c.JSON(errorInfo.HTTPStatus, map[string]string{
-					"code":    string(errorInfo.Code),
-					"message": "Invalid authorization header format",
-				})
-			}
-
-			accessToken := tokenParts[1]
-
-			// Validate access token
-			session, err := sessionService.ValidateAccessToken(accessToken)
-			if err != nil {
-				slog.Warn("Invalid access token", "error", err)
-				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized)
-				return c.JSON(errorInfo.HTTPStatus, map[string]string{
-					"code":    string(errorInfo.Code),
-					"message": "Invalid or expired token",
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: "Invalid authorization header format",
 				})
 			}
 
-			// Store session and user info in context
-			c.Set("session", session)
-			c.Set("user_id", session.UserID)
-			c.Set("access_token", accessToken)
+			return authenticate(c, next, sessionService, tokenParts[1])
+		}
+	}
+}
 
-			return next(c)
+// authenticate validates the access token and stores its session in the context before calling next
+func authenticate(
+	c echo.Context, next echo.HandlerFunc, sessionService service.SessionServiceInterface, accessToken string,
+) error {
+	// Validate access token
+	session, err := sessionService.ValidateAccessToken(accessToken)
+	if err != nil {
+		// Expired tokens can be refreshed silently; invalid ones require a new login. Other failures,
+		// such as an unreachable database, say nothing about the token and go to the error handler.
+		var code errors.ErrorCode
+		switch {
+		case stderrors.Is(err, auth.ErrTokenExpired):
+			code = errors.ErrCodeTokenExpired
+		case stderrors.Is(err, auth.ErrTokenInvalid):
+			code = errors.ErrCodeTokenInvalid
+		default:
+			slog.Error("Failed to validate access token", "error", err)
+			return err
 		}
+		slog.Warn("Rejected access token", "error", err, "code", code)
+		setBearerError(c, code)
+		errorInfo := errors.GetErrorInfo(code, GetLanguageFromContext(c))
+		return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+			Code:        string(errorInfo.Code),
+			Message:     errorInfo.Message,
+			Description: errorInfo.Description,
+		})
 	}
+
+	// Store session and user info in context
+	c.Set("session", session)
+	c.Set("user_id", session.UserID)
+	c.Set("access_token", accessToken)
+	sessionService.TouchSession(session)
+
+	return next(c)
 }
 
 // GetUserIDFromContext extracts user ID from echo context
@@ -2,13 +2,16 @@ package middleware_test
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"strikepad-backend/internal/auth"
 	"strikepad-backend/internal/middleware"
 	"strikepad-backend/internal/model"
 	servicemocks "strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/factory"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -56,6 +59,7 @@ func (suite *AuthMiddlewareTestSuite) TestJWTMiddleware() {
 				}
 				suite.mockSessionSvc.On("ValidateAccessToken", "valid-access-token").
 					Return(session, nil)
+				suite.mockSessionSvc.On("TouchSession", session).Return()
 			},
 			expectedStatus: http.StatusOK,
 			expectNext:     true,
@@ -152,12 +156,12 @@ func (suite *AuthMiddlewareTestSuite) TestJWTMiddleware() {
 			},
 			setupMocks: func() {
 				suite.mockSessionSvc.On("ValidateAccessToken", "invalid-token").
-					Return(nil, errors.New("invalid token"))
+					Return(nil, fmt.Errorf("invalid access token: %w", auth.ErrTokenInvalid))
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectedError: map[string]string{
-				"code":    "E005",
-				"message": "Invalid or expired token",
+				"code":    "E104",
+				"message": "Invalid token",
 			},
 			expectNext: false,
 		},
@@ -169,12 +173,12 @@ func (suite *AuthMiddlewareTestSuite) TestJWTMiddleware() {
 			},
 			setupMocks: func() {
 				suite.mockSessionSvc.On("ValidateAccessToken", "expired-token").
-					Return(nil, errors.New("token expired"))
+					Return(nil, fmt.Errorf("invalid access token: %w", auth.ErrTokenExpired))
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectedError: map[string]string{
-				"code":    "E005",
-				"message": "Invalid or expired token",
+				"code":    "E103",
+				"message": "Token expired",
 			},
 			expectNext: false,
 		},
@@ -186,12 +190,12 @@ func (suite *AuthMiddlewareTestSuite) TestJWTMiddleware() {
 			},
 			setupMocks: func() {
 				suite.mockSessionSvc.On("ValidateAccessToken", "token-no-session").
-					Return(nil, errors.New("session not found"))
+					Return(nil, fmt.Errorf("session not found: %w", auth.ErrTokenInvalid))
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectedError: map[string]string{
-				"code":    "E005",
-				"message": "Invalid or expired token",
+				"code":    "E104",
+				"message": "Invalid token",
 			},
 			expectNext: false,
 		},
@@ -222,6 +226,7 @@ func (suite *AuthMiddlewareTestSuite) TestJWTMiddleware() {
 				}
 				suite.mockSessionSvc.On("ValidateAccessToken", "valid-token-123.abc_def").
 					Return(session, nil)
+				suite.mockSessionSvc.On("TouchSession", session).Return()
 			},
 			expectedStatus: http.StatusOK,
 			expectNext:     true,
@@ -280,6 +285,22 @@ func (suite *AuthMiddlewareTestSuite) TestJWTMiddleware() {
 	}
 }
 
+func (suite *AuthMiddlewareTestSuite) TestJWTMiddlewareSessionLookupFailure() {
+	// A failure that says nothing about the token goes to the error handler instead of logging out
+	lookupErr := errors.New("failed to find session: connection refused")
+	suite.mockSessionSvc.On("ValidateAccessToken", "valid-token").Return(nil, lookupErr).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	c := suite.echo.NewContext(req, httptest.NewRecorder())
+	err := middleware.JWTMiddleware(suite.mockSessionSvc)(func(echo.Context) error {
+		suite.Fail("next should not be called")
+		return nil
+	})(c)
+
+	assert.ErrorIs(suite.T(), err, lookupErr)
+}
+
 func (suite *AuthMiddlewareTestSuite) TestGetUserIDFromContext() {
 	testCases := []struct {
 		setupContext func(c echo.Context)
@@ -474,12 +495,13 @@ func (suite *AuthMiddlewareTestSuite) TestMiddlewareIntegration() {
 			},
 			setupMocks: func() {
 				session := &model.UserSession{
-					ID:          10,
-					UserID:      999,
-					AccessToken: "integration-token",
+					ID:              10,
+					UserID:          999,
+					AccessTokenHash: auth.HashToken("integration-token"),
 				}
 				suite.mockSessionSvc.On("ValidateAccessToken", "integration-token").
 					Return(session, nil)
+				suite.mockSessionSvc.On("TouchSession", session).Return()
 			},
 			testFlow: func(t *testing.T, c echo.Context) {
 				// Test all helper functions work together
@@ -508,6 +530,7 @@ func (suite *AuthMiddlewareTestSuite) TestMiddlewareIntegration() {
 				}
 				suite.mockSessionSvc.On("ValidateAccessToken", "consistent-token").
 					Return(session, nil)
+				suite.mockSessionSvc.On("TouchSession", session).Return()
 			},
 			testFlow: func(t *testing.T, c echo.Context) {
 				// Call helper functions multiple times
@@ -559,6 +582,51 @@ func (suite *AuthMiddlewareTestSuite) TestMiddlewareIntegration() {
 	}
 }
 
+func (suite *AuthMiddlewareTestSuite) TestJWTMiddlewareCookieMode() {
+	testCases := []struct {
+		name           string
+		cookieMode     string
+		authHeader     string
+		expectedToken  string
+		expectedStatus int
+	}{
+		{name: "cookie used without Authorization header", cookieMode: "true", expectedToken: "cookie-token", expectedStatus: http.StatusOK},
+		{name: "Authorization header wins over cookie", cookieMode: "true", authHeader: "Bearer header-token", expectedToken: "header-token", expectedStatus: http.StatusOK},
+		{name: "cookie ignored when cookie mode is off", cookieMode: "false", expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.T().Setenv("AUTH_COOKIE_MODE", tc.cookieMode)
+			suite.mockSessionSvc.ExpectedCalls = nil
+			if tc.expectedToken != "" {
+				session := factory.NewSession().Build()
+				suite.mockSessionSvc.On("ValidateAccessToken", tc.expectedToken).Return(session, nil).Once()
+				suite.mockSessionSvc.On("TouchSession", session).Return().Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.AddCookie(&http.Cookie{Name: auth.AccessTokenCookieName, Value: "cookie-token"})
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+
+			var token string
+			handler := middleware.JWTMiddleware(suite.mockSessionSvc)(func(c echo.Context) error {
+				token, _ = c.Get("access_token").(string)
+				return c.NoContent(http.StatusOK)
+			})
+			suite.NoError(handler(c))
+
+			suite.Equal(tc.expectedStatus, rec.Code)
+			suite.Equal(tc.expectedToken, token)
+			suite.mockSessionSvc.AssertExpectations(suite.T())
+		})
+	}
+}
+
 func TestAuthMiddlewareTestSuite(t *testing.T) {
 	suite.Run(t, new(AuthMiddlewareTestSuite))
 }
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DefaultBodyLimit is the request body limit used when MAX_REQUEST_BODY_BYTES is not set
+const DefaultBodyLimit = 1 << 20
+
+// BodyLimit rejects requests whose body is larger than limit bytes with 413 and the E002 error
+// body. Requests that declare a Content-Length are rejected before their body is read; bodies of
+// unknown length are read up to the limit before the handler runs.
+func BodyLimit(limit int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Body == nil || req.Body == http.NoBody || req.ContentLength == 0 {
+				return next(c)
+			}
+			if req.ContentLength > limit {
+				return bodyTooLarge(c, limit)
+			}
+
+			if req.ContentLength < 0 {
+				body, err := io.ReadAll(io.LimitReader(req.Body, limit+1))
+				if err != nil {
+					errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidRequest, GetLanguageFromContext(c))
+					return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+						Code:        string(errorInfo.Code),
+						Message:     errorInfo.Message,
+						Description: "Failed to read request body",
+					})
+				}
+				if int64(len(body)) > limit {
+					return bodyTooLarge(c, limit)
+				}
+				req.Body = io.NopCloser(bytes.NewReader(body))
+				req.ContentLength = int64(len(body))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// bodyTooLarge writes the response for a request body over the limit. The connection is closed
+// afterwards, since the rest of the body is not read.
+func bodyTooLarge(c echo.Context, limit int64) error {
+	c.Response().Header().Set(echo.HeaderConnection, "close")
+	errorInfo := errors.GetErrorInfo(errors.ErrCodeInvalidRequest, GetLanguageFromContext(c))
+	return c.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse{
+		Code:        string(errorInfo.Code),
+		Message:     errorInfo.Message,
+		Description: fmt.Sprintf("Request body must not be larger than %d bytes", limit),
+	})
+}
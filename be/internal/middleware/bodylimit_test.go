@@ -0,0 +1,80 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBodyLimit(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		contentLength  int64
+		expectedStatus int
+	}{
+		{
+			name:           "within limit",
+			body:           `{"a":1}`,
+			contentLength:  7,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "declared length over limit",
+			body:           strings.Repeat("x", 17),
+			contentLength:  17,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "unknown length within limit",
+			body:           strings.Repeat("x", 16),
+			contentLength:  -1,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown length over limit",
+			body:           strings.Repeat("x", 17),
+			contentLength:  -1,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(middleware.BodyLimit(16))
+			e.POST("/api/items", func(c echo.Context) error {
+				body, err := io.ReadAll(c.Request().Body)
+				if err != nil {
+					return err
+				}
+				return c.String(http.StatusOK, string(body))
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/items", strings.NewReader(tt.body))
+			req.ContentLength = tt.contentLength
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.body, rec.Body.String())
+				return
+			}
+
+			var response map[string]string
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "E002", response["code"])
+			assert.Equal(t, "Request body must not be larger than 16 bytes", response["description"])
+			assert.Equal(t, "close", rec.Header().Get(echo.HeaderConnection))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CSRF protects requests authenticated by session cookies with the double-submit pattern: unsafe
+// requests must repeat the CSRF cookie in the X-CSRF-Token header. Requests that carry no session
+// cookie, or authenticate with a Bearer token or an API key, are not sent automatically by
+// browsers and pass unchecked.
+func CSRF() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !requiresCSRFToken(c.Request()) {
+				return next(c)
+			}
+
+			cookie, err := c.Cookie(auth.CSRFCookieName)
+			if err != nil || !auth.CSRFTokensMatch(cookie.Value, c.Request().Header.Get(auth.CSRFHeaderName)) {
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeForbidden, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:        string(errorInfo.Code),
+					Message:     errorInfo.Message,
+					Description: "Missing or invalid CSRF token",
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// requiresCSRFToken reports whether req changes state and is authenticated by a session cookie
+func requiresCSRFToken(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return false
+	}
+
+	if strings.HasPrefix(req.Header.Get(echo.HeaderAuthorization), "Bearer ") || req.Header.Get(APIKeyHeader) != "" {
+		return false
+	}
+
+	for _, name := range []string{auth.AccessTokenCookieName, auth.RefreshTokenCookieName} {
+		if _, err := req.Cookie(name); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSRF(t *testing.T) {
+	token, err := auth.GenerateCSRFToken()
+	require.NoError(t, err)
+	otherToken, err := auth.GenerateCSRFToken()
+	require.NoError(t, err)
+	sessionCookie := &http.Cookie{Name: auth.AccessTokenCookieName, Value: "access-token"}
+	csrfCookie := &http.Cookie{Name: auth.CSRFCookieName, Value: token}
+
+	tests := []struct {
+		headers        map[string]string
+		name           string
+		method         string
+		cookies        []*http.Cookie
+		expectedStatus int
+	}{
+		{
+			name:           "safe method with session cookie",
+			method:         http.MethodGet,
+			cookies:        []*http.Cookie{sessionCookie},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "no session cookie",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "bearer token",
+			method:         http.MethodPost,
+			cookies:        []*http.Cookie{sessionCookie},
+			headers:        map[string]string{echo.HeaderAuthorization: "Bearer access-token"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "API key",
+			method:         http.MethodDelete,
+			cookies:        []*http.Cookie{sessionCookie},
+			headers:        map[string]string{middleware.APIKeyHeader: "sk_key"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "matching token",
+			method:         http.MethodPost,
+			cookies:        []*http.Cookie{sessionCookie, csrfCookie},
+			headers:        map[string]string{auth.CSRFHeaderName: token},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing header",
+			method:         http.MethodPost,
+			cookies:        []*http.Cookie{sessionCookie, csrfCookie},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "missing cookie",
+			method:         http.MethodPatch,
+			cookies:        []*http.Cookie{sessionCookie},
+			headers:        map[string]string{auth.CSRFHeaderName: token},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "mismatched token",
+			method:         http.MethodPost,
+			cookies:        []*http.Cookie{{Name: auth.RefreshTokenCookieName, Value: "refresh-token"}, csrfCookie},
+			headers:        map[string]string{auth.CSRFHeaderName: otherToken},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(middleware.CSRF())
+			e.Any("/api/items", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, "/api/items", nil)
+			for _, cookie := range tt.cookies {
+				req.AddCookie(cookie)
+			}
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusForbidden {
+				var response map[string]string
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "E006", response["code"])
+				assert.Equal(t, "Missing or invalid CSRF token", response["description"])
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ETag adds a weak ETag, the hash of the body, to successful GET responses that the handler gave
+// none, e.g. from a version, and answers requests whose If-None-Match names the ETag with 304 and
+// no body, so that polling clients only download a resource when it changed. Responses are
+// buffered to hash them, so it must not be used on streaming routes. Responses without a
+// Cache-Control header are marked private, no-cache, so that clients revalidate them and shared
+// caches do not store them.
+func ETag() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Method != http.MethodGet {
+				return next(c)
+			}
+
+			res := c.Response()
+			buffer := &bufferedWriter{ResponseWriter: res.Writer}
+			res.Writer = buffer
+			err := next(c)
+			res.Writer = buffer.ResponseWriter
+			// Errors returned to the error handler have no response yet
+			if !res.Committed {
+				return err
+			}
+
+			if res.Status != http.StatusOK {
+				buffer.flush(res.Status)
+				return err
+			}
+
+			header := res.Header()
+			etag := header.Get("ETag")
+			if etag == "" {
+				hash := sha256.Sum256(buffer.body.Bytes())
+				etag = `W/"` + base64.RawURLEncoding.EncodeToString(hash[:18]) + `"`
+				header.Set("ETag", etag)
+			}
+			if header.Get(echo.HeaderCacheControl) == "" {
+				header.Set(echo.HeaderCacheControl, "private, no-cache")
+			}
+			if etagMatches(c.Request().Header.Get("If-None-Match"), etag) {
+				header.Del(echo.HeaderContentType)
+				header.Del(echo.HeaderContentLength)
+				res.Status = http.StatusNotModified
+				buffer.body.Reset()
+			}
+			buffer.flush(res.Status)
+			return err
+		}
+	}
+}
+
+// etagMatches reports whether the If-None-Match header value names etag, comparing weakly as
+// RFC 9110 requires for If-None-Match
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedWriter holds back the status and body written through it until flush
+type bufferedWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+// WriteHeader does nothing, the status is written by flush
+func (w *bufferedWriter) WriteHeader(int) {}
+
+// Write keeps b to be written by flush
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// flush writes status and the body kept so far to the wrapped response writer
+func (w *bufferedWriter) flush(status int) {
+	w.ResponseWriter.WriteHeader(status)
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
+
+// Unwrap returns the wrapped response writer, for http.ResponseController
+func (w *bufferedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,78 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETag(t *testing.T) {
+	e := echo.New()
+	name := "Ada"
+	e.GET("/api/users/me/privacy", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]string{"name": name})
+	}, middleware.ETag())
+	e.GET("/api/versioned", func(c echo.Context) error {
+		c.Response().Header().Set("ETag", `"7"`)
+		return c.JSON(http.StatusOK, map[string]string{"name": name})
+	}, middleware.ETag())
+	e.GET("/api/missing", func(c echo.Context) error {
+		return c.JSON(http.StatusNotFound, map[string]string{"code": "E003"})
+	}, middleware.ETag())
+	e.GET("/api/failing", func(_ echo.Context) error {
+		return echo.ErrInternalServerError
+	}, middleware.ETag())
+
+	request := func(path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := request("/api/users/me/privacy", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"name":"Ada"}`, rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	require.Regexp(t, `^W/"[A-Za-z0-9_-]+"$`, etag)
+	assert.Equal(t, "private, no-cache", rec.Header().Get(echo.HeaderCacheControl))
+
+	rec = request("/api/users/me/privacy", `"other", `+etag)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	assert.Empty(t, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+
+	// The comparison is weak, so the strong form of the tag matches too
+	assert.Equal(t, http.StatusNotModified, request("/api/users/me/privacy", etag[2:]).Code)
+	assert.Equal(t, http.StatusNotModified, request("/api/users/me/privacy", "*").Code)
+
+	name = "Grace"
+	rec = request("/api/users/me/privacy", etag)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"name":"Grace"}`, rec.Body.String())
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	// An ETag set by the handler is kept
+	rec = request("/api/versioned", "")
+	assert.Equal(t, `"7"`, rec.Header().Get("ETag"))
+	assert.Equal(t, http.StatusNotModified, request("/api/versioned", `W/"7"`).Code)
+
+	rec = request("/api/missing", "*")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{"code":"E003"}`, rec.Body.String())
+	assert.Empty(t, rec.Header().Get("ETag"))
+
+	rec = request("/api/failing", "*")
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Empty(t, rec.Header().Get("ETag"))
+}
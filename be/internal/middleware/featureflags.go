@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/featureflags"
+	"strikepad-backend/internal/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// featureFlagsKey is the context key under which FeatureFlags stores the flags of the request
+const featureFlagsKey = "feature_flags"
+
+// requestFlags evaluates flags for one request, remembering the answers so that a feature stays
+// on or off for the whole request even if the flags change meanwhile
+type requestFlags struct {
+	flags     featureflags.Flags
+	evaluated map[string]bool
+}
+
+// FeatureFlags makes flags available to handlers through FeatureEnabled. Flags are evaluated when
+// asked for, so that routes authenticating the user get answers for that user.
+func FeatureFlags(flags featureflags.Flags) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(featureFlagsKey, &requestFlags{flags: flags, evaluated: make(map[string]bool)})
+			return next(c)
+		}
+	}
+}
+
+// FeatureEnabled reports whether the feature named key is enabled for the user authenticated by
+// JWTMiddleware, or for anonymous users on other routes. Features are disabled for requests that
+// did not go through FeatureFlags.
+func FeatureEnabled(c echo.Context, key string) bool {
+	rf, ok := c.Get(featureFlagsKey).(*requestFlags)
+	if !ok {
+		return false
+	}
+	if enabled, ok := rf.evaluated[key]; ok {
+		return enabled
+	}
+
+	userID := ""
+	if session, ok := c.Get("session").(*model.UserSession); ok && session != nil {
+		userID = session.User.PublicID
+	}
+	enabled := rf.flags.IsEnabled(c.Request().Context(), key, userID)
+	rf.evaluated[key] = enabled
+	return enabled
+}
+
+// RequireFeature hides a route behind the feature named key: while the feature is disabled for the
+// caller, the route answers as if it did not exist. As route middleware it runs after the
+// authentication, so partial rollouts apply per user.
+func RequireFeature(key string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !FeatureEnabled(c, key) {
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeNotFound, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:        string(errorInfo.Code),
+					Message:     errorInfo.Message,
+					Description: errorInfo.Description,
+				})
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/featureflags"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingFlags counts the evaluations of the flags it wraps
+type countingFlags struct {
+	featureflags.Flags
+	calls int
+}
+
+func (f *countingFlags) IsEnabled(ctx context.Context, key string, userID string) bool {
+	f.calls++
+	return f.Flags.IsEnabled(ctx, key, userID)
+}
+
+func TestFeatureEnabled(t *testing.T) {
+	rollout := 0
+	flags := &countingFlags{Flags: featureflags.NewStatic(map[string]featureflags.Flag{
+		"passkeys": {Enabled: true, Rollout: &rollout, Users: []string{"usr_5k2d9x0q7m3hv8c1b4na"}},
+	})}
+
+	tests := []struct {
+		session  *model.UserSession
+		name     string
+		expected bool
+	}{
+		{name: "user in the rollout", session: &model.UserSession{User: model.User{PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}}, expected: true},
+		{name: "user outside the rollout", session: &model.UserSession{User: model.User{PublicID: "usr_0123456789abcdefghjk"}}},
+		{name: "anonymous request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags.calls = 0
+			e := echo.New()
+			var first, second bool
+			handler := middleware.FeatureFlags(flags)(func(c echo.Context) error {
+				if tt.session != nil {
+					c.Set("session", tt.session)
+				}
+				first = middleware.FeatureEnabled(c, "passkeys")
+				second = middleware.FeatureEnabled(c, "passkeys")
+				return c.NoContent(http.StatusNoContent)
+			})
+
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", http.NoBody), httptest.NewRecorder())
+			assert.NoError(t, handler(c))
+
+			assert.Equal(t, tt.expected, first)
+			assert.Equal(t, first, second)
+			assert.Equal(t, 1, flags.calls, "flags are evaluated once per request")
+		})
+	}
+
+	t.Run("without the middleware", func(t *testing.T) {
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", http.NoBody), httptest.NewRecorder())
+		assert.False(t, middleware.FeatureEnabled(c, "passkeys"))
+	})
+}
+
+func TestRequireFeature(t *testing.T) {
+	flags, err := featureflags.ParseEnv("passkeys")
+	assert.NoError(t, err)
+
+	e := echo.New()
+	e.Use(middleware.FeatureFlags(flags))
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusNoContent) }
+	e.GET("/passkeys", ok, middleware.RequireFeature("passkeys"))
+	e.GET("/new-editor", ok, middleware.RequireFeature("new_editor"))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/passkeys", http.NoBody))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/new-editor", http.NoBody))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header that makes a POST request safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks responses replayed from an earlier request with the same key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotencyKeyLength is the longest idempotency key accepted
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedHeaders are the response headers stored with an idempotent response and replayed with it.
+// Set-Cookie is left out, so that session cookies are never stored.
+var replayedHeaders = []string{echo.HeaderContentType, echo.HeaderLocation}
+
+// Idempotency makes POST requests sent with an Idempotency-Key header safe to retry. The first
+// successful response for a key is stored and replayed, with the Idempotent-Replayed header, to
+// later requests from the same caller to the same route, without running the handler again. A key
+// sent with a different request is rejected with 422, and one whose first request is still
+// running with 409. Failed requests are not stored, so they can be retried with the same key.
+// It must run after the authentication middleware, so that keys are scoped per caller.
+func Idempotency(idempotencyService service.IdempotencyServiceInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(IdempotencyKeyHeader)
+			if c.Request().Method != http.MethodPost || key == "" {
+				return next(c)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return idempotencyError(c, errors.ErrCodeInvalidRequest,
+					"Idempotency-Key must not be longer than 255 characters")
+			}
+
+			request, err := readRequest(c.Request())
+			if err != nil {
+				return idempotencyError(c, errors.ErrCodeInvalidRequest, "Failed to read request body")
+			}
+
+			record, err := idempotencyService.Begin(idempotencyScope(c), key, request)
+			switch {
+			case stderrors.Is(err, service.ErrIdempotencyKeyReused):
+				return idempotencyError(c, errors.ErrCodeIdempotencyKeyReused, "")
+			case stderrors.Is(err, service.ErrIdempotencyKeyInProgress):
+				return idempotencyError(c, errors.ErrCodeConflict,
+					"A request with this Idempotency-Key is still being processed")
+			case err != nil:
+				slog.Error("Failed to record idempotency key", "error", err, "path", c.Path())
+				return idempotencyError(c, errors.ErrCodeInternalError, "")
+			}
+			if record.IsCompleted() {
+				return replayResponse(c, record)
+			}
+
+			res := c.Response()
+			recorder := &responseRecorder{ResponseWriter: res.Writer}
+			res.Writer = recorder
+			err = next(c)
+			res.Writer = recorder.ResponseWriter
+
+			// Errors returned to the error handler have no response yet, and are never stored
+			if err != nil || res.Status < http.StatusOK || res.Status >= http.StatusMultipleChoices {
+				if releaseErr := idempotencyService.Release(record); releaseErr != nil {
+					slog.Error("Failed to release idempotency key", "error", releaseErr, "path", c.Path())
+				}
+				return err
+			}
+
+			header := http.Header{}
+			for _, name := range replayedHeaders {
+				if values := res.Header().Values(name); len(values) > 0 {
+					header[name] = values
+				}
+			}
+			if err := idempotencyService.Complete(record, res.Status, header, recorder.body.Bytes()); err != nil {
+				slog.Error("Failed to store idempotent response", "error", err, "path", c.Path())
+			}
+			return nil
+		}
+	}
+}
+
+// idempotencyScope limits an idempotency key to the caller, or to anonymous callers, and the route
+func idempotencyScope(c echo.Context) string {
+	actor := actorID(c)
+	if actor == "" {
+		actor = "anonymous"
+	}
+	return actor + " " + c.Request().Method + " " + c.Path()
+}
+
+// readRequest returns the request's method, URI and body, which tell retries of the request apart
+// from other requests. The body is read and replaced, so that the handler can still read it.
+func readRequest(req *http.Request) ([]byte, error) {
+	request := []byte(req.Method + " " + req.URL.RequestURI() + "\n")
+	if req.Body == nil {
+		return request, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return append(request, body...), nil
+}
+
+// replayResponse writes the response stored for an earlier request with the same idempotency key
+func replayResponse(c echo.Context, record *model.IdempotencyKey) error {
+	var header http.Header
+	if err := json.Unmarshal([]byte(record.ResponseHeaders), &header); err != nil {
+		slog.Error("Failed to decode stored response headers", "error", err, "path", c.Path())
+		return idempotencyError(c, errors.ErrCodeInternalError, "")
+	}
+
+	res := c.Response()
+	for name, values := range header {
+		for _, value := range values {
+			res.Header().Add(name, value)
+		}
+	}
+	res.Header().Set(IdempotentReplayedHeader, "true")
+	res.WriteHeader(*record.ResponseStatus)
+	_, err := res.Write([]byte(record.ResponseBody))
+	return err
+}
+
+// idempotencyError writes the error response for code, with description replacing the default one when set
+func idempotencyError(c echo.Context, code errors.ErrorCode, description string) error {
+	errorInfo := errors.GetErrorInfo(code, GetLanguageFromContext(c))
+	if description == "" {
+		description = errorInfo.Description
+	}
+	return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+		Code:        string(errorInfo.Code),
+		Message:     errorInfo.Message,
+		Description: description,
+	})
+}
+
+// responseRecorder keeps a copy of the response body written through it
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write writes b to the response and keeps a copy of it
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped response writer, for http.ResponseController
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
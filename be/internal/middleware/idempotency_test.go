@@ -0,0 +1,167 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotency(t *testing.T) {
+	created := http.StatusCreated
+
+	tests := []struct {
+		beginRecord    *model.IdempotencyKey
+		beginErr       error
+		handlerErr     error
+		name           string
+		key            string
+		expectedBody   string
+		expectedCode   string
+		expectedStatus int
+		expectBegin    bool
+		expectHandler  bool
+		expectComplete bool
+		expectRelease  bool
+		expectReplayed bool
+	}{
+		{
+			name:           "no key",
+			expectHandler:  true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"name":"worker"}`,
+		},
+		{
+			name:           "first request",
+			key:            "retry-1",
+			beginRecord:    &model.IdempotencyKey{ID: 4},
+			expectBegin:    true,
+			expectHandler:  true,
+			expectComplete: true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"name":"worker"}`,
+		},
+		{
+			name: "retry of a completed request",
+			key:  "retry-1",
+			beginRecord: &model.IdempotencyKey{
+				ID:              4,
+				ResponseStatus:  &created,
+				ResponseHeaders: `{"Content-Type":["application/json"]}`,
+				ResponseBody:    `{"name":"stored"}`,
+			},
+			expectBegin:    true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"name":"stored"}`,
+			expectReplayed: true,
+		},
+		{
+			name:           "failed request releases the key",
+			key:            "retry-1",
+			beginRecord:    &model.IdempotencyKey{ID: 4},
+			handlerErr:     echo.ErrBadRequest,
+			expectBegin:    true,
+			expectHandler:  true,
+			expectRelease:  true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "key reused for another request",
+			key:            "retry-1",
+			beginErr:       service.ErrIdempotencyKeyReused,
+			expectBegin:    true,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "E010",
+		},
+		{
+			name:           "first request still running",
+			key:            "retry-1",
+			beginErr:       service.ErrIdempotencyKeyInProgress,
+			expectBegin:    true,
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "E007",
+		},
+		{
+			name:           "key too long",
+			key:            strings.Repeat("k", 256),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E002",
+		},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idempotencyService := mocks.NewMockIdempotencyServiceInterface(t)
+			if tt.expectBegin {
+				idempotencyService.EXPECT().
+					Begin(factory.UserPublicID+" POST /api/admin/api-keys", tt.key,
+						[]byte("POST /api/admin/api-keys\n"+`{"name":"worker"}`)).
+					Return(tt.beginRecord, tt.beginErr).Once()
+			}
+			if tt.expectComplete {
+				idempotencyService.EXPECT().
+					Complete(tt.beginRecord, http.StatusCreated,
+						http.Header{"Content-Type": {"application/json"}}, []byte(`{"name":"worker"}`+"\n")).
+					Return(nil).Once()
+			}
+			if tt.expectRelease {
+				idempotencyService.EXPECT().Release(tt.beginRecord).Return(nil).Once()
+			}
+
+			handlerCalled := false
+			next := func(c echo.Context) error {
+				handlerCalled = true
+				body, _ := io.ReadAll(c.Request().Body)
+				assert.JSONEq(t, `{"name":"worker"}`, string(body))
+				if tt.handlerErr != nil {
+					return tt.handlerErr
+				}
+				// Cookies can carry session tokens, so they are never stored
+				c.SetCookie(&http.Cookie{Name: "access_token", Value: "secret"})
+				return c.JSON(http.StatusCreated, map[string]string{"name": "worker"})
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/api-keys", strings.NewReader(`{"name":"worker"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.key != "" {
+				req.Header.Set(middleware.IdempotencyKeyHeader, tt.key)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/admin/api-keys")
+			c.Set("session", factory.NewSession().ForUser(factory.NewUser().Admin().Build()).Build())
+
+			err := middleware.Idempotency(idempotencyService)(next)(c)
+
+			assert.Equal(t, tt.handlerErr, err)
+			assert.Equal(t, tt.expectHandler, handlerCalled)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+			if tt.expectedCode != "" {
+				var response map[string]string
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedCode, response["code"])
+			}
+			if tt.expectReplayed {
+				assert.Equal(t, "true", rec.Header().Get(middleware.IdempotentReplayedHeader))
+				assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+			} else {
+				assert.Empty(t, rec.Header().Get(middleware.IdempotentReplayedHeader))
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"strikepad-backend/internal/errors"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/text/language"
+)
+
+// languageKey is the context key under which Locale stores the language of the request
+const languageKey = "language"
+
+const (
+	headerAcceptLanguage  = "Accept-Language"
+	headerContentLanguage = "Content-Language"
+)
+
+// languageMatcher picks the supported language closest to those accepted by the client
+var languageMatcher = newLanguageMatcher()
+
+func newLanguageMatcher() language.Matcher {
+	tags := make([]language.Tag, 0, len(errors.SupportedLanguages))
+	for _, lang := range errors.SupportedLanguages {
+		tags = append(tags, language.Make(lang))
+	}
+	return language.NewMatcher(tags)
+}
+
+// Locale resolves the language of the request from its Accept-Language header and stores it in
+// the context, so that error messages are written in that language. Responses name the language
+// in Content-Language and vary on Accept-Language.
+func Locale() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			lang := resolveLanguage(c.Request().Header.Get(headerAcceptLanguage))
+			c.Set(languageKey, lang)
+
+			header := c.Response().Header()
+			header.Set(headerContentLanguage, lang)
+			header.Add(echo.HeaderVary, headerAcceptLanguage)
+			return next(c)
+		}
+	}
+}
+
+// GetLanguageFromContext returns the language of the request stored by Locale. Requests that did
+// not go through Locale are resolved from their Accept-Language header.
+func GetLanguageFromContext(c echo.Context) string {
+	if lang, ok := c.Get(languageKey).(string); ok {
+		return lang
+	}
+	return resolveLanguage(c.Request().Header.Get(headerAcceptLanguage))
+}
+
+// resolveLanguage returns the supported language matching acceptLanguage best, or the default
+// language when it accepts none of them or cannot be parsed
+func resolveLanguage(acceptLanguage string) string {
+	if acceptLanguage == "" {
+		return errors.DefaultLanguage
+	}
+
+	accepted, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(accepted) == 0 {
+		return errors.DefaultLanguage
+	}
+
+	// The matcher falls back to the first supported language, the default, when none matches
+	_, index, _ := languageMatcher.Match(accepted...)
+	return errors.SupportedLanguages[index]
+}
@@ -0,0 +1,58 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "no header", acceptLanguage: "", expected: "en"},
+		{name: "japanese", acceptLanguage: "ja", expected: "ja"},
+		{name: "japanese region", acceptLanguage: "ja-JP,ja;q=0.9", expected: "ja"},
+		{name: "preferred by quality", acceptLanguage: "en;q=0.5, ja;q=0.8", expected: "ja"},
+		{name: "english region", acceptLanguage: "en-GB", expected: "en"},
+		{name: "unsupported", acceptLanguage: "fr-FR", expected: "en"},
+		{name: "malformed", acceptLanguage: ";;;q=x", expected: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(middleware.Locale())
+			var lang string
+			e.GET("/", func(c echo.Context) error {
+				lang = middleware.GetLanguageFromContext(c)
+				return c.NoContent(http.StatusNoContent)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expected, lang)
+			assert.Equal(t, tt.expected, rec.Header().Get("Content-Language"))
+			assert.Contains(t, rec.Header().Values(echo.HeaderVary), "Accept-Language")
+		})
+	}
+}
+
+func TestGetLanguageFromContext_WithoutLocale(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "ja")
+
+	assert.Equal(t, "ja", middleware.GetLanguageFromContext(echo.New().NewContext(req, httptest.NewRecorder())))
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HeadAsGet serves HEAD requests with the GET handler of the matching route and discards the
+// response body. It must be registered with Echo#Pre so that it runs before routing.
+func HeadAsGet() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Method != http.MethodHead {
+				return next(c)
+			}
+
+			req.Method = http.MethodGet
+			res := c.Response()
+			res.Writer = &headResponseWriter{ResponseWriter: res.Writer}
+			return next(c)
+		}
+	}
+}
+
+// headResponseWriter drops the body of responses to HEAD requests while keeping status and headers
+type headResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w *headResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// MethodNotAllowed answers requests whose path matches a route but whose method does not.
+// OPTIONS requests get 204 with an Allow header; other methods get 405 with the standard
+// error body and an Allow header. GET routes are listed as also allowing HEAD (see HeadAsGet).
+//
+// The allowed methods are looked up in the router rather than taken from Echo's own 405
+// handling, because the catch-all routes registered by Group.Use hide it for grouped routes.
+func MethodNotAllowed() echo.MiddlewareFunc {
+	var (
+		once   sync.Once
+		routes map[string]bool
+	)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			e := c.Echo()
+			once.Do(func() {
+				routes = make(map[string]bool)
+				for _, route := range e.Routes() {
+					routes[route.Method+" "+route.Path] = true
+				}
+			})
+
+			req := c.Request()
+			if routes[req.Method+" "+c.Path()] {
+				return next(c)
+			}
+
+			allowed := allowedMethods(e, req, routes)
+			if len(allowed) == 0 {
+				return next(c)
+			}
+
+			c.Response().Header().Set(echo.HeaderAllow, strings.Join(allowed, ", "))
+			if req.Method == http.MethodOptions {
+				return c.NoContent(http.StatusNoContent)
+			}
+
+			errorInfo := errors.GetErrorInfo(errors.ErrCodeMethodNotAllowed, GetLanguageFromContext(c))
+			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+				Code:        string(errorInfo.Code),
+				Message:     errorInfo.Message,
+				Description: errorInfo.Description,
+			})
+		}
+	}
+}
+
+// allowHeaderMethods are the methods that are checked for the Allow header, in the order they are listed
+var allowHeaderMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// allowedMethods returns the methods registered for the request path, led by OPTIONS,
+// or nil when no route matches the path with any method
+func allowedMethods(e *echo.Echo, req *http.Request, routes map[string]bool) []string {
+	path := echo.GetPath(req)
+	allowed := make(map[string]bool, len(allowHeaderMethods))
+	for _, method := range allowHeaderMethods {
+		ctx := e.AcquireContext()
+		ctx.Reset(req, nil)
+		e.Router().Find(method, path, ctx)
+		allowed[method] = routes[method+" "+ctx.Path()]
+		e.ReleaseContext(ctx)
+	}
+	allowed[http.MethodHead] = allowed[http.MethodHead] || allowed[http.MethodGet]
+
+	var methods []string
+	for _, method := range allowHeaderMethods {
+		if allowed[method] {
+			methods = append(methods, method)
+		}
+	}
+	if len(methods) == 0 {
+		return nil
+	}
+	return append([]string{http.MethodOptions}, methods...)
+}
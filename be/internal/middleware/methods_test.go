@@ -0,0 +1,119 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMethodsTestServer() *echo.Echo {
+	e := echo.New()
+	e.Pre(middleware.HeadAsGet())
+	e.Use(middleware.MethodNotAllowed())
+
+	ok := func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	}
+	e.GET("/api/items", ok)
+	e.POST("/api/items", ok)
+
+	protected := e.Group("/api/users")
+	protected.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return c.NoContent(http.StatusUnauthorized)
+		}
+	})
+	protected.DELETE("/me", ok)
+
+	return e
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedAllow  string
+		expectedCode   string
+		expectedBody   string
+		expectedStatus int
+	}{
+		{
+			name:           "options lists allowed methods",
+			method:         http.MethodOptions,
+			path:           "/api/items",
+			expectedStatus: http.StatusNoContent,
+			expectedAllow:  "OPTIONS, GET, HEAD, POST",
+		},
+		{
+			name:           "options bypasses group middleware",
+			method:         http.MethodOptions,
+			path:           "/api/users/me",
+			expectedStatus: http.StatusNoContent,
+			expectedAllow:  "OPTIONS, DELETE",
+		},
+		{
+			name:           "unsupported method",
+			method:         http.MethodPut,
+			path:           "/api/items",
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedAllow:  "OPTIONS, GET, HEAD, POST",
+			expectedCode:   "E009",
+		},
+		{
+			name:           "unsupported method in group",
+			method:         http.MethodGet,
+			path:           "/api/users/me",
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedAllow:  "OPTIONS, DELETE",
+			expectedCode:   "E009",
+		},
+		{
+			name:           "head served by get handler",
+			method:         http.MethodHead,
+			path:           "/api/items",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown path",
+			method:         http.MethodPut,
+			path:           "/api/unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "{\"message\":\"Not Found\"}\n",
+		},
+		{
+			name:           "supported method",
+			method:         http.MethodPost,
+			path:           "/api/items",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "ok",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newMethodsTestServer()
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedAllow, rec.Header().Get(echo.HeaderAllow))
+			if tt.expectedCode != "" {
+				var body map[string]string
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, tt.expectedCode, body["code"])
+				assert.Equal(t, "Method not allowed", body["message"])
+			} else {
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
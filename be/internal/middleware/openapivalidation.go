@@ -0,0 +1,197 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/openapi"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OpenAPIValidationMode is what the OpenAPI validation middleware does with mismatches
+type OpenAPIValidationMode string
+
+const (
+	// OpenAPIValidationOff does not validate
+	OpenAPIValidationOff OpenAPIValidationMode = "off"
+	// OpenAPIValidationLog logs mismatches and lets the requests and responses through
+	OpenAPIValidationLog OpenAPIValidationMode = "log"
+	// OpenAPIValidationReject rejects mismatching requests with 400 and mismatching responses with 500
+	OpenAPIValidationReject OpenAPIValidationMode = "reject"
+)
+
+// OpenAPIValidationModeFromEnv reads the mode from OPENAPI_VALIDATION (off, log or reject). It is
+// off in production and log elsewhere by default.
+func OpenAPIValidationModeFromEnv() OpenAPIValidationMode {
+	defaultMode := OpenAPIValidationLog
+	if os.Getenv("APP_ENV") == "production" {
+		defaultMode = OpenAPIValidationOff
+	}
+
+	mode := OpenAPIValidationMode(strings.ToLower(config.GetEnv("OPENAPI_VALIDATION", string(defaultMode))))
+	switch mode {
+	case OpenAPIValidationOff, OpenAPIValidationLog, OpenAPIValidationReject:
+		return mode
+	default:
+		slog.Warn("Ignoring invalid OPENAPI_VALIDATION", "value", mode, "default", defaultMode)
+		return defaultMode
+	}
+}
+
+// OpenAPIValidation checks JSON request and response bodies of documented routes against the
+// OpenAPI document doc, so that DTO changes that are not reflected in the document are caught
+// before clients generated from it break. Mismatches are logged, and in reject mode mismatching
+// requests are answered with 400 before the handler runs, and mismatching responses are replaced
+// with 500. Responses of errors returned to the error handler are not checked. It must run inside
+// the compression middleware, so that it sees uncompressed bodies.
+func OpenAPIValidation(doc *openapi.Document, mode OpenAPIValidationMode) echo.MiddlewareFunc {
+	validator := openapi.NewValidator(doc)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if mode == OpenAPIValidationOff {
+			return next
+		}
+
+		return func(c echo.Context) error {
+			operation := validator.Operation(c.Request().Method, c.Path())
+			if operation == nil {
+				return next(c)
+			}
+
+			if err := validateRequestBody(c, validator, operation); err != nil {
+				slog.Warn("Request does not match the OpenAPI document",
+					"method", c.Request().Method, "path", c.Path(), "operation", operation.OperationID, "error", err)
+				if mode == OpenAPIValidationReject {
+					errorInfo := errors.GetErrorInfo(errors.ErrCodeValidationFailed, GetLanguageFromContext(c))
+					return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+						Code:        string(errorInfo.Code),
+						Message:     errorInfo.Message,
+						Description: err.Error(),
+					})
+				}
+			}
+
+			// Streams never end on their own and are not JSON, so their responses are not checked
+			if openapi.IsEventStream(operation) {
+				return next(c)
+			}
+
+			res := c.Response()
+			recorder := &validatingRecorder{ResponseWriter: res.Writer, buffer: mode == OpenAPIValidationReject}
+			res.Writer = recorder
+			err := next(c)
+			res.Writer = recorder.ResponseWriter
+
+			if err != nil || !isJSON(res.Header().Get(echo.HeaderContentType)) {
+				return recorder.flush()
+			}
+
+			validationErr := validator.ValidateResponse(operation, res.Status, recorder.body.Bytes())
+			if validationErr == nil {
+				return recorder.flush()
+			}
+			slog.Error("Response does not match the OpenAPI document",
+				"method", c.Request().Method, "path", c.Path(), "operation", operation.OperationID,
+				"status", res.Status, "error", validationErr)
+			if mode != OpenAPIValidationReject {
+				return nil
+			}
+			return recorder.reject(c)
+		}
+	}
+}
+
+// validateRequestBody checks the JSON body of the request, if any, against operation. The body is
+// read and replaced, so that the handler can still read it.
+func validateRequestBody(c echo.Context, validator *openapi.Validator, operation *openapi.Operation) error {
+	req := c.Request()
+	if req.Body == nil || req.Body == http.NoBody {
+		return validator.ValidateRequest(operation, nil)
+	}
+	if !isJSON(req.Header.Get(echo.HeaderContentType)) {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return validator.ValidateRequest(operation, body)
+}
+
+// isJSON reports whether contentType is a JSON media type
+func isJSON(contentType string) bool {
+	return strings.HasPrefix(contentType, echo.MIMEApplicationJSON)
+}
+
+// validatingRecorder keeps a copy of the response body written through it. When buffer is set,
+// the response is held back until it has been validated.
+type validatingRecorder struct {
+	http.ResponseWriter
+	body   bytes.Buffer
+	status int
+	buffer bool
+}
+
+// WriteHeader records the status, and sends it unless the response is buffered
+func (r *validatingRecorder) WriteHeader(status int) {
+	r.status = status
+	if !r.buffer {
+		r.ResponseWriter.WriteHeader(status)
+	}
+}
+
+// Write keeps a copy of b, and sends it unless the response is buffered
+func (r *validatingRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	if r.buffer {
+		return len(b), nil
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped response writer, for http.ResponseController
+func (r *validatingRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// flush sends a buffered response
+func (r *validatingRecorder) flush() error {
+	if !r.buffer || r.status == 0 {
+		return nil
+	}
+	r.ResponseWriter.WriteHeader(r.status)
+	_, err := r.ResponseWriter.Write(r.body.Bytes())
+	return err
+}
+
+// reject replaces a buffered response with the internal error response
+func (r *validatingRecorder) reject(c echo.Context) error {
+	errorInfo := errors.GetErrorInfo(errors.ErrCodeInternalError, GetLanguageFromContext(c))
+	body, err := json.Marshal(dto.ErrorResponse{
+		Code:        string(errorInfo.Code),
+		Message:     errorInfo.Message,
+		Description: "The response does not match the OpenAPI document",
+	})
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	res.Header().Del(echo.HeaderContentLength)
+	res.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+	res.Status = errorInfo.HTTPStatus
+	r.ResponseWriter.WriteHeader(errorInfo.HTTPStatus)
+	_, err = r.ResponseWriter.Write(append(body, '\n'))
+	return err
+}
@@ -0,0 +1,169 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/openapi"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type itemRequest struct {
+	Title string `json:"title" validate:"required"`
+}
+
+type itemResponse struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func TestOpenAPIValidation(t *testing.T) {
+	doc := openapi.Build([]openapi.Route{{
+		Method:      http.MethodPost,
+		Path:        "/api/items",
+		OperationID: "createItem",
+		Request:     itemRequest{},
+		Responses:   map[int]any{http.StatusCreated: itemResponse{}},
+	}})
+
+	tests := []struct {
+		response       any
+		name           string
+		mode           middleware.OpenAPIValidationMode
+		path           string
+		body           string
+		expectedCode   string
+		expectedStatus int
+		expectHandler  bool
+	}{
+		{
+			name:           "valid request and response",
+			mode:           middleware.OpenAPIValidationReject,
+			path:           "/api/items",
+			body:           `{"title":"Groceries"}`,
+			response:       map[string]string{"id": "itm_1", "title": "Groceries"},
+			expectedStatus: http.StatusCreated,
+			expectHandler:  true,
+		},
+		{
+			name:           "invalid request rejected",
+			mode:           middleware.OpenAPIValidationReject,
+			path:           "/api/items",
+			body:           `{"name":"Groceries"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "E003",
+		},
+		{
+			name:           "invalid request logged",
+			mode:           middleware.OpenAPIValidationLog,
+			path:           "/api/items",
+			body:           `{"name":"Groceries"}`,
+			response:       map[string]string{"id": "itm_1", "title": ""},
+			expectedStatus: http.StatusCreated,
+			expectHandler:  true,
+		},
+		{
+			name:           "invalid response rejected",
+			mode:           middleware.OpenAPIValidationReject,
+			path:           "/api/items",
+			body:           `{"title":"Groceries"}`,
+			response:       map[string]string{"id": "itm_1", "name": "Groceries"},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "E001",
+			expectHandler:  true,
+		},
+		{
+			name:           "invalid response logged",
+			mode:           middleware.OpenAPIValidationLog,
+			path:           "/api/items",
+			body:           `{"title":"Groceries"}`,
+			response:       map[string]string{"id": "itm_1", "name": "Groceries"},
+			expectedStatus: http.StatusCreated,
+			expectHandler:  true,
+		},
+		{
+			name:           "undocumented route",
+			mode:           middleware.OpenAPIValidationReject,
+			path:           "/api/drafts",
+			body:           `{"name":"Groceries"}`,
+			response:       map[string]string{"name": "Groceries"},
+			expectedStatus: http.StatusCreated,
+			expectHandler:  true,
+		},
+		{
+			name:           "off",
+			mode:           middleware.OpenAPIValidationOff,
+			path:           "/api/items",
+			body:           `{"name":"Groceries"}`,
+			response:       map[string]string{"name": "Groceries"},
+			expectedStatus: http.StatusCreated,
+			expectHandler:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlerCalled := false
+			handler := func(c echo.Context) error {
+				handlerCalled = true
+				body, err := io.ReadAll(c.Request().Body)
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, tt.body, string(body))
+				return c.JSON(http.StatusCreated, tt.response)
+			}
+			e := echo.New()
+			e.Use(middleware.OpenAPIValidation(doc, tt.mode))
+			e.POST("/api/items", handler)
+			e.POST("/api/drafts", handler)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectHandler, handlerCalled)
+			var response map[string]string
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			if tt.expectedCode != "" {
+				assert.Equal(t, tt.expectedCode, response["code"])
+				return
+			}
+			expected, err := json.Marshal(tt.response)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(expected), rec.Body.String())
+		})
+	}
+}
+
+func TestOpenAPIValidationModeFromEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		appEnv   string
+		value    string
+		expected middleware.OpenAPIValidationMode
+	}{
+		{name: "development default", appEnv: "dev", expected: middleware.OpenAPIValidationLog},
+		{name: "production default", appEnv: "production", expected: middleware.OpenAPIValidationOff},
+		{name: "configured", appEnv: "staging", value: "REJECT", expected: middleware.OpenAPIValidationReject},
+		{name: "invalid value", appEnv: "dev", value: "strict", expected: middleware.OpenAPIValidationLog},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("APP_ENV", tt.appEnv)
+			t.Setenv("OPENAPI_VALIDATION", tt.value)
+
+			assert.Equal(t, tt.expected, middleware.OpenAPIValidationModeFromEnv())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Quota response headers
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	// HeaderRateLimitReset is the Unix time in seconds at which the current window ends
+	HeaderRateLimitReset = "X-RateLimit-Reset"
+)
+
+// QuotaPolicy limits how many requests each caller makes to the routes of a group per window
+type QuotaPolicy struct {
+	Name   string
+	Limit  int64
+	Window time.Duration
+}
+
+// ParseQuotaPolicies parses a comma-separated list of policies of the form name=limit/window, with
+// a Go duration as window, e.g. "user=1000/1h,admin=5000/1h"
+func ParseQuotaPolicies(value string) (map[string]QuotaPolicy, error) {
+	policies := make(map[string]QuotaPolicy)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, rule, ok := strings.Cut(item, "=")
+		limit, window, hasWindow := strings.Cut(rule, "/")
+		if !ok || !hasWindow {
+			return nil, fmt.Errorf("invalid quota policy %q: want name=limit/window", item)
+		}
+		policy := QuotaPolicy{Name: strings.TrimSpace(name)}
+		var err error
+		if policy.Limit, err = strconv.ParseInt(strings.TrimSpace(limit), 10, 64); err != nil || policy.Limit <= 0 {
+			return nil, fmt.Errorf("invalid limit of quota policy %s: %q", policy.Name, limit)
+		}
+		if policy.Window, err = time.ParseDuration(strings.TrimSpace(window)); err != nil || policy.Window <= 0 {
+			return nil, fmt.Errorf("invalid window of quota policy %s: %q", policy.Name, window)
+		}
+		policies[policy.Name] = policy
+	}
+	return policies, nil
+}
+
+// Quota limits each caller to policy.Limit requests per window of the policy, counted in counter so
+// that instances sharing a Redis cache share the quota. Callers are the API key or user
+// authenticated before it runs, or the client IP for anonymous requests. Windows are fixed and
+// aligned to the Unix epoch. Every response carries the X-RateLimit-* headers; requests over the
+// limit are refused with 429 and Retry-After. When the counter cannot be reached, requests are let
+// through without the headers.
+func Quota(policy QuotaPolicy, counter cache.Cache) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			now := time.Now()
+			windowStart := now.Truncate(policy.Window)
+			reset := windowStart.Add(policy.Window)
+			key := "quota:" + policy.Name + ":" + quotaCaller(c) + ":" + strconv.FormatInt(windowStart.Unix(), 10)
+
+			count, err := counter.Increment(c.Request().Context(), key, reset.Sub(now))
+			if err != nil {
+				slog.Error("Failed to count request against quota", "error", err, "policy", policy.Name)
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set(HeaderRateLimitLimit, strconv.FormatInt(policy.Limit, 10))
+			header.Set(HeaderRateLimitRemaining, strconv.FormatInt(max(policy.Limit-count, 0), 10))
+			header.Set(HeaderRateLimitReset, strconv.FormatInt(reset.Unix(), 10))
+			if count > policy.Limit {
+				slog.Warn("Quota exceeded", "policy", policy.Name, "path", c.Path())
+				retryAfter := int64(reset.Sub(now).Round(time.Second) / time.Second)
+				header.Set("Retry-After", strconv.FormatInt(max(retryAfter, 1), 10))
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeTooManyRequests, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: errorInfo.Message,
+				})
+			}
+			return next(c)
+		}
+	}
+}
+
+// quotaCaller identifies the caller a request counts against: the API key authenticated by
+// APIKeyMiddleware, the user authenticated by JWTMiddleware, or the client IP
+func quotaCaller(c echo.Context) string {
+	if apiKey, ok := GetAPIKeyFromContext(c); ok {
+		return "key:" + apiKey.PublicID
+	}
+	if session, ok := c.Get("session").(*model.UserSession); ok && session != nil {
+		return "user:" + session.User.PublicID
+	}
+	return "ip:" + c.RealIP()
+}
@@ -0,0 +1,109 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unreachableCache fails every operation, like a Redis that is down
+type unreachableCache struct{}
+
+func (unreachableCache) Get(context.Context, string) ([]byte, error) { return nil, errors.New("down") }
+func (unreachableCache) Set(context.Context, string, []byte, time.Duration) error {
+	return errors.New("down")
+}
+func (unreachableCache) Delete(context.Context, ...string) error { return errors.New("down") }
+func (unreachableCache) Increment(context.Context, string, time.Duration) (int64, error) {
+	return 0, errors.New("down")
+}
+
+func TestParseQuotaPolicies(t *testing.T) {
+	policies, err := middleware.ParseQuotaPolicies(" user=1000/1h, admin = 5000/30m ,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]middleware.QuotaPolicy{
+		"user":  {Name: "user", Limit: 1000, Window: time.Hour},
+		"admin": {Name: "admin", Limit: 5000, Window: 30 * time.Minute},
+	}, policies)
+
+	policies, err = middleware.ParseQuotaPolicies("")
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	for _, value := range []string{"user", "user=1000", "user=many/1h", "user=0/1h", "user=1000/hourly", "user=1000/0s"} {
+		_, err := middleware.ParseQuotaPolicies(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestQuota(t *testing.T) {
+	e := echo.New()
+	quota := middleware.Quota(middleware.QuotaPolicy{Name: "user", Limit: 2, Window: time.Hour}, cache.NewMemoryCache())
+	e.GET("/api/notes", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if userID := c.Request().Header.Get("X-Test-User"); userID != "" {
+				c.Set("session", &model.UserSession{User: model.User{PublicID: userID}})
+			}
+			return next(c)
+		}
+	}, quota)
+
+	request := func(userID, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/notes", http.NoBody)
+		req.Header.Set("X-Test-User", userID)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := request("usr_5k2d9x0q7m3hv8c1b4na", "192.0.2.1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get(middleware.HeaderRateLimitLimit))
+	assert.Equal(t, "1", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+	reset, err := strconv.ParseInt(rec.Header().Get(middleware.HeaderRateLimitReset), 10, 64)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Truncate(time.Hour).Add(time.Hour), time.Unix(reset, 0), time.Second)
+
+	// The same user from another IP shares the quota
+	rec = request("usr_5k2d9x0q7m3hv8c1b4na", "192.0.2.2")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+
+	rec = request("usr_5k2d9x0q7m3hv8c1b4na", "192.0.2.1")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+	assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+
+	// Other users and anonymous callers are counted separately
+	assert.Equal(t, http.StatusOK, request("usr_0123456789abcdefghjk", "192.0.2.1").Code)
+	assert.Equal(t, http.StatusOK, request("", "192.0.2.1").Code)
+	assert.Equal(t, http.StatusOK, request("", "192.0.2.1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request("", "192.0.2.1").Code)
+	assert.Equal(t, http.StatusOK, request("", "192.0.2.3").Code)
+}
+
+func TestQuota_CounterUnavailable(t *testing.T) {
+	e := echo.New()
+	quota := middleware.Quota(middleware.QuotaPolicy{Name: "user", Limit: 1, Window: time.Hour}, unreachableCache{})
+	e.GET("/api/notes", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, quota)
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/notes", http.NoBody))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(middleware.HeaderRateLimitLimit))
+	}
+}
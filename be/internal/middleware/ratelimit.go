@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitByIP limits each client IP to requestsPerMinute requests, allowing short bursts.
+// Limits are tracked in memory, so they apply per server instance.
+func RateLimitByIP(requestsPerMinute float64, burst int) echo.MiddlewareFunc {
+	store := echomiddleware.NewRateLimiterMemoryStoreWithConfig(echomiddleware.RateLimiterMemoryStoreConfig{
+		Rate:      rate.Limit(requestsPerMinute / 60),
+		Burst:     burst,
+		ExpiresIn: 3 * time.Minute,
+	})
+
+	return echomiddleware.RateLimiterWithConfig(echomiddleware.RateLimiterConfig{
+		Store: store,
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return c.RealIP(), nil
+		},
+		DenyHandler: func(c echo.Context, identifier string, _ error) error {
+			slog.Warn("Rate limit exceeded", "ip", identifier, "path", c.Path())
+			errorInfo := errors.GetErrorInfo(errors.ErrCodeTooManyRequests, GetLanguageFromContext(c))
+			return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+				Code:    string(errorInfo.Code),
+				Message: errorInfo.Message,
+			})
+		},
+	})
+}
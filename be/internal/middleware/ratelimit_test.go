@@ -0,0 +1,35 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitByIP(t *testing.T) {
+	e := echo.New()
+	e.POST("/limited", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	}, middleware.RateLimitByIP(1, 2))
+
+	send := func(ip string) int {
+		req := httptest.NewRequest(http.MethodPost, "/limited", http.NoBody)
+		req.Header.Set(echo.HeaderXRealIP, ip)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// The burst allows two requests, the third is rejected
+	assert.Equal(t, http.StatusNoContent, send("203.0.113.1"))
+	assert.Equal(t, http.StatusNoContent, send("203.0.113.1"))
+	assert.Equal(t, http.StatusTooManyRequests, send("203.0.113.1"))
+
+	// Other clients have their own budget
+	assert.Equal(t, http.StatusNoContent, send("203.0.113.2"))
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"strikepad-backend/internal/config"
+
+	"github.com/labstack/echo/v4"
+)
+
+// securityHeaderOff disables a security header when set as its environment variable
+const securityHeaderOff = "off"
+
+// SecurityHeadersConfig holds the values of the security headers set on every response. An empty
+// value leaves the header out.
+type SecurityHeadersConfig struct {
+	// ContentSecurityPolicy is the Content-Security-Policy header
+	ContentSecurityPolicy string
+	// FrameOptions is the X-Frame-Options header
+	FrameOptions string
+	// ReferrerPolicy is the Referrer-Policy header
+	ReferrerPolicy string
+	// HSTSMaxAge is the max-age of Strict-Transport-Security in seconds, sent only on HTTPS
+	// requests. Zero leaves the header out.
+	HSTSMaxAge int
+	// HSTSIncludeSubdomains adds includeSubDomains to Strict-Transport-Security
+	HSTSIncludeSubdomains bool
+}
+
+// DefaultSecurityHeadersConfig is suited to a JSON API that is never rendered or framed by browsers
+func DefaultSecurityHeadersConfig() SecurityHeadersConfig {
+	return SecurityHeadersConfig{
+		ContentSecurityPolicy: "default-src 'none'; frame-ancestors 'none'",
+		FrameOptions:          "DENY",
+		ReferrerPolicy:        "no-referrer",
+		HSTSMaxAge:            31536000,
+		HSTSIncludeSubdomains: true,
+	}
+}
+
+// SecurityHeadersConfigFromEnv overrides the defaults with SECURITY_CSP, SECURITY_FRAME_OPTIONS,
+// SECURITY_REFERRER_POLICY, SECURITY_HSTS_MAX_AGE and SECURITY_HSTS_INCLUDE_SUBDOMAINS.
+// Setting one of the string headers to "off" leaves it out.
+func SecurityHeadersConfigFromEnv() SecurityHeadersConfig {
+	defaults := DefaultSecurityHeadersConfig()
+	headerValue := func(key, defaultValue string) string {
+		value := config.GetEnv(key, defaultValue)
+		if strings.EqualFold(value, securityHeaderOff) {
+			return ""
+		}
+		return value
+	}
+
+	return SecurityHeadersConfig{
+		ContentSecurityPolicy: headerValue("SECURITY_CSP", defaults.ContentSecurityPolicy),
+		FrameOptions:          headerValue("SECURITY_FRAME_OPTIONS", defaults.FrameOptions),
+		ReferrerPolicy:        headerValue("SECURITY_REFERRER_POLICY", defaults.ReferrerPolicy),
+		HSTSMaxAge:            max(config.GetEnvInt("SECURITY_HSTS_MAX_AGE", defaults.HSTSMaxAge), 0),
+		HSTSIncludeSubdomains: config.GetEnvBool("SECURITY_HSTS_INCLUDE_SUBDOMAINS", defaults.HSTSIncludeSubdomains),
+	}
+}
+
+// SecurityHeaders sets the configured security headers, and X-Content-Type-Options: nosniff, on
+// every response. The headers are set before the handler runs so that error responses carry them too.
+func SecurityHeaders(headersConfig SecurityHeadersConfig) echo.MiddlewareFunc {
+	hsts := ""
+	if headersConfig.HSTSMaxAge > 0 {
+		hsts = fmt.Sprintf("max-age=%d", headersConfig.HSTSMaxAge)
+		if headersConfig.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Response().Header()
+			header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+			if headersConfig.ContentSecurityPolicy != "" {
+				header.Set(echo.HeaderContentSecurityPolicy, headersConfig.ContentSecurityPolicy)
+			}
+			if headersConfig.FrameOptions != "" {
+				header.Set(echo.HeaderXFrameOptions, headersConfig.FrameOptions)
+			}
+			if headersConfig.ReferrerPolicy != "" {
+				header.Set(echo.HeaderReferrerPolicy, headersConfig.ReferrerPolicy)
+			}
+			// Browsers ignore HSTS on plain HTTP, so it is only sent when the client used HTTPS
+			if hsts != "" && (c.IsTLS() || c.Scheme() == "https") {
+				header.Set(echo.HeaderStrictTransportSecurity, hsts)
+			}
+
+			return next(c)
+		}
+	}
+}
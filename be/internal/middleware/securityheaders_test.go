@@ -0,0 +1,107 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	e := echo.New()
+	e.Use(middleware.SecurityHeaders(middleware.DefaultSecurityHeadersConfig()))
+	e.Use(echomiddleware.Recover())
+	e.GET("/ok", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+	})
+	e.GET("/error", func(_ echo.Context) error {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad request")
+	})
+	e.GET("/panic", func(_ echo.Context) error {
+		panic("boom")
+	})
+
+	tests := []struct {
+		name           string
+		path           string
+		forwardedProto string
+		expectedHSTS   string
+		expectedStatus int
+	}{
+		{name: "success", path: "/ok", expectedStatus: http.StatusOK},
+		{name: "handler error", path: "/error", expectedStatus: http.StatusBadRequest},
+		{name: "panic", path: "/panic", expectedStatus: http.StatusInternalServerError},
+		{name: "unknown route", path: "/missing", expectedStatus: http.StatusNotFound},
+		{
+			name:           "HTTPS behind proxy",
+			path:           "/ok",
+			forwardedProto: "https",
+			expectedHSTS:   "max-age=31536000; includeSubDomains",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.forwardedProto != "" {
+				req.Header.Set(echo.HeaderXForwardedProto, tt.forwardedProto)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, "nosniff", rec.Header().Get(echo.HeaderXContentTypeOptions))
+			assert.Equal(t, "default-src 'none'; frame-ancestors 'none'", rec.Header().Get(echo.HeaderContentSecurityPolicy))
+			assert.Equal(t, "DENY", rec.Header().Get(echo.HeaderXFrameOptions))
+			assert.Equal(t, "no-referrer", rec.Header().Get(echo.HeaderReferrerPolicy))
+			assert.Equal(t, tt.expectedHSTS, rec.Header().Get(echo.HeaderStrictTransportSecurity))
+		})
+	}
+}
+
+func TestSecurityHeadersConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		for _, key := range []string{
+			"SECURITY_CSP", "SECURITY_FRAME_OPTIONS", "SECURITY_REFERRER_POLICY",
+			"SECURITY_HSTS_MAX_AGE", "SECURITY_HSTS_INCLUDE_SUBDOMAINS",
+		} {
+			t.Setenv(key, "")
+		}
+
+		assert.Equal(t, middleware.DefaultSecurityHeadersConfig(), middleware.SecurityHeadersConfigFromEnv())
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		t.Setenv("SECURITY_CSP", "default-src 'self'")
+		t.Setenv("SECURITY_FRAME_OPTIONS", "off")
+		t.Setenv("SECURITY_REFERRER_POLICY", "strict-origin")
+		t.Setenv("SECURITY_HSTS_MAX_AGE", "600")
+		t.Setenv("SECURITY_HSTS_INCLUDE_SUBDOMAINS", "false")
+
+		headersConfig := middleware.SecurityHeadersConfigFromEnv()
+		assert.Equal(t, middleware.SecurityHeadersConfig{
+			ContentSecurityPolicy: "default-src 'self'",
+			ReferrerPolicy:        "strict-origin",
+			HSTSMaxAge:            600,
+		}, headersConfig)
+
+		e := echo.New()
+		e.Use(middleware.SecurityHeaders(headersConfig))
+		e.GET("/ok", func(c echo.Context) error {
+			return c.NoContent(http.StatusNoContent)
+		})
+		req := httptest.NewRequest(http.MethodGet, "/ok", nil)
+		req.Header.Set(echo.HeaderXForwardedProto, "https")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Empty(t, rec.Header().Get(echo.HeaderXFrameOptions))
+		assert.Equal(t, "max-age=600", rec.Header().Get(echo.HeaderStrictTransportSecurity))
+	})
+}
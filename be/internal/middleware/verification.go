@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"log/slog"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequireVerifiedEmail rejects requests from users whose email address has not been verified.
+// It must run after JWTMiddleware. When enforce is false the middleware only logs, so that
+// deployments can roll out verification without locking out existing users.
+func RequireVerifiedEmail(enforce bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			session, ok := c.Get("session").(*model.UserSession)
+			if !ok || session == nil {
+				slog.Warn("Missing session for email verification check")
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeUnauthorized, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: errorInfo.Message,
+				})
+			}
+
+			if !session.User.EmailVerified {
+				if !enforce {
+					slog.Debug("Allowing unverified email because enforcement is disabled", "user_id", session.UserID)
+					return next(c)
+				}
+
+				slog.Warn("Rejected request from user with unverified email", "user_id", session.UserID)
+				errorInfo := errors.GetErrorInfo(errors.ErrCodeEmailNotVerified, GetLanguageFromContext(c))
+				return c.JSON(errorInfo.HTTPStatus, dto.ErrorResponse{
+					Code:    string(errorInfo.Code),
+					Message: errorInfo.Message,
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type VerificationMiddlewareTestSuite struct {
+	suite.Suite
+	echo *echo.Echo
+}
+
+func (suite *VerificationMiddlewareTestSuite) SetupTest() {
+	suite.echo = echo.New()
+}
+
+func (suite *VerificationMiddlewareTestSuite) TestRequireVerifiedEmail() {
+	testCases := []struct {
+		session        *model.UserSession
+		expectedError  map[string]string
+		name           string
+		expectedStatus int
+		enforce        bool
+		expectNext     bool
+	}{
+		{
+			name:           "Verified user passes",
+			session:        factory.NewSession().ForUser(factory.NewUser().WithID(1).Verified().Build()).Build(),
+			enforce:        true,
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+		},
+		{
+			name:           "Unverified user rejected when enforced",
+			session:        factory.NewSession().ForUser(factory.NewUser().WithID(2).Build()).Build(),
+			enforce:        true,
+			expectedStatus: http.StatusForbidden,
+			expectedError: map[string]string{
+				"code":    "E300",
+				"message": "Email not verified",
+			},
+		},
+		{
+			name:           "Unverified user allowed when not enforced",
+			session:        factory.NewSession().ForUser(factory.NewUser().WithID(3).Build()).Build(),
+			enforce:        false,
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+		},
+		{
+			name:           "Missing session",
+			session:        nil,
+			enforce:        true,
+			expectedStatus: http.StatusUnauthorized,
+			expectedError: map[string]string{
+				"code":    "E005",
+				"message": "Unauthorized",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/test", http.NoBody)
+			rec := httptest.NewRecorder()
+			c := suite.echo.NewContext(req, rec)
+			if tc.session != nil {
+				c.Set("session", tc.session)
+			}
+
+			nextCalled := false
+			next := func(c echo.Context) error {
+				nextCalled = true
+				return c.String(http.StatusOK, "success")
+			}
+
+			err := middleware.RequireVerifiedEmail(tc.enforce)(next)(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectNext, nextCalled)
+
+			if tc.expectedError != nil {
+				var response map[string]string
+				err = json.Unmarshal(rec.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedError, response)
+			}
+		})
+	}
+}
+
+func TestVerificationMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(VerificationMiddlewareTestSuite))
+}
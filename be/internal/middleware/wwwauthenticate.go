@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"strikepad-backend/internal/errors"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AuthRealm is the protection space advertised in WWW-Authenticate challenges
+const AuthRealm = "strikepad"
+
+// bearerErrorKey is the context key under which authentication middleware records
+// why a presented token was rejected
+const bearerErrorKey = "bearer_error"
+
+// addBearerChallenge adds an RFC 6750 Bearer challenge to the response if it turns out to be a 401,
+// whether JWTMiddleware or the handler behind it wrote it. When a presented token was rejected, the
+// challenge carries error="invalid_token" and a description; requests without credentials get a
+// bare challenge. Routes that do not take Bearer tokens are left alone.
+func addBearerChallenge(c echo.Context) {
+	res := c.Response()
+	res.Before(func() {
+		if res.Status != http.StatusUnauthorized || res.Header().Get(echo.HeaderWWWAuthenticate) != "" {
+			return
+		}
+		res.Header().Set(echo.HeaderWWWAuthenticate, bearerChallenge(c))
+	})
+}
+
+// setBearerError records that the presented token was rejected with code, for the WWW-Authenticate challenge
+func setBearerError(c echo.Context, code errors.ErrorCode) {
+	c.Set(bearerErrorKey, code)
+}
+
+// bearerChallenge builds the WWW-Authenticate header value for a 401 response
+func bearerChallenge(c echo.Context) string {
+	challenge := fmt.Sprintf("Bearer realm=%q", AuthRealm)
+
+	code, ok := c.Get(bearerErrorKey).(errors.ErrorCode)
+	if !ok {
+		return challenge
+	}
+
+	errorInfo := errors.GetErrorInfo(code, errors.DefaultLanguage)
+	return fmt.Sprintf("%s, error=%q, error_description=%q",
+		challenge, "invalid_token", strings.ReplaceAll(errorInfo.Description, `"`, "'"))
+}
@@ -0,0 +1,126 @@
+package middleware_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/model"
+	servicemocks "strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWWWAuthenticate(t *testing.T) {
+	tests := []struct {
+		validateErr    error
+		name           string
+		authorization  string
+		expectedHeader string
+		expectedStatus int
+	}{
+		{
+			name:           "missing credentials",
+			expectedStatus: http.StatusUnauthorized,
+			expectedHeader: `Bearer realm="strikepad"`,
+		},
+		{
+			name:           "expired token",
+			authorization:  "Bearer expired-token",
+			validateErr:    fmt.Errorf("invalid access token: %w", auth.ErrTokenExpired),
+			expectedStatus: http.StatusUnauthorized,
+			expectedHeader: `Bearer realm="strikepad", error="invalid_token", ` +
+				`error_description="The authentication token has expired"`,
+		},
+		{
+			name:           "invalid token",
+			authorization:  "Bearer invalid-token",
+			validateErr:    fmt.Errorf("session not found: %w", auth.ErrTokenInvalid),
+			expectedStatus: http.StatusUnauthorized,
+			expectedHeader: `Bearer realm="strikepad", error="invalid_token", ` +
+				`error_description="The authentication token is invalid or malformed"`,
+		},
+		{
+			name:           "authenticated request",
+			authorization:  "Bearer valid-token",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionService := &servicemocks.MockSessionServiceInterface{}
+			if tt.authorization != "" {
+				token := tt.authorization[len("Bearer "):]
+				if tt.validateErr != nil {
+					sessionService.On("ValidateAccessToken", token).Return(nil, tt.validateErr)
+				} else {
+					session := &model.UserSession{ID: 1, UserID: 123}
+					sessionService.On("ValidateAccessToken", token).Return(session, nil)
+					sessionService.On("TouchSession", session).Return()
+				}
+			}
+
+			e := echo.New()
+			e.GET("/protected", func(c echo.Context) error {
+				return c.String(http.StatusOK, "success")
+			}, middleware.JWTMiddleware(sessionService))
+
+			req := httptest.NewRequest(http.MethodGet, "/protected", http.NoBody)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedHeader, rec.Header().Get(echo.HeaderWWWAuthenticate))
+		})
+	}
+}
+
+func TestWWWAuthenticate_HandlerResponses(t *testing.T) {
+	session := &model.UserSession{ID: 1, UserID: 123}
+	sessionService := &servicemocks.MockSessionServiceInterface{}
+	sessionService.On("ValidateAccessToken", "valid-token").Return(session, nil)
+	sessionService.On("TouchSession", session).Return()
+
+	unauthorized := func(c echo.Context) error {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"code": "E100"})
+	}
+	e := echo.New()
+	e.POST("/login", unauthorized)
+	e.POST("/protected", unauthorized, middleware.JWTMiddleware(sessionService))
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedHeader string
+	}{
+		{
+			name:           "JWT-authenticated route",
+			path:           "/protected",
+			expectedHeader: `Bearer realm="strikepad"`,
+		},
+		{
+			name: "public route",
+			path: "/login",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, http.NoBody)
+			req.Header.Set("Authorization", "Bearer valid-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Equal(t, tt.expectedHeader, rec.Header().Get(echo.HeaderWWWAuthenticate))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package migrations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// versionLayout formats the version prefix of migration files
+const versionLayout = "20060102150405"
+
+// migrationNamePattern matches the names of migrations, e.g. add_user_profiles
+var migrationNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// migrationTemplate is the content of a new migration file
+const migrationTemplate = `-- %s
+-- Write the statements of the migration below, with a comment on every new table and column.
+-- Atlas checks the migrations against atlas.sum: run ./atlas.exe migrate hash after editing this file.
+
+`
+
+// CreateMigration writes an empty migration file named name to dir and returns its path. Its
+// version is the time now, or the version after the latest one in dir when that is later, so that
+// the new migration always runs last.
+func CreateMigration(dir, name string, now time.Time) (string, error) {
+	if !migrationNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid migration name %q: use lowercase letters, digits and underscores", name)
+	}
+
+	version, err := strconv.ParseUint(now.UTC().Format(versionLayout), 10, 64)
+	if err != nil {
+		return "", err
+	}
+	latest, err := latestVersion(dir)
+	if err != nil {
+		return "", err
+	}
+	if version <= latest {
+		version = latest + 1
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%d_%s.sql", version, name))
+	content := fmt.Sprintf(migrationTemplate, strings.ReplaceAll(name, "_", " "))
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write migration: %w", err)
+	}
+	return path, nil
+}
+
+// latestVersion returns the highest version of the migration files in dir, 0 when there are none
+func latestVersion(dir string) (uint64, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return 0, err
+	}
+	var latest uint64
+	for _, file := range files {
+		prefix, _, ok := strings.Cut(filepath.Base(file), "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		latest = max(latest, version)
+	}
+	return latest, nil
+}
@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMigration(t *testing.T) {
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expected string
+		existing []string
+	}{
+		{
+			name:     "empty directory",
+			expected: "20260304050607_add_pads.sql",
+		},
+		{
+			name:     "older migrations",
+			existing: []string{"20250127000001_initial.sql", "20250127000015_add_webhook_endpoints.sql", "atlas.sum"},
+			expected: "20260304050607_add_pads.sql",
+		},
+		{
+			name:     "migration with a later version",
+			existing: []string{"20260304050607_add_tags.sql"},
+			expected: "20260304050608_add_pads.sql",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, file := range tt.existing {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, file), nil, 0o644))
+			}
+
+			path, err := CreateMigration(dir, "add_pads", now)
+
+			require.NoError(t, err)
+			assert.Equal(t, filepath.Join(dir, tt.expected), path)
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Contains(t, string(content), "-- add pads\n")
+		})
+	}
+}
+
+func TestCreateMigration_InvalidName(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, name := range []string{"", "Add pads", "add-pads", "1_add_pads", "../add_pads"} {
+		_, err := CreateMigration(dir, name, time.Now())
+		assert.Error(t, err, name)
+	}
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
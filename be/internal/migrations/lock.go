@@ -0,0 +1,82 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/config"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// advisoryLockKey identifies the PostgreSQL advisory lock held while migrations run. It only has
+// to differ from the keys of other advisory locks taken in the database.
+const advisoryLockKey int64 = 0x5374726b4d6967 // "StrkMig"
+
+// Locker keeps other runners from migrating the database at the same time
+type Locker interface {
+	// Lock blocks until the lock is held or ctx is done, and returns the function releasing it
+	Lock(ctx context.Context) (unlock func(), err error)
+}
+
+// PostgresLocker takes a session-level advisory lock in PostgreSQL. A runner that crashes releases
+// it with its connection, so the lock can never be left behind.
+type PostgresLocker struct {
+	db      *sql.DB
+	timeout time.Duration
+}
+
+// NewPostgresLocker returns a locker using db, which waits at most timeout for the lock
+func NewPostgresLocker(db *sql.DB, timeout time.Duration) *PostgresLocker {
+	return &PostgresLocker{db: db, timeout: timeout}
+}
+
+// NewLockerFromEnv returns a locker connected to the database that migrations run against:
+// DATABASE_URL, as in the production environment of atlas.hcl, or the database of the DB_*
+// variables. It waits at most MIGRATION_LOCK_TIMEOUT_SECONDS (300 by default) for the lock.
+func NewLockerFromEnv() (*PostgresLocker, error) {
+	dsn := config.GetEnv("DATABASE_URL", config.DatabaseDSN())
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the database to lock migrations: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Duration(config.GetEnvInt("MIGRATION_LOCK_TIMEOUT_SECONDS", 300)) * time.Second
+	return NewPostgresLocker(sqlDB, timeout), nil
+}
+
+// Lock takes the advisory lock on a dedicated connection, which is closed when the lock is released
+func (l *PostgresLocker) Lock(ctx context.Context) (func(), error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a connection to lock migrations: %w", err)
+	}
+
+	lockCtx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+	if _, err := conn.ExecContext(lockCtx, "SELECT pg_advisory_lock($1)", advisoryLockKey); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to acquire the migration lock within %s: %w", l.timeout, err)
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", advisoryLockKey); err != nil {
+			slog.Warn("Failed to release the migration lock, closing its connection", "error", err)
+		}
+		_ = conn.Close()
+	}, nil
+}
+
+// Close closes the connection pool of the locker
+func (l *PostgresLocker) Close() error {
+	return l.db.Close()
+}
@@ -0,0 +1,92 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresLocker_Lock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).
+		WithArgs(advisoryLockKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).
+		WithArgs(advisoryLockKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	unlock, err := NewPostgresLocker(db, time.Second).Lock(context.Background())
+	require.NoError(t, err)
+	unlock()
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresLocker_LockError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).
+		WithArgs(advisoryLockKey).
+		WillReturnError(context.DeadlineExceeded)
+
+	unlock, err := NewPostgresLocker(db, time.Second).Lock(context.Background())
+	assert.Nil(t, unlock)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "failed to acquire the migration lock within 1s")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+type stubLocker struct {
+	err      error
+	locked   int
+	unlocked int
+}
+
+func (l *stubLocker) Lock(_ context.Context) (func(), error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	l.locked++
+	return func() { l.unlocked++ }, nil
+}
+
+func TestMigrationRunner_Lock(t *testing.T) {
+	t.Run("without a locker", func(t *testing.T) {
+		unlock, err := (&MigrationRunner{}).lock(context.Background())
+		require.NoError(t, err)
+		unlock()
+	})
+
+	t.Run("with a locker", func(t *testing.T) {
+		locker := &stubLocker{}
+		runner := (&MigrationRunner{}).WithLocker(locker)
+
+		unlock, err := runner.lock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, locker.locked)
+		assert.Equal(t, 0, locker.unlocked)
+
+		unlock()
+		assert.Equal(t, 1, locker.unlocked)
+	})
+
+	t.Run("lock error", func(t *testing.T) {
+		locker := &stubLocker{err: errors.New("timeout")}
+		runner := (&MigrationRunner{}).WithLocker(locker)
+
+		_, err := runner.lock(context.Background())
+		assert.EqualError(t, err, "timeout")
+	})
+}
@@ -12,6 +12,8 @@ import (
 
 type MigrationRunner struct {
 	client *atlasexec.Client
+	// locker, when set, is held while migrations are applied or reverted
+	locker Locker
 	env    string
 }
 
@@ -43,16 +45,52 @@ func NewMigrationRunner(env string) (*MigrationRunner, error) {
 	}, nil
 }
 
+// WithLocker makes the runner hold the lock of locker while it applies or reverts migrations, so
+// that replicas booting at the same time run them one after the other
+func (mr *MigrationRunner) WithLocker(locker Locker) *MigrationRunner {
+	mr.locker = locker
+	return mr
+}
+
+// lock takes the lock of the locker of the runner, if it has one, and returns the function
+// releasing it
+func (mr *MigrationRunner) lock(ctx context.Context) (func(), error) {
+	if mr.locker == nil {
+		return func() {}, nil
+	}
+
+	slog.Info("Acquiring the migration lock", "environment", mr.env)
+	unlock, err := mr.locker.Lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Acquired the migration lock", "environment", mr.env)
+	return unlock, nil
+}
+
 // RunMigrations executes pending migrations
 func (mr *MigrationRunner) RunMigrations(ctx context.Context) error {
+	_, err := mr.Up(ctx, 0)
+	return err
+}
+
+// Up applies amount pending migrations, or all of them when amount is 0
+func (mr *MigrationRunner) Up(ctx context.Context, amount uint64) (*atlasexec.MigrateApply, error) {
+	unlock, err := mr.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	slog.Info("Running database migrations", "environment", mr.env)
 
 	// Apply pending migrations
 	result, err := mr.client.MigrateApply(ctx, &atlasexec.MigrateApplyParams{
-		Env: mr.env,
+		Env:    mr.env,
+		Amount: amount,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to apply migrations: %w", err)
+		return nil, fmt.Errorf("failed to apply migrations: %w", err)
 	}
 
 	if len(result.Applied) > 0 {
@@ -64,6 +102,54 @@ func (mr *MigrationRunner) RunMigrations(ctx context.Context) error {
 		slog.Info("No pending migrations found")
 	}
 
+	return result, nil
+}
+
+// Down reverts the last amount applied migrations, using the down statements Atlas computes
+// against the dev database of the environment
+func (mr *MigrationRunner) Down(ctx context.Context, amount uint64) (*atlasexec.MigrateDown, error) {
+	unlock, err := mr.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	slog.Info("Reverting database migrations", "environment", mr.env, "count", amount)
+
+	result, err := mr.client.MigrateDown(ctx, &atlasexec.MigrateDownParams{
+		Env:    mr.env,
+		Amount: amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert migrations: %w", err)
+	}
+	if result.Error != "" {
+		return result, fmt.Errorf("failed to revert migrations: %s", result.Error)
+	}
+
+	slog.Info("Successfully reverted migrations", "count", len(result.Reverted))
+	return result, nil
+}
+
+// Plan returns the amount pending migrations (all of them when amount is 0) that Up would apply,
+// with their statements, without applying them
+func (mr *MigrationRunner) Plan(ctx context.Context, amount uint64) (*atlasexec.MigrateApply, error) {
+	result, err := mr.client.MigrateApply(ctx, &atlasexec.MigrateApplyParams{
+		Env:    mr.env,
+		Amount: amount,
+		DryRun: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan migrations: %w", err)
+	}
+	return result, nil
+}
+
+// Hash recomputes the checksums of the migration directory (atlas.sum) after a file is added
+func (mr *MigrationRunner) Hash(ctx context.Context) error {
+	if err := mr.client.MigrateHash(ctx, &atlasexec.MigrateHashParams{Env: mr.env}); err != nil {
+		return fmt.Errorf("failed to hash migrations: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Dir is the migration directory of every environment in atlas.hcl, relative to the be directory
+// the server and the commands run from
+const Dir = "migrations"
+
+// appliedVersionQuery reads the last fully applied version from the revisions table Atlas keeps
+// in its own schema
+const appliedVersionQuery = `SELECT version FROM atlas_schema_revisions.atlas_schema_revisions
+WHERE applied = total ORDER BY version DESC LIMIT 1`
+
+// ErrPendingMigrations is returned by CheckApplied when the database is behind the migration files
+var ErrPendingMigrations = errors.New("migrations are pending")
+
+// CheckApplied returns ErrPendingMigrations when dir has migrations later than the last one
+// applied to db. A missing directory has nothing to apply.
+func CheckApplied(ctx context.Context, db *sql.DB, dir string) error {
+	latest, err := latestVersion(dir)
+	if err != nil {
+		return err
+	}
+	if latest == 0 {
+		return nil
+	}
+
+	var version string
+	err = db.QueryRowContext(ctx, appliedVersionQuery).Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to read the applied migration version: %w", err)
+	}
+	applied, _ := strconv.ParseUint(version, 10, 64)
+	if applied < latest {
+		return fmt.Errorf("%w: the database is at version %d, the migrations at %d", ErrPendingMigrations, applied, latest)
+	}
+	return nil
+}
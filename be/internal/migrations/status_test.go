@@ -0,0 +1,85 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckApplied(t *testing.T) {
+	tests := []struct {
+		rows        *sqlmock.Rows
+		queryErr    error
+		name        string
+		expectedErr string
+		pending     bool
+	}{
+		{
+			name: "latest migration applied",
+			rows: sqlmock.NewRows([]string{"version"}).AddRow("20250127000015"),
+		},
+		{
+			name:        "migrations pending",
+			rows:        sqlmock.NewRows([]string{"version"}).AddRow("20250127000014"),
+			expectedErr: "migrations are pending: the database is at version 20250127000014, the migrations at 20250127000015",
+			pending:     true,
+		},
+		{
+			name:        "nothing applied",
+			rows:        sqlmock.NewRows([]string{"version"}),
+			expectedErr: "migrations are pending: the database is at version 0, the migrations at 20250127000015",
+			pending:     true,
+		},
+		{
+			name:        "revisions table unreadable",
+			queryErr:    errors.New(`relation "atlas_schema_revisions.atlas_schema_revisions" does not exist`),
+			expectedErr: "failed to read the applied migration version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, file := range []string{"20250127000014_add_public_ids.sql", "20250127000015_add_webhook_endpoints.sql"} {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, file), nil, 0o600))
+			}
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			query := mock.ExpectQuery(regexp.QuoteMeta("SELECT version FROM atlas_schema_revisions.atlas_schema_revisions"))
+			if tt.queryErr != nil {
+				query.WillReturnError(tt.queryErr)
+			} else {
+				query.WillReturnRows(tt.rows)
+			}
+
+			err = CheckApplied(context.Background(), db, dir)
+
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				assert.Equal(t, tt.pending, errors.Is(err, ErrPendingMigrations))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCheckApplied_NoMigrations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	assert.NoError(t, CheckApplied(context.Background(), db, filepath.Join(t.TempDir(), "missing")))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
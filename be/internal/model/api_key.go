@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+// APIKey authenticates service-to-service calls. Only a hash of the key is stored;
+// the key itself is shown once when it is issued.
+type APIKey struct {
+	CreatedAt       time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	LastUsedAt      *time.Time `gorm:"column:last_used_at" json:"last_used_at,omitempty"`
+	RevokedAt       *time.Time `gorm:"column:revoked_at" json:"revoked_at,omitempty"`
+	PublicID        string     `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	Name            string     `gorm:"column:name;size:100;not null" json:"name"`
+	KeyPrefix       string     `gorm:"column:key_prefix;size:16;not null" json:"key_prefix"`
+	KeyHash         string     `gorm:"column:key_hash;size:64;not null;uniqueIndex" json:"-"`
+	CreatedBy       User       `gorm:"foreignKey:CreatedByUserID;references:ID" json:"-"`
+	ID              uint       `gorm:"primarykey" json:"-"`
+	CreatedByUserID uint       `gorm:"column:created_by_user_id;not null" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new API key
+func (k *APIKey) BeforeCreate(*gorm.DB) error {
+	if k.PublicID == "" {
+		k.PublicID = publicid.New(publicid.PrefixAPIKey)
+	}
+	return nil
+}
+
+// IsRevoked reports whether the key has been revoked
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// TableName specifies the table name for APIKey model
+func (APIKey) TableName() string {
+	return "api_keys"
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+// AuditLog records a security-sensitive action. The actor and target are public IDs rather than
+// foreign keys, so that the trail outlives the users and API keys it mentions.
+type AuditLog struct {
+	CreatedAt time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	ActorID   *string   `gorm:"column:actor_id;size:32" json:"actor_id,omitempty"`
+	TargetID  *string   `gorm:"column:target_id;size:32" json:"target_id,omitempty"`
+	IPAddress *string   `gorm:"column:ip_address;size:45" json:"ip_address,omitempty"`
+	UserAgent *string   `gorm:"column:user_agent" json:"user_agent,omitempty"`
+	PublicID  string    `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	Action    string    `gorm:"column:action;size:50;not null" json:"action"`
+	Metadata  string    `gorm:"column:metadata;default:{};not null" json:"-"`
+	ID        uint      `gorm:"primarykey" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new audit log entry
+func (a *AuditLog) BeforeCreate(*gorm.DB) error {
+	if a.PublicID == "" {
+		a.PublicID = publicid.New(publicid.PrefixAuditLog)
+	}
+	return nil
+}
+
+// TableName specifies the table name for AuditLog model
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DataExportStatusPending exports are waiting for the export worker
+	DataExportStatusPending = "pending"
+	// DataExportStatusProcessing exports are being assembled by an export worker
+	DataExportStatusProcessing = "processing"
+	// DataExportStatusReady exports can be downloaded until they expire
+	DataExportStatusReady = "ready"
+	// DataExportStatusFailed exports could not be assembled
+	DataExportStatusFailed = "failed"
+)
+
+// DataExport is an archive of a user's data that the user requested, assembled in the background.
+// Once ready, the archive is kept in storage under StorageKey until ExpiresAt.
+type DataExport struct {
+	CreatedAt   time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at" json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time `gorm:"column:expires_at" json:"expires_at,omitempty"`
+	StorageKey  *string    `gorm:"column:storage_key;size:255" json:"-"`
+	SizeBytes   *int64     `gorm:"column:size_bytes" json:"size_bytes,omitempty"`
+	LastError   *string    `gorm:"column:last_error" json:"-"`
+	PublicID    string     `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	Status      string     `gorm:"column:status;size:20;default:pending;not null" json:"status"`
+	ID          uint       `gorm:"primarykey" json:"-"`
+	UserID      uint       `gorm:"column:user_id;not null" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new data export
+func (e *DataExport) BeforeCreate(*gorm.DB) error {
+	if e.PublicID == "" {
+		e.PublicID = publicid.New(publicid.PrefixDataExport)
+	}
+	return nil
+}
+
+// TableName specifies the table name for DataExport model
+func (DataExport) TableName() string {
+	return "data_exports"
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// IdempotencyKey records a request sent with an Idempotency-Key header and, once it has succeeded,
+// its response, which is replayed to retries of the request until the key expires. ResponseStatus
+// is nil while the first request is still being processed.
+type IdempotencyKey struct {
+	ExpiresAt       time.Time `gorm:"column:expires_at;not null" json:"expires_at"`
+	CreatedAt       time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	ResponseStatus  *int      `gorm:"column:response_status" json:"response_status,omitempty"`
+	Scope           string    `gorm:"column:scope;size:300;not null" json:"scope"`
+	Key             string    `gorm:"column:idempotency_key;size:255;not null" json:"key"`
+	RequestHash     string    `gorm:"column:request_hash;size:64;not null" json:"-"`
+	ResponseHeaders string    `gorm:"column:response_headers;default:{};not null" json:"-"`
+	ResponseBody    string    `gorm:"column:response_body;not null" json:"-"`
+	ID              uint      `gorm:"primarykey" json:"-"`
+}
+
+// IsCompleted reports whether the response of the first request has been stored
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.ResponseStatus != nil
+}
+
+// TableName specifies the table name for IdempotencyKey model
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
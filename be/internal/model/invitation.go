@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+// Invitation lets the owner of an email address sign up, once, before it expires. The invite
+// token emailed to them is signed and names the invitation, so only its state is stored.
+type Invitation struct {
+	ExpiresAt       time.Time  `gorm:"column:expires_at;not null" json:"expires_at"`
+	CreatedAt       time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	AcceptedAt      *time.Time `gorm:"column:accepted_at" json:"accepted_at,omitempty"`
+	AcceptedUserID  *uint      `gorm:"column:accepted_user_id" json:"-"`
+	PublicID        string     `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	Email           string     `gorm:"column:email;size:255;not null;index" json:"email"`
+	ID              uint       `gorm:"primarykey" json:"-"`
+	InvitedByUserID uint       `gorm:"column:invited_by_user_id;not null" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new invitation
+func (i *Invitation) BeforeCreate(*gorm.DB) error {
+	if i.PublicID == "" {
+		i.PublicID = publicid.New(publicid.PrefixInvitation)
+	}
+	return nil
+}
+
+// IsUsable reports whether the invitation can still be accepted at the given time
+func (i *Invitation) IsUsable(now time.Time) bool {
+	return i.AcceptedAt == nil && now.Before(i.ExpiresAt)
+}
+
+// TableName specifies the table name for Invitation model
+func (Invitation) TableName() string {
+	return "invitations"
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+// Notification is an event shown to a user, such as one of their sessions being revoked. Data is
+// the JSON object describing it.
+type Notification struct {
+	CreatedAt time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	PublicID  string    `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	Type      string    `gorm:"column:type;size:50;not null" json:"type"`
+	Data      string    `gorm:"column:data;default:{};not null" json:"-"`
+	ID        uint      `gorm:"primarykey" json:"-"`
+	UserID    uint      `gorm:"column:user_id;not null" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new notification
+func (n *Notification) BeforeCreate(*gorm.DB) error {
+	if n.PublicID == "" {
+		n.PublicID = publicid.New(publicid.PrefixNotification)
+	}
+	return nil
+}
+
+// TableName specifies the table name for Notification model
+func (Notification) TableName() string {
+	return "notifications"
+}
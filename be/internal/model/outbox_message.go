@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+)
+
+const (
+	// OutboxKindEmail messages carry a rendered email to deliver through the mailer
+	OutboxKindEmail = "email"
+	// OutboxKindWebhook messages carry an event to post to a webhook endpoint
+	OutboxKindWebhook = "webhook"
+
+	// OutboxStatusPending messages are waiting for their next delivery attempt
+	OutboxStatusPending = "pending"
+	// OutboxStatusSent messages have been delivered
+	OutboxStatusSent = "sent"
+	// OutboxStatusFailed messages were rejected or ran out of attempts and will not be retried
+	OutboxStatusFailed = "failed"
+)
+
+// OutboxMessage is a message to deliver asynchronously. It is written in the same transaction
+// as the state change it belongs to and delivered later by the outbox dispatcher.
+type OutboxMessage struct {
+	CreatedAt     time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;default:CURRENT_TIMESTAMP;not null" json:"next_attempt_at"`
+	SentAt        *time.Time `gorm:"column:sent_at" json:"sent_at,omitempty"`
+	LastError     *string    `gorm:"column:last_error" json:"last_error,omitempty"`
+	Kind          string     `gorm:"column:kind;size:50;not null" json:"kind"`
+	Payload       string     `gorm:"column:payload;not null" json:"-"`
+	Status        string     `gorm:"column:status;size:20;default:pending;not null" json:"status"`
+	ID            uint       `gorm:"primarykey" json:"id"`
+	Attempts      int        `gorm:"column:attempts;default:0;not null" json:"attempts"`
+}
+
+// TableName specifies the table name for OutboxMessage model
+func (OutboxMessage) TableName() string {
+	return "outbox_messages"
+}
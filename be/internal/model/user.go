@@ -2,20 +2,83 @@ package model
 
 import (
 	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// RoleUser is the role of regular users
+	RoleUser = "user"
+	// RoleAdmin is the role of users allowed to call the admin API
+	RoleAdmin = "admin"
 )
 
 type User struct {
-	CreatedAt      time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
-	UpdatedAt      time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
-	DeletedAt      *time.Time `gorm:"column:deleted_at" json:"-"`
-	ProviderUserID *string    `gorm:"column:provider_user_id;size:255" json:"provider_user_id,omitempty"`
-	Email          *string    `gorm:"column:email;size:255" json:"email,omitempty"`
-	PasswordHash   *string    `gorm:"column:password_hash;size:255" json:"-"`
-	ProviderType   string     `gorm:"column:provider_type;size:20;not null" json:"provider_type"`
-	DisplayName    string     `gorm:"column:display_name;size:100;not null" json:"display_name"`
-	ID             uint       `gorm:"primarykey" json:"id"`
-	EmailVerified  bool       `gorm:"column:email_verified;default:false;not null" json:"email_verified"`
-	IsDeleted      bool       `gorm:"column:is_deleted;default:false;not null" json:"-"`
+	CreatedAt         time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt         time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	DeletedAt         *time.Time `gorm:"column:deleted_at" json:"-"`
+	PasswordChangedAt *time.Time `gorm:"column:password_changed_at" json:"password_changed_at,omitempty"`
+	PurgedAt          *time.Time `gorm:"column:purged_at" json:"-"`
+	// EmailChangedAt is when the user last changed their email address, nil if they never did
+	EmailChangedAt *time.Time `gorm:"column:email_changed_at" json:"-"`
+	// PendingEmailExpiresAt is when the confirmation links of the pending email change expire
+	PendingEmailExpiresAt *time.Time `gorm:"column:pending_email_expires_at" json:"-"`
+	ProviderUserID        *string    `gorm:"column:provider_user_id;size:255" json:"provider_user_id,omitempty"`
+	Email                 *string    `gorm:"column:email;size:255" json:"email,omitempty"`
+	// PendingEmail is the address the user asked to change their email to, until both the current
+	// and the new address confirmed it
+	PendingEmail *string `gorm:"column:pending_email;size:255" json:"-"`
+	// PendingEmailNonce is drawn anew for each requested email change and carried by its
+	// confirmation links, so that the links of a replaced or cancelled change confirm nothing
+	PendingEmailNonce *string `gorm:"column:pending_email_nonce;size:64" json:"-"`
+	PasswordHash      *string `gorm:"column:password_hash;size:255" json:"-"`
+	AvatarURL         *string `gorm:"column:avatar_url;size:1024" json:"avatar_url,omitempty"`
+	PublicID          string  `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	ProviderType      string  `gorm:"column:provider_type;size:20;not null" json:"provider_type"`
+	DisplayName       string  `gorm:"column:display_name;size:100;not null" json:"display_name"`
+	Role              string  `gorm:"column:role;size:20;default:user;not null" json:"role"`
+	ID                uint    `gorm:"primarykey" json:"-"`
+	// Version is incremented on every change, so that updates made from a stale copy are refused
+	Version        uint `gorm:"column:version;default:1;not null" json:"-"`
+	HideFromSearch bool `gorm:"column:hide_from_search;default:false;not null" json:"-"`
+	EmailVerified  bool `gorm:"column:email_verified;default:false;not null" json:"email_verified"`
+	IsDeleted      bool `gorm:"column:is_deleted;default:false;not null" json:"-"`
+	IsDisabled     bool `gorm:"column:is_disabled;default:false;not null" json:"is_disabled"`
+	// The pending email change completes once both addresses confirmed it, then ends the user's
+	// sessions if PendingEmailSignOut is set
+	PendingEmailOldConfirmed bool `gorm:"column:pending_email_old_confirmed;default:false;not null" json:"-"`
+	PendingEmailNewConfirmed bool `gorm:"column:pending_email_new_confirmed;default:false;not null" json:"-"`
+	PendingEmailSignOut      bool `gorm:"column:pending_email_sign_out;default:false;not null" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new user
+func (u *User) BeforeCreate(*gorm.DB) error {
+	if u.PublicID == "" {
+		u.PublicID = publicid.New(publicid.PrefixUser)
+	}
+	return nil
+}
+
+// IsAdmin reports whether the user may call the admin API
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+// PasswordChangedSince reports whether the password was set or changed after t
+func (u *User) PasswordChangedSince(t time.Time) bool {
+	return u.PasswordChangedAt != nil && u.PasswordChangedAt.After(t)
+}
+
+// ClearPendingEmail drops the pending email change
+func (u *User) ClearPendingEmail() {
+	u.PendingEmail = nil
+	u.PendingEmailNonce = nil
+	u.PendingEmailExpiresAt = nil
+	u.PendingEmailOldConfirmed = false
+	u.PendingEmailNewConfirmed = false
+	u.PendingEmailSignOut = false
 }
 
 // TableName specifies the table name for User model
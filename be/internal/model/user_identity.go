@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+// UserIdentity links an additional OAuth provider account to a user, so that the user
+// can log in with it alongside the provider they signed up with
+type UserIdentity struct {
+	CreatedAt      time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	Email          *string   `gorm:"column:email;size:255" json:"email,omitempty"`
+	Provider       string    `gorm:"column:provider;size:20;not null" json:"provider"`
+	ProviderUserID string    `gorm:"column:provider_user_id;size:255;not null" json:"provider_user_id"`
+	ID             uint      `gorm:"primarykey" json:"id"`
+	UserID         uint      `gorm:"column:user_id;not null;index" json:"user_id"`
+}
+
+// TableName specifies the table name for UserIdentity model
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
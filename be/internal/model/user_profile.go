@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+)
+
+// UserProfile stores optional onboarding answers that extend a user's profile
+type UserProfile struct {
+	CreatedAt   time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	Role        *string   `gorm:"column:role;size:50" json:"role,omitempty"`
+	TeamSize    *string   `gorm:"column:team_size;size:20" json:"team_size,omitempty"`
+	IntendedUse *string   `gorm:"column:intended_use;size:50" json:"intended_use,omitempty"`
+	ID          uint      `gorm:"primarykey" json:"id"`
+	UserID      uint      `gorm:"column:user_id;not null;uniqueIndex" json:"user_id"`
+	// Version is incremented on every change, so that updates made from a stale copy are refused
+	Version uint `gorm:"column:version;default:1;not null" json:"-"`
+}
+
+// TableName specifies the table name for UserProfile model
+func (UserProfile) TableName() string {
+	return "user_profiles"
+}
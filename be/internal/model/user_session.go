@@ -3,21 +3,31 @@ package model
 import (
 	"time"
 
+	"strikepad-backend/internal/publicid"
+
 	"gorm.io/gorm"
 )
 
-// UserSession represents a user session with tokens
+// UserSession represents a user session with tokens. Only the SHA-256 hashes of the tokens are
+// stored (auth.HashToken), so that the table holds no usable bearer tokens. LastUsedAt is when the
+// session last authenticated a request, recorded at most once per activity interval.
 type UserSession struct {
 	AccessTokenExpiresAt  time.Time      `gorm:"not null" json:"access_token_expires_at"`
 	RefreshTokenExpiresAt time.Time      `gorm:"not null" json:"refresh_token_expires_at"`
 	CreatedAt             time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdatedAt             time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
 	DeletedAt             gorm.DeletedAt `json:"deleted_at,omitempty"`
-	AccessToken           string         `gorm:"type:text;not null" json:"access_token"`
-	RefreshToken          string         `gorm:"column:refresh_token;type:text" json:"refresh_token"`
+	PublicID              string         `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	AccessTokenHash       string         `gorm:"column:access_token_hash;type:char(64);not null;index" json:"-"`
+	RefreshTokenHash      string         `gorm:"column:refresh_token_hash;type:char(64);index" json:"-"`
+	IPAddress             string         `gorm:"column:ip_address;size:45" json:"ip_address"`
+	UserAgent             string         `gorm:"column:user_agent;type:text" json:"user_agent"`
+	DeviceName            *string        `gorm:"column:device_name;size:100" json:"device_name,omitempty"`
+	Country               *string        `gorm:"column:country;size:2" json:"country,omitempty"`
+	LastUsedAt            *time.Time     `gorm:"column:last_used_at" json:"last_used_at,omitempty"`
 	User                  User           `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
-	ID                    uint           `gorm:"primarykey" json:"id"`
-	UserID                uint           `gorm:"not null;index" json:"user_id"`
+	ID                    uint           `gorm:"primarykey" json:"-"`
+	UserID                uint           `gorm:"not null;index" json:"-"`
 	IsDeleted             bool           `gorm:"default:false" json:"is_deleted"`
 }
 
@@ -26,14 +36,37 @@ func (UserSession) TableName() string {
 	return "user_sessions"
 }
 
-// IsAccessTokenValid checks if the access token is still valid
-func (us *UserSession) IsAccessTokenValid() bool {
-	return time.Now().Before(us.AccessTokenExpiresAt) && !us.IsDeleted
+// BeforeCreate assigns the public ID of a new session
+func (us *UserSession) BeforeCreate(*gorm.DB) error {
+	if us.PublicID == "" {
+		us.PublicID = publicid.New(publicid.PrefixSession)
+	}
+	return nil
+}
+
+// IsAccessTokenValid checks if the access token is still valid at now
+func (us *UserSession) IsAccessTokenValid(now time.Time) bool {
+	return now.Before(us.AccessTokenExpiresAt) && !us.IsDeleted
+}
+
+// IsRefreshTokenValid checks if the refresh token is still valid at now
+func (us *UserSession) IsRefreshTokenValid(now time.Time) bool {
+	return now.Before(us.RefreshTokenExpiresAt) && !us.IsDeleted
+}
+
+// LastActivityAt returns when the session was last used, or last refreshed if its use has not been
+// recorded
+func (us *UserSession) LastActivityAt() time.Time {
+	if us.LastUsedAt != nil && us.LastUsedAt.After(us.UpdatedAt) {
+		return *us.LastUsedAt
+	}
+	return us.UpdatedAt
 }
 
-// IsRefreshTokenValid checks if the refresh token is still valid
-func (us *UserSession) IsRefreshTokenValid() bool {
-	return time.Now().Before(us.RefreshTokenExpiresAt) && !us.IsDeleted
+// IsIdle reports whether the session has not been used for idleTimeout at now. Sessions never idle
+// when idleTimeout is zero.
+func (us *UserSession) IsIdle(now time.Time, idleTimeout time.Duration) bool {
+	return idleTimeout > 0 && !now.Before(us.LastActivityAt().Add(idleTimeout))
 }
 
 // Invalidate marks the session as deleted
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+// WaitlistEntry represents an email address waiting for access, and the invite code minted for it
+type WaitlistEntry struct {
+	CreatedAt  time.Time  `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	InvitedAt  *time.Time `gorm:"column:invited_at" json:"invited_at,omitempty"`
+	InviteCode *string    `gorm:"column:invite_code;size:32;uniqueIndex" json:"invite_code,omitempty"`
+	PublicID   string     `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	Email      string     `gorm:"column:email;size:255;not null;uniqueIndex" json:"email"`
+	ID         uint       `gorm:"primarykey" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new waitlist entry
+func (e *WaitlistEntry) BeforeCreate(*gorm.DB) error {
+	if e.PublicID == "" {
+		e.PublicID = publicid.New(publicid.PrefixWaitlistEntry)
+	}
+	return nil
+}
+
+// TableName specifies the table name for WaitlistEntry model
+func (WaitlistEntry) TableName() string {
+	return "waitlist_entries"
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/publicid"
+
+	"gorm.io/gorm"
+)
+
+// webhookAllEvents subscribes an endpoint to every event type
+const webhookAllEvents = "*"
+
+// WebhookEndpoint is a customer URL that receives signed event deliveries. The secret is
+// stored as is because every delivery is signed with it.
+type WebhookEndpoint struct {
+	CreatedAt       time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;not null" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;not null" json:"updated_at"`
+	PublicID        string    `gorm:"column:public_id;size:32;not null;uniqueIndex" json:"public_id"`
+	URL             string    `gorm:"column:url;size:2048;not null" json:"url"`
+	Secret          string    `gorm:"column:secret;size:100;not null" json:"-"`
+	EventTypes      string    `gorm:"column:event_types;size:500;not null" json:"event_types"`
+	ID              uint      `gorm:"primarykey" json:"-"`
+	CreatedByUserID uint      `gorm:"column:created_by_user_id;not null" json:"-"`
+}
+
+// BeforeCreate assigns the public ID of a new webhook endpoint
+func (w *WebhookEndpoint) BeforeCreate(*gorm.DB) error {
+	if w.PublicID == "" {
+		w.PublicID = publicid.New(publicid.PrefixWebhook)
+	}
+	return nil
+}
+
+// EventTypeList returns the event types the endpoint subscribes to
+func (w *WebhookEndpoint) EventTypeList() []string {
+	if w.EventTypes == "" {
+		return []string{}
+	}
+	return strings.Split(w.EventTypes, ",")
+}
+
+// Subscribes reports whether the endpoint receives events of eventType
+func (w *WebhookEndpoint) Subscribes(eventType string) bool {
+	types := w.EventTypeList()
+	return slices.Contains(types, webhookAllEvents) || slices.Contains(types, eventType)
+}
+
+// TableName specifies the table name for WebhookEndpoint model
+func (WebhookEndpoint) TableName() string {
+	return "webhook_endpoints"
+}
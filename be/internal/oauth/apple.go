@@ -0,0 +1,162 @@
+package oauth
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// appleIssuer is the issuer of Apple ID tokens and the audience of client secrets
+	appleIssuer = "https://appleid.apple.com"
+	// appleAuthURL and appleTokenURL are Sign in with Apple's OAuth endpoints
+	appleAuthURL  = appleIssuer + "/auth/authorize"
+	appleTokenURL = appleIssuer + "/auth/token"
+	// appleJWKSURL is where Apple publishes the keys used to sign ID tokens
+	appleJWKSURL = appleIssuer + "/auth/keys"
+	// appleClientSecretTTL is how long the client secret signed for a code exchange is valid
+	appleClientSecretTTL = 5 * time.Minute
+)
+
+// appleIDTokenClaims represents the claims of an Apple-issued ID token
+type appleIDTokenClaims struct {
+	// EmailVerified is the string "true" in some tokens and a boolean in others
+	EmailVerified any `json:"email_verified"`
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+}
+
+// AppleProvider authenticates users with Sign in with Apple. Apple has no user info endpoint:
+// users are identified by the ID token, which ExchangeCode returns in place of the access token,
+// and which apps signing in natively send as the access token.
+type AppleProvider struct {
+	keys       *jwksKeySet
+	config     *oauth2.Config
+	privateKey *ecdsa.PrivateKey
+	teamID     string
+	keyID      string
+}
+
+// NewAppleProvider creates an Apple provider from APPLE_CLIENT_ID (the Services ID), APPLE_TEAM_ID,
+// APPLE_KEY_ID, APPLE_PRIVATE_KEY (the PEM of the key, which signs the client secret of each code
+// exchange) and APPLE_REDIRECT_URL. Without them the provider rejects every request.
+func NewAppleProvider() *AppleProvider {
+	provider := &AppleProvider{
+		keys:   newJWKSKeySet("Apple", appleJWKSURL),
+		teamID: config.GetEnv("APPLE_TEAM_ID", ""),
+		keyID:  config.GetEnv("APPLE_KEY_ID", ""),
+	}
+
+	clientID := config.GetEnv("APPLE_CLIENT_ID", "")
+	privateKey := config.GetEnv("APPLE_PRIVATE_KEY", "")
+	if clientID == "" || provider.teamID == "" || provider.keyID == "" || privateKey == "" {
+		return provider
+	}
+	// Environment files cannot hold line breaks, so they may be escaped
+	key, err := jwt.ParseECPrivateKeyFromPEM([]byte(strings.ReplaceAll(privateKey, `\n`, "\n")))
+	if err != nil {
+		slog.Error("Invalid APPLE_PRIVATE_KEY, Sign in with Apple is disabled", "error", err)
+		return provider
+	}
+
+	provider.privateKey = key
+	provider.config = &oauth2.Config{
+		ClientID:    clientID,
+		RedirectURL: config.GetEnv("APPLE_REDIRECT_URL", ""),
+		Scopes:      []string{"name", "email"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   appleAuthURL,
+			TokenURL:  appleTokenURL,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+	return provider
+}
+
+// ProviderName implements Provider
+func (p *AppleProvider) ProviderName() string {
+	return "apple"
+}
+
+// ExchangeCode implements Provider, returning the ID token of the exchanged code
+func (p *AppleProvider) ExchangeCode(code, codeVerifier string) (string, error) {
+	if p.config == nil {
+		return "", ErrProviderNotConfigured
+	}
+
+	clientSecret, err := p.clientSecret(time.Now())
+	if err != nil {
+		return "", err
+	}
+	exchangeConfig := *p.config
+	exchangeConfig.ClientSecret = clientSecret
+
+	token, err := exchangeToken(&exchangeConfig, code, codeVerifier)
+	if err != nil {
+		return "", err
+	}
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return "", errors.New("apple token response has no ID token")
+	}
+	return idToken, nil
+}
+
+// GetUserInfo implements Provider by verifying an ID token issued for APPLE_CLIENT_ID. Apple
+// leaves the name out of ID tokens, so the user is named after their email address.
+func (p *AppleProvider) GetUserInfo(idToken string) (*UserInfo, error) {
+	if p.config == nil {
+		return nil, ErrProviderNotConfigured
+	}
+	if strings.TrimSpace(idToken) == "" {
+		return nil, errors.New("ID token is empty")
+	}
+
+	claims := &appleIDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, p.keys.keyFunc,
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithIssuer(appleIssuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(idTokenLeeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Apple ID token: %w", err)
+	}
+	if claims.Subject == "" || claims.Email == "" {
+		return nil, errors.New("apple ID token is missing subject or email")
+	}
+
+	name, _, _ := strings.Cut(claims.Email, "@")
+	return &UserInfo{
+		ID:            claims.Subject,
+		Email:         claims.Email,
+		Name:          name,
+		VerifiedEmail: claims.EmailVerified == true || claims.EmailVerified == "true",
+	}, nil
+}
+
+// clientSecret signs the short-lived JWT that Apple takes as the client secret of a code exchange
+func (p *AppleProvider) clientSecret(now time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    p.teamID,
+		Subject:   p.config.ClientID,
+		Audience:  jwt.ClaimStrings{appleIssuer},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appleClientSecretTTL)),
+	})
+	token.Header["kid"] = p.keyID
+	secret, err := token.SignedString(p.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign Apple client secret: %w", err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,111 @@
+package oauth
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"strikepad-backend/internal/config"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// githubAuthURL and githubTokenURL are GitHub's OAuth endpoints
+	githubAuthURL  = "https://github.com/login/oauth/authorize"
+	githubTokenURL = "https://github.com/login/oauth/access_token"
+	// githubAPIURL is the base URL of the GitHub REST API
+	githubAPIURL = "https://api.github.com"
+)
+
+// GitHubProvider authenticates users with GitHub OAuth apps
+type GitHubProvider struct {
+	httpClient *http.Client
+	config     *oauth2.Config
+	apiURL     string
+}
+
+// NewGitHubProvider creates a GitHub provider from GITHUB_CLIENT_ID, GITHUB_CLIENT_SECRET
+// and GITHUB_REDIRECT_URL. Without a client ID the provider rejects every request.
+func NewGitHubProvider() *GitHubProvider {
+	provider := &GitHubProvider{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		apiURL:     githubAPIURL,
+	}
+
+	clientID := config.GetEnv("GITHUB_CLIENT_ID", "")
+	clientSecret := config.GetEnv("GITHUB_CLIENT_SECRET", "")
+	if clientID != "" && clientSecret != "" {
+		provider.config = &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  config.GetEnv("GITHUB_REDIRECT_URL", ""),
+			Scopes:       []string{"read:user", "user:email"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   githubAuthURL,
+				TokenURL:  githubTokenURL,
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		}
+	}
+	return provider
+}
+
+// ProviderName implements Provider
+func (p *GitHubProvider) ProviderName() string {
+	return "github"
+}
+
+// ExchangeCode implements Provider
+func (p *GitHubProvider) ExchangeCode(code, codeVerifier string) (string, error) {
+	return exchangeCode(p.config, code, codeVerifier)
+}
+
+// GetUserInfo implements Provider. GitHub profiles may hide the email address,
+// so the primary verified address is looked up separately.
+func (p *GitHubProvider) GetUserInfo(accessToken string) (*UserInfo, error) {
+	if p.config == nil {
+		return nil, ErrProviderNotConfigured
+	}
+
+	var user struct {
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+		ID        int64  `json:"id"`
+	}
+	if err := getJSON(p.httpClient, p.apiURL+"/user", accessToken, &user); err != nil {
+		return nil, fmt.Errorf("failed to get GitHub user: %w", err)
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(p.httpClient, p.apiURL+"/user/emails", accessToken, &emails); err != nil {
+		return nil, fmt.Errorf("failed to get GitHub user emails: %w", err)
+	}
+
+	info := &UserInfo{
+		ID:      strconv.FormatInt(user.ID, 10),
+		Name:    user.Name,
+		Picture: user.AvatarURL,
+	}
+	if info.Name == "" {
+		info.Name = user.Login
+	}
+	for _, email := range emails {
+		if email.Primary {
+			info.Email = email.Email
+			info.VerifiedEmail = email.Verified
+			break
+		}
+	}
+	if info.Email == "" {
+		return nil, fmt.Errorf("no primary email address on GitHub account %s", info.ID)
+	}
+
+	return info, nil
+}
@@ -6,28 +6,60 @@ import (
 	"net/http"
 	"strings"
 
-	"google.golang.org/api/oauth2/v2"
+	"strikepad-backend/internal/config"
+
+	"golang.org/x/oauth2"
+	googleoauth2 "google.golang.org/api/oauth2/v2"
 	"google.golang.org/api/option"
 )
 
-type GoogleUserInfo struct {
-	ID            string `json:"id"`
-	Email         string `json:"email"`
-	Name          string `json:"name"`
-	Picture       string `json:"picture"`
-	VerifiedEmail bool   `json:"verified_email"`
+// GoogleService is Google sign-in as used by the auth service: the generic provider flow, local
+// ID token verification and the server-side authorization code flow
+type GoogleService interface {
+	Provider
+	VerifyIDToken(idToken string) (*UserInfo, error)
+	StartAuthorization() (*GoogleAuthorization, error)
+	CompleteAuthorization(ctx context.Context, code, state, stateToken string) (*UserInfo, error)
+}
+
+// NewGoogleService returns the sandbox fake in sandbox mode and the Google client otherwise
+func NewGoogleService() GoogleService {
+	if config.SandboxMode() {
+		return NewSandboxGoogleService()
+	}
+	return NewGoogleOAuthService()
 }
 
-type GoogleOAuthService struct{}
+type GoogleOAuthService struct {
+	idTokenVerifier *GoogleIDTokenVerifier
+	codeFlowConfig  *oauth2.Config
+	stateKey        []byte
+}
 
 func NewGoogleOAuthService() *GoogleOAuthService {
-	return &GoogleOAuthService{}
+	clientIDs := googleClientIDs()
+	return &GoogleOAuthService{
+		idTokenVerifier: NewGoogleIDTokenVerifier(clientIDs),
+		codeFlowConfig:  newCodeFlowConfig(clientIDs),
+		stateKey:        newStateKey(),
+	}
 }
 
-func (g *GoogleOAuthService) GetUserInfo(accessToken string) (*GoogleUserInfo, error) {
+// googleClientIDs reads the accepted OAuth client IDs from GOOGLE_CLIENT_ID (comma-separated)
+func googleClientIDs() []string {
+	var clientIDs []string
+	for _, id := range strings.Split(config.GetEnv("GOOGLE_CLIENT_ID", ""), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			clientIDs = append(clientIDs, id)
+		}
+	}
+	return clientIDs
+}
+
+func (g *GoogleOAuthService) GetUserInfo(accessToken string) (*UserInfo, error) {
 	ctx := context.Background()
 
-	service, err := oauth2.NewService(ctx, option.WithHTTPClient(&http.Client{}))
+	service, err := googleoauth2.NewService(ctx, option.WithHTTPClient(&http.Client{}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create OAuth2 service: %w", err)
 	}
@@ -45,7 +77,7 @@ func (g *GoogleOAuthService) GetUserInfo(accessToken string) (*GoogleUserInfo, e
 		verifiedEmail = *userInfo.VerifiedEmail
 	}
 
-	return &GoogleUserInfo{
+	return &UserInfo{
 		ID:            userInfo.Id,
 		Email:         userInfo.Email,
 		VerifiedEmail: verifiedEmail,
@@ -62,3 +94,20 @@ func (g *GoogleOAuthService) ValidateAccessToken(accessToken string) error {
 	_, err := g.GetUserInfo(accessToken)
 	return err
 }
+
+// VerifyIDToken verifies a Google ID token locally and returns the user it was issued for.
+// Unlike GetUserInfo, this proves the token was issued to this application.
+func (g *GoogleOAuthService) VerifyIDToken(idToken string) (*UserInfo, error) {
+	return g.idTokenVerifier.Verify(idToken)
+}
+
+// ProviderName implements Provider
+func (g *GoogleOAuthService) ProviderName() string {
+	return "google"
+}
+
+// ExchangeCode implements Provider by exchanging an authorization code obtained by the client.
+// The code must have been issued for the configured GOOGLE_REDIRECT_URL.
+func (g *GoogleOAuthService) ExchangeCode(code, codeVerifier string) (string, error) {
+	return exchangeCode(g.codeFlowConfig, code, codeVerifier)
+}
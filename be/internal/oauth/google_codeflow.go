@@ -0,0 +1,170 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// googleAuthURL and googleTokenURL are Google's OAuth 2.0 endpoints
+	googleAuthURL  = "https://accounts.google.com/o/oauth2/v2/auth"
+	googleTokenURL = "https://oauth2.googleapis.com/token"
+	// authorizationTTL bounds how long a user may take to complete the consent screen
+	authorizationTTL = 10 * time.Minute
+	// stateTokenType distinguishes authorization state tokens from other signed tokens
+	stateTokenType = "google_oauth_state"
+)
+
+// ErrCodeFlowNotConfigured is returned when the client secret or redirect URL is missing
+var ErrCodeFlowNotConfigured = errors.New(errors.ErrCodeNotFound, "google authorization code flow is not configured").
+	WithDescription("Google sign-in is not enabled")
+
+// ErrInvalidAuthorizationState is returned when the callback state does not match the authorization request
+var ErrInvalidAuthorizationState = stderrors.New("invalid OAuth authorization state")
+
+// GoogleAuthorization is a started authorization request. URL is where the user is redirected;
+// StateToken must be kept by the client (e.g. in a cookie) and presented again at the callback.
+type GoogleAuthorization struct {
+	URL        string
+	StateToken string
+	ExpiresIn  time.Duration
+}
+
+// authorizationStateClaims carries the per-request secrets between the authorize and callback steps
+type authorizationStateClaims struct {
+	jwt.RegisteredClaims
+	Type         string `json:"type"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// newCodeFlowConfig builds the OAuth client configuration, or returns nil when the flow is not configured
+func newCodeFlowConfig(clientIDs []string) *oauth2.Config {
+	clientSecret := config.GetEnv("GOOGLE_CLIENT_SECRET", "")
+	redirectURL := config.GetEnv("GOOGLE_REDIRECT_URL", "")
+	if len(clientIDs) == 0 || clientSecret == "" || redirectURL == "" {
+		return nil
+	}
+
+	return &oauth2.Config{
+		ClientID:     clientIDs[0],
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   googleAuthURL,
+			TokenURL:  googleTokenURL,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+}
+
+// newStateKey derives the key used to sign authorization state tokens from the JWT secret,
+// so that state tokens can never be mistaken for session tokens
+func newStateKey() []byte {
+	secret := config.GetEnv("JWT_SECRET_KEY", "your-secret-key-change-this-in-production")
+	key := sha256.Sum256([]byte(stateTokenType + ":" + secret))
+	return key[:]
+}
+
+// StartAuthorization creates a new authorization request with fresh state, nonce and PKCE verifier
+func (g *GoogleOAuthService) StartAuthorization() (*GoogleAuthorization, error) {
+	if g.codeFlowConfig == nil {
+		return nil, ErrCodeFlowNotConfigured
+	}
+
+	state, err := randomToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate state: %w", err)
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	now := time.Now()
+	claims := authorizationStateClaims{
+		Type:         stateTokenType,
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(authorizationTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	stateToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(g.stateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign authorization state: %w", err)
+	}
+
+	authURL := g.codeFlowConfig.AuthCodeURL(state,
+		oauth2.AccessTypeOnline,
+		oauth2.S256ChallengeOption(verifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	)
+
+	return &GoogleAuthorization{
+		URL:        authURL,
+		StateToken: stateToken,
+		ExpiresIn:  authorizationTTL,
+	}, nil
+}
+
+// CompleteAuthorization checks the callback state against the state token, exchanges the code
+// for tokens using the PKCE verifier, and verifies the returned ID token and its nonce
+func (g *GoogleOAuthService) CompleteAuthorization(
+	ctx context.Context,
+	code, state, stateToken string,
+) (*UserInfo, error) {
+	if g.codeFlowConfig == nil {
+		return nil, ErrCodeFlowNotConfigured
+	}
+
+	claims := &authorizationStateClaims{}
+	_, err := jwt.ParseWithClaims(stateToken, claims, func(*jwt.Token) (interface{}, error) {
+		return g.stateKey, nil
+	}, jwt.WithValidMethods([]string{"HS256"}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAuthorizationState, err)
+	}
+	if claims.Type != stateTokenType || state == "" ||
+		subtle.ConstantTimeCompare([]byte(claims.State), []byte(state)) != 1 {
+		return nil, ErrInvalidAuthorizationState
+	}
+
+	token, err := g.codeFlowConfig.Exchange(ctx, code, oauth2.VerifierOption(claims.CodeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return nil, fmt.Errorf("%w: token response has no ID token", ErrInvalidIDToken)
+	}
+
+	return g.idTokenVerifier.VerifyWithNonce(idToken, claims.Nonce)
+}
+
+// randomToken returns 32 bytes of URL-safe randomness
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,150 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// newTestCodeFlowService builds a service whose token endpoint returns an ID token carrying the given nonce
+func newTestCodeFlowService(t *testing.T, signingKey *rsa.PrivateKey, idTokenNonce func(r *http.Request) string) *GoogleOAuthService {
+	t.Helper()
+
+	var requests int32
+	jwks := newTestJWKSServer(t, map[string]*rsa.PublicKey{"key-1": &signingKey.PublicKey}, &requests)
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("code") != "valid-code" || r.PostForm.Get("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		claims := validTestClaims()
+		claims.Nonce = idTokenNonce(r)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "google-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     signTestIDToken(t, signingKey, "key-1", claims),
+		})
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	return &GoogleOAuthService{
+		idTokenVerifier: newTestVerifier(jwks.URL, testClientID),
+		codeFlowConfig: &oauth2.Config{
+			ClientID:     testClientID,
+			ClientSecret: "client-secret",
+			RedirectURL:  "https://app.example.com/api/auth/google/callback",
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   googleAuthURL,
+				TokenURL:  tokenServer.URL,
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		},
+		stateKey: []byte("test-state-key"),
+	}
+}
+
+func TestGoogleOAuthService_StartAuthorization(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		service := &GoogleOAuthService{}
+		authorization, err := service.StartAuthorization()
+		assert.ErrorIs(t, err, ErrCodeFlowNotConfigured)
+		assert.Nil(t, authorization)
+	})
+
+	t.Run("builds PKCE authorization URL", func(t *testing.T) {
+		signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		service := newTestCodeFlowService(t, signingKey, func(*http.Request) string { return "" })
+
+		authorization, err := service.StartAuthorization()
+		require.NoError(t, err)
+
+		authURL, err := url.Parse(authorization.URL)
+		require.NoError(t, err)
+		query := authURL.Query()
+		assert.Equal(t, testClientID, query.Get("client_id"))
+		assert.Equal(t, "code", query.Get("response_type"))
+		assert.Equal(t, "S256", query.Get("code_challenge_method"))
+		assert.NotEmpty(t, query.Get("code_challenge"))
+		assert.NotEmpty(t, query.Get("state"))
+		assert.NotEmpty(t, query.Get("nonce"))
+		assert.NotEmpty(t, authorization.StateToken)
+	})
+}
+
+func TestGoogleOAuthService_CompleteAuthorization(t *testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// The fake token endpoint echoes back the nonce of the most recent authorization
+	var currentNonce string
+	service := newTestCodeFlowService(t, signingKey, func(*http.Request) string { return currentNonce })
+
+	start := func(t *testing.T) (state, stateToken string) {
+		authorization, err := service.StartAuthorization()
+		require.NoError(t, err)
+		authURL, err := url.Parse(authorization.URL)
+		require.NoError(t, err)
+		currentNonce = authURL.Query().Get("nonce")
+		return authURL.Query().Get("state"), authorization.StateToken
+	}
+
+	t.Run("valid callback", func(t *testing.T) {
+		state, stateToken := start(t)
+
+		userInfo, err := service.CompleteAuthorization(context.Background(), "valid-code", state, stateToken)
+
+		require.NoError(t, err)
+		assert.Equal(t, "google-sub-123", userInfo.ID)
+		assert.Equal(t, "user@example.com", userInfo.Email)
+	})
+
+	t.Run("state mismatch", func(t *testing.T) {
+		_, stateToken := start(t)
+
+		_, err := service.CompleteAuthorization(context.Background(), "valid-code", "forged-state", stateToken)
+
+		assert.ErrorIs(t, err, ErrInvalidAuthorizationState)
+	})
+
+	t.Run("tampered state token", func(t *testing.T) {
+		state, stateToken := start(t)
+
+		_, err := service.CompleteAuthorization(context.Background(), "valid-code", state, stateToken+"x")
+
+		assert.ErrorIs(t, err, ErrInvalidAuthorizationState)
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		state, stateToken := start(t)
+		currentNonce = "replayed-nonce"
+
+		_, err := service.CompleteAuthorization(context.Background(), "valid-code", state, stateToken)
+
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("code exchange rejected", func(t *testing.T) {
+		state, stateToken := start(t)
+
+		_, err := service.CompleteAuthorization(context.Background(), "expired-code", state, stateToken)
+
+		assert.Error(t, err)
+	})
+}
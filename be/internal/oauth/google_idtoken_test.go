@@ -0,0 +1,260 @@
+package oauth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "test-client-id.apps.googleusercontent.com"
+
+// newTestJWKSServer serves the given keys as a JWKS document and counts requests
+func newTestJWKSServer(t *testing.T, keys map[string]*rsa.PublicKey, requests *int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+
+		document := struct {
+			Keys []jsonWebKey `json:"keys"`
+		}{}
+		for kid, key := range keys {
+			document.Keys = append(document.Keys, jsonWebKey{
+				Kid: kid,
+				Kty: "RSA",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		_ = json.NewEncoder(w).Encode(document)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestVerifier(serverURL string, audiences ...string) *GoogleIDTokenVerifier {
+	verifier := NewGoogleIDTokenVerifier(audiences)
+	verifier.keys.url = serverURL
+	return verifier
+}
+
+func signTestIDToken(t *testing.T, key *rsa.PrivateKey, kid string, claims GoogleIDTokenClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validTestClaims() GoogleIDTokenClaims {
+	now := time.Now()
+	return GoogleIDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "https://accounts.google.com",
+			Subject:   "google-sub-123",
+			Audience:  jwt.ClaimStrings{testClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		Email:         "user@example.com",
+		Name:          "Test User",
+		EmailVerified: true,
+	}
+}
+
+func TestGoogleIDTokenVerifier_Verify(t *testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var requests int32
+	server := newTestJWKSServer(t, map[string]*rsa.PublicKey{"key-1": &signingKey.PublicKey}, &requests)
+
+	tests := []struct {
+		buildToken  func() string
+		name        string
+		audiences   []string
+		expectError bool
+	}{
+		{
+			name:      "valid token",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				return signTestIDToken(t, signingKey, "key-1", validTestClaims())
+			},
+		},
+		{
+			name:      "accepts issuer without scheme",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				claims := validTestClaims()
+				claims.Issuer = "accounts.google.com"
+				return signTestIDToken(t, signingKey, "key-1", claims)
+			},
+		},
+		{
+			name:      "accepts any configured audience",
+			audiences: []string{"web-client", testClientID},
+			buildToken: func() string {
+				return signTestIDToken(t, signingKey, "key-1", validTestClaims())
+			},
+		},
+		{
+			name:      "wrong audience",
+			audiences: []string{"another-client"},
+			buildToken: func() string {
+				return signTestIDToken(t, signingKey, "key-1", validTestClaims())
+			},
+			expectError: true,
+		},
+		{
+			name:      "wrong issuer",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				claims := validTestClaims()
+				claims.Issuer = "https://evil.example.com"
+				return signTestIDToken(t, signingKey, "key-1", claims)
+			},
+			expectError: true,
+		},
+		{
+			name:      "expired token",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				claims := validTestClaims()
+				claims.IssuedAt = jwt.NewNumericDate(time.Now().Add(-2 * time.Hour))
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				return signTestIDToken(t, signingKey, "key-1", claims)
+			},
+			expectError: true,
+		},
+		{
+			name:      "missing expiry",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				claims := validTestClaims()
+				claims.ExpiresAt = nil
+				return signTestIDToken(t, signingKey, "key-1", claims)
+			},
+			expectError: true,
+		},
+		{
+			name:      "signed with a different key",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				return signTestIDToken(t, otherKey, "key-1", validTestClaims())
+			},
+			expectError: true,
+		},
+		{
+			name:      "unknown key ID",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				return signTestIDToken(t, signingKey, "key-unknown", validTestClaims())
+			},
+			expectError: true,
+		},
+		{
+			name:      "HMAC signed token",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, validTestClaims())
+				token.Header["kid"] = "key-1"
+				signed, err := token.SignedString([]byte("secret"))
+				require.NoError(t, err)
+				return signed
+			},
+			expectError: true,
+		},
+		{
+			name:      "empty token",
+			audiences: []string{testClientID},
+			buildToken: func() string {
+				return ""
+			},
+			expectError: true,
+		},
+		{
+			name:      "no client ID configured",
+			audiences: nil,
+			buildToken: func() string {
+				return signTestIDToken(t, signingKey, "key-1", validTestClaims())
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := newTestVerifier(server.URL, tt.audiences...)
+
+			userInfo, err := verifier.Verify(tt.buildToken())
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, userInfo)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "google-sub-123", userInfo.ID)
+			assert.Equal(t, "user@example.com", userInfo.Email)
+			assert.Equal(t, "Test User", userInfo.Name)
+			assert.True(t, userInfo.VerifiedEmail)
+		})
+	}
+}
+
+func TestGoogleIDTokenVerifier_CachesKeys(t *testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var requests int32
+	server := newTestJWKSServer(t, map[string]*rsa.PublicKey{"key-1": &signingKey.PublicKey}, &requests)
+	verifier := newTestVerifier(server.URL, testClientID)
+
+	for range 3 {
+		_, err := verifier.Verify(signTestIDToken(t, signingKey, "key-1", validTestClaims()))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// Unknown key IDs do not trigger a refetch while the cache is fresh and recently fetched
+	_, err = verifier.Verify(signTestIDToken(t, signingKey, "key-2", validTestClaims()))
+	assert.ErrorIs(t, err, ErrInvalidIDToken)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestCacheTTL(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheControl string
+		expected     time.Duration
+	}{
+		{name: "max-age present", cacheControl: "public, max-age=19845, must-revalidate", expected: 19845 * time.Second},
+		{name: "no header", cacheControl: "", expected: defaultJWKSCacheTTL},
+		{name: "zero max-age", cacheControl: "max-age=0", expected: defaultJWKSCacheTTL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cacheTTL(tt.cacheControl))
+		})
+	}
+}
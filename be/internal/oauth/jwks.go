@@ -0,0 +1,167 @@
+package oauth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// defaultJWKSCacheTTL is used when the JWKS response carries no usable max-age
+	defaultJWKSCacheTTL = time.Hour
+	// minJWKSRefreshInterval limits refetches triggered by unknown key IDs
+	minJWKSRefreshInterval = time.Minute
+	// idTokenLeeway tolerates small clock differences between us and the identity provider
+	idTokenLeeway = 30 * time.Second
+)
+
+var maxAgePattern = regexp.MustCompile(`max-age=(\d+)`)
+
+// jsonWebKey is a single RSA key from a JWKS document
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// jwksKeySet holds the keys an identity provider publishes to sign its ID tokens. Keys are cached
+// according to the Cache-Control header of the JWKS response, so most verifications need no
+// network call.
+type jwksKeySet struct {
+	keysExpireAt time.Time
+	fetchedAt    time.Time
+	httpClient   *http.Client
+	keys         map[string]*rsa.PublicKey
+	url          string
+	issuer       string
+	mu           sync.RWMutex
+}
+
+// newJWKSKeySet creates a key set fetched from url. The issuer names the provider in errors.
+func newJWKSKeySet(issuer, url string) *jwksKeySet {
+	return &jwksKeySet{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		url:        url,
+		issuer:     issuer,
+	}
+}
+
+// keyFunc looks up the public key for the token's key ID, refreshing the cached JWKS when needed
+func (s *jwksKeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return nil, errors.New("token has no key ID")
+	}
+
+	s.mu.RLock()
+	key, found := s.keys[kid]
+	fresh := time.Now().Before(s.keysExpireAt)
+	s.mu.RUnlock()
+	if found && fresh {
+		return key, nil
+	}
+
+	if err := s.refreshKeys(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, found = s.keys[kid]
+	if !found {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// refreshKeys fetches the JWKS document. Refetches for unknown key IDs are throttled so that
+// tokens with forged key IDs cannot be used to hammer the provider's endpoint.
+func (s *jwksKeySet) refreshKeys() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(s.keysExpireAt) && now.Sub(s.fetchedAt) < minJWKSRefreshInterval {
+		return nil
+	}
+
+	resp, err := s.httpClient.Get(s.url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s signing keys: %w", s.issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch %s signing keys: unexpected status %d", s.issuer, resp.StatusCode)
+	}
+
+	var document struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return fmt.Errorf("failed to decode %s signing keys: %w", s.issuer, err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(document.Keys))
+	for _, jwk := range document.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+		key, err := jwk.rsaPublicKey()
+		if err != nil {
+			return fmt.Errorf("failed to parse %s signing key %q: %w", s.issuer, jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	s.keys = keys
+	s.fetchedAt = now
+	s.keysExpireAt = now.Add(cacheTTL(resp.Header.Get("Cache-Control")))
+	return nil
+}
+
+// rsaPublicKey decodes the modulus and exponent of an RSA JWK
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > int64(^uint32(0)>>1) {
+		return nil, errors.New("exponent out of range")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+// cacheTTL extracts max-age from a Cache-Control header
+func cacheTTL(cacheControl string) time.Duration {
+	match := maxAgePattern.FindStringSubmatch(cacheControl)
+	if match == nil {
+		return defaultJWKSCacheTTL
+	}
+	seconds, err := strconv.Atoi(match[1])
+	if err != nil || seconds <= 0 {
+		return defaultJWKSCacheTTL
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,90 @@
+package oauth
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"strikepad-backend/internal/config"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// microsoftLoginURL is the Microsoft identity platform; the tenant is appended to it
+	microsoftLoginURL = "https://login.microsoftonline.com/"
+	// microsoftUserInfoURL is the OpenID Connect userinfo endpoint of Microsoft Graph
+	microsoftUserInfoURL = "https://graph.microsoft.com/oidc/userinfo"
+)
+
+// MicrosoftProvider authenticates users with Microsoft accounts and Entra ID
+type MicrosoftProvider struct {
+	httpClient  *http.Client
+	config      *oauth2.Config
+	userInfoURL string
+}
+
+// NewMicrosoftProvider creates a Microsoft provider from MICROSOFT_CLIENT_ID, MICROSOFT_CLIENT_SECRET,
+// MICROSOFT_REDIRECT_URL and MICROSOFT_TENANT. Without a client ID the provider rejects every request.
+func NewMicrosoftProvider() *MicrosoftProvider {
+	provider := &MicrosoftProvider{
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		userInfoURL: microsoftUserInfoURL,
+	}
+
+	clientID := config.GetEnv("MICROSOFT_CLIENT_ID", "")
+	clientSecret := config.GetEnv("MICROSOFT_CLIENT_SECRET", "")
+	if clientID != "" && clientSecret != "" {
+		tenant := config.GetEnv("MICROSOFT_TENANT", "common")
+		provider.config = &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  config.GetEnv("MICROSOFT_REDIRECT_URL", ""),
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   microsoftLoginURL + tenant + "/oauth2/v2.0/authorize",
+				TokenURL:  microsoftLoginURL + tenant + "/oauth2/v2.0/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		}
+	}
+	return provider
+}
+
+// ProviderName implements Provider
+func (p *MicrosoftProvider) ProviderName() string {
+	return "microsoft"
+}
+
+// ExchangeCode implements Provider
+func (p *MicrosoftProvider) ExchangeCode(code, codeVerifier string) (string, error) {
+	return exchangeCode(p.config, code, codeVerifier)
+}
+
+// GetUserInfo implements Provider. Microsoft does not report whether the email address
+// was verified, so it is always treated as unverified.
+func (p *MicrosoftProvider) GetUserInfo(accessToken string) (*UserInfo, error) {
+	if p.config == nil {
+		return nil, ErrProviderNotConfigured
+	}
+
+	var claims struct {
+		Sub     string `json:"sub"`
+		Email   string `json:"email"`
+		Name    string `json:"name"`
+		Picture string `json:"picture"`
+	}
+	if err := getJSON(p.httpClient, p.userInfoURL, accessToken, &claims); err != nil {
+		return nil, fmt.Errorf("failed to get Microsoft user info: %w", err)
+	}
+	if claims.Sub == "" || claims.Email == "" {
+		return nil, fmt.Errorf("microsoft user info is missing subject or email")
+	}
+
+	return &UserInfo{
+		ID:      claims.Sub,
+		Email:   claims.Email,
+		Name:    claims.Name,
+		Picture: claims.Picture,
+	}, nil
+}
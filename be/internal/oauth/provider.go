@@ -0,0 +1,158 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"strikepad-backend/internal/errors"
+
+	"golang.org/x/oauth2"
+)
+
+// providerDisabledDescription is the error description of providers that cannot be signed in with
+const providerDisabledDescription = "Sign-in with this provider is not enabled"
+
+// ErrUnknownProvider is returned when no provider is registered under the requested name
+var ErrUnknownProvider = errors.New(errors.ErrCodeNotFound, "unknown OAuth provider").
+	WithDescription(providerDisabledDescription)
+
+// ErrProviderNotConfigured is returned when a provider's client credentials are missing
+var ErrProviderNotConfigured = errors.New(errors.ErrCodeNotFound, "OAuth provider is not configured").
+	WithDescription(providerDisabledDescription)
+
+// UserInfo is the identity of a user as reported by an OAuth provider
+type UserInfo struct {
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	VerifiedEmail bool   `json:"verified_email"`
+}
+
+// Provider is an identity provider that users can sign up and log in with
+type Provider interface {
+	// ProviderName is the name used in routes and stored as the user's provider type
+	ProviderName() string
+	// ExchangeCode exchanges an authorization code (and optional PKCE verifier) for an access token
+	ExchangeCode(code, codeVerifier string) (string, error)
+	// GetUserInfo returns the user that the access token was issued for
+	GetUserInfo(accessToken string) (*UserInfo, error)
+}
+
+// Registry looks up providers by name
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry creates a registry containing the given providers
+func NewRegistry(providers ...Provider) *Registry {
+	registry := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, provider := range providers {
+		registry.providers[provider.ProviderName()] = provider
+	}
+	return registry
+}
+
+// NewDefaultRegistry registers every built-in provider. New identity providers are added here
+// and provided in the container; the auth service and routes pick them up by name.
+func NewDefaultRegistry(
+	google GoogleService,
+	github *GitHubProvider,
+	microsoft *MicrosoftProvider,
+	apple *AppleProvider,
+) *Registry {
+	return NewRegistry(google, github, microsoft, apple)
+}
+
+// Get returns the provider registered under name
+func (r *Registry) Get(name string) (Provider, error) {
+	provider, ok := r.providers[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, name)
+	}
+	return provider, nil
+}
+
+// Google returns the registered Google provider, which also offers ID token sign-in and the
+// server-side code flow, or nil when there is none
+func (r *Registry) Google() GoogleService {
+	if r == nil {
+		return nil
+	}
+	google, _ := r.providers["google"].(GoogleService)
+	return google
+}
+
+// Names returns the names of all registered providers in sorted order
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// exchangeCode exchanges an authorization code using config, returning the access token
+func exchangeCode(config *oauth2.Config, code, codeVerifier string) (string, error) {
+	if config == nil {
+		return "", ErrProviderNotConfigured
+	}
+	token, err := exchangeToken(config, code, codeVerifier)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// exchangeToken exchanges an authorization code using config, returning the whole token response
+func exchangeToken(config *oauth2.Config, code, codeVerifier string) (*oauth2.Token, error) {
+	if strings.TrimSpace(code) == "" {
+		return nil, stderrors.New("authorization code is empty")
+	}
+
+	var opts []oauth2.AuthCodeOption
+	if codeVerifier != "" {
+		opts = append(opts, oauth2.VerifierOption(codeVerifier))
+	}
+
+	token, err := config.Exchange(context.Background(), code, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	return token, nil
+}
+
+// getJSON performs an authenticated GET request and decodes the JSON response into out
+func getJSON(client *http.Client, url, accessToken string, out interface{}) error {
+	if strings.TrimSpace(accessToken) == "" {
+		return stderrors.New("access token is empty")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+	return nil
+}
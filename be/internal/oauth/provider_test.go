@@ -0,0 +1,285 @@
+package oauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestRegistry(t *testing.T) {
+	google := &GoogleOAuthService{}
+	registry := NewRegistry(google, &GitHubProvider{}, &MicrosoftProvider{}, &AppleProvider{})
+
+	assert.Equal(t, []string{"apple", "github", "google", "microsoft"}, registry.Names())
+	assert.Same(t, google, registry.Google())
+	assert.Nil(t, NewRegistry(&GitHubProvider{}).Google())
+
+	provider, err := registry.Get("github")
+	require.NoError(t, err)
+	assert.Equal(t, "github", provider.ProviderName())
+
+	provider, err = registry.Get("Microsoft")
+	require.NoError(t, err)
+	assert.Equal(t, "microsoft", provider.ProviderName())
+
+	_, err = registry.Get("myspace")
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+}
+
+// newTestAPIServer serves JSON responses by path and rejects requests without the expected bearer token
+func newTestAPIServer(t *testing.T, responses map[string]interface{}) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer valid-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGitHubProvider_GetUserInfo(t *testing.T) {
+	server := newTestAPIServer(t, map[string]interface{}{
+		"/user": map[string]interface{}{
+			"id":         12345,
+			"login":      "octocat",
+			"name":       "",
+			"avatar_url": "https://avatars.example.com/octocat",
+		},
+		"/user/emails": []map[string]interface{}{
+			{"email": "old@example.com", "primary": false, "verified": true},
+			{"email": "octocat@example.com", "primary": true, "verified": true},
+		},
+	})
+	provider := &GitHubProvider{
+		httpClient: server.Client(),
+		config:     &oauth2.Config{ClientID: "client"},
+		apiURL:     server.URL,
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		userInfo, err := provider.GetUserInfo("valid-token")
+
+		require.NoError(t, err)
+		assert.Equal(t, &UserInfo{
+			ID:            "12345",
+			Email:         "octocat@example.com",
+			Name:          "octocat",
+			Picture:       "https://avatars.example.com/octocat",
+			VerifiedEmail: true,
+		}, userInfo)
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		_, err := provider.GetUserInfo("invalid-token")
+		assert.Error(t, err)
+	})
+
+	t.Run("empty token", func(t *testing.T) {
+		_, err := provider.GetUserInfo("")
+		assert.Error(t, err)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		_, err := (&GitHubProvider{}).GetUserInfo("valid-token")
+		assert.ErrorIs(t, err, ErrProviderNotConfigured)
+	})
+}
+
+func TestMicrosoftProvider_GetUserInfo(t *testing.T) {
+	server := newTestAPIServer(t, map[string]interface{}{
+		"/oidc/userinfo": map[string]interface{}{
+			"sub":   "ms-sub-123",
+			"email": "user@example.com",
+			"name":  "Test User",
+		},
+	})
+	provider := &MicrosoftProvider{
+		httpClient:  server.Client(),
+		config:      &oauth2.Config{ClientID: "client"},
+		userInfoURL: server.URL + "/oidc/userinfo",
+	}
+
+	userInfo, err := provider.GetUserInfo("valid-token")
+	require.NoError(t, err)
+	assert.Equal(t, "ms-sub-123", userInfo.ID)
+	assert.Equal(t, "user@example.com", userInfo.Email)
+	assert.False(t, userInfo.VerifiedEmail)
+
+	_, err = provider.GetUserInfo("invalid-token")
+	assert.Error(t, err)
+}
+
+// newTestAppleProvider returns an Apple provider for the client ID "com.example.web" that fetches
+// the signing keys of ID tokens from jwksURL
+func newTestAppleProvider(t *testing.T, jwksURL, tokenURL string) *AppleProvider {
+	t.Helper()
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &AppleProvider{
+		keys:       newJWKSKeySet("Apple", jwksURL),
+		privateKey: privateKey,
+		teamID:     "TEAM123456",
+		keyID:      "KEY1234567",
+		config: &oauth2.Config{
+			ClientID: "com.example.web",
+			Endpoint: oauth2.Endpoint{TokenURL: tokenURL, AuthStyle: oauth2.AuthStyleInParams},
+		},
+	}
+}
+
+func TestAppleProvider_GetUserInfo(t *testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	var requests int32
+	server := newTestJWKSServer(t, map[string]*rsa.PublicKey{"apple-1": &signingKey.PublicKey}, &requests)
+	provider := newTestAppleProvider(t, server.URL, "")
+	signToken := func(claims appleIDTokenClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "apple-1"
+		signed, err := token.SignedString(signingKey)
+		require.NoError(t, err)
+		return signed
+	}
+	validClaims := func() appleIDTokenClaims {
+		return appleIDTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    appleIssuer,
+				Subject:   "001234.abcdef.0987",
+				Audience:  jwt.ClaimStrings{"com.example.web"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+			},
+			Email:         "jane@privaterelay.appleid.com",
+			EmailVerified: "true",
+		}
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		userInfo, err := provider.GetUserInfo(signToken(validClaims()))
+
+		require.NoError(t, err)
+		assert.Equal(t, &UserInfo{
+			ID:            "001234.abcdef.0987",
+			Email:         "jane@privaterelay.appleid.com",
+			Name:          "jane",
+			VerifiedEmail: true,
+		}, userInfo)
+	})
+
+	t.Run("boolean email_verified", func(t *testing.T) {
+		claims := validClaims()
+		claims.EmailVerified = false
+
+		userInfo, err := provider.GetUserInfo(signToken(claims))
+
+		require.NoError(t, err)
+		assert.False(t, userInfo.VerifiedEmail)
+	})
+
+	t.Run("issued for another client", func(t *testing.T) {
+		claims := validClaims()
+		claims.Audience = jwt.ClaimStrings{"com.example.other"}
+
+		_, err := provider.GetUserInfo(signToken(claims))
+		assert.Error(t, err)
+	})
+
+	t.Run("issued by someone else", func(t *testing.T) {
+		claims := validClaims()
+		claims.Issuer = "https://accounts.google.com"
+
+		_, err := provider.GetUserInfo(signToken(claims))
+		assert.Error(t, err)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		_, err := (&AppleProvider{}).GetUserInfo(signToken(validClaims()))
+		assert.ErrorIs(t, err, ErrProviderNotConfigured)
+	})
+}
+
+func TestAppleProvider_ExchangeCode(t *testing.T) {
+	var provider *AppleProvider
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		claims := &jwt.RegisteredClaims{}
+		secret, err := jwt.ParseWithClaims(r.PostForm.Get("client_secret"), claims,
+			func(*jwt.Token) (interface{}, error) { return &provider.privateKey.PublicKey, nil },
+			jwt.WithValidMethods([]string{"ES256"}), jwt.WithAudience(appleIssuer), jwt.WithIssuer("TEAM123456"))
+		if err != nil || secret.Header["kid"] != "KEY1234567" || claims.Subject != "com.example.web" ||
+			r.PostForm.Get("code") != "valid-code" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"unused","id_token":"apple-id-token","token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	provider = newTestAppleProvider(t, "", server.URL)
+
+	idToken, err := provider.ExchangeCode("valid-code", "")
+	require.NoError(t, err)
+	assert.Equal(t, "apple-id-token", idToken)
+
+	_, err = provider.ExchangeCode("stolen-code", "")
+	assert.Error(t, err)
+
+	_, err = (&AppleProvider{}).ExchangeCode("valid-code", "")
+	assert.ErrorIs(t, err, ErrProviderNotConfigured)
+}
+
+func TestExchangeCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("code") != "valid-code" || r.PostForm.Get("code_verifier") != "verifier" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"exchanged-token","token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+
+	provider := &GitHubProvider{config: &oauth2.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Endpoint:     oauth2.Endpoint{TokenURL: server.URL, AuthStyle: oauth2.AuthStyleInParams},
+	}}
+
+	accessToken, err := provider.ExchangeCode("valid-code", "verifier")
+	require.NoError(t, err)
+	assert.Equal(t, "exchanged-token", accessToken)
+
+	_, err = provider.ExchangeCode("stolen-code", "verifier")
+	assert.Error(t, err)
+
+	_, err = provider.ExchangeCode("", "")
+	assert.Error(t, err)
+
+	_, err = (&MicrosoftProvider{}).ExchangeCode("valid-code", "")
+	assert.ErrorIs(t, err, ErrProviderNotConfigured)
+}
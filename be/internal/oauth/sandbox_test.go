@@ -0,0 +1,90 @@
+package oauth
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGoogleService(t *testing.T) {
+	t.Setenv("APP_ENV", "dev")
+	t.Setenv("SANDBOX_MODE", "false")
+	assert.IsType(t, &GoogleOAuthService{}, NewGoogleService())
+
+	t.Setenv("SANDBOX_MODE", "true")
+	assert.IsType(t, &SandboxGoogleService{}, NewGoogleService())
+}
+
+func TestSandboxGoogleService_Tokens(t *testing.T) {
+	service := NewSandboxGoogleService()
+
+	tests := []struct {
+		expected *UserInfo
+		name     string
+		token    string
+	}{
+		{
+			name:  "name only",
+			token: "sandbox:Alice",
+			expected: &UserInfo{
+				ID: "sandbox-alice@sandbox.test", Email: "alice@sandbox.test", Name: "alice", VerifiedEmail: true,
+			},
+		},
+		{
+			name:  "email address",
+			token: "sandbox:bob@example.com",
+			expected: &UserInfo{
+				ID: "sandbox-bob@example.com", Email: "bob@example.com", Name: "bob", VerifiedEmail: true,
+			},
+		},
+		{name: "real token", token: "ya29.a0AfH6SM"},
+		{name: "empty user", token: "sandbox:"},
+		{name: "empty", token: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromAccessToken, err := service.GetUserInfo(tt.token)
+			fromIDToken, idErr := service.VerifyIDToken(tt.token)
+			accessToken, exchangeErr := service.ExchangeCode(tt.token, "verifier")
+
+			if tt.expected == nil {
+				assert.ErrorIs(t, err, ErrInvalidSandboxToken)
+				assert.ErrorIs(t, idErr, ErrInvalidSandboxToken)
+				assert.ErrorIs(t, exchangeErr, ErrInvalidSandboxToken)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, idErr)
+			require.NoError(t, exchangeErr)
+			assert.Equal(t, tt.expected, fromAccessToken)
+			assert.Equal(t, tt.expected, fromIDToken)
+			assert.Equal(t, tt.token, accessToken)
+		})
+	}
+}
+
+func TestSandboxGoogleService_AuthorizationFlow(t *testing.T) {
+	t.Setenv("GOOGLE_REDIRECT_URL", "http://localhost:8080/api/auth/google/callback")
+	service := NewSandboxGoogleService()
+
+	authorization, err := service.StartAuthorization()
+	require.NoError(t, err)
+
+	redirect, err := url.Parse(authorization.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:8080", redirect.Host)
+	assert.Equal(t, "/api/auth/google/callback", redirect.Path)
+	code, state := redirect.Query().Get("code"), redirect.Query().Get("state")
+	assert.Equal(t, "sandbox:developer", code)
+
+	user, err := service.CompleteAuthorization(context.Background(), code, state, authorization.StateToken)
+	require.NoError(t, err)
+	assert.Equal(t, "developer@sandbox.test", user.Email)
+
+	_, err = service.CompleteAuthorization(context.Background(), code, "forged", authorization.StateToken)
+	assert.ErrorIs(t, err, ErrInvalidAuthorizationState)
+}
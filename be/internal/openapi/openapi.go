@@ -0,0 +1,165 @@
+// Package openapi generates the OpenAPI document of the API from the route table in Routes and
+// the DTOs, described the way the JSON serializer writes them.
+package openapi
+
+//go:generate go run ../../cmd/openapi -o ../../api/openapi.json
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"strikepad-backend/internal/dto"
+)
+
+// Version is the version of the API described by the document
+const Version = "1.0.0"
+
+// MIMEEventStream is the media type of Server-Sent Events streams
+const MIMEEventStream = "text/event-stream"
+
+var (
+	specOnce sync.Once
+	spec     []byte
+	specErr  error
+)
+
+// Spec returns the OpenAPI document as indented JSON. It is built once.
+func Spec() ([]byte, error) {
+	specOnce.Do(func() {
+		spec, specErr = json.MarshalIndent(Build(Routes), "", "  ")
+		if specErr == nil {
+			spec = append(spec, '\n')
+		}
+	})
+	return spec, specErr
+}
+
+// Build returns the OpenAPI document describing routes
+func Build(routes []Route) *Document {
+	g := newSchemaGenerator()
+	errorSchema := g.schemaFor(reflect.TypeOf(dto.ErrorResponse{}))
+
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info: Info{
+			Title:       "StrikePad API",
+			Description: "Errors are returned as an ErrorResponse with the error code in code.",
+			Version:     Version,
+		},
+		Paths: make(map[string]PathItem),
+		Components: Components{
+			Schemas:         g.schemas,
+			SecuritySchemes: securitySchemes(),
+		},
+	}
+
+	for _, route := range routes {
+		path, parameters := convertPath(route.Path)
+		operation := &Operation{
+			OperationID: route.OperationID,
+			Summary:     route.Summary,
+			Parameters:  append(parameters, route.Query...),
+			Responses: map[string]Response{
+				"default": {Description: "Error", Content: jsonContent(errorSchema)},
+			},
+		}
+		if route.Tag != "" {
+			operation.Tags = []string{route.Tag}
+		}
+		for _, scheme := range route.Security {
+			operation.Security = append(operation.Security, map[string][]string{scheme: {}})
+		}
+		if route.Request != nil {
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content:  jsonContent(g.schemaFor(reflect.TypeOf(route.Request))),
+			}
+		}
+		if route.Upload != "" {
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content:  uploadContent(route.Upload),
+			}
+		}
+		for status, body := range route.Responses {
+			response := Response{Description: http.StatusText(status)}
+			switch {
+			case route.Download != "":
+				response.Content = map[string]MediaType{route.Download: {Schema: &Schema{Type: "string", Format: "binary"}}}
+			case body != nil && route.Stream:
+				response.Content = map[string]MediaType{MIMEEventStream: {Schema: g.schemaFor(reflect.TypeOf(body))}}
+			case body != nil:
+				response.Content = jsonContent(g.schemaFor(reflect.TypeOf(body)))
+			}
+			operation.Responses[strconv.Itoa(status)] = response
+		}
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(PathItem)
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = operation
+	}
+
+	return doc
+}
+
+// IsEventStream reports whether operation responds with a stream of Server-Sent Events
+func IsEventStream(operation *Operation) bool {
+	for _, response := range operation.Responses {
+		if _, ok := response.Content[MIMEEventStream]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// convertPath converts an Echo path to an OpenAPI path, and returns its path parameters
+func convertPath(echoPath string) (string, []Parameter) {
+	segments := strings.Split(echoPath, "/")
+	var parameters []Parameter
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, ":")
+		if !ok {
+			continue
+		}
+		segments[i] = "{" + name + "}"
+		parameters = append(parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	return strings.Join(segments, "/"), parameters
+}
+
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: schema}}
+}
+
+// uploadContent describes a multipart form with a single file field
+func uploadContent(field string) map[string]MediaType {
+	return map[string]MediaType{"multipart/form-data": {Schema: &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{field: {Type: "string", Format: "binary"}},
+		Required:   []string{field},
+	}}}
+}
+
+func securitySchemes() map[string]SecurityScheme {
+	return map[string]SecurityScheme{
+		BearerAuth: {Type: "http", Scheme: "bearer", Description: "Access token returned by signup and login"},
+		CookieAuth: {
+			Type: "apiKey", In: "cookie", Name: "access_token",
+			Description: "Session cookie set in cookie session mode; unsafe requests also need the X-CSRF-Token header",
+		},
+		APIKeyAuth: {Type: "apiKey", In: "header", Name: "X-API-Key", Description: "API key created by an administrator"},
+		AdminToken: {
+			Type: "apiKey", In: "header", Name: "X-Admin-Token", Description: "Admin API token from ADMIN_API_TOKEN",
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package openapi_test
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/openapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type WidgetRequest struct {
+	Name   string   `json:"name" validate:"required,min=2,max=50" example:"Gadget"`
+	Kind   string   `json:"kind" validate:"required,oneof=small large"`
+	Site   string   `json:"site,omitempty" validate:"omitempty,url"`
+	Hidden string   `json:"-"`
+	Tags   []string `json:"tags,omitempty" validate:"max=5,dive,required" example:"a,b"`
+	Count  int      `json:"count" validate:"min=1" example:"3"`
+}
+
+type WidgetResponse struct {
+	CreatedAt time.Time         `json:"created_at"`
+	DeletedAt *time.Time        `json:"deleted_at"`
+	Owner     *WidgetOwner      `json:"owner,omitempty"`
+	Labels    map[string]string `json:"labels"`
+	ID        string            `json:"id"`
+}
+
+type WidgetOwner struct {
+	Name string `json:"name"`
+}
+
+func TestBuild(t *testing.T) {
+	doc := openapi.Build([]openapi.Route{{
+		Method:      http.MethodPut,
+		Path:        "/api/widgets/:id",
+		OperationID: "updateWidget",
+		Summary:     "Update a widget",
+		Tag:         "Widgets",
+		Security:    []string{openapi.BearerAuth},
+		Request:     WidgetRequest{},
+		Responses:   map[int]any{http.StatusOK: WidgetResponse{}},
+	}})
+
+	operation := doc.Paths["/api/widgets/{id}"]["put"]
+	require.NotNil(t, operation)
+	assert.Equal(t, "updateWidget", operation.OperationID)
+	assert.Equal(t, []string{"Widgets"}, operation.Tags)
+	assert.Equal(t, []map[string][]string{{openapi.BearerAuth: {}}}, operation.Security)
+	require.Len(t, operation.Parameters, 1)
+	assert.Equal(t, "id", operation.Parameters[0].Name)
+	assert.Equal(t, "path", operation.Parameters[0].In)
+	assert.True(t, operation.Parameters[0].Required)
+	assert.Equal(t, "#/components/schemas/WidgetResponse", operation.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/ErrorResponse", operation.Responses["default"].Content["application/json"].Schema.Ref)
+
+	t.Run("request constraints", func(t *testing.T) {
+		schema := doc.Components.Schemas["WidgetRequest"]
+		require.NotNil(t, schema)
+		assert.ElementsMatch(t, []string{"name", "kind"}, schema.Required)
+		assert.NotContains(t, schema.Properties, "Hidden")
+
+		name := schema.Properties["name"]
+		assert.Equal(t, 2, *name.MinLength)
+		assert.Equal(t, 50, *name.MaxLength)
+		assert.Equal(t, "Gadget", name.Example)
+		assert.Equal(t, []string{"small", "large"}, schema.Properties["kind"].Enum)
+		assert.Equal(t, "uri", schema.Properties["site"].Format)
+		assert.Equal(t, 5, *schema.Properties["tags"].MaxItems)
+		assert.Equal(t, []string{"a", "b"}, schema.Properties["tags"].Example)
+		assert.Equal(t, 1.0, *schema.Properties["count"].Minimum)
+		assert.Equal(t, int64(3), schema.Properties["count"].Example)
+	})
+
+	t.Run("response shape", func(t *testing.T) {
+		schema := doc.Components.Schemas["WidgetResponse"]
+		require.NotNil(t, schema)
+		assert.Equal(t, []string{"created_at", "deleted_at", "labels", "id"}, schema.Required)
+		assert.Equal(t, "date-time", schema.Properties["created_at"].Format)
+		assert.True(t, schema.Properties["deleted_at"].Nullable)
+		assert.Equal(t, "#/components/schemas/WidgetOwner", schema.Properties["owner"].Ref)
+		assert.Equal(t, "string", schema.Properties["labels"].AdditionalProperties.Type)
+		assert.Contains(t, doc.Components.Schemas, "WidgetOwner")
+	})
+}
+
+func TestBuild_Upload(t *testing.T) {
+	doc := openapi.Build([]openapi.Route{{
+		Method:      http.MethodPost,
+		Path:        "/api/widgets/:id/photo",
+		OperationID: "uploadWidgetPhoto",
+		Upload:      "photo",
+		Responses:   map[int]any{http.StatusNoContent: nil},
+	}})
+
+	operation := doc.Paths["/api/widgets/{id}/photo"]["post"]
+	require.NotNil(t, operation)
+	require.NotNil(t, operation.RequestBody)
+	assert.NotContains(t, operation.RequestBody.Content, "application/json")
+	schema := operation.RequestBody.Content["multipart/form-data"].Schema
+	require.NotNil(t, schema)
+	assert.Equal(t, []string{"photo"}, schema.Required)
+	assert.Equal(t, "binary", schema.Properties["photo"].Format)
+}
+
+func TestBuild_Stream(t *testing.T) {
+	doc := openapi.Build([]openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/api/widgets/events", OperationID: "streamWidgetEvents",
+			Stream: true, Responses: map[int]any{http.StatusOK: WidgetOwner{}},
+		},
+		{
+			Method: http.MethodGet, Path: "/api/widgets/owner", OperationID: "getWidgetOwner",
+			Responses: map[int]any{http.StatusOK: WidgetOwner{}},
+		},
+	})
+
+	stream := doc.Paths["/api/widgets/events"]["get"]
+	require.NotNil(t, stream)
+	assert.Equal(t, "#/components/schemas/WidgetOwner",
+		stream.Responses["200"].Content[openapi.MIMEEventStream].Schema.Ref)
+	assert.True(t, openapi.IsEventStream(stream))
+	assert.False(t, openapi.IsEventStream(doc.Paths["/api/widgets/owner"]["get"]))
+}
+
+func TestBuild_Download(t *testing.T) {
+	doc := openapi.Build([]openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/api/widgets/:id/archive", OperationID: "downloadWidgetArchive",
+			Download: "application/zip", Responses: map[int]any{http.StatusOK: nil},
+		},
+	})
+
+	download := doc.Paths["/api/widgets/{id}/archive"]["get"]
+	require.NotNil(t, download)
+	schema := download.Responses["200"].Content["application/zip"].Schema
+	require.NotNil(t, schema)
+	assert.Equal(t, "string", schema.Type)
+	assert.Equal(t, "binary", schema.Format)
+}
+
+func TestSpec_IsUpToDate(t *testing.T) {
+	spec, err := openapi.Spec()
+	require.NoError(t, err)
+
+	committed, err := os.ReadFile("../../api/openapi.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(committed), string(spec), "api/openapi.json is out of date, run make openapi")
+}
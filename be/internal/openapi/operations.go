@@ -0,0 +1,433 @@
+package openapi
+
+import (
+	"net/http"
+
+	"strikepad-backend/internal/dto"
+)
+
+// Security schemes accepted by the API
+const (
+	BearerAuth = "bearerAuth"
+	CookieAuth = "cookieAuth"
+	APIKeyAuth = "apiKeyAuth"
+	AdminToken = "adminToken"
+)
+
+// Message is the body of responses that only confirm an action
+type Message struct {
+	Message string `json:"message" example:"Logout successful"`
+}
+
+// Route documents one endpoint of the API
+type Route struct {
+	// Request is the request body, nil for endpoints without one
+	Request any
+	// Upload is the file field of a multipart/form-data request body, for upload endpoints
+	Upload string
+	// Responses are the success response bodies by status; nil documents a response without body.
+	// Every operation also documents the error response.
+	Responses map[int]any
+	// Path uses Echo syntax; its :name segments become path parameters
+	Path        string
+	Method      string
+	OperationID string
+	Summary     string
+	Tag         string
+	Query       []Parameter
+	// Security lists the schemes the endpoint accepts, any one of them
+	Security []string
+	// Download is the media type of the file the success response returns, for download endpoints
+	Download string
+	// Stream documents the success response as a stream of Server-Sent Events, each carrying the
+	// response body as its data
+	Stream bool
+}
+
+var (
+	// userAuth are the ways regular user endpoints are authenticated
+	userAuth = []string{BearerAuth, CookieAuth}
+	// adminUserAuth accepts an admin session or an API key
+	adminUserAuth = []string{BearerAuth, CookieAuth, APIKeyAuth}
+
+	pageQuery = []Parameter{
+		queryParameter("page", "integer", "Page number, starting at 1"),
+		queryParameter("per_page", "integer", "Number of items per page"),
+		queryParameter("include_total", "boolean", "Count the total number of items"),
+	}
+	cursorQuery = append([]Parameter{
+		queryParameter("cursor", "string", "Continue after the page that returned this next_cursor, instead of page"),
+	}, pageQuery...)
+)
+
+// Routes are the documented endpoints. Keep them in sync with the routes the handlers declare in
+// internal/handler/routes.go.
+var Routes = []Route{
+	{
+		Method: http.MethodGet, Path: "/health", OperationID: "getHealth", Tag: "System",
+		Summary: "Check that the service and its database pools are up",
+		Responses: map[int]any{
+			http.StatusOK:                 dto.HealthResponse{},
+			http.StatusServiceUnavailable: dto.HealthResponse{},
+		},
+	},
+	{
+		Method: http.MethodGet, Path: "/health/live", OperationID: "getLiveness", Tag: "System",
+		Summary:   "Check that the process is up, without checking its dependencies",
+		Responses: map[int]any{http.StatusOK: dto.HealthResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/health/ready", OperationID: "getReadiness", Tag: "System",
+		Summary: "Check that the service can serve requests",
+		Responses: map[int]any{
+			http.StatusOK:                 dto.HealthResponse{},
+			http.StatusServiceUnavailable: dto.HealthResponse{},
+		},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/status", OperationID: "getStatus", Tag: "System",
+		Summary:   "Get the public status of the service's components",
+		Responses: map[int]any{http.StatusOK: dto.StatusResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/signup", OperationID: "signup", Tag: "Auth",
+		Summary:   "Create an account with email and password",
+		Request:   dto.SignupRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.AuthResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/login", OperationID: "login", Tag: "Auth",
+		Summary:   "Log in with email and password",
+		Request:   dto.LoginRequest{},
+		Responses: map[int]any{http.StatusOK: dto.LoginResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/google/signup", OperationID: "googleSignup", Tag: "Auth",
+		Summary:   "Create an account with a Google token",
+		Request:   dto.GoogleSignupRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.AuthResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/google/login", OperationID: "googleLogin", Tag: "Auth",
+		Summary:   "Log in with a Google token",
+		Request:   dto.GoogleLoginRequest{},
+		Responses: map[int]any{http.StatusOK: dto.LoginResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/auth/google/authorize", OperationID: "googleAuthorize", Tag: "Auth",
+		Summary:   "Redirect to Google to start the authorization code flow",
+		Responses: map[int]any{http.StatusFound: nil},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/auth/google/callback", OperationID: "googleCallback", Tag: "Auth",
+		Summary: "Complete the Google authorization code flow and log in",
+		Query: []Parameter{
+			queryParameter("code", "string", "Authorization code issued by Google"),
+			queryParameter("state", "string", "State passed to Google by the authorize endpoint"),
+			queryParameter("error", "string", "Error reported by Google"),
+		},
+		Responses: map[int]any{http.StatusOK: dto.LoginResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/:provider/signup", OperationID: "providerSignup", Tag: "Auth",
+		Summary:   "Create an account with a registered OAuth provider",
+		Request:   dto.OAuthRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.AuthResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/:provider/login", OperationID: "providerLogin", Tag: "Auth",
+		Summary:   "Log in with a registered OAuth provider",
+		Request:   dto.OAuthRequest{},
+		Responses: map[int]any{http.StatusOK: dto.LoginResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/auth/csrf", OperationID: "getCSRFToken", Tag: "Auth",
+		Summary:   "Get the CSRF token for requests authenticated by session cookies",
+		Responses: map[int]any{http.StatusOK: dto.CSRFTokenResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/logout", OperationID: "logout", Tag: "Sessions",
+		Summary:   "End the current session",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/auth/sessions", OperationID: "listSessions", Tag: "Sessions",
+		Summary:   "List the active sessions of the current user, newest first",
+		Security:  userAuth,
+		Query:     cursorQuery,
+		Responses: map[int]any{http.StatusOK: dto.SessionListResponse{}},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/auth/sessions/:id", OperationID: "revokeSession", Tag: "Sessions",
+		Summary:   "Revoke one of the current user's sessions",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/auth/sessions/revoke", OperationID: "revokeSessionByToken", Tag: "Sessions",
+		Summary: "Revoke the session named by the link in a new sign-in alert email",
+		Query: []Parameter{
+			queryParameter("token", "string", "The token from the link; it expires after 7 days"),
+		},
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/users/me/identities/google", OperationID: "linkGoogleIdentity", Tag: "Users",
+		Summary:   "Link a Google account to the current user",
+		Security:  userAuth,
+		Request:   dto.LinkGoogleIdentityRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.IdentityInfo{}},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/users/me/identities/:provider", OperationID: "unlinkIdentity", Tag: "Users",
+		Summary:   "Unlink a provider account from the current user",
+		Security:  userAuth,
+		Request:   dto.UnlinkIdentityRequest{},
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/users/me/email", OperationID: "requestEmailChange", Tag: "Users",
+		Summary:   "Change the current user's email address once both the current and the new address confirm it",
+		Security:  userAuth,
+		Request:   dto.EmailChangeRequest{},
+		Responses: map[int]any{http.StatusAccepted: dto.EmailChangeResponse{}},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/users/me/email", OperationID: "cancelEmailChange", Tag: "Users",
+		Summary:   "Cancel the pending email change of the current user",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/auth/email-change/confirm", OperationID: "confirmEmailChange", Tag: "Users",
+		Summary:   "Confirm an email change with the token of a link emailed to the current or the new address",
+		Request:   dto.ConfirmEmailChangeRequest{},
+		Responses: map[int]any{http.StatusOK: dto.EmailChangeResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/users/me/security", OperationID: "getSecuritySettings", Tag: "Users",
+		Summary:   "Get how the current user can sign in",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: dto.SecuritySettingsResponse{}, http.StatusNotModified: nil},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/users/search", OperationID: "searchUsers", Tag: "Users",
+		Summary:  "Find users by the start of their display name or email address",
+		Security: userAuth,
+		Query: []Parameter{
+			queryParameter("q", "string", "Start of the display name or email address, at least 2 characters"),
+			queryParameter("limit", "integer", "Number of users to return, 10 by default and at most 20"),
+		},
+		Responses: map[int]any{http.StatusOK: dto.UserSearchResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/users/me/privacy", OperationID: "getPrivacySettings", Tag: "Users",
+		Summary:   "Get the privacy settings of the current user",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: dto.PrivacySettingsResponse{}, http.StatusNotModified: nil},
+	},
+	{
+		Method: http.MethodPatch, Path: "/api/users/me/privacy", OperationID: "updatePrivacySettings", Tag: "Users",
+		Summary:   "Change the privacy settings of the current user",
+		Security:  userAuth,
+		Request:   dto.PrivacySettingsRequest{},
+		Responses: map[int]any{http.StatusOK: dto.PrivacySettingsResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/users/me/avatar", OperationID: "uploadAvatar", Tag: "Users",
+		Summary:   "Replace the avatar of the current user with a JPEG, PNG or GIF image",
+		Security:  userAuth,
+		Upload:    "avatar",
+		Responses: map[int]any{http.StatusOK: dto.AvatarResponse{}},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/users/me/avatar", OperationID: "deleteAvatar", Tag: "Users",
+		Summary:   "Remove the avatar of the current user",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/users/me/export", OperationID: "requestDataExport", Tag: "Users",
+		Summary:   "Start assembling an archive of the current user's data, or return the one in progress",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusAccepted: dto.DataExportResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/users/me/exports/:id", OperationID: "getDataExport", Tag: "Users",
+		Summary:   "Get the status of a data export, with a temporary download link once it is ready",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: dto.DataExportResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/users/me/logins", OperationID: "listLogins", Tag: "Users",
+		Summary:   "List the sign-ins to the current user's account, including refused ones, newest first",
+		Security:  userAuth,
+		Query:     cursorQuery,
+		Responses: map[int]any{http.StatusOK: dto.LoginAttemptListResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/exports/:id/download", OperationID: "downloadDataExport", Tag: "Users",
+		Summary: "Download the archive of a data export through its temporary link",
+		Query: []Parameter{
+			queryParameter("token", "string", "Token of the download link"),
+		},
+		Download:  "application/zip",
+		Responses: map[int]any{http.StatusOK: nil},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/notifications/stream", OperationID: "streamNotifications",
+		Tag:     "Notifications",
+		Summary: "Receive the notifications of the current user as Server-Sent Events",
+		Query: []Parameter{
+			queryParameter("cursor", "string", "ID of the last notification received, when Last-Event-ID cannot be set"),
+		},
+		Security:  userAuth,
+		Stream:    true,
+		Responses: map[int]any{http.StatusOK: dto.Notification{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/profile/onboarding", OperationID: "getOnboarding", Tag: "Profile",
+		Summary:   "Get the onboarding state of the current user",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: dto.OnboardingResponse{}, http.StatusNotModified: nil},
+	},
+	{
+		Method: http.MethodPatch, Path: "/api/profile/onboarding", OperationID: "updateOnboarding", Tag: "Profile",
+		Summary:   "Update the onboarding state of the current user",
+		Security:  userAuth,
+		Request:   dto.OnboardingRequest{},
+		Responses: map[int]any{http.StatusOK: dto.OnboardingResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/waitlist", OperationID: "joinWaitlist", Tag: "Waitlist",
+		Summary:   "Join the waitlist",
+		Request:   dto.WaitlistJoinRequest{},
+		Responses: map[int]any{http.StatusAccepted: Message{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/admin/waitlist", OperationID: "listWaitlistEntries", Tag: "Admin",
+		Summary:   "List the waitlist entries",
+		Security:  userAuth,
+		Query:     pageQuery,
+		Responses: map[int]any{http.StatusOK: dto.WaitlistListResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/admin/waitlist/invite", OperationID: "inviteWaitlistBatch", Tag: "Admin",
+		Summary:   "Invite the oldest waitlist entries",
+		Security:  userAuth,
+		Request:   dto.WaitlistInviteRequest{},
+		Responses: map[int]any{http.StatusOK: dto.WaitlistInviteResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/admin/outbox", OperationID: "getOutboxStats", Tag: "Admin",
+		Summary:   "Get the state of the email outbox",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: dto.OutboxStatsResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/admin/users", OperationID: "listUsers", Tag: "Admin",
+		Summary:  "List users",
+		Security: adminUserAuth,
+		Query: append([]Parameter{
+			queryParameter("sort", "string", "Field to sort by (id, created_at, display_name or email), "+
+				"prefixed with - for descending order; cursors are only accepted when sorting by id or created_at"),
+			queryParameter("provider_type", "string", "Only list users of this provider"),
+			queryParameter("email_prefix", "string", "Only list users whose email starts with this prefix"),
+		}, cursorQuery...),
+		Responses: map[int]any{http.StatusOK: dto.AdminUserListResponse{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/admin/users/:id/sessions", OperationID: "listUserSessions", Tag: "Admin",
+		Summary:   "List the active sessions of a user, newest first",
+		Security:  adminUserAuth,
+		Query:     cursorQuery,
+		Responses: map[int]any{http.StatusOK: dto.SessionListResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/admin/users/:id/disable", OperationID: "disableUser", Tag: "Admin",
+		Summary:   "Disable a user and end all of their sessions",
+		Security:  adminUserAuth,
+		Responses: map[int]any{http.StatusOK: dto.AdminUserInfo{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/admin/users/:id/enable", OperationID: "enableUser", Tag: "Admin",
+		Summary:   "Enable a disabled user",
+		Security:  adminUserAuth,
+		Responses: map[int]any{http.StatusOK: dto.AdminUserInfo{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/admin/users/:id/logout", OperationID: "forceLogout", Tag: "Admin",
+		Summary:   "End all sessions of a user",
+		Security:  adminUserAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/admin/users/:id/purge", OperationID: "purgeUser", Tag: "Admin",
+		Summary:   "Irreversibly erase the personal data of a user",
+		Security:  adminUserAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/admin/api-keys", OperationID: "listAPIKeys", Tag: "Admin",
+		Summary:   "List API keys",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: dto.APIKeyListResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/admin/api-keys", OperationID: "createAPIKey", Tag: "Admin",
+		Summary:   "Create an API key",
+		Security:  userAuth,
+		Request:   dto.CreateAPIKeyRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.APIKeyCreatedResponse{}},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/admin/api-keys/:id", OperationID: "revokeAPIKey", Tag: "Admin",
+		Summary:   "Revoke an API key",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/invitations", OperationID: "createInvitation", Tag: "Admin",
+		Summary:   "Invite an email address to sign up",
+		Security:  userAuth,
+		Request:   dto.CreateInvitationRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.InvitationInfo{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/admin/webhooks", OperationID: "listWebhooks", Tag: "Admin",
+		Summary:   "List webhook endpoints",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: dto.WebhookListResponse{}},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/admin/webhooks", OperationID: "createWebhook", Tag: "Admin",
+		Summary:   "Register a webhook endpoint",
+		Security:  userAuth,
+		Request:   dto.CreateWebhookRequest{},
+		Responses: map[int]any{http.StatusCreated: dto.WebhookCreatedResponse{}},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/admin/webhooks/:id", OperationID: "deleteWebhook", Tag: "Admin",
+		Summary:   "Delete a webhook endpoint",
+		Security:  userAuth,
+		Responses: map[int]any{http.StatusOK: Message{}},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/admin/audit-logs", OperationID: "listAuditLogs", Tag: "Admin",
+		Summary:  "List audit log entries, newest first",
+		Security: adminUserAuth,
+		Query: append([]Parameter{
+			queryParameter("action", "string", "Only list entries of this action, such as auth.login_failed"),
+			queryParameter("actor_id", "string", "Only list entries of the user or API key with this public ID"),
+			queryParameter("target_id", "string", "Only list entries about the resource with this public ID"),
+			queryParameter("since", "string", "Only list entries recorded at or after this RFC 3339 time"),
+			queryParameter("until", "string", "Only list entries recorded before this RFC 3339 time"),
+		}, cursorQuery...),
+		Responses: map[int]any{http.StatusOK: dto.AuditLogListResponse{}},
+	},
+}
+
+func queryParameter(name, schemaType, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: schemaType}}
+}
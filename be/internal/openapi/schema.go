@@ -0,0 +1,208 @@
+package openapi
+
+import (
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"strikepad-backend/internal/serializer"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaPackages are the packages whose types keep their plain name as schema name; types of
+// other packages are prefixed with their package name (pagination.Meta is PaginationMeta)
+var schemaPackages = map[string]bool{"dto": true, "openapi": true}
+
+// schemaGenerator builds schemas from Go types the way the JSON serializer writes them. Named
+// structs become components, referenced from where they are used.
+type schemaGenerator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaFor returns the schema of values of type t
+func (g *schemaGenerator) schemaFor(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Struct && t.Name() == "":
+		return g.objectSchema(t)
+	case t.Kind() == reflect.Struct:
+		return &Schema{Ref: "#/components/schemas/" + g.component(t)}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: ptr(0.0)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	default:
+		// Interfaces can hold any value
+		return &Schema{}
+	}
+}
+
+// component registers the schema of named struct t and returns its name
+func (g *schemaGenerator) component(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	// External test packages share the name of the package they test
+	if pkg := strings.TrimSuffix(path.Base(t.PkgPath()), "_test"); !schemaPackages[pkg] {
+		name = exportedName(pkg) + name
+	}
+	g.names[t] = name
+	// Registered before the fields are walked, so that recursive types terminate
+	g.schemas[name] = &Schema{}
+	*g.schemas[name] = *g.objectSchema(t)
+	return name
+}
+
+// objectSchema describes the fields of struct t that the serializer writes. A field is required
+// when its validate tag says so, or, for fields without validation rules, when the serializer
+// always writes it. Pointers that are written as null are nullable.
+func (g *schemaGenerator) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, f := range serializer.Fields(t) {
+		fieldType := f.StructField.Type
+		validate := f.StructField.Tag.Get("validate")
+
+		property := g.schemaFor(fieldType)
+		rules := applyValidateRules(property, validate)
+		if example, ok := f.StructField.Tag.Lookup("example"); ok && property.Ref == "" {
+			property.Example = parseExample(example, property)
+		}
+		if fieldType.Kind() == reflect.Pointer && !f.OmitEmpty && property.Ref == "" {
+			property.Nullable = true
+		}
+
+		schema.Properties[f.Name] = property
+		if rules["required"] || (validate == "" && !f.OmitEmpty) {
+			schema.Required = append(schema.Required, f.Name)
+		}
+	}
+	return schema
+}
+
+// applyValidateRules adds the constraints of a validate tag to schema and returns the rules that
+// apply to the field itself. Rules after dive apply to elements and are ignored.
+func applyValidateRules(schema *Schema, validate string) map[string]bool {
+	rules := make(map[string]bool)
+	if validate == "" {
+		return rules
+	}
+
+	for _, rule := range strings.Split(validate, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		if name == "dive" {
+			break
+		}
+		rules[name] = true
+
+		switch name {
+		case "email":
+			schema.Format = "email"
+		case "url":
+			schema.Format = "uri"
+		case "oneof":
+			schema.Enum = strings.Fields(param)
+		case "min", "max":
+			applyBound(schema, name == "min", param)
+		}
+	}
+	return rules
+}
+
+// applyBound sets the min or max rule of validator as length, item count or value bound
+func applyBound(schema *Schema, isMin bool, param string) {
+	n, err := strconv.Atoi(param)
+	if err != nil {
+		return
+	}
+
+	switch schema.Type {
+	case "string":
+		if isMin {
+			schema.MinLength = &n
+		} else {
+			schema.MaxLength = &n
+		}
+	case "array":
+		if isMin {
+			schema.MinItems = &n
+		} else {
+			schema.MaxItems = &n
+		}
+	case "integer", "number":
+		value := float64(n)
+		if isMin {
+			schema.Minimum = &value
+		} else {
+			schema.Maximum = &value
+		}
+	}
+}
+
+// parseExample converts an example tag to the type of the schema. Array examples are comma-separated.
+func parseExample(example string, schema *Schema) any {
+	switch schema.Type {
+	case "integer":
+		if n, err := strconv.ParseInt(example, 10, 64); err == nil {
+			return n
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(example, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(example); err == nil {
+			return b
+		}
+	case "array":
+		return strings.Split(example, ",")
+	}
+	return example
+}
+
+// exportedName capitalizes the first letter of name
+func exportedName(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,233 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// refPrefix is the prefix of references to component schemas
+const refPrefix = "#/components/schemas/"
+
+// Validator checks request and response bodies against the operations of a document, to catch
+// drift between the DTOs the handlers use and the documented ones
+type Validator struct {
+	doc *Document
+}
+
+// NewValidator returns a validator for the operations of doc
+func NewValidator(doc *Document) *Validator {
+	return &Validator{doc: doc}
+}
+
+// Operation returns the operation documented for method and Echo path, or nil for undocumented routes
+func (v *Validator) Operation(method, echoPath string) *Operation {
+	path, _ := convertPath(echoPath)
+	return v.doc.Paths[path][strings.ToLower(method)]
+}
+
+// ValidateRequest checks a JSON request body against the request body of operation. Operations
+// without a request body accept any body.
+func (v *Validator) ValidateRequest(operation *Operation, body []byte) error {
+	if operation.RequestBody == nil {
+		return nil
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		if operation.RequestBody.Required {
+			return stderrors.New("request body is required")
+		}
+		return nil
+	}
+	return v.validateBody(operation.RequestBody.Content, body)
+}
+
+// ValidateResponse checks a JSON response body against the response documented for status, or
+// the default response for undocumented statuses
+func (v *Validator) ValidateResponse(operation *Operation, status int, body []byte) error {
+	response, ok := operation.Responses[strconv.Itoa(status)]
+	if !ok {
+		response, ok = operation.Responses["default"]
+	}
+	if !ok {
+		return fmt.Errorf("status %d is not documented", status)
+	}
+	if len(response.Content) == 0 {
+		if len(bytes.TrimSpace(body)) > 0 {
+			return fmt.Errorf("status %d is documented without a body", status)
+		}
+		return nil
+	}
+	return v.validateBody(response.Content, body)
+}
+
+// validateBody decodes body and checks it against the JSON schema of content
+func (v *Validator) validateBody(content map[string]MediaType, body []byte) error {
+	mediaType, ok := content["application/json"]
+	if !ok || mediaType.Schema == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// Numbers are kept as written, so that integers can be told apart from other numbers
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	var mismatches []error
+	v.validate(mediaType.Schema, value, "$", &mismatches)
+	return stderrors.Join(mismatches...)
+}
+
+// validate checks value against schema and adds a mismatch for every violation to mismatches.
+// location is the JSON path of value, for the messages.
+func (v *Validator) validate(schema *Schema, value any, location string, mismatches *[]error) {
+	fail := func(format string, args ...any) {
+		*mismatches = append(*mismatches, fmt.Errorf("%s: %s", location, fmt.Sprintf(format, args...)))
+	}
+
+	if schema.Ref != "" {
+		resolved, ok := v.doc.Components.Schemas[strings.TrimPrefix(schema.Ref, refPrefix)]
+		if !ok {
+			fail("unknown schema %s", schema.Ref)
+			return
+		}
+		schema = resolved
+	}
+	// Schemas without a type, such as those of interface fields, accept any value
+	if schema.Type == "" {
+		return
+	}
+	if value == nil {
+		if !schema.Nullable {
+			fail("must not be null")
+		}
+		return
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			fail("must be an object")
+			return
+		}
+		v.validateObject(schema, object, location, mismatches)
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			fail("must be an array")
+			return
+		}
+		if schema.MinItems != nil && len(items) < *schema.MinItems {
+			fail("must have at least %d items", *schema.MinItems)
+		}
+		if schema.MaxItems != nil && len(items) > *schema.MaxItems {
+			fail("must have at most %d items", *schema.MaxItems)
+		}
+		if schema.Items != nil {
+			for i, item := range items {
+				v.validate(schema.Items, item, location+"["+strconv.Itoa(i)+"]", mismatches)
+			}
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			fail("must be a string")
+			return
+		}
+		if message := validateString(schema, s); message != "" {
+			fail("%s", message)
+		}
+	case "integer", "number":
+		n, ok := value.(json.Number)
+		if !ok {
+			fail("must be a number")
+			return
+		}
+		if message := validateNumber(schema, n); message != "" {
+			fail("%s", message)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail("must be a boolean")
+		}
+	}
+}
+
+// validateObject checks the properties of object. Properties the schema does not describe are
+// mismatches, except in maps, whose values are checked against AdditionalProperties.
+func (v *Validator) validateObject(schema *Schema, object map[string]any, location string, mismatches *[]error) {
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			*mismatches = append(*mismatches, fmt.Errorf("%s.%s: is required", location, name))
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	// Sorted, so that mismatches are reported in a stable order
+	slices.Sort(names)
+
+	for _, name := range names {
+		property, ok := schema.Properties[name]
+		if !ok {
+			property = schema.AdditionalProperties
+		}
+		if property == nil {
+			*mismatches = append(*mismatches, fmt.Errorf("%s.%s: is not documented", location, name))
+			continue
+		}
+		v.validate(property, object[name], location+"."+name, mismatches)
+	}
+}
+
+// validateString returns why s does not match schema, or "" when it does
+func validateString(schema *Schema, s string) string {
+	length := utf8.RuneCountInString(s)
+	switch {
+	case len(schema.Enum) > 0 && !slices.Contains(schema.Enum, s):
+		return fmt.Sprintf("must be one of %s", strings.Join(schema.Enum, ", "))
+	case schema.MinLength != nil && length < *schema.MinLength:
+		return fmt.Sprintf("must be at least %d characters long", *schema.MinLength)
+	case schema.MaxLength != nil && length > *schema.MaxLength:
+		return fmt.Sprintf("must be at most %d characters long", *schema.MaxLength)
+	}
+
+	if schema.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+			return "must be an RFC 3339 date-time"
+		}
+	}
+	return ""
+}
+
+// validateNumber returns why n does not match schema, or "" when it does
+func validateNumber(schema *Schema, n json.Number) string {
+	value, err := n.Float64()
+	if err != nil {
+		return "must be a number"
+	}
+	if schema.Type == "integer" {
+		if _, err := n.Int64(); err != nil {
+			return "must be an integer"
+		}
+	}
+
+	switch {
+	case schema.Minimum != nil && value < *schema.Minimum:
+		return fmt.Sprintf("must be at least %v", *schema.Minimum)
+	case schema.Maximum != nil && value > *schema.Maximum:
+		return fmt.Sprintf("must be at most %v", *schema.Maximum)
+	}
+	return ""
+}
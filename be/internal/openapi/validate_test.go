@@ -0,0 +1,134 @@
+package openapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"strikepad-backend/internal/openapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	validator := openapi.NewValidator(openapi.Build([]openapi.Route{
+		{
+			Method:      http.MethodPut,
+			Path:        "/api/widgets/:id",
+			OperationID: "updateWidget",
+			Request:     WidgetRequest{},
+			Responses:   map[int]any{http.StatusOK: WidgetResponse{}, http.StatusNoContent: nil},
+		},
+		{Method: http.MethodGet, Path: "/api/widgets", OperationID: "listWidgets"},
+	}))
+
+	operation := validator.Operation(http.MethodPut, "/api/widgets/:id")
+	require.NotNil(t, operation)
+	assert.Equal(t, "updateWidget", operation.OperationID)
+	assert.Nil(t, validator.Operation(http.MethodPost, "/api/widgets/:id"))
+
+	t.Run("request", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			body     string
+			expected string
+		}{
+			{name: "valid", body: `{"name":"Gadget","kind":"small","count":3,"tags":["a"]}`},
+			{name: "missing body", expected: "request body is required"},
+			{name: "invalid JSON", body: `{"name":`, expected: "invalid JSON"},
+			{name: "missing required property", body: `{"name":"Gadget"}`, expected: "$.kind: is required"},
+			{name: "value not in enum", body: `{"name":"Gadget","kind":"huge"}`, expected: "$.kind: must be one of small, large"},
+			{name: "too short", body: `{"name":"G","kind":"small"}`, expected: "$.name: must be at least 2 characters long"},
+			{name: "wrong type", body: `{"name":"Gadget","kind":"small","count":"3"}`, expected: "$.count: must be a number"},
+			{name: "not an integer", body: `{"name":"Gadget","kind":"small","count":1.5}`, expected: "$.count: must be an integer"},
+			{name: "below minimum", body: `{"name":"Gadget","kind":"small","count":0}`, expected: "$.count: must be at least 1"},
+			{name: "undocumented property", body: `{"name":"Gadget","kind":"small","colour":"red"}`, expected: "$.colour: is not documented"},
+			{name: "invalid item", body: `{"name":"Gadget","kind":"small","tags":[1]}`, expected: "$.tags[0]: must be a string"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := validator.ValidateRequest(operation, []byte(tt.body))
+
+				if tt.expected == "" {
+					assert.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expected)
+			})
+		}
+	})
+
+	t.Run("response", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			body     string
+			expected string
+			status   int
+		}{
+			{
+				name:   "valid",
+				status: http.StatusOK,
+				body: `{"id":"wdg_1","created_at":"2026-10-16T09:30:00Z","deleted_at":null,` +
+					`"labels":{"colour":"red"},"owner":{"name":"Alice"}}`,
+			},
+			{
+				name:   "optional property omitted",
+				status: http.StatusOK,
+				body:   `{"id":"wdg_1","created_at":"2026-10-16T09:30:00Z","deleted_at":null,"labels":{}}`,
+			},
+			{
+				name:     "renamed property",
+				status:   http.StatusOK,
+				body:     `{"ID":"wdg_1","created_at":"2026-10-16T09:30:00Z","deleted_at":null,"labels":{}}`,
+				expected: "$.id: is required\n$.ID: is not documented",
+			},
+			{
+				name:     "not nullable",
+				status:   http.StatusOK,
+				body:     `{"id":null,"created_at":"2026-10-16T09:30:00Z","deleted_at":null,"labels":{}}`,
+				expected: "$.id: must not be null",
+			},
+			{
+				name:     "invalid date-time",
+				status:   http.StatusOK,
+				body:     `{"id":"wdg_1","created_at":"yesterday","deleted_at":null,"labels":{}}`,
+				expected: "$.created_at: must be an RFC 3339 date-time",
+			},
+			{
+				name:     "invalid map value",
+				status:   http.StatusOK,
+				body:     `{"id":"wdg_1","created_at":"2026-10-16T09:30:00Z","deleted_at":null,"labels":{"size":2}}`,
+				expected: "$.labels.size: must be a string",
+			},
+			{name: "documented without body", status: http.StatusNoContent},
+			{
+				name:     "body of a response without body",
+				status:   http.StatusNoContent,
+				body:     `{"message":"deleted"}`,
+				expected: "status 204 is documented without a body",
+			},
+			{name: "error response", status: http.StatusNotFound, body: `{"code":"E004","message":"Not found"}`},
+			{
+				name:     "invalid error response",
+				status:   http.StatusNotFound,
+				body:     `{"error":"not found"}`,
+				expected: "$.code: is required",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := validator.ValidateResponse(operation, tt.status, []byte(tt.body))
+
+				if tt.expected == "" {
+					assert.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,250 @@
+package pagination
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+
+	"strikepad-backend/internal/config"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultPerPage is the page size used when Params.PerPage is not set
+	DefaultPerPage = 20
+	// MaxPerPage is the largest page size any list returns
+	MaxPerPage = 200
+
+	// CountModeExact counts matching rows with COUNT(*)
+	CountModeExact = "exact"
+	// CountModeEstimated reads the row estimate from the query planner and only counts exactly
+	// when the estimate is small, which keeps totals cheap on large tables
+	CountModeEstimated = "estimated"
+
+	// exactCountBelow is the estimate under which an exact count is cheap enough to run anyway
+	exactCountBelow = 10000
+)
+
+var (
+	// ErrInvalidPage is returned for a page query parameter that is not a positive integer
+	ErrInvalidPage = errors.New("page must be a positive integer")
+	// ErrInvalidIncludeTotal is returned for an include_total query parameter that is not a boolean
+	ErrInvalidIncludeTotal = errors.New("include_total must be true or false")
+	// ErrCursorWithPage is returned when a query sets both cursor and page
+	ErrCursorWithPage = errors.New("cursor and page cannot be combined")
+	// ErrInvalidCursor is returned by Seek for a cursor that names no row of the list
+	ErrInvalidCursor = errors.New("cursor does not name an item of the list")
+)
+
+// Params selects a page of a list
+type Params struct {
+	Page    int
+	PerPage int
+	// Cursor is the public ID of the last row of the previous page, for lists read with Seek. The
+	// page starts after that row and Page is ignored.
+	Cursor string
+	// IncludeTotal requests the number of matching rows, which costs an extra query
+	IncludeTotal bool
+}
+
+// Normalized returns params with defaults filled in and the page bounds clamped
+func (p Params) Normalized() Params {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PerPage < 1 {
+		p.PerPage = DefaultPerPage
+	}
+	if p.PerPage > MaxPerPage {
+		p.PerPage = MaxPerPage
+	}
+	return p
+}
+
+// Offset returns the number of rows before the page
+func (p Params) Offset() int {
+	return (p.Page - 1) * p.PerPage
+}
+
+// ParseQuery reads page, per_page and include_total from query parameters. per_page defaults to
+// defaultPerPage and may not exceed maxPerPage.
+func ParseQuery(query url.Values, defaultPerPage, maxPerPage int) (Params, error) {
+	params := Params{Page: 1, PerPage: defaultPerPage}
+
+	if raw := query.Get("page"); raw != "" {
+		page, err := strconv.Atoi(raw)
+		if err != nil || page < 1 {
+			return Params{}, ErrInvalidPage
+		}
+		params.Page = page
+	}
+
+	if raw := query.Get("per_page"); raw != "" {
+		perPage, err := strconv.Atoi(raw)
+		if err != nil || perPage < 1 || perPage > maxPerPage {
+			return Params{}, fmt.Errorf("per_page must be between 1 and %d", maxPerPage)
+		}
+		params.PerPage = perPage
+	}
+
+	if raw := query.Get("include_total"); raw != "" {
+		includeTotal, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Params{}, ErrInvalidIncludeTotal
+		}
+		params.IncludeTotal = includeTotal
+	}
+
+	return params, nil
+}
+
+// ParseCursorQuery reads the parameters of ParseQuery and cursor, for lists read with Seek. A
+// cursor continues the list after the page that returned it as next_cursor, and cannot be
+// combined with page.
+func ParseCursorQuery(query url.Values, defaultPerPage, maxPerPage int) (Params, error) {
+	params, err := ParseQuery(query, defaultPerPage, maxPerPage)
+	if err != nil {
+		return Params{}, err
+	}
+	if params.Cursor = query.Get("cursor"); params.Cursor != "" && query.Has("page") {
+		return Params{}, ErrCursorWithPage
+	}
+	return params, nil
+}
+
+// Meta is the pagination metadata returned with every page of a list. Total is only present
+// when it was requested with include_total=true, Page is left out of pages read by cursor, and
+// NextCursor is only set by lists read with Seek when more items follow.
+type Meta struct {
+	Total          *int64 `json:"total,omitempty"`
+	NextCursor     string `json:"next_cursor,omitempty" example:"aud_3m8q1x5k9d2v7h0c4b6n"`
+	Page           int    `json:"page,omitempty"`
+	PerPage        int    `json:"per_page"`
+	HasMore        bool   `json:"has_more"`
+	TotalEstimated bool   `json:"total_estimated,omitempty"`
+}
+
+// Page is one page of rows and what is known about the rest of the list
+type Page[T any] struct {
+	Total          *int64
+	NextCursor     string
+	Items          []T
+	HasMore        bool
+	TotalEstimated bool
+}
+
+// Meta returns the pagination metadata of the page selected by params
+func (p *Page[T]) Meta(params Params) Meta {
+	params = params.Normalized()
+	meta := Meta{
+		Page:           params.Page,
+		PerPage:        params.PerPage,
+		HasMore:        p.HasMore,
+		Total:          p.Total,
+		TotalEstimated: p.TotalEstimated,
+		NextCursor:     p.NextCursor,
+	}
+	if params.Cursor != "" {
+		meta.Page = 0
+	}
+	return meta
+}
+
+// Complete returns the metadata of a list returned whole, as a single page of count items
+func Complete(count int) Meta {
+	total := int64(count)
+	return Meta{Page: 1, PerPage: count, Total: &total}
+}
+
+// Find loads the page of query selected by params, in the given order. One row beyond the page is
+// read to tell whether more follow; the rows are only counted when params.IncludeTotal is set,
+// exactly or by estimate depending on PAGINATION_COUNT_MODE.
+func Find[T any](query *gorm.DB, order string, params Params) (*Page[T], error) {
+	params = params.Normalized()
+	page, err := newPage[T](query, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := page.load(query.Session(&gorm.Session{}).Order(order).Offset(params.Offset()), params); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// newPage returns an empty page of query, with the total filled in when params.IncludeTotal is set
+func newPage[T any](query *gorm.DB, params Params) (*Page[T], error) {
+	page := &Page[T]{}
+	if params.IncludeTotal {
+		total, estimated, err := count[T](query)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = &total
+		page.TotalEstimated = estimated
+	}
+	return page, nil
+}
+
+// load reads the items of the page from rows, which are ordered and positioned, and one more to
+// tell whether more follow
+func (p *Page[T]) load(rows *gorm.DB, params Params) error {
+	var items []T
+	if err := rows.Limit(params.PerPage + 1).Find(&items).Error; err != nil {
+		return err
+	}
+	if len(items) > params.PerPage {
+		items = items[:params.PerPage]
+		p.HasMore = true
+	}
+	p.Items = items
+	return nil
+}
+
+// count returns the number of rows matching query and whether it is an estimate
+func count[T any](query *gorm.DB) (int64, bool, error) {
+	if config.GetEnv("PAGINATION_COUNT_MODE", CountModeExact) == CountModeEstimated {
+		estimate, err := estimatedCount[T](query)
+		if err != nil {
+			// The estimate depends on planner output; an exact count is always possible
+			slog.Warn("Failed to estimate row count, counting exactly", "error", err)
+		} else if estimate >= exactCountBelow {
+			return estimate, true, nil
+		}
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Model(new(T)).Count(&total).Error; err != nil {
+		return 0, false, err
+	}
+	return total, false, nil
+}
+
+// estimatedCount returns the query planner's estimate of the rows matching query (PostgreSQL)
+func estimatedCount[T any](query *gorm.DB) (int64, error) {
+	var items []T
+	stmt := query.Session(&gorm.Session{DryRun: true}).Find(&items).Statement
+
+	var plan string
+	row := query.Session(&gorm.Session{NewDB: true}).
+		Raw("EXPLAIN (FORMAT JSON) "+stmt.SQL.String(), stmt.Vars...).Row()
+	if err := row.Scan(&plan); err != nil {
+		return 0, fmt.Errorf("failed to explain query: %w", err)
+	}
+
+	var explained []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explained); err != nil {
+		return 0, fmt.Errorf("failed to read query plan: %w", err)
+	}
+	if len(explained) == 0 {
+		return 0, errors.New("query plan is empty")
+	}
+	return int64(explained[0].Plan.Rows), nil
+}
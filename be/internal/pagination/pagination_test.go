@@ -0,0 +1,260 @@
+package pagination_test
+
+import (
+	"net/url"
+	"regexp"
+	"testing"
+
+	"strikepad-backend/internal/pagination"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type item struct {
+	Name     string
+	PublicID string
+	ID       uint
+}
+
+func (item) TableName() string {
+	return "items"
+}
+
+func newDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	return gormDB, mock
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		expectedErr error
+		name        string
+		query       string
+		expected    pagination.Params
+		expectError bool
+	}{
+		{
+			name:     "defaults",
+			query:    "",
+			expected: pagination.Params{Page: 1, PerPage: 50},
+		},
+		{
+			name:     "all parameters",
+			query:    "page=3&per_page=10&include_total=true",
+			expected: pagination.Params{Page: 3, PerPage: 10, IncludeTotal: true},
+		},
+		{
+			name:        "page is not a number",
+			query:       "page=first",
+			expectError: true,
+			expectedErr: pagination.ErrInvalidPage,
+		},
+		{
+			name:        "page is zero",
+			query:       "page=0",
+			expectError: true,
+			expectedErr: pagination.ErrInvalidPage,
+		},
+		{
+			name:        "per_page above the maximum",
+			query:       "per_page=201",
+			expectError: true,
+		},
+		{
+			name:        "include_total is not a boolean",
+			query:       "include_total=yes",
+			expectError: true,
+			expectedErr: pagination.ErrInvalidIncludeTotal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			params, err := pagination.ParseQuery(values, 50, 200)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, params)
+			}
+		})
+	}
+}
+
+func TestParams_Normalized(t *testing.T) {
+	assert.Equal(t, pagination.Params{Page: 1, PerPage: pagination.DefaultPerPage}, pagination.Params{}.Normalized())
+	assert.Equal(t, pagination.Params{Page: 2, PerPage: pagination.MaxPerPage}, pagination.Params{Page: 2, PerPage: 1000}.Normalized())
+	assert.Equal(t, 20, pagination.Params{Page: 3, PerPage: 10}.Offset())
+}
+
+func TestFind(t *testing.T) {
+	t.Run("reads one row ahead to detect more pages", func(t *testing.T) {
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` ORDER BY id ASC LIMIT ? OFFSET ?")).
+			WithArgs(3, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "c").AddRow(4, "d").AddRow(5, "e"))
+
+		page, err := pagination.Find[item](db.Model(&item{}), "id ASC", pagination.Params{Page: 2, PerPage: 2})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.True(t, page.HasMore)
+		assert.Nil(t, page.Total)
+		assert.Equal(t, pagination.Meta{Page: 2, PerPage: 2, HasMore: true}, page.Meta(pagination.Params{Page: 2, PerPage: 2}))
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` WHERE name <> ? ORDER BY id ASC LIMIT ?")).
+			WithArgs("", 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a"))
+
+		page, err := pagination.Find[item](db.Model(&item{}).Where("name <> ?", ""), "id ASC", pagination.Params{PerPage: 2})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.False(t, page.HasMore)
+	})
+
+	t.Run("exact total", func(t *testing.T) {
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `items` WHERE name <> ?")).
+			WithArgs("").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` WHERE name <> ? ORDER BY id ASC LIMIT ?")).
+			WithArgs("", 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a").AddRow(2, "b").AddRow(3, "c"))
+
+		query := db.Model(&item{}).Where("name <> ?", "")
+		page, err := pagination.Find[item](query, "id ASC", pagination.Params{PerPage: 2, IncludeTotal: true})
+
+		require.NoError(t, err)
+		require.NotNil(t, page.Total)
+		assert.Equal(t, int64(7), *page.Total)
+		assert.False(t, page.TotalEstimated)
+	})
+
+	t.Run("estimated total for large results", func(t *testing.T) {
+		t.Setenv("PAGINATION_COUNT_MODE", pagination.CountModeEstimated)
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("EXPLAIN (FORMAT JSON) SELECT * FROM `items` WHERE name <> ?")).
+			WithArgs("").
+			WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).AddRow(`[{"Plan":{"Plan Rows":250000}}]`))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` WHERE name <> ? ORDER BY id ASC LIMIT ?")).
+			WithArgs("", 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+		query := db.Model(&item{}).Where("name <> ?", "")
+		page, err := pagination.Find[item](query, "id ASC", pagination.Params{PerPage: 2, IncludeTotal: true})
+
+		require.NoError(t, err)
+		require.NotNil(t, page.Total)
+		assert.Equal(t, int64(250000), *page.Total)
+		assert.True(t, page.TotalEstimated)
+	})
+
+	t.Run("small estimates are counted exactly", func(t *testing.T) {
+		t.Setenv("PAGINATION_COUNT_MODE", pagination.CountModeEstimated)
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("EXPLAIN (FORMAT JSON) SELECT * FROM `items`")).
+			WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).AddRow(`[{"Plan":{"Plan Rows":12}}]`))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `items`")).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(9))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` ORDER BY id ASC LIMIT ?")).
+			WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+		page, err := pagination.Find[item](db.Model(&item{}), "id ASC", pagination.Params{PerPage: 2, IncludeTotal: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), *page.Total)
+		assert.False(t, page.TotalEstimated)
+	})
+}
+
+func TestParseCursorQuery(t *testing.T) {
+	params, err := pagination.ParseCursorQuery(url.Values{"cursor": {"itm_2"}, "per_page": {"10"}}, 50, 200)
+	require.NoError(t, err)
+	assert.Equal(t, pagination.Params{Page: 1, PerPage: 10, Cursor: "itm_2"}, params)
+
+	_, err = pagination.ParseCursorQuery(url.Values{"cursor": {"itm_2"}, "page": {"2"}}, 50, 200)
+	assert.ErrorIs(t, err, pagination.ErrCursorWithPage)
+
+	_, err = pagination.ParseCursorQuery(url.Values{"page": {"0"}}, 50, 200)
+	assert.ErrorIs(t, err, pagination.ErrInvalidPage)
+}
+
+func TestSeek(t *testing.T) {
+	publicID := func(i *item) string { return i.PublicID }
+	columns := []string{"id", "public_id", "name"}
+
+	t.Run("first page by offset", func(t *testing.T) {
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` ORDER BY id DESC LIMIT ?")).
+			WithArgs(3).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(9, "itm_9", "i").AddRow(8, "itm_8", "h").AddRow(7, "itm_7", "g"))
+
+		params := pagination.Params{PerPage: 2}
+		page, err := pagination.Seek(db.Model(&item{}), pagination.Keyset{Desc: true}, params, publicID)
+
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.Equal(t, pagination.Meta{Page: 1, PerPage: 2, HasMore: true, NextCursor: "itm_8"}, page.Meta(params))
+	})
+
+	t.Run("page after the cursor", func(t *testing.T) {
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `items` WHERE name <> ? AND public_id = ? LIMIT ?")).
+			WithArgs("", "itm_8", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `items` WHERE name <> ? AND id < ? ORDER BY id DESC LIMIT ?")).
+			WithArgs("", 8, 3).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(7, "itm_7", "g"))
+
+		params := pagination.Params{Page: 3, PerPage: 2, Cursor: "itm_8"}
+		page, err := pagination.Seek(db.Model(&item{}).Where("name <> ?", ""), pagination.Keyset{Desc: true}, params, publicID)
+
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.Equal(t, pagination.Meta{PerPage: 2}, page.Meta(params))
+	})
+
+	t.Run("unknown cursor", func(t *testing.T) {
+		db, mock := newDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `items` WHERE public_id = ? LIMIT ?")).
+			WithArgs("itm_0", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := pagination.Seek(db.Model(&item{}), pagination.Keyset{}, pagination.Params{Cursor: "itm_0"}, publicID)
+
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+}
+
+func TestComplete(t *testing.T) {
+	total := int64(3)
+	assert.Equal(t, pagination.Meta{Page: 1, PerPage: 3, Total: &total}, pagination.Complete(3))
+}
@@ -0,0 +1,65 @@
+package publicid
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Prefixes of the public IDs of each resource type
+const (
+	PrefixUser          = "usr"
+	PrefixSession       = "ses"
+	PrefixAPIKey        = "key"
+	PrefixWaitlistEntry = "wle"
+	PrefixEvent         = "evt"
+	PrefixWebhook       = "whk"
+	PrefixAuditLog      = "aud"
+	PrefixInvitation    = "inv"
+	PrefixNotification  = "ntf"
+	PrefixDataExport    = "exp"
+)
+
+// alphabet is lowercase base32 without i, l, o and u, so IDs are easy to read out and type
+const alphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+
+// randomLength is the number of random characters after the prefix, carrying 100 bits of randomness
+const randomLength = 20
+
+// ErrInvalid is returned for strings that are not public IDs of the expected type
+var ErrInvalid = errors.New("invalid public ID")
+
+// New generates a random public ID with the prefix, such as usr_5k2d9x0q7m3hv8c1b4na
+func New(prefix string) string {
+	b := make([]byte, randomLength)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand only fails when the platform's random source is unavailable
+		panic("failed to generate public ID: " + err.Error())
+	}
+
+	id := make([]byte, 0, len(prefix)+1+randomLength)
+	id = append(id, prefix...)
+	id = append(id, '_')
+	for _, c := range b {
+		id = append(id, alphabet[c%byte(len(alphabet))])
+	}
+	return string(id)
+}
+
+// Validate checks that id is a public ID with the prefix
+func Validate(prefix, id string) error {
+	random, ok := strings.CutPrefix(id, prefix+"_")
+	if !ok {
+		return fmt.Errorf("%w: expected prefix %s_", ErrInvalid, prefix)
+	}
+	if len(random) != randomLength {
+		return fmt.Errorf("%w: expected %d characters after the prefix", ErrInvalid, randomLength)
+	}
+	for _, c := range random {
+		if !strings.ContainsRune(alphabet, c) {
+			return fmt.Errorf("%w: unexpected character %q", ErrInvalid, c)
+		}
+	}
+	return nil
+}
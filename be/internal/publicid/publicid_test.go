@@ -0,0 +1,47 @@
+package publicid_test
+
+import (
+	"regexp"
+	"testing"
+
+	"strikepad-backend/internal/publicid"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	id := publicid.New(publicid.PrefixUser)
+
+	assert.Regexp(t, regexp.MustCompile(`^usr_[0-9a-hjkmnp-tv-z]{20}$`), id)
+	assert.NoError(t, publicid.Validate(publicid.PrefixUser, id))
+	assert.NotEqual(t, id, publicid.New(publicid.PrefixUser))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		id          string
+		expectError bool
+	}{
+		{name: "valid", prefix: publicid.PrefixSession, id: "ses_0123456789abcdefghjk"},
+		{name: "other prefix", prefix: publicid.PrefixSession, id: "usr_0123456789abcdefghjk", expectError: true},
+		{name: "numeric ID", prefix: publicid.PrefixSession, id: "42", expectError: true},
+		{name: "too short", prefix: publicid.PrefixSession, id: "ses_0123456789", expectError: true},
+		{name: "too long", prefix: publicid.PrefixSession, id: "ses_0123456789abcdefghjkm", expectError: true},
+		{name: "excluded character", prefix: publicid.PrefixSession, id: "ses_0123456789abcdefghil", expectError: true},
+		{name: "uppercase", prefix: publicid.PrefixSession, id: "ses_0123456789ABCDEFGHJK", expectError: true},
+		{name: "empty", prefix: publicid.PrefixSession, id: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := publicid.Validate(tt.prefix, tt.id)
+			if tt.expectError {
+				assert.ErrorIs(t, err, publicid.ErrInvalid)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
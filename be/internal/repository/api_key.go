@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrAPIKeyNotFound is returned when no API key matches the lookup
+var ErrAPIKeyNotFound = errors.New("API key not found")
+
+// APIKeyRepository handles database operations for API keys
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+// APIKeyRepositoryInterface defines the interface for API key repository
+type APIKeyRepositoryInterface interface {
+	Create(apiKey *model.APIKey) error
+	FindByHash(keyHash string) (*model.APIKey, error)
+	List() ([]model.APIKey, error)
+	Revoke(publicID string) error
+	UpdateLastUsed(id uint, usedAt time.Time) error
+}
+
+// NewAPIKeyRepository creates a new API key repository
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepositoryInterface {
+	return &APIKeyRepository{
+		db: db,
+	}
+}
+
+// Create stores a new API key. The creator must already exist and is not saved.
+func (r *APIKeyRepository) Create(apiKey *model.APIKey) error {
+	if err := r.db.Omit(clause.Associations).Create(apiKey).Error; err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+	return nil
+}
+
+// FindByHash finds the API key stored under a key hash, including revoked keys
+func (r *APIKeyRepository) FindByHash(keyHash string) (*model.APIKey, error) {
+	var apiKey model.APIKey
+	err := r.db.Where("key_hash = ?", keyHash).First(&apiKey).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to find API key: %w", err)
+	}
+
+	return &apiKey, nil
+}
+
+// List returns all API keys with their creators, newest first
+func (r *APIKeyRepository) List() ([]model.APIKey, error) {
+	var apiKeys []model.APIKey
+	if err := r.db.Preload("CreatedBy").Order("id DESC").Find(&apiKeys).Error; err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	return apiKeys, nil
+}
+
+// Revoke marks the API key with the public ID as revoked. Revoking a key that is already
+// revoked is not an error.
+func (r *APIKeyRepository) Revoke(publicID string) error {
+	result := r.db.Model(&model.APIKey{}).
+		Where("public_id = ?", publicID).
+		Updates(map[string]interface{}{
+			"revoked_at": gorm.Expr("COALESCE(revoked_at, ?)", time.Now()),
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke API key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// UpdateLastUsed records when an API key was last used
+func (r *APIKeyRepository) UpdateLastUsed(id uint, usedAt time.Time) error {
+	err := r.db.Model(&model.APIKey{}).Where("id = ?", id).Update("last_used_at", usedAt).Error
+	if err != nil {
+		return fmt.Errorf("failed to update API key last use: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.APIKeyRepositoryInterface
+}
+
+func (suite *APIKeyRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewAPIKeyRepository(gormDB)
+}
+
+func (suite *APIKeyRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `api_keys`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	apiKey := &model.APIKey{
+		Name:            "worker",
+		KeyPrefix:       "sk_0123abcd",
+		KeyHash:         "hash",
+		CreatedBy:       model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"},
+		CreatedByUserID: 1,
+	}
+	err := suite.repo.Create(apiKey)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), apiKey.ID)
+	assert.NoError(suite.T(), publicid.Validate(publicid.PrefixAPIKey, apiKey.PublicID))
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestFindByHash() {
+	testCases := []struct {
+		mockSetup   func()
+		expectedErr error
+		name        string
+		expectError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "name", "key_prefix", "key_hash", "created_by_user_id"}).
+					AddRow(3, "worker", "sk_0123abcd", "hash", 1)
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE key_hash = ?")).
+					WithArgs("hash", sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Not found",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE key_hash = ?")).
+					WithArgs("hash", sqlmock.AnyArg()).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectError: true,
+			expectedErr: repository.ErrAPIKeyNotFound,
+		},
+		{
+			name: "Database error",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE key_hash = ?")).
+					WithArgs("hash", sqlmock.AnyArg()).
+					WillReturnError(assert.AnError)
+			},
+			expectError: true,
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			apiKey, err := suite.repo.FindByHash("hash")
+
+			if tc.expectError {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, apiKey)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(3), apiKey.ID)
+				assert.Equal(t, "worker", apiKey.Name)
+			}
+		})
+	}
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestList() {
+	rows := sqlmock.NewRows([]string{"id", "name", "key_prefix", "created_by_user_id"}).
+		AddRow(2, "reporting", "sk_4567efab", 1).
+		AddRow(1, "worker", "sk_0123abcd", 1)
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `api_keys` ORDER BY id DESC")).
+		WillReturnRows(rows)
+	userRows := sqlmock.NewRows([]string{"id", "public_id"}).
+		AddRow(1, "usr_5k2d9x0q7m3hv8c1b4na")
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`id` = ?")).
+		WithArgs(1).
+		WillReturnRows(userRows)
+
+	apiKeys, err := suite.repo.List()
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), apiKeys, 2)
+	assert.Equal(suite.T(), "reporting", apiKeys[0].Name)
+	assert.Equal(suite.T(), "usr_5k2d9x0q7m3hv8c1b4na", apiKeys[0].CreatedBy.PublicID)
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestRevoke() {
+	testCases := []struct {
+		expectedErr  error
+		name         string
+		rowsAffected int64
+	}{
+		{
+			name:         "Success",
+			rowsAffected: 1,
+		},
+		{
+			name:         "Not found",
+			rowsAffected: 0,
+			expectedErr:  repository.ErrAPIKeyNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			suite.mock.ExpectBegin()
+			suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `api_keys` SET `revoked_at`=COALESCE(revoked_at, ?),`updated_at`=? WHERE public_id = ?")).
+				WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "key_8h2m4q0x9d7c3v1k5b6n").
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			suite.mock.ExpectCommit()
+
+			err := suite.repo.Revoke("key_8h2m4q0x9d7c3v1k5b6n")
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestUpdateLastUsed() {
+	usedAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `api_keys` SET `last_used_at`=?,`updated_at`=? WHERE id = ?")).
+		WithArgs(usedAt, sqlmock.AnyArg(), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.UpdateLastUsed(3, usedAt)
+
+	assert.NoError(suite.T(), err)
+}
+
+func TestAPIKeyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyRepositoryTestSuite))
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogListParams selects a page of audit log entries. Empty filters match every entry.
+type AuditLogListParams struct {
+	Since    *time.Time
+	Until    *time.Time
+	Action   string
+	ActorID  string
+	TargetID string
+	pagination.Params
+}
+
+// UserActivityListParams selects a page of the entries of Actions about a user: those the user with
+// the public ID ActorID did, and those without an actor naming Email that were recorded from
+// EmailSince, when the address was the user's.
+type UserActivityListParams struct {
+	EmailSince time.Time
+	Actions    []string
+	ActorID    string
+	Email      string
+	pagination.Params
+}
+
+// AuditLogRepository handles database operations for audit log entries
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+// AuditLogRepositoryInterface defines the interface for audit log repository
+type AuditLogRepositoryInterface interface {
+	Create(entry *model.AuditLog) error
+	List(params AuditLogListParams) (*pagination.Page[model.AuditLog], error)
+	ListByUser(publicID string, limit int) ([]model.AuditLog, error)
+	ListUserActivity(params UserActivityListParams) (*pagination.Page[model.AuditLog], error)
+}
+
+// NewAuditLogRepository creates a new audit log repository
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepositoryInterface {
+	return &AuditLogRepository{
+		db: db,
+	}
+}
+
+// Create stores a new audit log entry
+func (r *AuditLogRepository) Create(entry *model.AuditLog) error {
+	if err := r.db.Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to create audit log entry: %w", err)
+	}
+	return nil
+}
+
+// List returns a page of audit log entries matching params, newest first, read from a replica when
+// there is one. Pages after params.Cursor are found by ID, as the table grows large.
+func (r *AuditLogRepository) List(params AuditLogListParams) (*pagination.Page[model.AuditLog], error) {
+	query := onReplica(r.db).Model(&model.AuditLog{})
+	if params.Action != "" {
+		query = query.Where("action = ?", params.Action)
+	}
+	if params.ActorID != "" {
+		query = query.Where("actor_id = ?", params.ActorID)
+	}
+	if params.TargetID != "" {
+		query = query.Where("target_id = ?", params.TargetID)
+	}
+	if params.Since != nil {
+		query = query.Where("created_at >= ?", *params.Since)
+	}
+	if params.Until != nil {
+		query = query.Where("created_at < ?", *params.Until)
+	}
+
+	page, err := pagination.Seek(query, pagination.Keyset{Desc: true}, params.Params, func(entry *model.AuditLog) string {
+		return entry.PublicID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log entries: %w", err)
+	}
+	return page, nil
+}
+
+// ListByUser returns up to limit of the entries in which the user with publicID is the actor or the
+// target, oldest first
+func (r *AuditLogRepository) ListByUser(publicID string, limit int) ([]model.AuditLog, error) {
+	var entries []model.AuditLog
+	err := onReplica(r.db).Where("actor_id = ? OR target_id = ?", publicID, publicID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log entries of user: %w", err)
+	}
+	return entries, nil
+}
+
+// ListUserActivity returns a page of the entries about a user selected by params, newest first, read
+// from a replica when there is one
+func (r *AuditLogRepository) ListUserActivity(params UserActivityListParams) (*pagination.Page[model.AuditLog], error) {
+	query := onReplica(r.db).Model(&model.AuditLog{}).Where("action IN ?", params.Actions)
+	if params.Email != "" {
+		email, err := json.Marshal(params.Email)
+		if err != nil {
+			return nil, err
+		}
+		pattern := "%" + likeEscaper.Replace(`"email":`+string(email)) + "%"
+		query = query.Where("actor_id = ? OR (actor_id IS NULL AND metadata LIKE ? AND created_at >= ?)",
+			params.ActorID, pattern, params.EmailSince)
+	} else {
+		query = query.Where("actor_id = ?", params.ActorID)
+	}
+
+	page, err := pagination.Seek(query, pagination.Keyset{Desc: true}, params.Params, func(entry *model.AuditLog) string {
+		return entry.PublicID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log entries of user: %w", err)
+	}
+	return page, nil
+}
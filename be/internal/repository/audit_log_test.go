@@ -0,0 +1,264 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/test/testutil"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo repository.AuditLogRepositoryInterface
+}
+
+func (suite *AuditLogRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.mock = mock
+	suite.repo = repository.NewAuditLogRepository(gormDB)
+}
+
+func (suite *AuditLogRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuditLogRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `audit_logs`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	entry := &model.AuditLog{Action: "auth.logout", Metadata: "{}"}
+	err := suite.repo.Create(entry)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), entry.ID)
+	assert.NoError(suite.T(), publicid.Validate(publicid.PrefixAuditLog, entry.PublicID))
+}
+
+func (suite *AuditLogRepositoryTestSuite) TestList() {
+	columns := []string{"id", "public_id", "action", "actor_id", "target_id", "metadata", "created_at"}
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 7)
+
+	testCases := []struct {
+		mockSetup     func()
+		name          string
+		params        repository.AuditLogListParams
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name:   "no filters",
+			params: repository.AuditLogListParams{},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs` ORDER BY id DESC LIMIT ?")).
+					WithArgs(pagination.DefaultPerPage + 1).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(2, "aud_3m8q1x5k9d2v7h0c4b6n", "auth.logout", "usr_5k2d9x0q7m3hv8c1b4na", nil, "{}", since).
+						AddRow(1, "aud_7d2k9m4q0x8h3v1c5b6n", "auth.login_failed", nil, nil, "{}", since))
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "every filter",
+			params: repository.AuditLogListParams{
+				Action:   "admin.action",
+				ActorID:  "usr_5k2d9x0q7m3hv8c1b4na",
+				TargetID: "usr_0123456789abcdefghjk",
+				Since:    &since,
+				Until:    &until,
+				Params:   pagination.Params{PerPage: 10},
+			},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs` WHERE action = ? AND actor_id = ? "+
+					"AND target_id = ? AND created_at >= ? AND created_at < ? ORDER BY id DESC LIMIT ?")).
+					WithArgs("admin.action", "usr_5k2d9x0q7m3hv8c1b4na", "usr_0123456789abcdefghjk", since, until, 11).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "after a cursor",
+			params: repository.AuditLogListParams{
+				Action: "auth.logout",
+				Params: pagination.Params{PerPage: 1, Cursor: "aud_3m8q1x5k9d2v7h0c4b6n"},
+			},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT `id` FROM `audit_logs` WHERE action = ? AND public_id = ? LIMIT ?")).
+					WithArgs("auth.logout", "aud_3m8q1x5k9d2v7h0c4b6n", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				suite.mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `audit_logs` WHERE action = ? AND id < ? ORDER BY id DESC LIMIT ?")).
+					WithArgs("auth.logout", 2, 2).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(1, "aud_7d2k9m4q0x8h3v1c5b6n", "auth.logout", nil, nil, "{}", since))
+			},
+			expectedCount: 1,
+		},
+		{
+			name:   "database error",
+			params: repository.AuditLogListParams{},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs`")).
+					WillReturnError(assert.AnError)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			page, err := suite.repo.List(tc.params)
+
+			if tc.expectError {
+				assert.ErrorIs(t, err, assert.AnError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, page.Items, tc.expectedCount)
+		})
+	}
+}
+
+func (suite *AuditLogRepositoryTestSuite) TestListByUser() {
+	columns := []string{"id", "public_id", "action", "actor_id", "target_id", "metadata", "created_at"}
+	createdAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `audit_logs` WHERE actor_id = ? OR target_id = ? ORDER BY id ASC LIMIT ?")).
+		WithArgs("usr_5k2d9x0q7m3hv8c1b4na", "usr_5k2d9x0q7m3hv8c1b4na", 100).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "aud_7d2k9m4q0x8h3v1c5b6n", "auth.login", "usr_5k2d9x0q7m3hv8c1b4na", nil, "{}", createdAt).
+			AddRow(2, "aud_3m8q1x5k9d2v7h0c4b6n", "admin.action", nil, "usr_5k2d9x0q7m3hv8c1b4na", "{}", createdAt))
+
+	entries, err := suite.repo.ListByUser("usr_5k2d9x0q7m3hv8c1b4na", 100)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), entries, 2)
+	assert.Equal(suite.T(), "auth.login", entries[0].Action)
+}
+
+func (suite *AuditLogRepositoryTestSuite) TestListUserActivity() {
+	columns := []string{"id", "public_id", "action", "actor_id", "target_id", "metadata", "created_at"}
+	createdAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	actions := []string{"auth.login_succeeded", "auth.login_failed"}
+
+	testCases := []struct {
+		mockSetup     func()
+		name          string
+		params        repository.UserActivityListParams
+		expectedCount int
+	}{
+		{
+			name: "with an email address",
+			params: repository.UserActivityListParams{
+				Actions:    actions,
+				ActorID:    "usr_5k2d9x0q7m3hv8c1b4na",
+				Email:      "test_user@example.com",
+				EmailSince: createdAt,
+			},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs` WHERE action IN (?,?) AND "+
+					"(actor_id = ? OR (actor_id IS NULL AND metadata LIKE ? AND created_at >= ?)) ORDER BY id DESC LIMIT ?")).
+					WithArgs("auth.login_succeeded", "auth.login_failed", "usr_5k2d9x0q7m3hv8c1b4na",
+						`%"email":"test\_user@example.com"%`, createdAt, pagination.DefaultPerPage+1).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(2, "aud_3m8q1x5k9d2v7h0c4b6n", "auth.login_succeeded", "usr_5k2d9x0q7m3hv8c1b4na", nil,
+							`{"method":"password"}`, createdAt).
+						AddRow(1, "aud_7d2k9m4q0x8h3v1c5b6n", "auth.login_failed", nil, nil,
+							`{"email":"test_user@example.com","method":"password"}`, createdAt))
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "without an email address",
+			params: repository.UserActivityListParams{
+				Actions: actions,
+				ActorID: "usr_5k2d9x0q7m3hv8c1b4na",
+				Params:  pagination.Params{PerPage: 10},
+			},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `audit_logs` WHERE action IN (?,?) AND actor_id = ? ORDER BY id DESC LIMIT ?")).
+					WithArgs("auth.login_succeeded", "auth.login_failed", "usr_5k2d9x0q7m3hv8c1b4na", 11).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expectedCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			page, err := suite.repo.ListUserActivity(tc.params)
+
+			assert.NoError(t, err)
+			assert.Len(t, page.Items, tc.expectedCount)
+		})
+	}
+}
+
+func TestAuditLogRepository_ListUserActivity_SinceTheAddressWasTheUsers(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(db)
+	require.NoError(t, db.AutoMigrate(&model.AuditLog{}))
+
+	signedUpAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	actorID := "usr_5k2d9x0q7m3hv8c1b4na"
+	otherActorID := "usr_0123456789abcdefghjk"
+	failure := `{"email":"test@example.com","method":"password","reason":"E100"}`
+	entries := []model.AuditLog{
+		// Probing the address before the user signed up with it
+		{Action: "auth.login_failed", Metadata: failure, CreatedAt: signedUpAt.Add(-time.Hour)},
+		// Refused for a previous owner of the address
+		{Action: "auth.login_failed", ActorID: &otherActorID, Metadata: failure, CreatedAt: signedUpAt.Add(time.Hour)},
+		{Action: "auth.login_failed", Metadata: failure, CreatedAt: signedUpAt.Add(2 * time.Hour)},
+		{Action: "auth.login_succeeded", ActorID: &actorID, Metadata: `{"method":"password"}`,
+			CreatedAt: signedUpAt.Add(3 * time.Hour)},
+	}
+	for i := range entries {
+		require.NoError(t, db.Create(&entries[i]).Error)
+	}
+
+	page, err := repository.NewAuditLogRepository(db).ListUserActivity(repository.UserActivityListParams{
+		Actions:    []string{"auth.login_succeeded", "auth.login_failed"},
+		ActorID:    actorID,
+		Email:      "test@example.com",
+		EmailSince: signedUpAt,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, entries[3].PublicID, page.Items[0].PublicID)
+	assert.Equal(t, entries[2].PublicID, page.Items[1].PublicID)
+}
+
+func TestAuditLogRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogRepositoryTestSuite))
+}
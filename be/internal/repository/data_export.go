@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrDataExportNotFound is returned when no data export matches the lookup
+var ErrDataExportNotFound = errors.New("data export not found")
+
+// DataExportRepository handles database operations for data exports
+type DataExportRepository struct {
+	db *gorm.DB
+}
+
+// DataExportRepositoryInterface defines the interface for data export repository
+type DataExportRepositoryInterface interface {
+	Create(export *model.DataExport) error
+	GetByPublicID(userID uint, publicID string) (*model.DataExport, error)
+	FindActiveByUserID(userID uint) (*model.DataExport, error)
+	ClaimPending(now time.Time, limit int, lease time.Duration) ([]model.DataExport, error)
+	MarkReady(id uint, storageKey string, sizeBytes int64, completedAt, expiresAt time.Time) error
+	MarkFailed(id uint, lastError string) error
+	ListExpired(now time.Time, limit int) ([]model.DataExport, error)
+	Delete(id uint) error
+}
+
+// NewDataExportRepository creates a new data export repository
+func NewDataExportRepository(db *gorm.DB) DataExportRepositoryInterface {
+	return &DataExportRepository{
+		db: db,
+	}
+}
+
+// Create stores a new pending data export
+func (r *DataExportRepository) Create(export *model.DataExport) error {
+	export.Status = model.DataExportStatusPending
+	if err := r.db.Create(export).Error; err != nil {
+		return fmt.Errorf("failed to create data export: %w", err)
+	}
+	return nil
+}
+
+// GetByPublicID finds one of the user's data exports. Exports of other users are not found.
+func (r *DataExportRepository) GetByPublicID(userID uint, publicID string) (*model.DataExport, error) {
+	var export model.DataExport
+	err := r.db.Where("public_id = ? AND user_id = ?", publicID, userID).First(&export).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDataExportNotFound
+		}
+		return nil, fmt.Errorf("failed to find data export: %w", err)
+	}
+	return &export, nil
+}
+
+// FindActiveByUserID finds the user's newest data export that is still pending or processing
+func (r *DataExportRepository) FindActiveByUserID(userID uint) (*model.DataExport, error) {
+	var export model.DataExport
+	err := r.db.Where("user_id = ? AND status IN ?", userID,
+		[]string{model.DataExportStatusPending, model.DataExportStatusProcessing}).
+		Order("id DESC").
+		First(&export).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDataExportNotFound
+		}
+		return nil, fmt.Errorf("failed to find active data export: %w", err)
+	}
+	return &export, nil
+}
+
+// ClaimPending returns up to limit pending exports, oldest first, and marks them as processing so
+// that other workers skip them. Exports that have been processing for longer than lease, because
+// their worker stopped, are claimed again. Rows locked by another worker are skipped.
+func (r *DataExportRepository) ClaimPending(now time.Time, limit int, lease time.Duration) ([]model.DataExport, error) {
+	var exports []model.DataExport
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND updated_at <= ?)",
+				model.DataExportStatusPending, model.DataExportStatusProcessing, now.Add(-lease)).
+			Order("id ASC").
+			Limit(limit).
+			Find(&exports).Error
+		if err != nil || len(exports) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(exports))
+		for i := range exports {
+			ids = append(ids, exports[i].ID)
+			exports[i].Status = model.DataExportStatusProcessing
+		}
+		return tx.Model(&model.DataExport{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":     model.DataExportStatusProcessing,
+				"updated_at": now,
+			}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim data exports: %w", err)
+	}
+	return exports, nil
+}
+
+// MarkReady records that the archive of an export was stored under storageKey
+func (r *DataExportRepository) MarkReady(
+	id uint, storageKey string, sizeBytes int64, completedAt, expiresAt time.Time,
+) error {
+	return r.update(id, map[string]interface{}{
+		"status":       model.DataExportStatusReady,
+		"storage_key":  storageKey,
+		"size_bytes":   sizeBytes,
+		"completed_at": completedAt,
+		"expires_at":   expiresAt,
+		"last_error":   nil,
+	})
+}
+
+// MarkFailed records that the archive of an export could not be assembled
+func (r *DataExportRepository) MarkFailed(id uint, lastError string) error {
+	return r.update(id, map[string]interface{}{
+		"status":     model.DataExportStatusFailed,
+		"last_error": lastError,
+	})
+}
+
+func (r *DataExportRepository) update(id uint, updates map[string]interface{}) error {
+	updates["updated_at"] = time.Now()
+	if err := r.db.Model(&model.DataExport{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update data export: %w", err)
+	}
+	return nil
+}
+
+// ListExpired returns up to limit ready exports whose archives expired at now, oldest first
+func (r *DataExportRepository) ListExpired(now time.Time, limit int) ([]model.DataExport, error) {
+	var exports []model.DataExport
+	err := r.db.Where("status = ? AND expires_at <= ?", model.DataExportStatusReady, now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&exports).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired data exports: %w", err)
+	}
+	return exports, nil
+}
+
+// Delete removes a data export
+func (r *DataExportRepository) Delete(id uint) error {
+	if err := r.db.Delete(&model.DataExport{}, id).Error; err != nil {
+		return fmt.Errorf("failed to delete data export: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package repository_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type DataExportRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.DataExportRepositoryInterface
+}
+
+func (suite *DataExportRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewDataExportRepository(gormDB)
+}
+
+func (suite *DataExportRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *DataExportRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `data_exports`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	export := &model.DataExport{UserID: 42}
+	err := suite.repo.Create(export)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), export.ID)
+	assert.Equal(suite.T(), model.DataExportStatusPending, export.Status)
+	assert.Regexp(suite.T(), "^exp_", export.PublicID)
+}
+
+func (suite *DataExportRepositoryTestSuite) TestGetByPublicID() {
+	query := "SELECT * FROM `data_exports` WHERE public_id = ? AND user_id = ? ORDER BY `data_exports`.`id` LIMIT ?"
+
+	testCases := []struct {
+		mockSetup   func()
+		expectedErr error
+		name        string
+	}{
+		{
+			name: "Found",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs("exp_9c4m1x7k2d5v8h0q3b6n", 42, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "public_id", "user_id", "status"}).
+						AddRow(1, "exp_9c4m1x7k2d5v8h0q3b6n", 42, model.DataExportStatusReady))
+			},
+		},
+		{
+			name: "Not found",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectedErr: repository.ErrDataExportNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.mockSetup()
+
+			export, err := suite.repo.GetByPublicID(42, "exp_9c4m1x7k2d5v8h0q3b6n")
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+				return
+			}
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), model.DataExportStatusReady, export.Status)
+		})
+	}
+}
+
+func (suite *DataExportRepositoryTestSuite) TestFindActiveByUserID() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `data_exports` WHERE user_id = ? AND status IN (?,?) ORDER BY id DESC,"+
+			"`data_exports`.`id` LIMIT ?")).
+		WithArgs(42, model.DataExportStatusPending, model.DataExportStatusProcessing, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := suite.repo.FindActiveByUserID(42)
+
+	assert.ErrorIs(suite.T(), err, repository.ErrDataExportNotFound)
+}
+
+func (suite *DataExportRepositoryTestSuite) TestClaimPending() {
+	now := time.Now()
+	selectQuery := "SELECT * FROM `data_exports` WHERE status = ? OR (status = ? AND updated_at <= ?) " +
+		"ORDER BY id ASC LIMIT ? FOR UPDATE SKIP LOCKED"
+
+	testCases := []struct {
+		mockSetup     func()
+		name          string
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name: "Claims pending and abandoned exports",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				rows := sqlmock.NewRows([]string{"id", "user_id", "status"}).
+					AddRow(1, 42, model.DataExportStatusPending).
+					AddRow(2, 43, model.DataExportStatusProcessing)
+				suite.mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(model.DataExportStatusPending, model.DataExportStatusProcessing, now.Add(-time.Minute), 5).
+					WillReturnRows(rows)
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `data_exports` SET")).
+					WithArgs(model.DataExportStatusProcessing, now, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				suite.mock.ExpectCommit()
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "Nothing pending",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				suite.mock.ExpectCommit()
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "Database error",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WillReturnError(errors.New("connection lost"))
+				suite.mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.mockSetup()
+
+			exports, err := suite.repo.ClaimPending(now, 5, time.Minute)
+
+			if tc.expectError {
+				assert.Error(suite.T(), err)
+				return
+			}
+			assert.NoError(suite.T(), err)
+			assert.Len(suite.T(), exports, tc.expectedCount)
+			for _, export := range exports {
+				assert.Equal(suite.T(), model.DataExportStatusProcessing, export.Status)
+			}
+		})
+	}
+}
+
+func (suite *DataExportRepositoryTestSuite) TestMarkReady() {
+	completedAt := time.Now()
+	expiresAt := completedAt.Add(24 * time.Hour)
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `data_exports` SET")).
+		WithArgs(completedAt, expiresAt, nil, int64(2048), model.DataExportStatusReady,
+			"private/exports/exp_9c4m1x7k2d5v8h0q3b6n.zip", sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.MarkReady(7, "private/exports/exp_9c4m1x7k2d5v8h0q3b6n.zip", 2048, completedAt, expiresAt)
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *DataExportRepositoryTestSuite) TestMarkFailed() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `data_exports` SET")).
+		WithArgs("storage unavailable", model.DataExportStatusFailed, sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.MarkFailed(7, "storage unavailable")
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *DataExportRepositoryTestSuite) TestListExpired() {
+	now := time.Now()
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `data_exports` WHERE status = ? AND expires_at <= ? ORDER BY id ASC LIMIT ?")).
+		WithArgs(model.DataExportStatusReady, now, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "storage_key"}).
+			AddRow(1, "private/exports/exp_9c4m1x7k2d5v8h0q3b6n.zip"))
+
+	exports, err := suite.repo.ListExpired(now, 20)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), exports, 1)
+}
+
+func (suite *DataExportRepositoryTestSuite) TestDelete() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `data_exports` WHERE `data_exports`.`id` = ?")).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.Delete(7)
+
+	assert.NoError(suite.T(), err)
+}
+
+func TestDataExportRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DataExportRepositoryTestSuite))
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrIdempotencyKeyNotFound is returned when no request has been recorded for an idempotency key
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+	// ErrIdempotencyKeyExists is returned when a request has already been recorded for an idempotency key
+	ErrIdempotencyKeyExists = errors.New("idempotency key already exists")
+)
+
+// IdempotencyKeyRepository handles database operations for idempotency keys
+type IdempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+// IdempotencyKeyRepositoryInterface defines the interface for idempotency key repository
+type IdempotencyKeyRepositoryInterface interface {
+	Create(record *model.IdempotencyKey) error
+	Find(scope, key string) (*model.IdempotencyKey, error)
+	Complete(id uint, status int, headers, body string) error
+	Delete(id uint) error
+	DeleteExpired(now time.Time) (int64, error)
+}
+
+// NewIdempotencyKeyRepository creates a new idempotency key repository
+func NewIdempotencyKeyRepository(db *gorm.DB) IdempotencyKeyRepositoryInterface {
+	return &IdempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// Create records a new request for an idempotency key. It returns ErrIdempotencyKeyExists when
+// the key has already been used in the scope, so that only one of concurrent requests runs.
+func (r *IdempotencyKeyRepository) Create(record *model.IdempotencyKey) error {
+	if err := r.db.Create(record).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrIdempotencyKeyExists
+		}
+		return fmt.Errorf("failed to create idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Find returns the request recorded for the key in the scope, including expired ones
+func (r *IdempotencyKeyRepository) Find(scope, key string) (*model.IdempotencyKey, error) {
+	var record model.IdempotencyKey
+	err := r.db.Where("scope = ? AND idempotency_key = ?", scope, key).First(&record).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrIdempotencyKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to find idempotency key: %w", err)
+	}
+
+	return &record, nil
+}
+
+// Complete stores the response of the request recorded with the ID
+func (r *IdempotencyKeyRepository) Complete(id uint, status int, headers, body string) error {
+	err := r.db.Model(&model.IdempotencyKey{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"response_status":  status,
+			"response_headers": headers,
+			"response_body":    body,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the request recorded with the ID, so that its key can be used again
+func (r *IdempotencyKeyRepository) Delete(id uint) error {
+	if err := r.db.Where("id = ?", id).Delete(&model.IdempotencyKey{}).Error; err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes the keys that expired before now and returns how many were removed
+func (r *IdempotencyKeyRepository) DeleteExpired(now time.Time) (int64, error) {
+	result := r.db.Where("expires_at <= ?", now).Delete(&model.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
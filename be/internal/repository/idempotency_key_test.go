@@ -0,0 +1,140 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type IdempotencyKeyRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo repository.IdempotencyKeyRepositoryInterface
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.mock = mock
+	suite.repo = repository.NewIdempotencyKeyRepository(gormDB)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestCreate() {
+	testCases := []struct {
+		insertErr   error
+		expectedErr error
+		name        string
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:        "Key already used",
+			insertErr:   gorm.ErrDuplicatedKey,
+			expectedErr: repository.ErrIdempotencyKeyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			suite.mock.ExpectBegin()
+			if tc.insertErr != nil {
+				suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_keys`")).
+					WillReturnError(tc.insertErr)
+				suite.mock.ExpectRollback()
+			} else {
+				suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_keys`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				suite.mock.ExpectCommit()
+			}
+
+			err := suite.repo.Create(&model.IdempotencyKey{
+				Scope:       "anonymous POST /api/auth/signup",
+				Key:         "retry-1",
+				RequestHash: "hash",
+				ExpiresAt:   time.Now().Add(24 * time.Hour),
+			})
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestFind() {
+	query := regexp.QuoteMeta("SELECT * FROM `idempotency_keys` WHERE scope = ? AND idempotency_key = ?")
+
+	suite.mock.ExpectQuery(query).
+		WithArgs("anonymous POST /api/auth/signup", "retry-1", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "scope", "idempotency_key", "request_hash", "response_status"}).
+			AddRow(4, "anonymous POST /api/auth/signup", "retry-1", "hash", 201))
+
+	record, err := suite.repo.Find("anonymous POST /api/auth/signup", "retry-1")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(4), record.ID)
+	assert.True(suite.T(), record.IsCompleted())
+
+	suite.mock.ExpectQuery(query).
+		WithArgs("anonymous POST /api/auth/signup", "retry-2", sqlmock.AnyArg()).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err = suite.repo.Find("anonymous POST /api/auth/signup", "retry-2")
+
+	assert.ErrorIs(suite.T(), err, repository.ErrIdempotencyKeyNotFound)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestComplete() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `idempotency_keys` SET `response_body`=?,`response_headers`=?,"+
+		"`response_status`=? WHERE id = ?")).
+		WithArgs(`{"id":"usr_5k2d9x0q7m3hv8c1b4na"}`, `{"Content-Type":["application/json"]}`, 201, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.Complete(4, 201, `{"Content-Type":["application/json"]}`, `{"id":"usr_5k2d9x0q7m3hv8c1b4na"}`)
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdempotencyKeyRepositoryTestSuite) TestDeleteExpired() {
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_keys` WHERE expires_at <= ?")).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	suite.mock.ExpectCommit()
+
+	deleted, err := suite.repo.DeleteExpired(now)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), deleted)
+}
+
+func TestIdempotencyKeyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeyRepositoryTestSuite))
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// ErrIdentityNotFound is returned when no linked identity matches the lookup
+var ErrIdentityNotFound = errors.New("identity not found")
+
+// IdentityRepository handles database operations for linked OAuth identities
+type IdentityRepository struct {
+	db *gorm.DB
+}
+
+// IdentityRepositoryInterface defines the interface for identity repository
+type IdentityRepositoryInterface interface {
+	Create(identity *model.UserIdentity) error
+	FindByProvider(provider, providerUserID string) (*model.UserIdentity, error)
+	ListByUserID(userID uint) ([]model.UserIdentity, error)
+	Delete(userID uint, provider string) error
+}
+
+// NewIdentityRepository creates a new identity repository
+func NewIdentityRepository(db *gorm.DB) IdentityRepositoryInterface {
+	return &IdentityRepository{
+		db: db,
+	}
+}
+
+// Create links a provider account to a user
+func (r *IdentityRepository) Create(identity *model.UserIdentity) error {
+	if err := r.db.Create(identity).Error; err != nil {
+		return fmt.Errorf("failed to create identity: %w", err)
+	}
+	return nil
+}
+
+// FindByProvider finds the identity for an account at a provider
+func (r *IdentityRepository) FindByProvider(provider, providerUserID string) (*model.UserIdentity, error) {
+	var identity model.UserIdentity
+	err := r.db.Where("provider = ? AND provider_user_id = ?", provider, providerUserID).First(&identity).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrIdentityNotFound
+		}
+		return nil, fmt.Errorf("failed to find identity: %w", err)
+	}
+
+	return &identity, nil
+}
+
+// ListByUserID returns all identities linked to a user
+func (r *IdentityRepository) ListByUserID(userID uint) ([]model.UserIdentity, error) {
+	var identities []model.UserIdentity
+	err := r.db.Where("user_id = ?", userID).Order("id ASC").Find(&identities).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	return identities, nil
+}
+
+// Delete unlinks the user's identity at a provider
+func (r *IdentityRepository) Delete(userID uint, provider string) error {
+	result := r.db.Where("user_id = ? AND provider = ?", userID, provider).Delete(&model.UserIdentity{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete identity: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrIdentityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type IdentityRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.IdentityRepositoryInterface
+}
+
+func (suite *IdentityRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewIdentityRepository(gormDB)
+}
+
+func (suite *IdentityRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *IdentityRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_identities`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	identity := &model.UserIdentity{UserID: 1, Provider: "google", ProviderUserID: "google-123"}
+	err := suite.repo.Create(identity)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), identity.ID)
+}
+
+func (suite *IdentityRepositoryTestSuite) TestFindByProvider() {
+	testCases := []struct {
+		mockSetup   func()
+		expectedErr error
+		name        string
+		expectError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "provider", "provider_user_id", "created_at"}).
+					AddRow(1, 7, "google", "google-123", time.Now())
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_identities`")).
+					WithArgs("google", "google-123", sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Not found",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_identities`")).
+					WithArgs("google", "google-123", sqlmock.AnyArg()).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectError: true,
+			expectedErr: repository.ErrIdentityNotFound,
+		},
+		{
+			name: "Database error",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_identities`")).
+					WithArgs("google", "google-123", sqlmock.AnyArg()).
+					WillReturnError(assert.AnError)
+			},
+			expectError: true,
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			identity, err := suite.repo.FindByProvider("google", "google-123")
+
+			if tc.expectError {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, identity)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(7), identity.UserID)
+			}
+		})
+	}
+}
+
+func (suite *IdentityRepositoryTestSuite) TestListByUserID() {
+	rows := sqlmock.NewRows([]string{"id", "user_id", "provider", "provider_user_id"}).
+		AddRow(1, 7, "google", "google-123").
+		AddRow(2, 7, "github", "42")
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_identities` WHERE user_id = ? ORDER BY id ASC")).
+		WithArgs(7).
+		WillReturnRows(rows)
+
+	identities, err := suite.repo.ListByUserID(7)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), identities, 2)
+	assert.Equal(suite.T(), "github", identities[1].Provider)
+}
+
+func (suite *IdentityRepositoryTestSuite) TestDelete() {
+	testCases := []struct {
+		expectedErr  error
+		name         string
+		rowsAffected int64
+	}{
+		{
+			name:         "Success",
+			rowsAffected: 1,
+		},
+		{
+			name:         "Not linked",
+			rowsAffected: 0,
+			expectedErr:  repository.ErrIdentityNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			suite.mock.ExpectBegin()
+			suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `user_identities` WHERE user_id = ? AND provider = ?")).
+				WithArgs(7, "google").
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			suite.mock.ExpectCommit()
+
+			err := suite.repo.Delete(7, "google")
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIdentityRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(IdentityRepositoryTestSuite))
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// ErrInvitationNotFound is returned when no usable invitation matches the lookup
+var ErrInvitationNotFound = errors.New("invitation not found")
+
+// InvitationRepository handles database operations for signup invitations
+type InvitationRepository struct {
+	db *gorm.DB
+}
+
+// InvitationRepositoryInterface defines the interface for invitation repository
+type InvitationRepositoryInterface interface {
+	Create(invitation *model.Invitation) error
+	GetByPublicID(publicID string) (*model.Invitation, error)
+	Accept(invitationID, userID uint) error
+}
+
+// NewInvitationRepository creates a new invitation repository
+func NewInvitationRepository(db *gorm.DB) InvitationRepositoryInterface {
+	return &InvitationRepository{
+		db: db,
+	}
+}
+
+// Create stores a new invitation
+func (r *InvitationRepository) Create(invitation *model.Invitation) error {
+	if err := r.db.Create(invitation).Error; err != nil {
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+	return nil
+}
+
+// GetByPublicID finds an invitation by its public ID
+func (r *InvitationRepository) GetByPublicID(publicID string) (*model.Invitation, error) {
+	var invitation model.Invitation
+	err := r.db.Where("public_id = ?", publicID).First(&invitation).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// Accept marks an invitation as used by the user who signed up with it. It returns
+// ErrInvitationNotFound when the invitation has already been accepted or has expired.
+func (r *InvitationRepository) Accept(invitationID, userID uint) error {
+	now := time.Now()
+	result := r.db.Model(&model.Invitation{}).
+		Where("id = ? AND accepted_at IS NULL AND expires_at > ?", invitationID, now).
+		Updates(map[string]interface{}{
+			"accepted_at":      now,
+			"accepted_user_id": userID,
+			"updated_at":       now,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to accept invitation: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
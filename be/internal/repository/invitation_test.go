@@ -0,0 +1,145 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type InvitationRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo repository.InvitationRepositoryInterface
+}
+
+func (suite *InvitationRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.mock = mock
+	suite.repo = repository.NewInvitationRepository(gormDB)
+}
+
+func (suite *InvitationRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *InvitationRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `invitations`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	invitation := &model.Invitation{
+		Email:           "friend@example.com",
+		InvitedByUserID: 1,
+		ExpiresAt:       time.Now().Add(7 * 24 * time.Hour),
+	}
+	err := suite.repo.Create(invitation)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), invitation.ID)
+	assert.NoError(suite.T(), publicid.Validate(publicid.PrefixInvitation, invitation.PublicID))
+}
+
+func (suite *InvitationRepositoryTestSuite) TestGetByPublicID() {
+	testCases := []struct {
+		mockSetup   func()
+		expectedErr error
+		name        string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "public_id", "email", "invited_by_user_id"}).
+					AddRow(3, "inv_3m8q1x5k9d2v7h0c4b6n", "friend@example.com", 1)
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `invitations` WHERE public_id = ?")).
+					WithArgs("inv_3m8q1x5k9d2v7h0c4b6n", sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Not found",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `invitations` WHERE public_id = ?")).
+					WithArgs("inv_3m8q1x5k9d2v7h0c4b6n", sqlmock.AnyArg()).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectedErr: repository.ErrInvitationNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			invitation, err := suite.repo.GetByPublicID("inv_3m8q1x5k9d2v7h0c4b6n")
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, invitation)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "friend@example.com", invitation.Email)
+		})
+	}
+}
+
+func (suite *InvitationRepositoryTestSuite) TestAccept() {
+	testCases := []struct {
+		expectedErr  error
+		name         string
+		rowsAffected int64
+	}{
+		{
+			name:         "Success",
+			rowsAffected: 1,
+		},
+		{
+			name:         "Already accepted or expired",
+			rowsAffected: 0,
+			expectedErr:  repository.ErrInvitationNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			suite.mock.ExpectBegin()
+			suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `invitations` SET `accepted_at`=?,`accepted_user_id`=?,"+
+				"`updated_at`=? WHERE id = ? AND accepted_at IS NULL AND expires_at > ?")).
+				WithArgs(sqlmock.AnyArg(), 7, sqlmock.AnyArg(), 3, sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			suite.mock.ExpectCommit()
+
+			err := suite.repo.Accept(3, 7)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestInvitationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InvitationRepositoryTestSuite))
+}
@@ -1,7 +1,10 @@
 package mocks
 
 import (
+	"time"
+
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -17,18 +20,18 @@ func (m *MockSessionRepository) Create(session *model.UserSession) error {
 	return args.Error(0)
 }
 
-// FindByAccessToken mocks the FindByAccessToken method
-func (m *MockSessionRepository) FindByAccessToken(accessToken string) (*model.UserSession, error) {
-	args := m.Called(accessToken)
+// FindByAccessTokenHash mocks the FindByAccessTokenHash method
+func (m *MockSessionRepository) FindByAccessTokenHash(accessTokenHash string) (*model.UserSession, error) {
+	args := m.Called(accessTokenHash)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*model.UserSession), args.Error(1)
 }
 
-// FindByRefreshToken mocks the FindByRefreshToken method
-func (m *MockSessionRepository) FindByRefreshToken(refreshToken string) (*model.UserSession, error) {
-	args := m.Called(refreshToken)
+// FindByRefreshTokenHash mocks the FindByRefreshTokenHash method
+func (m *MockSessionRepository) FindByRefreshTokenHash(refreshTokenHash string) (*model.UserSession, error) {
+	args := m.Called(refreshTokenHash)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -44,12 +47,58 @@ func (m *MockSessionRepository) FindActiveByUserID(userID uint) ([]*model.UserSe
 	return args.Get(0).([]*model.UserSession), args.Error(1)
 }
 
+// ListActiveByUserID mocks the ListActiveByUserID method
+func (m *MockSessionRepository) ListActiveByUserID(
+	userID uint, params pagination.Params,
+) (*pagination.Page[model.UserSession], error) {
+	args := m.Called(userID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.Page[model.UserSession]), args.Error(1)
+}
+
+// FindRefreshableByUserID mocks the FindRefreshableByUserID method
+func (m *MockSessionRepository) FindRefreshableByUserID(userID uint) ([]*model.UserSession, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.UserSession), args.Error(1)
+}
+
+// FindRecentByUserID mocks the FindRecentByUserID method
+func (m *MockSessionRepository) FindRecentByUserID(
+	userID uint,
+	since time.Time,
+	excludeID uint,
+	limit int,
+) ([]*model.UserSession, error) {
+	args := m.Called(userID, since, excludeID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.UserSession), args.Error(1)
+}
+
 // Update mocks the Update method
 func (m *MockSessionRepository) Update(session *model.UserSession) error {
 	args := m.Called(session)
 	return args.Error(0)
 }
 
+// UpdateLastUsedAt mocks the UpdateLastUsedAt method
+func (m *MockSessionRepository) UpdateLastUsedAt(session *model.UserSession, lastUsedAt time.Time) error {
+	args := m.Called(session, lastUsedAt)
+	return args.Error(0)
+}
+
+// InvalidateByPublicID mocks the InvalidateByPublicID method
+func (m *MockSessionRepository) InvalidateByPublicID(publicID string, userID uint) error {
+	args := m.Called(publicID, userID)
+	return args.Error(0)
+}
+
 // InvalidateByUserID mocks the InvalidateByUserID method
 func (m *MockSessionRepository) InvalidateByUserID(userID uint) error {
 	args := m.Called(userID)
@@ -5,6 +5,10 @@ package mocks
 import (
 	model "strikepad-backend/internal/model"
 
+	pagination "strikepad-backend/internal/pagination"
+
+	repository "strikepad-backend/internal/repository"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -79,52 +83,6 @@ func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(*model.User) (*m
 	return _c
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockUserRepository) Delete(id uint) error {
-	ret := _m.Called(id)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Delete")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// MockUserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockUserRepository_Delete_Call struct {
-	*mock.Call
-}
-
-// Delete is a helper method to define mock.On call
-//   - id uint
-func (_e *MockUserRepository_Expecter) Delete(id interface{}) *MockUserRepository_Delete_Call {
-	return &MockUserRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
-}
-
-func (_c *MockUserRepository_Delete_Call) Run(run func(id uint)) *MockUserRepository_Delete_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
-	})
-	return _c
-}
-
-func (_c *MockUserRepository_Delete_Call) Return(_a0 error) *MockUserRepository_Delete_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *MockUserRepository_Delete_Call) RunAndReturn(run func(uint) error) *MockUserRepository_Delete_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // FindByEmail provides a mock function with given fields: email
 func (_m *MockUserRepository) FindByEmail(email string) (*model.User, error) {
 	ret := _m.Called(email)
@@ -299,29 +257,87 @@ func (_c *MockUserRepository_GetByID_Call) RunAndReturn(run func(uint) (*model.U
 	return _c
 }
 
-// List provides a mock function with no fields
-func (_m *MockUserRepository) List() ([]model.User, error) {
-	ret := _m.Called()
+// GetByPublicID provides a mock function with given fields: publicID
+func (_m *MockUserRepository) GetByPublicID(publicID string) (*model.User, error) {
+	ret := _m.Called(publicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByPublicID")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.User, error)); ok {
+		return rf(publicID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.User); ok {
+		r0 = rf(publicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(publicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_GetByPublicID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPublicID'
+type MockUserRepository_GetByPublicID_Call struct {
+	*mock.Call
+}
+
+// GetByPublicID is a helper method to define mock.On call
+//   - publicID string
+func (_e *MockUserRepository_Expecter) GetByPublicID(publicID interface{}) *MockUserRepository_GetByPublicID_Call {
+	return &MockUserRepository_GetByPublicID_Call{Call: _e.mock.On("GetByPublicID", publicID)}
+}
+
+func (_c *MockUserRepository_GetByPublicID_Call) Run(run func(publicID string)) *MockUserRepository_GetByPublicID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicID_Call) Return(_a0 *model.User, _a1 error) *MockUserRepository_GetByPublicID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByPublicID_Call) RunAndReturn(run func(string) (*model.User, error)) *MockUserRepository_GetByPublicID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: params
+func (_m *MockUserRepository) List(params repository.ListParams) (*pagination.Page[model.User], error) {
+	ret := _m.Called(params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
 	}
 
-	var r0 []model.User
+	var r0 *pagination.Page[model.User]
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.User, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(repository.ListParams) (*pagination.Page[model.User], error)); ok {
+		return rf(params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.User); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(repository.ListParams) *pagination.Page[model.User]); ok {
+		r0 = rf(params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.User)
+			r0 = ret.Get(0).(*pagination.Page[model.User])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(repository.ListParams) error); ok {
+		r1 = rf(params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -335,23 +351,174 @@ type MockUserRepository_List_Call struct {
 }
 
 // List is a helper method to define mock.On call
-func (_e *MockUserRepository_Expecter) List() *MockUserRepository_List_Call {
-	return &MockUserRepository_List_Call{Call: _e.mock.On("List")}
+//   - params repository.ListParams
+func (_e *MockUserRepository_Expecter) List(params interface{}) *MockUserRepository_List_Call {
+	return &MockUserRepository_List_Call{Call: _e.mock.On("List", params)}
 }
 
-func (_c *MockUserRepository_List_Call) Run(run func()) *MockUserRepository_List_Call {
+func (_c *MockUserRepository_List_Call) Run(run func(params repository.ListParams)) *MockUserRepository_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(repository.ListParams))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_List_Call) Return(_a0 []model.User, _a1 error) *MockUserRepository_List_Call {
+func (_c *MockUserRepository_List_Call) Return(_a0 *pagination.Page[model.User], _a1 error) *MockUserRepository_List_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUserRepository_List_Call) RunAndReturn(run func() ([]model.User, error)) *MockUserRepository_List_Call {
+func (_c *MockUserRepository_List_Call) RunAndReturn(run func(repository.ListParams) (*pagination.Page[model.User], error)) *MockUserRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: id
+func (_m *MockUserRepository) Restore(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockUserRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+func (_e *MockUserRepository_Expecter) Restore(id interface{}) *MockUserRepository_Restore_Call {
+	return &MockUserRepository_Restore_Call{Call: _e.mock.On("Restore", id)}
+}
+
+func (_c *MockUserRepository_Restore_Call) Run(run func(id uint)) *MockUserRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Restore_Call) Return(_a0 error) *MockUserRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_Restore_Call) RunAndReturn(run func(uint) error) *MockUserRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: params
+func (_m *MockUserRepository) Search(params repository.UserSearchParams) ([]model.User, error) {
+	ret := _m.Called(params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.UserSearchParams) ([]model.User, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(repository.UserSearchParams) []model.User); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.UserSearchParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockUserRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - params repository.UserSearchParams
+func (_e *MockUserRepository_Expecter) Search(params interface{}) *MockUserRepository_Search_Call {
+	return &MockUserRepository_Search_Call{Call: _e.mock.On("Search", params)}
+}
+
+func (_c *MockUserRepository_Search_Call) Run(run func(params repository.UserSearchParams)) *MockUserRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.UserSearchParams))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Search_Call) Return(_a0 []model.User, _a1 error) *MockUserRepository_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_Search_Call) RunAndReturn(run func(repository.UserSearchParams) ([]model.User, error)) *MockUserRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDelete provides a mock function with given fields: id
+func (_m *MockUserRepository) SoftDelete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_SoftDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDelete'
+type MockUserRepository_SoftDelete_Call struct {
+	*mock.Call
+}
+
+// SoftDelete is a helper method to define mock.On call
+//   - id uint
+func (_e *MockUserRepository_Expecter) SoftDelete(id interface{}) *MockUserRepository_SoftDelete_Call {
+	return &MockUserRepository_SoftDelete_Call{Call: _e.mock.On("SoftDelete", id)}
+}
+
+func (_c *MockUserRepository_SoftDelete_Call) Run(run func(id uint)) *MockUserRepository_SoftDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SoftDelete_Call) Return(_a0 error) *MockUserRepository_SoftDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_SoftDelete_Call) RunAndReturn(run func(uint) error) *MockUserRepository_SoftDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -402,6 +569,53 @@ func (_c *MockUserRepository_Update_Call) RunAndReturn(run func(*model.User) err
 	return _c
 }
 
+// WithDeleted provides a mock function with no fields
+func (_m *MockUserRepository) WithDeleted() repository.UserRepository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDeleted")
+	}
+
+	var r0 repository.UserRepository
+	if rf, ok := ret.Get(0).(func() repository.UserRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.UserRepository)
+		}
+	}
+
+	return r0
+}
+
+// MockUserRepository_WithDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDeleted'
+type MockUserRepository_WithDeleted_Call struct {
+	*mock.Call
+}
+
+// WithDeleted is a helper method to define mock.On call
+func (_e *MockUserRepository_Expecter) WithDeleted() *MockUserRepository_WithDeleted_Call {
+	return &MockUserRepository_WithDeleted_Call{Call: _e.mock.On("WithDeleted")}
+}
+
+func (_c *MockUserRepository_WithDeleted_Call) Run(run func()) *MockUserRepository_WithDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_WithDeleted_Call) Return(_a0 repository.UserRepository) *MockUserRepository_WithDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_WithDeleted_Call) RunAndReturn(run func() repository.UserRepository) *MockUserRepository_WithDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserRepository(t interface {
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"fmt"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// NotificationRepository handles database operations for notifications
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+// NotificationRepositoryInterface defines the interface for notification repository
+type NotificationRepositoryInterface interface {
+	Create(notification *model.Notification) error
+	ListAfter(userID uint, afterPublicID string, limit int) ([]model.Notification, error)
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *gorm.DB) NotificationRepositoryInterface {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+// Create stores a new notification
+func (r *NotificationRepository) Create(notification *model.Notification) error {
+	if err := r.db.Create(notification).Error; err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+// ListAfter returns up to limit of the user's notifications created after the one with
+// afterPublicID, oldest first. An unknown afterPublicID, such as one of another user, matches
+// nothing.
+func (r *NotificationRepository) ListAfter(userID uint, afterPublicID string, limit int) ([]model.Notification, error) {
+	var notifications []model.Notification
+	after := r.db.Model(&model.Notification{}).Select("id").
+		Where("public_id = ? AND user_id = ?", afterPublicID, userID)
+	err := r.db.Where("user_id = ? AND id > (?)", userID, after).
+		Order("id ASC").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return notifications, nil
+}
@@ -0,0 +1,88 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type NotificationRepositoryTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo repository.NotificationRepositoryInterface
+}
+
+func (suite *NotificationRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.mock = mock
+	suite.repo = repository.NewNotificationRepository(gormDB)
+}
+
+func (suite *NotificationRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *NotificationRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `notifications`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	notification := &model.Notification{UserID: 1, Type: "session.revoked", Data: "{}"}
+	err := suite.repo.Create(notification)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), notification.ID)
+	assert.NoError(suite.T(), publicid.Validate(publicid.PrefixNotification, notification.PublicID))
+}
+
+func (suite *NotificationRepositoryTestSuite) TestListAfter() {
+	columns := []string{"id", "public_id", "user_id", "type", "data", "created_at"}
+	now := time.Now()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `notifications` WHERE user_id = ? AND id > (SELECT `id` FROM `notifications` "+
+			"WHERE public_id = ? AND user_id = ?) ORDER BY id ASC LIMIT ?")).
+		WithArgs(1, "ntf_3m8q1x5k9d2v7h0c4b6n", 1, 50).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(4, "ntf_7d2k9m4q0x8h3v1c5b6n", 1, "session.revoked", `{"session_id":"ses_1"}`, now))
+
+	notifications, err := suite.repo.ListAfter(1, "ntf_3m8q1x5k9d2v7h0c4b6n", 50)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), notifications, 1)
+	assert.Equal(suite.T(), "ntf_7d2k9m4q0x8h3v1c5b6n", notifications[0].PublicID)
+}
+
+func (suite *NotificationRepositoryTestSuite) TestListAfterError() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `notifications`")).
+		WillReturnError(assert.AnError)
+
+	notifications, err := suite.repo.ListAfter(1, "ntf_3m8q1x5k9d2v7h0c4b6n", 50)
+
+	assert.ErrorIs(suite.T(), err, assert.AnError)
+	assert.Nil(suite.T(), notifications)
+}
+
+func TestNotificationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationRepositoryTestSuite))
+}
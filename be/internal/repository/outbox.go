@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxStats describes the outbox queue
+type OutboxStats struct {
+	OldestPendingAt *time.Time
+	Pending         int64
+	Failed          int64
+}
+
+// OutboxRepository handles database operations for outbox messages
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+// OutboxRepositoryInterface defines the interface for outbox repository
+type OutboxRepositoryInterface interface {
+	Enqueue(message *model.OutboxMessage) error
+	ClaimDue(now time.Time, limit int, lease time.Duration) ([]model.OutboxMessage, error)
+	MarkSent(id uint, attempts int, sentAt time.Time) error
+	MarkRetry(id uint, attempts int, nextAttemptAt time.Time, lastError string) error
+	MarkFailed(id uint, attempts int, lastError string) error
+	Stats() (*OutboxStats, error)
+}
+
+// NewOutboxRepository creates a new outbox repository
+func NewOutboxRepository(db *gorm.DB) OutboxRepositoryInterface {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// Enqueue stores a new pending message. Call it on the repositories of a transaction so that
+// the message is only stored when the state change it belongs to is committed.
+func (r *OutboxRepository) Enqueue(message *model.OutboxMessage) error {
+	message.Status = model.OutboxStatusPending
+	if message.NextAttemptAt.IsZero() {
+		message.NextAttemptAt = time.Now()
+	}
+	if err := r.db.Create(message).Error; err != nil {
+		return fmt.Errorf("failed to enqueue outbox message: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue returns up to limit pending messages that are due at now, oldest first, and pushes
+// their next attempt back by lease so that other dispatchers skip them while they are delivered.
+// Rows locked by another dispatcher are skipped.
+func (r *OutboxRepository) ClaimDue(now time.Time, limit int, lease time.Duration) ([]model.OutboxMessage, error) {
+	var messages []model.OutboxMessage
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", model.OutboxStatusPending, now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&messages).Error
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+		return tx.Model(&model.OutboxMessage{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"next_attempt_at": now.Add(lease),
+				"updated_at":      now,
+			}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+	return messages, nil
+}
+
+// MarkSent records that a message was delivered
+func (r *OutboxRepository) MarkSent(id uint, attempts int, sentAt time.Time) error {
+	return r.update(id, map[string]interface{}{
+		"status":     model.OutboxStatusSent,
+		"attempts":   attempts,
+		"sent_at":    sentAt,
+		"last_error": nil,
+	})
+}
+
+// MarkRetry records a failed delivery attempt and schedules the next one
+func (r *OutboxRepository) MarkRetry(id uint, attempts int, nextAttemptAt time.Time, lastError string) error {
+	return r.update(id, map[string]interface{}{
+		"attempts":        attempts,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	})
+}
+
+// MarkFailed records a failed delivery attempt after which the message is not retried
+func (r *OutboxRepository) MarkFailed(id uint, attempts int, lastError string) error {
+	return r.update(id, map[string]interface{}{
+		"status":     model.OutboxStatusFailed,
+		"attempts":   attempts,
+		"last_error": lastError,
+	})
+}
+
+func (r *OutboxRepository) update(id uint, updates map[string]interface{}) error {
+	updates["updated_at"] = time.Now()
+	if err := r.db.Model(&model.OutboxMessage{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update outbox message: %w", err)
+	}
+	return nil
+}
+
+// Stats counts the pending and failed messages and finds when the oldest pending one was enqueued
+func (r *OutboxRepository) Stats() (*OutboxStats, error) {
+	var rows []struct {
+		Oldest *time.Time
+		Status string
+		Count  int64
+	}
+	err := r.db.Model(&model.OutboxMessage{}).
+		Select("status, COUNT(*) AS count, MIN(created_at) AS oldest").
+		Where("status IN ?", []string{model.OutboxStatusPending, model.OutboxStatusFailed}).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox stats: %w", err)
+	}
+
+	stats := &OutboxStats{}
+	for _, row := range rows {
+		switch row.Status {
+		case model.OutboxStatusPending:
+			stats.Pending = row.Count
+			stats.OldestPendingAt = row.Oldest
+		case model.OutboxStatusFailed:
+			stats.Failed = row.Count
+		}
+	}
+	return stats, nil
+}
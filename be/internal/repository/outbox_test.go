@@ -0,0 +1,186 @@
+package repository_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.OutboxRepositoryInterface
+}
+
+func (suite *OutboxRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewOutboxRepository(gormDB)
+}
+
+func (suite *OutboxRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestEnqueue() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox_messages`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	message := &model.OutboxMessage{Kind: model.OutboxKindEmail, Payload: `{"to":"user@example.com"}`}
+	err := suite.repo.Enqueue(message)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), message.ID)
+	assert.Equal(suite.T(), model.OutboxStatusPending, message.Status)
+	assert.False(suite.T(), message.NextAttemptAt.IsZero())
+}
+
+func (suite *OutboxRepositoryTestSuite) TestClaimDue() {
+	now := time.Now()
+	selectQuery := "SELECT * FROM `outbox_messages` WHERE status = ? AND next_attempt_at <= ? " +
+		"ORDER BY next_attempt_at ASC LIMIT ? FOR UPDATE SKIP LOCKED"
+
+	testCases := []struct {
+		mockSetup     func()
+		name          string
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name: "Claims due messages",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				rows := sqlmock.NewRows([]string{"id", "kind", "payload", "status", "attempts"}).
+					AddRow(1, model.OutboxKindEmail, "{}", model.OutboxStatusPending, 0).
+					AddRow(2, model.OutboxKindEmail, "{}", model.OutboxStatusPending, 2)
+				suite.mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(model.OutboxStatusPending, now, 10).
+					WillReturnRows(rows)
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox_messages` SET")).
+					WithArgs(now.Add(time.Minute), now, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				suite.mock.ExpectCommit()
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "Nothing due",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				suite.mock.ExpectCommit()
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "Database error",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WillReturnError(errors.New("connection lost"))
+				suite.mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.mockSetup()
+
+			messages, err := suite.repo.ClaimDue(now, 10, time.Minute)
+
+			if tc.expectError {
+				assert.Error(suite.T(), err)
+				return
+			}
+			assert.NoError(suite.T(), err)
+			assert.Len(suite.T(), messages, tc.expectedCount)
+		})
+	}
+}
+
+func (suite *OutboxRepositoryTestSuite) TestMarkSent() {
+	sentAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox_messages` SET")).
+		WithArgs(3, nil, sentAt, model.OutboxStatusSent, sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.MarkSent(7, 3, sentAt)
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestMarkRetry() {
+	nextAttemptAt := time.Now().Add(time.Minute)
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox_messages` SET")).
+		WithArgs(2, "connection reset", nextAttemptAt, sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.MarkRetry(7, 2, nextAttemptAt, "connection reset")
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestMarkFailed() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox_messages` SET")).
+		WithArgs(8, "mailbox unavailable", model.OutboxStatusFailed, sqlmock.AnyArg(), 7).
+		WillReturnError(errors.New("connection lost"))
+	suite.mock.ExpectRollback()
+
+	err := suite.repo.MarkFailed(7, 8, "mailbox unavailable")
+
+	assert.Error(suite.T(), err)
+}
+
+func (suite *OutboxRepositoryTestSuite) TestStats() {
+	oldest := time.Now().Add(-time.Minute)
+	rows := sqlmock.NewRows([]string{"status", "count", "oldest"}).
+		AddRow(model.OutboxStatusPending, 4, oldest).
+		AddRow(model.OutboxStatusFailed, 1, oldest.Add(-time.Hour))
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT status, COUNT(*) AS count, MIN(created_at) AS oldest FROM `outbox_messages` "+
+			"WHERE status IN (?,?) GROUP BY `status`")).
+		WithArgs(model.OutboxStatusPending, model.OutboxStatusFailed).
+		WillReturnRows(rows)
+
+	stats, err := suite.repo.Stats()
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(4), stats.Pending)
+	assert.Equal(suite.T(), int64(1), stats.Failed)
+	assert.Equal(suite.T(), oldest, *stats.OldestPendingAt)
+}
+
+func TestOutboxRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// ErrProfileNotFound is returned when the user has no profile extension yet
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ProfileRepository handles database operations for user profile extensions
+type ProfileRepository struct {
+	db *gorm.DB
+}
+
+// ProfileRepositoryInterface defines the interface for profile repository
+type ProfileRepositoryInterface interface {
+	FindByUserID(userID uint) (*model.UserProfile, error)
+	Save(profile *model.UserProfile) error
+}
+
+// NewProfileRepository creates a new profile repository
+func NewProfileRepository(db *gorm.DB) ProfileRepositoryInterface {
+	return &ProfileRepository{
+		db: db,
+	}
+}
+
+// FindByUserID finds the profile extension of a user
+func (r *ProfileRepository) FindByUserID(userID uint) (*model.UserProfile, error) {
+	var profile model.UserProfile
+	err := r.db.Where("user_id = ?", userID).First(&profile).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProfileNotFound
+		}
+		return nil, fmt.Errorf("failed to find profile for user %d: %w", userID, err)
+	}
+
+	return &profile, nil
+}
+
+// Save creates a profile extension, or saves all fields of an existing one and increments its
+// version. It returns ErrStaleVersion, saving nothing, when the stored profile no longer has the
+// version of profile, or when a new profile was created for the user since it was found missing.
+func (r *ProfileRepository) Save(profile *model.UserProfile) error {
+	if profile.ID == 0 {
+		profile.Version = 1
+		if err := r.db.Create(profile).Error; err != nil {
+			profile.Version = 0
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return ErrStaleVersion
+			}
+			return fmt.Errorf("failed to save profile for user %d: %w", profile.UserID, err)
+		}
+		return nil
+	}
+
+	version := profile.Version
+	profile.Version++
+	result := r.db.Model(profile).Where("version = ?", version).Select("*").Updates(profile)
+	if result.Error != nil {
+		profile.Version = version
+		return fmt.Errorf("failed to save profile for user %d: %w", profile.UserID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		profile.Version = version
+		return ErrStaleVersion
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type ProfileRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.ProfileRepositoryInterface
+}
+
+func (suite *ProfileRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewProfileRepository(gormDB)
+}
+
+func (suite *ProfileRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ProfileRepositoryTestSuite) TestFindByUserID() {
+	testCases := []struct {
+		mockSetup    func()
+		expectedErr  error
+		name         string
+		errorMsg     string
+		expectedRole string
+		userID       uint
+		expectError  bool
+	}{
+		{
+			name:   "Success",
+			userID: 123,
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{
+					"id", "user_id", "role", "team_size", "intended_use", "created_at", "updated_at",
+				}).AddRow(1, 123, "engineer", "2-10", "work", time.Now(), time.Now())
+
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_profiles`")).
+					WithArgs(uint(123), sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+			expectedRole: "engineer",
+		},
+		{
+			name:   "Not found",
+			userID: 456,
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_profiles`")).
+					WithArgs(uint(456), sqlmock.AnyArg()).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectError: true,
+			expectedErr: repository.ErrProfileNotFound,
+		},
+		{
+			name:   "Database error",
+			userID: 789,
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_profiles`")).
+					WithArgs(uint(789), sqlmock.AnyArg()).
+					WillReturnError(assert.AnError)
+			},
+			expectError: true,
+			errorMsg:    "failed to find profile for user 789",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.mockSetup()
+
+			// Execute
+			profile, err := suite.repo.FindByUserID(tc.userID)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, profile)
+				if tc.expectedErr != nil {
+					assert.ErrorIs(t, err, tc.expectedErr)
+				}
+				if tc.errorMsg != "" {
+					assert.Contains(t, err.Error(), tc.errorMsg)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, profile)
+				assert.Equal(t, tc.userID, profile.UserID)
+				assert.Equal(t, tc.expectedRole, *profile.Role)
+			}
+		})
+	}
+}
+
+func (suite *ProfileRepositoryTestSuite) TestSave() {
+	role := "designer"
+	testCases := []struct {
+		profile     *model.UserProfile
+		mockSetup   func()
+		expectedErr error
+		name        string
+		errorMsg    string
+		expectError bool
+	}{
+		{
+			name:    "Create new profile",
+			profile: &model.UserProfile{UserID: 1, Role: &role},
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_profiles`")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				suite.mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Update existing profile",
+			profile: &model.UserProfile{ID: 5, UserID: 2, Role: &role},
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_profiles`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				suite.mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "Stale version",
+			profile: &model.UserProfile{ID: 5, UserID: 2, Role: &role, Version: 3},
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_profiles` SET")).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), &role, nil, nil, uint(2), uint(4), uint(3), uint(5)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				suite.mock.ExpectCommit()
+			},
+			expectedErr: repository.ErrStaleVersion,
+		},
+		{
+			name:    "Created concurrently",
+			profile: &model.UserProfile{UserID: 4, Role: &role},
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_profiles`")).
+					WillReturnError(gorm.ErrDuplicatedKey)
+				suite.mock.ExpectRollback()
+			},
+			expectedErr: repository.ErrStaleVersion,
+		},
+		{
+			name:    "Database error",
+			profile: &model.UserProfile{UserID: 3, Role: &role},
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_profiles`")).
+					WillReturnError(assert.AnError)
+				suite.mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to save profile for user 3",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.mockSetup()
+
+			// Execute
+			err := suite.repo.Save(tc.profile)
+
+			// Assert
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else if tc.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProfileRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfileRepositoryTestSuite))
+}
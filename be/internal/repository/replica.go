@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"strikepad-backend/internal/config"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// dbResolverPlugin is the name dbresolver registers itself under
+const dbResolverPlugin = "gorm:db_resolver"
+
+// findOnReplica runs the read-only query find on a read replica (DB_REPLICA_DSNS), and again on
+// the primary when it fails there: a row that was just written, such as the session of a login
+// or the user of a signup, may not have reached the replica yet, and a replica may be down. Without
+// replicas, find only runs on the primary.
+func findOnReplica(db *gorm.DB, find func(db *gorm.DB) error) error {
+	if _, ok := db.Config.Plugins[dbResolverPlugin]; !ok {
+		return find(db)
+	}
+	if err := find(db.Clauses(dbresolver.Use(config.ReplicaResolverName))); err == nil {
+		return nil
+	}
+	return find(db)
+}
+
+// onReplica returns db with its queries routed to a read replica, when there are replicas. It is
+// for reads that tolerate replication lag, such as listings.
+func onReplica(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Use(config.ReplicaResolverName))
+}
@@ -0,0 +1,119 @@
+package repository_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// ReplicaTestSuite checks which pool the read-heavy repository methods query when the database
+// has a read replica
+type ReplicaTestSuite struct {
+	suite.Suite
+	primary     sqlmock.Sqlmock
+	replica     sqlmock.Sqlmock
+	userRepo    repository.UserRepository
+	sessionRepo repository.SessionRepositoryInterface
+}
+
+func (suite *ReplicaTestSuite) SetupTest() {
+	primaryDB, primary, err := sqlmock.New()
+	suite.Require().NoError(err)
+	replicaDB, replica, err := sqlmock.New()
+	suite.Require().NoError(err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      primaryDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(config.UseReplicas(gormDB, config.Replicas{{Name: "replica_1", DB: replicaDB}}))
+
+	suite.primary = primary
+	suite.replica = replica
+	suite.userRepo = repository.NewUserRepository(gormDB)
+	suite.sessionRepo = repository.NewSessionRepository(gormDB)
+}
+
+func (suite *ReplicaTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.primary.ExpectationsWereMet())
+	assert.NoError(suite.T(), suite.replica.ExpectationsWereMet())
+}
+
+func (suite *ReplicaTestSuite) TestFindByEmail_ReadsReplica() {
+	suite.replica.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE email = ? AND is_deleted = ?")).
+		WithArgs(testEmail, false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(1, testEmail))
+
+	user, err := suite.userRepo.FindByEmail(testEmail)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), uint(1), user.ID)
+}
+
+func (suite *ReplicaTestSuite) TestFindByEmail_FallsBackToPrimary() {
+	tests := []struct {
+		replicaErr error
+		name       string
+	}{
+		{name: "row not replicated yet", replicaErr: gorm.ErrRecordNotFound},
+		{name: "replica down", replicaErr: errors.New("connection refused")},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.replica.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users`")).
+				WillReturnError(tt.replicaErr)
+			suite.primary.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE email = ? AND is_deleted = ?")).
+				WithArgs(testEmail, false, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(1, testEmail))
+
+			user, err := suite.userRepo.FindByEmail(testEmail)
+
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), uint(1), user.ID)
+			suite.TearDownTest()
+		})
+	}
+}
+
+func (suite *ReplicaTestSuite) TestFindByAccessTokenHash_FallsBackToPrimary() {
+	suite.replica.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	suite.primary.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions`")).
+		WithArgs("new-token-hash", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "access_token_hash"}).AddRow(1, 2, "new-token-hash"))
+	suite.primary.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`id` = ?")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	session, err := suite.sessionRepo.FindByAccessTokenHash("new-token-hash")
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), uint(2), session.User.ID)
+}
+
+func (suite *ReplicaTestSuite) TestList_ReadsReplica() {
+	suite.replica.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	page, err := suite.userRepo.List(repository.ListParams{Params: pagination.Params{Page: 1, PerPage: 10}})
+
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), page.Items, 1)
+}
+
+func TestReplicaTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplicaTestSuite))
+}
@@ -1,14 +1,19 @@
 package repository
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// ErrSessionNotFound is returned when no matching active session exists
+var ErrSessionNotFound = errors.New("session not found")
+
 // SessionRepository handles database operations for user sessions
 type SessionRepository struct {
 	db *gorm.DB
@@ -17,10 +22,15 @@ type SessionRepository struct {
 // SessionRepositoryInterface defines the interface for session repository
 type SessionRepositoryInterface interface {
 	Create(session *model.UserSession) error
-	FindByAccessToken(accessToken string) (*model.UserSession, error)
-	FindByRefreshToken(refreshToken string) (*model.UserSession, error)
+	FindByAccessTokenHash(accessTokenHash string) (*model.UserSession, error)
+	FindByRefreshTokenHash(refreshTokenHash string) (*model.UserSession, error)
 	FindActiveByUserID(userID uint) ([]*model.UserSession, error)
+	ListActiveByUserID(userID uint, params pagination.Params) (*pagination.Page[model.UserSession], error)
+	FindRefreshableByUserID(userID uint) ([]*model.UserSession, error)
+	FindRecentByUserID(userID uint, since time.Time, excludeID uint, limit int) ([]*model.UserSession, error)
 	Update(session *model.UserSession) error
+	UpdateLastUsedAt(session *model.UserSession, lastUsedAt time.Time) error
+	InvalidateByPublicID(publicID string, userID uint) error
 	InvalidateByUserID(userID uint) error
 	InvalidateExpiredSessions() error
 	Delete(sessionID uint) error
@@ -41,16 +51,19 @@ func (r *SessionRepository) Create(session *model.UserSession) error {
 	return nil
 }
 
-// FindByAccessToken finds a session by access token
-func (r *SessionRepository) FindByAccessToken(accessToken string) (*model.UserSession, error) {
+// FindByAccessTokenHash finds a session by the hash of its access token, read from a replica when
+// there is one
+func (r *SessionRepository) FindByAccessTokenHash(accessTokenHash string) (*model.UserSession, error) {
 	var session model.UserSession
-	err := r.db.Where("access_token = ? AND is_deleted = false", accessToken).
-		Preload("User").
-		First(&session).Error
+	err := findOnReplica(r.db, func(db *gorm.DB) error {
+		return db.Where("access_token_hash = ? AND is_deleted = false", accessTokenHash).
+			Preload("User").
+			First(&session).Error
+	})
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("session not found")
+			return nil, ErrSessionNotFound
 		}
 		return nil, fmt.Errorf("failed to find session by access token: %w", err)
 	}
@@ -58,16 +71,16 @@ func (r *SessionRepository) FindByAccessToken(accessToken string) (*model.UserSe
 	return &session, nil
 }
 
-// FindByRefreshToken finds a session by refresh token
-func (r *SessionRepository) FindByRefreshToken(refreshToken string) (*model.UserSession, error) {
+// FindByRefreshTokenHash finds a session by the hash of its refresh token
+func (r *SessionRepository) FindByRefreshTokenHash(refreshTokenHash string) (*model.UserSession, error) {
 	var session model.UserSession
-	err := r.db.Where("refresh_token = ? AND is_deleted = false", refreshToken).
+	err := r.db.Where("refresh_token_hash = ? AND is_deleted = false", refreshTokenHash).
 		Preload("User").
 		First(&session).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("session not found")
+			return nil, ErrSessionNotFound
 		}
 		return nil, fmt.Errorf("failed to find session by refresh token: %w", err)
 	}
@@ -90,6 +103,62 @@ func (r *SessionRepository) FindActiveByUserID(userID uint) ([]*model.UserSessio
 	return sessions, nil
 }
 
+// ListActiveByUserID returns a page of the active sessions of a user, newest first. Pages after
+// params.Cursor are found by creation time.
+func (r *SessionRepository) ListActiveByUserID(
+	userID uint, params pagination.Params,
+) (*pagination.Page[model.UserSession], error) {
+	query := r.db.Model(&model.UserSession{}).
+		Where("user_id = ? AND is_deleted = false AND access_token_expires_at > ?", userID, time.Now())
+
+	keyset := pagination.Keyset{Column: "created_at", Desc: true}
+	page, err := pagination.Seek(query, keyset, params, func(session *model.UserSession) string {
+		return session.PublicID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active sessions: %w", err)
+	}
+	return page, nil
+}
+
+// FindRefreshableByUserID finds the sessions of a user that can still be refreshed, which includes
+// those whose access token has expired, oldest first
+func (r *SessionRepository) FindRefreshableByUserID(userID uint) ([]*model.UserSession, error) {
+	var sessions []*model.UserSession
+	err := r.db.Where("user_id = ? AND is_deleted = false AND refresh_token_expires_at > ?",
+		userID, time.Now()).
+		Order("created_at ASC, id ASC").
+		Find(&sessions).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find refreshable sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// FindRecentByUserID finds up to limit of the user's sessions created since the given time, newest
+// first, including invalidated and expired ones but not the session with excludeID
+func (r *SessionRepository) FindRecentByUserID(
+	userID uint,
+	since time.Time,
+	excludeID uint,
+	limit int,
+) ([]*model.UserSession, error) {
+	var sessions []*model.UserSession
+	err := r.db.Unscoped().
+		Where("user_id = ? AND created_at >= ? AND id <> ?", userID, since, excludeID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&sessions).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recent sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
 // Update updates a session
 func (r *SessionRepository) Update(session *model.UserSession) error {
 	if err := r.db.Save(session).Error; err != nil {
@@ -98,6 +167,38 @@ func (r *SessionRepository) Update(session *model.UserSession) error {
 	return nil
 }
 
+// UpdateLastUsedAt records when the session was last used, leaving updated_at alone
+func (r *SessionRepository) UpdateLastUsedAt(session *model.UserSession, lastUsedAt time.Time) error {
+	err := r.db.Model(&model.UserSession{}).
+		Where("id = ?", session.ID).
+		UpdateColumn("last_used_at", lastUsedAt).Error
+	if err != nil {
+		return fmt.Errorf("failed to update session last used time: %w", err)
+	}
+	return nil
+}
+
+// InvalidateByPublicID invalidates a single active session owned by the given user
+func (r *SessionRepository) InvalidateByPublicID(publicID string, userID uint) error {
+	now := time.Now()
+	result := r.db.Model(&model.UserSession{}).
+		Where("public_id = ? AND user_id = ? AND is_deleted = false", publicID, userID).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": now,
+			"updated_at": now,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to invalidate session %s: %w", publicID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
 // InvalidateByUserID invalidates all sessions for a specific user
 func (r *SessionRepository) InvalidateByUserID(userID uint) error {
 	err := r.db.Model(&model.UserSession{}).
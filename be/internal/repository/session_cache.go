@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/model"
+)
+
+const (
+	// sessionCacheTTL bounds how long a cached session, and the user loaded with it, can be
+	// stale when it changes outside this repository
+	sessionCacheTTL = time.Minute
+
+	sessionTokenKeyPrefix = "session:token-hash:"
+	sessionIDKeyPrefix    = "session:id:"
+)
+
+// CachedSessionRepository caches sessions looked up by access token hash, which happens on every
+// authenticated request. Sessions are cached on read and evicted after the database is updated.
+// The cache also maps session IDs to their access token hashes, so the entry of a refreshed token
+// can be found and evicted.
+type CachedSessionRepository struct {
+	SessionRepositoryInterface
+	cache cache.Cache
+}
+
+// NewCachedSessionRepository puts c in front of repo. When c is nil, caching is disabled and repo
+// is returned as is.
+func NewCachedSessionRepository(repo SessionRepositoryInterface, c cache.Cache) SessionRepositoryInterface {
+	if c == nil {
+		return repo
+	}
+	return &CachedSessionRepository{
+		SessionRepositoryInterface: repo,
+		cache:                      c,
+	}
+}
+
+// FindByAccessTokenHash returns the cached session, loading and caching it on a miss. Cache
+// failures are logged and fall back to the database.
+func (r *CachedSessionRepository) FindByAccessTokenHash(accessTokenHash string) (*model.UserSession, error) {
+	ctx := context.Background()
+
+	data, err := r.cache.Get(ctx, sessionTokenKeyPrefix+accessTokenHash)
+	if err == nil {
+		var session model.UserSession
+		if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&session); err == nil {
+			return &session, nil
+		}
+		slog.Warn("Discarding undecodable cached session", "error", err)
+	} else if !errors.Is(err, cache.ErrMiss) {
+		slog.Warn("Failed to read session cache", "error", err)
+	}
+
+	session, err := r.SessionRepositoryInterface.FindByAccessTokenHash(accessTokenHash)
+	if err != nil {
+		return nil, err
+	}
+	r.store(ctx, session)
+	return session, nil
+}
+
+// Update updates the session and evicts it, under both its previous and its new access token hash
+func (r *CachedSessionRepository) Update(session *model.UserSession) error {
+	if err := r.SessionRepositoryInterface.Update(session); err != nil {
+		return err
+	}
+	r.evict(context.Background(), []*model.UserSession{session})
+	return nil
+}
+
+// UpdateLastUsedAt records when the session was last used and evicts it, so that the next request
+// does not see the previous time
+func (r *CachedSessionRepository) UpdateLastUsedAt(session *model.UserSession, lastUsedAt time.Time) error {
+	if err := r.SessionRepositoryInterface.UpdateLastUsedAt(session, lastUsedAt); err != nil {
+		return err
+	}
+	r.evict(context.Background(), []*model.UserSession{session})
+	return nil
+}
+
+// InvalidateByPublicID invalidates the session and evicts it
+func (r *CachedSessionRepository) InvalidateByPublicID(publicID string, userID uint) error {
+	// The session's token can only be looked up while the session is still active
+	sessions, err := r.SessionRepositoryInterface.FindActiveByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.SessionRepositoryInterface.InvalidateByPublicID(publicID, userID); err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.PublicID == publicID {
+			r.evict(context.Background(), []*model.UserSession{session})
+		}
+	}
+	return nil
+}
+
+// InvalidateByUserID invalidates the user's sessions and evicts them
+func (r *CachedSessionRepository) InvalidateByUserID(userID uint) error {
+	sessions, err := r.SessionRepositoryInterface.FindActiveByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.SessionRepositoryInterface.InvalidateByUserID(userID); err != nil {
+		return err
+	}
+	r.evict(context.Background(), sessions)
+	return nil
+}
+
+// Delete deletes the session and evicts it
+func (r *CachedSessionRepository) Delete(sessionID uint) error {
+	if err := r.SessionRepositoryInterface.Delete(sessionID); err != nil {
+		return err
+	}
+	r.evict(context.Background(), []*model.UserSession{{ID: sessionID}})
+	return nil
+}
+
+// store caches the session until its access token expires, for at most sessionCacheTTL
+func (r *CachedSessionRepository) store(ctx context.Context, session *model.UserSession) {
+	ttl := min(time.Until(session.AccessTokenExpiresAt), sessionCacheTTL)
+	if ttl <= 0 || !session.IsAccessTokenValid(time.Now()) {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(session); err != nil {
+		slog.Warn("Failed to encode session for cache", "error", err)
+		return
+	}
+	idKey := sessionIDKeyPrefix + fmt.Sprint(session.ID)
+	if err := r.cache.Set(ctx, idKey, []byte(session.AccessTokenHash), ttl); err != nil {
+		slog.Warn("Failed to write session cache", "error", err)
+		return
+	}
+	if err := r.cache.Set(ctx, sessionTokenKeyPrefix+session.AccessTokenHash, buf.Bytes(), ttl); err != nil {
+		slog.Warn("Failed to write session cache", "error", err)
+	}
+}
+
+// evict removes the cached entries of the sessions, including the entry of the access token hash
+// each session had when it was cached
+func (r *CachedSessionRepository) evict(ctx context.Context, sessions []*model.UserSession) {
+	var keys []string
+	for _, session := range sessions {
+		idKey := sessionIDKeyPrefix + fmt.Sprint(session.ID)
+		keys = append(keys, idKey)
+		if session.AccessTokenHash != "" {
+			keys = append(keys, sessionTokenKeyPrefix+session.AccessTokenHash)
+		}
+
+		cachedHash, err := r.cache.Get(ctx, idKey)
+		if err == nil {
+			keys = append(keys, sessionTokenKeyPrefix+string(cachedHash))
+		} else if !errors.Is(err, cache.ErrMiss) {
+			slog.Error("Failed to read session cache, cached session may stay valid until it expires",
+				"session_id", session.ID, "error", err)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		slog.Error("Failed to evict sessions from cache, cached sessions may stay valid until they expire",
+			"error", err)
+	}
+}
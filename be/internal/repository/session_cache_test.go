@@ -0,0 +1,165 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachedSession(id uint, accessToken string) *model.UserSession {
+	user := factory.NewUser().WithPasswordHash("hash").Admin().Build()
+	return factory.NewSession().WithID(id).ForUser(user).WithTokens(accessToken, factory.RefreshToken).Build()
+}
+
+func TestNewCachedSessionRepository_WithoutCache(t *testing.T) {
+	inner := &mocks.MockSessionRepository{}
+
+	assert.Same(t, inner, repository.NewCachedSessionRepository(inner, nil))
+}
+
+func TestCachedSessionRepository_FindByAccessTokenHash(t *testing.T) {
+	inner := &mocks.MockSessionRepository{}
+	inner.On("FindByAccessTokenHash", auth.HashToken("access-1")).Return(newCachedSession(7, "access-1"), nil).Once()
+	inner.On("FindByAccessTokenHash", auth.HashToken("unknown")).Return(nil, repository.ErrSessionNotFound).Twice()
+	repo := repository.NewCachedSessionRepository(inner, cache.NewMemoryCache())
+
+	first, err := repo.FindByAccessTokenHash(auth.HashToken("access-1"))
+	require.NoError(t, err)
+	second, err := repo.FindByAccessTokenHash(auth.HashToken("access-1"))
+	require.NoError(t, err)
+
+	// The second lookup is served from the cache, including fields hidden from JSON
+	assert.Equal(t, first.PublicID, second.PublicID)
+	assert.Equal(t, uint(7), second.ID)
+	assert.Equal(t, "hash", *second.User.PasswordHash)
+	assert.True(t, second.User.IsAdmin())
+
+	// Missing sessions are not cached
+	for range 2 {
+		_, err = repo.FindByAccessTokenHash(auth.HashToken("unknown"))
+		assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+	}
+	inner.AssertExpectations(t)
+}
+
+func TestCachedSessionRepository_SkipsInvalidSessions(t *testing.T) {
+	expired := newCachedSession(7, "access-1")
+	expired.AccessTokenExpiresAt = time.Now().Add(-time.Minute)
+	inner := &mocks.MockSessionRepository{}
+	inner.On("FindByAccessTokenHash", auth.HashToken("access-1")).Return(expired, nil).Twice()
+	repo := repository.NewCachedSessionRepository(inner, cache.NewMemoryCache())
+
+	for range 2 {
+		_, err := repo.FindByAccessTokenHash(auth.HashToken("access-1"))
+		require.NoError(t, err)
+	}
+	inner.AssertExpectations(t)
+}
+
+func TestCachedSessionRepository_UpdateEvictsPreviousToken(t *testing.T) {
+	sessionCache := cache.NewMemoryCache()
+	inner := &mocks.MockSessionRepository{}
+	session := newCachedSession(7, "access-1")
+	inner.On("FindByAccessTokenHash", auth.HashToken("access-1")).Return(session, nil).Once()
+	repo := repository.NewCachedSessionRepository(inner, sessionCache)
+
+	_, err := repo.FindByAccessTokenHash(auth.HashToken("access-1"))
+	require.NoError(t, err)
+
+	// A token refresh replaces the access token before saving
+	refreshed := newCachedSession(7, "access-2")
+	inner.On("Update", refreshed).Return(nil).Once()
+	require.NoError(t, repo.Update(refreshed))
+
+	_, err = sessionCache.Get(context.Background(), "session:token-hash:"+auth.HashToken("access-1"))
+	assert.ErrorIs(t, err, cache.ErrMiss)
+	inner.On("FindByAccessTokenHash", auth.HashToken("access-1")).Return(nil, repository.ErrSessionNotFound).Once()
+	_, err = repo.FindByAccessTokenHash(auth.HashToken("access-1"))
+	assert.ErrorIs(t, err, repository.ErrSessionNotFound)
+	inner.AssertExpectations(t)
+}
+
+func TestCachedSessionRepository_Invalidate(t *testing.T) {
+	tests := []struct {
+		invalidate func(repo repository.SessionRepositoryInterface) error
+		setup      func(inner *mocks.MockSessionRepository)
+		name       string
+	}{
+		{
+			name: "by public ID",
+			setup: func(inner *mocks.MockSessionRepository) {
+				inner.On("InvalidateByPublicID", "ses_8h2m4q0x9d7c3v1k5b6n", uint(1)).Return(nil).Once()
+			},
+			invalidate: func(repo repository.SessionRepositoryInterface) error {
+				return repo.InvalidateByPublicID("ses_8h2m4q0x9d7c3v1k5b6n", 1)
+			},
+		},
+		{
+			name: "by user ID",
+			setup: func(inner *mocks.MockSessionRepository) {
+				inner.On("InvalidateByUserID", uint(1)).Return(nil).Once()
+			},
+			invalidate: func(repo repository.SessionRepositoryInterface) error {
+				return repo.InvalidateByUserID(1)
+			},
+		},
+		{
+			name: "delete",
+			setup: func(inner *mocks.MockSessionRepository) {
+				inner.On("Delete", uint(7)).Return(nil).Once()
+			},
+			invalidate: func(repo repository.SessionRepositoryInterface) error {
+				return repo.Delete(7)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionCache := cache.NewMemoryCache()
+			inner := &mocks.MockSessionRepository{}
+			session := newCachedSession(7, "access-1")
+			inner.On("FindByAccessTokenHash", auth.HashToken("access-1")).Return(session, nil).Once()
+			inner.On("FindActiveByUserID", uint(1)).Return([]*model.UserSession{session}, nil).Maybe()
+			tt.setup(inner)
+			repo := repository.NewCachedSessionRepository(inner, sessionCache)
+
+			_, err := repo.FindByAccessTokenHash(auth.HashToken("access-1"))
+			require.NoError(t, err)
+			require.NoError(t, tt.invalidate(repo))
+
+			_, err = sessionCache.Get(context.Background(), "session:token-hash:"+auth.HashToken("access-1"))
+			assert.ErrorIs(t, err, cache.ErrMiss)
+			inner.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCachedSessionRepository_InvalidateError(t *testing.T) {
+	sessionCache := cache.NewMemoryCache()
+	inner := &mocks.MockSessionRepository{}
+	session := newCachedSession(7, "access-1")
+	inner.On("FindByAccessTokenHash", auth.HashToken("access-1")).Return(session, nil).Once()
+	inner.On("FindActiveByUserID", uint(1)).Return([]*model.UserSession{session}, nil).Once()
+	inner.On("InvalidateByUserID", uint(1)).Return(assert.AnError).Once()
+	repo := repository.NewCachedSessionRepository(inner, sessionCache)
+
+	_, err := repo.FindByAccessTokenHash(auth.HashToken("access-1"))
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.InvalidateByUserID(1), assert.AnError)
+
+	// The session is still active, so its cache entry is kept
+	_, err = sessionCache.Get(context.Background(), "session:token-hash:"+auth.HashToken("access-1"))
+	assert.NoError(t, err)
+	inner.AssertExpectations(t)
+}
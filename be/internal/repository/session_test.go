@@ -6,10 +6,13 @@ import (
 	"time"
 
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
 	"strikepad-backend/internal/repository"
+	"strikepad-backend/test/testutil"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -54,8 +57,8 @@ func (suite *SessionRepositoryTestSuite) TestCreate() {
 			name: "Success",
 			session: &model.UserSession{
 				UserID:                1,
-				AccessToken:           "test-access-token",
-				RefreshToken:          "test-refresh-token",
+				AccessTokenHash:       "test-access-token-hash",
+				RefreshTokenHash:      "test-refresh-token-hash",
 				AccessTokenExpiresAt:  time.Now().Add(time.Hour),
 				RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
 				IsDeleted:             false,
@@ -65,8 +68,14 @@ func (suite *SessionRepositoryTestSuite) TestCreate() {
 				suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_sessions`")).
 					WithArgs(
 						sqlmock.AnyArg(), // user_id
-						sqlmock.AnyArg(), // access_token
-						sqlmock.AnyArg(), // refresh_token
+						sqlmock.AnyArg(), // public_id
+						sqlmock.AnyArg(), // access_token_hash
+						sqlmock.AnyArg(), // refresh_token_hash
+						sqlmock.AnyArg(), // ip_address
+						sqlmock.AnyArg(), // user_agent
+						sqlmock.AnyArg(), // device_name
+						sqlmock.AnyArg(), // country
+						sqlmock.AnyArg(), // last_used_at
 						sqlmock.AnyArg(), // access_token_expires_at
 						sqlmock.AnyArg(), // refresh_token_expires_at
 						sqlmock.AnyArg(), // is_deleted
@@ -80,10 +89,10 @@ func (suite *SessionRepositoryTestSuite) TestCreate() {
 		{
 			name: "Database error",
 			session: &model.UserSession{
-				UserID:       2,
-				AccessToken:  "test-access-token-2",
-				RefreshToken: "test-refresh-token-2",
-				IsDeleted:    false,
+				UserID:           2,
+				AccessTokenHash:  "test-access-token-hash-2",
+				RefreshTokenHash: "test-refresh-token-hash-2",
+				IsDeleted:        false,
 			},
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
@@ -117,31 +126,31 @@ func (suite *SessionRepositoryTestSuite) TestCreate() {
 	}
 }
 
-func (suite *SessionRepositoryTestSuite) TestFindByAccessToken() {
+func (suite *SessionRepositoryTestSuite) TestFindByAccessTokenHash() {
 	testCases := []struct {
-		mockSetup   func()
-		name        string
-		accessToken string
-		errorMsg    string
-		expectedUID uint
-		expectError bool
+		mockSetup       func()
+		name            string
+		accessTokenHash string
+		errorMsg        string
+		expectedUID     uint
+		expectError     bool
 	}{
 		{
-			name:        "Success",
-			accessToken: "valid-access-token",
+			name:            "Success",
+			accessTokenHash: "valid-access-token-hash",
 			mockSetup: func() {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "access_token", "refresh_token",
+					"id", "user_id", "access_token_hash", "refresh_token_hash",
 					"access_token_expires_at", "refresh_token_expires_at",
 					"created_at", "updated_at", "is_deleted", "deleted_at",
 				}).AddRow(
-					1, 123, "valid-access-token", "refresh-token",
+					1, 123, "valid-access-token-hash", "refresh-token-hash",
 					time.Now().Add(time.Hour), time.Now().Add(24*time.Hour),
 					time.Now(), time.Now(), false, nil,
 				)
 
-				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions`")).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions` WHERE (access_token_hash = ? AND is_deleted = false)")).
+					WithArgs("valid-access-token-hash", sqlmock.AnyArg()).
 					WillReturnRows(rows)
 
 				// Mock for User preload
@@ -163,8 +172,8 @@ func (suite *SessionRepositoryTestSuite) TestFindByAccessToken() {
 			expectedUID: 123,
 		},
 		{
-			name:        "Session not found",
-			accessToken: "nonexistent-token",
+			name:            "Session not found",
+			accessTokenHash: "nonexistent-token-hash",
 			mockSetup: func() {
 				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions`")).
 					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
@@ -174,8 +183,8 @@ func (suite *SessionRepositoryTestSuite) TestFindByAccessToken() {
 			errorMsg:    "session not found",
 		},
 		{
-			name:        "Database error",
-			accessToken: "error-token",
+			name:            "Database error",
+			accessTokenHash: "error-token-hash",
 			mockSetup: func() {
 				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions`")).
 					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
@@ -192,7 +201,7 @@ func (suite *SessionRepositoryTestSuite) TestFindByAccessToken() {
 			tc.mockSetup()
 
 			// Execute
-			session, err := suite.repo.FindByAccessToken(tc.accessToken)
+			session, err := suite.repo.FindByAccessTokenHash(tc.accessTokenHash)
 
 			// Assert
 			if tc.expectError {
@@ -205,37 +214,37 @@ func (suite *SessionRepositoryTestSuite) TestFindByAccessToken() {
 				assert.NoError(t, err)
 				assert.NotNil(t, session)
 				assert.Equal(t, tc.expectedUID, session.UserID)
-				assert.Equal(t, tc.accessToken, session.AccessToken)
+				assert.Equal(t, tc.accessTokenHash, session.AccessTokenHash)
 			}
 		})
 	}
 }
 
-func (suite *SessionRepositoryTestSuite) TestFindByRefreshToken() {
+func (suite *SessionRepositoryTestSuite) TestFindByRefreshTokenHash() {
 	testCases := []struct {
-		mockSetup    func()
-		name         string
-		refreshToken string
-		errorMsg     string
-		expectedUID  uint
-		expectError  bool
+		mockSetup        func()
+		name             string
+		refreshTokenHash string
+		errorMsg         string
+		expectedUID      uint
+		expectError      bool
 	}{
 		{
-			name:         "Success",
-			refreshToken: "valid-refresh-token",
+			name:             "Success",
+			refreshTokenHash: "valid-refresh-token-hash",
 			mockSetup: func() {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "access_token", "refresh_token",
+					"id", "user_id", "access_token_hash", "refresh_token_hash",
 					"access_token_expires_at", "refresh_token_expires_at",
 					"created_at", "updated_at", "is_deleted", "deleted_at",
 				}).AddRow(
-					1, 456, "access-token", "valid-refresh-token",
+					1, 456, "access-token-hash", "valid-refresh-token-hash",
 					time.Now().Add(time.Hour), time.Now().Add(24*time.Hour),
 					time.Now(), time.Now(), false, nil,
 				)
 
-				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions`")).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions` WHERE (refresh_token_hash = ? AND is_deleted = false)")).
+					WithArgs("valid-refresh-token-hash", sqlmock.AnyArg()).
 					WillReturnRows(rows)
 
 				// Mock for User preload
@@ -257,8 +266,8 @@ func (suite *SessionRepositoryTestSuite) TestFindByRefreshToken() {
 			expectedUID: 456,
 		},
 		{
-			name:         "Session not found",
-			refreshToken: "nonexistent-refresh-token",
+			name:             "Session not found",
+			refreshTokenHash: "nonexistent-refresh-token-hash",
 			mockSetup: func() {
 				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions`")).
 					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
@@ -275,7 +284,7 @@ func (suite *SessionRepositoryTestSuite) TestFindByRefreshToken() {
 			tc.mockSetup()
 
 			// Execute
-			session, err := suite.repo.FindByRefreshToken(tc.refreshToken)
+			session, err := suite.repo.FindByRefreshTokenHash(tc.refreshTokenHash)
 
 			// Assert
 			if tc.expectError {
@@ -288,7 +297,7 @@ func (suite *SessionRepositoryTestSuite) TestFindByRefreshToken() {
 				assert.NoError(t, err)
 				assert.NotNil(t, session)
 				assert.Equal(t, tc.expectedUID, session.UserID)
-				assert.Equal(t, tc.refreshToken, session.RefreshToken)
+				assert.Equal(t, tc.refreshTokenHash, session.RefreshTokenHash)
 			}
 		})
 	}
@@ -308,7 +317,7 @@ func (suite *SessionRepositoryTestSuite) TestFindActiveByUserID() {
 			userID: 789,
 			mockSetup: func() {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "access_token", "refresh_token",
+					"id", "user_id", "access_token_hash", "refresh_token_hash",
 					"access_token_expires_at", "refresh_token_expires_at",
 					"created_at", "updated_at", "is_deleted", "deleted_at",
 				}).
@@ -327,7 +336,7 @@ func (suite *SessionRepositoryTestSuite) TestFindActiveByUserID() {
 			userID: 999,
 			mockSetup: func() {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "access_token", "refresh_token",
+					"id", "user_id", "access_token_hash", "refresh_token_hash",
 					"access_token_expires_at", "refresh_token_expires_at",
 					"created_at", "updated_at", "is_deleted", "deleted_at",
 				})
@@ -381,6 +390,78 @@ func (suite *SessionRepositoryTestSuite) TestFindActiveByUserID() {
 	}
 }
 
+func (suite *SessionRepositoryTestSuite) TestListActiveByUserID() {
+	createdAt := time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`created_at` FROM `user_sessions` WHERE (user_id = ? "+
+		"AND is_deleted = false AND access_token_expires_at > ?) AND public_id = ? "+
+		"AND `user_sessions`.`deleted_at` IS NULL LIMIT ?")).
+		WithArgs(uint(789), sqlmock.AnyArg(), "ses_5k2d9x0q7m3hv8c1b4na", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, createdAt))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions` WHERE (user_id = ? AND is_deleted = false "+
+		"AND access_token_expires_at > ?) AND (created_at < ? OR (created_at = ? AND id > ?)) "+
+		"AND `user_sessions`.`deleted_at` IS NULL ORDER BY created_at DESC, id ASC LIMIT ?")).
+		WithArgs(uint(789), sqlmock.AnyArg(), createdAt, createdAt, 7, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "public_id", "user_id"}).
+			AddRow(3, "ses_0vd3m8k2q9x7h1c5b4nz", 789).
+			AddRow(9, "ses_9c4m1x7k2d5v8h0q3b6n", 789).
+			AddRow(2, "ses_3m8q1x5k9d2v7h0c4b6n", 789))
+
+	params := pagination.Params{PerPage: 2, Cursor: "ses_5k2d9x0q7m3hv8c1b4na"}
+	page, err := suite.repo.ListActiveByUserID(789, params)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), page.Items, 2)
+	assert.True(suite.T(), page.HasMore)
+	assert.Equal(suite.T(), "ses_9c4m1x7k2d5v8h0q3b6n", page.NextCursor)
+}
+
+func (suite *SessionRepositoryTestSuite) TestFindRecentByUserID() {
+	since := time.Now().Add(-90 * 24 * time.Hour)
+	rows := sqlmock.NewRows([]string{"id", "user_id", "user_agent", "country", "is_deleted", "deleted_at"}).
+		AddRow(4, 789, "Mozilla/5.0 (Windows NT 10.0; Win64; x64) Chrome/129.0", "JP", true, time.Now()).
+		AddRow(2, 789, "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_0) Safari/605.1.15", nil, false, nil)
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions` WHERE user_id = ? AND created_at >= ? "+
+		"AND id <> ? ORDER BY created_at DESC LIMIT ?")).
+		WithArgs(uint(789), since, uint(5), 50).
+		WillReturnRows(rows)
+
+	sessions, err := suite.repo.FindRecentByUserID(789, since, 5, 50)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), sessions, 2)
+	assert.Equal(suite.T(), "JP", *sessions[0].Country)
+	assert.Nil(suite.T(), sessions[1].Country)
+}
+
+func (suite *SessionRepositoryTestSuite) TestFindRefreshableByUserID() {
+	rows := sqlmock.NewRows([]string{"id", "user_id", "access_token_expires_at", "refresh_token_expires_at"}).
+		AddRow(1, 789, time.Now().Add(-time.Hour), time.Now().Add(24*time.Hour)).
+		AddRow(2, 789, time.Now().Add(time.Hour), time.Now().Add(48*time.Hour))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_sessions` WHERE (user_id = ? AND is_deleted = false "+
+		"AND refresh_token_expires_at > ?) AND `user_sessions`.`deleted_at` IS NULL ORDER BY created_at ASC, id ASC")).
+		WithArgs(uint(789), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	sessions, err := suite.repo.FindRefreshableByUserID(789)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), sessions, 2)
+	assert.Equal(suite.T(), uint(1), sessions[0].ID)
+}
+
+func (suite *SessionRepositoryTestSuite) TestUpdateLastUsedAt() {
+	lastUsedAt := time.Now()
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_sessions` SET `last_used_at`=? WHERE id = ?")).
+		WithArgs(lastUsedAt, uint(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.UpdateLastUsedAt(&model.UserSession{ID: 7}, lastUsedAt)
+
+	assert.NoError(suite.T(), err)
+}
+
 func (suite *SessionRepositoryTestSuite) TestUpdate() {
 	testCases := []struct {
 		session     *model.UserSession
@@ -394,8 +475,8 @@ func (suite *SessionRepositoryTestSuite) TestUpdate() {
 			session: &model.UserSession{
 				ID:                    1,
 				UserID:                123,
-				AccessToken:           "updated-access-token",
-				RefreshToken:          "updated-refresh-token",
+				AccessTokenHash:       "updated-access-token-hash",
+				RefreshTokenHash:      "updated-refresh-token-hash",
 				AccessTokenExpiresAt:  time.Now().Add(2 * time.Hour),
 				RefreshTokenExpiresAt: time.Now().Add(48 * time.Hour),
 				IsDeleted:             false,
@@ -405,8 +486,14 @@ func (suite *SessionRepositoryTestSuite) TestUpdate() {
 				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_sessions`")).
 					WithArgs(
 						sqlmock.AnyArg(), // user_id
-						sqlmock.AnyArg(), // access_token
-						sqlmock.AnyArg(), // refresh_token
+						sqlmock.AnyArg(), // public_id
+						sqlmock.AnyArg(), // access_token_hash
+						sqlmock.AnyArg(), // refresh_token_hash
+						sqlmock.AnyArg(), // ip_address
+						sqlmock.AnyArg(), // user_agent
+						sqlmock.AnyArg(), // device_name
+						sqlmock.AnyArg(), // country
+						sqlmock.AnyArg(), // last_used_at
 						sqlmock.AnyArg(), // access_token_expires_at
 						sqlmock.AnyArg(), // refresh_token_expires_at
 						sqlmock.AnyArg(), // created_at
@@ -458,6 +545,83 @@ func (suite *SessionRepositoryTestSuite) TestUpdate() {
 	}
 }
 
+func (suite *SessionRepositoryTestSuite) TestInvalidateByPublicID() {
+	testCases := []struct {
+		mockSetup   func()
+		expectedErr error
+		name        string
+		errorMsg    string
+		sessionID   string
+		userID      uint
+		expectError bool
+	}{
+		{
+			name:      "Success",
+			sessionID: "ses_000000000000000000aa",
+			userID:    123,
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_sessions`")).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "ses_000000000000000000aa", uint(123)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				suite.mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:      "Session not found or owned by another user",
+			sessionID: "ses_000000000000000000bb",
+			userID:    123,
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_sessions`")).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "ses_000000000000000000bb", uint(123)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				suite.mock.ExpectCommit()
+			},
+			expectError: true,
+			expectedErr: repository.ErrSessionNotFound,
+		},
+		{
+			name:      "Database error",
+			sessionID: "ses_000000000000000000cc",
+			userID:    456,
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_sessions`")).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "ses_000000000000000000cc", uint(456)).
+					WillReturnError(assert.AnError)
+				suite.mock.ExpectRollback()
+			},
+			expectError: true,
+			errorMsg:    "failed to invalidate session ses_000000000000000000cc",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.mockSetup()
+
+			// Execute
+			err := suite.repo.InvalidateByPublicID(tc.sessionID, tc.userID)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				if tc.expectedErr != nil {
+					assert.ErrorIs(t, err, tc.expectedErr)
+				}
+				if tc.errorMsg != "" {
+					assert.Contains(t, err.Error(), tc.errorMsg)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func (suite *SessionRepositoryTestSuite) TestInvalidateByUserID() {
 	testCases := []struct {
 		mockSetup   func()
@@ -623,6 +787,29 @@ func (suite *SessionRepositoryTestSuite) TestDelete() {
 	}
 }
 
+func TestSessionRepository_ListActiveByUserID_CursorOfAnotherUser(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(db)
+	require.NoError(t, db.AutoMigrate(&model.UserSession{}))
+
+	owner := testutil.CreateTestUser(t, db, "Owner", "owner@example.com")
+	other := testutil.CreateTestUser(t, db, "Other", "other@example.com")
+	expiresAt := time.Now().Add(time.Hour)
+	sessions := []model.UserSession{
+		{UserID: owner.ID, AccessTokenHash: "owner", AccessTokenExpiresAt: expiresAt, RefreshTokenExpiresAt: expiresAt},
+		{UserID: other.ID, AccessTokenHash: "other", AccessTokenExpiresAt: expiresAt, RefreshTokenExpiresAt: expiresAt},
+	}
+	for i := range sessions {
+		require.NoError(t, db.Create(&sessions[i]).Error)
+	}
+
+	repo := repository.NewSessionRepository(db)
+	page, err := repo.ListActiveByUserID(owner.ID, pagination.Params{Cursor: sessions[1].PublicID})
+
+	assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	assert.Nil(t, page)
+}
+
 func TestSessionRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(SessionRepositoryTestSuite))
 }
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Repositories groups the repositories that can take part in a unit of work
+type Repositories struct {
+	Users       UserRepository
+	Identities  IdentityRepositoryInterface
+	Sessions    SessionRepositoryInterface
+	Outbox      OutboxRepositoryInterface
+	Invitations InvitationRepositoryInterface
+}
+
+// NewRepositories creates repositories that share a database connection or transaction
+func NewRepositories(db *gorm.DB) *Repositories {
+	return &Repositories{
+		Users:       NewUserRepository(db),
+		Identities:  NewIdentityRepository(db),
+		Sessions:    NewSessionRepository(db),
+		Outbox:      NewOutboxRepository(db),
+		Invitations: NewInvitationRepository(db),
+	}
+}
+
+// TxManager runs units of work in database transactions
+type TxManager struct {
+	db *gorm.DB
+}
+
+// TxManagerInterface defines the interface for transaction manager
+type TxManagerInterface interface {
+	WithTransaction(ctx context.Context, fn func(repos *Repositories) error) error
+}
+
+// NewTxManager creates a new transaction manager
+func NewTxManager(db *gorm.DB) TxManagerInterface {
+	return &TxManager{
+		db: db,
+	}
+}
+
+// WithTransaction calls fn with repositories bound to a new transaction.
+// The transaction is committed when fn returns nil and rolled back otherwise.
+func (m *TxManager) WithTransaction(ctx context.Context, fn func(repos *Repositories) error) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepositories(tx))
+	})
+}
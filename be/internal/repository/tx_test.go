@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestTxManager_WithTransaction(t *testing.T) {
+	tests := []struct {
+		sessionErr error
+		name       string
+	}{
+		{
+			name: "commits when every step succeeds",
+		},
+		{
+			name:       "rolls back when a step fails",
+			sessionErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			gormDB, err := gorm.Open(mysql.New(mysql.Config{
+				Conn:                      db,
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{})
+			assert.NoError(t, err)
+
+			mock.ExpectBegin()
+			mock.ExpectExec("INSERT INTO `users`").WillReturnResult(sqlmock.NewResult(1, 1))
+			if tt.sessionErr != nil {
+				mock.ExpectExec("INSERT INTO `user_sessions`").WillReturnError(tt.sessionErr)
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectExec("INSERT INTO `user_sessions`").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			}
+
+			email := "tx@example.com"
+			err = repository.NewTxManager(gormDB).WithTransaction(context.Background(),
+				func(repos *repository.Repositories) error {
+					user, err := repos.Users.Create(&model.User{ProviderType: "email", Email: &email, DisplayName: "Tx"})
+					if err != nil {
+						return err
+					}
+					return repos.Sessions.Create(&model.UserSession{UserID: user.ID, AccessTokenHash: "token-hash"})
+				})
+
+			if tt.sessionErr != nil {
+				assert.ErrorIs(t, err, tt.sessionErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,32 +1,126 @@
 package repository
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
 
 	"gorm.io/gorm"
 )
 
+// ErrDuplicateEmail is returned when an active user already has the email address
+var ErrDuplicateEmail = errors.New("duplicate user email")
+
+// ErrStaleVersion is returned when saving a user or a profile that was changed since it was read
+var ErrStaleVersion = errors.New("record was changed since it was read")
+
+// ErrInvalidSort is returned when ListParams.Sort names a column that cannot be sorted on
+var ErrInvalidSort = errors.New("invalid sort field")
+
+// ErrCursorSort is returned for a ListParams.Cursor when users are sorted by a column other than ID
+// and creation time, which cannot be paged by cursor
+var ErrCursorSort = errors.New("cursor requires sorting by id or created_at")
+
+// userSortColumns maps the accepted sort fields to their columns
+var userSortColumns = map[string]string{
+	"id":           "id",
+	"created_at":   "created_at",
+	"display_name": "display_name",
+	"email":        "email",
+}
+
+// ListParams selects a page of users. Sort is a field name, prefixed with "-" for descending
+// order; users are sorted by ID when it is empty. Pages may follow a cursor when sorted by ID or
+// creation time.
+type ListParams struct {
+	Sort         string
+	ProviderType string
+	EmailPrefix  string
+	pagination.Params
+}
+
+// keyset converts Sort to the order of the list, with ID as the tie-breaker
+func (p ListParams) keyset() (pagination.Keyset, error) {
+	if p.Sort == "" {
+		return pagination.Keyset{}, nil
+	}
+
+	field, desc := strings.CutPrefix(p.Sort, "-")
+	column, ok := userSortColumns[field]
+	if !ok {
+		return pagination.Keyset{}, fmt.Errorf("%w: %s", ErrInvalidSort, field)
+	}
+	if column == "id" {
+		return pagination.Keyset{Desc: desc}, nil
+	}
+	return pagination.Keyset{Column: column, Desc: desc}, nil
+}
+
+// UserSearchParams selects the users matching a typeahead query
+type UserSearchParams struct {
+	// Query is matched as a prefix of the display name, case-insensitively, and of the email address
+	Query string
+	// ExcludeUserID leaves out the user searching, 0 to leave out nobody
+	ExcludeUserID uint
+	Limit         int
+}
+
+// likeEscaper escapes LIKE wildcards so user input matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// UserRepository reads and writes users. Users are only ever soft deleted, and its lookups skip
+// soft-deleted users unless the repository comes from WithDeleted.
 type UserRepository interface {
 	Create(user *model.User) (*model.User, error)
 	GetByID(id uint) (*model.User, error)
+	GetByPublicID(publicID string) (*model.User, error)
 	GetByEmail(email string) (*model.User, error)
 	FindByEmail(email string) (*model.User, error)
 	Update(user *model.User) error
-	Delete(id uint) error
-	List() ([]model.User, error)
+	SoftDelete(id uint) error
+	Restore(id uint) error
+	List(params ListParams) (*pagination.Page[model.User], error)
+	Search(params UserSearchParams) ([]model.User, error)
+	WithDeleted() UserRepository
 }
 
 type userRepository struct {
-	db *gorm.DB
+	db          *gorm.DB
+	withDeleted bool
 }
 
 func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{db: db}
 }
 
+// WithDeleted returns a copy of the repository whose lookups and listings include soft-deleted users
+func (r *userRepository) WithDeleted() UserRepository {
+	return &userRepository{db: r.db, withDeleted: true}
+}
+
+// notDeleted is a scope excluding soft-deleted users
+func notDeleted(db *gorm.DB) *gorm.DB {
+	return db.Where("is_deleted = ?", false)
+}
+
+// scoped returns db restricted to the users the repository can see
+func (r *userRepository) scoped(db *gorm.DB) *gorm.DB {
+	if r.withDeleted {
+		return db
+	}
+	return db.Scopes(notDeleted)
+}
+
 func (r *userRepository) Create(user *model.User) (*model.User, error) {
 	err := r.db.Create(user).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateEmail
+		}
 		return nil, err
 	}
 	return user, nil
@@ -34,7 +128,16 @@ func (r *userRepository) Create(user *model.User) (*model.User, error) {
 
 func (r *userRepository) GetByID(id uint) (*model.User, error) {
 	var user model.User
-	err := r.db.First(&user, id).Error
+	err := r.scoped(r.db).First(&user, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) GetByPublicID(publicID string) (*model.User, error) {
+	var user model.User
+	err := r.scoped(r.db).Where("public_id = ?", publicID).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
@@ -43,32 +146,139 @@ func (r *userRepository) GetByID(id uint) (*model.User, error) {
 
 func (r *userRepository) GetByEmail(email string) (*model.User, error) {
 	var user model.User
-	err := r.db.Where("email = ?", email).First(&user).Error
+	err := r.scoped(r.db).Where("email = ?", email).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
+// FindByEmail returns the user with the email address, read from a replica when there is one
 func (r *userRepository) FindByEmail(email string) (*model.User, error) {
 	var user model.User
-	err := r.db.Where("email = ? AND is_deleted = ?", email, false).First(&user).Error
+	err := findOnReplica(r.db, func(db *gorm.DB) error {
+		return r.scoped(db).Where("email = ?", email).First(&user).Error
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
+// Update saves all fields of user and increments its version. It returns ErrStaleVersion, saving
+// nothing, when the stored user no longer has the version of user because it was changed since,
+// and ErrDuplicateEmail when another active user has its email address.
 func (r *userRepository) Update(user *model.User) error {
-	return r.db.Save(user).Error
+	version := user.Version
+	user.Version++
+	result := r.db.Model(user).Where("version = ?", version).Select("*").Updates(user)
+	if result.Error != nil {
+		user.Version = version
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return ErrDuplicateEmail
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		user.Version = version
+		return ErrStaleVersion
+	}
+	return nil
+}
+
+// SoftDelete marks the active user with the ID as deleted. Its email address becomes free for a
+// new signup.
+func (r *userRepository) SoftDelete(id uint) error {
+	now := time.Now()
+	result := r.db.Model(&model.User{}).
+		Where("id = ? AND is_deleted = ?", id, false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": now,
+			"updated_at": now,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete user %d: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Restore brings back the soft-deleted user with the ID. It returns ErrDuplicateEmail when an
+// active user has taken the email address since.
+func (r *userRepository) Restore(id uint) error {
+	result := r.db.Model(&model.User{}).
+		Where("id = ? AND is_deleted = ?", id, true).
+		Updates(map[string]interface{}{
+			"is_deleted": false,
+			"deleted_at": nil,
+			"updated_at": time.Now(),
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return ErrDuplicateEmail
+		}
+		return fmt.Errorf("failed to restore user %d: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
-func (r *userRepository) Delete(id uint) error {
-	return r.db.Delete(&model.User{}, id).Error
+// List returns a page of users matching params, read from a replica when there is one
+func (r *userRepository) List(params ListParams) (*pagination.Page[model.User], error) {
+	keyset, err := params.keyset()
+	if err != nil {
+		return nil, err
+	}
+	// Only the orders served by an index are paged by cursor; email addresses may also be missing
+	seekable := keyset.Column == "" || keyset.Column == "created_at"
+	if params.Cursor != "" && !seekable {
+		return nil, ErrCursorSort
+	}
+
+	query := r.scoped(onReplica(r.db)).Model(&model.User{})
+	if params.ProviderType != "" {
+		query = query.Where("provider_type = ?", params.ProviderType)
+	}
+	if params.EmailPrefix != "" {
+		query = query.Where("email LIKE ?", likeEscaper.Replace(strings.ToLower(params.EmailPrefix))+"%")
+	}
+
+	var page *pagination.Page[model.User]
+	if seekable {
+		page, err = pagination.Seek(query, keyset, params.Params, func(user *model.User) string {
+			return user.PublicID
+		})
+	} else {
+		page, err = pagination.Find[model.User](query, keyset.Order(), params.Params)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return page, nil
 }
 
-func (r *userRepository) List() ([]model.User, error) {
+// Search returns the enabled users matching params that have not hidden themselves from the search,
+// ordered by display name and read from a replica when there is one. The prefix matches are served
+// by the idx_users_*_prefix indexes.
+func (r *userRepository) Search(params UserSearchParams) ([]model.User, error) {
+	prefix := likeEscaper.Replace(strings.ToLower(params.Query)) + "%"
+	query := r.scoped(onReplica(r.db)).
+		Where("hide_from_search = ? AND is_disabled = ?", false, false).
+		Where("LOWER(display_name) LIKE ? OR email LIKE ?", prefix, prefix)
+	if params.ExcludeUserID != 0 {
+		query = query.Where("id <> ?", params.ExcludeUserID)
+	}
+
 	var users []model.User
-	err := r.db.Find(&users).Error
-	return users, err
+	if err := query.Order("display_name ASC, id ASC").Limit(params.Limit).Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	return users, nil
 }
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// PurgedDisplayName replaces the display name of purged users
+const PurgedDisplayName = "Deleted user"
+
+// ErrUserAlreadyPurged is returned when the personal data of a user was already erased
+var ErrUserAlreadyPurged = errors.New("user already purged")
+
+// UserPurgeRepository erases the personal data of deleted users
+type UserPurgeRepository struct {
+	db *gorm.DB
+}
+
+// UserPurgeRepositoryInterface defines the interface for user purge repository
+type UserPurgeRepositoryInterface interface {
+	ListDue(deletedBefore time.Time, limit int) ([]model.User, error)
+	Purge(user *model.User, now time.Time) ([]string, error)
+}
+
+// NewUserPurgeRepository creates a new user purge repository
+func NewUserPurgeRepository(db *gorm.DB) UserPurgeRepositoryInterface {
+	return &UserPurgeRepository{
+		db: db,
+	}
+}
+
+// ListDue returns up to limit users that were soft deleted before deletedBefore and still hold
+// personal data, longest deleted first
+func (r *UserPurgeRepository) ListDue(deletedBefore time.Time, limit int) ([]model.User, error) {
+	var users []model.User
+	err := r.db.Where("is_deleted = ? AND purged_at IS NULL AND deleted_at <= ?", true, deletedBefore).
+		Order("deleted_at ASC").
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users due for purge: %w", err)
+	}
+	return users, nil
+}
+
+// Purge irreversibly erases the personal data of user in one transaction, and returns the storage
+// keys of the user's export archives for the caller to delete. The users row is kept, anonymized
+// and soft deleted, so that the audit trail and the records other users own keep pointing at it.
+// Sessions, the profile, linked identities, notifications, data exports and the idempotency keys of
+// the user's requests, whose responses carry their data, are deleted, as are invitations and
+// waitlist entries for the user's email address and queued messages naming it or a pending address.
+// Audit log entries about the user keep their action but lose their IP address, user agent and
+// metadata. It returns
+// ErrUserAlreadyPurged when another purge got there first.
+func (r *UserPurgeRepository) Purge(user *model.User, now time.Time) ([]string, error) {
+	var storageKeys []string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.User{}).
+			Where("id = ? AND purged_at IS NULL", user.ID).
+			Updates(map[string]interface{}{
+				"email":               nil,
+				"pending_email":       nil,
+				"pending_email_nonce": nil,
+				"provider_user_id":    nil,
+				"password_hash":       nil,
+				"avatar_url":          nil,
+				"display_name":        PurgedDisplayName,
+				"hide_from_search":    true,
+				"is_deleted":          true,
+				"deleted_at":          gorm.Expr("COALESCE(deleted_at, ?)", now),
+				"purged_at":           now,
+				"updated_at":          now,
+				"version":             gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrUserAlreadyPurged
+		}
+
+		err := tx.Model(&model.DataExport{}).
+			Where("user_id = ? AND storage_key IS NOT NULL", user.ID).
+			Pluck("storage_key", &storageKeys).Error
+		if err != nil {
+			return err
+		}
+
+		owned := []any{
+			&model.UserSession{}, &model.UserProfile{}, &model.UserIdentity{},
+			&model.Notification{}, &model.DataExport{},
+		}
+		for _, table := range owned {
+			// Unscoped, so that ended sessions are deleted rather than soft deleted again
+			if err := tx.Unscoped().Where("user_id = ?", user.ID).Delete(table).Error; err != nil {
+				return err
+			}
+		}
+		// Idempotency keys are scoped by the public ID of the caller who sent the request
+		scope := likeEscaper.Replace(user.PublicID+" ") + "%"
+		if err := tx.Where("scope LIKE ?", scope).Delete(&model.IdempotencyKey{}).Error; err != nil {
+			return err
+		}
+		// Emails and webhook events carry the addresses they were sent to or are about as JSON strings
+		for _, address := range []*string{user.Email, user.PendingEmail} {
+			if address == nil {
+				continue
+			}
+			quoted, err := json.Marshal(*address)
+			if err != nil {
+				return err
+			}
+			pattern := "%" + likeEscaper.Replace(string(quoted)) + "%"
+			if err := tx.Where("payload LIKE ?", pattern).Delete(&model.OutboxMessage{}).Error; err != nil {
+				return err
+			}
+		}
+
+		auditLogs := tx.Where("actor_id = ? OR target_id = ?", user.PublicID, user.PublicID)
+		invitations := tx.Where("accepted_user_id = ?", user.ID)
+		if user.Email != nil {
+			// Failed logins name the email address they tried rather than a user
+			email, err := json.Marshal(*user.Email)
+			if err != nil {
+				return err
+			}
+			pattern := "%" + likeEscaper.Replace(`"email":`+string(email)) + "%"
+			auditLogs = tx.Where("actor_id = ? OR target_id = ? OR metadata LIKE ?",
+				user.PublicID, user.PublicID, pattern)
+			invitations = tx.Where("accepted_user_id = ? OR email = ?", user.ID, *user.Email)
+
+			if err := tx.Where("email = ?", *user.Email).Delete(&model.WaitlistEntry{}).Error; err != nil {
+				return err
+			}
+		}
+		if err := invitations.Delete(&model.Invitation{}).Error; err != nil {
+			return err
+		}
+
+		return auditLogs.Model(&model.AuditLog{}).Updates(map[string]interface{}{
+			"ip_address": nil,
+			"user_agent": nil,
+			"metadata":   "{}",
+		}).Error
+	})
+	if err != nil {
+		if errors.Is(err, ErrUserAlreadyPurged) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to purge user %d: %w", user.ID, err)
+	}
+	return storageKeys, nil
+}
@@ -0,0 +1,128 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type UserPurgeRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.UserPurgeRepositoryInterface
+}
+
+func (suite *UserPurgeRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewUserPurgeRepository(gormDB)
+}
+
+func (suite *UserPurgeRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *UserPurgeRepositoryTestSuite) TestListDue() {
+	deletedBefore := time.Now().Add(-30 * 24 * time.Hour)
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `users` WHERE is_deleted = ? AND purged_at IS NULL AND deleted_at <= ? "+
+			"ORDER BY deleted_at ASC LIMIT ?")).
+		WithArgs(true, deletedBefore, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "public_id", "is_deleted"}).
+			AddRow(42, "usr_5k2d9x0q7m3hv8c1b4na", true))
+
+	users, err := suite.repo.ListDue(deletedBefore, 10)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), users, 1)
+}
+
+func (suite *UserPurgeRepositoryTestSuite) TestPurge() {
+	now := time.Now()
+	email := "user_1@example.com"
+	user := factory.NewUser().WithID(42).WithEmail(email).
+		WithPendingEmail("new_1@example.com", "3q2-7wBxR8eKc1vZpL0yNg", now.Add(time.Hour)).Build()
+
+	suite.Run("Erases the user's personal data", func() {
+		suite.mock.ExpectBegin()
+		suite.mock.ExpectExec(regexp.QuoteMeta(
+			"UPDATE `users` SET `avatar_url`=?,`deleted_at`=COALESCE(deleted_at, ?),`display_name`=?,"+
+				"`email`=?,`hide_from_search`=?,`is_deleted`=?,`password_hash`=?,`pending_email`=?,"+
+				"`pending_email_nonce`=?,`provider_user_id`=?,"+
+				"`purged_at`=?,`updated_at`=?,`version`=version + 1 WHERE id = ? AND purged_at IS NULL")).
+			WithArgs(nil, now, repository.PurgedDisplayName, nil, true, true, nil, nil, nil, nil, now, now, 42).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		suite.mock.ExpectQuery(regexp.QuoteMeta(
+			"SELECT `storage_key` FROM `data_exports` WHERE user_id = ? AND storage_key IS NOT NULL")).
+			WithArgs(42).
+			WillReturnRows(sqlmock.NewRows([]string{"storage_key"}).
+				AddRow("private/exports/exp_9c4m1x7k2d5v8h0q3b6n.zip"))
+		for _, table := range []string{"user_sessions", "user_profiles", "user_identities", "notifications", "data_exports"} {
+			suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + table + "` WHERE user_id = ?")).
+				WithArgs(42).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_keys` WHERE scope LIKE ?")).
+			WithArgs(`usr\_5k2d9x0q7m3hv8c1b4na %`).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `outbox_messages` WHERE payload LIKE ?")).
+			WithArgs(`%"user\_1@example.com"%`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `outbox_messages` WHERE payload LIKE ?")).
+			WithArgs(`%"new\_1@example.com"%`).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `waitlist_entries` WHERE email = ?")).
+			WithArgs(email).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		suite.mock.ExpectExec(regexp.QuoteMeta(
+			"DELETE FROM `invitations` WHERE accepted_user_id = ? OR email = ?")).
+			WithArgs(42, email).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		suite.mock.ExpectExec(regexp.QuoteMeta(
+			"UPDATE `audit_logs` SET `ip_address`=?,`metadata`=?,`user_agent`=? "+
+				"WHERE actor_id = ? OR target_id = ? OR metadata LIKE ?")).
+			WithArgs(nil, "{}", nil, user.PublicID, user.PublicID, `%"email":"user\_1@example.com"%`).
+			WillReturnResult(sqlmock.NewResult(0, 5))
+		suite.mock.ExpectCommit()
+
+		storageKeys, err := suite.repo.Purge(user, now)
+
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []string{"private/exports/exp_9c4m1x7k2d5v8h0q3b6n.zip"}, storageKeys)
+	})
+
+	suite.Run("Already purged", func() {
+		suite.mock.ExpectBegin()
+		suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		suite.mock.ExpectRollback()
+
+		_, err := suite.repo.Purge(user, now)
+
+		assert.ErrorIs(suite.T(), err, repository.ErrUserAlreadyPurged)
+	})
+}
+
+func TestUserPurgeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPurgeRepositoryTestSuite))
+}
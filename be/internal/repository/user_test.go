@@ -1,12 +1,15 @@
 package repository_test
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
 	"strikepad-backend/internal/repository"
 
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/testutil/factory"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -59,15 +62,11 @@ func (suite *UserRepositoryTestSuite) TestCreate() {
 	}{
 		{
 			name: "successful email user creation",
-			user: &model.User{
-				ProviderType: "email",
-				DisplayName:  "Test User",
-				Email:        func() *string { s := testEmail; return &s }(),
-			},
+			user: factory.NewUser().Unsaved().Build(),
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
 				suite.mock.ExpectExec("INSERT INTO `users`").
-					WithArgs(nil, nil, "test@example.com", nil, "email", "Test User", false, false).
+					WithArgs(nil, nil, nil, nil, nil, nil, "test@example.com", nil, nil, nil, nil, sqlmock.AnyArg(), "email", "Test User", "user", 1, false, false, false, false, false, false, false).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				suite.mock.ExpectCommit()
 			},
@@ -81,16 +80,12 @@ func (suite *UserRepositoryTestSuite) TestCreate() {
 		},
 		{
 			name: "oauth user creation",
-			user: &model.User{
-				ProviderType:   "oauth",
-				ProviderUserID: func() *string { s := "oauth123"; return &s }(),
-				DisplayName:    "OAuth User",
-				Email:          func() *string { s := testOAuthEmail; return &s }(),
-			},
+			user: factory.NewUser().Unsaved().WithProvider("oauth", "oauth123").
+				WithDisplayName("OAuth User").WithEmail(testOAuthEmail).Build(),
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
 				suite.mock.ExpectExec("INSERT INTO `users`").
-					WithArgs(nil, "oauth123", testOAuthEmail, nil, "oauth", "OAuth User", false, false).
+					WithArgs(nil, nil, nil, nil, nil, "oauth123", testOAuthEmail, nil, nil, nil, nil, sqlmock.AnyArg(), "oauth", "OAuth User", "user", 1, false, false, false, false, false, false, false).
 					WillReturnResult(sqlmock.NewResult(2, 1))
 				suite.mock.ExpectCommit()
 			},
@@ -104,16 +99,12 @@ func (suite *UserRepositoryTestSuite) TestCreate() {
 		},
 		{
 			name: "user with password hash",
-			user: &model.User{
-				ProviderType: "email",
-				DisplayName:  "Password User",
-				Email:        func() *string { s := "password@example.com"; return &s }(),
-				PasswordHash: func() *string { s := "hashedpassword"; return &s }(),
-			},
+			user: factory.NewUser().Unsaved().WithDisplayName("Password User").
+				WithEmail("password@example.com").WithPasswordHash("hashedpassword").Build(),
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
 				suite.mock.ExpectExec("INSERT INTO `users`").
-					WithArgs(nil, nil, "password@example.com", "hashedpassword", "email", "Password User", false, false).
+					WithArgs(nil, nil, nil, nil, nil, nil, "password@example.com", nil, nil, "hashedpassword", nil, sqlmock.AnyArg(), "email", "Password User", "user", 1, false, false, false, false, false, false, false).
 					WillReturnResult(sqlmock.NewResult(3, 1))
 				suite.mock.ExpectCommit()
 			},
@@ -146,6 +137,18 @@ func (suite *UserRepositoryTestSuite) TestCreate() {
 	}
 }
 
+func (suite *UserRepositoryTestSuite) TestCreateDuplicateEmail() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec("INSERT INTO `users`").
+		WillReturnError(gorm.ErrDuplicatedKey)
+	suite.mock.ExpectRollback()
+
+	createdUser, err := suite.repo.Create(factory.NewUser().Unsaved().Build())
+
+	assert.ErrorIs(suite.T(), err, repository.ErrDuplicateEmail)
+	assert.Nil(suite.T(), createdUser)
+}
+
 func (suite *UserRepositoryTestSuite) TestGetByID() {
 	// Table-driven test for getting user by ID
 	tests := []struct {
@@ -162,8 +165,8 @@ func (suite *UserRepositoryTestSuite) TestGetByID() {
 			mockSetup: func() {
 				email := testEmail
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\? ORDER BY `users`.`id` LIMIT \\?").
-					WithArgs(1, 1).
+				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\? AND is_deleted = \\? ORDER BY `users`.`id` LIMIT \\?").
+					WithArgs(1, false, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
 						AddRow(1, "email", nil, email, "Test User", nil, false, now, now, false, nil))
 			},
@@ -183,8 +186,8 @@ func (suite *UserRepositoryTestSuite) TestGetByID() {
 				email := testOAuthEmail
 				providerUserID := "oauth123"
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\? ORDER BY `users`.`id` LIMIT \\?").
-					WithArgs(2, 1).
+				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\? AND is_deleted = \\? ORDER BY `users`.`id` LIMIT \\?").
+					WithArgs(2, false, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
 						AddRow(2, "oauth", providerUserID, email, "OAuth User", nil, true, now, now, false, nil))
 			},
@@ -205,8 +208,8 @@ func (suite *UserRepositoryTestSuite) TestGetByID() {
 			mockSetup: func() {
 				email := "verified@example.com"
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\? ORDER BY `users`.`id` LIMIT \\?").
-					WithArgs(3, 1).
+				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE `users`.`id` = \\? AND is_deleted = \\? ORDER BY `users`.`id` LIMIT \\?").
+					WithArgs(3, false, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
 						AddRow(3, "email", nil, email, "Verified User", "passwordhash", true, now, now, false, nil))
 			},
@@ -240,6 +243,25 @@ func (suite *UserRepositoryTestSuite) TestGetByID() {
 	}
 }
 
+func (suite *UserRepositoryTestSuite) TestGetByID_WithDeleted() {
+	deletedAt := time.Now()
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `users` WHERE `users`.`id` = ? AND is_deleted = ? ORDER BY `users`.`id` LIMIT ?")).
+		WithArgs(4, false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`id` = ? ORDER BY `users`.`id` LIMIT ?")).
+		WithArgs(4, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "is_deleted", "deleted_at"}).AddRow(4, true, deletedAt))
+
+	_, err := suite.repo.GetByID(4)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound, "soft-deleted users should be hidden by default")
+
+	found, err := suite.repo.WithDeleted().GetByID(4)
+	suite.Require().NoError(err)
+	assert.True(suite.T(), found.IsDeleted)
+	assert.WithinDuration(suite.T(), deletedAt, *found.DeletedAt, time.Second)
+}
+
 func (suite *UserRepositoryTestSuite) TestGetByEmail() {
 	// Table-driven test for getting user by email
 	tests := []struct {
@@ -255,8 +277,8 @@ func (suite *UserRepositoryTestSuite) TestGetByEmail() {
 			email: testEmail,
 			mockSetup: func() {
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE email = \\? ORDER BY `users`.`id` LIMIT \\?").
-					WithArgs(testEmail, 1).
+				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE email = \\? AND is_deleted = \\? ORDER BY `users`.`id` LIMIT \\?").
+					WithArgs(testEmail, false, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
 						AddRow(1, "email", nil, testEmail, "Test User", nil, false, now, now, false, nil))
 			},
@@ -274,8 +296,8 @@ func (suite *UserRepositoryTestSuite) TestGetByEmail() {
 			email: testOAuthEmail,
 			mockSetup: func() {
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE email = \\? ORDER BY `users`.`id` LIMIT \\?").
-					WithArgs(testOAuthEmail, 1).
+				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE email = \\? AND is_deleted = \\? ORDER BY `users`.`id` LIMIT \\?").
+					WithArgs(testOAuthEmail, false, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
 						AddRow(2, "oauth", "oauth123", testOAuthEmail, "OAuth User", nil, true, now, now, false, nil))
 			},
@@ -294,8 +316,8 @@ func (suite *UserRepositoryTestSuite) TestGetByEmail() {
 			email: "Mixed@Example.Com",
 			mockSetup: func() {
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE email = \\? ORDER BY `users`.`id` LIMIT \\?").
-					WithArgs("Mixed@Example.Com", 1).
+				suite.mock.ExpectQuery("SELECT \\* FROM `users` WHERE email = \\? AND is_deleted = \\? ORDER BY `users`.`id` LIMIT \\?").
+					WithArgs("Mixed@Example.Com", false, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
 						AddRow(3, "email", nil, "Mixed@Example.Com", "Mixed Case User", "hash123", false, now, now, false, nil))
 			},
@@ -330,139 +352,338 @@ func (suite *UserRepositoryTestSuite) TestGetByEmail() {
 }
 
 func (suite *UserRepositoryTestSuite) TestList() {
+	userColumns := []string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash",
+		"email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}
+
 	// Table-driven test for listing users
 	tests := []struct {
 		mockSetup      func()
 		validateResult func([]model.User)
+		expectedErr    error
 		name           string
 		description    string
+		params         repository.ListParams
 		expectedCount  int
+		expectedTotal  int64
 		expectError    bool
+		withDeleted    bool
 	}{
 		{
-			name: "list multiple users",
+			name:   "first page with defaults",
+			params: repository.ListParams{},
 			mockSetup: func() {
-				email1 := "user1@example.com"
-				email2 := "user2@example.com"
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users`").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
-						AddRow(1, "email", nil, email1, "User 1", nil, false, now, now, false, nil).
-						AddRow(2, "email", nil, email2, "User 2", nil, false, now, now, false, nil))
+				suite.mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `users` WHERE is_deleted = ? ORDER BY id ASC LIMIT ?")).
+					WithArgs(false, pagination.DefaultPerPage+1).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow(1, "email", nil, "user1@example.com", "User 1", nil, false, now, now, false, nil).
+						AddRow(2, "email", nil, "user2@example.com", "User 2", nil, false, now, now, false, nil))
 			},
-			expectError:   false,
 			expectedCount: 2,
 			validateResult: func(users []model.User) {
 				assert.Equal(suite.T(), "User 1", users[0].DisplayName)
-				assert.Equal(suite.T(), "User 2", users[1].DisplayName)
-				assert.Equal(suite.T(), "user1@example.com", *users[0].Email)
 				assert.Equal(suite.T(), "user2@example.com", *users[1].Email)
 			},
-			description: "should list multiple users successfully",
+			description: "should list the first page sorted by ID without counting",
 		},
 		{
-			name: "list mixed provider types",
+			name: "filters, sorting, offset and total",
+			params: repository.ListParams{
+				ProviderType: "google",
+				EmailPrefix:  "Jo_",
+				Sort:         "-created_at",
+				Params:       pagination.Params{Page: 3, PerPage: 10, IncludeTotal: true},
+			},
 			mockSetup: func() {
 				now := time.Now()
-				suite.mock.ExpectQuery("SELECT \\* FROM `users`").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}).
-						AddRow(1, "email", nil, "email@example.com", "Email User", "hash123", true, now, now, false, nil).
-						AddRow(2, "oauth", "oauth456", testOAuthEmail, "OAuth User", nil, true, now, now, false, nil).
-						AddRow(3, "email", nil, "another@example.com", "Another User", "hash456", false, now, now, false, nil))
-			},
-			expectError:   false,
-			expectedCount: 3,
+				suite.mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT count(*) FROM `users` WHERE provider_type = ? AND email LIKE ? AND is_deleted = ?")).
+					WithArgs("google", `jo\_%`, false).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(21))
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE provider_type = ? AND email LIKE ? "+
+					"AND is_deleted = ? ORDER BY created_at DESC, id ASC LIMIT ? OFFSET ?")).
+					WithArgs("google", `jo\_%`, false, 11, 20).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow(21, "google", "g-21", "jo_21@example.com", "Jo", nil, true, now, now, false, nil))
+			},
+			expectedCount: 1,
+			expectedTotal: 21,
 			validateResult: func(users []model.User) {
-				assert.Equal(suite.T(), "email", users[0].ProviderType)
-				assert.Equal(suite.T(), "oauth", users[1].ProviderType)
-				assert.Equal(suite.T(), "email", users[2].ProviderType)
-				assert.Equal(suite.T(), "oauth456", *users[1].ProviderUserID)
-				assert.True(suite.T(), users[0].EmailVerified)
-				assert.False(suite.T(), users[2].EmailVerified)
-			},
-			description: "should list users with different provider types",
+				assert.Equal(suite.T(), "google", users[0].ProviderType)
+			},
+			description: "should apply filters, sort order and page offset",
 		},
 		{
-			name: "empty user list",
+			name:        "page size is capped and deleted users included on request",
+			params:      repository.ListParams{Params: pagination.Params{PerPage: 1000}},
+			withDeleted: true,
 			mockSetup: func() {
-				suite.mock.ExpectQuery("SELECT \\* FROM `users`").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "provider_type", "provider_user_id", "email", "display_name", "password_hash", "email_verified", "created_at", "updated_at", "is_deleted", "deleted_at"}))
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` ORDER BY id ASC LIMIT ?")).
+					WithArgs(pagination.MaxPerPage + 1).
+					WillReturnRows(sqlmock.NewRows(userColumns))
 			},
-			expectError:   false,
 			expectedCount: 0,
 			validateResult: func(users []model.User) {
 				assert.Empty(suite.T(), users)
 			},
 			description: "should handle empty user list",
 		},
+		{
+			name: "after a cursor",
+			params: repository.ListParams{
+				ProviderType: "google",
+				Sort:         "-created_at",
+				Params:       pagination.Params{PerPage: 1, Cursor: "usr_5k2d9x0q7m3hv8c1b4na"},
+			},
+			mockSetup: func() {
+				now := time.Now()
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`created_at` FROM `users` WHERE provider_type = ? "+
+					"AND public_id = ? AND is_deleted = ? LIMIT ?")).
+					WithArgs("google", "usr_5k2d9x0q7m3hv8c1b4na", false, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(21, now))
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE provider_type = ? "+
+					"AND (created_at < ? OR (created_at = ? AND id > ?)) AND is_deleted = ? "+
+					"ORDER BY created_at DESC, id ASC LIMIT ?")).
+					WithArgs("google", now, now, 21, false, 2).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow(22, "google", "g-22", "jo_22@example.com", "Jo", nil, true, now, now, false, nil).
+						AddRow(20, "google", "g-20", "jo_20@example.com", "Jo", nil, true, now, now, false, nil))
+			},
+			expectedCount: 1,
+			validateResult: func(users []model.User) {
+				assert.Equal(suite.T(), "jo_22@example.com", *users[0].Email)
+			},
+			description: "should continue after the cursor by creation time",
+		},
+		{
+			name: "cursor with a sort that has no keyset",
+			params: repository.ListParams{
+				Sort:   "display_name",
+				Params: pagination.Params{Cursor: "usr_5k2d9x0q7m3hv8c1b4na"},
+			},
+			mockSetup:   func() {},
+			expectError: true,
+			expectedErr: repository.ErrCursorSort,
+			description: "should reject cursors for orders without a keyset",
+		},
+		{
+			name:        "invalid sort field",
+			params:      repository.ListParams{Sort: "password_hash"},
+			mockSetup:   func() {},
+			expectError: true,
+			expectedErr: repository.ErrInvalidSort,
+			description: "should reject columns that are not sortable",
+		},
+		{
+			name:   "database error",
+			params: repository.ListParams{},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users`")).
+					WillReturnError(assert.AnError)
+			},
+			expectError: true,
+			expectedErr: assert.AnError,
+			description: "should wrap database errors",
+		},
 	}
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
 			tt.mockSetup()
 
-			result, err := suite.repo.List()
+			repo := suite.repo
+			if tt.withDeleted {
+				repo = repo.WithDeleted()
+			}
+			page, err := repo.List(tt.params)
 
 			if tt.expectError {
-				assert.Error(suite.T(), err, tt.description)
+				assert.ErrorIs(suite.T(), err, tt.expectedErr, tt.description)
+				assert.Nil(suite.T(), page)
 			} else {
 				assert.NoError(suite.T(), err, tt.description)
-				assert.Len(suite.T(), result, tt.expectedCount, tt.description)
+				assert.Len(suite.T(), page.Items, tt.expectedCount, tt.description)
+				if tt.params.IncludeTotal {
+					assert.Equal(suite.T(), tt.expectedTotal, *page.Total)
+				} else {
+					assert.Nil(suite.T(), page.Total)
+				}
 				if tt.validateResult != nil {
-					tt.validateResult(result)
+					tt.validateResult(page.Items)
 				}
 			}
 		})
 	}
 }
 
-func (suite *UserRepositoryTestSuite) TestDelete() {
-	// Table-driven test for user deletion
+func (suite *UserRepositoryTestSuite) TestSearch() {
+	userColumns := []string{"id", "public_id", "display_name", "email"}
+	searchQuery := "SELECT * FROM `users` WHERE (hide_from_search = ? AND is_disabled = ?) AND " +
+		"(LOWER(display_name) LIKE ? OR email LIKE ?) AND id <> ? AND is_deleted = ? " +
+		"ORDER BY display_name ASC, id ASC LIMIT ?"
+
+	// Table-driven test for searching users
 	tests := []struct {
+		mockSetup     func()
+		expectedErr   error
+		name          string
+		description   string
+		params        repository.UserSearchParams
+		expectedCount int
+	}{
+		{
+			name:   "matches display name and email prefixes",
+			params: repository.UserSearchParams{Query: "Jo_", ExcludeUserID: 1, Limit: 10},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta(searchQuery)).
+					WithArgs(false, false, `jo\_%`, `jo\_%`, 1, false, 10).
+					WillReturnRows(sqlmock.NewRows(userColumns).
+						AddRow(2, "usr_2", "Jo_Anne", "anne@example.com").
+						AddRow(3, "usr_3", "Someone", "jo_3@example.com"))
+			},
+			expectedCount: 2,
+			description:   "should escape wildcards, lowercase the query and leave out the searching user",
+		},
+		{
+			name:   "database error",
+			params: repository.UserSearchParams{Query: "jo", ExcludeUserID: 1, Limit: 10},
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta(searchQuery)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+			description: "should wrap database errors",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			users, err := suite.repo.Search(tt.params)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.expectedErr, tt.description)
+				assert.Nil(suite.T(), users)
+			} else {
+				assert.NoError(suite.T(), err, tt.description)
+				assert.Len(suite.T(), users, tt.expectedCount, tt.description)
+			}
+		})
+	}
+}
+
+func (suite *UserRepositoryTestSuite) TestSoftDelete() {
+	// Table-driven test for soft deleting users
+	tests := []struct {
+		expectedErr error
 		mockSetup   func()
 		name        string
 		description string
 		userID      uint
-		expectError bool
 	}{
 		{
-			name:   "successful deletion",
+			name:   "successful soft delete",
 			userID: 1,
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
-				suite.mock.ExpectExec("DELETE FROM `users` WHERE `users`.`id` = \\?").
-					WithArgs(1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				suite.mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `users` SET `deleted_at`=?,`is_deleted`=?,`updated_at`=?,`version`=version + 1 WHERE id = ? AND is_deleted = ?")).
+					WithArgs(sqlmock.AnyArg(), true, sqlmock.AnyArg(), 1, false).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 				suite.mock.ExpectCommit()
 			},
-			expectError: false,
-			description: "should delete user successfully",
+			description: "should mark the user as deleted",
 		},
 		{
-			name:   "delete different user ID",
+			name:   "user already deleted or missing",
 			userID: 99,
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
-				suite.mock.ExpectExec("DELETE FROM `users` WHERE `users`.`id` = \\?").
-					WithArgs(99).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `users`")).
+					WithArgs(sqlmock.AnyArg(), true, sqlmock.AnyArg(), 99, false).
+					WillReturnResult(sqlmock.NewResult(0, 0))
 				suite.mock.ExpectCommit()
 			},
-			expectError: false,
-			description: "should delete user with different ID successfully",
+			expectedErr: gorm.ErrRecordNotFound,
+			description: "should report that there is no active user to delete",
 		},
 		{
-			name:   "delete zero ID",
-			userID: 0,
+			name:   "database error",
+			userID: 1,
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
-				suite.mock.ExpectExec("DELETE FROM `users` WHERE `users`.`id` = \\?").
-					WithArgs(0).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `users`")).
+					WillReturnError(assert.AnError)
+				suite.mock.ExpectRollback()
+			},
+			expectedErr: assert.AnError,
+			description: "should wrap database errors",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			err := suite.repo.SoftDelete(tt.userID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.expectedErr, tt.description)
+			} else {
+				assert.NoError(suite.T(), err, tt.description)
+			}
+		})
+	}
+}
+
+func (suite *UserRepositoryTestSuite) TestRestore() {
+	// Table-driven test for restoring soft-deleted users
+	tests := []struct {
+		expectedErr error
+		mockSetup   func()
+		name        string
+		description string
+		userID      uint
+	}{
+		{
+			name:   "successful restore",
+			userID: 1,
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `users` SET `deleted_at`=?,`is_deleted`=?,`updated_at`=?,`version`=version + 1 WHERE id = ? AND is_deleted = ?")).
+					WithArgs(nil, false, sqlmock.AnyArg(), 1, true).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 				suite.mock.ExpectCommit()
 			},
-			expectError: false,
-			description: "should handle zero ID deletion",
+			description: "should bring the user back",
+		},
+		{
+			name:   "user not deleted",
+			userID: 2,
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `users`")).
+					WithArgs(nil, false, sqlmock.AnyArg(), 2, true).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				suite.mock.ExpectCommit()
+			},
+			expectedErr: gorm.ErrRecordNotFound,
+			description: "should report that there is no deleted user to restore",
+		},
+		{
+			name:   "email taken since the deletion",
+			userID: 3,
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `users`")).
+					WillReturnError(gorm.ErrDuplicatedKey)
+				suite.mock.ExpectRollback()
+			},
+			expectedErr: repository.ErrDuplicateEmail,
+			description: "should report the email address conflict",
 		},
 	}
 
@@ -470,10 +691,10 @@ func (suite *UserRepositoryTestSuite) TestDelete() {
 		suite.Run(tt.name, func() {
 			tt.mockSetup()
 
-			err := suite.repo.Delete(tt.userID)
+			err := suite.repo.Restore(tt.userID)
 
-			if tt.expectError {
-				assert.Error(suite.T(), err, tt.description)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.expectedErr, tt.description)
 			} else {
 				assert.NoError(suite.T(), err, tt.description)
 			}
@@ -586,12 +807,7 @@ func (suite *UserRepositoryTestSuite) TestUpdate() {
 	}{
 		{
 			name: "update display name",
-			user: &model.User{
-				ID:           1,
-				ProviderType: "email",
-				DisplayName:  "Updated User",
-				Email:        func() *string { s := testEmail; return &s }(),
-			},
+			user: factory.NewUser().WithDisplayName("Updated User").Build(),
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
 				suite.mock.ExpectExec("UPDATE `users` SET").
@@ -603,13 +819,8 @@ func (suite *UserRepositoryTestSuite) TestUpdate() {
 		},
 		{
 			name: "update email verification status",
-			user: &model.User{
-				ID:            2,
-				ProviderType:  "email",
-				DisplayName:   "Email User",
-				Email:         func() *string { s := "verify@example.com"; return &s }(),
-				EmailVerified: true,
-			},
+			user: factory.NewUser().WithID(2).WithDisplayName("Email User").
+				WithEmail("verify@example.com").Verified().Build(),
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
 				suite.mock.ExpectExec("UPDATE `users` SET").
@@ -621,13 +832,8 @@ func (suite *UserRepositoryTestSuite) TestUpdate() {
 		},
 		{
 			name: "update password hash",
-			user: &model.User{
-				ID:           3,
-				ProviderType: "email",
-				DisplayName:  "Password User",
-				Email:        func() *string { s := "password@example.com"; return &s }(),
-				PasswordHash: func() *string { s := "$2a$10$newhash"; return &s }(),
-			},
+			user: factory.NewUser().WithID(3).WithDisplayName("Password User").
+				WithEmail("password@example.com").WithPasswordHash("$2a$10$newhash").Build(),
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
 				suite.mock.ExpectExec("UPDATE `users` SET").
@@ -639,14 +845,8 @@ func (suite *UserRepositoryTestSuite) TestUpdate() {
 		},
 		{
 			name: "update oauth user",
-			user: &model.User{
-				ID:             4,
-				ProviderType:   "oauth",
-				ProviderUserID: func() *string { s := "oauth456"; return &s }(),
-				DisplayName:    "Updated OAuth User",
-				Email:          func() *string { s := testOAuthEmail; return &s }(),
-				EmailVerified:  true,
-			},
+			user: factory.NewUser().WithID(4).WithProvider("oauth", "oauth456").
+				WithDisplayName("Updated OAuth User").WithEmail(testOAuthEmail).Verified().Build(),
 			mockSetup: func() {
 				suite.mock.ExpectBegin()
 				suite.mock.ExpectExec("UPDATE `users` SET").
@@ -673,6 +873,33 @@ func (suite *UserRepositoryTestSuite) TestUpdate() {
 	}
 }
 
+func (suite *UserRepositoryTestSuite) TestUpdate_Version() {
+	user := factory.NewUser().WithID(5).WithDisplayName("Versioned User").WithVersion(3).Build()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(`UPDATE .users. SET .* WHERE version = \? AND .id. = \?`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+	assert.NoError(suite.T(), suite.repo.Update(user))
+	assert.Equal(suite.T(), uint(4), user.Version)
+
+	// Another request saved the user in the meantime
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(`UPDATE .users. SET .* WHERE version = \? AND .id. = \?`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+	assert.ErrorIs(suite.T(), suite.repo.Update(user), repository.ErrStaleVersion)
+	assert.Equal(suite.T(), uint(4), user.Version)
+
+	// Another active user has the email address
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(`UPDATE .users. SET .* WHERE version = \? AND .id. = \?`).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	suite.mock.ExpectRollback()
+	assert.ErrorIs(suite.T(), suite.repo.Update(user), repository.ErrDuplicateEmail)
+	assert.Equal(suite.T(), uint(4), user.Version)
+}
+
 func (suite *UserRepositoryTestSuite) TestNewUserRepository() {
 	// Test that NewUserRepository creates a repository with the provided DB
 	repo := repository.NewUserRepository(suite.db)
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+
+	"gorm.io/gorm"
+)
+
+// ErrWaitlistEntryNotFound is returned when no waitlist entry matches the lookup
+var ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+
+// WaitlistRepository handles database operations for waitlist entries
+type WaitlistRepository struct {
+	db *gorm.DB
+}
+
+// WaitlistRepositoryInterface defines the interface for waitlist repository
+type WaitlistRepositoryInterface interface {
+	Create(entry *model.WaitlistEntry) error
+	FindByEmail(email string) (*model.WaitlistEntry, error)
+	List(params pagination.Params) (*pagination.Page[model.WaitlistEntry], error)
+	ListPending(limit int) ([]model.WaitlistEntry, error)
+	MarkInvited(entries []model.WaitlistEntry) error
+}
+
+// NewWaitlistRepository creates a new waitlist repository
+func NewWaitlistRepository(db *gorm.DB) WaitlistRepositoryInterface {
+	return &WaitlistRepository{
+		db: db,
+	}
+}
+
+// Create adds an email address to the waitlist
+func (r *WaitlistRepository) Create(entry *model.WaitlistEntry) error {
+	if err := r.db.Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to create waitlist entry: %w", err)
+	}
+	return nil
+}
+
+// FindByEmail finds the waitlist entry for an email address
+func (r *WaitlistRepository) FindByEmail(email string) (*model.WaitlistEntry, error) {
+	var entry model.WaitlistEntry
+	err := r.db.Where("email = ?", email).First(&entry).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWaitlistEntryNotFound
+		}
+		return nil, fmt.Errorf("failed to find waitlist entry: %w", err)
+	}
+
+	return &entry, nil
+}
+
+// List returns a page of waitlist entries in signup order
+func (r *WaitlistRepository) List(params pagination.Params) (*pagination.Page[model.WaitlistEntry], error) {
+	page, err := pagination.Find[model.WaitlistEntry](r.db.Model(&model.WaitlistEntry{}), "id ASC", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist entries: %w", err)
+	}
+	return page, nil
+}
+
+// ListPending returns the oldest entries that have not been invited yet
+func (r *WaitlistRepository) ListPending(limit int) ([]model.WaitlistEntry, error) {
+	var entries []model.WaitlistEntry
+	err := r.db.Where("invited_at IS NULL").Order("id ASC").Limit(limit).Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending waitlist entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// MarkInvited stores the invite code and invitation time of each entry in a single transaction
+func (r *WaitlistRepository) MarkInvited(entries []model.WaitlistEntry) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, entry := range entries {
+			result := tx.Model(&model.WaitlistEntry{}).
+				Where("id = ? AND invited_at IS NULL", entry.ID).
+				Updates(map[string]interface{}{
+					"invite_code": entry.InviteCode,
+					"invited_at":  entry.InvitedAt,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("waitlist entry %d was already invited", entry.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark waitlist entries invited: %w", err)
+	}
+	return nil
+}
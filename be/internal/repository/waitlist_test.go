@@ -0,0 +1,202 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type WaitlistRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.WaitlistRepositoryInterface
+}
+
+func (suite *WaitlistRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewWaitlistRepository(gormDB)
+}
+
+func (suite *WaitlistRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *WaitlistRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `waitlist_entries`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	entry := &model.WaitlistEntry{Email: "user@example.com"}
+	err := suite.repo.Create(entry)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), entry.ID)
+}
+
+func (suite *WaitlistRepositoryTestSuite) TestFindByEmail() {
+	testCases := []struct {
+		mockSetup   func()
+		expectedErr error
+		name        string
+		email       string
+		expectError bool
+	}{
+		{
+			name:  "Success",
+			email: "user@example.com",
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "email", "created_at", "updated_at"}).
+					AddRow(1, "user@example.com", time.Now(), time.Now())
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `waitlist_entries`")).
+					WithArgs("user@example.com", sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:  "Not found",
+			email: "missing@example.com",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `waitlist_entries`")).
+					WithArgs("missing@example.com", sqlmock.AnyArg()).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectError: true,
+			expectedErr: repository.ErrWaitlistEntryNotFound,
+		},
+		{
+			name:  "Database error",
+			email: "user@example.com",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `waitlist_entries`")).
+					WithArgs("user@example.com", sqlmock.AnyArg()).
+					WillReturnError(assert.AnError)
+			},
+			expectError: true,
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			entry, err := suite.repo.FindByEmail(tc.email)
+
+			if tc.expectError {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, entry)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.email, entry.Email)
+			}
+		})
+	}
+}
+
+func (suite *WaitlistRepositoryTestSuite) TestList() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `waitlist_entries`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `waitlist_entries` ORDER BY id ASC LIMIT ? OFFSET ?")).
+		WithArgs(3, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).
+			AddRow(3, "c@example.com"))
+
+	page, err := suite.repo.List(pagination.Params{Page: 2, PerPage: 2, IncludeTotal: true})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), *page.Total)
+	assert.Len(suite.T(), page.Items, 1)
+	assert.False(suite.T(), page.HasMore)
+}
+
+func (suite *WaitlistRepositoryTestSuite) TestListPending() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `waitlist_entries` WHERE invited_at IS NULL ORDER BY id ASC LIMIT ?")).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(1, "a@example.com"))
+
+	entries, err := suite.repo.ListPending(10)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), entries, 1)
+}
+
+func (suite *WaitlistRepositoryTestSuite) TestMarkInvited() {
+	now := time.Now()
+	codeA, codeB := "CODEA", "CODEB"
+	entries := []model.WaitlistEntry{
+		{ID: 1, InviteCode: &codeA, InvitedAt: &now},
+		{ID: 2, InviteCode: &codeB, InvitedAt: &now},
+	}
+
+	testCases := []struct {
+		mockSetup   func()
+		name        string
+		expectError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `waitlist_entries`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `waitlist_entries`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				suite.mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Entry already invited rolls back",
+			mockSetup: func() {
+				suite.mock.ExpectBegin()
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `waitlist_entries`")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `waitlist_entries`")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				suite.mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			err := suite.repo.MarkInvited(entries)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWaitlistRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WaitlistRepositoryTestSuite))
+}
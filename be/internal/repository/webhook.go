@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// ErrWebhookNotFound is returned when no webhook endpoint matches the lookup
+var ErrWebhookNotFound = errors.New("webhook endpoint not found")
+
+// WebhookRepository handles database operations for webhook endpoints
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+// WebhookRepositoryInterface defines the interface for webhook repository
+type WebhookRepositoryInterface interface {
+	Create(endpoint *model.WebhookEndpoint) error
+	GetByID(id uint) (*model.WebhookEndpoint, error)
+	List() ([]model.WebhookEndpoint, error)
+	Delete(publicID string) error
+}
+
+// NewWebhookRepository creates a new webhook repository
+func NewWebhookRepository(db *gorm.DB) WebhookRepositoryInterface {
+	return &WebhookRepository{
+		db: db,
+	}
+}
+
+// Create stores a new webhook endpoint
+func (r *WebhookRepository) Create(endpoint *model.WebhookEndpoint) error {
+	if err := r.db.Create(endpoint).Error; err != nil {
+		return fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// GetByID finds a webhook endpoint by its database ID
+func (r *WebhookRepository) GetByID(id uint) (*model.WebhookEndpoint, error) {
+	var endpoint model.WebhookEndpoint
+	err := r.db.First(&endpoint, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook endpoint: %w", err)
+	}
+
+	return &endpoint, nil
+}
+
+// List returns all webhook endpoints, oldest first
+func (r *WebhookRepository) List() ([]model.WebhookEndpoint, error) {
+	var endpoints []model.WebhookEndpoint
+	if err := r.db.Order("id ASC").Find(&endpoints).Error; err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+// Delete removes the webhook endpoint with the public ID. Deliveries already queued for it
+// are dropped by the outbox dispatcher.
+func (r *WebhookRepository) Delete(publicID string) error {
+	result := r.db.Where("public_id = ?", publicID).Delete(&model.WebhookEndpoint{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type WebhookRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo repository.WebhookRepositoryInterface
+}
+
+func (suite *WebhookRepositoryTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.db = gormDB
+	suite.mock = mock
+	suite.repo = repository.NewWebhookRepository(gormDB)
+}
+
+func (suite *WebhookRepositoryTestSuite) TearDownTest() {
+	err := suite.mock.ExpectationsWereMet()
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *WebhookRepositoryTestSuite) TestCreate() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `webhook_endpoints`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	endpoint := &model.WebhookEndpoint{
+		URL:             "https://hooks.example.com/strikepad",
+		Secret:          "whsec_test",
+		EventTypes:      "user.created",
+		CreatedByUserID: 1,
+	}
+	err := suite.repo.Create(endpoint)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), endpoint.ID)
+	assert.NoError(suite.T(), publicid.Validate(publicid.PrefixWebhook, endpoint.PublicID))
+}
+
+func (suite *WebhookRepositoryTestSuite) TestGetByID() {
+	testCases := []struct {
+		mockSetup   func()
+		expectedErr error
+		name        string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				rows := sqlmock.NewRows([]string{"id", "public_id", "url", "secret", "event_types"}).
+					AddRow(3, "whk_7d2k9m4q0x8h3v1c5b6n", "https://hooks.example.com/strikepad", "whsec_test", "*")
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `webhook_endpoints` WHERE `webhook_endpoints`.`id` = ?")).
+					WithArgs(3, sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "Not found",
+			mockSetup: func() {
+				suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `webhook_endpoints` WHERE `webhook_endpoints`.`id` = ?")).
+					WithArgs(3, sqlmock.AnyArg()).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			expectedErr: repository.ErrWebhookNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			endpoint, err := suite.repo.GetByID(3)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, endpoint)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "whk_7d2k9m4q0x8h3v1c5b6n", endpoint.PublicID)
+				assert.True(t, endpoint.Subscribes("session.revoked"))
+			}
+		})
+	}
+}
+
+func (suite *WebhookRepositoryTestSuite) TestList() {
+	rows := sqlmock.NewRows([]string{"id", "public_id", "url", "event_types"}).
+		AddRow(1, "whk_7d2k9m4q0x8h3v1c5b6n", "https://hooks.example.com/a", "user.created,user.deleted").
+		AddRow(2, "whk_0000000000000000000b", "https://hooks.example.com/b", "*")
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `webhook_endpoints` ORDER BY id ASC")).
+		WillReturnRows(rows)
+
+	endpoints, err := suite.repo.List()
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), endpoints, 2)
+	assert.Equal(suite.T(), []string{"user.created", "user.deleted"}, endpoints[0].EventTypeList())
+	assert.False(suite.T(), endpoints[0].Subscribes("session.revoked"))
+}
+
+func (suite *WebhookRepositoryTestSuite) TestDelete() {
+	testCases := []struct {
+		expectedErr  error
+		name         string
+		rowsAffected int64
+	}{
+		{
+			name:         "Success",
+			rowsAffected: 1,
+		},
+		{
+			name:         "Not found",
+			rowsAffected: 0,
+			expectedErr:  repository.ErrWebhookNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			suite.mock.ExpectBegin()
+			suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `webhook_endpoints` WHERE public_id = ?")).
+				WithArgs("whk_7d2k9m4q0x8h3v1c5b6n").
+				WillReturnResult(sqlmock.NewResult(0, tc.rowsAffected))
+			suite.mock.ExpectCommit()
+
+			err := suite.repo.Delete("whk_7d2k9m4q0x8h3v1c5b6n")
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookRepositoryTestSuite))
+}
@@ -0,0 +1,184 @@
+// Package router registers the routes that handlers declare. Each handler lists its routes with
+// the access they require, and the registrar applies the middleware of that access level, so
+// that adding a handler only takes providing it to the container.
+package router
+
+import (
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/featureflags"
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+)
+
+// RoutesGroup is the container value group that route providers are added to
+const RoutesGroup = "routes"
+
+// Access is the authentication a route requires
+type Access int
+
+const (
+	// Public routes need no authentication
+	Public Access = iota
+	// User routes need a user session
+	User
+	// Admin routes need a session of a user with the admin role
+	Admin
+	// AdminOrAPIKey routes need a session of an admin user, or an API key
+	AdminOrAPIKey
+	// AdminToken routes need the admin API token from ADMIN_API_TOKEN
+	AdminToken
+)
+
+// Quota policies of the routes that do not name one. Policies are configured in QUOTA_POLICIES.
+const (
+	// QuotaUser is the policy of User routes
+	QuotaUser = "user"
+	// QuotaAdmin is the policy of Admin and AdminOrAPIKey routes
+	QuotaAdmin = "admin"
+	// NoQuota exempts a route from quotas
+	NoQuota = "-"
+
+	defaultQuotaPolicies = QuotaUser + "=1000/1h," + QuotaAdmin + "=5000/1h"
+)
+
+// Route is an endpoint of the API
+type Route struct {
+	Handler echo.HandlerFunc
+	Method  string
+	// Path uses Echo syntax
+	Path string
+	// Middleware runs after the authentication of Access, e.g. rate limits or
+	// middleware.RequireFeature
+	Middleware []echo.MiddlewareFunc
+	Access     Access
+	// Quota names the quota policy the route counts against, shared by the routes naming it.
+	// Empty uses the policy of Access: QuotaUser, QuotaAdmin or none for public routes.
+	Quota string
+	// Idempotent makes POST requests with an Idempotency-Key header safe to retry
+	Idempotent bool
+}
+
+// RouteProvider is implemented by handlers, which declare their routes
+type RouteProvider interface {
+	Routes() []Route
+}
+
+// RegistrarParams are the dependencies of the registrar, with the route providers of the container
+type RegistrarParams struct {
+	dig.In
+
+	SessionService     service.SessionServiceInterface
+	APIKeyService      service.APIKeyServiceInterface
+	AuditService       service.AuditServiceInterface
+	IdempotencyService service.IdempotencyServiceInterface
+	FeatureFlags       featureflags.Flags
+	// Cache counts the requests of quotas; nil counts them in memory, per instance
+	Cache     cache.Cache
+	Providers []RouteProvider `group:"routes"`
+}
+
+// Registrar registers the routes of all route providers with the middleware of their access level
+type Registrar struct {
+	access       map[Access][]echo.MiddlewareFunc
+	quotas       map[string]echo.MiddlewareFunc
+	featureFlags echo.MiddlewareFunc
+	idempotent   echo.MiddlewareFunc
+	audit        echo.MiddlewareFunc
+	providers    []RouteProvider
+}
+
+// NewRegistrar creates a registrar for the route providers in params. It panics if QUOTA_POLICIES
+// cannot be parsed.
+func NewRegistrar(params RegistrarParams) *Registrar {
+	jwt := middleware.JWTMiddleware(params.SessionService)
+	apiKey := middleware.APIKeyMiddleware(params.APIKeyService, params.SessionService)
+
+	return &Registrar{
+		access: map[Access][]echo.MiddlewareFunc{
+			Public:        nil,
+			User:          {jwt},
+			Admin:         {jwt, middleware.AdminMiddleware()},
+			AdminOrAPIKey: {apiKey, middleware.AdminMiddleware()},
+			AdminToken:    {middleware.RequireAdminToken(config.GetEnv("ADMIN_API_TOKEN", ""))},
+		},
+		quotas:       newQuotas(params.Cache),
+		featureFlags: middleware.FeatureFlags(params.FeatureFlags),
+		idempotent:   middleware.Idempotency(params.IdempotencyService),
+		audit:        middleware.AuditAdminActions(params.AuditService),
+		providers:    params.Providers,
+	}
+}
+
+// Register adds the routes of all providers to e
+func (r *Registrar) Register(e *echo.Echo) {
+	for _, provider := range r.providers {
+		for _, route := range provider.Routes() {
+			r.add(e, route)
+		}
+	}
+}
+
+// add adds route to e. Its middleware runs in order: the feature flags, authentication, the quota
+// (after authentication, as quotas are counted per caller), the route's own middleware, idempotency
+// (after authentication, as keys are scoped per caller), and for admin routes the audit log (after
+// idempotency, so that replayed responses are not recorded twice).
+func (r *Registrar) add(e *echo.Echo, route Route) {
+	middlewares := append([]echo.MiddlewareFunc{r.featureFlags}, r.access[route.Access]...)
+	if quota, ok := r.quotas[route.quotaPolicy()]; ok {
+		middlewares = append(middlewares, quota)
+	}
+	middlewares = append(middlewares, route.Middleware...)
+	if route.Idempotent {
+		middlewares = append(middlewares, r.idempotent)
+	}
+	if route.Access.isAdmin() {
+		// Changes made through the admin API are recorded in the audit log
+		middlewares = append(middlewares, r.audit)
+	}
+	e.Add(route.Method, route.Path, route.Handler, middlewares...)
+}
+
+// isAdmin reports whether routes with access a belong to the admin API
+func (a Access) isAdmin() bool {
+	return a == Admin || a == AdminOrAPIKey || a == AdminToken
+}
+
+// quotaPolicy returns the name of the quota policy of the route, or "" when it has none
+func (route Route) quotaPolicy() string {
+	switch {
+	case route.Quota == NoQuota:
+		return ""
+	case route.Quota != "":
+		return route.Quota
+	case route.Access == User:
+		return QuotaUser
+	case route.Access == Admin || route.Access == AdminOrAPIKey:
+		return QuotaAdmin
+	default:
+		return ""
+	}
+}
+
+// newQuotas returns the quota middleware of each policy in QUOTA_POLICIES, counting in c. A policy
+// left out is not enforced, and QUOTA_ENABLED=false turns all of them off.
+func newQuotas(c cache.Cache) map[string]echo.MiddlewareFunc {
+	quotas := make(map[string]echo.MiddlewareFunc)
+	if !config.GetEnvBool("QUOTA_ENABLED", true) {
+		return quotas
+	}
+	policies, err := middleware.ParseQuotaPolicies(config.GetEnv("QUOTA_POLICIES", defaultQuotaPolicies))
+	if err != nil {
+		panic("Invalid QUOTA_POLICIES: " + err.Error())
+	}
+	if c == nil {
+		c = cache.NewMemoryCache()
+	}
+	for name, policy := range policies {
+		quotas[name] = middleware.Quota(policy, c)
+	}
+	return quotas
+}
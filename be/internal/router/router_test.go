@@ -0,0 +1,156 @@
+package router_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/middleware"
+	"strikepad-backend/internal/router"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/service/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// widgetHandler declares one route of each kind
+type widgetHandler struct{}
+
+func (h *widgetHandler) Routes() []router.Route {
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/widgets", Handler: ok},
+		{Method: http.MethodPost, Path: "/api/widgets", Handler: ok, Idempotent: true},
+		{Method: http.MethodGet, Path: "/api/widgets/mine", Handler: ok, Access: router.User},
+		{Method: http.MethodPost, Path: "/api/admin/widgets", Handler: ok, Access: router.AdminToken},
+	}
+}
+
+func TestRegistrar_Register(t *testing.T) {
+	t.Setenv("ADMIN_API_TOKEN", "admin-secret")
+
+	tests := []struct {
+		setup          func(audit *mocks.MockAuditServiceInterface, idempotency *mocks.MockIdempotencyServiceInterface)
+		headers        map[string]string
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "public route",
+			method:         http.MethodGet,
+			path:           "/api/widgets",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "user route without a session",
+			method:         http.MethodGet,
+			path:           "/api/widgets/mine",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:    "idempotent route",
+			method:  http.MethodPost,
+			path:    "/api/widgets",
+			headers: map[string]string{middleware.IdempotencyKeyHeader: "retry-1"},
+			setup: func(_ *mocks.MockAuditServiceInterface, idempotency *mocks.MockIdempotencyServiceInterface) {
+				idempotency.EXPECT().Begin("anonymous POST /api/widgets", "retry-1", mock.Anything).
+					Return(nil, service.ErrIdempotencyKeyInProgress).Once()
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:    "admin route is audited",
+			method:  http.MethodPost,
+			path:    "/api/admin/widgets",
+			headers: map[string]string{middleware.AdminTokenHeader: "admin-secret"},
+			setup: func(audit *mocks.MockAuditServiceInterface, _ *mocks.MockIdempotencyServiceInterface) {
+				audit.On("Record", mock.MatchedBy(func(entry service.AuditEntry) bool {
+					return entry.Metadata["route"] == "/api/admin/widgets"
+				})).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "admin route with a wrong token",
+			method:         http.MethodPost,
+			path:           "/api/admin/widgets",
+			headers:        map[string]string{middleware.AdminTokenHeader: "guess"},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditService := mocks.NewMockAuditServiceInterface(t)
+			idempotencyService := mocks.NewMockIdempotencyServiceInterface(t)
+			if tt.setup != nil {
+				tt.setup(auditService, idempotencyService)
+			}
+
+			e := echo.New()
+			router.NewRegistrar(router.RegistrarParams{
+				SessionService:     new(mocks.MockSessionServiceInterface),
+				APIKeyService:      mocks.NewMockAPIKeyServiceInterface(t),
+				AuditService:       auditService,
+				IdempotencyService: idempotencyService,
+				Providers:          []router.RouteProvider{&widgetHandler{}},
+			}).Register(e)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader("{}"))
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+// searchHandler declares public routes with and without a quota
+type searchHandler struct{}
+
+func (h *searchHandler) Routes() []router.Route {
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	return []router.Route{
+		{Method: http.MethodGet, Path: "/api/search", Handler: ok, Quota: "search"},
+		{Method: http.MethodGet, Path: "/api/search/suggestions", Handler: ok, Quota: router.NoQuota},
+	}
+}
+
+func TestRegistrar_Quota(t *testing.T) {
+	t.Setenv("QUOTA_POLICIES", "search=1/1h")
+
+	e := echo.New()
+	router.NewRegistrar(router.RegistrarParams{
+		SessionService:     new(mocks.MockSessionServiceInterface),
+		APIKeyService:      mocks.NewMockAPIKeyServiceInterface(t),
+		AuditService:       mocks.NewMockAuditServiceInterface(t),
+		IdempotencyService: mocks.NewMockIdempotencyServiceInterface(t),
+		Providers:          []router.RouteProvider{&searchHandler{}},
+	}).Register(e)
+
+	request := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+		return rec
+	}
+
+	rec := request("/api/search")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get(middleware.HeaderRateLimitLimit))
+	assert.Equal(t, http.StatusTooManyRequests, request("/api/search").Code)
+
+	rec = request("/api/search/suggestions")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(middleware.HeaderRateLimitLimit))
+
+	t.Setenv("QUOTA_POLICIES", "search=1/soon")
+	assert.Panics(t, func() { router.NewRegistrar(router.RegistrarParams{}) })
+}
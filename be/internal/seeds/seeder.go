@@ -0,0 +1,178 @@
+// Package seeds inserts deterministic data for local development and e2e tests. Each environment
+// has its own seed set; seeding upserts rows by their fixed public IDs, so it can run again and
+// again, and restores seeded rows that were changed or deleted in the meantime.
+package seeds
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SeededSession is a seeded session and the tokens issued for it
+type SeededSession struct {
+	AccessTokenExpiresAt time.Time
+	PublicID             string
+	UserEmail            string
+	AccessToken          string
+	RefreshToken         string
+}
+
+// Result describes the seeded rows
+type Result struct {
+	Sessions []SeededSession
+	Users    int
+}
+
+// Seeder inserts the seed set of an environment
+type Seeder struct {
+	db             *gorm.DB
+	passwordHasher *auth.PasswordHasher
+	jwtService     *auth.JWTService
+}
+
+// NewSeeder creates a new seeder
+func NewSeeder(db *gorm.DB, passwordHasher *auth.PasswordHasher, jwtService *auth.JWTService) *Seeder {
+	return &Seeder{db: db, passwordHasher: passwordHasher, jwtService: jwtService}
+}
+
+// Run upserts the seed set of env in a transaction. Sessions get new tokens on every run, which
+// are returned so that e2e tests can authenticate without logging in.
+func (s *Seeder) Run(ctx context.Context, env string) (*Result, error) {
+	set, ok := SetFor(env)
+	if !ok {
+		return nil, fmt.Errorf("no seed set for environment %q", env)
+	}
+
+	// Hash once: every seeded user shares the password
+	passwordHash, err := s.passwordHasher.Hash(Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the seed password: %w", err)
+	}
+
+	result := &Result{}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		users := make(map[string]*model.User, len(set.Users))
+		for _, seed := range set.Users {
+			user, err := upsertUser(tx, seed, passwordHash)
+			if err != nil {
+				return err
+			}
+			users[seed.PublicID] = user
+		}
+		result.Users = len(users)
+
+		for _, seed := range set.Sessions {
+			user, ok := users[seed.UserPublicID]
+			if !ok {
+				return fmt.Errorf("seed session %s belongs to unknown user %s", seed.PublicID, seed.UserPublicID)
+			}
+			session, tokenPair, err := s.upsertSession(tx, seed, user)
+			if err != nil {
+				return err
+			}
+			result.Sessions = append(result.Sessions, SeededSession{
+				PublicID:             session.PublicID,
+				UserEmail:            *user.Email,
+				AccessToken:          tokenPair.AccessToken,
+				RefreshToken:         tokenPair.RefreshToken,
+				AccessTokenExpiresAt: session.AccessTokenExpiresAt,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("Seed data inserted", "environment", env, "users", result.Users, "sessions", len(result.Sessions))
+	return result, nil
+}
+
+// upsertUser inserts the seeded user, or resets the row with its public ID to the seed
+func upsertUser(tx *gorm.DB, seed User, passwordHash string) (*model.User, error) {
+	now := time.Now()
+	email := seed.Email
+	user := &model.User{
+		PublicID:          seed.PublicID,
+		Email:             &email,
+		PasswordHash:      &passwordHash,
+		PasswordChangedAt: &now,
+		ProviderType:      "email",
+		DisplayName:       seed.DisplayName,
+		Role:              seed.Role,
+		EmailVerified:     seed.EmailVerified,
+		IsDisabled:        seed.IsDisabled,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "public_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"email", "password_hash", "password_changed_at", "provider_type", "display_name", "role",
+			"email_verified", "is_disabled", "is_deleted", "deleted_at", "updated_at",
+		}),
+	}).Create(user).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to seed user %s: %w", seed.Email, err)
+	}
+	return user, nil
+}
+
+// upsertSession issues tokens for the seeded session of user, and inserts it or resets the row
+// with its public ID to the seed
+func (s *Seeder) upsertSession(
+	tx *gorm.DB, seed Session, user *model.User,
+) (*model.UserSession, *auth.TokenPair, error) {
+	now := time.Now()
+
+	var sessionClaims *auth.SessionClaims
+	if s.jwtService.Stateless() {
+		sessionClaims = &auth.SessionClaims{
+			ID:            seed.PublicID,
+			UserPublicID:  user.PublicID,
+			Role:          user.Role,
+			AuthTime:      now.Unix(),
+			EmailVerified: user.EmailVerified,
+		}
+	}
+	tokenPair, err := s.jwtService.GenerateSessionTokenPair(user.ID, sessionClaims)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate tokens for seed session %s: %w", seed.PublicID, err)
+	}
+
+	deviceName := seed.DeviceName
+	session := &model.UserSession{
+		PublicID:              seed.PublicID,
+		UserID:                user.ID,
+		AccessTokenHash:       auth.HashToken(tokenPair.AccessToken),
+		RefreshTokenHash:      auth.HashToken(tokenPair.RefreshToken),
+		AccessTokenExpiresAt:  tokenPair.AccessTokenExpiresAt,
+		RefreshTokenExpiresAt: tokenPair.RefreshTokenExpiresAt,
+		IPAddress:             seed.IPAddress,
+		UserAgent:             seed.UserAgent,
+		DeviceName:            &deviceName,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+
+	err = tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "public_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"user_id", "access_token_hash", "refresh_token_hash", "access_token_expires_at", "refresh_token_expires_at",
+			"ip_address", "user_agent", "device_name", "is_deleted", "deleted_at", "updated_at",
+		}),
+	}).Create(session).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to seed session %s: %w", seed.PublicID, err)
+	}
+	return session, tokenPair, nil
+}
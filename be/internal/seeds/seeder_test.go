@@ -0,0 +1,101 @@
+package seeds
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/publicid"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestSets(t *testing.T) {
+	_, ok := SetFor("production")
+	assert.False(t, ok, "production must not have a seed set")
+
+	for _, env := range []string{"dev", "test"} {
+		t.Run(env, func(t *testing.T) {
+			set, ok := SetFor(env)
+			require.True(t, ok)
+
+			users := make(map[string]bool)
+			emails := make(map[string]bool)
+			for _, user := range set.Users {
+				assert.NoError(t, publicid.Validate(publicid.PrefixUser, user.PublicID))
+				assert.False(t, users[user.PublicID], "duplicate user %s", user.PublicID)
+				assert.False(t, emails[user.Email], "duplicate email %s", user.Email)
+				users[user.PublicID] = true
+				emails[user.Email] = true
+			}
+
+			sessions := make(map[string]bool)
+			for _, session := range set.Sessions {
+				assert.NoError(t, publicid.Validate(publicid.PrefixSession, session.PublicID))
+				assert.False(t, sessions[session.PublicID], "duplicate session %s", session.PublicID)
+				assert.True(t, users[session.UserPublicID], "session %s of unknown user", session.PublicID)
+				sessions[session.PublicID] = true
+			}
+		})
+	}
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	require.NoError(t, err)
+	return gormDB, mock
+}
+
+func TestSeeder_Run(t *testing.T) {
+	t.Setenv("PASSWORD_BCRYPT_COST", "4")
+	db, mock := newMockDB(t)
+	set, _ := SetFor("test")
+
+	mock.ExpectBegin()
+	for i := range set.Users {
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`) + `.*` +
+			regexp.QuoteMeta(`ON CONFLICT ("public_id") DO UPDATE SET`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(i + 1))
+	}
+	for i := range set.Sessions {
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "user_sessions"`) + `.*` +
+			regexp.QuoteMeta(`ON CONFLICT ("public_id") DO UPDATE SET`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(i + 1))
+	}
+	mock.ExpectCommit()
+
+	jwtService := auth.NewJWTService(clock.New())
+	result, err := NewSeeder(db, auth.NewPasswordHasher(), jwtService).Run(context.Background(), "test")
+	require.NoError(t, err)
+
+	assert.Equal(t, len(set.Users), result.Users)
+	require.Len(t, result.Sessions, len(set.Sessions))
+	assert.Equal(t, seedID(publicid.PrefixSession, 1), result.Sessions[0].PublicID)
+	assert.Equal(t, "admin@example.com", result.Sessions[0].UserEmail)
+
+	claims, err := jwtService.ValidateAccessToken(result.Sessions[0].AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), claims.UserID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSeeder_RunUnknownEnvironment(t *testing.T) {
+	db, mock := newMockDB(t)
+
+	seeder := NewSeeder(db, auth.NewPasswordHasher(), auth.NewJWTService(clock.New()))
+	_, err := seeder.Run(context.Background(), "production")
+	assert.EqualError(t, err, `no seed set for environment "production"`)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
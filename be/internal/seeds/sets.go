@@ -0,0 +1,118 @@
+package seeds
+
+import (
+	"fmt"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+)
+
+// Password is the password of every seeded user. Seed data never runs in production, so it is
+// public on purpose: developers and e2e tests log in with it.
+const Password = "Password123!"
+
+// User is a user inserted by a seed set
+type User struct {
+	PublicID      string
+	Email         string
+	DisplayName   string
+	Role          string
+	EmailVerified bool
+	IsDisabled    bool
+}
+
+// Session is a session inserted by a seed set, for the user of UserPublicID
+type Session struct {
+	PublicID     string
+	UserPublicID string
+	DeviceName   string
+	UserAgent    string
+	IPAddress    string
+}
+
+// Set is the seed data of an environment
+type Set struct {
+	Users    []User
+	Sessions []Session
+}
+
+// seedID returns the public ID of the nth seeded resource with the prefix. Seeded IDs are fixed,
+// so that seeding again updates the same rows and tests can refer to them.
+func seedID(prefix string, n int) string {
+	return fmt.Sprintf("%s_seed%016d", prefix, n)
+}
+
+// Public IDs of the seeded users
+var (
+	AdminUserID      = seedID(publicid.PrefixUser, 1)
+	VerifiedUserID   = seedID(publicid.PrefixUser, 2)
+	UnverifiedUserID = seedID(publicid.PrefixUser, 3)
+	DisabledUserID   = seedID(publicid.PrefixUser, 4)
+)
+
+// baseUsers are seeded in every environment: one user per state the API treats differently
+var baseUsers = []User{
+	{PublicID: AdminUserID, Email: "admin@example.com", DisplayName: "Admin", Role: model.RoleAdmin, EmailVerified: true},
+	{
+		PublicID: VerifiedUserID, Email: "alice@example.com", DisplayName: "Alice", Role: model.RoleUser,
+		EmailVerified: true,
+	},
+	{PublicID: UnverifiedUserID, Email: "bob@example.com", DisplayName: "Bob", Role: model.RoleUser},
+	{
+		PublicID: DisabledUserID, Email: "carol@example.com", DisplayName: "Carol", Role: model.RoleUser,
+		EmailVerified: true, IsDisabled: true,
+	},
+}
+
+// sets are the seed sets by environment. There is deliberately none for production.
+var sets = map[string]Set{
+	// dev gives local development a few users, with sessions on several devices to list and revoke
+	"dev": {
+		Users: append(append([]User{}, baseUsers...),
+			User{
+				PublicID: seedID(publicid.PrefixUser, 5), Email: "dave@example.com", DisplayName: "Dave",
+				Role: model.RoleUser, EmailVerified: true,
+			},
+		),
+		Sessions: []Session{
+			{
+				PublicID: seedID(publicid.PrefixSession, 1), UserPublicID: AdminUserID,
+				DeviceName: "Admin laptop", UserAgent: "Mozilla/5.0 (Macintosh)", IPAddress: "127.0.0.1",
+			},
+			{
+				PublicID: seedID(publicid.PrefixSession, 2), UserPublicID: VerifiedUserID,
+				DeviceName: "Alice laptop", UserAgent: "Mozilla/5.0 (Windows NT 10.0)", IPAddress: "127.0.0.1",
+			},
+			{
+				PublicID: seedID(publicid.PrefixSession, 3), UserPublicID: VerifiedUserID,
+				DeviceName: "Alice phone", UserAgent: "Mozilla/5.0 (iPhone)", IPAddress: "127.0.0.2",
+			},
+		},
+	},
+	// test gives e2e tests one signed-in session per active user
+	"test": {
+		Users: baseUsers,
+		Sessions: []Session{
+			e2eSession(1, AdminUserID),
+			e2eSession(2, VerifiedUserID),
+			e2eSession(3, UnverifiedUserID),
+		},
+	},
+}
+
+// e2eSession returns the nth session of the test set, for the user of userPublicID
+func e2eSession(n int, userPublicID string) Session {
+	return Session{
+		PublicID:     seedID(publicid.PrefixSession, n),
+		UserPublicID: userPublicID,
+		DeviceName:   "e2e",
+		UserAgent:    "e2e",
+		IPAddress:    "127.0.0.1",
+	}
+}
+
+// SetFor returns the seed set of env
+func SetFor(env string) (Set, bool) {
+	set, ok := sets[env]
+	return set, ok
+}
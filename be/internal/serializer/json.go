@@ -0,0 +1,375 @@
+package serializer
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/model"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	marshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	modelPkgPath      = reflect.TypeOf(model.User{}).PkgPath()
+
+	// fieldCache maps struct types to their []field
+	fieldCache sync.Map
+)
+
+// JSONSerializer is the Echo JSON serializer for API responses. It applies one casing and
+// null-handling policy to every response body, whatever struct tags the value carries:
+//   - field names come from the json tag, or are the snake_case form of the Go field name
+//   - fields tagged json:"-" are omitted, and so are untagged fields of model structs, so a
+//     column added to a model is not exposed until it is given a json tag
+//   - time.Time values are written as RFC 3339 timestamps in UTC
+//   - nil slices are written as [] and nil maps as {}; nil pointers are null unless omitempty
+//
+// With PROBLEM_JSON_ENABLED, error responses are written as RFC 7807 problems, see ProblemDetails.
+// Request bodies are decoded by Echo's default serializer.
+type JSONSerializer struct {
+	// problemTypeBaseURL is prefixed to error codes to form the problem type; empty is about:blank
+	problemTypeBaseURL string
+	problems           bool
+}
+
+// NewJSONSerializer creates a new JSON serializer
+func NewJSONSerializer() *JSONSerializer {
+	return &JSONSerializer{
+		problemTypeBaseURL: config.GetEnv("PROBLEM_TYPE_BASE_URL", ""),
+		problems:           config.GetEnvBool("PROBLEM_JSON_ENABLED", false),
+	}
+}
+
+// Serialize writes i to the response as JSON, indented with indent when it is not empty
+func (s *JSONSerializer) Serialize(c echo.Context, i interface{}, indent string) error {
+	if s.problems {
+		if problem, ok := s.problemFor(c, i); ok {
+			c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+			i = problem
+		}
+	}
+
+	b, err := Marshal(i)
+	if err != nil {
+		return err
+	}
+
+	if indent != "" {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, b, "", indent); err != nil {
+			return err
+		}
+		b = buf.Bytes()
+	}
+
+	_, err = c.Response().Write(append(b, '\n'))
+	return err
+}
+
+// Deserialize reads the request body into i
+func (s *JSONSerializer) Deserialize(c echo.Context, i interface{}) error {
+	return echo.DefaultJSONSerializer{}.Deserialize(c, i)
+}
+
+// Marshal returns the JSON encoding of v under the serializer's policy
+func Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encode(&buf, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encode(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+
+	t := v.Type()
+	if t == timeType {
+		buf.WriteString(strconv.Quote(v.Interface().(time.Time).UTC().Format(time.RFC3339)))
+		return nil
+	}
+
+	kind := v.Kind()
+	if kind != reflect.Pointer && kind != reflect.Interface &&
+		(t.Implements(marshalerType) || t.Implements(textMarshalerType)) {
+		return writeStandard(buf, v.Interface())
+	}
+
+	switch kind {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return encode(buf, v.Elem())
+	case reflect.Struct:
+		return encodeStruct(buf, v)
+	case reflect.Map:
+		return encodeMap(buf, v)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return writeStandard(buf, v.Interface())
+		}
+		if v.IsNil() {
+			buf.WriteString("[]")
+			return nil
+		}
+		return encodeList(buf, v)
+	case reflect.Array:
+		return encodeList(buf, v)
+	default:
+		return writeStandard(buf, v.Interface())
+	}
+}
+
+func encodeStruct(buf *bytes.Buffer, v reflect.Value) error {
+	buf.WriteByte('{')
+	first := true
+	for _, f := range cachedFields(v.Type()) {
+		fv, err := v.FieldByIndexErr(f.index)
+		if err != nil {
+			// The field is promoted through a nil embedded pointer
+			continue
+		}
+		if f.omitEmpty && isEmpty(fv) {
+			continue
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		buf.WriteString(strconv.Quote(f.name))
+		buf.WriteByte(':')
+		if err := encode(buf, fv); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func encodeMap(buf *bytes.Buffer, v reflect.Value) error {
+	keys := make([]string, 0, v.Len())
+	values := make(map[string]reflect.Value, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := mapKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		values[key] = iter.Value()
+	}
+	sort.Strings(keys)
+
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(key))
+		buf.WriteByte(':')
+		if err := encode(buf, values[key]); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func encodeList(buf *bytes.Buffer, v reflect.Value) error {
+	buf.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := encode(buf, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+// writeStandard writes the encoding/json encoding of values the policy does not change
+func writeStandard(buf *bytes.Buffer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+func mapKey(k reflect.Value) (string, error) {
+	switch k.Kind() {
+	case reflect.String:
+		return k.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(k.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported map key type: %s", k.Type())
+	}
+}
+
+// isEmpty reports whether v is empty in the sense of the omitempty option
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// Field describes a struct field as the serializer writes it
+type Field struct {
+	Name        string
+	StructField reflect.StructField
+	OmitEmpty   bool
+}
+
+// Fields returns the fields of struct type t that the serializer writes, in order, with the
+// fields of embedded structs promoted. It lets API documentation describe the actual wire format.
+func Fields(t reflect.Type) []Field {
+	fields := cachedFields(t)
+	result := make([]Field, len(fields))
+	for i, f := range fields {
+		result[i] = Field{StructField: t.FieldByIndex(f.index), Name: f.name, OmitEmpty: f.omitEmpty}
+	}
+	return result
+}
+
+// field is a struct field written to JSON
+type field struct {
+	name      string
+	index     []int
+	depth     int
+	omitEmpty bool
+}
+
+func cachedFields(t reflect.Type) []field {
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.([]field)
+	}
+	fields, _ := fieldCache.LoadOrStore(t, typeFields(t))
+	return fields.([]field)
+}
+
+// typeFields returns the fields of struct type t in declaration order, with the fields of
+// embedded structs without a json name promoted in place. When names collide the least
+// nested field wins.
+func typeFields(t reflect.Type) []field {
+	all := collectFields(t, nil, 0)
+
+	best := make(map[string]int, len(all))
+	for i, f := range all {
+		if j, ok := best[f.name]; !ok || f.depth < all[j].depth {
+			best[f.name] = i
+		}
+	}
+
+	fields := make([]field, 0, len(best))
+	for i, f := range all {
+		if best[f.name] == i {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func collectFields(t reflect.Type, index []int, depth int) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		fieldIndex := append(append([]int{}, index...), i)
+
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && ft != timeType {
+				fields = append(fields, collectFields(ft, fieldIndex, depth+1)...)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			if t.PkgPath() == modelPkgPath {
+				continue
+			}
+			name = snakeCase(sf.Name)
+		}
+		fields = append(fields, field{
+			name:      name,
+			index:     fieldIndex,
+			depth:     depth,
+			omitEmpty: hasOption(options, "omitempty"),
+		})
+	}
+	return fields
+}
+
+func hasOption(options, option string) bool {
+	for _, o := range strings.Split(options, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// snakeCase converts a Go identifier such as CreatedByUserID to snake_case (created_by_user_id)
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
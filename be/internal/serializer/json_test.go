@@ -0,0 +1,211 @@
+package serializer_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/serializer"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type untaggedDTO struct {
+	CreatedAt       time.Time
+	IPAddress       string
+	CreatedByUserID uint
+}
+
+type embeddedBase struct {
+	Name string `json:"name"`
+	ID   uint   `json:"id"`
+}
+
+type embeddingDTO struct {
+	Name         string `json:"name"`
+	embeddedBase `json:",inline"`
+}
+
+type nullsDTO struct {
+	Labels     map[string]string `json:"labels"`
+	LastUsedAt *time.Time        `json:"last_used_at"`
+	RevokedAt  *time.Time        `json:"revoked_at,omitempty"`
+	Note       string            `json:"note,omitempty"`
+	Tags       []string          `json:"tags"`
+}
+
+func TestMarshal(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	createdAt := time.Date(2025, 1, 27, 19, 15, 30, 123456789, tokyo)
+	email := "user@example.com"
+	hash := "secret-hash"
+
+	tests := []struct {
+		value    interface{}
+		name     string
+		expected string
+	}{
+		{
+			name:     "untagged fields are snake_case",
+			value:    untaggedDTO{CreatedAt: createdAt, IPAddress: "127.0.0.1", CreatedByUserID: 7},
+			expected: `{"created_at":"2025-01-27T10:15:30Z","ip_address":"127.0.0.1","created_by_user_id":7}`,
+		},
+		{
+			name:     "timestamps are RFC 3339 in UTC",
+			value:    dto.IdentityInfo{LinkedAt: createdAt, Provider: "google"},
+			expected: `{"linked_at":"2025-01-27T10:15:30Z","provider":"google"}`,
+		},
+		{
+			name:     "embedded structs are inlined and outer fields win",
+			value:    embeddingDTO{embeddedBase: embeddedBase{ID: 1, Name: "inner"}, Name: "outer"},
+			expected: `{"name":"outer","id":1}`,
+		},
+		{
+			name:     "nil values",
+			value:    nullsDTO{},
+			expected: `{"labels":{},"last_used_at":null,"tags":[]}`,
+		},
+		{
+			name: "model internal fields are omitted",
+			value: &model.User{
+				CreatedAt:    createdAt,
+				UpdatedAt:    createdAt,
+				Email:        &email,
+				PasswordHash: &hash,
+				PublicID:     "usr_5k2d9x0q7m3hv8c1b4na",
+				DisplayName:  "Test User",
+				ID:           1,
+				IsDeleted:    true,
+			},
+			expected: `{"created_at":"2025-01-27T10:15:30Z","updated_at":"2025-01-27T10:15:30Z",` +
+				`"email":"user@example.com","public_id":"usr_5k2d9x0q7m3hv8c1b4na","provider_type":"",` +
+				`"display_name":"Test User","role":"","email_verified":false,"is_disabled":false}`,
+		},
+		{
+			name:     "maps are written with sorted keys",
+			value:    map[string]string{"message": "Unauthorized", "code": "E005"},
+			expected: `{"code":"E005","message":"Unauthorized"}`,
+		},
+		{
+			name:     "raw JSON is written as is",
+			value:    map[string]json.RawMessage{"data": json.RawMessage(`{"a":1}`)},
+			expected: `{"data":{"a":1}}`,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := serializer.Marshal(tt.value)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(b))
+		})
+	}
+}
+
+func TestJSONSerializer(t *testing.T) {
+	e := echo.New()
+	e.JSONSerializer = serializer.NewJSONSerializer()
+	e.GET("/", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, dto.ErrorResponse{Code: "E005", Message: "Unauthorized"})
+	})
+	e.POST("/", func(c echo.Context) error {
+		var req dto.LoginRequest
+		if err := c.Bind(&req); err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, req.Email)
+	})
+
+	t.Run("serialize", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "{\"code\":\"E005\",\"message\":\"Unauthorized\"}\n", rec.Body.String())
+	})
+
+	t.Run("serialize indented", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?pretty", nil))
+
+		assert.Equal(t, "{\n  \"code\": \"E005\",\n  \"message\": \"Unauthorized\"\n}\n", rec.Body.String())
+	})
+
+	t.Run("deserialize", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":"user@example.com"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "user@example.com", rec.Body.String())
+	})
+
+	t.Run("deserialize malformed body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestJSONSerializerProblems(t *testing.T) {
+	t.Setenv("PROBLEM_JSON_ENABLED", "true")
+	t.Setenv("PROBLEM_TYPE_BASE_URL", "https://docs.example.com/errors/")
+
+	e := echo.New()
+	e.JSONSerializer = serializer.NewJSONSerializer()
+	e.GET("/error", func(c echo.Context) error {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Code:        "E005",
+			Message:     "Unauthorized",
+			Description: "Missing authorization header",
+		})
+	})
+	e.GET("/ok", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/error", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, serializer.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+
+		var problem dto.ProblemDetails
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+		assert.Equal(t, dto.ProblemDetails{
+			Type:     "https://docs.example.com/errors/E005",
+			Title:    "Unauthorized",
+			Status:   http.StatusUnauthorized,
+			Detail:   "Missing authorization header",
+			Instance: "/error",
+			Code:     "E005",
+		}, problem)
+	})
+
+	t.Run("other response", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ok", nil))
+
+		assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "{\"status\":\"ok\"}\n", rec.Body.String())
+	})
+}
@@ -0,0 +1,41 @@
+package serializer
+
+import (
+	"strikepad-backend/internal/dto"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MIMEApplicationProblemJSON is the media type of RFC 7807 problems
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// problemFor returns the problem of the response when i is an error response. The status is the
+// one the response is written with, and the instance is the request path.
+func (s *JSONSerializer) problemFor(c echo.Context, i interface{}) (dto.ProblemDetails, bool) {
+	var response dto.ErrorResponse
+	switch v := i.(type) {
+	case dto.ErrorResponse:
+		response = v
+	case *dto.ErrorResponse:
+		if v == nil {
+			return dto.ProblemDetails{}, false
+		}
+		response = *v
+	default:
+		return dto.ProblemDetails{}, false
+	}
+
+	problemType := "about:blank"
+	if s.problemTypeBaseURL != "" {
+		problemType = s.problemTypeBaseURL + response.Code
+	}
+	return dto.ProblemDetails{
+		Type:     problemType,
+		Title:    response.Message,
+		Status:   c.Response().Status,
+		Detail:   response.Description,
+		Instance: c.Request().URL.Path,
+		Code:     response.Code,
+		Details:  response.Details,
+	}, true
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// AdminService handles user administration for the admin API
+type AdminService struct {
+	userRepo       repository.UserRepository
+	sessionService SessionServiceInterface
+}
+
+// NewAdminService creates a new admin service. Sessions are ended through the session service,
+// which also revokes stateless access tokens.
+func NewAdminService(
+	userRepo repository.UserRepository,
+	sessionService SessionServiceInterface,
+) AdminServiceInterface {
+	return &AdminService{
+		userRepo:       userRepo,
+		sessionService: sessionService,
+	}
+}
+
+// ListUsers returns a page of users matching params
+func (s *AdminService) ListUsers(params repository.ListParams) (*dto.AdminUserListResponse, error) {
+	page, err := s.userRepo.List(params)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidSort) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	users := make([]dto.AdminUserInfo, 0, len(page.Items))
+	for i := range page.Items {
+		users = append(users, toAdminUserInfo(&page.Items[i]))
+	}
+
+	response := dto.AdminUserListResponse(dto.NewListResponse(users, page.Meta(params.Params)))
+	return &response, nil
+}
+
+// ListUserSessions returns a page of the active sessions of the user with the public ID
+func (s *AdminService) ListUserSessions(
+	userPublicID string, params pagination.Params,
+) (*pagination.Page[model.UserSession], error) {
+	user, err := s.getUser(userPublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.sessionService.ListActiveSessions(user.ID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// SetUserDisabled disables or re-enables the user with the public ID. Disabling also ends all of
+// the user's sessions.
+func (s *AdminService) SetUserDisabled(userPublicID string, disabled bool) (*dto.AdminUserInfo, error) {
+	user, err := s.getUser(userPublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsDisabled != disabled {
+		user.IsDisabled = disabled
+		if err := s.userRepo.Update(user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+	if disabled {
+		if err := s.sessionService.InvalidateAllUserSessions(user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.Info("User disabled state changed by admin", "user_id", user.ID, "disabled", disabled)
+	info := toAdminUserInfo(user)
+	return &info, nil
+}
+
+// ForceLogout ends all sessions of the user with the public ID
+func (s *AdminService) ForceLogout(userPublicID string) error {
+	user, err := s.getUser(userPublicID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.sessionService.InvalidateAllUserSessions(user.ID); err != nil {
+		return err
+	}
+
+	slog.Info("User logged out by admin", "user_id", user.ID)
+	return nil
+}
+
+// getUser loads a user by public ID, returning auth.ErrUserNotFound when it does not exist
+func (s *AdminService) getUser(userPublicID string) (*model.User, error) {
+	user, err := s.userRepo.GetByPublicID(userPublicID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, auth.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// toAdminUserInfo converts a user model to the representation returned by the admin API
+func toAdminUserInfo(user *model.User) dto.AdminUserInfo {
+	info := dto.AdminUserInfo{
+		ID:            user.PublicID,
+		DisplayName:   user.DisplayName,
+		ProviderType:  user.ProviderType,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+		IsDisabled:    user.IsDisabled,
+		CreatedAt:     user.CreatedAt,
+	}
+	if user.Email != nil {
+		info.Email = *user.Email
+	}
+	return info
+}
@@ -0,0 +1,139 @@
+package service_test
+
+import (
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	servicemocks "strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestAdminService_ListUsers(t *testing.T) {
+	t.Run("normalizes paging and maps users", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		email := "admin@example.com"
+		total := int64(1)
+		userRepo.EXPECT().List(repository.ListParams{Params: pagination.Params{IncludeTotal: true}}).
+			Return(&pagination.Page[model.User]{
+				Items: []model.User{{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na", Email: &email, Role: model.RoleAdmin}},
+				Total: &total,
+			}, nil).Once()
+
+		adminService := service.NewAdminService(userRepo, &servicemocks.MockSessionServiceInterface{})
+		response, err := adminService.ListUsers(repository.ListParams{Params: pagination.Params{IncludeTotal: true}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &total, response.Total)
+		assert.Equal(t, 1, response.Page)
+		assert.Equal(t, pagination.DefaultPerPage, response.PerPage)
+		assert.False(t, response.HasMore)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, "usr_5k2d9x0q7m3hv8c1b4na", response.Data[0].ID)
+		assert.Equal(t, email, response.Data[0].Email)
+		assert.Equal(t, model.RoleAdmin, response.Data[0].Role)
+	})
+
+	t.Run("invalid sort", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().List(mock.Anything).Return(nil, repository.ErrInvalidSort).Once()
+
+		adminService := service.NewAdminService(userRepo, &servicemocks.MockSessionServiceInterface{})
+		_, err := adminService.ListUsers(repository.ListParams{Sort: "password_hash"})
+
+		assert.ErrorIs(t, err, repository.ErrInvalidSort)
+	})
+}
+
+func TestAdminService_SetUserDisabled(t *testing.T) {
+	tests := []struct {
+		user             *model.User
+		name             string
+		disabled         bool
+		expectUpdate     bool
+		expectInvalidate bool
+	}{
+		{
+			name:             "disables user and ends sessions",
+			user:             factory.NewUser().Build(),
+			disabled:         true,
+			expectUpdate:     true,
+			expectInvalidate: true,
+		},
+		{
+			name:         "enables user",
+			user:         factory.NewUser().Disabled().Build(),
+			expectUpdate: true,
+		},
+		{
+			name:             "already disabled still ends sessions",
+			user:             factory.NewUser().Disabled().Build(),
+			disabled:         true,
+			expectInvalidate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := mocks.NewMockUserRepository(t)
+			sessionService := &servicemocks.MockSessionServiceInterface{}
+			userRepo.EXPECT().GetByPublicID("usr_5k2d9x0q7m3hv8c1b4na").Return(tt.user, nil).Once()
+			if tt.expectUpdate {
+				userRepo.EXPECT().Update(mock.MatchedBy(func(user *model.User) bool {
+					return user.IsDisabled == tt.disabled
+				})).Return(nil).Once()
+			}
+			if tt.expectInvalidate {
+				sessionService.On("InvalidateAllUserSessions", uint(1)).Return(nil).Once()
+			}
+
+			adminService := service.NewAdminService(userRepo, sessionService)
+			info, err := adminService.SetUserDisabled("usr_5k2d9x0q7m3hv8c1b4na", tt.disabled)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.disabled, info.IsDisabled)
+			sessionService.AssertExpectations(t)
+		})
+	}
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByPublicID("usr_0000000000000000000z").Return(nil, gorm.ErrRecordNotFound).Once()
+
+		adminService := service.NewAdminService(userRepo, &servicemocks.MockSessionServiceInterface{})
+		_, err := adminService.SetUserDisabled("usr_0000000000000000000z", true)
+
+		assert.ErrorIs(t, err, auth.ErrUserNotFound)
+	})
+}
+
+func TestAdminService_ForceLogout(t *testing.T) {
+	t.Run("ends all sessions", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		sessionService := &servicemocks.MockSessionServiceInterface{}
+		userRepo.EXPECT().GetByPublicID("usr_5k2d9x0q7m3hv8c1b4na").Return(factory.NewUser().Build(), nil).Once()
+		sessionService.On("InvalidateAllUserSessions", uint(1)).Return(nil).Once()
+
+		adminService := service.NewAdminService(userRepo, sessionService)
+
+		assert.NoError(t, adminService.ForceLogout("usr_5k2d9x0q7m3hv8c1b4na"))
+		sessionService.AssertExpectations(t)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByPublicID("usr_0000000000000000000z").Return(nil, gorm.ErrRecordNotFound).Once()
+
+		adminService := service.NewAdminService(userRepo, &servicemocks.MockSessionServiceInterface{})
+
+		assert.ErrorIs(t, adminService.ForceLogout("usr_0000000000000000000z"), auth.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+)
+
+// APIKeyService issues, revokes and authenticates API keys for service-to-service calls
+type APIKeyService struct {
+	apiKeyRepo repository.APIKeyRepositoryInterface
+}
+
+// NewAPIKeyService creates a new API key service
+func NewAPIKeyService(apiKeyRepo repository.APIKeyRepositoryInterface) APIKeyServiceInterface {
+	return &APIKeyService{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+// CreateKey issues a new API key. The returned key is not stored and cannot be retrieved again.
+func (s *APIKeyService) CreateKey(
+	createdBy *model.User, req *dto.CreateAPIKeyRequest,
+) (*dto.APIKeyCreatedResponse, error) {
+	key, displayPrefix, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := &model.APIKey{
+		Name:            req.Name,
+		KeyPrefix:       displayPrefix,
+		KeyHash:         auth.HashAPIKey(key),
+		CreatedBy:       *createdBy,
+		CreatedByUserID: createdBy.ID,
+	}
+	if err := s.apiKeyRepo.Create(apiKey); err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	slog.Info("API key issued", "api_key_id", apiKey.ID, "name", apiKey.Name, "created_by", createdBy.ID)
+	return &dto.APIKeyCreatedResponse{
+		Key:        key,
+		APIKeyInfo: toAPIKeyInfo(apiKey),
+	}, nil
+}
+
+// ListKeys returns all issued API keys, including revoked ones
+func (s *APIKeyService) ListKeys() (*dto.APIKeyListResponse, error) {
+	apiKeys, err := s.apiKeyRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	infos := make([]dto.APIKeyInfo, 0, len(apiKeys))
+	for i := range apiKeys {
+		infos = append(infos, toAPIKeyInfo(&apiKeys[i]))
+	}
+
+	response := dto.APIKeyListResponse(dto.NewCompleteListResponse(infos))
+	return &response, nil
+}
+
+// RevokeKey revokes the API key with the public ID so that it can no longer authenticate
+func (s *APIKeyService) RevokeKey(publicID string) error {
+	if err := s.apiKeyRepo.Revoke(publicID); err != nil {
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	slog.Info("API key revoked", "api_key_id", publicID)
+	return nil
+}
+
+// Authenticate returns the API key matching key, or auth.ErrInvalidAPIKey when it is unknown or revoked
+func (s *APIKeyService) Authenticate(key string) (*model.APIKey, error) {
+	apiKey, err := s.apiKeyRepo.FindByHash(auth.HashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			return nil, auth.ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("failed to find API key: %w", err)
+	}
+	if apiKey.IsRevoked() {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	// Recording the last use is best effort and must not fail the request
+	if err := s.apiKeyRepo.UpdateLastUsed(apiKey.ID, time.Now()); err != nil {
+		slog.Warn("Failed to record API key use", "error", err, "api_key_id", apiKey.ID)
+	}
+
+	return apiKey, nil
+}
+
+// toAPIKeyInfo converts an API key model to the representation returned to administrators
+func toAPIKeyInfo(apiKey *model.APIKey) dto.APIKeyInfo {
+	return dto.APIKeyInfo{
+		CreatedAt:       apiKey.CreatedAt,
+		LastUsedAt:      apiKey.LastUsedAt,
+		RevokedAt:       apiKey.RevokedAt,
+		Name:            apiKey.Name,
+		KeyPrefix:       apiKey.KeyPrefix,
+		ID:              apiKey.PublicID,
+		CreatedByUserID: apiKey.CreatedBy.PublicID,
+	}
+}
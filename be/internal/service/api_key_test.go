@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAPIKeyService_CreateKey(t *testing.T) {
+	apiKeyRepo := mocks.NewMockAPIKeyRepositoryInterface(t)
+	var stored *model.APIKey
+	apiKeyRepo.EXPECT().Create(mock.AnythingOfType("*model.APIKey")).
+		Run(func(apiKey *model.APIKey) {
+			apiKey.ID = 5
+			apiKey.PublicID = "key_8h2m4q0x9d7c3v1k5b6n"
+			stored = apiKey
+		}).
+		Return(nil).Once()
+
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+	response, err := apiKeyService.CreateKey(&model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}, &dto.CreateAPIKeyRequest{Name: "worker"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "key_8h2m4q0x9d7c3v1k5b6n", response.ID)
+	assert.Equal(t, "usr_5k2d9x0q7m3hv8c1b4na", response.CreatedByUserID)
+	assert.Equal(t, "worker", response.Name)
+	assert.True(t, strings.HasPrefix(response.Key, response.KeyPrefix))
+	assert.Equal(t, auth.HashAPIKey(response.Key), stored.KeyHash)
+	assert.Equal(t, uint(1), stored.CreatedByUserID)
+}
+
+func TestAPIKeyService_Authenticate(t *testing.T) {
+	revokedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		findResult    *model.APIKey
+		findErr       error
+		expectedError error
+		name          string
+		expectTouch   bool
+	}{
+		{
+			name:        "valid key",
+			findResult:  &model.APIKey{ID: 5, Name: "worker"},
+			expectTouch: true,
+		},
+		{
+			name:          "unknown key",
+			findErr:       repository.ErrAPIKeyNotFound,
+			expectedError: auth.ErrInvalidAPIKey,
+		},
+		{
+			name:          "revoked key",
+			findResult:    &model.APIKey{ID: 5, Name: "worker", RevokedAt: &revokedAt},
+			expectedError: auth.ErrInvalidAPIKey,
+		},
+		{
+			name:          "database error",
+			findErr:       assert.AnError,
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyRepo := mocks.NewMockAPIKeyRepositoryInterface(t)
+			apiKeyRepo.EXPECT().FindByHash(auth.HashAPIKey("sk_key")).Return(tt.findResult, tt.findErr).Once()
+			if tt.expectTouch {
+				apiKeyRepo.EXPECT().UpdateLastUsed(uint(5), mock.AnythingOfType("time.Time")).Return(nil).Once()
+			}
+
+			apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+			apiKey, err := apiKeyService.Authenticate("sk_key")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, apiKey)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(5), apiKey.ID)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_RevokeKey(t *testing.T) {
+	apiKeyRepo := mocks.NewMockAPIKeyRepositoryInterface(t)
+	apiKeyRepo.EXPECT().Revoke("key_8h2m4q0x9d7c3v1k5b6n").Return(nil).Once()
+	apiKeyRepo.EXPECT().Revoke("key_0000000000000000000z").Return(repository.ErrAPIKeyNotFound).Once()
+
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+
+	assert.NoError(t, apiKeyService.RevokeKey("key_8h2m4q0x9d7c3v1k5b6n"))
+	assert.ErrorIs(t, apiKeyService.RevokeKey("key_0000000000000000000z"), repository.ErrAPIKeyNotFound)
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// Audit log actions
+const (
+	// AuditActionLoginSucceeded is recorded when a user signs in, with the sign-in method
+	AuditActionLoginSucceeded = "auth.login_succeeded"
+	// AuditActionLoginFailed is recorded when a sign-in is refused, with the method and the reason.
+	// Password sign-ins record the email address tried, and the user who has it as the actor.
+	AuditActionLoginFailed = "auth.login_failed"
+	// AuditActionLogout is recorded when a user ends their current session
+	AuditActionLogout = "auth.logout"
+	// AuditActionPasswordChanged is recorded when a user changes their password
+	AuditActionPasswordChanged = "user.password_changed"
+	// AuditActionEmailChangeRequested is recorded when a user asks to change their email address
+	AuditActionEmailChangeRequested = "user.email_change_requested"
+	// AuditActionEmailChanged is recorded when a user changes their email address
+	AuditActionEmailChanged = "user.email_changed"
+	// AuditActionDataExportRequested is recorded when a user requests an export of their data
+	AuditActionDataExportRequested = "user.data_export_requested"
+	// AuditActionSessionRevoked is recorded when a user revokes one of their sessions, the target
+	AuditActionSessionRevoked = "session.revoked"
+	// AuditActionAdmin is recorded for every change made through the admin API, with the route
+	AuditActionAdmin = "admin.action"
+)
+
+// AuditActions lists the actions that are recorded, e.g. to validate filters
+var AuditActions = []string{
+	AuditActionLoginSucceeded,
+	AuditActionLoginFailed,
+	AuditActionLogout,
+	AuditActionPasswordChanged,
+	AuditActionEmailChangeRequested,
+	AuditActionEmailChanged,
+	AuditActionDataExportRequested,
+	AuditActionSessionRevoked,
+	AuditActionAdmin,
+}
+
+// ErrUnknownAuditAction is returned when filtering audit logs by an action that is never recorded
+var ErrUnknownAuditAction = errors.New("unknown audit action")
+
+// AuditEntry describes an action to record in the audit log. ActorID and TargetID are public IDs;
+// ActorID is empty when nobody is authenticated, e.g. for a failed sign-in.
+type AuditEntry struct {
+	Metadata  map[string]string
+	Action    string
+	ActorID   string
+	TargetID  string
+	IPAddress string
+	UserAgent string
+}
+
+// Results of the sign-in attempts listed to users
+const (
+	loginResultSucceeded = "succeeded"
+	loginResultFailed    = "failed"
+)
+
+// AuditService records security-sensitive actions and lists them for administrators, and the
+// sign-ins of users for the users themselves
+type AuditService struct {
+	auditLogRepo repository.AuditLogRepositoryInterface
+	userRepo     repository.UserRepository
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(
+	auditLogRepo repository.AuditLogRepositoryInterface,
+	userRepo repository.UserRepository,
+) AuditServiceInterface {
+	return &AuditService{
+		auditLogRepo: auditLogRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Record writes entry to the audit log. The action it describes has already happened, so a failure
+// is logged with the entry rather than returned.
+func (s *AuditService) Record(entry AuditEntry) {
+	metadata := entry.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		slog.Error("Failed to encode audit log metadata", "action", entry.Action, "error", err)
+		encoded = []byte("{}")
+	}
+
+	auditLog := &model.AuditLog{
+		Action:    entry.Action,
+		ActorID:   optionalString(entry.ActorID),
+		TargetID:  optionalString(entry.TargetID),
+		IPAddress: optionalString(entry.IPAddress),
+		UserAgent: optionalString(entry.UserAgent),
+		Metadata:  string(encoded),
+	}
+	if err := s.auditLogRepo.Create(auditLog); err != nil {
+		slog.Error("Failed to record audit log entry", "error", err, "action", entry.Action,
+			"actor_id", entry.ActorID, "target_id", entry.TargetID, "ip", entry.IPAddress)
+	}
+}
+
+// RecordLoginFailure writes entry, a refused password sign-in with email, to the audit log like
+// Record. The address is recorded in the metadata, and the user who has it as the actor, so that the
+// attempt is listed to that user and not to whoever has the address later.
+func (s *AuditService) RecordLoginFailure(entry AuditEntry, email string) {
+	if entry.Metadata == nil {
+		entry.Metadata = map[string]string{}
+	}
+	entry.Metadata["email"] = email
+	user, err := s.userRepo.FindByEmail(email)
+	switch {
+	case err == nil:
+		entry.ActorID = user.PublicID
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		slog.Warn("Failed to find the user of a refused sign-in", "error", err)
+	}
+	s.Record(entry)
+}
+
+// ListLogs returns a page of audit log entries matching params, newest first
+func (s *AuditService) ListLogs(params repository.AuditLogListParams) (*dto.AuditLogListResponse, error) {
+	if params.Action != "" && !slices.Contains(AuditActions, params.Action) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAuditAction, params.Action)
+	}
+
+	page, err := s.auditLogRepo.List(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+
+	auditLogs := make([]dto.AuditLogInfo, 0, len(page.Items))
+	for i := range page.Items {
+		auditLogs = append(auditLogs, toAuditLogInfo(&page.Items[i]))
+	}
+
+	response := dto.AuditLogListResponse(dto.NewListResponse(auditLogs, page.Meta(params.Params)))
+	return &response, nil
+}
+
+// ListLogins returns a page of the sign-ins to the user's account, newest first: those done as the
+// user, and the refused password sign-ins that name no user but the user's current email address
+// since it became theirs. Sign-ins refused before the address was the user's belong to whoever tried
+// or had it then.
+func (s *AuditService) ListLogins(userID uint, params pagination.Params) (*dto.LoginAttemptListResponse, error) {
+	// The user is loaded rather than taken from the session, as stateless sessions have no email
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	activity := repository.UserActivityListParams{
+		Actions:    []string{AuditActionLoginSucceeded, AuditActionLoginFailed},
+		ActorID:    user.PublicID,
+		Params:     params,
+		EmailSince: user.CreatedAt,
+	}
+	if user.Email != nil {
+		activity.Email = *user.Email
+	}
+	if user.EmailChangedAt != nil && user.EmailChangedAt.After(activity.EmailSince) {
+		activity.EmailSince = *user.EmailChangedAt
+	}
+	page, err := s.auditLogRepo.ListUserActivity(activity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list logins: %w", err)
+	}
+
+	logins := make([]dto.LoginAttemptInfo, 0, len(page.Items))
+	for i := range page.Items {
+		info := toAuditLogInfo(&page.Items[i])
+		login := dto.LoginAttemptInfo{
+			CreatedAt: info.CreatedAt,
+			Result:    loginResultSucceeded,
+			Method:    info.Metadata["method"],
+			IPAddress: info.IPAddress,
+			UserAgent: info.UserAgent,
+		}
+		if info.Action == AuditActionLoginFailed {
+			login.Result = loginResultFailed
+			login.Reason = info.Metadata["reason"]
+		}
+		logins = append(logins, login)
+	}
+
+	response := dto.LoginAttemptListResponse(dto.NewListResponse(logins, page.Meta(params)))
+	return &response, nil
+}
+
+// toAuditLogInfo converts an audit log model to the representation returned by the admin API
+func toAuditLogInfo(auditLog *model.AuditLog) dto.AuditLogInfo {
+	info := dto.AuditLogInfo{
+		ID:        auditLog.PublicID,
+		Action:    auditLog.Action,
+		CreatedAt: auditLog.CreatedAt,
+		Metadata:  map[string]string{},
+	}
+	if auditLog.ActorID != nil {
+		info.ActorID = *auditLog.ActorID
+	}
+	if auditLog.TargetID != nil {
+		info.TargetID = *auditLog.TargetID
+	}
+	if auditLog.IPAddress != nil {
+		info.IPAddress = *auditLog.IPAddress
+	}
+	if auditLog.UserAgent != nil {
+		info.UserAgent = *auditLog.UserAgent
+	}
+	if err := json.Unmarshal([]byte(auditLog.Metadata), &info.Metadata); err != nil {
+		slog.Warn("Ignoring unreadable audit log metadata", "audit_log_id", auditLog.PublicID, "error", err)
+	}
+	return info
+}
+
+// optionalString returns nil for an empty string, which is stored as NULL
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,220 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestAuditService_Record(t *testing.T) {
+	t.Run("stores the entry with encoded metadata", func(t *testing.T) {
+		auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+		var stored *model.AuditLog
+		auditLogRepo.EXPECT().Create(mock.AnythingOfType("*model.AuditLog")).
+			Run(func(entry *model.AuditLog) { stored = entry }).
+			Return(nil).Once()
+
+		service.NewAuditService(auditLogRepo, nil).Record(service.AuditEntry{
+			Action:    service.AuditActionLoginSucceeded,
+			ActorID:   "usr_5k2d9x0q7m3hv8c1b4na",
+			IPAddress: "203.0.113.7",
+			Metadata:  map[string]string{"method": "password"},
+		})
+
+		require.NotNil(t, stored)
+		assert.Equal(t, service.AuditActionLoginSucceeded, stored.Action)
+		assert.Equal(t, "usr_5k2d9x0q7m3hv8c1b4na", *stored.ActorID)
+		assert.Equal(t, "203.0.113.7", *stored.IPAddress)
+		assert.Nil(t, stored.TargetID)
+		assert.Nil(t, stored.UserAgent)
+		assert.JSONEq(t, `{"method":"password"}`, stored.Metadata)
+	})
+
+	t.Run("does not fail the caller when the entry cannot be stored", func(t *testing.T) {
+		auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+		auditLogRepo.EXPECT().Create(mock.Anything).Return(assert.AnError).Once()
+
+		assert.NotPanics(t, func() {
+			service.NewAuditService(auditLogRepo, nil).Record(service.AuditEntry{Action: service.AuditActionLogout})
+		})
+	})
+}
+
+func TestAuditService_RecordLoginFailure(t *testing.T) {
+	tests := []struct {
+		findErr         error
+		name            string
+		expectedActorID string
+	}{
+		{name: "registered address", expectedActorID: factory.UserPublicID},
+		{name: "unregistered address", findErr: gorm.ErrRecordNotFound},
+		{name: "lookup error", findErr: assert.AnError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := mocks.NewMockUserRepository(t)
+			var user *model.User
+			if tt.findErr == nil {
+				user = factory.NewUser().Build()
+			}
+			userRepo.On("FindByEmail", factory.UserEmail).Return(user, tt.findErr).Once()
+			auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+			var stored *model.AuditLog
+			auditLogRepo.EXPECT().Create(mock.AnythingOfType("*model.AuditLog")).
+				Run(func(entry *model.AuditLog) { stored = entry }).
+				Return(nil).Once()
+
+			service.NewAuditService(auditLogRepo, userRepo).RecordLoginFailure(service.AuditEntry{
+				Action:   service.AuditActionLoginFailed,
+				Metadata: map[string]string{"method": "password", "reason": "E100"},
+			}, factory.UserEmail)
+
+			require.NotNil(t, stored)
+			if tt.expectedActorID == "" {
+				assert.Nil(t, stored.ActorID)
+			} else {
+				assert.Equal(t, tt.expectedActorID, *stored.ActorID)
+			}
+			assert.JSONEq(t, `{"email":"test@example.com","method":"password","reason":"E100"}`, stored.Metadata)
+		})
+	}
+}
+
+func TestAuditService_ListLogs(t *testing.T) {
+	t.Run("converts the entries", func(t *testing.T) {
+		createdAt := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+		actorID := "usr_5k2d9x0q7m3hv8c1b4na"
+		params := repository.AuditLogListParams{Action: service.AuditActionLoginFailed}
+		auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+		auditLogRepo.EXPECT().List(params).Return(&pagination.Page[model.AuditLog]{
+			Items: []model.AuditLog{{
+				ID:        1,
+				PublicID:  "aud_3m8q1x5k9d2v7h0c4b6n",
+				Action:    service.AuditActionLoginFailed,
+				ActorID:   &actorID,
+				Metadata:  `{"reason":"E100"}`,
+				CreatedAt: createdAt,
+			}},
+			HasMore: true,
+		}, nil).Once()
+
+		response, err := service.NewAuditService(auditLogRepo, nil).ListLogs(params)
+
+		require.NoError(t, err)
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, "aud_3m8q1x5k9d2v7h0c4b6n", response.Data[0].ID)
+		assert.Equal(t, actorID, response.Data[0].ActorID)
+		assert.Empty(t, response.Data[0].TargetID)
+		assert.Equal(t, map[string]string{"reason": "E100"}, response.Data[0].Metadata)
+		assert.Equal(t, createdAt, response.Data[0].CreatedAt)
+		assert.True(t, response.HasMore)
+	})
+
+	t.Run("rejects unknown actions", func(t *testing.T) {
+		auditService := service.NewAuditService(mocks.NewMockAuditLogRepositoryInterface(t), nil)
+
+		_, err := auditService.ListLogs(repository.AuditLogListParams{Action: "pad.created"})
+
+		assert.ErrorIs(t, err, service.ErrUnknownAuditAction)
+	})
+}
+
+func TestAuditService_ListLogins(t *testing.T) {
+	createdAt := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	email := factory.UserEmail
+	actorID := factory.UserPublicID
+	ipAddress := "203.0.113.7"
+
+	signedUpAt := createdAt.AddDate(0, -1, 0)
+
+	t.Run("lists successful and refused sign-ins", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.On("GetByID", uint(1)).Return(factory.NewUser().CreatedAt(signedUpAt).Build(), nil).Once()
+		auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+		auditLogRepo.EXPECT().ListUserActivity(repository.UserActivityListParams{
+			Actions:    []string{service.AuditActionLoginSucceeded, service.AuditActionLoginFailed},
+			ActorID:    actorID,
+			Email:      email,
+			EmailSince: signedUpAt,
+			Params:     pagination.Params{PerPage: 20},
+		}).Return(&pagination.Page[model.AuditLog]{
+			Items: []model.AuditLog{
+				{
+					ID: 2, PublicID: "aud_3m8q1x5k9d2v7h0c4b6n", Action: service.AuditActionLoginSucceeded,
+					ActorID: &actorID, IPAddress: &ipAddress, Metadata: `{"method":"google"}`, CreatedAt: createdAt,
+				},
+				{
+					ID: 1, PublicID: "aud_7d2k9m4q0x8h3v1c5b6n", Action: service.AuditActionLoginFailed,
+					Metadata:  `{"email":"test@example.com","method":"password","reason":"E100"}`,
+					CreatedAt: createdAt,
+				},
+			},
+		}, nil).Once()
+
+		response, err := service.NewAuditService(auditLogRepo, userRepo).ListLogins(1, pagination.Params{PerPage: 20})
+
+		require.NoError(t, err)
+		require.Len(t, response.Data, 2)
+		assert.Equal(t, "succeeded", response.Data[0].Result)
+		assert.Equal(t, "google", response.Data[0].Method)
+		assert.Equal(t, ipAddress, response.Data[0].IPAddress)
+		assert.Empty(t, response.Data[0].Reason)
+		assert.Equal(t, "failed", response.Data[1].Result)
+		assert.Equal(t, "password", response.Data[1].Method)
+		assert.Equal(t, "E100", response.Data[1].Reason)
+		assert.False(t, response.HasMore)
+	})
+
+	t.Run("only matches the address since it was changed to", func(t *testing.T) {
+		emailChangedAt := signedUpAt.AddDate(0, 0, 7)
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.On("GetByID", uint(1)).Return(
+			factory.NewUser().CreatedAt(signedUpAt).WithEmailChangedAt(emailChangedAt).Build(), nil,
+		).Once()
+		auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+		auditLogRepo.EXPECT().ListUserActivity(mock.MatchedBy(func(params repository.UserActivityListParams) bool {
+			return params.EmailSince.Equal(emailChangedAt)
+		})).Return(&pagination.Page[model.AuditLog]{}, nil).Once()
+
+		_, err := service.NewAuditService(auditLogRepo, userRepo).ListLogins(1, pagination.Params{})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("only lists sign-ins as the user without an email address", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.On("GetByID", uint(1)).Return(factory.NewUser().WithoutEmail().Build(), nil).Once()
+		auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+		auditLogRepo.EXPECT().ListUserActivity(mock.MatchedBy(func(params repository.UserActivityListParams) bool {
+			return params.ActorID == actorID && params.Email == ""
+		})).Return(&pagination.Page[model.AuditLog]{}, nil).Once()
+
+		response, err := service.NewAuditService(auditLogRepo, userRepo).ListLogins(1, pagination.Params{})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Data)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.On("GetByID", uint(1)).Return(factory.NewUser().Build(), nil).Once()
+		auditLogRepo := mocks.NewMockAuditLogRepositoryInterface(t)
+		auditLogRepo.EXPECT().ListUserActivity(mock.Anything).Return(nil, pagination.ErrInvalidCursor).Once()
+
+		_, err := service.NewAuditService(auditLogRepo, userRepo).ListLogins(1, pagination.Params{Cursor: "aud_x"})
+
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+}
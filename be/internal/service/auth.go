@@ -1,11 +1,16 @@
 package service
 
 import (
-	"errors"
+	"context"
+	stderrors "errors"
 	"log/slog"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/config"
 	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/events"
 	"strikepad-backend/internal/model"
 	"strikepad-backend/internal/oauth"
 	"strikepad-backend/internal/repository"
@@ -14,19 +19,74 @@ import (
 )
 
 type AuthService struct {
-	userRepo    repository.UserRepository
-	googleOAuth *oauth.GoogleOAuthService
+	userRepo           repository.UserRepository
+	identityRepo       repository.IdentityRepositoryInterface
+	invitationRepo     repository.InvitationRepositoryInterface
+	txManager          repository.TxManagerInterface
+	googleOAuth        oauth.GoogleService
+	providers          *oauth.Registry
+	jwtService         *auth.JWTService
+	passwordHasher     *auth.PasswordHasher
+	breachChecker      auth.BreachChecker
+	bus                events.Bus
+	clock              clock.Clock
+	tokens             auth.TokenGenerator
+	closedRegistration bool
 }
 
-func NewAuthService(userRepo repository.UserRepository) AuthServiceInterface {
+func NewAuthService(
+	userRepo repository.UserRepository,
+	identityRepo repository.IdentityRepositoryInterface,
+	invitationRepo repository.InvitationRepositoryInterface,
+	txManager repository.TxManagerInterface,
+	providers *oauth.Registry,
+	jwtService *auth.JWTService,
+	passwordHasher *auth.PasswordHasher,
+	breachChecker auth.BreachChecker,
+	bus events.Bus,
+	clk clock.Clock,
+	tokens auth.TokenGenerator,
+) AuthServiceInterface {
 	return &AuthService{
-		userRepo:    userRepo,
-		googleOAuth: oauth.NewGoogleOAuthService(),
+		userRepo:           userRepo,
+		identityRepo:       identityRepo,
+		invitationRepo:     invitationRepo,
+		txManager:          txManager,
+		googleOAuth:        providers.Google(),
+		providers:          providers,
+		jwtService:         jwtService,
+		passwordHasher:     passwordHasher,
+		breachChecker:      breachChecker,
+		bus:                bus,
+		clock:              clk,
+		tokens:             tokens,
+		closedRegistration: config.GetEnvBool("CLOSED_REGISTRATION", false),
 	}
 }
 
+// withRepositories returns a copy of the service that uses repos, e.g. inside a transaction
+func (s *AuthService) withRepositories(repos *repository.Repositories) *AuthService {
+	txService := *s
+	txService.userRepo = repos.Users
+	txService.identityRepo = repos.Identities
+	txService.invitationRepo = repos.Invitations
+	return &txService
+}
+
 // Signup creates a new user account
 func (s *AuthService) Signup(req *dto.SignupRequest) (*dto.SignupResponse, error) {
+	response, err := s.signup(req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishUserCreated(context.Background(), response.ID, response.Email, response.DisplayName, "email")
+	return response, nil
+}
+
+// signup creates a new user account without announcing it, so that callers running it in a
+// transaction can publish user.created once the transaction is committed
+func (s *AuthService) signup(req *dto.SignupRequest) (*dto.SignupResponse, error) {
 	// Validate email format
 	if err := auth.ValidateEmail(req.Email); err != nil {
 		slog.Warn("Invalid email format during signup", "email", req.Email, "error", err)
@@ -34,7 +94,7 @@ func (s *AuthService) Signup(req *dto.SignupRequest) (*dto.SignupResponse, error
 	}
 
 	// Validate password
-	if err := auth.ValidatePassword(req.Password); err != nil {
+	if err := auth.ValidatePassword(req.Password, s.breachChecker); err != nil {
 		slog.Warn("Invalid password during signup", "error", err)
 		return nil, err
 	}
@@ -42,11 +102,16 @@ func (s *AuthService) Signup(req *dto.SignupRequest) (*dto.SignupResponse, error
 	// Normalize email
 	normalizedEmail := auth.NormalizeEmail(req.Email)
 
+	invitation, err := s.findInvitation(req.InviteToken, normalizedEmail)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if user already exists
 	existingUser, err := s.userRepo.FindByEmail(normalizedEmail)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+	if err != nil && !stderrors.Is(err, gorm.ErrRecordNotFound) {
 		slog.Error("Failed to check existing user", "email", normalizedEmail, "error", err)
-		return nil, errors.New("internal server error")
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to check existing user")
 	}
 	if existingUser != nil {
 		slog.Warn("User already exists", "email", normalizedEmail)
@@ -54,34 +119,46 @@ func (s *AuthService) Signup(req *dto.SignupRequest) (*dto.SignupResponse, error
 	}
 
 	// Hash password
-	hashedPassword, err := auth.HashPassword(req.Password)
+	hashedPassword, err := s.passwordHasher.Hash(req.Password)
 	if err != nil {
 		slog.Error("Failed to hash password", "error", err)
-		return nil, errors.New("internal server error")
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to hash password")
 	}
 
 	// Create user
+	passwordChangedAt := s.clock.Now()
 	user := &model.User{
-		ProviderType:   "email",
-		ProviderUserID: nil,
-		Email:          &normalizedEmail,
-		DisplayName:    req.DisplayName,
-		PasswordHash:   &hashedPassword,
-		EmailVerified:  false,
-		IsDeleted:      false,
+		ProviderType:      "email",
+		ProviderUserID:    nil,
+		Email:             &normalizedEmail,
+		DisplayName:       req.DisplayName,
+		PasswordHash:      &hashedPassword,
+		PasswordChangedAt: &passwordChangedAt,
+		EmailVerified:     false,
+		IsDeleted:         false,
 	}
 
 	createdUser, err := s.userRepo.Create(user)
+	if stderrors.Is(err, repository.ErrDuplicateEmail) {
+		// A concurrent signup registered the email after the existence check
+		slog.Warn("User already exists", "email", normalizedEmail)
+		return nil, auth.ErrUserAlreadyExists
+	}
 	if err != nil {
 		slog.Error("Failed to create user", "email", normalizedEmail, "error", err)
-		return nil, errors.New("internal server error")
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to create user")
 	}
 
 	slog.Info("User created successfully", "user_id", createdUser.ID, "email", normalizedEmail)
 
+	if err := s.acceptInvitation(invitation, createdUser.ID); err != nil {
+		return nil, err
+	}
+
 	// Return response
 	response := &dto.SignupResponse{
-		ID:            createdUser.ID,
+		ID:            createdUser.PublicID,
+		UserID:        createdUser.ID,
 		Email:         normalizedEmail,
 		DisplayName:   createdUser.DisplayName,
 		EmailVerified: createdUser.EmailVerified,
@@ -91,6 +168,36 @@ func (s *AuthService) Signup(req *dto.SignupRequest) (*dto.SignupResponse, error
 	return response, nil
 }
 
+// SignupWithSession creates a new user account and its first session in one transaction,
+// so a failed session leaves no account behind
+func (s *AuthService) SignupWithSession(
+	ctx context.Context,
+	req *dto.SignupRequest,
+	metadata SessionMetadata,
+) (*dto.SignupResponse, *auth.TokenPair, error) {
+	var response *dto.SignupResponse
+	var tokenPair *auth.TokenPair
+
+	err := s.txManager.WithTransaction(ctx, func(repos *repository.Repositories) error {
+		var err error
+		response, err = s.withRepositories(repos).signup(req)
+		if err != nil {
+			return err
+		}
+
+		tokenPair, _, err = createSession(
+			repos.Sessions, repos.Users, s.jwtService, s.clock, s.tokens, response.UserID, metadata,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.publishUserCreated(ctx, response.ID, response.Email, response.DisplayName, "email")
+	return response, tokenPair, nil
+}
+
 // Login authenticates a user and returns user information
 func (s *AuthService) Login(req *dto.LoginRequest) (*dto.UserInfo, error) {
 	// Validate email format
@@ -105,12 +212,12 @@ func (s *AuthService) Login(req *dto.LoginRequest) (*dto.UserInfo, error) {
 	// Find user by email
 	user, err := s.userRepo.FindByEmail(normalizedEmail)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+		if stderrors.Is(err, gorm.ErrRecordNotFound) {
 			slog.Warn("Login attempt with non-existent email", "email", normalizedEmail)
 			return nil, auth.ErrInvalidCredentials
 		}
 		slog.Error("Failed to find user during login", "email", normalizedEmail, "error", err)
-		return nil, errors.New("internal server error")
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to find user during login")
 	}
 
 	// Check if user is deleted
@@ -126,129 +233,397 @@ func (s *AuthService) Login(req *dto.LoginRequest) (*dto.UserInfo, error) {
 	}
 
 	// Verify password
-	if !auth.CheckPasswordHash(req.Password, *user.PasswordHash) {
+	if !s.passwordHasher.Verify(req.Password, *user.PasswordHash) {
 		slog.Warn("Invalid password during login", "user_id", user.ID, "email", normalizedEmail)
 		return nil, auth.ErrInvalidCredentials
 	}
 
+	// Check if an admin disabled the account
+	if user.IsDisabled {
+		slog.Warn("Login attempt with disabled user", "user_id", user.ID, "email", normalizedEmail)
+		return nil, auth.ErrAccountDisabled
+	}
+
+	if s.passwordHasher.NeedsRehash(*user.PasswordHash) {
+		s.rehashPassword(user, req.Password)
+	}
+
 	slog.Info("User logged in successfully", "user_id", user.ID, "email", normalizedEmail)
 
 	// Return user info
 	userInfo := &dto.UserInfo{
-		ID:            user.ID,
+		ID:            user.PublicID,
+		UserID:        user.ID,
 		Email:         normalizedEmail,
 		DisplayName:   user.DisplayName,
 		EmailVerified: user.EmailVerified,
+		AvatarURL:     user.AvatarURL,
 	}
 
 	return userInfo, nil
 }
 
+// rehashPassword replaces the stored hash of user with one using the configured algorithm and
+// parameters. The password itself is unchanged, so PasswordChangedAt is kept and no session is
+// invalidated. Failures are logged and leave the old hash, which still verifies.
+func (s *AuthService) rehashPassword(user *model.User, password string) {
+	hashedPassword, err := s.passwordHasher.Hash(password)
+	if err != nil {
+		slog.Error("Failed to rehash password", "user_id", user.ID, "error", err)
+		return
+	}
+
+	user.PasswordHash = &hashedPassword
+	if err := s.userRepo.Update(user); err != nil {
+		slog.Error("Failed to store rehashed password", "user_id", user.ID, "error", err)
+		return
+	}
+	slog.Info("Password rehashed with the current parameters", "user_id", user.ID)
+}
+
 // GoogleSignup creates a new user account using Google OAuth
 func (s *AuthService) GoogleSignup(req *dto.GoogleSignupRequest) (*dto.SignupResponse, error) {
 	// Validate and get user info from Google
-	var googleUserInfo *oauth.GoogleUserInfo
-	if s.googleOAuth != nil {
-		ui, err := s.googleOAuth.GetUserInfo(req.AccessToken)
-		if err != nil {
-			slog.Warn("Failed to get Google user info during signup", "error", err)
-			return nil, errors.New("invalid access token")
+	googleUserInfo, err := s.resolveGoogleUser(req.IDToken, req.AccessToken)
+	if err != nil {
+		slog.Warn("Failed to get Google user info during signup", "error", err)
+		return nil, auth.ErrInvalidGoogleToken
+	}
+
+	createdUser, err := s.createInvitedOAuthUser("google", googleUserInfo, req.InviteToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	response := &dto.SignupResponse{
+		ID:            createdUser.PublicID,
+		UserID:        createdUser.ID,
+		Email:         *createdUser.Email,
+		DisplayName:   createdUser.DisplayName,
+		EmailVerified: createdUser.EmailVerified,
+		CreatedAt:     createdUser.CreatedAt,
+	}
+
+	return response, nil
+}
+
+// GoogleLogin authenticates a user using Google OAuth and returns user information
+func (s *AuthService) GoogleLogin(req *dto.GoogleLoginRequest) (*dto.UserInfo, error) {
+	// Validate and get user info from Google
+	googleUserInfo, err := s.resolveGoogleUser(req.IDToken, req.AccessToken)
+	if err != nil {
+		slog.Warn("Failed to get Google user info during login", "error", err)
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	user, err := s.findOAuthUser("google", googleUserInfo)
+	if err != nil {
+		if stderrors.Is(err, auth.ErrUserNotFound) {
+			return nil, auth.ErrInvalidCredentials
 		}
-		googleUserInfo = ui
-	} else {
-		// Fallback for tests where googleOAuth is not injected
-		if req.AccessToken == "" {
-			return nil, errors.New("invalid access token")
+		return nil, err
+	}
+
+	return toUserInfo(user), nil
+}
+
+// GoogleAuthorize starts a server-side authorization code flow with Google
+func (s *AuthService) GoogleAuthorize() (*oauth.GoogleAuthorization, error) {
+	if s.googleOAuth == nil {
+		return nil, oauth.ErrCodeFlowNotConfigured
+	}
+	return s.googleOAuth.StartAuthorization()
+}
+
+// GoogleCallback completes the authorization code flow, signing the Google user in
+// and creating their account on first use, unless registration is closed
+func (s *AuthService) GoogleCallback(code, state, stateToken string) (*dto.UserInfo, error) {
+	if s.googleOAuth == nil {
+		return nil, oauth.ErrCodeFlowNotConfigured
+	}
+
+	googleUserInfo, err := s.googleOAuth.CompleteAuthorization(context.Background(), code, state, stateToken)
+	if err != nil {
+		if stderrors.Is(err, oauth.ErrCodeFlowNotConfigured) {
+			return nil, err
 		}
-		googleUserInfo = &oauth.GoogleUserInfo{
-			ID:            "google_id_123",
-			Email:         "test@example.com",
-			VerifiedEmail: true,
-			Name:          "Test User",
+		slog.Warn("Failed to complete Google authorization", "error", err)
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	user, err := s.findOAuthUser("google", googleUserInfo)
+	if stderrors.Is(err, auth.ErrUserNotFound) {
+		// The redirect cannot carry an invite token, so closed registration rules out new accounts here
+		if s.closedRegistration {
+			slog.Warn("Google sign-in for an unregistered user while registration is closed")
+			return nil, auth.ErrInvitationRequired
+		}
+		user, err = s.createOAuthUser("google", googleUserInfo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserInfo(user), nil
+}
+
+// ProviderSignup creates a new user account using a registered OAuth provider
+func (s *AuthService) ProviderSignup(provider string, req *dto.OAuthRequest) (*dto.SignupResponse, error) {
+	providerName, userInfo, err := s.resolveProviderUser(provider, req)
+	if err != nil {
+		return nil, err
+	}
+
+	createdUser, err := s.createInvitedOAuthUser(providerName, userInfo, req.InviteToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SignupResponse{
+		ID:            createdUser.PublicID,
+		UserID:        createdUser.ID,
+		Email:         *createdUser.Email,
+		DisplayName:   createdUser.DisplayName,
+		EmailVerified: createdUser.EmailVerified,
+		CreatedAt:     createdUser.CreatedAt,
+	}, nil
+}
+
+// ProviderLogin authenticates a user using a registered OAuth provider
+func (s *AuthService) ProviderLogin(provider string, req *dto.OAuthRequest) (*dto.UserInfo, error) {
+	providerName, userInfo, err := s.resolveProviderUser(provider, req)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.findOAuthUser(providerName, userInfo)
+	if err != nil {
+		if stderrors.Is(err, auth.ErrUserNotFound) {
+			return nil, auth.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	return toUserInfo(user), nil
+}
+
+// resolveProviderUser identifies the provider user behind the supplied code or access token.
+// It returns oauth.ErrUnknownProvider and oauth.ErrProviderNotConfigured unchanged and
+// auth.ErrInvalidCredentials for any credential the provider rejects.
+func (s *AuthService) resolveProviderUser(provider string, req *dto.OAuthRequest) (string, *oauth.UserInfo, error) {
+	if s.providers == nil {
+		return "", nil, oauth.ErrUnknownProvider
+	}
+	p, err := s.providers.Get(provider)
+	if err != nil {
+		return "", nil, err
+	}
+
+	accessToken := req.AccessToken
+	if req.Code != "" {
+		accessToken, err = p.ExchangeCode(req.Code, req.CodeVerifier)
+		if err != nil {
+			if stderrors.Is(err, oauth.ErrProviderNotConfigured) {
+				return "", nil, err
+			}
+			slog.Warn("Failed to exchange authorization code", "provider", p.ProviderName(), "error", err)
+			return "", nil, auth.ErrInvalidCredentials
 		}
 	}
 
+	userInfo, err := p.GetUserInfo(accessToken)
+	if err != nil {
+		if stderrors.Is(err, oauth.ErrProviderNotConfigured) {
+			return "", nil, err
+		}
+		slog.Warn("Failed to get OAuth user info", "provider", p.ProviderName(), "error", err)
+		return "", nil, auth.ErrInvalidCredentials
+	}
+
+	return p.ProviderName(), userInfo, nil
+}
+
+// createOAuthUser registers a new user for an account at the named provider
+func (s *AuthService) createOAuthUser(providerName string, providerUserInfo *oauth.UserInfo) (*model.User, error) {
 	// Normalize email
-	normalizedEmail := auth.NormalizeEmail(googleUserInfo.Email)
+	normalizedEmail := auth.NormalizeEmail(providerUserInfo.Email)
 
 	// Check if user already exists
 	existingUser, err := s.userRepo.FindByEmail(normalizedEmail)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+	if err != nil && !stderrors.Is(err, gorm.ErrRecordNotFound) {
 		slog.Error("Failed to check existing user", "email", normalizedEmail, "error", err)
-		return nil, errors.New("internal server error")
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to check existing user")
 	}
 	if existingUser != nil {
 		slog.Warn("User already exists", "email", normalizedEmail)
 		return nil, auth.ErrUserAlreadyExists
 	}
 
-	// Create user with Google provider
+	// Check if the provider account is already linked to another user
+	linkedUser, err := s.findLinkedUser(providerName, providerUserInfo.ID)
+	if err != nil && !stderrors.Is(err, auth.ErrUserNotFound) {
+		return nil, err
+	}
+	if linkedUser != nil {
+		slog.Warn("Provider account is already linked", "provider", providerName, "user_id", linkedUser.ID)
+		return nil, auth.ErrUserAlreadyExists
+	}
+
+	// Create user with the OAuth provider
 	user := &model.User{
-		ProviderType:   "google",
-		ProviderUserID: &googleUserInfo.ID,
+		ProviderType:   providerName,
+		ProviderUserID: &providerUserInfo.ID,
 		Email:          &normalizedEmail,
-		DisplayName:    googleUserInfo.Name,
-		PasswordHash:   nil, // Google users don't have passwords
-		EmailVerified:  googleUserInfo.VerifiedEmail,
+		DisplayName:    providerUserInfo.Name,
+		PasswordHash:   nil, // OAuth users don't have passwords
+		EmailVerified:  providerUserInfo.VerifiedEmail,
 		IsDeleted:      false,
 	}
 
 	createdUser, err := s.userRepo.Create(user)
+	if stderrors.Is(err, repository.ErrDuplicateEmail) {
+		// A concurrent signup registered the email after the existence check
+		slog.Warn("User already exists", "email", normalizedEmail)
+		return nil, auth.ErrUserAlreadyExists
+	}
 	if err != nil {
 		slog.Error("Failed to create user", "email", normalizedEmail, "error", err)
-		return nil, errors.New("internal server error")
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to create user")
+	}
+	if createdUser.Email == nil {
+		createdUser.Email = &normalizedEmail
 	}
 
-	slog.Info("Google user created successfully", "user_id", createdUser.ID, "email", normalizedEmail)
+	slog.Info("OAuth user created successfully", "provider", providerName, "user_id", createdUser.ID,
+		"email", normalizedEmail)
+	s.publishUserCreated(context.Background(),
+		createdUser.PublicID, normalizedEmail, createdUser.DisplayName, providerName)
+	return createdUser, nil
+}
 
-	// Return response
-	response := &dto.SignupResponse{
-		ID:            createdUser.ID,
-		Email:         normalizedEmail,
-		DisplayName:   createdUser.DisplayName,
-		EmailVerified: createdUser.EmailVerified,
-		CreatedAt:     createdUser.CreatedAt,
+// createInvitedOAuthUser registers a new user for an account at the named provider, checking the
+// invitation against the provider's email address and accepting it once the user exists
+func (s *AuthService) createInvitedOAuthUser(
+	providerName string,
+	providerUserInfo *oauth.UserInfo,
+	inviteToken string,
+) (*model.User, error) {
+	invitation, err := s.findInvitation(inviteToken, auth.NormalizeEmail(providerUserInfo.Email))
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	createdUser, err := s.createOAuthUser(providerName, providerUserInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// The user exists by now and keeps the email address the invitation was for, so the invitation
+	// cannot be used by anyone else even when it could not be marked as accepted
+	if err := s.acceptInvitation(invitation, createdUser.ID); err != nil {
+		slog.Error("Failed to accept invitation after OAuth signup", "invitation_id", invitation.PublicID,
+			"user_id", createdUser.ID, "error", err)
+	}
+	return createdUser, nil
 }
 
-// GoogleLogin authenticates a user using Google OAuth and returns user information
-func (s *AuthService) GoogleLogin(req *dto.GoogleLoginRequest) (*dto.UserInfo, error) {
-	// Validate and get user info from Google
-	var googleUserInfo *oauth.GoogleUserInfo
-	if s.googleOAuth != nil {
-		ui, err := s.googleOAuth.GetUserInfo(req.AccessToken)
-		if err != nil {
-			slog.Warn("Failed to get Google user info during login", "error", err)
-			return nil, auth.ErrInvalidCredentials
+// findInvitation returns the usable invitation for email that inviteToken names. Without a token it
+// returns nil, or auth.ErrInvitationRequired when registration is closed; a token that is invalid,
+// expired, already used or for another email address gives auth.ErrInvalidInvitation.
+func (s *AuthService) findInvitation(inviteToken, email string) (*model.Invitation, error) {
+	if inviteToken == "" {
+		if s.closedRegistration {
+			slog.Warn("Signup without an invitation while registration is closed", "email", email)
+			return nil, auth.ErrInvitationRequired
 		}
-		googleUserInfo = ui
-	} else {
-		// Fallback for tests where googleOAuth is not injected
-		if req.AccessToken == "" {
-			return nil, auth.ErrInvalidCredentials
+		return nil, nil
+	}
+
+	claims, err := s.jwtService.ValidateInviteToken(inviteToken)
+	if err != nil || s.invitationRepo == nil {
+		slog.Warn("Invalid invite token during signup", "email", email, "error", err)
+		return nil, auth.ErrInvalidInvitation
+	}
+
+	invitation, err := s.invitationRepo.GetByPublicID(claims.ID)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrInvitationNotFound) {
+			slog.Warn("Unknown invitation during signup", "invitation_id", claims.ID, "email", email)
+			return nil, auth.ErrInvalidInvitation
 		}
-		googleUserInfo = &oauth.GoogleUserInfo{
-			ID:            "google_id_123",
-			Email:         "test@example.com",
-			VerifiedEmail: true,
-			Name:          "Test User",
+		slog.Error("Failed to find invitation", "invitation_id", claims.ID, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to find invitation")
+	}
+
+	if !invitation.IsUsable(s.clock.Now()) || invitation.Email != email {
+		slog.Warn("Unusable invitation during signup", "invitation_id", invitation.PublicID, "email", email)
+		return nil, auth.ErrInvalidInvitation
+	}
+
+	return invitation, nil
+}
+
+// acceptInvitation marks the invitation, if any, as used by the new user. It returns
+// auth.ErrInvalidInvitation when a concurrent signup used it first or it has just expired.
+func (s *AuthService) acceptInvitation(invitation *model.Invitation, userID uint) error {
+	if invitation == nil {
+		return nil
+	}
+
+	if err := s.invitationRepo.Accept(invitation.ID, userID); err != nil {
+		if stderrors.Is(err, repository.ErrInvitationNotFound) {
+			slog.Warn("Invitation was used or expired during signup", "invitation_id", invitation.PublicID)
+			return auth.ErrInvalidInvitation
+		}
+		slog.Error("Failed to accept invitation", "invitation_id", invitation.PublicID, "error", err)
+		return errors.Wrap(errors.ErrCodeInternalError, err, "failed to accept invitation")
+	}
+
+	slog.Info("Invitation accepted", "invitation_id", invitation.PublicID, "user_id", userID)
+	return nil
+}
+
+// publishUserCreated announces a newly registered user on the event bus
+func (s *AuthService) publishUserCreated(ctx context.Context, publicID, email, displayName, providerType string) {
+	s.bus.Publish(ctx, events.New(events.UserCreated, events.UserData{
+		ID:           publicID,
+		Email:        email,
+		DisplayName:  displayName,
+		ProviderType: providerType,
+	}))
+}
+
+// findOAuthUser looks up the active user registered for, or linked to, an account at the named provider.
+// It returns auth.ErrUserNotFound when the account is not linked and no user has its email address.
+func (s *AuthService) findOAuthUser(providerName string, providerUserInfo *oauth.UserInfo) (*model.User, error) {
+	// Accounts linked to an existing user log in as that user, whatever their email address
+	linkedUser, err := s.findLinkedUser(providerName, providerUserInfo.ID)
+	if !stderrors.Is(err, auth.ErrUserNotFound) {
+		if err != nil {
+			return nil, err
 		}
+		if linkedUser.IsDisabled {
+			slog.Warn("Login attempt with disabled user", "user_id", linkedUser.ID, "provider", providerName)
+			return nil, auth.ErrAccountDisabled
+		}
+		slog.Info("Linked OAuth user logged in successfully", "provider", providerName, "user_id", linkedUser.ID)
+		return linkedUser, nil
 	}
 
 	// Normalize email
-	normalizedEmail := auth.NormalizeEmail(googleUserInfo.Email)
+	normalizedEmail := auth.NormalizeEmail(providerUserInfo.Email)
 
 	// Find user by email and provider
 	user, err := s.userRepo.FindByEmail(normalizedEmail)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			slog.Warn("Login attempt with non-existent Google account", "email", normalizedEmail)
-			return nil, auth.ErrInvalidCredentials
+		if stderrors.Is(err, gorm.ErrRecordNotFound) {
+			slog.Warn("Login attempt with non-existent OAuth account", "provider", providerName, "email", normalizedEmail)
+			return nil, auth.ErrUserNotFound
 		}
-		slog.Error("Failed to find user during Google login", "email", normalizedEmail, "error", err)
-		return nil, errors.New("internal server error")
+		slog.Error("Failed to find user during OAuth login", "provider", providerName, "email", normalizedEmail,
+			"error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to find user during OAuth login")
 	}
 
 	// Check if user is deleted
@@ -257,8 +632,9 @@ func (s *AuthService) GoogleLogin(req *dto.GoogleLoginRequest) (*dto.UserInfo, e
 		return nil, auth.ErrInvalidCredentials
 	}
 
-	// Verify this is a Google user
-	if user.ProviderType != "google" || user.ProviderUserID == nil || *user.ProviderUserID != googleUserInfo.ID {
+	// Verify the user registered with this provider account
+	if user.ProviderType != providerName || user.ProviderUserID == nil ||
+		*user.ProviderUserID != providerUserInfo.ID {
 		slog.Warn(
 			"Login attempt with wrong provider",
 			"user_id", user.ID,
@@ -268,15 +644,165 @@ func (s *AuthService) GoogleLogin(req *dto.GoogleLoginRequest) (*dto.UserInfo, e
 		return nil, auth.ErrInvalidCredentials
 	}
 
-	slog.Info("Google user logged in successfully", "user_id", user.ID, "email", normalizedEmail)
+	// Check if an admin disabled the account
+	if user.IsDisabled {
+		slog.Warn("Login attempt with disabled user", "user_id", user.ID, "email", normalizedEmail)
+		return nil, auth.ErrAccountDisabled
+	}
 
-	// Return user info
+	user.Email = &normalizedEmail
+	slog.Info("OAuth user logged in successfully", "provider", providerName, "user_id", user.ID,
+		"email", normalizedEmail)
+	return user, nil
+}
+
+// findLinkedUser looks up the user that a provider account has been linked to.
+// It returns auth.ErrUserNotFound when the account is not linked to anyone.
+func (s *AuthService) findLinkedUser(providerName, providerUserID string) (*model.User, error) {
+	if s.identityRepo == nil {
+		return nil, auth.ErrUserNotFound
+	}
+
+	identity, err := s.identityRepo.FindByProvider(providerName, providerUserID)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrIdentityNotFound) {
+			return nil, auth.ErrUserNotFound
+		}
+		slog.Error("Failed to find linked identity", "provider", providerName, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to find linked identity")
+	}
+
+	user, err := s.userRepo.GetByID(identity.UserID)
+	if err != nil {
+		slog.Error("Failed to find user of linked identity", "user_id", identity.UserID, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to find user of linked identity")
+	}
+
+	if user.IsDeleted {
+		slog.Warn("Login attempt with deleted user", "user_id", user.ID, "provider", providerName)
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	return user, nil
+}
+
+// LinkGoogleIdentity links a Google account to an existing user, so that the user
+// can also log in with Google
+func (s *AuthService) LinkGoogleIdentity(userID uint, req *dto.LinkGoogleIdentityRequest) (*dto.IdentityInfo, error) {
+	googleUserInfo, err := s.resolveGoogleUser(req.IDToken, req.AccessToken)
+	if err != nil {
+		slog.Warn("Failed to get Google user info during identity linking", "user_id", userID, "error", err)
+		return nil, auth.ErrInvalidCredentials
+	}
+
+	return s.linkIdentity(userID, "google", googleUserInfo)
+}
+
+// linkIdentity links a provider account to a user. A user can link at most one account per provider,
+// and an account can belong to only one user.
+func (s *AuthService) linkIdentity(userID uint, providerName string, providerUserInfo *oauth.UserInfo) (
+	*dto.IdentityInfo, error,
+) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		slog.Error("Failed to find user for identity linking", "user_id", userID, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to find user for identity linking")
+	}
+	if user.ProviderType == providerName {
+		slog.Warn("User signed up with the provider being linked", "user_id", userID, "provider", providerName)
+		return nil, auth.ErrIdentityAlreadyLinked
+	}
+
+	// The account must not be linked already, to this or any other user
+	_, err = s.identityRepo.FindByProvider(providerName, providerUserInfo.ID)
+	if err == nil {
+		slog.Warn("Provider account is already linked", "user_id", userID, "provider", providerName)
+		return nil, auth.ErrIdentityAlreadyLinked
+	}
+	if !stderrors.Is(err, repository.ErrIdentityNotFound) {
+		slog.Error("Failed to find linked identity", "provider", providerName, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to find linked identity")
+	}
+
+	// The account must not be another user's sign-up provider
+	normalizedEmail := auth.NormalizeEmail(providerUserInfo.Email)
+	owner, err := s.userRepo.FindByEmail(normalizedEmail)
+	if err != nil && !stderrors.Is(err, gorm.ErrRecordNotFound) {
+		slog.Error("Failed to check existing user", "email", normalizedEmail, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to check existing user")
+	}
+	if owner != nil && owner.ID != userID && owner.ProviderType == providerName &&
+		owner.ProviderUserID != nil && *owner.ProviderUserID == providerUserInfo.ID {
+		slog.Warn("Provider account belongs to another user", "user_id", userID, "provider", providerName)
+		return nil, auth.ErrIdentityAlreadyLinked
+	}
+
+	// A user can link only one account per provider
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		slog.Error("Failed to list identities", "user_id", userID, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to list identities")
+	}
+	for _, identity := range identities {
+		if identity.Provider == providerName {
+			slog.Warn("User already linked an account at the provider", "user_id", userID, "provider", providerName)
+			return nil, auth.ErrIdentityAlreadyLinked
+		}
+	}
+
+	identity := &model.UserIdentity{
+		UserID:         userID,
+		Provider:       providerName,
+		ProviderUserID: providerUserInfo.ID,
+		Email:          &normalizedEmail,
+	}
+	if err := s.identityRepo.Create(identity); err != nil {
+		slog.Error("Failed to link identity", "user_id", userID, "provider", providerName, "error", err)
+		return nil, errors.Wrap(errors.ErrCodeInternalError, err, "failed to link identity")
+	}
+
+	slog.Info("Identity linked successfully", "user_id", userID, "provider", providerName)
+	return &dto.IdentityInfo{
+		Provider: identity.Provider,
+		Email:    normalizedEmail,
+		LinkedAt: identity.CreatedAt,
+	}, nil
+}
+
+// toUserInfo converts a user model to the basic user information returned by login endpoints
+func toUserInfo(user *model.User) *dto.UserInfo {
 	userInfo := &dto.UserInfo{
-		ID:            user.ID,
-		Email:         normalizedEmail,
+		ID:            user.PublicID,
+		UserID:        user.ID,
 		DisplayName:   user.DisplayName,
 		EmailVerified: user.EmailVerified,
+		AvatarURL:     user.AvatarURL,
+	}
+	if user.Email != nil {
+		userInfo.Email = *user.Email
 	}
+	return userInfo
+}
 
-	return userInfo, nil
+// resolveGoogleUser identifies the Google user behind the supplied credentials.
+// ID tokens are verified locally and preferred; access tokens fall back to the userinfo endpoint,
+// which cannot prove the token was issued to this application.
+func (s *AuthService) resolveGoogleUser(idToken, accessToken string) (*oauth.UserInfo, error) {
+	if s.googleOAuth == nil {
+		// Fallback for tests where googleOAuth is not injected
+		if idToken == "" && accessToken == "" {
+			return nil, stderrors.New("no Google credentials provided")
+		}
+		return &oauth.UserInfo{
+			ID:            "google_id_123",
+			Email:         "test@example.com",
+			VerifiedEmail: true,
+			Name:          "Test User",
+		}, nil
+	}
+
+	if idToken != "" {
+		return s.googleOAuth.VerifyIDToken(idToken)
+	}
+	return s.googleOAuth.GetUserInfo(accessToken)
 }
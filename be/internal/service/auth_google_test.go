@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
 	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
 	"strikepad-backend/internal/model"
 	"strikepad-backend/internal/oauth"
 	"strikepad-backend/internal/repository/mocks"
@@ -19,12 +21,20 @@ type MockGoogleOAuthService struct {
 	mock.Mock
 }
 
-func (m *MockGoogleOAuthService) GetUserInfo(accessToken string) (*oauth.GoogleUserInfo, error) {
+func (m *MockGoogleOAuthService) GetUserInfo(accessToken string) (*oauth.UserInfo, error) {
 	args := m.Called(accessToken)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*oauth.GoogleUserInfo), args.Error(1)
+	return args.Get(0).(*oauth.UserInfo), args.Error(1)
+}
+
+func (m *MockGoogleOAuthService) VerifyIDToken(idToken string) (*oauth.UserInfo, error) {
+	args := m.Called(idToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*oauth.UserInfo), args.Error(1)
 }
 
 func (m *MockGoogleOAuthService) ValidateAccessToken(accessToken string) error {
@@ -36,6 +46,7 @@ func TestAuthService_GoogleSignup(t *testing.T) {
 	mockUserRepo := &mocks.MockUserRepository{}
 	authService := &AuthService{
 		userRepo: mockUserRepo,
+		bus:      events.NewBus(),
 	}
 
 	tests := []struct {
@@ -110,6 +121,7 @@ func TestAuthService_GoogleLogin(t *testing.T) {
 	mockUserRepo := &mocks.MockUserRepository{}
 	authService := &AuthService{
 		userRepo: mockUserRepo,
+		bus:      events.NewBus(),
 	}
 
 	tests := []struct {
@@ -138,6 +150,30 @@ func TestAuthService_GoogleLogin(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "successful Google login with ID token",
+			request: &dto.GoogleLoginRequest{
+				IDToken: "valid_id_token",
+			},
+			setupMocks: func() {
+				googleUserID := "google_id_123"
+				user := &model.User{
+					ID:             1,
+					Email:          &[]string{"test@example.com"}[0],
+					DisplayName:    "Test User",
+					ProviderType:   "google",
+					ProviderUserID: &googleUserID,
+					EmailVerified:  true,
+				}
+				mockUserRepo.On("FindByEmail", "test@example.com").Return(user, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "no Google credentials",
+			request:       &dto.GoogleLoginRequest{},
+			expectedError: auth.ErrInvalidCredentials,
+		},
 		{
 			name: "user not found",
 			request: &dto.GoogleLoginRequest{
@@ -192,3 +228,28 @@ func TestAuthService_GoogleLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_GoogleCodeFlowNotConfigured(t *testing.T) {
+	authService := &AuthService{
+		userRepo: &mocks.MockUserRepository{},
+	}
+
+	authorization, err := authService.GoogleAuthorize()
+	assert.ErrorIs(t, err, oauth.ErrCodeFlowNotConfigured)
+	assert.Nil(t, authorization)
+
+	userInfo, err := authService.GoogleCallback("code", "state", "state-token")
+	assert.ErrorIs(t, err, oauth.ErrCodeFlowNotConfigured)
+	assert.Nil(t, userInfo)
+}
+
+func TestNewAuthService_UsesTheRegisteredGoogleProvider(t *testing.T) {
+	authService := NewAuthService(
+		&mocks.MockUserRepository{}, nil, nil, nil, oauth.NewRegistry(oauth.NewSandboxGoogleService()), nil, nil, nil,
+		events.NewBus(), clock.New(), auth.NewTokenGenerator(),
+	)
+
+	authorization, err := authService.GoogleAuthorize()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, authorization.URL)
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestAuthService_LinkGoogleIdentity(t *testing.T) {
+	emailUser := &model.User{ID: 1, ProviderType: "email", Email: strPtr("owner@example.com")}
+
+	tests := []struct {
+		expectedError error
+		setupMocks    func(*mocks.MockUserRepository, *mocks.MockIdentityRepositoryInterface)
+		name          string
+	}{
+		{
+			name: "links Google account to email user",
+			setupMocks: func(userRepo *mocks.MockUserRepository, identityRepo *mocks.MockIdentityRepositoryInterface) {
+				userRepo.On("GetByID", uint(1)).Return(emailUser, nil)
+				identityRepo.EXPECT().FindByProvider("google", "google_id_123").
+					Return(nil, repository.ErrIdentityNotFound).Once()
+				userRepo.On("FindByEmail", "test@example.com").Return(nil, gorm.ErrRecordNotFound)
+				identityRepo.EXPECT().ListByUserID(uint(1)).Return(nil, nil).Once()
+				identityRepo.EXPECT().Create(mock.MatchedBy(func(identity *model.UserIdentity) bool {
+					return identity.UserID == 1 && identity.Provider == "google" && identity.ProviderUserID == "google_id_123"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "user signed up with Google",
+			setupMocks: func(userRepo *mocks.MockUserRepository, _ *mocks.MockIdentityRepositoryInterface) {
+				userRepo.On("GetByID", uint(1)).Return(&model.User{ID: 1, ProviderType: "google"}, nil)
+			},
+			expectedError: auth.ErrIdentityAlreadyLinked,
+		},
+		{
+			name: "account already linked to a user",
+			setupMocks: func(userRepo *mocks.MockUserRepository, identityRepo *mocks.MockIdentityRepositoryInterface) {
+				userRepo.On("GetByID", uint(1)).Return(emailUser, nil)
+				identityRepo.EXPECT().FindByProvider("google", "google_id_123").
+					Return(&model.UserIdentity{UserID: 2}, nil).Once()
+			},
+			expectedError: auth.ErrIdentityAlreadyLinked,
+		},
+		{
+			name: "account is another user's sign-up provider",
+			setupMocks: func(userRepo *mocks.MockUserRepository, identityRepo *mocks.MockIdentityRepositoryInterface) {
+				userRepo.On("GetByID", uint(1)).Return(emailUser, nil)
+				identityRepo.EXPECT().FindByProvider("google", "google_id_123").
+					Return(nil, repository.ErrIdentityNotFound).Once()
+				userRepo.On("FindByEmail", "test@example.com").Return(&model.User{
+					ID:             2,
+					ProviderType:   "google",
+					ProviderUserID: strPtr("google_id_123"),
+				}, nil)
+			},
+			expectedError: auth.ErrIdentityAlreadyLinked,
+		},
+		{
+			name: "user already linked another Google account",
+			setupMocks: func(userRepo *mocks.MockUserRepository, identityRepo *mocks.MockIdentityRepositoryInterface) {
+				userRepo.On("GetByID", uint(1)).Return(emailUser, nil)
+				identityRepo.EXPECT().FindByProvider("google", "google_id_123").
+					Return(nil, repository.ErrIdentityNotFound).Once()
+				userRepo.On("FindByEmail", "test@example.com").Return(nil, gorm.ErrRecordNotFound)
+				identityRepo.EXPECT().ListByUserID(uint(1)).
+					Return([]model.UserIdentity{{Provider: "google", ProviderUserID: "other"}}, nil).Once()
+			},
+			expectedError: auth.ErrIdentityAlreadyLinked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &mocks.MockUserRepository{}
+			identityRepo := mocks.NewMockIdentityRepositoryInterface(t)
+			tt.setupMocks(userRepo, identityRepo)
+			authService := &AuthService{userRepo: userRepo, identityRepo: identityRepo}
+
+			result, err := authService.LinkGoogleIdentity(1, &dto.LinkGoogleIdentityRequest{IDToken: "valid_id_token"})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "google", result.Provider)
+				assert.Equal(t, "test@example.com", result.Email)
+			}
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthService_GoogleLoginWithLinkedIdentity(t *testing.T) {
+	userRepo := &mocks.MockUserRepository{}
+	identityRepo := mocks.NewMockIdentityRepositoryInterface(t)
+	authService := &AuthService{userRepo: userRepo, identityRepo: identityRepo}
+
+	identityRepo.EXPECT().FindByProvider("google", "google_id_123").
+		Return(&model.UserIdentity{UserID: 1, Provider: "google", ProviderUserID: "google_id_123"}, nil).Once()
+	userRepo.On("GetByID", uint(1)).Return(&model.User{
+		ID:           1,
+		ProviderType: "email",
+		Email:        strPtr("owner@example.com"),
+		DisplayName:  "Owner",
+	}, nil)
+
+	result, err := authService.GoogleLogin(&dto.GoogleLoginRequest{IDToken: "valid_id_token"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), result.UserID)
+	assert.Equal(t, "owner@example.com", result.Email)
+	userRepo.AssertExpectations(t)
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestAuthService_Signup_Invitation(t *testing.T) {
+	jwtService := auth.NewJWTService(clock.New())
+	inviteToken, expiresAt, err := jwtService.GenerateInviteToken("inv_3m8q1x5k9d2v7h0c4b6n", 72*time.Hour)
+	require.NoError(t, err)
+	acceptedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		invitation         *model.Invitation
+		acceptErr          error
+		expectedErr        error
+		name               string
+		inviteToken        string
+		closedRegistration bool
+		expectCreate       bool
+		expectAccept       bool
+	}{
+		{
+			name:               "closed registration requires an invitation",
+			closedRegistration: true,
+			expectedErr:        auth.ErrInvitationRequired,
+		},
+		{
+			name:         "open registration without an invitation",
+			expectCreate: true,
+		},
+		{
+			name:               "valid invitation",
+			closedRegistration: true,
+			inviteToken:        inviteToken,
+			invitation:         &model.Invitation{ID: 3, Email: "invited@example.com", ExpiresAt: expiresAt},
+			expectCreate:       true,
+			expectAccept:       true,
+		},
+		{
+			name:        "invalid token",
+			inviteToken: "not-a-token",
+			expectedErr: auth.ErrInvalidInvitation,
+		},
+		{
+			name:        "invitation for another email address",
+			inviteToken: inviteToken,
+			invitation:  &model.Invitation{ID: 3, Email: "someone-else@example.com", ExpiresAt: expiresAt},
+			expectedErr: auth.ErrInvalidInvitation,
+		},
+		{
+			name:        "invitation already accepted",
+			inviteToken: inviteToken,
+			invitation: &model.Invitation{
+				ID: 3, Email: "invited@example.com", ExpiresAt: expiresAt, AcceptedAt: &acceptedAt,
+			},
+			expectedErr: auth.ErrInvalidInvitation,
+		},
+		{
+			name:         "invitation used by a concurrent signup",
+			inviteToken:  inviteToken,
+			invitation:   &model.Invitation{ID: 3, Email: "invited@example.com", ExpiresAt: expiresAt},
+			acceptErr:    repository.ErrInvitationNotFound,
+			expectCreate: true,
+			expectAccept: true,
+			expectedErr:  auth.ErrInvalidInvitation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.closedRegistration {
+				t.Setenv("CLOSED_REGISTRATION", "true")
+			}
+
+			email := "invited@example.com"
+			userRepo := new(mocks.MockUserRepository)
+			invitationRepo := mocks.NewMockInvitationRepositoryInterface(t)
+			if tt.invitation != nil {
+				invitationRepo.EXPECT().GetByPublicID("inv_3m8q1x5k9d2v7h0c4b6n").Return(tt.invitation, nil).Once()
+			}
+			if tt.expectCreate {
+				userRepo.On("FindByEmail", email).Return(nil, gorm.ErrRecordNotFound).Once()
+				userRepo.On("Create", mock.AnythingOfType("*model.User")).
+					Return(&model.User{ID: 7, PublicID: "usr_5k2d9x0q7m3hv8c1b4na", Email: &email}, nil).Once()
+			}
+			if tt.expectAccept {
+				invitationRepo.EXPECT().Accept(uint(3), uint(7)).Return(tt.acceptErr).Once()
+			}
+
+			authService := service.NewAuthService(
+				userRepo, nil, invitationRepo, nil, nil, jwtService, auth.NewPasswordHasher(), nil,
+				events.NewBus(), clock.New(), auth.NewTokenGenerator(),
+			)
+			response, err := authService.Signup(&dto.SignupRequest{
+				Email:       "Invited@example.com",
+				Password:    testServicePasswordConst,
+				DisplayName: "Invited User",
+				InviteToken: tt.inviteToken,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, response)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, uint(7), response.UserID)
+			}
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/oauth"
+	"strikepad-backend/internal/repository/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// stubProvider is an OAuth provider that accepts a fixed code and access token
+type stubProvider struct {
+	userInfo *oauth.UserInfo
+	name     string
+}
+
+func (p *stubProvider) ProviderName() string {
+	return p.name
+}
+
+func (p *stubProvider) ExchangeCode(code, _ string) (string, error) {
+	if code != "valid_code" {
+		return "", errors.New("invalid_grant")
+	}
+	return "valid_token", nil
+}
+
+func (p *stubProvider) GetUserInfo(accessToken string) (*oauth.UserInfo, error) {
+	if accessToken != "valid_token" {
+		return nil, errors.New("unauthorized")
+	}
+	return p.userInfo, nil
+}
+
+func newProviderTestService(userRepo *mocks.MockUserRepository) *AuthService {
+	return &AuthService{
+		userRepo: userRepo,
+		bus:      events.NewBus(),
+		providers: oauth.NewRegistry(&stubProvider{
+			name: "github",
+			userInfo: &oauth.UserInfo{
+				ID:            "12345",
+				Email:         "Octocat@Example.com",
+				Name:          "Octocat",
+				VerifiedEmail: true,
+			},
+		}),
+	}
+}
+
+func TestAuthService_ProviderSignup(t *testing.T) {
+	tests := []struct {
+		expectedError error
+		request       *dto.OAuthRequest
+		setupMocks    func(*mocks.MockUserRepository)
+		name          string
+		provider      string
+	}{
+		{
+			name:     "successful signup with access token",
+			provider: "github",
+			request:  &dto.OAuthRequest{AccessToken: "valid_token"},
+			setupMocks: func(userRepo *mocks.MockUserRepository) {
+				userRepo.On("FindByEmail", "octocat@example.com").Return(nil, gorm.ErrRecordNotFound)
+				userRepo.On("Create", mock.MatchedBy(func(user *model.User) bool {
+					return user.ProviderType == "github" && *user.ProviderUserID == "12345" && user.EmailVerified
+				})).Return(&model.User{ID: 1, DisplayName: "Octocat", EmailVerified: true}, nil)
+			},
+		},
+		{
+			name:     "successful signup with authorization code",
+			provider: "github",
+			request:  &dto.OAuthRequest{Code: "valid_code", CodeVerifier: "verifier"},
+			setupMocks: func(userRepo *mocks.MockUserRepository) {
+				userRepo.On("FindByEmail", "octocat@example.com").Return(nil, gorm.ErrRecordNotFound)
+				userRepo.On("Create", mock.AnythingOfType("*model.User")).Return(&model.User{ID: 1}, nil)
+			},
+		},
+		{
+			name:          "unknown provider",
+			provider:      "myspace",
+			request:       &dto.OAuthRequest{AccessToken: "valid_token"},
+			expectedError: oauth.ErrUnknownProvider,
+		},
+		{
+			name:          "rejected authorization code",
+			provider:      "github",
+			request:       &dto.OAuthRequest{Code: "stolen_code"},
+			expectedError: auth.ErrInvalidCredentials,
+		},
+		{
+			name:          "rejected access token",
+			provider:      "github",
+			request:       &dto.OAuthRequest{AccessToken: "expired_token"},
+			expectedError: auth.ErrInvalidCredentials,
+		},
+		{
+			name:     "user already exists",
+			provider: "github",
+			request:  &dto.OAuthRequest{AccessToken: "valid_token"},
+			setupMocks: func(userRepo *mocks.MockUserRepository) {
+				userRepo.On("FindByEmail", "octocat@example.com").Return(&model.User{ID: 1}, nil)
+			},
+			expectedError: auth.ErrUserAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &mocks.MockUserRepository{}
+			if tt.setupMocks != nil {
+				tt.setupMocks(userRepo)
+			}
+
+			result, err := newProviderTestService(userRepo).ProviderSignup(tt.provider, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "octocat@example.com", result.Email)
+			}
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthService_ProviderLogin(t *testing.T) {
+	githubUserID := "12345"
+	email := "octocat@example.com"
+
+	tests := []struct {
+		expectedError error
+		user          *model.User
+		findErr       error
+		name          string
+	}{
+		{
+			name: "successful login",
+			user: &model.User{
+				ID:             1,
+				Email:          &email,
+				ProviderType:   "github",
+				ProviderUserID: &githubUserID,
+			},
+		},
+		{
+			name:          "user not found",
+			findErr:       gorm.ErrRecordNotFound,
+			expectedError: auth.ErrInvalidCredentials,
+		},
+		{
+			name: "registered with another provider",
+			user: &model.User{
+				ID:             1,
+				Email:          &email,
+				ProviderType:   "google",
+				ProviderUserID: &githubUserID,
+			},
+			expectedError: auth.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &mocks.MockUserRepository{}
+			userRepo.On("FindByEmail", email).Return(tt.user, tt.findErr)
+
+			result, err := newProviderTestService(userRepo).ProviderLogin("github", &dto.OAuthRequest{
+				AccessToken: "valid_token",
+			})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(1), result.UserID)
+			}
+			userRepo.AssertExpectations(t)
+		})
+	}
+}
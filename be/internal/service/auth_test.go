@@ -1,17 +1,24 @@
 package service_test
 
 import (
+	"context"
 	"testing"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
 	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/errors"
+	"strikepad-backend/internal/events"
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
 	"strikepad-backend/internal/repository/mocks"
 	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/testutil/factory"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
@@ -28,7 +35,10 @@ type AuthServiceTestSuite struct {
 
 func (suite *AuthServiceTestSuite) SetupTest() {
 	suite.mockUserRepo = new(mocks.MockUserRepository)
-	suite.authService = service.NewAuthService(suite.mockUserRepo)
+	suite.authService = service.NewAuthService(
+		suite.mockUserRepo, nil, nil, nil, nil, nil, auth.NewPasswordHasher(), nil, events.NewBus(), clock.New(),
+		auth.NewTokenGenerator(),
+	)
 }
 
 func (suite *AuthServiceTestSuite) TearDownTest() {
@@ -99,6 +109,24 @@ func (suite *AuthServiceTestSuite) TestSignup() {
 			expectedErrMsg: "",
 			checkResult:    false,
 		},
+		{
+			name: "Email registered by a concurrent signup",
+			request: &dto.SignupRequest{
+				Email:       "race@example.com",
+				Password:    testServicePasswordConst,
+				DisplayName: "Test User",
+			},
+			mockSetup: func() {
+				// Mock: the existence check passes but the unique index rejects the insert
+				suite.mockUserRepo.On("FindByEmail", "race@example.com").Return(nil, gorm.ErrRecordNotFound)
+				suite.mockUserRepo.On("Create", mock.MatchedBy(func(user *model.User) bool {
+					return *user.Email == "race@example.com"
+				})).Return(nil, repository.ErrDuplicateEmail)
+			},
+			expectedError:  auth.ErrUserAlreadyExists,
+			expectedErrMsg: "",
+			checkResult:    false,
+		},
 		{
 			name: "Invalid email",
 			request: &dto.SignupRequest{
@@ -146,9 +174,8 @@ func (suite *AuthServiceTestSuite) TestSignup() {
 				// Mock: FindByEmail returns a database error
 				suite.mockUserRepo.On("FindByEmail", "dberror-signup@example.com").Return(nil, assert.AnError)
 			},
-			expectedError:  nil,
-			expectedErrMsg: "internal server error",
-			checkResult:    false,
+			expectedError: errors.Wrap(errors.ErrCodeInternalError, assert.AnError, "failed to check existing user"),
+			checkResult:   false,
 		},
 		{
 			name: "Repository create error",
@@ -170,9 +197,8 @@ func (suite *AuthServiceTestSuite) TestSignup() {
 						!user.EmailVerified
 				})).Return(nil, assert.AnError)
 			},
-			expectedError:  nil,
-			expectedErrMsg: "internal server error", // Service converts to generic error
-			checkResult:    false,
+			expectedError: errors.Wrap(errors.ErrCodeInternalError, assert.AnError, "failed to create user"),
+			checkResult:   false,
 		},
 	}
 
@@ -205,7 +231,7 @@ func (suite *AuthServiceTestSuite) TestSignup() {
 						DisplayName:   "Test User",
 						EmailVerified: false,
 					}
-					assert.Equal(t, expectedUser.ID, result.ID)
+					assert.Equal(t, expectedUser.ID, result.UserID)
 					assert.Equal(t, *expectedUser.Email, result.Email)
 					assert.Equal(t, expectedUser.DisplayName, result.DisplayName)
 					assert.Equal(t, expectedUser.EmailVerified, result.EmailVerified)
@@ -235,14 +261,7 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 				Password: correctPassword,
 			},
 			mockSetup: func() {
-				email := testServiceEmailConst
-				existingUser := &model.User{
-					ID:           1,
-					ProviderType: "email",
-					Email:        &email,
-					DisplayName:  "Test User",
-					PasswordHash: &hashedPassword,
-				}
+				existingUser := factory.NewUser().WithPasswordHash(hashedPassword).Build()
 				// Mock: FindByEmail returns the user
 				suite.mockUserRepo.On("FindByEmail", testServiceEmailConst).Return(existingUser, nil)
 			},
@@ -271,14 +290,7 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 				Password: "WrongPassword456!",
 			},
 			mockSetup: func() {
-				email := testServiceEmailConst
-				existingUser := &model.User{
-					ID:           1,
-					ProviderType: "email",
-					Email:        &email,
-					DisplayName:  "Test User",
-					PasswordHash: &hashedPassword,
-				}
+				existingUser := factory.NewUser().WithPasswordHash(hashedPassword).Build()
 				// Mock: FindByEmail returns the user
 				suite.mockUserRepo.On("FindByEmail", testServiceEmailConst).Return(existingUser, nil)
 			},
@@ -306,15 +318,7 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 				Password: correctPassword,
 			},
 			mockSetup: func() {
-				email := "deleted@example.com"
-				existingUser := &model.User{
-					ID:           1,
-					ProviderType: "email",
-					Email:        &email,
-					DisplayName:  "Test User",
-					PasswordHash: &hashedPassword,
-					IsDeleted:    true,
-				}
+				existingUser := factory.NewUser().WithEmail("deleted@example.com").WithPasswordHash(hashedPassword).Deleted().Build()
 				// Mock: FindByEmail returns a deleted user
 				suite.mockUserRepo.On("FindByEmail", "deleted@example.com").Return(existingUser, nil)
 			},
@@ -322,6 +326,21 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 			expectedErrMsg: "",
 			checkResult:    false,
 		},
+		{
+			name: "User is disabled",
+			request: &dto.LoginRequest{
+				Email:    "disabled@example.com",
+				Password: correctPassword,
+			},
+			mockSetup: func() {
+				existingUser := factory.NewUser().WithEmail("disabled@example.com").WithPasswordHash(hashedPassword).Disabled().Build()
+				// Mock: FindByEmail returns a user disabled by an admin
+				suite.mockUserRepo.On("FindByEmail", "disabled@example.com").Return(existingUser, nil)
+			},
+			expectedError:  auth.ErrAccountDisabled,
+			expectedErrMsg: "",
+			checkResult:    false,
+		},
 		{
 			name: "User without password hash",
 			request: &dto.LoginRequest{
@@ -329,14 +348,7 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 				Password: correctPassword,
 			},
 			mockSetup: func() {
-				email := "oauth@example.com"
-				existingUser := &model.User{
-					ID:           1,
-					ProviderType: "oauth",
-					Email:        &email,
-					DisplayName:  "Test User",
-					PasswordHash: nil, // No password hash
-				}
+				existingUser := factory.NewUser().WithEmail("oauth@example.com").WithGoogle().Build()
 				// Mock: FindByEmail returns a user without password hash
 				suite.mockUserRepo.On("FindByEmail", "oauth@example.com").Return(existingUser, nil)
 			},
@@ -354,9 +366,8 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 				// Mock: FindByEmail returns a database error
 				suite.mockUserRepo.On("FindByEmail", "dberror@example.com").Return(nil, assert.AnError)
 			},
-			expectedError:  nil,
-			expectedErrMsg: "internal server error",
-			checkResult:    false,
+			expectedError: errors.Wrap(errors.ErrCodeInternalError, assert.AnError, "failed to find user during login"),
+			checkResult:   false,
 		},
 	}
 
@@ -389,7 +400,7 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 						Email:       &email,
 						DisplayName: "Test User",
 					}
-					assert.Equal(t, existingUser.ID, result.ID)
+					assert.Equal(t, existingUser.ID, result.UserID)
 					assert.Equal(t, *existingUser.Email, result.Email)
 					assert.Equal(t, existingUser.DisplayName, result.DisplayName)
 				}
@@ -398,9 +409,68 @@ func (suite *AuthServiceTestSuite) TestLogin() {
 	}
 }
 
+func (suite *AuthServiceTestSuite) TestLogin_RehashesOutdatedPasswordHash() {
+	outdatedHash, err := bcrypt.GenerateFromPassword([]byte(testServicePasswordConst), bcrypt.MinCost)
+	suite.Require().NoError(err)
+	user := factory.NewUser().WithPasswordHash(string(outdatedHash)).Build()
+	passwordChangedAt := user.PasswordChangedAt
+
+	suite.mockUserRepo.On("FindByEmail", testServiceEmailConst).Return(user, nil)
+	suite.mockUserRepo.On("Update", mock.MatchedBy(func(updated *model.User) bool {
+		cost, err := bcrypt.Cost([]byte(*updated.PasswordHash))
+		return err == nil && cost == auth.DefaultCost && auth.CheckPasswordHash(testServicePasswordConst, *updated.PasswordHash)
+	})).Return(nil).Once()
+
+	result, err := suite.authService.Login(&dto.LoginRequest{Email: testServiceEmailConst, Password: testServicePasswordConst})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), user.PublicID, result.ID)
+	assert.Equal(suite.T(), passwordChangedAt, user.PasswordChangedAt, "rehashing does not change the password")
+}
+
+func (suite *AuthServiceTestSuite) TestLogin_RehashFailureDoesNotFailLogin() {
+	outdatedHash, err := bcrypt.GenerateFromPassword([]byte(testServicePasswordConst), bcrypt.MinCost)
+	suite.Require().NoError(err)
+	user := factory.NewUser().WithPasswordHash(string(outdatedHash)).Build()
+
+	suite.mockUserRepo.On("FindByEmail", testServiceEmailConst).Return(user, nil)
+	suite.mockUserRepo.On("Update", mock.Anything).Return(assert.AnError).Once()
+
+	result, err := suite.authService.Login(&dto.LoginRequest{Email: testServiceEmailConst, Password: testServicePasswordConst})
+
+	suite.Require().NoError(err)
+	assert.NotNil(suite.T(), result)
+}
+
+type breachedPasswords map[string]bool
+
+func (b breachedPasswords) IsBreached(password string) (bool, error) {
+	return b[password], nil
+}
+
+func (suite *AuthServiceTestSuite) TestSignup_BreachedPassword() {
+	authService := service.NewAuthService(
+		suite.mockUserRepo, nil, nil, nil, nil, nil, auth.NewPasswordHasher(),
+		breachedPasswords{testServicePasswordConst: true}, events.NewBus(), clock.New(), auth.NewTokenGenerator(),
+	)
+
+	result, err := authService.Signup(&dto.SignupRequest{
+		Email:       testServiceEmailConst,
+		Password:    testServicePasswordConst,
+		DisplayName: "Test User",
+	})
+
+	assert.Equal(suite.T(), auth.ErrPasswordBreached, err)
+	assert.Nil(suite.T(), result)
+	suite.mockUserRepo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
 func (suite *AuthServiceTestSuite) TestNewAuthService() {
 	// Test that NewAuthService creates a valid service
-	svc := service.NewAuthService(suite.mockUserRepo)
+	svc := service.NewAuthService(
+		suite.mockUserRepo, nil, nil, nil, nil, nil, nil, nil, events.NewBus(), clock.New(),
+		auth.NewTokenGenerator(),
+	)
 	assert.NotNil(suite.T(), svc)
 }
 
@@ -437,13 +507,8 @@ func (suite *AuthServiceTestSuite) TestEmailNormalization() {
 				Password: password,
 			}
 
-			existingUser := &model.User{
-				ID:           1,
-				ProviderType: "email",
-				Email:        &tc.normalizedEmail, // Stored email is normalized
-				DisplayName:  "Test User",
-				PasswordHash: &hashedPassword,
-			}
+			// Stored email is normalized
+			existingUser := factory.NewUser().WithEmail(tc.normalizedEmail).WithPasswordHash(hashedPassword).Build()
 
 			// Mock: FindByEmail should be called with normalized email
 			suite.mockUserRepo.On("FindByEmail", tc.normalizedEmail).Return(existingUser, nil)
@@ -462,3 +527,80 @@ func (suite *AuthServiceTestSuite) TestEmailNormalization() {
 func TestAuthServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(AuthServiceTestSuite))
 }
+
+func TestAuthService_SignupWithSession(t *testing.T) {
+	request := &dto.SignupRequest{
+		Email:       "tx@example.com",
+		Password:    testServicePasswordConst,
+		DisplayName: "Tx User",
+	}
+
+	tests := []struct {
+		sessionErr error
+		name       string
+	}{
+		{
+			name: "creates user and session",
+		},
+		{
+			name:       "session failure aborts the transaction",
+			sessionErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The transaction repositories are the only ones used
+			txUserRepo := new(mocks.MockUserRepository)
+			txSessionRepo := new(mocks.MockSessionRepository)
+			txManager := mocks.NewMockTxManagerInterface(t)
+			txManager.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, fn func(repos *repository.Repositories) error) error {
+					return fn(&repository.Repositories{Users: txUserRepo, Sessions: txSessionRepo})
+				}).Once()
+
+			email := "tx@example.com"
+			txUserRepo.On("FindByEmail", email).Return(nil, gorm.ErrRecordNotFound)
+			txUserRepo.On("Create", mock.AnythingOfType("*model.User")).
+				Return(&model.User{ID: 7, PublicID: "usr_5k2d9x0q7m3hv8c1b4na", Email: &email, DisplayName: "Tx User"}, nil)
+			txSessionRepo.On("Create", mock.MatchedBy(func(session *model.UserSession) bool {
+				return session.UserID == 7 && session.IPAddress == "203.0.113.1"
+			})).Return(tt.sessionErr)
+
+			var published []events.Event
+			bus := events.NewBus()
+			bus.Subscribe(events.UserCreated, func(_ context.Context, event events.Event) {
+				published = append(published, event)
+			})
+
+			authService := service.NewAuthService(
+				new(mocks.MockUserRepository), nil, nil, txManager, nil, auth.NewJWTService(clock.New()),
+				auth.NewPasswordHasher(), nil, bus, clock.New(), auth.NewTokenGenerator(),
+			)
+			response, tokenPair, err := authService.SignupWithSession(
+				context.Background(), request, service.SessionMetadata{IPAddress: "203.0.113.1"},
+			)
+
+			if tt.sessionErr != nil {
+				assert.ErrorIs(t, err, tt.sessionErr)
+				assert.Nil(t, response)
+				assert.Nil(t, tokenPair)
+				assert.Empty(t, published)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(7), response.UserID)
+				assert.NotEmpty(t, tokenPair.AccessToken)
+				if assert.Len(t, published, 1) {
+					assert.Equal(t, events.UserData{
+						ID:           "usr_5k2d9x0q7m3hv8c1b4na",
+						Email:        "tx@example.com",
+						DisplayName:  "Tx User",
+						ProviderType: "email",
+					}, published[0].Data)
+				}
+			}
+			txUserRepo.AssertExpectations(t)
+			txSessionRepo.AssertExpectations(t)
+		})
+	}
+}
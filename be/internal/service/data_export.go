@@ -0,0 +1,390 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/storage"
+)
+
+const (
+	// dataExportClaimLease is how long an export may be processing before another worker takes it over
+	dataExportClaimLease = 15 * time.Minute
+	// dataExportBatchSize is the number of exports a worker assembles per poll
+	dataExportBatchSize = 5
+	// maxExportedSessions and maxExportedAuditEntries bound the size of an archive
+	maxExportedSessions     = 1000
+	maxExportedAuditEntries = 10000
+	// dataExportContentType is the content type of export archives
+	dataExportContentType = "application/zip"
+)
+
+// DataExportArchive is the archive of a ready data export
+type DataExportArchive struct {
+	Filename string
+	Body     []byte
+}
+
+// DataExportService assembles archives of users' data in the background, gives out temporary
+// links to download them, and deletes them once they expire
+type DataExportService struct {
+	dataExportRepo repository.DataExportRepositoryInterface
+	userRepo       repository.UserRepository
+	profileRepo    repository.ProfileRepositoryInterface
+	identityRepo   repository.IdentityRepositoryInterface
+	sessionRepo    repository.SessionRepositoryInterface
+	auditLogRepo   repository.AuditLogRepositoryInterface
+	storage        storage.Storage
+	jwtService     *auth.JWTService
+	pollInterval   time.Duration
+	retention      time.Duration
+	linkTTL        time.Duration
+}
+
+// NewDataExportService creates a new data export service
+func NewDataExportService(
+	dataExportRepo repository.DataExportRepositoryInterface,
+	userRepo repository.UserRepository,
+	profileRepo repository.ProfileRepositoryInterface,
+	identityRepo repository.IdentityRepositoryInterface,
+	sessionRepo repository.SessionRepositoryInterface,
+	auditLogRepo repository.AuditLogRepositoryInterface,
+	store storage.Storage,
+	jwtService *auth.JWTService,
+) DataExportServiceInterface {
+	return &DataExportService{
+		dataExportRepo: dataExportRepo,
+		userRepo:       userRepo,
+		profileRepo:    profileRepo,
+		identityRepo:   identityRepo,
+		sessionRepo:    sessionRepo,
+		auditLogRepo:   auditLogRepo,
+		storage:        store,
+		jwtService:     jwtService,
+		pollInterval:   time.Duration(config.GetEnvInt("DATA_EXPORT_POLL_INTERVAL_SECONDS", 10)) * time.Second,
+		retention:      time.Duration(config.GetEnvInt("DATA_EXPORT_RETENTION_HOURS", 48)) * time.Hour,
+		linkTTL:        time.Duration(config.GetEnvInt("DATA_EXPORT_LINK_TTL_MINUTES", 15)) * time.Minute,
+	}
+}
+
+// RequestExport starts an export of the user's data. While an earlier export is still being
+// assembled, that one is returned instead of starting another.
+func (s *DataExportService) RequestExport(userID uint) (*dto.DataExportResponse, error) {
+	export, err := s.dataExportRepo.FindActiveByUserID(userID)
+	if err == nil {
+		return s.toResponse(export)
+	}
+	if !errors.Is(err, repository.ErrDataExportNotFound) {
+		return nil, err
+	}
+
+	export = &model.DataExport{UserID: userID}
+	if err := s.dataExportRepo.Create(export); err != nil {
+		return nil, err
+	}
+	slog.Info("Data export requested", "user_id", userID, "export_id", export.PublicID)
+	return s.toResponse(export)
+}
+
+// GetExport returns one of the user's exports, with a fresh download link once it is ready.
+// Exports of other users are not found (repository.ErrDataExportNotFound).
+func (s *DataExportService) GetExport(userID uint, publicID string) (*dto.DataExportResponse, error) {
+	export, err := s.dataExportRepo.GetByPublicID(userID, publicID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(export)
+}
+
+// Download returns the archive of the export with publicID through a download link. The token in
+// the link must have been issued for that export; it also identifies the user.
+func (s *DataExportService) Download(ctx context.Context, publicID, token string) (*DataExportArchive, error) {
+	claims, err := s.jwtService.ValidateDataExportToken(token)
+	if err == nil && claims.ID != publicID {
+		err = errors.New("token was issued for another export")
+	}
+	if err != nil {
+		slog.Warn("Invalid data export token", "error", err, "export_id", publicID)
+		return nil, auth.ErrInvalidDataExportToken
+	}
+
+	export, err := s.dataExportRepo.GetByPublicID(claims.UserID, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isDownloadable(export, time.Now()) {
+		return nil, fmt.Errorf("data export is %s: %w", export.Status, repository.ErrDataExportNotFound)
+	}
+
+	body, err := s.storage.Get(ctx, *export.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("data export archive is gone: %w", repository.ErrDataExportNotFound)
+		}
+		return nil, fmt.Errorf("failed to read data export archive: %w", err)
+	}
+	return &DataExportArchive{
+		Filename: "strikepad-export-" + export.PublicID + ".zip",
+		Body:     body,
+	}, nil
+}
+
+// Run assembles pending exports and deletes expired ones every poll interval until ctx is canceled
+func (s *DataExportService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ProcessPending(ctx); err != nil {
+			slog.Error("Failed to process data exports", "error", err)
+		}
+		if err := s.deleteExpired(ctx); err != nil {
+			slog.Error("Failed to delete expired data exports", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessPending claims up to one batch of pending exports, assembles and stores their archives,
+// and returns how many became ready. An export that cannot be assembled is marked as failed; the
+// user can request a new one.
+func (s *DataExportService) ProcessPending(ctx context.Context) (int, error) {
+	exports, err := s.dataExportRepo.ClaimPending(time.Now(), dataExportBatchSize, dataExportClaimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	ready := 0
+	for i := range exports {
+		// Unprocessed exports are claimed again once their lease expires
+		if ctx.Err() != nil {
+			break
+		}
+		export := &exports[i]
+		if err := s.process(ctx, export); err != nil {
+			slog.Error("Data export failed", "error", err, "export_id", export.PublicID, "user_id", export.UserID)
+			if err := s.dataExportRepo.MarkFailed(export.ID, err.Error()); err != nil {
+				slog.Error("Failed to mark data export as failed", "error", err, "export_id", export.PublicID)
+			}
+			continue
+		}
+		ready++
+	}
+	return ready, nil
+}
+
+func (s *DataExportService) process(ctx context.Context, export *model.DataExport) error {
+	archive, err := s.buildArchive(export)
+	if err != nil {
+		return err
+	}
+
+	key := storage.PrivatePrefix + "exports/" + export.PublicID + ".zip"
+	if err := s.storage.Put(ctx, key, archive, dataExportContentType); err != nil {
+		return fmt.Errorf("failed to store data export archive: %w", err)
+	}
+
+	completedAt := time.Now()
+	if err := s.dataExportRepo.MarkReady(export.ID, key, int64(len(archive)), completedAt,
+		completedAt.Add(s.retention)); err != nil {
+		return err
+	}
+	slog.Info("Data export ready", "export_id", export.PublicID, "user_id", export.UserID, "size_bytes", len(archive))
+	return nil
+}
+
+// exportedProfile is profile.json of an export archive
+type exportedProfile struct {
+	User       *model.User          `json:"user"`
+	Onboarding *model.UserProfile   `json:"onboarding"`
+	Identities []model.UserIdentity `json:"linked_accounts"`
+}
+
+// exportedSession is an entry of sessions.json of an export archive
+type exportedSession struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	EndedAt    *time.Time `json:"ended_at,omitempty"`
+	DeviceName *string    `json:"device_name,omitempty"`
+	Country    *string    `json:"country,omitempty"`
+	ID         string     `json:"id"`
+	IPAddress  string     `json:"ip_address"`
+	UserAgent  string     `json:"user_agent"`
+}
+
+// exportedAuditEntry is an entry of audit_log.json of an export archive
+type exportedAuditEntry struct {
+	CreatedAt time.Time       `json:"created_at"`
+	ActorID   *string         `json:"actor_id,omitempty"`
+	TargetID  *string         `json:"target_id,omitempty"`
+	IPAddress *string         `json:"ip_address,omitempty"`
+	UserAgent *string         `json:"user_agent,omitempty"`
+	ID        string          `json:"id"`
+	Action    string          `json:"action"`
+	Metadata  json.RawMessage `json:"metadata"`
+}
+
+// buildArchive collects the user's data into a ZIP archive of JSON files
+func (s *DataExportService) buildArchive(export *model.DataExport) ([]byte, error) {
+	user, err := s.userRepo.GetByID(export.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+
+	profile := exportedProfile{User: user, Identities: []model.UserIdentity{}}
+	onboarding, err := s.profileRepo.FindByUserID(user.ID)
+	switch {
+	case err == nil:
+		profile.Onboarding = onboarding
+	case !errors.Is(err, repository.ErrProfileNotFound):
+		return nil, fmt.Errorf("failed to load profile: %w", err)
+	}
+	identities, err := s.identityRepo.ListByUserID(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load linked accounts: %w", err)
+	}
+	if identities != nil {
+		profile.Identities = identities
+	}
+
+	userSessions, err := s.sessionRepo.FindRecentByUserID(user.ID, time.Time{}, 0, maxExportedSessions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sessions: %w", err)
+	}
+	sessions := make([]exportedSession, 0, len(userSessions))
+	for _, session := range userSessions {
+		exported := exportedSession{
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			DeviceName: session.DeviceName,
+			Country:    session.Country,
+			ID:         session.PublicID,
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+		}
+		if session.DeletedAt.Valid {
+			exported.EndedAt = &session.DeletedAt.Time
+		}
+		sessions = append(sessions, exported)
+	}
+
+	entries, err := s.auditLogRepo.ListByUser(user.PublicID, maxExportedAuditEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load audit log: %w", err)
+	}
+	auditLog := make([]exportedAuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		auditLog = append(auditLog, exportedAuditEntry{
+			CreatedAt: entry.CreatedAt,
+			ActorID:   entry.ActorID,
+			TargetID:  entry.TargetID,
+			IPAddress: entry.IPAddress,
+			UserAgent: entry.UserAgent,
+			ID:        entry.PublicID,
+			Action:    entry.Action,
+			Metadata:  json.RawMessage(entry.Metadata),
+		})
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	files := []struct {
+		content any
+		name    string
+	}{
+		{name: "export.json", content: map[string]any{
+			"id":          export.PublicID,
+			"user_id":     user.PublicID,
+			"exported_at": time.Now().UTC(),
+		}},
+		{name: "profile.json", content: profile},
+		{name: "sessions.json", content: sessions},
+		{name: "audit_log.json", content: auditLog},
+	}
+	for _, file := range files {
+		w, err := archive.Create(file.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s: %w", file.name, err)
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// deleteExpired deletes the archives and records of exports whose retention has passed
+func (s *DataExportService) deleteExpired(ctx context.Context) error {
+	exports, err := s.dataExportRepo.ListExpired(time.Now(), dataExportBatchSize*10)
+	if err != nil {
+		return err
+	}
+	for _, export := range exports {
+		if export.StorageKey != nil {
+			if err := s.storage.Delete(ctx, *export.StorageKey); err != nil {
+				return fmt.Errorf("failed to delete data export archive: %w", err)
+			}
+		}
+		if err := s.dataExportRepo.Delete(export.ID); err != nil {
+			return err
+		}
+	}
+	if len(exports) > 0 {
+		slog.Info("Expired data exports deleted", "count", len(exports))
+	}
+	return nil
+}
+
+// toResponse describes export, signing a download link when the archive can be downloaded
+func (s *DataExportService) toResponse(export *model.DataExport) (*dto.DataExportResponse, error) {
+	response := &dto.DataExportResponse{
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+		SizeBytes:   export.SizeBytes,
+		ID:          export.PublicID,
+		Status:      export.Status,
+	}
+	if !isDownloadable(export, time.Now()) {
+		return response, nil
+	}
+
+	// The link never outlives the archive
+	ttl := min(s.linkTTL, time.Until(*export.ExpiresAt))
+	token, expiresAt, err := s.jwtService.GenerateDataExportToken(export.UserID, export.PublicID, ttl)
+	if err != nil {
+		return nil, err
+	}
+	downloadURL := "/api/exports/" + url.PathEscape(export.PublicID) + "/download?token=" + url.QueryEscape(token)
+	response.DownloadURL = &downloadURL
+	response.DownloadURLExpiresAt = &expiresAt
+	return response, nil
+}
+
+// isDownloadable reports whether the archive of export is stored and has not expired at now
+func isDownloadable(export *model.DataExport, now time.Time) bool {
+	return export.Status == model.DataExportStatusReady && export.StorageKey != nil &&
+		export.ExpiresAt != nil && now.Before(*export.ExpiresAt)
+}
@@ -0,0 +1,225 @@
+package service_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	storagemocks "strikepad-backend/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const exportPublicID = "exp_9c4m1x7k2d5v8h0q3b6n"
+
+type dataExportMocks struct {
+	dataExportRepo *mocks.MockDataExportRepositoryInterface
+	userRepo       *mocks.MockUserRepository
+	profileRepo    *mocks.MockProfileRepositoryInterface
+	identityRepo   *mocks.MockIdentityRepositoryInterface
+	sessionRepo    *mocks.MockSessionRepository
+	auditLogRepo   *mocks.MockAuditLogRepositoryInterface
+	storage        *storagemocks.MockStorage
+	jwtService     *auth.JWTService
+}
+
+func newDataExportService(t *testing.T) (service.DataExportServiceInterface, *dataExportMocks) {
+	t.Helper()
+
+	m := &dataExportMocks{
+		dataExportRepo: mocks.NewMockDataExportRepositoryInterface(t),
+		userRepo:       mocks.NewMockUserRepository(t),
+		profileRepo:    mocks.NewMockProfileRepositoryInterface(t),
+		identityRepo:   mocks.NewMockIdentityRepositoryInterface(t),
+		sessionRepo:    new(mocks.MockSessionRepository),
+		auditLogRepo:   mocks.NewMockAuditLogRepositoryInterface(t),
+		storage:        storagemocks.NewMockStorage(t),
+		jwtService:     auth.NewJWTService(clock.New()),
+	}
+	t.Cleanup(func() { m.sessionRepo.AssertExpectations(t) })
+	return service.NewDataExportService(m.dataExportRepo, m.userRepo, m.profileRepo, m.identityRepo,
+		m.sessionRepo, m.auditLogRepo, m.storage, m.jwtService), m
+}
+
+func readyExport(expiresAt time.Time) *model.DataExport {
+	key := "private/exports/" + exportPublicID + ".zip"
+	size := int64(7)
+	return &model.DataExport{
+		ID:         3,
+		UserID:     42,
+		PublicID:   exportPublicID,
+		Status:     model.DataExportStatusReady,
+		StorageKey: &key,
+		SizeBytes:  &size,
+		ExpiresAt:  &expiresAt,
+	}
+}
+
+func TestDataExportService_RequestExport(t *testing.T) {
+	t.Run("starts an export", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		m.dataExportRepo.EXPECT().FindActiveByUserID(uint(42)).Return(nil, repository.ErrDataExportNotFound).Once()
+		m.dataExportRepo.EXPECT().Create(mock.AnythingOfType("*model.DataExport")).
+			Run(func(export *model.DataExport) {
+				export.PublicID = exportPublicID
+				export.Status = model.DataExportStatusPending
+			}).
+			Return(nil).Once()
+
+		response, err := exportService.RequestExport(42)
+
+		require.NoError(t, err)
+		assert.Equal(t, exportPublicID, response.ID)
+		assert.Equal(t, model.DataExportStatusPending, response.Status)
+		assert.Nil(t, response.DownloadURL)
+	})
+
+	t.Run("returns the export in progress", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		m.dataExportRepo.EXPECT().FindActiveByUserID(uint(42)).
+			Return(&model.DataExport{PublicID: exportPublicID, Status: model.DataExportStatusProcessing}, nil).Once()
+
+		response, err := exportService.RequestExport(42)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.DataExportStatusProcessing, response.Status)
+	})
+}
+
+func TestDataExportService_ProcessPending(t *testing.T) {
+	email := "user@example.com"
+	user := &model.User{ID: 42, PublicID: "usr_5k2d9x0q7m3hv8c1b4na", Email: &email, DisplayName: "Test User"}
+	actorID := user.PublicID
+
+	t.Run("stores the archive", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		m.dataExportRepo.EXPECT().ClaimPending(mock.Anything, 5, 15*time.Minute).
+			Return([]model.DataExport{{ID: 3, UserID: 42, PublicID: exportPublicID}}, nil).Once()
+		m.userRepo.EXPECT().GetByID(uint(42)).Return(user, nil).Once()
+		m.profileRepo.EXPECT().FindByUserID(uint(42)).Return(nil, repository.ErrProfileNotFound).Once()
+		m.identityRepo.EXPECT().ListByUserID(uint(42)).
+			Return([]model.UserIdentity{{Provider: "github", ProviderUserID: "1234"}}, nil).Once()
+		m.sessionRepo.On("FindRecentByUserID", uint(42), time.Time{}, uint(0), 1000).
+			Return([]*model.UserSession{{PublicID: "ses_7d2k9m4q0x8h3v1c5b6n", IPAddress: "203.0.113.7"}}, nil).Once()
+		m.auditLogRepo.EXPECT().ListByUser(user.PublicID, 10000).
+			Return([]model.AuditLog{{PublicID: "aud_7d2k9m4q0x8h3v1c5b6n", Action: "auth.login_succeeded",
+				ActorID: &actorID, Metadata: `{"method":"password"}`}}, nil).Once()
+		var archive []byte
+		m.storage.EXPECT().Put(mock.Anything, "private/exports/"+exportPublicID+".zip", mock.Anything, "application/zip").
+			RunAndReturn(func(_ context.Context, _ string, body []byte, _ string) error {
+				archive = body
+				return nil
+			}).Once()
+		m.dataExportRepo.EXPECT().MarkReady(uint(3), "private/exports/"+exportPublicID+".zip", mock.Anything,
+			mock.Anything, mock.Anything).Return(nil).Once()
+
+		ready, err := exportService.ProcessPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, ready)
+		reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		require.NoError(t, err)
+		files := make(map[string]string)
+		for _, file := range reader.File {
+			rc, err := file.Open()
+			require.NoError(t, err)
+			content, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			rc.Close()
+			files[file.Name] = string(content)
+		}
+		assert.Len(t, files, 4)
+		assert.Contains(t, files["export.json"], exportPublicID)
+		assert.Contains(t, files["profile.json"], email)
+		assert.Contains(t, files["profile.json"], `"provider": "github"`)
+		assert.NotContains(t, files["profile.json"], "password_hash")
+		assert.Contains(t, files["sessions.json"], "203.0.113.7")
+		var auditLog []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(files["audit_log.json"]), &auditLog))
+		require.Len(t, auditLog, 1)
+		assert.Equal(t, map[string]any{"method": "password"}, auditLog[0]["metadata"])
+	})
+
+	t.Run("marks exports that cannot be assembled as failed", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		m.dataExportRepo.EXPECT().ClaimPending(mock.Anything, 5, 15*time.Minute).
+			Return([]model.DataExport{{ID: 3, UserID: 42, PublicID: exportPublicID}}, nil).Once()
+		m.userRepo.EXPECT().GetByID(uint(42)).Return(nil, assert.AnError).Once()
+		m.dataExportRepo.EXPECT().MarkFailed(uint(3), mock.Anything).Return(nil).Once()
+
+		ready, err := exportService.ProcessPending(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, ready)
+	})
+}
+
+func TestDataExportService_Download(t *testing.T) {
+	t.Run("downloads through the link of a ready export", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		export := readyExport(time.Now().Add(time.Hour))
+		m.dataExportRepo.EXPECT().GetByPublicID(uint(42), exportPublicID).Return(export, nil).Twice()
+		m.storage.EXPECT().Get(mock.Anything, *export.StorageKey).Return([]byte("archive"), nil).Once()
+
+		response, err := exportService.GetExport(42, exportPublicID)
+		require.NoError(t, err)
+		require.NotNil(t, response.DownloadURL)
+		assert.True(t, strings.HasPrefix(*response.DownloadURL, "/api/exports/"+exportPublicID+"/download?token="))
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), *response.DownloadURLExpiresAt, time.Minute)
+		link, err := url.Parse(*response.DownloadURL)
+		require.NoError(t, err)
+
+		archive, err := exportService.Download(context.Background(), exportPublicID, link.Query().Get("token"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(archive.Body))
+		assert.Equal(t, "strikepad-export-"+exportPublicID+".zip", archive.Filename)
+	})
+
+	t.Run("the link never outlives the archive", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		m.dataExportRepo.EXPECT().GetByPublicID(uint(42), exportPublicID).
+			Return(readyExport(time.Now().Add(5*time.Minute)), nil).Once()
+
+		response, err := exportService.GetExport(42, exportPublicID)
+
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(5*time.Minute), *response.DownloadURLExpiresAt, time.Minute)
+	})
+
+	t.Run("rejects a token of another export", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		token, _, err := m.jwtService.GenerateDataExportToken(42, "exp_0123456789abcdefghjk", time.Minute)
+		require.NoError(t, err)
+
+		_, err = exportService.Download(context.Background(), exportPublicID, token)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidDataExportToken)
+	})
+
+	t.Run("expired export", func(t *testing.T) {
+		exportService, m := newDataExportService(t)
+		token, _, err := m.jwtService.GenerateDataExportToken(42, exportPublicID, time.Minute)
+		require.NoError(t, err)
+		m.dataExportRepo.EXPECT().GetByPublicID(uint(42), exportPublicID).
+			Return(readyExport(time.Now().Add(-time.Minute)), nil).Once()
+
+		_, err = exportService.Download(context.Background(), exportPublicID, token)
+
+		assert.ErrorIs(t, err, repository.ErrDataExportNotFound)
+	})
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"errors"
+	"html"
+	"regexp"
+	"slices"
+	"strings"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/mailer"
+)
+
+// ErrEmailCaptureDisabled is returned when the mailer delivers emails instead of keeping them
+var ErrEmailCaptureDisabled = errors.New("email capture is disabled")
+
+// linkPattern matches absolute http(s) URLs in email bodies
+var linkPattern = regexp.MustCompile(`https?://[^\s"'<>]+`)
+
+// DevEmailService lists the emails kept by the sandbox mailer, for development and e2e tests
+type DevEmailService struct {
+	inbox mailer.Inbox
+}
+
+// NewDevEmailService creates a new dev email service. Emails can only be listed when m keeps them.
+func NewDevEmailService(m mailer.Mailer) DevEmailServiceInterface {
+	inbox, _ := m.(mailer.Inbox)
+	return &DevEmailService{
+		inbox: inbox,
+	}
+}
+
+// ListEmails returns the captured emails, newest first. When to is not empty only emails to that
+// address are returned.
+func (s *DevEmailService) ListEmails(to string) (*dto.DevEmailListResponse, error) {
+	if s.inbox == nil {
+		return nil, ErrEmailCaptureDisabled
+	}
+
+	emails := s.inbox.Emails()
+	captured := make([]dto.DevEmail, 0, len(emails))
+	for _, email := range slices.Backward(emails) {
+		if to != "" && !strings.EqualFold(email.To, to) {
+			continue
+		}
+		captured = append(captured, dto.DevEmail{
+			SentAt:  email.SentAt,
+			From:    email.From,
+			To:      email.To,
+			Subject: email.Subject,
+			Text:    email.Text,
+			HTML:    email.HTML,
+			Links:   extractLinks(email.Text, email.HTML),
+		})
+	}
+
+	response := dto.DevEmailListResponse(dto.NewCompleteListResponse(captured))
+	return &response, nil
+}
+
+// extractLinks returns the distinct URLs in the text and HTML bodies in order of appearance
+func extractLinks(text, htmlBody string) []string {
+	links := []string{}
+	for _, body := range []string{text, htmlBody} {
+		for _, link := range linkPattern.FindAllString(body, -1) {
+			link = strings.TrimRight(html.UnescapeString(link), ".,;:!?)")
+			if !slices.Contains(links, link) {
+				links = append(links, link)
+			}
+		}
+	}
+	return links
+}
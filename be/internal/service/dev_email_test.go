@@ -0,0 +1,49 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevEmailService_ListEmails(t *testing.T) {
+	inbox := mailer.NewInboxMailer("StrikePad <no-reply@localhost>")
+	ctx := context.Background()
+	require.NoError(t, inbox.Send(ctx, mailer.Message{
+		To:      "alice@sandbox.test",
+		Subject: "Verify your email address",
+		Text:    "Open https://app.example.com/verify?token=abc&user=1.",
+		HTML:    `<a href="https://app.example.com/verify?token=abc&amp;user=1">Verify</a> <a href="https://app.example.com/help">Help</a>`,
+	}))
+	require.NoError(t, inbox.Send(ctx, mailer.Message{To: "bob@sandbox.test", Subject: "Welcome", Text: "Hello"}))
+
+	devEmailService := service.NewDevEmailService(inbox)
+
+	response, err := devEmailService.ListEmails("")
+	require.NoError(t, err)
+	require.Len(t, response.Data, 2)
+	assert.Equal(t, "bob@sandbox.test", response.Data[0].To)
+	assert.Empty(t, response.Data[0].Links)
+
+	response, err = devEmailService.ListEmails("Alice@Sandbox.test")
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	assert.Equal(t, "Verify your email address", response.Data[0].Subject)
+	assert.Equal(t, []string{
+		"https://app.example.com/verify?token=abc&user=1",
+		"https://app.example.com/help",
+	}, response.Data[0].Links)
+}
+
+func TestDevEmailService_CaptureDisabled(t *testing.T) {
+	devEmailService := service.NewDevEmailService(mailer.NewLogMailer("StrikePad <no-reply@localhost>"))
+
+	_, err := devEmailService.ListEmails("")
+
+	assert.ErrorIs(t, err, service.ErrEmailCaptureDisabled)
+}
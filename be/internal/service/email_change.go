@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// defaultEmailChangeConfirmURL is the frontend page that the links of email change confirmations open
+const defaultEmailChangeConfirmURL = "http://localhost:5173/confirm-email-change"
+
+// emailChangeNonceBytes is the amount of randomness in the nonce of an email change
+const emailChangeNonceBytes = 16
+
+// ErrEmailChangeNotFound is returned when cancelling the email change of a user who has none pending
+var ErrEmailChangeNotFound = stderrors.New("no pending email change")
+
+// EmailChangeService changes the email addresses of users. A change only takes effect once both the
+// current and the new address confirmed it through an emailed link, so that neither someone holding
+// a session nor a mistyped address can move the account to an address its owner does not control.
+type EmailChangeService struct {
+	userRepo       repository.UserRepository
+	txManager      repository.TxManagerInterface
+	sessionService SessionServiceInterface
+	jwtService     *auth.JWTService
+	clock          clock.Clock
+	confirmURL     string
+	ttl            time.Duration
+}
+
+// NewEmailChangeService creates a new email change service
+func NewEmailChangeService(
+	userRepo repository.UserRepository,
+	txManager repository.TxManagerInterface,
+	sessionService SessionServiceInterface,
+	jwtService *auth.JWTService,
+	clk clock.Clock,
+) EmailChangeServiceInterface {
+	return &EmailChangeService{
+		userRepo:       userRepo,
+		txManager:      txManager,
+		sessionService: sessionService,
+		jwtService:     jwtService,
+		clock:          clk,
+		confirmURL:     config.GetEnv("EMAIL_CHANGE_CONFIRM_URL", defaultEmailChangeConfirmURL),
+		ttl:            time.Duration(config.GetEnvInt("EMAIL_CHANGE_TTL_HOURS", 24)) * time.Hour,
+	}
+}
+
+// RequestEmailChange starts changing the user's email address to the requested one and queues the
+// confirmation emails to both addresses in the same transaction. A pending change is replaced, and
+// its links stop working even for the same address, as the links carry the nonce of their request.
+// The current address has to confirm even when it was never verified, so that a session alone cannot
+// move the account away from it. It returns auth.ErrStepUpRequired without a recent authentication,
+// and auth.ErrUserAlreadyExists when the address is already registered.
+func (s *EmailChangeService) RequestEmailChange(
+	userID uint,
+	req *dto.EmailChangeRequest,
+	proof StepUpProof,
+) (*dto.EmailChangeResponse, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !proof.verify(user) {
+		slog.Warn("Email change rejected without step-up", "user_id", userID)
+		return nil, auth.ErrStepUpRequired
+	}
+
+	if err := auth.ValidateEmail(req.Email); err != nil {
+		return nil, err
+	}
+	email := auth.NormalizeEmail(req.Email)
+
+	existingUser, err := s.userRepo.FindByEmail(email)
+	if err != nil && !stderrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to check existing user: %w", err)
+	}
+	if existingUser != nil {
+		return nil, auth.ErrUserAlreadyExists
+	}
+
+	nonce, err := generateEmailChangeNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate email change nonce: %w", err)
+	}
+	user.PendingEmail = &email
+	user.PendingEmailNonce = &nonce
+	user.PendingEmailOldConfirmed = user.Email == nil
+	user.PendingEmailNewConfirmed = false
+	user.PendingEmailSignOut = req.SignOutEverywhere
+
+	var messages []*model.OutboxMessage
+	tokenTypes := []string{auth.EmailChangeNewToken}
+	if !user.PendingEmailOldConfirmed {
+		tokenTypes = append(tokenTypes, auth.EmailChangeOldToken)
+	}
+	for _, tokenType := range tokenTypes {
+		token, expiresAt, err := s.jwtService.GenerateEmailChangeToken(user.ID, tokenType, nonce, s.ttl)
+		if err != nil {
+			return nil, err
+		}
+		user.PendingEmailExpiresAt = &expiresAt
+
+		to := email
+		if tokenType == auth.EmailChangeOldToken {
+			to = *user.Email
+		}
+		msg, err := mailer.NewMessage(to, mailer.TemplateEmailChange, mailer.EmailChangeData{
+			DisplayName:    user.DisplayName,
+			NewEmail:       email,
+			ConfirmURL:     s.confirmURL + "?token=" + url.QueryEscape(token),
+			ExpiresInHours: int(s.ttl.Hours()),
+			CurrentAddress: tokenType == auth.EmailChangeOldToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		message, err := NewEmailOutboxMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	err = s.txManager.WithTransaction(context.Background(), func(repos *repository.Repositories) error {
+		if err := repos.Users.Update(user); err != nil {
+			return err
+		}
+		for _, message := range messages {
+			if err := repos.Outbox.Enqueue(message); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request email change: %w", err)
+	}
+
+	slog.Info("Email change requested", "user_id", userID)
+	return emailChangeResponse(user), nil
+}
+
+// ConfirmEmailChange records the confirmation of the address that the token was emailed to, and
+// completes the change once both addresses confirmed it: the account gets the new, now verified,
+// address, and the user's sessions end if they asked for it. It returns the user and the change,
+// and auth.ErrInvalidEmailChangeToken when the token does not confirm the user's pending change.
+func (s *EmailChangeService) ConfirmEmailChange(token string) (*model.User, *dto.EmailChangeResponse, error) {
+	claims, err := s.jwtService.ValidateEmailChangeToken(token)
+	if err != nil {
+		slog.Warn("Invalid email change token", "error", err)
+		return nil, nil, auth.ErrInvalidEmailChangeToken
+	}
+
+	user, err := s.userRepo.GetByID(claims.UserID)
+	if err != nil {
+		if stderrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, auth.ErrInvalidEmailChangeToken
+		}
+		return nil, nil, fmt.Errorf("failed to load user for email change: %w", err)
+	}
+	// The links of a change that was cancelled or replaced by another request confirm nothing
+	if user.PendingEmail == nil || user.PendingEmailNonce == nil ||
+		subtle.ConstantTimeCompare([]byte(*user.PendingEmailNonce), []byte(claims.ID)) != 1 ||
+		user.PendingEmailExpiresAt == nil || s.clock.Now().After(*user.PendingEmailExpiresAt) {
+		slog.Warn("Email change token does not match the pending change", "user_id", user.ID)
+		return nil, nil, auth.ErrInvalidEmailChangeToken
+	}
+
+	if claims.Type == auth.EmailChangeOldToken {
+		user.PendingEmailOldConfirmed = true
+	} else {
+		user.PendingEmailNewConfirmed = true
+	}
+	response := emailChangeResponse(user)
+	signOut := user.PendingEmailSignOut
+	if response.Completed {
+		now := s.clock.Now()
+		user.Email = user.PendingEmail
+		user.EmailChangedAt = &now
+		user.EmailVerified = true
+		user.ClearPendingEmail()
+		response.ExpiresAt = nil
+	}
+
+	if err := s.userRepo.Update(user); err != nil {
+		if stderrors.Is(err, repository.ErrDuplicateEmail) {
+			return nil, nil, auth.ErrUserAlreadyExists
+		}
+		return nil, nil, fmt.Errorf("failed to confirm email change: %w", err)
+	}
+	if !response.Completed {
+		slog.Info("Email change confirmed by one address", "user_id", user.ID, "type", claims.Type)
+		return user, response, nil
+	}
+
+	if signOut {
+		if err := s.sessionService.InvalidateAllUserSessions(user.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	slog.Info("Email changed successfully", "user_id", user.ID, "signed_out", signOut)
+	return user, response, nil
+}
+
+// CancelEmailChange drops the user's pending email change, so that its links stop working. It
+// returns ErrEmailChangeNotFound when the user has none.
+func (s *EmailChangeService) CancelEmailChange(userID uint) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.PendingEmail == nil {
+		return ErrEmailChangeNotFound
+	}
+
+	user.ClearPendingEmail()
+	if err := s.userRepo.Update(user); err != nil {
+		return fmt.Errorf("failed to cancel email change: %w", err)
+	}
+
+	slog.Info("Email change cancelled", "user_id", userID)
+	return nil
+}
+
+// generateEmailChangeNonce returns a random nonce that tells the requests of email changes apart
+func generateEmailChangeNonce() (string, error) {
+	buf := make([]byte, emailChangeNonceBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// emailChangeResponse returns the pending email change of user
+func emailChangeResponse(user *model.User) *dto.EmailChangeResponse {
+	return &dto.EmailChangeResponse{
+		ExpiresAt:               user.PendingEmailExpiresAt,
+		Email:                   *user.PendingEmail,
+		CurrentAddressConfirmed: user.PendingEmailOldConfirmed,
+		NewAddressConfirmed:     user.PendingEmailNewConfirmed,
+		Completed:               user.PendingEmailOldConfirmed && user.PendingEmailNewConfirmed,
+	}
+}
@@ -0,0 +1,295 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	servicemocks "strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// emailChangeToken returns the token of the confirmation link in a queued email change message
+func emailChangeToken(t *testing.T, message *model.OutboxMessage) (string, string) {
+	t.Helper()
+	var msg mailer.Message
+	require.NoError(t, json.Unmarshal([]byte(message.Payload), &msg))
+	link := msg.Text[strings.Index(msg.Text, "https://strikepad.example/"):]
+	confirmURL, err := url.Parse(strings.Fields(link)[0])
+	require.NoError(t, err)
+	return msg.To, confirmURL.Query().Get("token")
+}
+
+func TestEmailChangeService_RequestEmailChange(t *testing.T) {
+	t.Setenv("EMAIL_CHANGE_CONFIRM_URL", "https://strikepad.example/confirm-email-change")
+	hash, err := auth.HashPassword("Password123!")
+	require.NoError(t, err)
+	newUser := func(verified bool) *model.User {
+		user := factory.NewUser().WithEmail("old@example.com").WithPasswordHash(hash).WithVersion(3)
+		if verified {
+			user.Verified()
+		}
+		return user.Build()
+	}
+	proof := service.StepUpProof{Password: "Password123!"}
+
+	t.Run("emails both addresses", func(t *testing.T) {
+		user := newUser(true)
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(user, nil).Once()
+		userRepo.On("FindByEmail", "new@example.com").Return(nil, gorm.ErrRecordNotFound).Once()
+		txUserRepo := new(mocks.MockUserRepository)
+		txUserRepo.On("Update", user).Return(nil).Once()
+		txOutboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+		var queued []*model.OutboxMessage
+		txOutboxRepo.EXPECT().Enqueue(mock.AnythingOfType("*model.OutboxMessage")).
+			Run(func(message *model.OutboxMessage) { queued = append(queued, message) }).
+			Return(nil).Twice()
+		txManager := mocks.NewMockTxManagerInterface(t)
+		txManager.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(repos *repository.Repositories) error) error {
+				return fn(&repository.Repositories{Users: txUserRepo, Outbox: txOutboxRepo})
+			}).Once()
+		jwtService := auth.NewJWTService(clock.New())
+
+		response, err := service.NewEmailChangeService(userRepo, txManager, nil, jwtService, clock.New()).RequestEmailChange(
+			1, &dto.EmailChangeRequest{Email: "New@Example.com", SignOutEverywhere: true}, proof,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", response.Email)
+		assert.False(t, response.CurrentAddressConfirmed)
+		assert.False(t, response.NewAddressConfirmed)
+		assert.False(t, response.Completed)
+		require.NotNil(t, response.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), *response.ExpiresAt, time.Minute)
+		assert.Equal(t, "new@example.com", *user.PendingEmail)
+		assert.Equal(t, "old@example.com", *user.Email)
+		assert.True(t, user.PendingEmailSignOut)
+
+		require.Len(t, queued, 2)
+		tokenTypes := make(map[string]string)
+		for _, message := range queued {
+			to, token := emailChangeToken(t, message)
+			claims, err := jwtService.ValidateEmailChangeToken(token)
+			require.NoError(t, err)
+			assert.Equal(t, *user.PendingEmailNonce, claims.ID)
+			tokenTypes[to] = claims.Type
+		}
+		assert.Equal(t, map[string]string{
+			"new@example.com": auth.EmailChangeNewToken,
+			"old@example.com": auth.EmailChangeOldToken,
+		}, tokenTypes)
+		userRepo.AssertExpectations(t)
+		txUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("emails the current address even when it is unverified", func(t *testing.T) {
+		user := newUser(false)
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(user, nil).Once()
+		userRepo.On("FindByEmail", "new@example.com").Return(nil, gorm.ErrRecordNotFound).Once()
+		txUserRepo := new(mocks.MockUserRepository)
+		txUserRepo.On("Update", user).Return(nil).Once()
+		txOutboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+		var recipients []string
+		txOutboxRepo.EXPECT().Enqueue(mock.AnythingOfType("*model.OutboxMessage")).
+			Run(func(message *model.OutboxMessage) {
+				to, _ := emailChangeToken(t, message)
+				recipients = append(recipients, to)
+			}).
+			Return(nil).Twice()
+		txManager := mocks.NewMockTxManagerInterface(t)
+		txManager.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(repos *repository.Repositories) error) error {
+				return fn(&repository.Repositories{Users: txUserRepo, Outbox: txOutboxRepo})
+			}).Once()
+
+		response, err := service.NewEmailChangeService(
+			userRepo, txManager, nil, auth.NewJWTService(clock.New()), clock.New(),
+		).RequestEmailChange(1, &dto.EmailChangeRequest{Email: "new@example.com"}, proof)
+
+		require.NoError(t, err)
+		assert.False(t, response.CurrentAddressConfirmed)
+		assert.ElementsMatch(t, []string{"new@example.com", "old@example.com"}, recipients)
+	})
+
+	t.Run("requires the password", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(newUser(true), nil).Once()
+
+		_, err := service.NewEmailChangeService(userRepo, nil, nil, auth.NewJWTService(clock.New()), clock.New()).
+			RequestEmailChange(1, &dto.EmailChangeRequest{Email: "new@example.com"}, service.StepUpProof{Password: "wrong"})
+
+		assert.ErrorIs(t, err, auth.ErrStepUpRequired)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects registered email addresses", func(t *testing.T) {
+		taken := "taken@example.com"
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(newUser(true), nil).Once()
+		userRepo.On("FindByEmail", taken).Return(factory.NewUser().WithID(2).WithEmail(taken).Build(), nil).Once()
+
+		_, err := service.NewEmailChangeService(userRepo, nil, nil, auth.NewJWTService(clock.New()), clock.New()).
+			RequestEmailChange(1, &dto.EmailChangeRequest{Email: taken}, proof)
+
+		assert.ErrorIs(t, err, auth.ErrUserAlreadyExists)
+		userRepo.AssertExpectations(t)
+	})
+}
+
+func TestEmailChangeService_ConfirmEmailChange(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	jwtService := auth.NewJWTService(clk)
+	token := func(tokenType, nonce string) string {
+		token, _, err := jwtService.GenerateEmailChangeToken(1, tokenType, nonce, time.Hour)
+		require.NoError(t, err)
+		return token
+	}
+	pendingUser := func(oldConfirmed, signOut bool) *model.User {
+		user := factory.NewUser().WithEmail("old@example.com").Verified().
+			WithPendingEmail("new@example.com", "3q2-7wBxR8eKc1vZpL0yNg", clk.Now().Add(time.Hour)).Build()
+		user.PendingEmailOldConfirmed = oldConfirmed
+		user.PendingEmailSignOut = signOut
+		return user
+	}
+
+	t.Run("records the first confirmation", func(t *testing.T) {
+		user := pendingUser(false, true)
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(user, nil).Once()
+		userRepo.On("Update", user).Return(nil).Once()
+
+		_, response, err := service.NewEmailChangeService(userRepo, nil, nil, jwtService, clk).
+			ConfirmEmailChange(token(auth.EmailChangeNewToken, "3q2-7wBxR8eKc1vZpL0yNg"))
+
+		require.NoError(t, err)
+		assert.True(t, response.NewAddressConfirmed)
+		assert.False(t, response.Completed)
+		assert.Equal(t, "old@example.com", *user.Email)
+		assert.True(t, user.PendingEmailNewConfirmed)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("changes the address and signs out once both confirmed", func(t *testing.T) {
+		user := pendingUser(false, true)
+		user.PendingEmailNewConfirmed = true
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(user, nil).Once()
+		userRepo.On("Update", user).Return(nil).Once()
+		sessionService := &servicemocks.MockSessionServiceInterface{}
+		sessionService.On("InvalidateAllUserSessions", uint(1)).Return(nil).Once()
+
+		changed, response, err := service.NewEmailChangeService(userRepo, nil, sessionService, jwtService, clk).
+			ConfirmEmailChange(token(auth.EmailChangeOldToken, "3q2-7wBxR8eKc1vZpL0yNg"))
+
+		require.NoError(t, err)
+		assert.True(t, response.Completed)
+		assert.Nil(t, response.ExpiresAt)
+		assert.Equal(t, factory.UserPublicID, changed.PublicID)
+		assert.Equal(t, "new@example.com", *user.Email)
+		assert.True(t, user.EmailVerified)
+		assert.Nil(t, user.PendingEmail)
+		assert.False(t, user.PendingEmailSignOut)
+		userRepo.AssertExpectations(t)
+		sessionService.AssertExpectations(t)
+	})
+
+	t.Run("keeps the sessions unless asked", func(t *testing.T) {
+		user := pendingUser(true, false)
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(user, nil).Once()
+		userRepo.On("Update", user).Return(nil).Once()
+
+		_, response, err := service.NewEmailChangeService(userRepo, nil, &servicemocks.MockSessionServiceInterface{}, jwtService, clk).
+			ConfirmEmailChange(token(auth.EmailChangeNewToken, "3q2-7wBxR8eKc1vZpL0yNg"))
+
+		require.NoError(t, err)
+		assert.True(t, response.Completed)
+		assert.Equal(t, "new@example.com", *user.Email)
+	})
+
+	t.Run("rejects links of a replaced change to the same address", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(pendingUser(false, false), nil).Once()
+
+		_, _, err := service.NewEmailChangeService(userRepo, nil, nil, jwtService, clk).
+			ConfirmEmailChange(token(auth.EmailChangeNewToken, "Vt9m4Qx1Lr6bZc0kHs2wJa"))
+
+		assert.ErrorIs(t, err, auth.ErrInvalidEmailChangeToken)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects links after the change expired", func(t *testing.T) {
+		user := pendingUser(false, false)
+		expiresAt := clk.Now().Add(30 * time.Minute)
+		user.PendingEmailExpiresAt = &expiresAt
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(user, nil).Once()
+		confirmToken := token(auth.EmailChangeNewToken, "3q2-7wBxR8eKc1vZpL0yNg")
+		clk.Advance(45 * time.Minute)
+		defer clk.Advance(-45 * time.Minute)
+
+		_, _, err := service.NewEmailChangeService(userRepo, nil, nil, jwtService, clk).ConfirmEmailChange(confirmToken)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidEmailChangeToken)
+		assert.False(t, user.PendingEmailNewConfirmed)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects other tokens", func(t *testing.T) {
+		revokeToken, err := jwtService.GenerateSessionRevokeToken(1, "ses_7d2k9m4q0x8h3v1c5b6n")
+		require.NoError(t, err)
+
+		_, _, err = service.NewEmailChangeService(new(mocks.MockUserRepository), nil, nil, jwtService, clk).
+			ConfirmEmailChange(revokeToken)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidEmailChangeToken)
+	})
+
+	t.Run("rejects an address registered in the meantime", func(t *testing.T) {
+		user := pendingUser(true, false)
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("GetByID", uint(1)).Return(user, nil).Once()
+		userRepo.On("Update", user).Return(repository.ErrDuplicateEmail).Once()
+
+		_, _, err := service.NewEmailChangeService(userRepo, nil, nil, jwtService, clk).
+			ConfirmEmailChange(token(auth.EmailChangeNewToken, "3q2-7wBxR8eKc1vZpL0yNg"))
+
+		assert.ErrorIs(t, err, auth.ErrUserAlreadyExists)
+	})
+}
+
+func TestEmailChangeService_CancelEmailChange(t *testing.T) {
+	user := factory.NewUser().WithPendingEmail("new@example.com", "3q2-7wBxR8eKc1vZpL0yNg", time.Now().Add(time.Hour)).Build()
+	user.PendingEmailNewConfirmed = true
+	userRepo := new(mocks.MockUserRepository)
+	userRepo.On("GetByID", uint(1)).Return(user, nil).Twice()
+	userRepo.On("Update", user).Return(nil).Once()
+	emailChangeService := service.NewEmailChangeService(userRepo, nil, nil, auth.NewJWTService(clock.New()), clock.New())
+
+	require.NoError(t, emailChangeService.CancelEmailChange(1))
+	assert.Nil(t, user.PendingEmail)
+	assert.Nil(t, user.PendingEmailNonce)
+	assert.False(t, user.PendingEmailNewConfirmed)
+
+	assert.ErrorIs(t, emailChangeService.CancelEmailChange(1), service.ErrEmailChangeNotFound)
+	userRepo.AssertExpectations(t)
+}
@@ -1,16 +1,129 @@
 package service
 
-import "strikepad-backend/internal/dto"
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
 
-type healthService struct{}
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/migrations"
 
-func NewHealthService() HealthServiceInterface {
-	return &healthService{}
+	"gorm.io/gorm"
+)
+
+// Health statuses of the service and of each dependency. The service is degraded when a read
+// replica is down, since reads fall back to the primary, and unavailable when the primary is.
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusDegraded    = "degraded"
+	HealthStatusUnavailable = "unavailable"
+	HealthStatusDown        = "down"
+)
+
+// healthCheckTimeout bounds how long the database pools may take to answer a health check
+const healthCheckTimeout = 2 * time.Second
+
+// Dependencies named in health checks
+const (
+	primaryDependency    = "database"
+	migrationsDependency = "migrations"
+	cacheDependency      = "cache"
+)
+
+// readinessCacheKey is read to check that the cache answers; it is never set
+const readinessCacheKey = "health:ready"
+
+type healthService struct {
+	db       *gorm.DB
+	cache    cache.Cache
+	replicas config.Replicas
+}
+
+// NewHealthService creates a health service checking the primary database, its read replicas and,
+// when it is enabled, the cache
+func NewHealthService(db *gorm.DB, replicas config.Replicas, c cache.Cache) HealthServiceInterface {
+	return &healthService{db: db, replicas: replicas, cache: c}
+}
+
+// GetHealth pings every database pool and reports the status of each
+func (s *healthService) GetHealth(ctx context.Context) *dto.HealthResponse {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	results := config.PingDatabases(ctx, s.db, s.replicas)
+
+	response := &dto.HealthResponse{
+		Status:       HealthStatusOK,
+		Message:      "Server is healthy",
+		Dependencies: make([]dto.DependencyHealth, 0, len(results)),
+	}
+	response.Dependencies = append(response.Dependencies, dependencyHealth(primaryDependency, results["primary"]))
+	if results["primary"] != nil {
+		response.Status = HealthStatusUnavailable
+		response.Message = "The database is unreachable"
+	}
+
+	for _, replica := range s.replicas {
+		err := results[replica.Name]
+		response.Dependencies = append(response.Dependencies, dependencyHealth(replica.Name, err))
+		if err != nil && response.Status == HealthStatusOK {
+			response.Status = HealthStatusDegraded
+			response.Message = "A read replica is unreachable; reads fall back to the primary"
+		}
+	}
+	return response
+}
+
+// GetReadiness reports whether the service can serve requests: the primary database answers, the
+// migrations in migrations.Dir are applied to it, and the cache answers when it is enabled. Read
+// replicas are left out, since reads fall back to the primary.
+func (s *healthService) GetReadiness(ctx context.Context) *dto.HealthResponse {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	response := &dto.HealthResponse{
+		Status:       HealthStatusOK,
+		Message:      "Server is ready",
+		Dependencies: make([]dto.DependencyHealth, 0, 3),
+	}
+
+	sqlDB, err := s.db.DB()
+	if err == nil {
+		err = sqlDB.PingContext(ctx)
+	}
+	response.Dependencies = append(response.Dependencies, dependencyHealth(primaryDependency, err))
+	if err == nil {
+		err = migrations.CheckApplied(ctx, sqlDB, migrations.Dir)
+	} else {
+		err = errors.New("the database is unreachable")
+	}
+	response.Dependencies = append(response.Dependencies, dependencyHealth(migrationsDependency, err))
+
+	if s.cache != nil {
+		_, err := s.cache.Get(ctx, readinessCacheKey)
+		if errors.Is(err, cache.ErrMiss) {
+			err = nil
+		}
+		response.Dependencies = append(response.Dependencies, dependencyHealth(cacheDependency, err))
+	}
+
+	for _, dependency := range response.Dependencies {
+		if dependency.Status != HealthStatusOK {
+			response.Status = HealthStatusUnavailable
+			response.Message = "Server is not ready"
+		}
+	}
+	return response
 }
 
-func (s *healthService) GetHealth() *dto.HealthResponse {
-	return &dto.HealthResponse{
-		Status:  "ok",
-		Message: "Server is healthy",
+// dependencyHealth returns the health of the dependency name, whose check returned err
+func dependencyHealth(name string, err error) dto.DependencyHealth {
+	if err != nil {
+		slog.Warn("Health check failed", "dependency", name, "error", err)
+		return dto.DependencyHealth{Name: name, Status: HealthStatusDown}
 	}
+	return dto.DependencyHealth{Name: name, Status: HealthStatusOK}
 }
@@ -1,71 +1,210 @@
 package service_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"strikepad-backend/internal/cache"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
 	"strikepad-backend/internal/service"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 type HealthServiceTestSuite struct {
 	suite.Suite
-	healthService service.HealthServiceInterface
+	db       *gorm.DB
+	primary  sqlmock.Sqlmock
+	replica  sqlmock.Sqlmock
+	replicas config.Replicas
 }
 
 func (suite *HealthServiceTestSuite) SetupTest() {
-	suite.healthService = service.NewHealthService()
+	primaryDB, primary, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	suite.Require().NoError(err)
+	replicaDB, replica, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	suite.Require().NoError(err)
+
+	// Opening the database pings it once
+	primary.ExpectPing()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: primaryDB}), &gorm.Config{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.primary = primary
+	suite.replica = replica
+	suite.replicas = config.Replicas{{Name: "replica_1", DB: replicaDB}}
+}
+
+func (suite *HealthServiceTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.primary.ExpectationsWereMet())
+	assert.NoError(suite.T(), suite.replica.ExpectationsWereMet())
 }
 
 func (suite *HealthServiceTestSuite) TestGetHealth() {
 	testCases := []struct {
-		name            string
-		expectedStatus  string
-		expectedMessage string
+		primaryErr           error
+		replicaErr           error
+		name                 string
+		expectedStatus       string
+		expectedMessage      string
+		expectedDependencies []dto.DependencyHealth
 	}{
 		{
-			name:            "Health check returns ok status",
-			expectedStatus:  "ok",
+			name:            "all pools answer",
+			expectedStatus:  service.HealthStatusOK,
 			expectedMessage: "Server is healthy",
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusOK},
+				{Name: "replica_1", Status: service.HealthStatusOK},
+			},
+		},
+		{
+			name:            "replica down",
+			replicaErr:      errors.New("connection refused"),
+			expectedStatus:  service.HealthStatusDegraded,
+			expectedMessage: "A read replica is unreachable; reads fall back to the primary",
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusOK},
+				{Name: "replica_1", Status: service.HealthStatusDown},
+			},
+		},
+		{
+			name:            "primary down",
+			primaryErr:      errors.New("connection refused"),
+			expectedStatus:  service.HealthStatusUnavailable,
+			expectedMessage: "The database is unreachable",
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusDown},
+				{Name: "replica_1", Status: service.HealthStatusOK},
+			},
+		},
+		{
+			name:            "every pool down",
+			primaryErr:      errors.New("connection refused"),
+			replicaErr:      errors.New("connection refused"),
+			expectedStatus:  service.HealthStatusUnavailable,
+			expectedMessage: "The database is unreachable",
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusDown},
+				{Name: "replica_1", Status: service.HealthStatusDown},
+			},
 		},
 	}
 
 	for _, tc := range testCases {
-		suite.T().Run(tc.name, func(t *testing.T) {
-			result := suite.healthService.GetHealth()
+		suite.Run(tc.name, func() {
+			suite.primary.ExpectPing().WillReturnError(tc.primaryErr)
+			suite.replica.ExpectPing().WillReturnError(tc.replicaErr)
+
+			result := service.NewHealthService(suite.db, suite.replicas, nil).GetHealth(context.Background())
 
-			assert.NotNil(t, result)
-			assert.Equal(t, tc.expectedStatus, result.Status)
-			assert.Equal(t, tc.expectedMessage, result.Message)
+			assert.Equal(suite.T(), tc.expectedStatus, result.Status)
+			assert.Equal(suite.T(), tc.expectedMessage, result.Message)
+			assert.Equal(suite.T(), tc.expectedDependencies, result.Dependencies)
 		})
 	}
 }
 
-func TestHealthServiceTestSuite(t *testing.T) {
-	suite.Run(t, new(HealthServiceTestSuite))
+// failingCache is a cache whose server cannot be reached
+type failingCache struct{}
+
+func (failingCache) Get(context.Context, string) ([]byte, error) {
+	return nil, errors.New("redis: failed to connect")
+}
+
+func (failingCache) Set(context.Context, string, []byte, time.Duration) error {
+	return errors.New("redis: failed to connect")
+}
+
+func (failingCache) Delete(context.Context, ...string) error {
+	return errors.New("redis: failed to connect")
 }
 
-func TestHealthService_GetHealth_Simple(t *testing.T) {
+func (failingCache) Increment(context.Context, string, time.Duration) (int64, error) {
+	return 0, errors.New("redis: failed to connect")
+}
+
+func (suite *HealthServiceTestSuite) TestGetReadiness() {
 	testCases := []struct {
-		name            string
-		expectedStatus  string
-		expectedMessage string
+		primaryErr           error
+		cache                cache.Cache
+		name                 string
+		expectedStatus       string
+		expectedDependencies []dto.DependencyHealth
 	}{
 		{
-			name:            "Simple health check test",
-			expectedStatus:  "ok",
-			expectedMessage: "Server is healthy",
+			name:           "ready without a cache",
+			expectedStatus: service.HealthStatusOK,
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusOK},
+				{Name: "migrations", Status: service.HealthStatusOK},
+			},
+		},
+		{
+			name:           "ready with a cache",
+			cache:          cache.NewMemoryCache(),
+			expectedStatus: service.HealthStatusOK,
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusOK},
+				{Name: "migrations", Status: service.HealthStatusOK},
+				{Name: "cache", Status: service.HealthStatusOK},
+			},
+		},
+		{
+			name:           "cache unreachable",
+			cache:          failingCache{},
+			expectedStatus: service.HealthStatusUnavailable,
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusOK},
+				{Name: "migrations", Status: service.HealthStatusOK},
+				{Name: "cache", Status: service.HealthStatusDown},
+			},
+		},
+		{
+			name:           "database unreachable",
+			primaryErr:     errors.New("connection refused"),
+			expectedStatus: service.HealthStatusUnavailable,
+			expectedDependencies: []dto.DependencyHealth{
+				{Name: "database", Status: service.HealthStatusDown},
+				{Name: "migrations", Status: service.HealthStatusDown},
+			},
 		},
 	}
 
 	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			svc := service.NewHealthService()
-			result := svc.GetHealth()
+		suite.Run(tc.name, func() {
+			// Replicas are not checked: reads fall back to the primary
+			suite.primary.ExpectPing().WillReturnError(tc.primaryErr)
 
-			assert.Equal(t, tc.expectedStatus, result.Status)
-			assert.Equal(t, tc.expectedMessage, result.Message)
+			result := service.NewHealthService(suite.db, suite.replicas, tc.cache).GetReadiness(context.Background())
+
+			assert.Equal(suite.T(), tc.expectedStatus, result.Status)
+			assert.Equal(suite.T(), tc.expectedDependencies, result.Dependencies)
 		})
 	}
 }
+
+func TestHealthServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(HealthServiceTestSuite))
+}
+
+func TestHealthService_GetHealth_WithoutReplicas(t *testing.T) {
+	sqlDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+
+	result := service.NewHealthService(db, nil, nil).GetHealth(context.Background())
+
+	assert.Equal(t, service.HealthStatusOK, result.Status)
+	assert.Equal(t, []dto.DependencyHealth{{Name: "database", Status: service.HealthStatusOK}}, result.Dependencies)
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+)
+
+const (
+	// idempotencyPurgeInterval is how often expired idempotency keys are deleted
+	idempotencyPurgeInterval = time.Hour
+	// idempotencyRequestKeyPurpose and idempotencyResponseKeyPurpose tell apart the keys derived
+	// from the JWT secret to hash requests and to encrypt stored responses
+	idempotencyRequestKeyPurpose  = "idempotency_request"
+	idempotencyResponseKeyPurpose = "idempotency_response"
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned when an idempotency key is sent with a different request
+	ErrIdempotencyKeyReused = errors.New("idempotency key was used for a different request")
+	// ErrIdempotencyKeyInProgress is returned while the first request with an idempotency key is running
+	ErrIdempotencyKeyInProgress = errors.New("request with the idempotency key is in progress")
+)
+
+// IdempotencyService records requests sent with an idempotency key and their responses, so that
+// retries get the stored response instead of repeating the request. Keys expire after the TTL.
+// Responses can carry tokens and secrets, so they are stored encrypted, and requests, which can
+// carry passwords, only as an HMAC; both keys are derived from the JWT secret.
+type IdempotencyService struct {
+	idempotencyKeyRepo repository.IdempotencyKeyRepositoryInterface
+	responseCipher     cipher.AEAD
+	requestKey         []byte
+	ttl                time.Duration
+}
+
+// NewIdempotencyService creates a new idempotency service
+func NewIdempotencyService(
+	idempotencyKeyRepo repository.IdempotencyKeyRepositoryInterface,
+) (IdempotencyServiceInterface, error) {
+	block, err := aes.NewCipher(newIdempotencyKey(idempotencyResponseKeyPurpose))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create idempotency response cipher: %w", err)
+	}
+	responseCipher, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create idempotency response cipher: %w", err)
+	}
+
+	return &IdempotencyService{
+		idempotencyKeyRepo: idempotencyKeyRepo,
+		responseCipher:     responseCipher,
+		requestKey:         newIdempotencyKey(idempotencyRequestKeyPurpose),
+		ttl:                time.Duration(config.GetEnvInt("IDEMPOTENCY_KEY_TTL_HOURS", 24)) * time.Hour,
+	}, nil
+}
+
+// newIdempotencyKey derives the key for purpose from the JWT secret
+func newIdempotencyKey(purpose string) []byte {
+	secret := config.GetEnv("JWT_SECRET_KEY", "your-secret-key-change-this-in-production")
+	key := sha256.Sum256([]byte(purpose + ":" + secret))
+	return key[:]
+}
+
+// Begin records the request, given as its method, URI and body, with the key in the scope. It
+// returns the new record when the request should run, or the completed record of an earlier
+// identical request, with its decrypted response, which should be replayed. A key sent with a
+// different request gives ErrIdempotencyKeyReused, and one whose first request has not finished
+// ErrIdempotencyKeyInProgress.
+func (s *IdempotencyService) Begin(scope, key string, request []byte) (*model.IdempotencyKey, error) {
+	requestHash := s.hashRequest(request)
+	now := time.Now()
+	record := &model.IdempotencyKey{
+		Scope:       scope,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   now.Add(s.ttl),
+	}
+
+	// The key may be released or expire while it is looked up, so creating it is tried again once
+	for attempt := 0; attempt < 2; attempt++ {
+		err := s.idempotencyKeyRepo.Create(record)
+		if err == nil {
+			return record, nil
+		}
+		if !errors.Is(err, repository.ErrIdempotencyKeyExists) {
+			return nil, err
+		}
+
+		existing, err := s.idempotencyKeyRepo.Find(scope, key)
+		if errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !existing.ExpiresAt.After(now) {
+			if err := s.idempotencyKeyRepo.Delete(existing.ID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if existing.RequestHash != requestHash {
+			return nil, ErrIdempotencyKeyReused
+		}
+		if !existing.IsCompleted() {
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		if err := s.openResponse(existing); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	return nil, ErrIdempotencyKeyInProgress
+}
+
+// Complete stores the response of the request that Begin recorded, for replay to its retries
+func (s *IdempotencyService) Complete(
+	record *model.IdempotencyKey,
+	status int,
+	header http.Header,
+	body []byte,
+) error {
+	headers, err := json.Marshal(header)
+	if err != nil {
+		return fmt.Errorf("failed to encode response headers: %w", err)
+	}
+
+	sealedHeaders, err := s.seal(headers)
+	if err != nil {
+		return err
+	}
+	sealedBody, err := s.seal(body)
+	if err != nil {
+		return err
+	}
+	if err := s.idempotencyKeyRepo.Complete(record.ID, status, sealedHeaders, sealedBody); err != nil {
+		return err
+	}
+
+	record.ResponseStatus = &status
+	record.ResponseHeaders = string(headers)
+	record.ResponseBody = string(body)
+	return nil
+}
+
+// Release forgets the request that Begin recorded, e.g. because it failed, so that it can be
+// retried with the same key
+func (s *IdempotencyService) Release(record *model.IdempotencyKey) error {
+	return s.idempotencyKeyRepo.Delete(record.ID)
+}
+
+// hashRequest returns the hex HMAC-SHA256 of request
+func (s *IdempotencyService) hashRequest(request []byte) string {
+	mac := hmac.New(sha256.New, s.requestKey)
+	mac.Write(request)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// seal encrypts plaintext for storage, returning the base64 of the nonce followed by the ciphertext
+func (s *IdempotencyService) seal(plaintext []byte) (string, error) {
+	nonce := make([]byte, s.responseCipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := s.responseCipher.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts a value from seal
+func (s *IdempotencyService) open(value string) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := s.responseCipher.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.New("sealed value is too short")
+	}
+	return s.responseCipher.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+}
+
+// openResponse replaces the stored response of record with its decrypted headers and body
+func (s *IdempotencyService) openResponse(record *model.IdempotencyKey) error {
+	headers, err := s.open(record.ResponseHeaders)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt stored response headers: %w", err)
+	}
+	body, err := s.open(record.ResponseBody)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt stored response body: %w", err)
+	}
+	record.ResponseHeaders = string(headers)
+	record.ResponseBody = string(body)
+	return nil
+}
+
+// Run deletes expired idempotency keys every purge interval until ctx is canceled
+func (s *IdempotencyService) Run(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.idempotencyKeyRepo.DeleteExpired(time.Now())
+		if err != nil {
+			slog.Error("Failed to delete expired idempotency keys", "error", err)
+		} else if deleted > 0 {
+			slog.Info("Expired idempotency keys deleted", "count", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newIdempotencyService creates an idempotency service on repo
+func newIdempotencyService(t *testing.T, repo repository.IdempotencyKeyRepositoryInterface) service.IdempotencyServiceInterface {
+	idempotencyService, err := service.NewIdempotencyService(repo)
+	require.NoError(t, err)
+	return idempotencyService
+}
+
+// storedResponse returns the request hash of request and the response headers and body that the
+// idempotency service stores when it completes with a JSON `{"ok":true}`
+func storedResponse(t *testing.T, request []byte) (hash, headers, body string) {
+	repo := mocks.NewMockIdempotencyKeyRepositoryInterface(t)
+	repo.EXPECT().Create(mock.AnythingOfType("*model.IdempotencyKey")).Return(nil).Once()
+	repo.EXPECT().Complete(uint(0), http.StatusCreated, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+		Run(func(_ uint, _ int, storedHeaders string, storedBody string) {
+			headers, body = storedHeaders, storedBody
+		}).
+		Return(nil).Once()
+	idempotencyService := newIdempotencyService(t, repo)
+
+	record, err := idempotencyService.Begin("anonymous POST /api/auth/signup", "retry-0", request)
+	require.NoError(t, err)
+	require.NoError(t, idempotencyService.Complete(record, http.StatusCreated,
+		http.Header{"Content-Type": {"application/json"}}, []byte(`{"ok":true}`)))
+	return record.RequestHash, headers, body
+}
+
+func TestIdempotencyService_Begin(t *testing.T) {
+	const scope = "anonymous POST /api/auth/signup"
+	request := []byte("POST /api/auth/signup\n" + `{"email":"user@example.com","password":"secret"}`)
+	hash, headers, body := storedResponse(t, request)
+	status := http.StatusCreated
+
+	tests := []struct {
+		existing        *model.IdempotencyKey
+		expectedErr     error
+		name            string
+		expectCreated   bool
+		expectReplay    bool
+		expectDeleteOld bool
+	}{
+		{
+			name:          "new key",
+			expectCreated: true,
+		},
+		{
+			name: "completed identical request",
+			existing: &model.IdempotencyKey{
+				ID: 4, RequestHash: hash, ResponseStatus: &status, ResponseHeaders: headers, ResponseBody: body,
+				ExpiresAt: time.Now().Add(time.Hour),
+			},
+			expectReplay: true,
+		},
+		{
+			name:        "different request",
+			existing:    &model.IdempotencyKey{ID: 4, RequestHash: "other", ExpiresAt: time.Now().Add(time.Hour)},
+			expectedErr: service.ErrIdempotencyKeyReused,
+		},
+		{
+			name:        "first request still running",
+			existing:    &model.IdempotencyKey{ID: 4, RequestHash: hash, ExpiresAt: time.Now().Add(time.Hour)},
+			expectedErr: service.ErrIdempotencyKeyInProgress,
+		},
+		{
+			name: "expired key is replaced",
+			existing: &model.IdempotencyKey{
+				ID: 4, RequestHash: "other", ResponseStatus: &status, ExpiresAt: time.Now().Add(-time.Minute),
+			},
+			expectDeleteOld: true,
+			expectCreated:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockIdempotencyKeyRepositoryInterface(t)
+			if tt.existing != nil {
+				repo.EXPECT().Create(mock.AnythingOfType("*model.IdempotencyKey")).
+					Return(repository.ErrIdempotencyKeyExists).Once()
+				repo.EXPECT().Find(scope, "retry-1").Return(tt.existing, nil).Once()
+			}
+			if tt.expectDeleteOld {
+				repo.EXPECT().Delete(uint(4)).Return(nil).Once()
+			}
+			if tt.expectCreated {
+				repo.EXPECT().Create(mock.AnythingOfType("*model.IdempotencyKey")).Return(nil).Once()
+			}
+
+			record, err := newIdempotencyService(t, repo).Begin(scope, "retry-1", request)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, record)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectReplay, record.IsCompleted())
+			if tt.expectReplay {
+				assert.Equal(t, `{"Content-Type":["application/json"]}`, record.ResponseHeaders)
+				assert.Equal(t, `{"ok":true}`, record.ResponseBody)
+			}
+			if tt.expectCreated {
+				assert.Equal(t, scope, record.Scope)
+				assert.Equal(t, hash, record.RequestHash)
+				assert.WithinDuration(t, time.Now().Add(24*time.Hour), record.ExpiresAt, time.Minute)
+			}
+		})
+	}
+
+	t.Run("hashes requests with a server key", func(t *testing.T) {
+		sum := sha256.Sum256(request)
+		assert.NotEqual(t, hex.EncodeToString(sum[:]), hash)
+		assert.Len(t, hash, 64)
+	})
+
+	t.Run("stored response that does not decrypt", func(t *testing.T) {
+		repo := mocks.NewMockIdempotencyKeyRepositoryInterface(t)
+		repo.EXPECT().Create(mock.AnythingOfType("*model.IdempotencyKey")).
+			Return(repository.ErrIdempotencyKeyExists).Once()
+		repo.EXPECT().Find(scope, "retry-1").Return(&model.IdempotencyKey{
+			ID: 4, RequestHash: hash, ResponseStatus: &status, ResponseHeaders: headers,
+			ResponseBody: `{"ok":true}`, ExpiresAt: time.Now().Add(time.Hour),
+		}, nil).Once()
+
+		record, err := newIdempotencyService(t, repo).Begin(scope, "retry-1", request)
+
+		assert.Error(t, err)
+		assert.Nil(t, record)
+	})
+}
+
+func TestIdempotencyService_Complete(t *testing.T) {
+	_, headers, body := storedResponse(t, []byte("POST /api/admin/api-keys\n{}"))
+
+	assert.NotContains(t, headers, "application/json")
+	assert.NotContains(t, body, "ok")
+
+	repo := mocks.NewMockIdempotencyKeyRepositoryInterface(t)
+	repo.EXPECT().Complete(uint(4), http.StatusCreated, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+		Return(nil).Once()
+	record := &model.IdempotencyKey{ID: 4}
+
+	err := newIdempotencyService(t, repo).Complete(record, http.StatusCreated,
+		http.Header{"Content-Type": {"application/json"}}, []byte(`{"ok":true}`))
+
+	require.NoError(t, err)
+	assert.True(t, record.IsCompleted())
+	assert.Equal(t, `{"ok":true}`, record.ResponseBody)
+}
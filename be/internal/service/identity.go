@@ -0,0 +1,133 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+)
+
+// StepUpWindow is how long after signing in a user without a password may perform sensitive actions
+const StepUpWindow = 10 * time.Minute
+
+// StepUpProof is the evidence that the user authenticated recently
+type StepUpProof struct {
+	// AuthenticatedAt is when the current session was created
+	AuthenticatedAt time.Time
+	// Password is the current password, if the user confirmed it
+	Password string
+}
+
+// verify reports whether the proof shows that user authenticated recently: users with a password
+// confirm it; others must have signed in within the step-up window
+func (p StepUpProof) verify(user *model.User) bool {
+	if user.PasswordHash != nil {
+		return p.Password != "" && auth.CheckPasswordHash(p.Password, *user.PasswordHash)
+	}
+	return time.Since(p.AuthenticatedAt) <= StepUpWindow
+}
+
+// IdentityService manages the provider accounts linked to users
+type IdentityService struct {
+	userRepo     repository.UserRepository
+	identityRepo repository.IdentityRepositoryInterface
+}
+
+// NewIdentityService creates a new identity service
+func NewIdentityService(
+	userRepo repository.UserRepository,
+	identityRepo repository.IdentityRepositoryInterface,
+) IdentityServiceInterface {
+	return &IdentityService{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+	}
+}
+
+// UnlinkIdentity removes the user's linked account at a provider. The user must prove a recent
+// authentication and keep at least one other way to sign in.
+func (s *IdentityService) UnlinkIdentity(userID uint, provider string, proof StepUpProof) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !proof.verify(user) {
+		slog.Warn("Identity unlink rejected without step-up", "user_id", userID)
+		return auth.ErrStepUpRequired
+	}
+
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	loginMethods := len(identities)
+	linked := false
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			linked = true
+		}
+	}
+	if !linked {
+		return repository.ErrIdentityNotFound
+	}
+	if user.PasswordHash != nil {
+		loginMethods++
+	}
+	if user.ProviderType != "email" {
+		// The provider the user signed up with
+		loginMethods++
+	}
+	if loginMethods <= 1 {
+		slog.Warn("Identity unlink rejected for last login method", "user_id", userID, "provider", provider)
+		return auth.ErrLastLoginMethod
+	}
+
+	if err := s.identityRepo.Delete(userID, provider); err != nil {
+		if errors.Is(err, repository.ErrIdentityNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to unlink identity: %w", err)
+	}
+
+	slog.Info("Identity unlinked successfully", "user_id", userID, "provider", provider)
+	return nil
+}
+
+// GetSecuritySettings returns how the user can sign in and when their password last changed
+func (s *IdentityService) GetSecuritySettings(userID uint) (*dto.SecuritySettingsResponse, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	response := &dto.SecuritySettingsResponse{
+		PasswordChangedAt: user.PasswordChangedAt,
+		ProviderType:      user.ProviderType,
+		Identities:        make([]dto.IdentityInfo, 0, len(identities)),
+		HasPassword:       user.PasswordHash != nil,
+	}
+	for _, identity := range identities {
+		info := dto.IdentityInfo{
+			LinkedAt: identity.CreatedAt,
+			Provider: identity.Provider,
+		}
+		if identity.Email != nil {
+			info.Email = *identity.Email
+		}
+		response.Identities = append(response.Identities, info)
+	}
+
+	return response, nil
+}
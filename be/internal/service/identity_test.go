@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityService_UnlinkIdentity(t *testing.T) {
+	passwordHash, err := auth.HashPassword("Password123!")
+	assert.NoError(t, err)
+
+	emailUser := factory.NewUser().WithPasswordHash(passwordHash).Build()
+	googleUser := factory.NewUser().WithGoogle().Build()
+	linked := []model.UserIdentity{{UserID: 1, Provider: "github"}}
+
+	tests := []struct {
+		user          *model.User
+		setupMocks    func(*mocks.MockIdentityRepositoryInterface)
+		expectedError error
+		name          string
+		proof         service.StepUpProof
+	}{
+		{
+			name:  "password user confirms password",
+			user:  emailUser,
+			proof: service.StepUpProof{Password: "Password123!"},
+			setupMocks: func(identityRepo *mocks.MockIdentityRepositoryInterface) {
+				identityRepo.EXPECT().ListByUserID(uint(1)).Return(linked, nil).Once()
+				identityRepo.EXPECT().Delete(uint(1), "github").Return(nil).Once()
+			},
+		},
+		{
+			name:          "password user without password confirmation",
+			user:          emailUser,
+			proof:         service.StepUpProof{AuthenticatedAt: time.Now()},
+			setupMocks:    func(_ *mocks.MockIdentityRepositoryInterface) {},
+			expectedError: auth.ErrStepUpRequired,
+		},
+		{
+			name:          "password user with wrong password",
+			user:          emailUser,
+			proof:         service.StepUpProof{Password: "WrongPassword1!"},
+			setupMocks:    func(_ *mocks.MockIdentityRepositoryInterface) {},
+			expectedError: auth.ErrStepUpRequired,
+		},
+		{
+			name:  "OAuth user with recent sign-in keeps sign-up provider",
+			user:  googleUser,
+			proof: service.StepUpProof{AuthenticatedAt: time.Now().Add(-time.Minute)},
+			setupMocks: func(identityRepo *mocks.MockIdentityRepositoryInterface) {
+				identityRepo.EXPECT().ListByUserID(uint(1)).Return(linked, nil).Once()
+				identityRepo.EXPECT().Delete(uint(1), "github").Return(nil).Once()
+			},
+		},
+		{
+			name:          "OAuth user with stale session",
+			user:          googleUser,
+			proof:         service.StepUpProof{AuthenticatedAt: time.Now().Add(-service.StepUpWindow - time.Minute)},
+			setupMocks:    func(_ *mocks.MockIdentityRepositoryInterface) {},
+			expectedError: auth.ErrStepUpRequired,
+		},
+		{
+			name:  "last login method",
+			user:  factory.NewUser().Build(),
+			proof: service.StepUpProof{AuthenticatedAt: time.Now()},
+			setupMocks: func(identityRepo *mocks.MockIdentityRepositoryInterface) {
+				identityRepo.EXPECT().ListByUserID(uint(1)).Return(linked, nil).Once()
+			},
+			expectedError: auth.ErrLastLoginMethod,
+		},
+		{
+			name:  "provider not linked",
+			user:  emailUser,
+			proof: service.StepUpProof{Password: "Password123!"},
+			setupMocks: func(identityRepo *mocks.MockIdentityRepositoryInterface) {
+				identityRepo.EXPECT().ListByUserID(uint(1)).
+					Return([]model.UserIdentity{{UserID: 1, Provider: "google"}}, nil).Once()
+			},
+			expectedError: repository.ErrIdentityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := mocks.NewMockUserRepository(t)
+			identityRepo := mocks.NewMockIdentityRepositoryInterface(t)
+			userRepo.EXPECT().GetByID(uint(1)).Return(tt.user, nil).Once()
+			tt.setupMocks(identityRepo)
+
+			identityService := service.NewIdentityService(userRepo, identityRepo)
+			err := identityService.UnlinkIdentity(1, "github", tt.proof)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIdentityService_GetSecuritySettings(t *testing.T) {
+	passwordChangedAt := time.Now().Add(-time.Hour)
+	githubEmail := "octocat@example.com"
+
+	userRepo := mocks.NewMockUserRepository(t)
+	identityRepo := mocks.NewMockIdentityRepositoryInterface(t)
+	userRepo.EXPECT().GetByID(uint(1)).Return(
+		factory.NewUser().WithPasswordHash("hash").WithPasswordChangedAt(passwordChangedAt).Build(), nil,
+	).Once()
+	identityRepo.EXPECT().ListByUserID(uint(1)).
+		Return([]model.UserIdentity{{UserID: 1, Provider: "github", Email: &githubEmail}}, nil).Once()
+
+	identityService := service.NewIdentityService(userRepo, identityRepo)
+	settings, err := identityService.GetSecuritySettings(1)
+
+	assert.NoError(t, err)
+	assert.True(t, settings.HasPassword)
+	assert.Equal(t, &passwordChangedAt, settings.PasswordChangedAt)
+	assert.Equal(t, "email", settings.ProviderType)
+	assert.Len(t, settings.Identities, 1)
+	assert.Equal(t, "github", settings.Identities[0].Provider)
+	assert.Equal(t, githubEmail, settings.Identities[0].Email)
+}
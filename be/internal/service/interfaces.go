@@ -1,21 +1,169 @@
 package service
 
-import "strikepad-backend/internal/dto"
+import (
+	"context"
+	"net/http"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/oauth"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+)
 
 // AuthServiceInterface defines the interface for authentication service
 type AuthServiceInterface interface {
 	Signup(req *dto.SignupRequest) (*dto.SignupResponse, error)
+	SignupWithSession(
+		ctx context.Context,
+		req *dto.SignupRequest,
+		metadata SessionMetadata,
+	) (*dto.SignupResponse, *auth.TokenPair, error)
 	Login(req *dto.LoginRequest) (*dto.UserInfo, error)
 	GoogleSignup(req *dto.GoogleSignupRequest) (*dto.SignupResponse, error)
 	GoogleLogin(req *dto.GoogleLoginRequest) (*dto.UserInfo, error)
+	GoogleAuthorize() (*oauth.GoogleAuthorization, error)
+	GoogleCallback(code, state, stateToken string) (*dto.UserInfo, error)
+	ProviderSignup(provider string, req *dto.OAuthRequest) (*dto.SignupResponse, error)
+	ProviderLogin(provider string, req *dto.OAuthRequest) (*dto.UserInfo, error)
+	LinkGoogleIdentity(userID uint, req *dto.LinkGoogleIdentityRequest) (*dto.IdentityInfo, error)
+}
+
+// IdentityServiceInterface defines the interface for identity service
+type IdentityServiceInterface interface {
+	UnlinkIdentity(userID uint, provider string, proof StepUpProof) error
+	GetSecuritySettings(userID uint) (*dto.SecuritySettingsResponse, error)
+}
+
+// EmailChangeServiceInterface defines the interface for email change service
+type EmailChangeServiceInterface interface {
+	RequestEmailChange(userID uint, req *dto.EmailChangeRequest, proof StepUpProof) (*dto.EmailChangeResponse, error)
+	ConfirmEmailChange(token string) (*model.User, *dto.EmailChangeResponse, error)
+	CancelEmailChange(userID uint) error
+}
+
+// AdminServiceInterface defines the interface for admin service
+type AdminServiceInterface interface {
+	ListUsers(params repository.ListParams) (*dto.AdminUserListResponse, error)
+	ListUserSessions(userPublicID string, params pagination.Params) (*pagination.Page[model.UserSession], error)
+	SetUserDisabled(userPublicID string, disabled bool) (*dto.AdminUserInfo, error)
+	ForceLogout(userPublicID string) error
+}
+
+// AuditServiceInterface defines the interface for audit service
+type AuditServiceInterface interface {
+	Record(entry AuditEntry)
+	RecordLoginFailure(entry AuditEntry, email string)
+	ListLogs(params repository.AuditLogListParams) (*dto.AuditLogListResponse, error)
+	ListLogins(userID uint, params pagination.Params) (*dto.LoginAttemptListResponse, error)
+}
+
+// LoginAlertServiceInterface defines the interface for login alert service
+type LoginAlertServiceInterface interface {
+	CheckLogin(session *model.UserSession)
+}
+
+// APIKeyServiceInterface defines the interface for API key service
+type APIKeyServiceInterface interface {
+	CreateKey(createdBy *model.User, req *dto.CreateAPIKeyRequest) (*dto.APIKeyCreatedResponse, error)
+	ListKeys() (*dto.APIKeyListResponse, error)
+	RevokeKey(publicID string) error
+	Authenticate(key string) (*model.APIKey, error)
+}
+
+// InvitationServiceInterface defines the interface for invitation service
+type InvitationServiceInterface interface {
+	CreateInvitation(invitedBy *model.User, req *dto.CreateInvitationRequest) (*dto.InvitationInfo, error)
+}
+
+// IdempotencyServiceInterface defines the interface for idempotency service
+type IdempotencyServiceInterface interface {
+	Begin(scope, key string, request []byte) (*model.IdempotencyKey, error)
+	Complete(record *model.IdempotencyKey, status int, header http.Header, body []byte) error
+	Release(record *model.IdempotencyKey) error
+	Run(ctx context.Context)
 }
 
 // HealthServiceInterface defines the interface for health service
 type HealthServiceInterface interface {
-	GetHealth() *dto.HealthResponse
+	GetHealth(ctx context.Context) *dto.HealthResponse
+	GetReadiness(ctx context.Context) *dto.HealthResponse
+}
+
+// StatusServiceInterface defines the interface for status service
+type StatusServiceInterface interface {
+	GetStatus() *dto.StatusResponse
 }
 
 // APIServiceInterface defines the interface for API service
 type APIServiceInterface interface {
 	GetTestMessage() map[string]string
 }
+
+// ProfileServiceInterface defines the interface for profile service
+type ProfileServiceInterface interface {
+	GetOnboarding(userID uint) (*dto.OnboardingResponse, error)
+	UpdateOnboarding(userID uint, req *dto.OnboardingRequest) (*dto.OnboardingResponse, error)
+}
+
+// UserServiceInterface defines the interface for user service
+type UserServiceInterface interface {
+	SearchUsers(userID uint, query string, limit int) (*dto.UserSearchResponse, error)
+	GetPrivacySettings(userID uint) (*dto.PrivacySettingsResponse, error)
+	UpdatePrivacySettings(userID uint, req *dto.PrivacySettingsRequest) (*dto.PrivacySettingsResponse, error)
+	UploadAvatar(ctx context.Context, userID uint, data []byte) (*dto.AvatarResponse, error)
+	DeleteAvatar(ctx context.Context, userID uint) error
+}
+
+// DataExportServiceInterface defines the interface for data export service
+type DataExportServiceInterface interface {
+	RequestExport(userID uint) (*dto.DataExportResponse, error)
+	GetExport(userID uint, publicID string) (*dto.DataExportResponse, error)
+	Download(ctx context.Context, publicID, token string) (*DataExportArchive, error)
+	Run(ctx context.Context)
+	ProcessPending(ctx context.Context) (int, error)
+}
+
+// UserPurgeServiceInterface defines the interface for user purge service
+type UserPurgeServiceInterface interface {
+	Run(ctx context.Context)
+	PurgeDue(ctx context.Context) (int, error)
+	PurgeUser(ctx context.Context, userPublicID string) error
+}
+
+// WaitlistServiceInterface defines the interface for waitlist service
+type WaitlistServiceInterface interface {
+	Join(req *dto.WaitlistJoinRequest, remoteIP string) error
+	ListEntries(params pagination.Params) (*dto.WaitlistListResponse, error)
+	InviteBatch(count int) (*dto.WaitlistInviteResponse, error)
+}
+
+// OutboxServiceInterface defines the interface for outbox service
+type OutboxServiceInterface interface {
+	Run(ctx context.Context)
+	DispatchDue(ctx context.Context) (int, error)
+	Stats() (*dto.OutboxStatsResponse, error)
+}
+
+// WebhookServiceInterface defines the interface for webhook service
+type WebhookServiceInterface interface {
+	CreateEndpoint(createdBy *model.User, req *dto.CreateWebhookRequest) (*dto.WebhookCreatedResponse, error)
+	ListEndpoints() (*dto.WebhookListResponse, error)
+	DeleteEndpoint(publicID string) error
+	HandleEvent(ctx context.Context, event events.Event)
+}
+
+// NotificationServiceInterface defines the interface for notification service
+type NotificationServiceInterface interface {
+	HandleEvent(ctx context.Context, event events.Event)
+	Notify(userID uint, notificationType string, data map[string]string) error
+	Subscribe(userID uint) (<-chan dto.Notification, func())
+	ListSince(userID uint, cursor string) ([]dto.Notification, error)
+}
+
+// DevEmailServiceInterface defines the interface for dev email service
+type DevEmailServiceInterface interface {
+	ListEmails(to string) (*dto.DevEmailListResponse, error)
+}
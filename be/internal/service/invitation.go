@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/publicid"
+	"strikepad-backend/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// defaultInvitationSignupURL is the frontend signup page that invitation emails link to
+const defaultInvitationSignupURL = "http://localhost:5173/signup"
+
+// InvitationService invites people to sign up by email. The emailed invite token is accepted by
+// AuthService when they sign up.
+type InvitationService struct {
+	userRepo   repository.UserRepository
+	txManager  repository.TxManagerInterface
+	jwtService *auth.JWTService
+	signupURL  string
+	ttl        time.Duration
+}
+
+// NewInvitationService creates a new invitation service
+func NewInvitationService(
+	userRepo repository.UserRepository,
+	txManager repository.TxManagerInterface,
+	jwtService *auth.JWTService,
+) InvitationServiceInterface {
+	return &InvitationService{
+		userRepo:   userRepo,
+		txManager:  txManager,
+		jwtService: jwtService,
+		signupURL:  config.GetEnv("INVITATION_SIGNUP_URL", defaultInvitationSignupURL),
+		ttl:        time.Duration(config.GetEnvInt("INVITATION_TTL_DAYS", 7)) * 24 * time.Hour,
+	}
+}
+
+// CreateInvitation invites the email address to sign up and queues the invitation email in the
+// same transaction. It returns auth.ErrUserAlreadyExists when the address is already registered.
+func (s *InvitationService) CreateInvitation(
+	invitedBy *model.User,
+	req *dto.CreateInvitationRequest,
+) (*dto.InvitationInfo, error) {
+	if err := auth.ValidateEmail(req.Email); err != nil {
+		return nil, err
+	}
+	email := auth.NormalizeEmail(req.Email)
+
+	existingUser, err := s.userRepo.FindByEmail(email)
+	if err != nil && !stderrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to check existing user: %w", err)
+	}
+	if existingUser != nil {
+		return nil, auth.ErrUserAlreadyExists
+	}
+
+	// The token names the invitation, so its public ID is assigned before the token is signed
+	invitation := &model.Invitation{
+		PublicID:        publicid.New(publicid.PrefixInvitation),
+		Email:           email,
+		InvitedByUserID: invitedBy.ID,
+	}
+	token, expiresAt, err := s.jwtService.GenerateInviteToken(invitation.PublicID, s.ttl)
+	if err != nil {
+		return nil, err
+	}
+	invitation.ExpiresAt = expiresAt
+
+	msg, err := mailer.NewMessage(email, mailer.TemplateInvitation, mailer.InvitationData{
+		InvitedBy:     invitedBy.DisplayName,
+		SignupURL:     s.signupURL + "?invite_token=" + url.QueryEscape(token),
+		ExpiresInDays: int(s.ttl.Hours() / 24),
+	})
+	if err != nil {
+		return nil, err
+	}
+	message, err := NewEmailOutboxMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithTransaction(context.Background(), func(repos *repository.Repositories) error {
+		if err := repos.Invitations.Create(invitation); err != nil {
+			return err
+		}
+		return repos.Outbox.Enqueue(message)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	slog.Info("Invitation sent", "invitation_id", invitation.PublicID, "invited_by", invitedBy.ID)
+	return &dto.InvitationInfo{
+		ID:              invitation.PublicID,
+		Email:           invitation.Email,
+		InvitedByUserID: invitedBy.PublicID,
+		ExpiresAt:       invitation.ExpiresAt,
+		CreatedAt:       invitation.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestInvitationService_CreateInvitation(t *testing.T) {
+	t.Setenv("INVITATION_SIGNUP_URL", "https://strikepad.example/signup")
+	admin := &model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na", DisplayName: "Admin User"}
+
+	t.Run("stores the invitation and queues the email", func(t *testing.T) {
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("FindByEmail", "friend@example.com").Return(nil, gorm.ErrRecordNotFound).Once()
+		txInvitationRepo := mocks.NewMockInvitationRepositoryInterface(t)
+		var stored *model.Invitation
+		txInvitationRepo.EXPECT().Create(mock.AnythingOfType("*model.Invitation")).
+			Run(func(invitation *model.Invitation) { stored = invitation }).
+			Return(nil).Once()
+		txOutboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+		var queued *model.OutboxMessage
+		txOutboxRepo.EXPECT().Enqueue(mock.AnythingOfType("*model.OutboxMessage")).
+			Run(func(message *model.OutboxMessage) { queued = message }).
+			Return(nil).Once()
+		txManager := mocks.NewMockTxManagerInterface(t)
+		txManager.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(repos *repository.Repositories) error) error {
+				return fn(&repository.Repositories{Invitations: txInvitationRepo, Outbox: txOutboxRepo})
+			}).Once()
+		jwtService := auth.NewJWTService(clock.New())
+
+		response, err := service.NewInvitationService(userRepo, txManager, jwtService).
+			CreateInvitation(admin, &dto.CreateInvitationRequest{Email: "Friend@Example.com"})
+
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.Equal(t, "friend@example.com", stored.Email)
+		assert.Equal(t, uint(1), stored.InvitedByUserID)
+		assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), stored.ExpiresAt, time.Minute)
+		assert.Equal(t, stored.PublicID, response.ID)
+		assert.Equal(t, "usr_5k2d9x0q7m3hv8c1b4na", response.InvitedByUserID)
+
+		require.NotNil(t, queued)
+		var msg mailer.Message
+		require.NoError(t, json.Unmarshal([]byte(queued.Payload), &msg))
+		assert.Equal(t, "friend@example.com", msg.To)
+		assert.Equal(t, "Admin User invited you to StrikePad", msg.Subject)
+
+		link := msg.Text[strings.Index(msg.Text, "https://strikepad.example/"):]
+		signupURL, err := url.Parse(strings.Fields(link)[0])
+		require.NoError(t, err)
+		claims, err := jwtService.ValidateInviteToken(signupURL.Query().Get("invite_token"))
+		require.NoError(t, err)
+		assert.Equal(t, stored.PublicID, claims.ID)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects registered email addresses", func(t *testing.T) {
+		email := "member@example.com"
+		userRepo := new(mocks.MockUserRepository)
+		userRepo.On("FindByEmail", email).Return(&model.User{ID: 2, Email: &email}, nil).Once()
+
+		invitationService := service.NewInvitationService(
+			userRepo, mocks.NewMockTxManagerInterface(t), auth.NewJWTService(clock.New()),
+		)
+		_, err := invitationService.CreateInvitation(admin, &dto.CreateInvitationRequest{Email: email})
+
+		assert.ErrorIs(t, err, auth.ErrUserAlreadyExists)
+		userRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+)
+
+const (
+	// defaultLoginAlertRevokeURL is the endpoint that revokes a session from the link in a new sign-in alert
+	defaultLoginAlertRevokeURL = "http://localhost:8080/api/auth/sessions/revoke"
+	// loginAlertMaxSessions caps how many recent sessions a sign-in is compared with
+	loginAlertMaxSessions = 100
+)
+
+// LoginAlertService emails users when they sign in from a device or a country that none of their
+// recent sessions came from, with a link that revokes the new session
+type LoginAlertService struct {
+	sessionRepo repository.SessionRepositoryInterface
+	userRepo    repository.UserRepository
+	outboxRepo  repository.OutboxRepositoryInterface
+	jwtService  *auth.JWTService
+	revokeURL   string
+	lookback    time.Duration
+	enabled     bool
+}
+
+// NewLoginAlertService creates a new login alert service
+func NewLoginAlertService(
+	sessionRepo repository.SessionRepositoryInterface,
+	userRepo repository.UserRepository,
+	outboxRepo repository.OutboxRepositoryInterface,
+	jwtService *auth.JWTService,
+) LoginAlertServiceInterface {
+	return &LoginAlertService{
+		sessionRepo: sessionRepo,
+		userRepo:    userRepo,
+		outboxRepo:  outboxRepo,
+		jwtService:  jwtService,
+		revokeURL:   config.GetEnv("LOGIN_ALERT_REVOKE_URL", defaultLoginAlertRevokeURL),
+		lookback:    time.Duration(config.GetEnvInt("LOGIN_ALERT_LOOKBACK_DAYS", 90)) * 24 * time.Hour,
+		enabled:     config.GetEnvBool("LOGIN_ALERTS_ENABLED", true),
+	}
+}
+
+// CheckLogin compares a new session with the user's recent sessions and queues an alert email
+// when it comes from a new device or country. Failures are logged, since the sign-in has already
+// succeeded.
+func (s *LoginAlertService) CheckLogin(session *model.UserSession) {
+	if !s.enabled {
+		return
+	}
+
+	prior, err := s.sessionRepo.FindRecentByUserID(
+		session.UserID, session.CreatedAt.Add(-s.lookback), session.ID, loginAlertMaxSessions)
+	if err != nil {
+		slog.Error("Failed to load recent sessions for login alert", "user_id", session.UserID, "error", err)
+		return
+	}
+
+	newDevice, newCountry := newLoginTraits(session, prior)
+	if !newDevice && !newCountry {
+		return
+	}
+	if err := s.sendAlert(session, newDevice); err != nil {
+		slog.Error("Failed to queue login alert", "user_id", session.UserID, "session_id", session.PublicID,
+			"error", err)
+		return
+	}
+
+	slog.Info("Login alert queued", "user_id", session.UserID, "session_id", session.PublicID,
+		"new_device", newDevice, "new_country", newCountry)
+}
+
+// sendAlert queues the new sign-in email for the session's user
+func (s *LoginAlertService) sendAlert(session *model.UserSession, newDevice bool) error {
+	user, err := s.userRepo.GetByID(session.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+	if user.Email == nil {
+		slog.Info("User has no email address, skipping login alert", "user_id", user.ID)
+		return nil
+	}
+
+	token, err := s.jwtService.GenerateSessionRevokeToken(user.ID, session.PublicID)
+	if err != nil {
+		return err
+	}
+
+	data := mailer.NewLoginData{
+		DisplayName:   user.DisplayName,
+		Device:        describeDevice(session.UserAgent),
+		IPAddress:     session.IPAddress,
+		Time:          session.CreatedAt.UTC().Format(time.RFC1123),
+		RevokeURL:     s.revokeURL + "?token=" + url.QueryEscape(token),
+		ExpiresInDays: int(auth.SessionRevokeTokenTTL.Hours() / 24),
+		NewDevice:     newDevice,
+	}
+	if session.Country != nil {
+		data.Country = *session.Country
+	}
+
+	msg, err := mailer.NewMessage(*user.Email, mailer.TemplateNewLogin, data)
+	if err != nil {
+		return err
+	}
+	message, err := NewEmailOutboxMessage(msg)
+	if err != nil {
+		return err
+	}
+	return s.outboxRepo.Enqueue(message)
+}
+
+// newLoginTraits reports whether the session comes from a device, and from a country, that none of
+// the prior sessions came from. The first sign-in is never new, as there is nothing to compare with,
+// and a country only counts once prior sessions have one, so that setting LOGIN_COUNTRY_HEADER does
+// not alert every user.
+func newLoginTraits(session *model.UserSession, prior []*model.UserSession) (newDevice, newCountry bool) {
+	if len(prior) == 0 {
+		return false, false
+	}
+
+	device := describeDevice(session.UserAgent)
+	newDevice = true
+	newCountry = session.Country != nil
+	knownCountries := 0
+	for _, p := range prior {
+		if describeDevice(p.UserAgent) == device {
+			newDevice = false
+		}
+		if p.Country != nil {
+			knownCountries++
+			if session.Country != nil && *p.Country == *session.Country {
+				newCountry = false
+			}
+		}
+	}
+	return newDevice, newCountry && knownCountries > 0
+}
+
+// describeDevice names the browser and operating system of a user agent, e.g. "Chrome on Windows",
+// so that browser updates do not count as new devices. Unrecognized user agents are returned as they are.
+func describeDevice(userAgent string) string {
+	browser := firstMatch(userAgent, [][2]string{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"FxiOS/", "Firefox"},
+		{"CriOS/", "Chrome"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	})
+	// iOS and Android user agents also name macOS and Linux, so they are checked first
+	os := firstMatch(userAgent, [][2]string{
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	})
+
+	switch {
+	case browser != "" && os != "":
+		return browser + " on " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	case strings.TrimSpace(userAgent) == "":
+		return "Unknown device"
+	default:
+		return userAgent
+	}
+}
+
+// firstMatch returns the name of the first pattern found in s, or "" when none is
+func firstMatch(s string, patterns [][2]string) string {
+	for _, pattern := range patterns {
+		if strings.Contains(s, pattern[0]) {
+			return pattern[1]
+		}
+	}
+	return ""
+}
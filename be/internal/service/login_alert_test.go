@@ -0,0 +1,177 @@
+package service
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chromeOnWindows = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) " +
+		"Chrome/129.0.0.0 Safari/537.36"
+	safariOnIPhone = "Mozilla/5.0 (iPhone; CPU iPhone OS 18_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) " +
+		"Version/18.0 Mobile/15E148 Safari/604.1"
+)
+
+func TestDescribeDevice(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		expected  string
+	}{
+		{chromeOnWindows, "Chrome on Windows"},
+		{strings.Replace(chromeOnWindows, "Chrome/129.0.0.0", "Chrome/130.0.1.2", 1), "Chrome on Windows"},
+		{safariOnIPhone, "Safari on iPhone"},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0", "Firefox on Linux"},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 Chrome/129.0 Mobile Safari/537.36",
+			"Chrome on Android"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_6) AppleWebKit/537.36 Chrome/129.0 Safari/537.36 Edg/129.0",
+			"Edge on macOS"},
+		{"curl/8.7.1", "curl/8.7.1"},
+		{"", "Unknown device"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, describeDevice(tt.userAgent))
+		})
+	}
+}
+
+func TestNewLoginTraits(t *testing.T) {
+	jp, us := "JP", "US"
+
+	tests := []struct {
+		country            *string
+		name               string
+		userAgent          string
+		prior              []*model.UserSession
+		expectedNewDevice  bool
+		expectedNewCountry bool
+	}{
+		{
+			name:      "first sign-in",
+			userAgent: chromeOnWindows,
+			country:   &jp,
+		},
+		{
+			name:      "known device and country",
+			userAgent: chromeOnWindows,
+			country:   &jp,
+			prior:     []*model.UserSession{{UserAgent: safariOnIPhone, Country: &us}, {UserAgent: chromeOnWindows, Country: &jp}},
+		},
+		{
+			name:              "new device",
+			userAgent:         safariOnIPhone,
+			country:           &jp,
+			prior:             []*model.UserSession{{UserAgent: chromeOnWindows, Country: &jp}},
+			expectedNewDevice: true,
+		},
+		{
+			name:               "new country",
+			userAgent:          chromeOnWindows,
+			country:            &us,
+			prior:              []*model.UserSession{{UserAgent: chromeOnWindows, Country: &jp}},
+			expectedNewCountry: true,
+		},
+		{
+			name:      "no country recorded before",
+			userAgent: chromeOnWindows,
+			country:   &us,
+			prior:     []*model.UserSession{{UserAgent: chromeOnWindows}},
+		},
+		{
+			name:      "country unknown",
+			userAgent: chromeOnWindows,
+			prior:     []*model.UserSession{{UserAgent: chromeOnWindows, Country: &jp}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &model.UserSession{UserAgent: tt.userAgent, Country: tt.country}
+
+			newDevice, newCountry := newLoginTraits(session, tt.prior)
+
+			assert.Equal(t, tt.expectedNewDevice, newDevice)
+			assert.Equal(t, tt.expectedNewCountry, newCountry)
+		})
+	}
+}
+
+func TestLoginAlertService_CheckLogin(t *testing.T) {
+	t.Setenv("LOGIN_ALERT_REVOKE_URL", "https://api.strikepad.example/api/auth/sessions/revoke")
+	email := "user@example.com"
+	user := &model.User{ID: 7, PublicID: "usr_5k2d9x0q7m3hv8c1b4na", Email: &email, DisplayName: "Test User"}
+	session := &model.UserSession{
+		ID:        12,
+		PublicID:  "ses_7d2k9m4q0x8h3v1c5b6n",
+		UserID:    7,
+		UserAgent: safariOnIPhone,
+		IPAddress: "203.0.113.7",
+		CreatedAt: time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC),
+	}
+	since := session.CreatedAt.Add(-90 * 24 * time.Hour)
+
+	t.Run("queues an alert for a new device", func(t *testing.T) {
+		sessionRepo := new(mocks.MockSessionRepository)
+		sessionRepo.On("FindRecentByUserID", uint(7), since, uint(12), loginAlertMaxSessions).
+			Return([]*model.UserSession{{UserAgent: chromeOnWindows}}, nil).Once()
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByID(uint(7)).Return(user, nil).Once()
+		outboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+		var queued *model.OutboxMessage
+		outboxRepo.EXPECT().Enqueue(mock.AnythingOfType("*model.OutboxMessage")).
+			Run(func(message *model.OutboxMessage) { queued = message }).
+			Return(nil).Once()
+		jwtService := auth.NewJWTService(clock.New())
+
+		NewLoginAlertService(sessionRepo, userRepo, outboxRepo, jwtService).CheckLogin(session)
+
+		require.NotNil(t, queued)
+		assert.Equal(t, model.OutboxKindEmail, queued.Kind)
+		var msg mailer.Message
+		require.NoError(t, json.Unmarshal([]byte(queued.Payload), &msg))
+		assert.Equal(t, email, msg.To)
+		assert.Contains(t, msg.Text, "Device: Safari on iPhone")
+		assert.Contains(t, msg.Text, "IP address: 203.0.113.7")
+
+		link := msg.Text[strings.Index(msg.Text, "https://api.strikepad.example/"):]
+		revokeURL, err := url.Parse(strings.Fields(link)[0])
+		require.NoError(t, err)
+		claims, err := jwtService.ValidateSessionRevokeToken(revokeURL.Query().Get("token"))
+		require.NoError(t, err)
+		assert.Equal(t, uint(7), claims.UserID)
+		assert.Equal(t, "ses_7d2k9m4q0x8h3v1c5b6n", claims.ID)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("known device", func(t *testing.T) {
+		sessionRepo := new(mocks.MockSessionRepository)
+		sessionRepo.On("FindRecentByUserID", uint(7), since, uint(12), loginAlertMaxSessions).
+			Return([]*model.UserSession{{UserAgent: safariOnIPhone}}, nil).Once()
+
+		NewLoginAlertService(sessionRepo, mocks.NewMockUserRepository(t), mocks.NewMockOutboxRepositoryInterface(t),
+			auth.NewJWTService(clock.New())).CheckLogin(session)
+
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv("LOGIN_ALERTS_ENABLED", "false")
+
+		NewLoginAlertService(new(mocks.MockSessionRepository), mocks.NewMockUserRepository(t),
+			mocks.NewMockOutboxRepositoryInterface(t), auth.NewJWTService(clock.New())).CheckLogin(session)
+	})
+}
@@ -3,8 +3,16 @@
 package mocks
 
 import (
+	auth "strikepad-backend/internal/auth"
+
+	context "context"
+
 	dto "strikepad-backend/internal/dto"
 
+	oauth "strikepad-backend/internal/oauth"
+
+	service "strikepad-backend/internal/service"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,29 +29,86 @@ func (_m *MockAuthServiceInterface) EXPECT() *MockAuthServiceInterface_Expecter
 	return &MockAuthServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function with given fields: req
-func (_m *MockAuthServiceInterface) Login(req *dto.LoginRequest) (*dto.UserInfo, error) {
-	ret := _m.Called(req)
+// GoogleAuthorize provides a mock function with no fields
+func (_m *MockAuthServiceInterface) GoogleAuthorize() (*oauth.GoogleAuthorization, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for GoogleAuthorize")
+	}
+
+	var r0 *oauth.GoogleAuthorization
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*oauth.GoogleAuthorization, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *oauth.GoogleAuthorization); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oauth.GoogleAuthorization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_GoogleAuthorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleAuthorize'
+type MockAuthServiceInterface_GoogleAuthorize_Call struct {
+	*mock.Call
+}
+
+// GoogleAuthorize is a helper method to define mock.On call
+func (_e *MockAuthServiceInterface_Expecter) GoogleAuthorize() *MockAuthServiceInterface_GoogleAuthorize_Call {
+	return &MockAuthServiceInterface_GoogleAuthorize_Call{Call: _e.mock.On("GoogleAuthorize")}
+}
+
+func (_c *MockAuthServiceInterface_GoogleAuthorize_Call) Run(run func()) *MockAuthServiceInterface_GoogleAuthorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_GoogleAuthorize_Call) Return(_a0 *oauth.GoogleAuthorization, _a1 error) *MockAuthServiceInterface_GoogleAuthorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_GoogleAuthorize_Call) RunAndReturn(run func() (*oauth.GoogleAuthorization, error)) *MockAuthServiceInterface_GoogleAuthorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GoogleCallback provides a mock function with given fields: code, state, stateToken
+func (_m *MockAuthServiceInterface) GoogleCallback(code string, state string, stateToken string) (*dto.UserInfo, error) {
+	ret := _m.Called(code, state, stateToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GoogleCallback")
 	}
 
 	var r0 *dto.UserInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*dto.LoginRequest) (*dto.UserInfo, error)); ok {
-		return rf(req)
+	if rf, ok := ret.Get(0).(func(string, string, string) (*dto.UserInfo, error)); ok {
+		return rf(code, state, stateToken)
 	}
-	if rf, ok := ret.Get(0).(func(*dto.LoginRequest) *dto.UserInfo); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(string, string, string) *dto.UserInfo); ok {
+		r0 = rf(code, state, stateToken)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.UserInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*dto.LoginRequest) error); ok {
-		r1 = rf(req)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(code, state, stateToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -51,56 +116,58 @@ func (_m *MockAuthServiceInterface) Login(req *dto.LoginRequest) (*dto.UserInfo,
 	return r0, r1
 }
 
-// MockAuthServiceInterface_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type MockAuthServiceInterface_Login_Call struct {
+// MockAuthServiceInterface_GoogleCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleCallback'
+type MockAuthServiceInterface_GoogleCallback_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
-//   - req *dto.LoginRequest
-func (_e *MockAuthServiceInterface_Expecter) Login(req interface{}) *MockAuthServiceInterface_Login_Call {
-	return &MockAuthServiceInterface_Login_Call{Call: _e.mock.On("Login", req)}
+// GoogleCallback is a helper method to define mock.On call
+//   - code string
+//   - state string
+//   - stateToken string
+func (_e *MockAuthServiceInterface_Expecter) GoogleCallback(code interface{}, state interface{}, stateToken interface{}) *MockAuthServiceInterface_GoogleCallback_Call {
+	return &MockAuthServiceInterface_GoogleCallback_Call{Call: _e.mock.On("GoogleCallback", code, state, stateToken)}
 }
 
-func (_c *MockAuthServiceInterface_Login_Call) Run(run func(req *dto.LoginRequest)) *MockAuthServiceInterface_Login_Call {
+func (_c *MockAuthServiceInterface_GoogleCallback_Call) Run(run func(code string, state string, stateToken string)) *MockAuthServiceInterface_GoogleCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*dto.LoginRequest))
+		run(args[0].(string), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_Login_Call) Return(_a0 *dto.UserInfo, _a1 error) *MockAuthServiceInterface_Login_Call {
+func (_c *MockAuthServiceInterface_GoogleCallback_Call) Return(_a0 *dto.UserInfo, _a1 error) *MockAuthServiceInterface_GoogleCallback_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_Login_Call) RunAndReturn(run func(*dto.LoginRequest) (*dto.UserInfo, error)) *MockAuthServiceInterface_Login_Call {
+func (_c *MockAuthServiceInterface_GoogleCallback_Call) RunAndReturn(run func(string, string, string) (*dto.UserInfo, error)) *MockAuthServiceInterface_GoogleCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Signup provides a mock function with given fields: req
-func (_m *MockAuthServiceInterface) Signup(req *dto.SignupRequest) (*dto.SignupResponse, error) {
+// GoogleLogin provides a mock function with given fields: req
+func (_m *MockAuthServiceInterface) GoogleLogin(req *dto.GoogleLoginRequest) (*dto.UserInfo, error) {
 	ret := _m.Called(req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Signup")
+		panic("no return value specified for GoogleLogin")
 	}
 
-	var r0 *dto.SignupResponse
+	var r0 *dto.UserInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*dto.SignupRequest) (*dto.SignupResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(*dto.GoogleLoginRequest) (*dto.UserInfo, error)); ok {
 		return rf(req)
 	}
-	if rf, ok := ret.Get(0).(func(*dto.SignupRequest) *dto.SignupResponse); ok {
+	if rf, ok := ret.Get(0).(func(*dto.GoogleLoginRequest) *dto.UserInfo); ok {
 		r0 = rf(req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.SignupResponse)
+			r0 = ret.Get(0).(*dto.UserInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*dto.SignupRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(*dto.GoogleLoginRequest) error); ok {
 		r1 = rf(req)
 	} else {
 		r1 = ret.Error(1)
@@ -109,30 +176,30 @@ func (_m *MockAuthServiceInterface) Signup(req *dto.SignupRequest) (*dto.SignupR
 	return r0, r1
 }
 
-// MockAuthServiceInterface_Signup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signup'
-type MockAuthServiceInterface_Signup_Call struct {
+// MockAuthServiceInterface_GoogleLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleLogin'
+type MockAuthServiceInterface_GoogleLogin_Call struct {
 	*mock.Call
 }
 
-// Signup is a helper method to define mock.On call
-//   - req *dto.SignupRequest
-func (_e *MockAuthServiceInterface_Expecter) Signup(req interface{}) *MockAuthServiceInterface_Signup_Call {
-	return &MockAuthServiceInterface_Signup_Call{Call: _e.mock.On("Signup", req)}
+// GoogleLogin is a helper method to define mock.On call
+//   - req *dto.GoogleLoginRequest
+func (_e *MockAuthServiceInterface_Expecter) GoogleLogin(req interface{}) *MockAuthServiceInterface_GoogleLogin_Call {
+	return &MockAuthServiceInterface_GoogleLogin_Call{Call: _e.mock.On("GoogleLogin", req)}
 }
 
-func (_c *MockAuthServiceInterface_Signup_Call) Run(run func(req *dto.SignupRequest)) *MockAuthServiceInterface_Signup_Call {
+func (_c *MockAuthServiceInterface_GoogleLogin_Call) Run(run func(req *dto.GoogleLoginRequest)) *MockAuthServiceInterface_GoogleLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*dto.SignupRequest))
+		run(args[0].(*dto.GoogleLoginRequest))
 	})
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_Signup_Call) Return(_a0 *dto.SignupResponse, _a1 error) *MockAuthServiceInterface_Signup_Call {
+func (_c *MockAuthServiceInterface_GoogleLogin_Call) Return(_a0 *dto.UserInfo, _a1 error) *MockAuthServiceInterface_GoogleLogin_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_Signup_Call) RunAndReturn(run func(*dto.SignupRequest) (*dto.SignupResponse, error)) *MockAuthServiceInterface_Signup_Call {
+func (_c *MockAuthServiceInterface_GoogleLogin_Call) RunAndReturn(run func(*dto.GoogleLoginRequest) (*dto.UserInfo, error)) *MockAuthServiceInterface_GoogleLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -195,20 +262,79 @@ func (_c *MockAuthServiceInterface_GoogleSignup_Call) RunAndReturn(run func(*dto
 	return _c
 }
 
-// GoogleLogin provides a mock function with given fields: req
-func (_m *MockAuthServiceInterface) GoogleLogin(req *dto.GoogleLoginRequest) (*dto.UserInfo, error) {
+// LinkGoogleIdentity provides a mock function with given fields: userID, req
+func (_m *MockAuthServiceInterface) LinkGoogleIdentity(userID uint, req *dto.LinkGoogleIdentityRequest) (*dto.IdentityInfo, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkGoogleIdentity")
+	}
+
+	var r0 *dto.IdentityInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *dto.LinkGoogleIdentityRequest) (*dto.IdentityInfo, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *dto.LinkGoogleIdentityRequest) *dto.IdentityInfo); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.IdentityInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *dto.LinkGoogleIdentityRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_LinkGoogleIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkGoogleIdentity'
+type MockAuthServiceInterface_LinkGoogleIdentity_Call struct {
+	*mock.Call
+}
+
+// LinkGoogleIdentity is a helper method to define mock.On call
+//   - userID uint
+//   - req *dto.LinkGoogleIdentityRequest
+func (_e *MockAuthServiceInterface_Expecter) LinkGoogleIdentity(userID interface{}, req interface{}) *MockAuthServiceInterface_LinkGoogleIdentity_Call {
+	return &MockAuthServiceInterface_LinkGoogleIdentity_Call{Call: _e.mock.On("LinkGoogleIdentity", userID, req)}
+}
+
+func (_c *MockAuthServiceInterface_LinkGoogleIdentity_Call) Run(run func(userID uint, req *dto.LinkGoogleIdentityRequest)) *MockAuthServiceInterface_LinkGoogleIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(*dto.LinkGoogleIdentityRequest))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_LinkGoogleIdentity_Call) Return(_a0 *dto.IdentityInfo, _a1 error) *MockAuthServiceInterface_LinkGoogleIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_LinkGoogleIdentity_Call) RunAndReturn(run func(uint, *dto.LinkGoogleIdentityRequest) (*dto.IdentityInfo, error)) *MockAuthServiceInterface_LinkGoogleIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: req
+func (_m *MockAuthServiceInterface) Login(req *dto.LoginRequest) (*dto.UserInfo, error) {
 	ret := _m.Called(req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GoogleLogin")
+		panic("no return value specified for Login")
 	}
 
 	var r0 *dto.UserInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*dto.GoogleLoginRequest) (*dto.UserInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(*dto.LoginRequest) (*dto.UserInfo, error)); ok {
 		return rf(req)
 	}
-	if rf, ok := ret.Get(0).(func(*dto.GoogleLoginRequest) *dto.UserInfo); ok {
+	if rf, ok := ret.Get(0).(func(*dto.LoginRequest) *dto.UserInfo); ok {
 		r0 = rf(req)
 	} else {
 		if ret.Get(0) != nil {
@@ -216,7 +342,7 @@ func (_m *MockAuthServiceInterface) GoogleLogin(req *dto.GoogleLoginRequest) (*d
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*dto.GoogleLoginRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(*dto.LoginRequest) error); ok {
 		r1 = rf(req)
 	} else {
 		r1 = ret.Error(1)
@@ -225,30 +351,275 @@ func (_m *MockAuthServiceInterface) GoogleLogin(req *dto.GoogleLoginRequest) (*d
 	return r0, r1
 }
 
-// MockAuthServiceInterface_GoogleLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoogleLogin'
-type MockAuthServiceInterface_GoogleLogin_Call struct {
+// MockAuthServiceInterface_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthServiceInterface_Login_Call struct {
 	*mock.Call
 }
 
-// GoogleLogin is a helper method to define mock.On call
-//   - req *dto.GoogleLoginRequest
-func (_e *MockAuthServiceInterface_Expecter) GoogleLogin(req interface{}) *MockAuthServiceInterface_GoogleLogin_Call {
-	return &MockAuthServiceInterface_GoogleLogin_Call{Call: _e.mock.On("GoogleLogin", req)}
+// Login is a helper method to define mock.On call
+//   - req *dto.LoginRequest
+func (_e *MockAuthServiceInterface_Expecter) Login(req interface{}) *MockAuthServiceInterface_Login_Call {
+	return &MockAuthServiceInterface_Login_Call{Call: _e.mock.On("Login", req)}
 }
 
-func (_c *MockAuthServiceInterface_GoogleLogin_Call) Run(run func(req *dto.GoogleLoginRequest)) *MockAuthServiceInterface_GoogleLogin_Call {
+func (_c *MockAuthServiceInterface_Login_Call) Run(run func(req *dto.LoginRequest)) *MockAuthServiceInterface_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*dto.GoogleLoginRequest))
+		run(args[0].(*dto.LoginRequest))
 	})
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_GoogleLogin_Call) Return(_a0 *dto.UserInfo, _a1 error) *MockAuthServiceInterface_GoogleLogin_Call {
+func (_c *MockAuthServiceInterface_Login_Call) Return(_a0 *dto.UserInfo, _a1 error) *MockAuthServiceInterface_Login_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_GoogleLogin_Call) RunAndReturn(run func(*dto.GoogleLoginRequest) (*dto.UserInfo, error)) *MockAuthServiceInterface_GoogleLogin_Call {
+func (_c *MockAuthServiceInterface_Login_Call) RunAndReturn(run func(*dto.LoginRequest) (*dto.UserInfo, error)) *MockAuthServiceInterface_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderLogin provides a mock function with given fields: provider, req
+func (_m *MockAuthServiceInterface) ProviderLogin(provider string, req *dto.OAuthRequest) (*dto.UserInfo, error) {
+	ret := _m.Called(provider, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderLogin")
+	}
+
+	var r0 *dto.UserInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *dto.OAuthRequest) (*dto.UserInfo, error)); ok {
+		return rf(provider, req)
+	}
+	if rf, ok := ret.Get(0).(func(string, *dto.OAuthRequest) *dto.UserInfo); ok {
+		r0 = rf(provider, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UserInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *dto.OAuthRequest) error); ok {
+		r1 = rf(provider, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_ProviderLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderLogin'
+type MockAuthServiceInterface_ProviderLogin_Call struct {
+	*mock.Call
+}
+
+// ProviderLogin is a helper method to define mock.On call
+//   - provider string
+//   - req *dto.OAuthRequest
+func (_e *MockAuthServiceInterface_Expecter) ProviderLogin(provider interface{}, req interface{}) *MockAuthServiceInterface_ProviderLogin_Call {
+	return &MockAuthServiceInterface_ProviderLogin_Call{Call: _e.mock.On("ProviderLogin", provider, req)}
+}
+
+func (_c *MockAuthServiceInterface_ProviderLogin_Call) Run(run func(provider string, req *dto.OAuthRequest)) *MockAuthServiceInterface_ProviderLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*dto.OAuthRequest))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ProviderLogin_Call) Return(_a0 *dto.UserInfo, _a1 error) *MockAuthServiceInterface_ProviderLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ProviderLogin_Call) RunAndReturn(run func(string, *dto.OAuthRequest) (*dto.UserInfo, error)) *MockAuthServiceInterface_ProviderLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderSignup provides a mock function with given fields: provider, req
+func (_m *MockAuthServiceInterface) ProviderSignup(provider string, req *dto.OAuthRequest) (*dto.SignupResponse, error) {
+	ret := _m.Called(provider, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderSignup")
+	}
+
+	var r0 *dto.SignupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *dto.OAuthRequest) (*dto.SignupResponse, error)); ok {
+		return rf(provider, req)
+	}
+	if rf, ok := ret.Get(0).(func(string, *dto.OAuthRequest) *dto.SignupResponse); ok {
+		r0 = rf(provider, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SignupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *dto.OAuthRequest) error); ok {
+		r1 = rf(provider, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_ProviderSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderSignup'
+type MockAuthServiceInterface_ProviderSignup_Call struct {
+	*mock.Call
+}
+
+// ProviderSignup is a helper method to define mock.On call
+//   - provider string
+//   - req *dto.OAuthRequest
+func (_e *MockAuthServiceInterface_Expecter) ProviderSignup(provider interface{}, req interface{}) *MockAuthServiceInterface_ProviderSignup_Call {
+	return &MockAuthServiceInterface_ProviderSignup_Call{Call: _e.mock.On("ProviderSignup", provider, req)}
+}
+
+func (_c *MockAuthServiceInterface_ProviderSignup_Call) Run(run func(provider string, req *dto.OAuthRequest)) *MockAuthServiceInterface_ProviderSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*dto.OAuthRequest))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ProviderSignup_Call) Return(_a0 *dto.SignupResponse, _a1 error) *MockAuthServiceInterface_ProviderSignup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_ProviderSignup_Call) RunAndReturn(run func(string, *dto.OAuthRequest) (*dto.SignupResponse, error)) *MockAuthServiceInterface_ProviderSignup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Signup provides a mock function with given fields: req
+func (_m *MockAuthServiceInterface) Signup(req *dto.SignupRequest) (*dto.SignupResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Signup")
+	}
+
+	var r0 *dto.SignupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dto.SignupRequest) (*dto.SignupResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.SignupRequest) *dto.SignupResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SignupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.SignupRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceInterface_Signup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signup'
+type MockAuthServiceInterface_Signup_Call struct {
+	*mock.Call
+}
+
+// Signup is a helper method to define mock.On call
+//   - req *dto.SignupRequest
+func (_e *MockAuthServiceInterface_Expecter) Signup(req interface{}) *MockAuthServiceInterface_Signup_Call {
+	return &MockAuthServiceInterface_Signup_Call{Call: _e.mock.On("Signup", req)}
+}
+
+func (_c *MockAuthServiceInterface_Signup_Call) Run(run func(req *dto.SignupRequest)) *MockAuthServiceInterface_Signup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.SignupRequest))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Signup_Call) Return(_a0 *dto.SignupResponse, _a1 error) *MockAuthServiceInterface_Signup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_Signup_Call) RunAndReturn(run func(*dto.SignupRequest) (*dto.SignupResponse, error)) *MockAuthServiceInterface_Signup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignupWithSession provides a mock function with given fields: ctx, req, metadata
+func (_m *MockAuthServiceInterface) SignupWithSession(ctx context.Context, req *dto.SignupRequest, metadata service.SessionMetadata) (*dto.SignupResponse, *auth.TokenPair, error) {
+	ret := _m.Called(ctx, req, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignupWithSession")
+	}
+
+	var r0 *dto.SignupResponse
+	var r1 *auth.TokenPair
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SignupRequest, service.SessionMetadata) (*dto.SignupResponse, *auth.TokenPair, error)); ok {
+		return rf(ctx, req, metadata)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SignupRequest, service.SessionMetadata) *dto.SignupResponse); ok {
+		r0 = rf(ctx, req, metadata)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SignupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.SignupRequest, service.SessionMetadata) *auth.TokenPair); ok {
+		r1 = rf(ctx, req, metadata)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*auth.TokenPair)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *dto.SignupRequest, service.SessionMetadata) error); ok {
+		r2 = rf(ctx, req, metadata)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAuthServiceInterface_SignupWithSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignupWithSession'
+type MockAuthServiceInterface_SignupWithSession_Call struct {
+	*mock.Call
+}
+
+// SignupWithSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.SignupRequest
+//   - metadata service.SessionMetadata
+func (_e *MockAuthServiceInterface_Expecter) SignupWithSession(ctx interface{}, req interface{}, metadata interface{}) *MockAuthServiceInterface_SignupWithSession_Call {
+	return &MockAuthServiceInterface_SignupWithSession_Call{Call: _e.mock.On("SignupWithSession", ctx, req, metadata)}
+}
+
+func (_c *MockAuthServiceInterface_SignupWithSession_Call) Run(run func(ctx context.Context, req *dto.SignupRequest, metadata service.SessionMetadata)) *MockAuthServiceInterface_SignupWithSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*dto.SignupRequest), args[2].(service.SessionMetadata))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_SignupWithSession_Call) Return(_a0 *dto.SignupResponse, _a1 *auth.TokenPair, _a2 error) *MockAuthServiceInterface_SignupWithSession_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAuthServiceInterface_SignupWithSession_Call) RunAndReturn(run func(context.Context, *dto.SignupRequest, service.SessionMetadata) (*dto.SignupResponse, *auth.TokenPair, error)) *MockAuthServiceInterface_SignupWithSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -265,4 +636,4 @@ func NewMockAuthServiceInterface(t interface {
 	t.Cleanup(func() { mock.AssertExpectations(t) })
 
 	return mock
-}
\ No newline at end of file
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	dto "strikepad-backend/internal/dto"
 
 	mock "github.com/stretchr/testify/mock"
@@ -21,17 +23,17 @@ func (_m *MockHealthServiceInterface) EXPECT() *MockHealthServiceInterface_Expec
 	return &MockHealthServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// GetHealth provides a mock function with no fields
-func (_m *MockHealthServiceInterface) GetHealth() *dto.HealthResponse {
-	ret := _m.Called()
+// GetHealth provides a mock function with given fields: ctx
+func (_m *MockHealthServiceInterface) GetHealth(ctx context.Context) *dto.HealthResponse {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetHealth")
 	}
 
 	var r0 *dto.HealthResponse
-	if rf, ok := ret.Get(0).(func() *dto.HealthResponse); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.HealthResponse); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.HealthResponse)
@@ -47,13 +49,14 @@ type MockHealthServiceInterface_GetHealth_Call struct {
 }
 
 // GetHealth is a helper method to define mock.On call
-func (_e *MockHealthServiceInterface_Expecter) GetHealth() *MockHealthServiceInterface_GetHealth_Call {
-	return &MockHealthServiceInterface_GetHealth_Call{Call: _e.mock.On("GetHealth")}
+//   - ctx context.Context
+func (_e *MockHealthServiceInterface_Expecter) GetHealth(ctx interface{}) *MockHealthServiceInterface_GetHealth_Call {
+	return &MockHealthServiceInterface_GetHealth_Call{Call: _e.mock.On("GetHealth", ctx)}
 }
 
-func (_c *MockHealthServiceInterface_GetHealth_Call) Run(run func()) *MockHealthServiceInterface_GetHealth_Call {
+func (_c *MockHealthServiceInterface_GetHealth_Call) Run(run func(ctx context.Context)) *MockHealthServiceInterface_GetHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -63,7 +66,55 @@ func (_c *MockHealthServiceInterface_GetHealth_Call) Return(_a0 *dto.HealthRespo
 	return _c
 }
 
-func (_c *MockHealthServiceInterface_GetHealth_Call) RunAndReturn(run func() *dto.HealthResponse) *MockHealthServiceInterface_GetHealth_Call {
+func (_c *MockHealthServiceInterface_GetHealth_Call) RunAndReturn(run func(context.Context) *dto.HealthResponse) *MockHealthServiceInterface_GetHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadiness provides a mock function with given fields: ctx
+func (_m *MockHealthServiceInterface) GetReadiness(ctx context.Context) *dto.HealthResponse {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadiness")
+	}
+
+	var r0 *dto.HealthResponse
+	if rf, ok := ret.Get(0).(func(context.Context) *dto.HealthResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.HealthResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockHealthServiceInterface_GetReadiness_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadiness'
+type MockHealthServiceInterface_GetReadiness_Call struct {
+	*mock.Call
+}
+
+// GetReadiness is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockHealthServiceInterface_Expecter) GetReadiness(ctx interface{}) *MockHealthServiceInterface_GetReadiness_Call {
+	return &MockHealthServiceInterface_GetReadiness_Call{Call: _e.mock.On("GetReadiness", ctx)}
+}
+
+func (_c *MockHealthServiceInterface_GetReadiness_Call) Run(run func(ctx context.Context)) *MockHealthServiceInterface_GetReadiness_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockHealthServiceInterface_GetReadiness_Call) Return(_a0 *dto.HealthResponse) *MockHealthServiceInterface_GetReadiness_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHealthServiceInterface_GetReadiness_Call) RunAndReturn(run func(context.Context) *dto.HealthResponse) *MockHealthServiceInterface_GetReadiness_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,6 +3,8 @@ package mocks
 import (
 	"strikepad-backend/internal/auth"
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/service"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -13,8 +15,11 @@ type MockSessionServiceInterface struct {
 }
 
 // CreateSession mocks the CreateSession method
-func (m *MockSessionServiceInterface) CreateSession(userID uint) (*auth.TokenPair, error) {
-	args := m.Called(userID)
+func (m *MockSessionServiceInterface) CreateSession(
+	userID uint,
+	metadata service.SessionMetadata,
+) (*auth.TokenPair, error) {
+	args := m.Called(userID, metadata)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -30,6 +35,11 @@ func (m *MockSessionServiceInterface) ValidateAccessToken(token string) (*model.
 	return args.Get(0).(*model.UserSession), args.Error(1)
 }
 
+// TouchSession mocks the TouchSession method
+func (m *MockSessionServiceInterface) TouchSession(session *model.UserSession) {
+	m.Called(session)
+}
+
 // RefreshToken mocks the RefreshToken method
 func (m *MockSessionServiceInterface) RefreshToken(refreshToken string) (*auth.TokenPair, error) {
 	args := m.Called(refreshToken)
@@ -51,6 +61,32 @@ func (m *MockSessionServiceInterface) InvalidateAllUserSessions(userID uint) err
 	return args.Error(0)
 }
 
+// ListActiveSessions mocks the ListActiveSessions method
+func (m *MockSessionServiceInterface) ListActiveSessions(
+	userID uint, params pagination.Params,
+) (*pagination.Page[model.UserSession], error) {
+	args := m.Called(userID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.Page[model.UserSession]), args.Error(1)
+}
+
+// RevokeSession mocks the RevokeSession method
+func (m *MockSessionServiceInterface) RevokeSession(user *model.User, sessionPublicID string) error {
+	args := m.Called(user, sessionPublicID)
+	return args.Error(0)
+}
+
+// RevokeSessionByToken mocks the RevokeSessionByToken method
+func (m *MockSessionServiceInterface) RevokeSessionByToken(token string) (*model.User, string, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).(*model.User), args.String(1), args.Error(2)
+}
+
 // Logout mocks the Logout method
 func (m *MockSessionServiceInterface) Logout(userID uint, accessToken string) error {
 	args := m.Called(userID, accessToken)
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+)
+
+const (
+	// NotificationSessionRevoked tells a user that one of their sessions was revoked
+	NotificationSessionRevoked = "session.revoked"
+
+	// MaxNotificationReplay is the most missed notifications sent to a reconnecting stream
+	MaxNotificationReplay = 100
+	// notificationBuffer is the number of notifications a slow stream may fall behind by before
+	// new ones are dropped for it; it gets them on reconnection
+	notificationBuffer = 16
+)
+
+// NotificationService records notifications for users and delivers them to their open streams.
+// Streams are held in memory, so a notification reaches the streams open on the instance that
+// handled the event; streams on other instances get it when they reconnect with their cursor.
+type NotificationService struct {
+	notificationRepo repository.NotificationRepositoryInterface
+	userRepo         repository.UserRepository
+	subscribers      map[uint]map[chan dto.Notification]struct{}
+	mu               sync.Mutex
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(
+	notificationRepo repository.NotificationRepositoryInterface,
+	userRepo repository.UserRepository,
+) NotificationServiceInterface {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		subscribers:      make(map[uint]map[chan dto.Notification]struct{}),
+	}
+}
+
+// HandleEvent notifies the users concerned by event. Events that are not shown to users are ignored.
+func (s *NotificationService) HandleEvent(_ context.Context, event events.Event) {
+	switch event.Type {
+	case events.SessionRevoked:
+		data, ok := event.Data.(events.SessionData)
+		if !ok {
+			slog.Error("Unexpected session event data", "event_id", event.ID)
+			return
+		}
+		user, err := s.userRepo.GetByPublicID(data.UserID)
+		if err != nil {
+			slog.Error("Failed to find user to notify", "error", err, "event_id", event.ID)
+			return
+		}
+		if err := s.Notify(user.ID, NotificationSessionRevoked, map[string]string{"session_id": data.ID}); err != nil {
+			slog.Error("Failed to notify user", "error", err, "event_id", event.ID, "user_id", user.ID)
+		}
+	}
+}
+
+// Notify records a notification for the user and sends it to the user's open streams
+func (s *NotificationService) Notify(userID uint, notificationType string, data map[string]string) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+	notification := &model.Notification{
+		UserID: userID,
+		Type:   notificationType,
+		Data:   string(encoded),
+	}
+	if err := s.notificationRepo.Create(notification); err != nil {
+		return err
+	}
+
+	s.publish(userID, toNotification(notification))
+	return nil
+}
+
+// Subscribe returns a channel receiving the user's new notifications, and a function to call when
+// the stream ends
+func (s *NotificationService) Subscribe(userID uint) (<-chan dto.Notification, func()) {
+	ch := make(chan dto.Notification, notificationBuffer)
+
+	s.mu.Lock()
+	if s.subscribers[userID] == nil {
+		s.subscribers[userID] = make(map[chan dto.Notification]struct{})
+	}
+	s.subscribers[userID][ch] = struct{}{}
+	s.mu.Unlock()
+
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers[userID], ch)
+		if len(s.subscribers[userID]) == 0 {
+			delete(s.subscribers, userID)
+		}
+	}
+}
+
+// ListSince returns the user's notifications after the one with the cursor ID, oldest first and
+// up to MaxNotificationReplay. Without a cursor there is nothing to replay.
+func (s *NotificationService) ListSince(userID uint, cursor string) ([]dto.Notification, error) {
+	if cursor == "" {
+		return []dto.Notification{}, nil
+	}
+	notifications, err := s.notificationRepo.ListAfter(userID, cursor, MaxNotificationReplay)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.Notification, 0, len(notifications))
+	for i := range notifications {
+		result = append(result, toNotification(&notifications[i]))
+	}
+	return result, nil
+}
+
+// publish sends notification to the user's streams without waiting for slow ones
+func (s *NotificationService) publish(userID uint, notification dto.Notification) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers[userID] {
+		select {
+		case ch <- notification:
+		default:
+			slog.Warn("Notification stream is behind, dropping notification",
+				"user_id", userID, "notification_id", notification.ID)
+		}
+	}
+}
+
+// toNotification converts a notification to its DTO
+func toNotification(notification *model.Notification) dto.Notification {
+	data := map[string]string{}
+	if err := json.Unmarshal([]byte(notification.Data), &data); err != nil {
+		slog.Warn("Invalid notification data", "error", err, "notification_id", notification.PublicID)
+	}
+	return dto.Notification{
+		ID:        notification.PublicID,
+		Type:      notification.Type,
+		Data:      data,
+		CreatedAt: notification.CreatedAt,
+	}
+}
@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationService_HandleEvent(t *testing.T) {
+	notificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+	userRepo := mocks.NewMockUserRepository(t)
+	notificationService := service.NewNotificationService(notificationRepo, userRepo)
+
+	userRepo.EXPECT().GetByPublicID("usr_1").Return(&model.User{ID: 1, PublicID: "usr_1"}, nil).Once()
+	notificationRepo.EXPECT().Create(mock.MatchedBy(func(notification *model.Notification) bool {
+		return notification.UserID == 1 && notification.Type == service.NotificationSessionRevoked &&
+			notification.Data == `{"session_id":"ses_1"}`
+	})).RunAndReturn(func(notification *model.Notification) error {
+		notification.PublicID = "ntf_1"
+		notification.CreatedAt = time.Now()
+		return nil
+	}).Once()
+
+	notifications, unsubscribe := notificationService.Subscribe(1)
+	defer unsubscribe()
+	other, unsubscribeOther := notificationService.Subscribe(2)
+	defer unsubscribeOther()
+
+	notificationService.HandleEvent(context.Background(), events.New(events.SessionRevoked, events.SessionData{
+		ID: "ses_1", UserID: "usr_1",
+	}))
+	notificationService.HandleEvent(context.Background(), events.New(events.UserCreated, events.UserData{ID: "usr_1"}))
+
+	select {
+	case notification := <-notifications:
+		assert.Equal(t, "ntf_1", notification.ID)
+		assert.Equal(t, service.NotificationSessionRevoked, notification.Type)
+		assert.Equal(t, map[string]string{"session_id": "ses_1"}, notification.Data)
+	default:
+		t.Fatal("the user's stream did not get the notification")
+	}
+	assert.Empty(t, other, "other users' streams get nothing")
+}
+
+func TestNotificationService_Unsubscribe(t *testing.T) {
+	notificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+	notificationService := service.NewNotificationService(notificationRepo, mocks.NewMockUserRepository(t))
+	notificationRepo.EXPECT().Create(mock.Anything).Return(nil).Once()
+
+	notifications, unsubscribe := notificationService.Subscribe(1)
+	unsubscribe()
+
+	require.NoError(t, notificationService.Notify(1, service.NotificationSessionRevoked, map[string]string{}))
+	assert.Empty(t, notifications)
+}
+
+func TestNotificationService_ListSince(t *testing.T) {
+	t.Run("without cursor", func(t *testing.T) {
+		notificationService := service.NewNotificationService(
+			mocks.NewMockNotificationRepositoryInterface(t), mocks.NewMockUserRepository(t))
+
+		notifications, err := notificationService.ListSince(1, "")
+
+		require.NoError(t, err)
+		assert.Empty(t, notifications)
+	})
+
+	t.Run("after cursor", func(t *testing.T) {
+		notificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+		notificationRepo.EXPECT().ListAfter(uint(1), "ntf_1", service.MaxNotificationReplay).Return([]model.Notification{
+			{PublicID: "ntf_2", Type: service.NotificationSessionRevoked, Data: `{"session_id":"ses_2"}`},
+		}, nil).Once()
+		notificationService := service.NewNotificationService(notificationRepo, mocks.NewMockUserRepository(t))
+
+		notifications, err := notificationService.ListSince(1, "ntf_1")
+
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "ntf_2", notifications[0].ID)
+		assert.Equal(t, "ses_2", notifications[0].Data["session_id"])
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		notificationRepo := mocks.NewMockNotificationRepositoryInterface(t)
+		notificationRepo.EXPECT().ListAfter(uint(1), "ntf_1", service.MaxNotificationReplay).Return(nil, assert.AnError).Once()
+		notificationService := service.NewNotificationService(notificationRepo, mocks.NewMockUserRepository(t))
+
+		_, err := notificationService.ListSince(1, "ntf_1")
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
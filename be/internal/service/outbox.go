@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/webhook"
+)
+
+const (
+	// outboxClaimLease is how long a claimed message is hidden from other dispatchers
+	outboxClaimLease = 5 * time.Minute
+	// outboxMaxRetryDelay caps the exponential backoff between delivery attempts
+	outboxMaxRetryDelay = time.Hour
+)
+
+// errUndeliverable is returned for outbox messages that can never be delivered
+var errUndeliverable = errors.New("outbox message cannot be delivered")
+
+// OutboxService delivers outbox messages, emails and webhook events, in the background, retrying
+// failures with exponential backoff
+type OutboxService struct {
+	outboxRepo   repository.OutboxRepositoryInterface
+	webhookRepo  repository.WebhookRepositoryInterface
+	mailer       mailer.Mailer
+	webhooks     webhook.Sender
+	pollInterval time.Duration
+	retryDelay   time.Duration
+	batchSize    int
+	maxAttempts  int
+}
+
+// NewOutboxService creates a new outbox service
+func NewOutboxService(
+	outboxRepo repository.OutboxRepositoryInterface,
+	webhookRepo repository.WebhookRepositoryInterface,
+	m mailer.Mailer,
+	webhooks webhook.Sender,
+) OutboxServiceInterface {
+	return &OutboxService{
+		outboxRepo:   outboxRepo,
+		webhookRepo:  webhookRepo,
+		mailer:       m,
+		webhooks:     webhooks,
+		pollInterval: time.Duration(config.GetEnvInt("OUTBOX_POLL_INTERVAL_SECONDS", 5)) * time.Second,
+		retryDelay:   time.Duration(config.GetEnvInt("OUTBOX_RETRY_DELAY_SECONDS", 30)) * time.Second,
+		batchSize:    config.GetEnvInt("OUTBOX_BATCH_SIZE", 20),
+		maxAttempts:  config.GetEnvInt("OUTBOX_MAX_ATTEMPTS", 8),
+	}
+}
+
+// NewEmailOutboxMessage builds an outbox message that delivers msg through the mailer.
+// Enqueue it with the outbox repository of the transaction that makes the change the email is about.
+func NewEmailOutboxMessage(msg mailer.Message) (*model.OutboxMessage, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode email: %w", err)
+	}
+	return &model.OutboxMessage{
+		Kind:    model.OutboxKindEmail,
+		Payload: string(payload),
+	}, nil
+}
+
+// Run dispatches due messages every poll interval until ctx is canceled
+func (s *OutboxService) Run(ctx context.Context) {
+	slog.Info("Outbox dispatcher started", "poll_interval", s.pollInterval, "batch_size", s.batchSize)
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DispatchDue(ctx); err != nil {
+			slog.Error("Failed to dispatch outbox messages", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Outbox dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue claims up to one batch of due messages, delivers them and returns how many were delivered
+func (s *OutboxService) DispatchDue(ctx context.Context) (int, error) {
+	messages, err := s.outboxRepo.ClaimDue(time.Now(), s.batchSize, outboxClaimLease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+
+	delivered := 0
+	for i := range messages {
+		// Unprocessed messages are claimed again once their lease expires
+		if ctx.Err() != nil {
+			break
+		}
+		if s.deliver(ctx, &messages[i]) {
+			delivered++
+		}
+	}
+
+	if len(messages) > 0 {
+		slog.Info("Outbox batch dispatched", "claimed", len(messages), "delivered", delivered)
+	}
+	return delivered, nil
+}
+
+// deliver makes one delivery attempt and records its outcome
+func (s *OutboxService) deliver(ctx context.Context, message *model.OutboxMessage) bool {
+	attempts := message.Attempts + 1
+	err := s.send(ctx, message)
+	if err == nil {
+		if err := s.outboxRepo.MarkSent(message.ID, attempts, time.Now()); err != nil {
+			slog.Error("Failed to mark outbox message as sent", "error", err, "outbox_message_id", message.ID)
+		}
+		return true
+	}
+
+	permanent := errors.Is(err, errUndeliverable) ||
+		errors.Is(err, mailer.ErrRejected) ||
+		errors.Is(err, mailer.ErrInvalidMessage)
+	if permanent || attempts >= s.maxAttempts {
+		slog.Error("Outbox message failed permanently",
+			"error", err, "outbox_message_id", message.ID, "kind", message.Kind, "attempts", attempts)
+		if err := s.outboxRepo.MarkFailed(message.ID, attempts, err.Error()); err != nil {
+			slog.Error("Failed to mark outbox message as failed", "error", err, "outbox_message_id", message.ID)
+		}
+		return false
+	}
+
+	nextAttemptAt := time.Now().Add(s.backoff(attempts))
+	slog.Warn("Outbox message delivery failed, retrying",
+		"error", err, "outbox_message_id", message.ID, "kind", message.Kind,
+		"attempts", attempts, "next_attempt_at", nextAttemptAt)
+	if err := s.outboxRepo.MarkRetry(message.ID, attempts, nextAttemptAt, err.Error()); err != nil {
+		slog.Error("Failed to reschedule outbox message", "error", err, "outbox_message_id", message.ID)
+	}
+	return false
+}
+
+func (s *OutboxService) send(ctx context.Context, message *model.OutboxMessage) error {
+	switch message.Kind {
+	case model.OutboxKindEmail:
+		var msg mailer.Message
+		if err := json.Unmarshal([]byte(message.Payload), &msg); err != nil {
+			return fmt.Errorf("%w: invalid email payload: %v", errUndeliverable, err)
+		}
+		return s.mailer.Send(ctx, msg)
+	case model.OutboxKindWebhook:
+		var payload webhookPayload
+		if err := json.Unmarshal([]byte(message.Payload), &payload); err != nil {
+			return fmt.Errorf("%w: invalid webhook payload: %v", errUndeliverable, err)
+		}
+		endpoint, err := s.webhookRepo.GetByID(payload.EndpointID)
+		if err != nil {
+			if errors.Is(err, repository.ErrWebhookNotFound) {
+				return fmt.Errorf("%w: webhook endpoint was deleted", errUndeliverable)
+			}
+			return err
+		}
+		return s.webhooks.Deliver(ctx, webhook.Delivery{
+			URL:       endpoint.URL,
+			Secret:    endpoint.Secret,
+			EventID:   payload.EventID,
+			EventType: payload.EventType,
+			Body:      payload.Body,
+		})
+	default:
+		return fmt.Errorf("%w: unknown kind %q", errUndeliverable, message.Kind)
+	}
+}
+
+// backoff returns the wait after the given number of failed attempts: the retry delay,
+// doubled after each further failure and capped at outboxMaxRetryDelay
+func (s *OutboxService) backoff(attempts int) time.Duration {
+	delay := s.retryDelay
+	for i := 1; i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryDelay)
+}
+
+// Stats returns the depth of the outbox queue
+func (s *OutboxService) Stats() (*dto.OutboxStatsResponse, error) {
+	stats, err := s.outboxRepo.Stats()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox stats: %w", err)
+	}
+
+	response := &dto.OutboxStatsResponse{
+		Pending:         stats.Pending,
+		Failed:          stats.Failed,
+		OldestPendingAt: stats.OldestPendingAt,
+	}
+	if stats.OldestPendingAt != nil {
+		response.OldestPendingAgeSeconds = int64(time.Since(*stats.OldestPendingAt).Seconds())
+	}
+	return response, nil
+}
@@ -0,0 +1,258 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/mailer"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMailer records sent messages and fails every send with err when it is set
+type recordingMailer struct {
+	err  error
+	sent []mailer.Message
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg mailer.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// recordingSender records webhook deliveries and fails every delivery with err when it is set
+type recordingSender struct {
+	err        error
+	deliveries []webhook.Delivery
+}
+
+func (s *recordingSender) Deliver(_ context.Context, d webhook.Delivery) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.deliveries = append(s.deliveries, d)
+	return nil
+}
+
+func emailOutboxMessage(t *testing.T, id uint, attempts int) model.OutboxMessage {
+	t.Helper()
+
+	message, err := service.NewEmailOutboxMessage(mailer.Message{
+		To:      "user@example.com",
+		Subject: "Hello",
+		Text:    "Hello",
+	})
+	require.NoError(t, err)
+	message.ID = id
+	message.Attempts = attempts
+	return *message
+}
+
+func TestNewEmailOutboxMessage(t *testing.T) {
+	message, err := service.NewEmailOutboxMessage(mailer.Message{To: "user@example.com", Subject: "Hello", Text: "Hi"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.OutboxKindEmail, message.Kind)
+	assert.JSONEq(t, `{"to":"user@example.com","subject":"Hello","text":"Hi"}`, message.Payload)
+
+	_, err = service.NewEmailOutboxMessage(mailer.Message{To: "not-an-email", Subject: "Hello", Text: "Hi"})
+	assert.ErrorIs(t, err, mailer.ErrInvalidMessage)
+}
+
+func TestOutboxService_DispatchDue(t *testing.T) {
+	t.Setenv("OUTBOX_RETRY_DELAY_SECONDS", "60")
+	t.Setenv("OUTBOX_MAX_ATTEMPTS", "5")
+
+	tests := []struct {
+		sendErr           error
+		setupMocks        func(t *testing.T, outboxRepo *mocks.MockOutboxRepositoryInterface)
+		name              string
+		expectedDelivered int
+	}{
+		{
+			name: "delivers due messages",
+			setupMocks: func(t *testing.T, outboxRepo *mocks.MockOutboxRepositoryInterface) {
+				outboxRepo.EXPECT().ClaimDue(mock.Anything, 20, mock.Anything).
+					Return([]model.OutboxMessage{emailOutboxMessage(t, 1, 0), emailOutboxMessage(t, 2, 3)}, nil).Once()
+				outboxRepo.EXPECT().MarkSent(uint(1), 1, mock.Anything).Return(nil).Once()
+				outboxRepo.EXPECT().MarkSent(uint(2), 4, mock.Anything).Return(nil).Once()
+			},
+			expectedDelivered: 2,
+		},
+		{
+			name:    "transient failure is retried with backoff",
+			sendErr: errors.New("connection reset"),
+			setupMocks: func(t *testing.T, outboxRepo *mocks.MockOutboxRepositoryInterface) {
+				outboxRepo.EXPECT().ClaimDue(mock.Anything, 20, mock.Anything).
+					Return([]model.OutboxMessage{emailOutboxMessage(t, 1, 2)}, nil).Once()
+				// Third attempt: 60s doubled twice
+				outboxRepo.EXPECT().MarkRetry(uint(1), 3, mock.MatchedBy(func(next time.Time) bool {
+					delay := time.Until(next)
+					return delay > 239*time.Second && delay <= 240*time.Second
+				}), "connection reset").Return(nil).Once()
+			},
+		},
+		{
+			name:    "last attempt fails permanently",
+			sendErr: errors.New("connection reset"),
+			setupMocks: func(t *testing.T, outboxRepo *mocks.MockOutboxRepositoryInterface) {
+				outboxRepo.EXPECT().ClaimDue(mock.Anything, 20, mock.Anything).
+					Return([]model.OutboxMessage{emailOutboxMessage(t, 1, 4)}, nil).Once()
+				outboxRepo.EXPECT().MarkFailed(uint(1), 5, "connection reset").Return(nil).Once()
+			},
+		},
+		{
+			name:    "rejected email is not retried",
+			sendErr: fmt.Errorf("%w: mailbox unavailable", mailer.ErrRejected),
+			setupMocks: func(t *testing.T, outboxRepo *mocks.MockOutboxRepositoryInterface) {
+				outboxRepo.EXPECT().ClaimDue(mock.Anything, 20, mock.Anything).
+					Return([]model.OutboxMessage{emailOutboxMessage(t, 1, 0)}, nil).Once()
+				outboxRepo.EXPECT().MarkFailed(uint(1), 1, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "unknown kind is not retried",
+			setupMocks: func(t *testing.T, outboxRepo *mocks.MockOutboxRepositoryInterface) {
+				outboxRepo.EXPECT().ClaimDue(mock.Anything, 20, mock.Anything).
+					Return([]model.OutboxMessage{{ID: 1, Kind: "carrier-pigeon", Payload: "{}"}}, nil).Once()
+				outboxRepo.EXPECT().MarkFailed(uint(1), 1, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "nothing due",
+			setupMocks: func(t *testing.T, outboxRepo *mocks.MockOutboxRepositoryInterface) {
+				outboxRepo.EXPECT().ClaimDue(mock.Anything, 20, mock.Anything).Return(nil, nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+			tt.setupMocks(t, outboxRepo)
+			m := &recordingMailer{err: tt.sendErr}
+
+			outboxService := service.NewOutboxService(outboxRepo, nil, m, nil)
+			delivered, err := outboxService.DispatchDue(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedDelivered, delivered)
+			assert.Len(t, m.sent, tt.expectedDelivered)
+		})
+	}
+}
+
+func TestOutboxService_DispatchDueWebhook(t *testing.T) {
+	endpoint := &model.WebhookEndpoint{
+		ID:         3,
+		PublicID:   "whk_7d2k9m4q0x8h3v1c5b6n",
+		URL:        "https://hooks.example.com/strikepad",
+		Secret:     "whsec_test",
+		EventTypes: "*",
+	}
+	payload := `{"endpoint_id":3,"event_id":"evt_1","event_type":"user.created","body":{"id":"evt_1"}}`
+
+	tests := []struct {
+		getErr        error
+		sendErr       error
+		name          string
+		expectMarked  string
+		expectDeliver bool
+	}{
+		{
+			name:          "posts the event to the endpoint",
+			expectMarked:  "sent",
+			expectDeliver: true,
+		},
+		{
+			name:         "deleted endpoint is not retried",
+			getErr:       repository.ErrWebhookNotFound,
+			expectMarked: "failed",
+		},
+		{
+			name:         "endpoint error is retried",
+			sendErr:      errors.New("webhook endpoint responded with status 503"),
+			expectMarked: "retry",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+			webhookRepo := mocks.NewMockWebhookRepositoryInterface(t)
+			outboxRepo.EXPECT().ClaimDue(mock.Anything, 20, mock.Anything).
+				Return([]model.OutboxMessage{{ID: 1, Kind: model.OutboxKindWebhook, Payload: payload}}, nil).Once()
+			if tt.getErr != nil {
+				webhookRepo.EXPECT().GetByID(uint(3)).Return(nil, tt.getErr).Once()
+			} else {
+				webhookRepo.EXPECT().GetByID(uint(3)).Return(endpoint, nil).Once()
+			}
+			switch tt.expectMarked {
+			case "sent":
+				outboxRepo.EXPECT().MarkSent(uint(1), 1, mock.Anything).Return(nil).Once()
+			case "failed":
+				outboxRepo.EXPECT().MarkFailed(uint(1), 1, mock.Anything).Return(nil).Once()
+			case "retry":
+				outboxRepo.EXPECT().MarkRetry(uint(1), 1, mock.Anything, mock.Anything).Return(nil).Once()
+			}
+			sender := &recordingSender{err: tt.sendErr}
+
+			outboxService := service.NewOutboxService(outboxRepo, webhookRepo, &recordingMailer{}, sender)
+			_, err := outboxService.DispatchDue(context.Background())
+
+			assert.NoError(t, err)
+			if tt.expectDeliver {
+				require.Len(t, sender.deliveries, 1)
+				assert.Equal(t, webhook.Delivery{
+					URL:       "https://hooks.example.com/strikepad",
+					Secret:    "whsec_test",
+					EventID:   "evt_1",
+					EventType: "user.created",
+					Body:      []byte(`{"id":"evt_1"}`),
+				}, sender.deliveries[0])
+			} else {
+				assert.Empty(t, sender.deliveries)
+			}
+		})
+	}
+}
+
+func TestOutboxService_DispatchDueClaimError(t *testing.T) {
+	outboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+	outboxRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection lost")).Once()
+
+	outboxService := service.NewOutboxService(outboxRepo, nil, &recordingMailer{}, nil)
+	_, err := outboxService.DispatchDue(context.Background())
+
+	assert.Error(t, err)
+}
+
+func TestOutboxService_Stats(t *testing.T) {
+	oldest := time.Now().Add(-90 * time.Second)
+	outboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+	outboxRepo.EXPECT().Stats().
+		Return(&repository.OutboxStats{Pending: 4, Failed: 1, OldestPendingAt: &oldest}, nil).Once()
+
+	outboxService := service.NewOutboxService(outboxRepo, nil, &recordingMailer{}, nil)
+	stats, err := outboxService.Stats()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), stats.Pending)
+	assert.Equal(t, int64(1), stats.Failed)
+	assert.Equal(t, &oldest, stats.OldestPendingAt)
+	assert.InDelta(t, 90, stats.OldestPendingAgeSeconds, 1)
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+)
+
+// ProfileService handles onboarding and other optional profile data
+type ProfileService struct {
+	profileRepo repository.ProfileRepositoryInterface
+}
+
+// NewProfileService creates a new profile service
+func NewProfileService(profileRepo repository.ProfileRepositoryInterface) ProfileServiceInterface {
+	return &ProfileService{
+		profileRepo: profileRepo,
+	}
+}
+
+// GetOnboarding returns the onboarding answers recorded for a user
+func (s *ProfileService) GetOnboarding(userID uint) (*dto.OnboardingResponse, error) {
+	profile, err := s.profileRepo.FindByUserID(userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProfileNotFound) {
+			return &dto.OnboardingResponse{}, nil
+		}
+		return nil, fmt.Errorf("failed to get onboarding answers: %w", err)
+	}
+
+	return toOnboardingResponse(profile), nil
+}
+
+// UpdateOnboarding records the provided onboarding answers, keeping previous answers for omitted fields.
+// With req.Version set, it returns repository.ErrStaleVersion unless that is still the version of the
+// answers.
+func (s *ProfileService) UpdateOnboarding(userID uint, req *dto.OnboardingRequest) (*dto.OnboardingResponse, error) {
+	profile, err := s.profileRepo.FindByUserID(userID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrProfileNotFound) {
+			return nil, fmt.Errorf("failed to get onboarding answers: %w", err)
+		}
+		profile = &model.UserProfile{UserID: userID}
+	}
+	if req.Version != nil && *req.Version != profile.Version {
+		return nil, repository.ErrStaleVersion
+	}
+
+	if req.Role != nil {
+		profile.Role = req.Role
+	}
+	if req.TeamSize != nil {
+		profile.TeamSize = req.TeamSize
+	}
+	if req.IntendedUse != nil {
+		profile.IntendedUse = req.IntendedUse
+	}
+
+	if err := s.profileRepo.Save(profile); err != nil {
+		return nil, fmt.Errorf("failed to save onboarding answers: %w", err)
+	}
+
+	slog.Info("Onboarding answers recorded", "user_id", userID)
+	return toOnboardingResponse(profile), nil
+}
+
+// toOnboardingResponse converts a profile model to its onboarding response
+func toOnboardingResponse(profile *model.UserProfile) *dto.OnboardingResponse {
+	response := &dto.OnboardingResponse{
+		Role:        profile.Role,
+		TeamSize:    profile.TeamSize,
+		IntendedUse: profile.IntendedUse,
+		Version:     profile.Version,
+	}
+	if !profile.UpdatedAt.IsZero() {
+		updatedAt := profile.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
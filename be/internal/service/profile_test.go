@@ -0,0 +1,188 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfileServiceTestSuite struct {
+	suite.Suite
+	profileService  service.ProfileServiceInterface
+	mockProfileRepo *mocks.MockProfileRepositoryInterface
+}
+
+func (suite *ProfileServiceTestSuite) SetupTest() {
+	suite.mockProfileRepo = mocks.NewMockProfileRepositoryInterface(suite.T())
+	suite.profileService = service.NewProfileService(suite.mockProfileRepo)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func uintPtr(u uint) *uint {
+	return &u
+}
+
+func (suite *ProfileServiceTestSuite) TestGetOnboarding() {
+	testCases := []struct {
+		mockSetup    func()
+		expectedRole *string
+		name         string
+		expectError  bool
+		expectStamp  bool
+	}{
+		{
+			name: "Existing profile",
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(&model.UserProfile{
+					UserID:    1,
+					Role:      stringPtr("engineer"),
+					UpdatedAt: time.Now(),
+				}, nil).Once()
+			},
+			expectedRole: stringPtr("engineer"),
+			expectStamp:  true,
+		},
+		{
+			name: "No profile yet",
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(nil, repository.ErrProfileNotFound).Once()
+			},
+		},
+		{
+			name: "Repository error",
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(nil, assert.AnError).Once()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			response, err := suite.profileService.GetOnboarding(1)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, response)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRole, response.Role)
+			assert.Equal(t, tc.expectStamp, response.UpdatedAt != nil)
+		})
+	}
+}
+
+func (suite *ProfileServiceTestSuite) TestUpdateOnboarding() {
+	testCases := []struct {
+		mockSetup    func()
+		request      *dto.OnboardingRequest
+		expectedRole *string
+		expectedTeam *string
+		expectedUse  *string
+		name         string
+		expectError  bool
+	}{
+		{
+			name:    "Creates profile on first answer",
+			request: &dto.OnboardingRequest{Role: stringPtr("founder")},
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(nil, repository.ErrProfileNotFound).Once()
+				suite.mockProfileRepo.EXPECT().Save(mock.MatchedBy(func(p *model.UserProfile) bool {
+					return p.UserID == 1 && *p.Role == "founder" && p.TeamSize == nil
+				})).Return(nil).Once()
+			},
+			expectedRole: stringPtr("founder"),
+		},
+		{
+			name:    "Keeps previous answers for omitted fields",
+			request: &dto.OnboardingRequest{TeamSize: stringPtr("2-10")},
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(&model.UserProfile{
+					ID:          3,
+					UserID:      1,
+					Role:        stringPtr("engineer"),
+					IntendedUse: stringPtr("work"),
+				}, nil).Once()
+				suite.mockProfileRepo.EXPECT().Save(mock.AnythingOfType("*model.UserProfile")).Return(nil).Once()
+			},
+			expectedRole: stringPtr("engineer"),
+			expectedTeam: stringPtr("2-10"),
+			expectedUse:  stringPtr("work"),
+		},
+		{
+			name:    "Creates profile at version 0",
+			request: &dto.OnboardingRequest{Role: stringPtr("founder"), Version: uintPtr(0)},
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(nil, repository.ErrProfileNotFound).Once()
+				suite.mockProfileRepo.EXPECT().Save(mock.AnythingOfType("*model.UserProfile")).Return(nil).Once()
+			},
+			expectedRole: stringPtr("founder"),
+		},
+		{
+			name:    "Stale version",
+			request: &dto.OnboardingRequest{Role: stringPtr("founder"), Version: uintPtr(1)},
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(&model.UserProfile{
+					ID:      3,
+					UserID:  1,
+					Version: 2,
+				}, nil).Once()
+			},
+			expectError: true,
+		},
+		{
+			name:    "Find error",
+			request: &dto.OnboardingRequest{Role: stringPtr("founder")},
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(nil, assert.AnError).Once()
+			},
+			expectError: true,
+		},
+		{
+			name:    "Save error",
+			request: &dto.OnboardingRequest{Role: stringPtr("founder")},
+			mockSetup: func() {
+				suite.mockProfileRepo.EXPECT().FindByUserID(uint(1)).Return(nil, repository.ErrProfileNotFound).Once()
+				suite.mockProfileRepo.EXPECT().Save(mock.AnythingOfType("*model.UserProfile")).Return(assert.AnError).Once()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			response, err := suite.profileService.UpdateOnboarding(1, tc.request)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, response)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRole, response.Role)
+			assert.Equal(t, tc.expectedTeam, response.TeamSize)
+			assert.Equal(t, tc.expectedUse, response.IntendedUse)
+		})
+	}
+}
+
+func TestProfileServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfileServiceTestSuite))
+}
@@ -1,28 +1,90 @@
 package service
 
 import (
+	"context"
+	stderrors "errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/events"
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/publicid"
 	"strikepad-backend/internal/repository"
 )
 
 // SessionService handles session-related business logic
 type SessionService struct {
 	sessionRepo repository.SessionRepositoryInterface
+	userRepo    repository.UserRepository
 	jwtService  *auth.JWTService
+	denylist    *auth.Denylist
+	bus         events.Bus
+	// loginAlerts checks sign-ins from new devices and countries; nil disables the check
+	loginAlerts LoginAlertServiceInterface
+	clock       clock.Clock
+	tokens      auth.TokenGenerator
+	// sessionLimitPolicy is what signing in does when the user already has sessionLimit sessions
+	sessionLimitPolicy SessionLimitPolicy
+	// sessionLimit is the maximum number of refreshable sessions per user; 0 means no limit
+	sessionLimit int
+	// activityInterval is how often the last use of a session is recorded
+	activityInterval time.Duration
+	// idleTimeout ends sessions that have not been used for that long; 0 disables it
+	idleTimeout time.Duration
+	// revokeOnPasswordChange rejects sessions created before the user's last password change
+	revokeOnPasswordChange bool
+}
+
+// SessionLimitPolicy is what signing in does when the user already has the maximum number of
+// sessions
+type SessionLimitPolicy string
+
+const (
+	// SessionLimitEvictOldest invalidates the user's oldest sessions to make room for the new one
+	SessionLimitEvictOldest SessionLimitPolicy = "evict_oldest"
+	// SessionLimitReject rejects the sign-in until the user signs out of another session
+	SessionLimitReject SessionLimitPolicy = "reject"
+)
+
+// SessionLimitPolicyFromEnv reads the policy from SESSION_LIMIT_POLICY (evict_oldest or reject).
+// It is evict_oldest by default.
+func SessionLimitPolicyFromEnv() SessionLimitPolicy {
+	policy := SessionLimitPolicy(strings.ToLower(config.GetEnv("SESSION_LIMIT_POLICY", string(SessionLimitEvictOldest))))
+	switch policy {
+	case SessionLimitEvictOldest, SessionLimitReject:
+		return policy
+	default:
+		slog.Warn("Ignoring invalid SESSION_LIMIT_POLICY", "value", policy, "default", SessionLimitEvictOldest)
+		return SessionLimitEvictOldest
+	}
+}
+
+// SessionMetadata describes the client that created a session
+type SessionMetadata struct {
+	IPAddress  string
+	UserAgent  string
+	DeviceName string
+	// Country is the ISO 3166-1 alpha-2 code reported by the reverse proxy, if any
+	Country string
 }
 
 // SessionServiceInterface defines the interface for session service
 type SessionServiceInterface interface {
-	CreateSession(userID uint) (*auth.TokenPair, error)
+	CreateSession(userID uint, metadata SessionMetadata) (*auth.TokenPair, error)
 	ValidateAccessToken(token string) (*model.UserSession, error)
+	TouchSession(session *model.UserSession)
 	RefreshToken(refreshToken string) (*auth.TokenPair, error)
 	InvalidateSession(accessToken string) error
 	InvalidateAllUserSessions(userID uint) error
+	ListActiveSessions(userID uint, params pagination.Params) (*pagination.Page[model.UserSession], error)
+	RevokeSession(user *model.User, sessionPublicID string) error
+	RevokeSessionByToken(token string) (*model.User, string, error)
 	Logout(userID uint, accessToken string) error
 	CleanupExpiredSessions() error
 }
@@ -30,40 +92,145 @@ type SessionServiceInterface interface {
 // NewSessionService creates a new session service
 func NewSessionService(
 	sessionRepo repository.SessionRepositoryInterface,
+	userRepo repository.UserRepository,
 	jwtService *auth.JWTService,
+	denylist *auth.Denylist,
+	bus events.Bus,
+	loginAlerts LoginAlertServiceInterface,
+	clk clock.Clock,
+	tokens auth.TokenGenerator,
 ) SessionServiceInterface {
 	return &SessionService{
-		sessionRepo: sessionRepo,
-		jwtService:  jwtService,
+		sessionRepo:            sessionRepo,
+		userRepo:               userRepo,
+		jwtService:             jwtService,
+		denylist:               denylist,
+		bus:                    bus,
+		loginAlerts:            loginAlerts,
+		clock:                  clk,
+		tokens:                 tokens,
+		sessionLimitPolicy:     SessionLimitPolicyFromEnv(),
+		sessionLimit:           max(config.GetEnvInt("MAX_SESSIONS_PER_USER", 0), 0),
+		activityInterval:       time.Duration(config.GetEnvInt("SESSION_ACTIVITY_INTERVAL_SECONDS", 60)) * time.Second,
+		idleTimeout:            time.Duration(max(config.GetEnvInt("SESSION_IDLE_TIMEOUT_SECONDS", 0), 0)) * time.Second,
+		revokeOnPasswordChange: config.GetEnvBool("REVOKE_SESSIONS_ON_PASSWORD_CHANGE", true),
+	}
+}
+
+// CreateSession creates a new session with token pair and alerts the user when it comes from a new
+// device or country. When the user already has the maximum number of sessions, the sign-in is
+// rejected with auth.ErrSessionLimitReached or the oldest sessions are evicted, depending on the
+// session limit policy.
+func (s *SessionService) CreateSession(userID uint, metadata SessionMetadata) (*auth.TokenPair, error) {
+	if s.sessionLimit > 0 && s.sessionLimitPolicy == SessionLimitReject {
+		sessions, err := s.sessionRepo.FindRefreshableByUserID(userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count sessions: %w", err)
+		}
+		if len(sessions) >= s.sessionLimit {
+			slog.Info("Rejecting session over the limit", "user_id", userID, "sessions", len(sessions))
+			return nil, auth.ErrSessionLimitReached
+		}
+	}
+
+	tokenPair, session, err := createSession(s.sessionRepo, s.userRepo, s.jwtService, s.clock, s.tokens, userID, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.sessionLimit > 0 && s.sessionLimitPolicy == SessionLimitEvictOldest {
+		s.evictOldestSessions(session)
+	}
+	if s.loginAlerts != nil {
+		s.loginAlerts.CheckLogin(session)
+	}
+	return tokenPair, nil
+}
+
+// evictOldestSessions invalidates the oldest sessions of the user of the new session until the user
+// is within the session limit. Failures are logged, since the user has already signed in.
+func (s *SessionService) evictOldestSessions(newSession *model.UserSession) {
+	sessions, err := s.sessionRepo.FindRefreshableByUserID(newSession.UserID)
+	if err != nil {
+		slog.Error("Failed to find sessions to evict", "user_id", newSession.UserID, "error", err)
+		return
+	}
+
+	excess := len(sessions) - s.sessionLimit
+	for _, session := range sessions {
+		if excess <= 0 {
+			break
+		}
+		if session.ID == newSession.ID {
+			continue
+		}
+		if err := s.sessionRepo.InvalidateByPublicID(session.PublicID, session.UserID); err != nil {
+			slog.Error("Failed to evict session", "user_id", session.UserID, "session_id", session.PublicID, "error", err)
+			continue
+		}
+		s.revokeSession(session.PublicID)
+		excess--
+		slog.Info("Session evicted over the limit", "user_id", session.UserID, "session_id", session.PublicID)
 	}
 }
 
-// CreateSession creates a new session with token pair
-func (s *SessionService) CreateSession(userID uint) (*auth.TokenPair, error) {
+// createSession issues a token pair for the user and stores the session in sessionRepo. In
+// stateless mode the user is loaded from userRepo to embed in the access token.
+func createSession(
+	sessionRepo repository.SessionRepositoryInterface,
+	userRepo repository.UserRepository,
+	jwtService *auth.JWTService,
+	clk clock.Clock,
+	tokens auth.TokenGenerator,
+	userID uint,
+	metadata SessionMetadata,
+) (*auth.TokenPair, *model.UserSession, error) {
+	now := clk.Now()
+	publicID := tokens.PublicID(publicid.PrefixSession)
+
+	var sessionClaims *auth.SessionClaims
+	if jwtService.Stateless() {
+		user, err := userRepo.GetByID(userID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load user for session: %w", err)
+		}
+		sessionClaims = newSessionClaims(publicID, user, now)
+	}
+
 	// Generate token pair
-	tokenPair, err := s.jwtService.GenerateTokenPair(userID)
+	tokenPair, err := jwtService.GenerateSessionTokenPair(userID, sessionClaims)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token pair: %w", err)
+		return nil, nil, fmt.Errorf("failed to generate token pair: %w", err)
 	}
 
 	// Create session record
 	session := &model.UserSession{
+		PublicID:              publicID,
 		UserID:                userID,
-		AccessToken:           tokenPair.AccessToken,
-		RefreshToken:          tokenPair.RefreshToken,
+		AccessTokenHash:       auth.HashToken(tokenPair.AccessToken),
+		RefreshTokenHash:      auth.HashToken(tokenPair.RefreshToken),
 		AccessTokenExpiresAt:  tokenPair.AccessTokenExpiresAt,
 		RefreshTokenExpiresAt: tokenPair.RefreshTokenExpiresAt,
-		CreatedAt:             time.Now(),
-		UpdatedAt:             time.Now(),
+		IPAddress:             metadata.IPAddress,
+		UserAgent:             metadata.UserAgent,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+		LastUsedAt:            &now,
 		IsDeleted:             false,
 	}
+	if deviceName := strings.TrimSpace(metadata.DeviceName); deviceName != "" {
+		session.DeviceName = &deviceName
+	}
+	if country := strings.ToUpper(strings.TrimSpace(metadata.Country)); len(country) == 2 {
+		session.Country = &country
+	}
 
-	if err := s.sessionRepo.Create(session); err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
+	if err := sessionRepo.Create(session); err != nil {
+		return nil, nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
-	slog.Info("Session created successfully", "user_id", userID, "session_id", session.ID)
-	return tokenPair, nil
+	slog.Info("Session created successfully", "user_id", userID, "session_id", session.ID, "ip_address", session.IPAddress)
+	return tokenPair, session, nil
 }
 
 // ValidateAccessToken validates an access token and returns the session
@@ -71,64 +238,120 @@ func (s *SessionService) ValidateAccessToken(token string) (*model.UserSession,
 	// Validate JWT token
 	claims, err := s.jwtService.ValidateAccessToken(token)
 	if err != nil {
-		return nil, fmt.Errorf("invalid access token: %w", err)
+		return nil, tokenError("invalid access token", err)
+	}
+
+	if s.jwtService.Stateless() && claims.Session != nil && claims.ExpiresAt != nil {
+		revoked, err := s.denylist.IsRevoked(context.Background(), claims)
+		if err == nil {
+			if revoked {
+				return nil, fmt.Errorf("session is invalidated: %w", auth.ErrTokenInvalid)
+			}
+			return sessionFromClaims(token, claims), nil
+		}
+		slog.Warn("Failed to check token revocation, loading the session instead", "error", err)
 	}
 
 	// Find session in database
-	session, err := s.sessionRepo.FindByAccessToken(token)
+	session, err := s.sessionRepo.FindByAccessTokenHash(auth.HashToken(token))
 	if err != nil {
-		return nil, fmt.Errorf("session not found: %w", err)
+		return nil, sessionLookupError(err)
 	}
 
 	// Check if session is still valid
-	if !session.IsAccessTokenValid() {
-		return nil, fmt.Errorf("session is expired or invalidated")
+	if session.IsDeleted {
+		return nil, fmt.Errorf("session is invalidated: %w", auth.ErrTokenInvalid)
+	}
+	now := s.clock.Now()
+	if !session.IsAccessTokenValid(now) {
+		return nil, fmt.Errorf("session is expired: %w", auth.ErrTokenExpired)
+	}
+	// Refreshing cannot revive an idle session, so the token is invalid rather than expired
+	if session.IsIdle(now, s.idleTimeout) {
+		return nil, fmt.Errorf("session is idle: %w", auth.ErrTokenInvalid)
+	}
+
+	if s.issuedBeforePasswordChange(session) {
+		return nil, fmt.Errorf("session was issued before the last password change: %w", auth.ErrTokenInvalid)
 	}
 
 	// Verify user ID matches
 	if session.UserID != claims.UserID {
-		return nil, fmt.Errorf("token user ID mismatch")
+		return nil, fmt.Errorf("token user ID mismatch: %w", auth.ErrTokenInvalid)
 	}
 
 	return session, nil
 }
 
+// TouchSession records that the session authenticated a request. Only one use per activity interval
+// is written, so that most requests do not write; a session used within its idle timeout thereby
+// stays alive. Sessions rebuilt from stateless access tokens are not recorded, as they are not
+// loaded on requests. Failures are logged, since the request has already been authenticated.
+func (s *SessionService) TouchSession(session *model.UserSession) {
+	if session.ID == 0 {
+		return
+	}
+	now := s.clock.Now()
+	if session.LastUsedAt != nil && now.Sub(*session.LastUsedAt) < s.activityInterval {
+		return
+	}
+	if err := s.sessionRepo.UpdateLastUsedAt(session, now); err != nil {
+		slog.Warn("Failed to record session use", "session_id", session.ID, "error", err)
+		return
+	}
+	session.LastUsedAt = &now
+}
+
 // RefreshToken refreshes an access token using a refresh token
 func (s *SessionService) RefreshToken(refreshToken string) (*auth.TokenPair, error) {
 	// Validate refresh token
 	claims, err := s.jwtService.ValidateRefreshToken(refreshToken)
 	if err != nil {
-		return nil, fmt.Errorf("invalid refresh token: %w", err)
+		return nil, tokenError("invalid refresh token", err)
 	}
 
 	// Find session in database
-	session, err := s.sessionRepo.FindByRefreshToken(refreshToken)
+	session, err := s.sessionRepo.FindByRefreshTokenHash(auth.HashToken(refreshToken))
 	if err != nil {
-		return nil, fmt.Errorf("session not found: %w", err)
+		return nil, sessionLookupError(err)
 	}
 
-	// Check if refresh token is still valid
-	if !session.IsRefreshTokenValid() {
-		return nil, fmt.Errorf("refresh token is expired or invalidated")
+	// Check if refresh token is still valid. Refreshing cannot renew a refresh token, so all of
+	// these failures are invalid rather than expired.
+	now := s.clock.Now()
+	if !session.IsRefreshTokenValid(now) {
+		return nil, fmt.Errorf("refresh token is expired or invalidated: %w", auth.ErrTokenInvalid)
+	}
+	if session.IsIdle(now, s.idleTimeout) {
+		return nil, fmt.Errorf("session is idle: %w", auth.ErrTokenInvalid)
+	}
+
+	if s.issuedBeforePasswordChange(session) {
+		return nil, fmt.Errorf("session was issued before the last password change: %w", auth.ErrTokenInvalid)
 	}
 
 	// Verify user ID matches
 	if session.UserID != claims.UserID {
-		return nil, fmt.Errorf("token user ID mismatch")
+		return nil, fmt.Errorf("token user ID mismatch: %w", auth.ErrTokenInvalid)
 	}
 
 	// Generate new token pair
-	tokenPair, err := s.jwtService.GenerateTokenPair(claims.UserID)
+	var sessionClaims *auth.SessionClaims
+	if s.jwtService.Stateless() {
+		sessionClaims = newSessionClaims(session.PublicID, &session.User, session.CreatedAt)
+	}
+	tokenPair, err := s.jwtService.GenerateSessionTokenPair(claims.UserID, sessionClaims)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate new token pair: %w", err)
 	}
 
 	// Update session with new tokens
-	session.AccessToken = tokenPair.AccessToken
-	session.RefreshToken = tokenPair.RefreshToken
+	session.AccessTokenHash = auth.HashToken(tokenPair.AccessToken)
+	session.RefreshTokenHash = auth.HashToken(tokenPair.RefreshToken)
 	session.AccessTokenExpiresAt = tokenPair.AccessTokenExpiresAt
 	session.RefreshTokenExpiresAt = tokenPair.RefreshTokenExpiresAt
-	session.UpdatedAt = time.Now()
+	session.UpdatedAt = now
+	session.LastUsedAt = &now
 
 	if err := s.sessionRepo.Update(session); err != nil {
 		return nil, fmt.Errorf("failed to update session: %w", err)
@@ -138,9 +361,33 @@ func (s *SessionService) RefreshToken(refreshToken string) (*auth.TokenPair, err
 	return tokenPair, nil
 }
 
+// tokenError wraps the error of validating a token: expired tokens keep auth.ErrTokenExpired, and
+// any other failure is auth.ErrTokenInvalid
+func tokenError(message string, err error) error {
+	if stderrors.Is(err, auth.ErrTokenExpired) {
+		return fmt.Errorf("%s: %w", message, err)
+	}
+	return fmt.Errorf("%s: %w: %w", message, auth.ErrTokenInvalid, err)
+}
+
+// sessionLookupError wraps the error of looking up the session of a token. A missing session makes
+// the token invalid; other errors, such as an unreachable database, are not the client's fault.
+func sessionLookupError(err error) error {
+	if stderrors.Is(err, repository.ErrSessionNotFound) {
+		return fmt.Errorf("session not found: %w: %w", auth.ErrTokenInvalid, err)
+	}
+	return fmt.Errorf("failed to find session: %w", err)
+}
+
+// issuedBeforePasswordChange reports whether the policy forces the session's user to log in again
+// because their password changed after the session was created
+func (s *SessionService) issuedBeforePasswordChange(session *model.UserSession) bool {
+	return s.revokeOnPasswordChange && session.User.PasswordChangedSince(session.CreatedAt)
+}
+
 // InvalidateSession invalidates a session by access token
 func (s *SessionService) InvalidateSession(accessToken string) error {
-	session, err := s.sessionRepo.FindByAccessToken(accessToken)
+	session, err := s.sessionRepo.FindByAccessTokenHash(auth.HashToken(accessToken))
 	if err != nil {
 		return fmt.Errorf("session not found: %w", err)
 	}
@@ -149,6 +396,7 @@ func (s *SessionService) InvalidateSession(accessToken string) error {
 	if err := s.sessionRepo.Update(session); err != nil {
 		return fmt.Errorf("failed to invalidate session: %w", err)
 	}
+	s.revokeSession(session.PublicID)
 
 	slog.Info("Session invalidated successfully", "user_id", session.UserID, "session_id", session.ID)
 	return nil
@@ -159,22 +407,78 @@ func (s *SessionService) InvalidateAllUserSessions(userID uint) error {
 	if err := s.sessionRepo.InvalidateByUserID(userID); err != nil {
 		return fmt.Errorf("failed to invalidate all user sessions: %w", err)
 	}
+	if s.jwtService.Stateless() {
+		if err := s.denylist.RevokeUser(context.Background(), userID); err != nil {
+			slog.Error("Failed to revoke access tokens, they stay valid until they expire", "user_id", userID, "error", err)
+		}
+	}
 
 	slog.Info("All user sessions invalidated", "user_id", userID)
 	return nil
 }
 
-// Logout handles user logout by invalidating the specific session
+// ListActiveSessions returns a page of the user's sessions whose access token has not expired,
+// newest first
+func (s *SessionService) ListActiveSessions(
+	userID uint, params pagination.Params,
+) (*pagination.Page[model.UserSession], error) {
+	page, err := s.sessionRepo.ListActiveByUserID(userID, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active sessions: %w", err)
+	}
+
+	return page, nil
+}
+
+// RevokeSession invalidates one of the user's sessions by its public ID
+func (s *SessionService) RevokeSession(user *model.User, sessionPublicID string) error {
+	if err := s.sessionRepo.InvalidateByPublicID(sessionPublicID, user.ID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	s.revokeSession(sessionPublicID)
+
+	slog.Info("Session revoked successfully", "user_id", user.ID, "session_id", sessionPublicID)
+	s.bus.Publish(context.Background(), events.New(events.SessionRevoked, events.SessionData{
+		ID:     sessionPublicID,
+		UserID: user.PublicID,
+	}))
+	return nil
+}
+
+// RevokeSessionByToken revokes the session named by the revoke link of a new sign-in alert and
+// returns the session's user and public ID
+func (s *SessionService) RevokeSessionByToken(token string) (*model.User, string, error) {
+	claims, err := s.jwtService.ValidateSessionRevokeToken(token)
+	if err != nil {
+		slog.Warn("Invalid session revoke token", "error", err)
+		return nil, "", auth.ErrInvalidSessionRevokeToken
+	}
+
+	user, err := s.userRepo.GetByID(claims.UserID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load user for session revoke: %w", err)
+	}
+	if err := s.RevokeSession(user, claims.ID); err != nil {
+		return nil, "", err
+	}
+	return user, claims.ID, nil
+}
+
+// Logout handles user logout by invalidating the specific session. When the session has already
+// been invalidated, e.g. by a concurrent logout, the error wraps repository.ErrSessionNotFound.
 func (s *SessionService) Logout(userID uint, accessToken string) error {
 	// Find session by access token
-	session, err := s.sessionRepo.FindByAccessToken(accessToken)
+	session, err := s.sessionRepo.FindByAccessTokenHash(auth.HashToken(accessToken))
 	if err != nil {
 		return fmt.Errorf("session not found: %w", err)
 	}
+	if session.IsDeleted {
+		return fmt.Errorf("session is already invalidated: %w", repository.ErrSessionNotFound)
+	}
 
 	// Verify the session belongs to the user
 	if session.UserID != userID {
-		return fmt.Errorf("session does not belong to user")
+		return auth.ErrSessionNotOwned
 	}
 
 	// Invalidate the session
@@ -182,11 +486,53 @@ func (s *SessionService) Logout(userID uint, accessToken string) error {
 	if err := s.sessionRepo.Update(session); err != nil {
 		return fmt.Errorf("failed to logout session: %w", err)
 	}
+	s.revokeSession(session.PublicID)
 
 	slog.Info("User logged out successfully", "user_id", userID, "session_id", session.ID)
 	return nil
 }
 
+// revokeSession denies the session's stateless access tokens until they expire. Failures are
+// logged, since the session itself has already been invalidated.
+func (s *SessionService) revokeSession(sessionPublicID string) {
+	if !s.jwtService.Stateless() {
+		return
+	}
+	if err := s.denylist.RevokeSession(context.Background(), sessionPublicID); err != nil {
+		slog.Error("Failed to revoke access tokens, they stay valid until they expire",
+			"session_id", sessionPublicID, "error", err)
+	}
+}
+
+// newSessionClaims describes the session and its user for a stateless access token
+func newSessionClaims(sessionPublicID string, user *model.User, authTime time.Time) *auth.SessionClaims {
+	return &auth.SessionClaims{
+		ID:            sessionPublicID,
+		UserPublicID:  user.PublicID,
+		Role:          user.Role,
+		AuthTime:      authTime.Unix(),
+		EmailVerified: user.EmailVerified,
+	}
+}
+
+// sessionFromClaims rebuilds the session of a stateless access token. Only the fields carried by
+// the token are set; in particular the database ID of the session is zero.
+func sessionFromClaims(token string, claims *auth.JWTClaims) *model.UserSession {
+	return &model.UserSession{
+		PublicID:             claims.Session.ID,
+		UserID:               claims.UserID,
+		AccessTokenHash:      auth.HashToken(token),
+		AccessTokenExpiresAt: claims.ExpiresAt.Time,
+		CreatedAt:            time.Unix(claims.Session.AuthTime, 0),
+		User: model.User{
+			ID:            claims.UserID,
+			PublicID:      claims.Session.UserPublicID,
+			Role:          claims.Session.Role,
+			EmailVerified: claims.Session.EmailVerified,
+		},
+	}
+}
+
 // CleanupExpiredSessions removes expired sessions from the database
 func (s *SessionService) CleanupExpiredSessions() error {
 	if err := s.sessionRepo.InvalidateExpiredSessions(); err != nil {
@@ -1,18 +1,26 @@
 package service_test
 
 import (
+	"context"
 	"errors"
 	"os"
 	"testing"
 	"time"
 
 	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/clock"
+	"strikepad-backend/internal/events"
 	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
 	"strikepad-backend/internal/repository/mocks"
 	"strikepad-backend/internal/service"
+	servicemocks "strikepad-backend/internal/service/mocks"
+	"strikepad-backend/internal/testutil/factory"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 )
@@ -22,6 +30,7 @@ type SessionServiceTestSuite struct {
 	sessionService  service.SessionServiceInterface
 	mockSessionRepo *mocks.MockSessionRepository
 	jwtService      *auth.JWTService
+	denylist        *auth.Denylist
 }
 
 func (suite *SessionServiceTestSuite) SetupTest() {
@@ -29,8 +38,12 @@ func (suite *SessionServiceTestSuite) SetupTest() {
 	os.Setenv("JWT_SECRET_KEY", "test-secret-key-for-session-testing")
 
 	suite.mockSessionRepo = new(mocks.MockSessionRepository)
-	suite.jwtService = auth.NewJWTService()
-	suite.sessionService = service.NewSessionService(suite.mockSessionRepo, suite.jwtService)
+	suite.jwtService = auth.NewJWTService(clock.New())
+	suite.denylist = auth.NewDenylist(nil, suite.jwtService)
+	suite.sessionService = service.NewSessionService(
+		suite.mockSessionRepo, nil, suite.jwtService, suite.denylist, events.NewBus(), nil, clock.New(),
+		auth.NewTokenGenerator(),
+	)
 }
 
 func (suite *SessionServiceTestSuite) TearDownTest() {
@@ -43,6 +56,7 @@ func (suite *SessionServiceTestSuite) TearDownTest() {
 func (suite *SessionServiceTestSuite) TestCreateSession() {
 	testCases := []struct {
 		mockSetup     func()
+		metadata      service.SessionMetadata
 		name          string
 		errorMessage  string
 		userID        uint
@@ -54,8 +68,8 @@ func (suite *SessionServiceTestSuite) TestCreateSession() {
 			mockSetup: func() {
 				suite.mockSessionRepo.On("Create", mock.MatchedBy(func(session *model.UserSession) bool {
 					return session.UserID == 1 &&
-						session.AccessToken != "" &&
-						session.RefreshToken != "" &&
+						len(session.AccessTokenHash) == 64 &&
+						len(session.RefreshTokenHash) == 64 &&
 						!session.AccessTokenExpiresAt.IsZero() &&
 						!session.RefreshTokenExpiresAt.IsZero() &&
 						!session.IsDeleted
@@ -74,6 +88,39 @@ func (suite *SessionServiceTestSuite) TestCreateSession() {
 			expectedError: true,
 			errorMessage:  "failed to create session",
 		},
+		{
+			name:   "Persists client metadata",
+			userID: 3,
+			metadata: service.SessionMetadata{
+				IPAddress:  "203.0.113.10",
+				UserAgent:  "Mozilla/5.0",
+				DeviceName: "  Work laptop  ",
+			},
+			mockSetup: func() {
+				suite.mockSessionRepo.On("Create", mock.MatchedBy(func(session *model.UserSession) bool {
+					return session.UserID == 3 &&
+						session.IPAddress == "203.0.113.10" &&
+						session.UserAgent == "Mozilla/5.0" &&
+						session.DeviceName != nil &&
+						*session.DeviceName == "Work laptop"
+				})).Return(nil).Once()
+			},
+			expectedError: false,
+		},
+		{
+			name:   "Empty device name is stored as nil",
+			userID: 4,
+			metadata: service.SessionMetadata{
+				IPAddress:  "203.0.113.11",
+				DeviceName: "   ",
+			},
+			mockSetup: func() {
+				suite.mockSessionRepo.On("Create", mock.MatchedBy(func(session *model.UserSession) bool {
+					return session.UserID == 4 && session.DeviceName == nil
+				})).Return(nil).Once()
+			},
+			expectedError: false,
+		},
 		{
 			name:   "Zero user ID",
 			userID: 0,
@@ -96,7 +143,7 @@ func (suite *SessionServiceTestSuite) TestCreateSession() {
 			tc.mockSetup()
 
 			// Execute
-			tokenPair, err := suite.sessionService.CreateSession(tc.userID)
+			tokenPair, err := suite.sessionService.CreateSession(tc.userID, tc.metadata)
 
 			// Assert
 			if tc.expectedError {
@@ -120,32 +167,12 @@ func (suite *SessionServiceTestSuite) TestCreateSession() {
 func (suite *SessionServiceTestSuite) TestValidateAccessToken() {
 	userID := uint(123)
 	tokenPair, _ := suite.jwtService.GenerateTokenPair(userID)
-	validSession := &model.UserSession{
-		ID:                    1,
-		UserID:                userID,
-		AccessToken:           tokenPair.AccessToken,
-		RefreshToken:          tokenPair.RefreshToken,
-		AccessTokenExpiresAt:  tokenPair.AccessTokenExpiresAt,
-		RefreshTokenExpiresAt: tokenPair.RefreshTokenExpiresAt,
-		CreatedAt:             time.Now(),
-		UpdatedAt:             time.Now(),
-		IsDeleted:             false,
-	}
-
-	expiredSession := &model.UserSession{
-		ID:                    2,
-		UserID:                userID,
-		AccessToken:           tokenPair.AccessToken,
-		RefreshToken:          tokenPair.RefreshToken,
-		AccessTokenExpiresAt:  time.Now().Add(-time.Hour), // Expired
-		RefreshTokenExpiresAt: tokenPair.RefreshTokenExpiresAt,
-		CreatedAt:             time.Now(),
-		UpdatedAt:             time.Now(),
-		IsDeleted:             false,
-	}
+	validSession := factory.NewSession().WithUserID(userID).WithTokenPair(tokenPair).Build()
+	expiredSession := factory.NewSession().WithID(2).WithUserID(userID).WithTokenPair(tokenPair).AccessExpired().Build()
 
 	testCases := []struct {
 		mockSetup     func()
+		expectedErr   error
 		name          string
 		token         string
 		errorMessage  string
@@ -156,7 +183,7 @@ func (suite *SessionServiceTestSuite) TestValidateAccessToken() {
 			name:  "Valid access token",
 			token: tokenPair.AccessToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByAccessToken", tokenPair.AccessToken).Return(validSession, nil)
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(validSession, nil)
 			},
 			expectedError: false,
 			expectedUID:   userID,
@@ -169,44 +196,85 @@ func (suite *SessionServiceTestSuite) TestValidateAccessToken() {
 			},
 			expectedError: true,
 			errorMessage:  "invalid access token",
+			expectedErr:   auth.ErrTokenInvalid,
 		},
 		{
 			name:  "Session not found in database",
 			token: tokenPair.AccessToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByAccessToken", tokenPair.AccessToken).Return(nil, gorm.ErrRecordNotFound)
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(nil, repository.ErrSessionNotFound)
 			},
 			expectedError: true,
 			errorMessage:  "session not found",
+			expectedErr:   auth.ErrTokenInvalid,
+		},
+		{
+			name:  "Session lookup failure",
+			token: tokenPair.AccessToken,
+			mockSetup: func() {
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(nil, gorm.ErrInvalidDB)
+			},
+			expectedError: true,
+			errorMessage:  "failed to find session",
+			expectedErr:   gorm.ErrInvalidDB,
 		},
 		{
 			name:  "Expired session",
 			token: tokenPair.AccessToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByAccessToken", tokenPair.AccessToken).Return(expiredSession, nil)
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(expiredSession, nil)
+			},
+			expectedError: true,
+			errorMessage:  "session is expired",
+			expectedErr:   auth.ErrTokenExpired,
+		},
+		{
+			name:  "Invalidated session",
+			token: tokenPair.AccessToken,
+			mockSetup: func() {
+				invalidatedSession := *validSession
+				invalidatedSession.IsDeleted = true
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(&invalidatedSession, nil)
 			},
 			expectedError: true,
-			errorMessage:  "session is expired or invalidated",
+			errorMessage:  "session is invalidated",
+			expectedErr:   auth.ErrTokenInvalid,
 		},
 		{
 			name:  "User ID mismatch",
 			token: tokenPair.AccessToken,
 			mockSetup: func() {
-				mismatchSession := &model.UserSession{
-					ID:                    3,
-					UserID:                999, // Different user ID
-					AccessToken:           tokenPair.AccessToken,
-					RefreshToken:          tokenPair.RefreshToken,
-					AccessTokenExpiresAt:  tokenPair.AccessTokenExpiresAt,
-					RefreshTokenExpiresAt: tokenPair.RefreshTokenExpiresAt,
-					CreatedAt:             time.Now(),
-					UpdatedAt:             time.Now(),
-					IsDeleted:             false,
-				}
-				suite.mockSessionRepo.On("FindByAccessToken", tokenPair.AccessToken).Return(mismatchSession, nil)
+				mismatchSession := factory.NewSession().WithID(3).WithUserID(999).WithTokenPair(tokenPair).Build()
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(mismatchSession, nil)
 			},
 			expectedError: true,
 			errorMessage:  "token user ID mismatch",
+			expectedErr:   auth.ErrTokenInvalid,
+		},
+		{
+			name:  "Session issued before password change",
+			token: tokenPair.AccessToken,
+			mockSetup: func() {
+				passwordChangedAt := time.Now()
+				user := factory.NewUser().WithID(userID).WithPasswordChangedAt(passwordChangedAt).Build()
+				staleSession := factory.NewSession().ForUser(user).WithTokenPair(tokenPair).CreatedAt(passwordChangedAt.Add(-time.Hour)).Build()
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(staleSession, nil)
+			},
+			expectedError: true,
+			errorMessage:  "session was issued before the last password change",
+			expectedErr:   auth.ErrTokenInvalid,
+		},
+		{
+			name:  "Session issued after password change",
+			token: tokenPair.AccessToken,
+			mockSetup: func() {
+				passwordChangedAt := time.Now().Add(-time.Hour)
+				user := factory.NewUser().WithID(userID).WithPasswordChangedAt(passwordChangedAt).Build()
+				freshSession := factory.NewSession().ForUser(user).WithTokenPair(tokenPair).Build()
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(freshSession, nil)
+			},
+			expectedError: false,
+			expectedUID:   userID,
 		},
 	}
 
@@ -229,6 +297,9 @@ func (suite *SessionServiceTestSuite) TestValidateAccessToken() {
 				if tc.errorMessage != "" {
 					assert.Contains(t, err.Error(), tc.errorMessage)
 				}
+				if tc.expectedErr != nil {
+					assert.ErrorIs(t, err, tc.expectedErr)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, session)
@@ -238,20 +309,31 @@ func (suite *SessionServiceTestSuite) TestValidateAccessToken() {
 	}
 }
 
+func (suite *SessionServiceTestSuite) TestValidateAccessTokenWithoutPasswordChangePolicy() {
+	os.Setenv("REVOKE_SESSIONS_ON_PASSWORD_CHANGE", "false")
+	defer os.Unsetenv("REVOKE_SESSIONS_ON_PASSWORD_CHANGE")
+	sessionService := service.NewSessionService(
+		suite.mockSessionRepo, nil, suite.jwtService, suite.denylist, events.NewBus(), nil, clock.New(),
+		auth.NewTokenGenerator(),
+	)
+
+	userID := uint(123)
+	tokenPair, _ := suite.jwtService.GenerateTokenPair(userID)
+	passwordChangedAt := time.Now()
+	user := factory.NewUser().WithID(userID).WithPasswordChangedAt(passwordChangedAt).Build()
+	staleSession := factory.NewSession().ForUser(user).WithTokenPair(tokenPair).CreatedAt(passwordChangedAt.Add(-time.Hour)).Build()
+	suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(staleSession, nil)
+
+	session, err := sessionService.ValidateAccessToken(tokenPair.AccessToken)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), userID, session.UserID)
+}
+
 func (suite *SessionServiceTestSuite) TestRefreshToken() {
 	userID := uint(456)
 	tokenPair, _ := suite.jwtService.GenerateTokenPair(userID)
-	validSession := &model.UserSession{
-		ID:                    1,
-		UserID:                userID,
-		AccessToken:           tokenPair.AccessToken,
-		RefreshToken:          tokenPair.RefreshToken,
-		AccessTokenExpiresAt:  tokenPair.AccessTokenExpiresAt,
-		RefreshTokenExpiresAt: tokenPair.RefreshTokenExpiresAt,
-		CreatedAt:             time.Now(),
-		UpdatedAt:             time.Now(),
-		IsDeleted:             false,
-	}
+	validSession := factory.NewSession().WithUserID(userID).WithTokenPair(tokenPair).Build()
 
 	testCases := []struct {
 		mockSetup     func()
@@ -264,7 +346,7 @@ func (suite *SessionServiceTestSuite) TestRefreshToken() {
 			name:         "Success",
 			refreshToken: tokenPair.RefreshToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByRefreshToken", tokenPair.RefreshToken).Return(validSession, nil).Once()
+				suite.mockSessionRepo.On("FindByRefreshTokenHash", auth.HashToken(tokenPair.RefreshToken)).Return(validSession, nil).Once()
 				suite.mockSessionRepo.On("Update", mock.AnythingOfType("*model.UserSession")).Return(nil).Once().Once()
 			},
 			expectedError: false,
@@ -282,7 +364,7 @@ func (suite *SessionServiceTestSuite) TestRefreshToken() {
 			name:         "Session not found",
 			refreshToken: tokenPair.RefreshToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByRefreshToken", tokenPair.RefreshToken).Return(nil, gorm.ErrRecordNotFound).Once()
+				suite.mockSessionRepo.On("FindByRefreshTokenHash", auth.HashToken(tokenPair.RefreshToken)).Return(nil, repository.ErrSessionNotFound).Once()
 			},
 			expectedError: true,
 			errorMessage:  "session not found",
@@ -291,7 +373,7 @@ func (suite *SessionServiceTestSuite) TestRefreshToken() {
 			name:         "Update session error",
 			refreshToken: tokenPair.RefreshToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByRefreshToken", tokenPair.RefreshToken).Return(validSession, nil)
+				suite.mockSessionRepo.On("FindByRefreshTokenHash", auth.HashToken(tokenPair.RefreshToken)).Return(validSession, nil)
 				suite.mockSessionRepo.On("Update", mock.AnythingOfType("*model.UserSession")).Return(errors.New("update error")).Once()
 			},
 			expectedError: true,
@@ -339,15 +421,14 @@ func (suite *SessionServiceTestSuite) TestRefreshToken() {
 func (suite *SessionServiceTestSuite) TestLogout() {
 	userID := uint(789)
 	accessToken := "test-access-token"
-	validSession := &model.UserSession{
-		ID:          1,
-		UserID:      userID,
-		AccessToken: accessToken,
-		IsDeleted:   false,
+	// Each case gets its own session, as logging out invalidates it
+	validSession := func() *model.UserSession {
+		return factory.NewSession().WithUserID(userID).WithTokens(accessToken, factory.RefreshToken).Build()
 	}
 
 	testCases := []struct {
 		mockSetup     func()
+		expectedIs    error
 		name          string
 		accessToken   string
 		errorMessage  string
@@ -359,7 +440,7 @@ func (suite *SessionServiceTestSuite) TestLogout() {
 			userID:      userID,
 			accessToken: accessToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByAccessToken", accessToken).Return(validSession, nil).Once()
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(accessToken)).Return(validSession(), nil).Once()
 				suite.mockSessionRepo.On("Update", mock.AnythingOfType("*model.UserSession")).Return(nil).Once().Once()
 			},
 			expectedError: false,
@@ -369,7 +450,7 @@ func (suite *SessionServiceTestSuite) TestLogout() {
 			userID:      userID,
 			accessToken: "nonexistent-token",
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByAccessToken", "nonexistent-token").Return(nil, gorm.ErrRecordNotFound).Once()
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken("nonexistent-token")).Return(nil, gorm.ErrRecordNotFound).Once()
 			},
 			expectedError: true,
 			errorMessage:  "session not found",
@@ -379,17 +460,31 @@ func (suite *SessionServiceTestSuite) TestLogout() {
 			userID:      999, // Different user ID
 			accessToken: accessToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByAccessToken", accessToken).Return(validSession, nil)
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(accessToken)).Return(validSession(), nil)
 			},
 			expectedError: true,
-			errorMessage:  "session does not belong to user",
+			errorMessage:  "session belongs to another user",
+			expectedIs:    auth.ErrSessionNotOwned,
+		},
+		{
+			name:        "Session already invalidated",
+			userID:      userID,
+			accessToken: accessToken,
+			mockSetup: func() {
+				invalidated := validSession()
+				invalidated.Invalidate()
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(accessToken)).Return(invalidated, nil).Once()
+			},
+			expectedError: true,
+			errorMessage:  "session is already invalidated",
+			expectedIs:    repository.ErrSessionNotFound,
 		},
 		{
 			name:        "Update session error",
 			userID:      userID,
 			accessToken: accessToken,
 			mockSetup: func() {
-				suite.mockSessionRepo.On("FindByAccessToken", accessToken).Return(validSession, nil).Once()
+				suite.mockSessionRepo.On("FindByAccessTokenHash", auth.HashToken(accessToken)).Return(validSession(), nil).Once()
 				suite.mockSessionRepo.On("Update", mock.AnythingOfType("*model.UserSession")).Return(errors.New("update error")).Once().Once()
 			},
 			expectedError: true,
@@ -415,6 +510,9 @@ func (suite *SessionServiceTestSuite) TestLogout() {
 				if tc.errorMessage != "" && err != nil {
 					assert.Contains(t, err.Error(), tc.errorMessage)
 				}
+				if tc.expectedIs != nil {
+					assert.ErrorIs(t, err, tc.expectedIs)
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -474,6 +572,191 @@ func (suite *SessionServiceTestSuite) TestInvalidateAllUserSessions() {
 	}
 }
 
+func (suite *SessionServiceTestSuite) TestListActiveSessions() {
+	testCases := []struct {
+		mockSetup     func()
+		name          string
+		errorMessage  string
+		userID        uint
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name:   "Success",
+			userID: 1,
+			mockSetup: func() {
+				page := &pagination.Page[model.UserSession]{Items: []model.UserSession{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}}}
+				suite.mockSessionRepo.On("ListActiveByUserID", uint(1), pagination.Params{PerPage: 2}).Return(page, nil).Once()
+			},
+			expectedCount: 2,
+		},
+		{
+			name:   "Repository error",
+			userID: 2,
+			mockSetup: func() {
+				suite.mockSessionRepo.On("ListActiveByUserID", uint(2), pagination.Params{PerPage: 2}).
+					Return(nil, errors.New("database error")).Once()
+			},
+			expectedError: true,
+			errorMessage:  "failed to list active sessions",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			// Reset mocks for this specific test case
+			suite.mockSessionRepo.ExpectedCalls = nil
+			suite.mockSessionRepo.Calls = nil
+
+			// Setup mocks
+			tc.mockSetup()
+
+			// Execute
+			page, err := suite.sessionService.ListActiveSessions(tc.userID, pagination.Params{PerPage: 2})
+
+			// Assert
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, page)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, page.Items, tc.expectedCount)
+			}
+		})
+	}
+}
+
+func (suite *SessionServiceTestSuite) TestRevokeSession() {
+	testCases := []struct {
+		mockSetup     func()
+		expectedIs    error
+		name          string
+		errorMessage  string
+		sessionID     string
+		userID        uint
+		expectedError bool
+	}{
+		{
+			name:      "Success",
+			userID:    1,
+			sessionID: "ses_000000000000000000aa",
+			mockSetup: func() {
+				suite.mockSessionRepo.On("InvalidateByPublicID", "ses_000000000000000000aa", uint(1)).Return(nil).Once()
+			},
+		},
+		{
+			name:      "Session not found",
+			userID:    1,
+			sessionID: "ses_000000000000000000bb",
+			mockSetup: func() {
+				suite.mockSessionRepo.On("InvalidateByPublicID", "ses_000000000000000000bb", uint(1)).Return(repository.ErrSessionNotFound).Once()
+			},
+			expectedError: true,
+			expectedIs:    repository.ErrSessionNotFound,
+			errorMessage:  "failed to revoke session",
+		},
+		{
+			name:      "Repository error",
+			userID:    2,
+			sessionID: "ses_000000000000000000cc",
+			mockSetup: func() {
+				suite.mockSessionRepo.On("InvalidateByPublicID", "ses_000000000000000000cc", uint(2)).Return(errors.New("database error")).Once()
+			},
+			expectedError: true,
+			errorMessage:  "failed to revoke session",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			// Reset mocks for this specific test case
+			suite.mockSessionRepo.ExpectedCalls = nil
+			suite.mockSessionRepo.Calls = nil
+
+			// Setup mocks
+			tc.mockSetup()
+
+			// Execute
+			err := suite.sessionService.RevokeSession(factory.NewUser().WithID(tc.userID).Build(), tc.sessionID)
+
+			// Assert
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+				if tc.expectedIs != nil {
+					assert.ErrorIs(t, err, tc.expectedIs)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (suite *SessionServiceTestSuite) TestRevokeSessionPublishesEvent() {
+	var published []events.Event
+	bus := events.NewBus()
+	bus.Subscribe(events.SessionRevoked, func(_ context.Context, event events.Event) {
+		published = append(published, event)
+	})
+	suite.mockSessionRepo.On("InvalidateByPublicID", "ses_000000000000000000aa", uint(1)).Return(nil).Once()
+
+	sessionService := service.NewSessionService(
+		suite.mockSessionRepo, nil, suite.jwtService, suite.denylist, bus, nil, clock.New(), auth.NewTokenGenerator(),
+	)
+	err := sessionService.RevokeSession(factory.NewUser().Build(), "ses_000000000000000000aa")
+
+	assert.NoError(suite.T(), err)
+	if assert.Len(suite.T(), published, 1) {
+		assert.Equal(suite.T(), events.SessionData{
+			ID:     "ses_000000000000000000aa",
+			UserID: "usr_5k2d9x0q7m3hv8c1b4na",
+		}, published[0].Data)
+	}
+}
+
+func (suite *SessionServiceTestSuite) TestCreateSessionChecksLoginAlerts() {
+	loginAlerts := servicemocks.NewMockLoginAlertServiceInterface(suite.T())
+	loginAlerts.EXPECT().CheckLogin(mock.MatchedBy(func(session *model.UserSession) bool {
+		return session.UserID == 1 && session.PublicID != "" && session.Country != nil && *session.Country == "JP"
+	})).Once()
+	suite.mockSessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).Return(nil).Once()
+
+	sessionService := service.NewSessionService(
+		suite.mockSessionRepo, nil, suite.jwtService, suite.denylist, events.NewBus(), loginAlerts, clock.New(),
+		auth.NewTokenGenerator(),
+	)
+	_, err := sessionService.CreateSession(1, service.SessionMetadata{UserAgent: "test", Country: "jp"})
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *SessionServiceTestSuite) TestRevokeSessionByToken() {
+	user := factory.NewUser().Build()
+	revokeToken, err := suite.jwtService.GenerateSessionRevokeToken(user.ID, "ses_000000000000000000aa")
+	require.NoError(suite.T(), err)
+	tokenPair, err := suite.jwtService.GenerateTokenPair(user.ID)
+	require.NoError(suite.T(), err)
+
+	userRepo := mocks.NewMockUserRepository(suite.T())
+	userRepo.EXPECT().GetByID(user.ID).Return(user, nil).Once()
+	suite.mockSessionRepo.On("InvalidateByPublicID", "ses_000000000000000000aa", user.ID).Return(nil).Once()
+	sessionService := service.NewSessionService(
+		suite.mockSessionRepo, userRepo, suite.jwtService, suite.denylist, events.NewBus(), nil, clock.New(),
+		auth.NewTokenGenerator(),
+	)
+
+	revokedBy, sessionID, err := sessionService.RevokeSessionByToken(revokeToken)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), user, revokedBy)
+	assert.Equal(suite.T(), "ses_000000000000000000aa", sessionID)
+
+	_, _, err = sessionService.RevokeSessionByToken(tokenPair.AccessToken)
+	assert.ErrorIs(suite.T(), err, auth.ErrInvalidSessionRevokeToken)
+}
+
 func (suite *SessionServiceTestSuite) TestCleanupExpiredSessions() {
 	testCases := []struct {
 		mockSetup     func()
@@ -525,10 +808,305 @@ func (suite *SessionServiceTestSuite) TestCleanupExpiredSessions() {
 
 func (suite *SessionServiceTestSuite) TestNewSessionService() {
 	// Test that NewSessionService creates a valid service
-	svc := service.NewSessionService(suite.mockSessionRepo, suite.jwtService)
+	svc := service.NewSessionService(
+		suite.mockSessionRepo, nil, suite.jwtService, suite.denylist, events.NewBus(), nil, clock.New(),
+		auth.NewTokenGenerator(),
+	)
 	assert.NotNil(suite.T(), svc)
 }
 
+func TestSessionService_StatelessAccessTokens(t *testing.T) {
+	t.Setenv("JWT_SECRET_KEY", "test-secret-key-for-session-testing")
+	t.Setenv("STATELESS_ACCESS_TOKENS", "true")
+	t.Setenv("STATELESS_ACCESS_TOKEN_TTL_SECONDS", "120")
+
+	jwtService := auth.NewJWTService(clock.New())
+	assert.Equal(t, 2*time.Minute, jwtService.AccessTokenTTL())
+	sessionRepo := new(mocks.MockSessionRepository)
+	userRepo := mocks.NewMockUserRepository(t)
+	sessionService := service.NewSessionService(
+		sessionRepo, userRepo, jwtService, auth.NewDenylist(nil, jwtService), events.NewBus(), nil, clock.New(),
+		auth.NewTokenGenerator(),
+	)
+
+	userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().Admin().Verified().Build(), nil).Once()
+	var created *model.UserSession
+	sessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).
+		Run(func(args mock.Arguments) { created = args.Get(0).(*model.UserSession) }).
+		Return(nil).Once()
+
+	tokenPair, err := sessionService.CreateSession(1, service.SessionMetadata{})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), tokenPair.AccessTokenExpiresAt, 5*time.Second)
+
+	// The access token is validated from its claims, without loading the session
+	session, err := sessionService.ValidateAccessToken(tokenPair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, created.PublicID, session.PublicID)
+	assert.Equal(t, uint(1), session.UserID)
+	assert.Equal(t, "usr_5k2d9x0q7m3hv8c1b4na", session.User.PublicID)
+	assert.True(t, session.User.IsAdmin())
+	assert.True(t, session.User.EmailVerified)
+	assert.WithinDuration(t, created.CreatedAt, session.CreatedAt, time.Second)
+
+	// Logging out loads the session and revokes the token
+	created.User = model.User{ID: 1}
+	sessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(created, nil).Once()
+	sessionRepo.On("Update", created).Return(nil).Once()
+	require.NoError(t, sessionService.Logout(1, tokenPair.AccessToken))
+
+	_, err = sessionService.ValidateAccessToken(tokenPair.AccessToken)
+	assert.ErrorContains(t, err, "session is invalidated")
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestSessionService_StatelessRevokeUser(t *testing.T) {
+	t.Setenv("JWT_SECRET_KEY", "test-secret-key-for-session-testing")
+	t.Setenv("STATELESS_ACCESS_TOKENS", "true")
+
+	jwtService := auth.NewJWTService(clock.New())
+	sessionRepo := new(mocks.MockSessionRepository)
+	sessionService := service.NewSessionService(
+		sessionRepo, nil, jwtService, auth.NewDenylist(nil, jwtService), events.NewBus(), nil, clock.New(),
+		auth.NewTokenGenerator(),
+	)
+
+	tokenPair, err := jwtService.GenerateSessionTokenPair(1, &auth.SessionClaims{ID: "ses_8h2m4q0x9d7c3v1k5b6n"})
+	require.NoError(t, err)
+	_, err = sessionService.ValidateAccessToken(tokenPair.AccessToken)
+	require.NoError(t, err)
+
+	sessionRepo.On("InvalidateByUserID", uint(1)).Return(nil).Once()
+	require.NoError(t, sessionService.InvalidateAllUserSessions(1))
+
+	_, err = sessionService.ValidateAccessToken(tokenPair.AccessToken)
+	assert.ErrorContains(t, err, "session is invalidated")
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestSessionService_FrozenClock(t *testing.T) {
+	t.Setenv("JWT_SECRET_KEY", "test-secret-key-for-session-testing")
+
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(createdAt)
+	tokens := auth.TokenGeneratorFunc(func(prefix string) string { return prefix + "_8h2m4q0x9d7c3v1k5b6n" })
+	jwtService := auth.NewJWTService(clk)
+	sessionRepo := new(mocks.MockSessionRepository)
+	sessionService := service.NewSessionService(
+		sessionRepo, nil, jwtService, auth.NewDenylist(nil, jwtService), events.NewBus(), nil, clk, tokens,
+	)
+
+	var created *model.UserSession
+	sessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).
+		Run(func(args mock.Arguments) { created = args.Get(0).(*model.UserSession) }).
+		Return(nil).Once()
+	tokenPair, err := sessionService.CreateSession(1, service.SessionMetadata{})
+	require.NoError(t, err)
+	assert.Equal(t, "ses_8h2m4q0x9d7c3v1k5b6n", created.PublicID)
+	assert.Equal(t, auth.HashToken(tokenPair.AccessToken), created.AccessTokenHash)
+	assert.Equal(t, auth.HashToken(tokenPair.RefreshToken), created.RefreshTokenHash)
+	assert.Equal(t, createdAt, created.CreatedAt)
+	assert.Equal(t, createdAt.Add(time.Hour), created.AccessTokenExpiresAt)
+	assert.Equal(t, createdAt.Add(30*24*time.Hour), created.RefreshTokenExpiresAt)
+	sessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(created, nil)
+	sessionRepo.On("FindByRefreshTokenHash", auth.HashToken(tokenPair.RefreshToken)).Return(created, nil)
+
+	t.Run("access token valid until its last second", func(t *testing.T) {
+		clk.Set(createdAt.Add(time.Hour - time.Second))
+		_, err := sessionService.ValidateAccessToken(tokenPair.AccessToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("access token expired at its expiry", func(t *testing.T) {
+		clk.Set(createdAt.Add(time.Hour))
+		_, err := sessionService.ValidateAccessToken(tokenPair.AccessToken)
+		assert.ErrorIs(t, err, auth.ErrTokenExpired)
+	})
+
+	t.Run("session expired before its token", func(t *testing.T) {
+		// The session row is checked besides the token, e.g. when its expiry was shortened
+		clk.Set(createdAt.Add(30 * time.Minute))
+		created.AccessTokenExpiresAt = createdAt.Add(30 * time.Minute)
+		_, err := sessionService.ValidateAccessToken(tokenPair.AccessToken)
+		assert.ErrorIs(t, err, auth.ErrTokenExpired)
+		assert.ErrorContains(t, err, "session is expired")
+	})
+
+	t.Run("refresh token expired at its expiry", func(t *testing.T) {
+		clk.Set(createdAt.Add(30 * 24 * time.Hour))
+		_, err := sessionService.RefreshToken(tokenPair.RefreshToken)
+		assert.ErrorIs(t, err, auth.ErrTokenExpired)
+	})
+
+	t.Run("refresh extends the session from now", func(t *testing.T) {
+		refreshedAt := createdAt.Add(2 * time.Hour)
+		clk.Set(refreshedAt)
+		sessionRepo.On("Update", created).Return(nil).Once()
+
+		refreshed, err := sessionService.RefreshToken(tokenPair.RefreshToken)
+		require.NoError(t, err)
+		assert.Equal(t, refreshedAt.Add(time.Hour), refreshed.AccessTokenExpiresAt)
+		assert.Equal(t, refreshedAt.Add(30*24*time.Hour), refreshed.RefreshTokenExpiresAt)
+		assert.Equal(t, refreshedAt, created.UpdatedAt)
+		assert.Equal(t, createdAt, created.CreatedAt)
+	})
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestSessionService_Activity(t *testing.T) {
+	t.Setenv("JWT_SECRET_KEY", "test-secret-key-for-session-testing")
+	t.Setenv("SESSION_ACTIVITY_INTERVAL_SECONDS", "60")
+	t.Setenv("SESSION_IDLE_TIMEOUT_SECONDS", "1800")
+
+	createdAt := time.Now().Truncate(time.Second)
+	clk := clock.NewFake(createdAt)
+	jwtService := auth.NewJWTService(clk)
+	sessionRepo := new(mocks.MockSessionRepository)
+	sessionService := service.NewSessionService(
+		sessionRepo, nil, jwtService, auth.NewDenylist(nil, jwtService), events.NewBus(), nil, clk,
+		auth.NewTokenGenerator(),
+	)
+
+	var created *model.UserSession
+	sessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).
+		Run(func(args mock.Arguments) {
+			created = args.Get(0).(*model.UserSession)
+			created.ID = 1
+		}).
+		Return(nil).Once()
+	tokenPair, err := sessionService.CreateSession(1, service.SessionMetadata{})
+	require.NoError(t, err)
+	assert.Equal(t, createdAt, *created.LastUsedAt)
+	sessionRepo.On("FindByAccessTokenHash", auth.HashToken(tokenPair.AccessToken)).Return(created, nil)
+	sessionRepo.On("FindByRefreshTokenHash", auth.HashToken(tokenPair.RefreshToken)).Return(created, nil)
+
+	t.Run("use within the activity interval is not recorded", func(t *testing.T) {
+		clk.Set(createdAt.Add(59 * time.Second))
+		sessionService.TouchSession(created)
+		sessionRepo.AssertNotCalled(t, "UpdateLastUsedAt", mock.Anything, mock.Anything)
+	})
+
+	t.Run("use after the activity interval is recorded", func(t *testing.T) {
+		usedAt := createdAt.Add(20 * time.Minute)
+		clk.Set(usedAt)
+		sessionRepo.On("UpdateLastUsedAt", created, usedAt).Return(nil).Once()
+
+		sessionService.TouchSession(created)
+
+		assert.Equal(t, usedAt, *created.LastUsedAt)
+	})
+
+	t.Run("use keeps the session from idling", func(t *testing.T) {
+		clk.Set(createdAt.Add(45 * time.Minute))
+		_, err := sessionService.ValidateAccessToken(tokenPair.AccessToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("idle session is rejected", func(t *testing.T) {
+		clk.Set(createdAt.Add(50 * time.Minute))
+		_, err := sessionService.ValidateAccessToken(tokenPair.AccessToken)
+		assert.ErrorContains(t, err, "session is idle")
+		assert.NotErrorIs(t, err, auth.ErrTokenExpired)
+
+		_, err = sessionService.RefreshToken(tokenPair.RefreshToken)
+		assert.ErrorContains(t, err, "session is idle")
+	})
+
+	t.Run("sessions of stateless access tokens are not recorded", func(t *testing.T) {
+		clk.Set(createdAt.Add(time.Hour))
+		sessionService.TouchSession(&model.UserSession{UserID: 1})
+	})
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestSessionService_SessionLimit(t *testing.T) {
+	t.Setenv("JWT_SECRET_KEY", "test-secret-key-for-session-testing")
+	t.Setenv("MAX_SESSIONS_PER_USER", "2")
+
+	userID := uint(1)
+	oldest := factory.NewSession().WithID(1).WithPublicID("ses_1oldest0000000000000").WithUserID(userID).
+		CreatedAt(time.Now().Add(-48 * time.Hour)).AccessExpired().Build()
+	older := factory.NewSession().WithID(2).WithPublicID("ses_2older00000000000000").WithUserID(userID).
+		CreatedAt(time.Now().Add(-time.Hour)).Build()
+
+	newService := func(sessionRepo *mocks.MockSessionRepository) service.SessionServiceInterface {
+		jwtService := auth.NewJWTService(clock.New())
+		return service.NewSessionService(
+			sessionRepo, nil, jwtService, auth.NewDenylist(nil, jwtService), events.NewBus(), nil, clock.New(),
+			auth.NewTokenGenerator(),
+		)
+	}
+
+	t.Run("evicts the oldest session by default", func(t *testing.T) {
+		sessionRepo := new(mocks.MockSessionRepository)
+		sessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).
+			Run(func(args mock.Arguments) { args.Get(0).(*model.UserSession).ID = 3 }).
+			Return(nil).Once()
+		sessionRepo.On("FindRefreshableByUserID", userID).
+			Return([]*model.UserSession{oldest, older, {ID: 3, UserID: userID}}, nil).Once()
+		sessionRepo.On("InvalidateByPublicID", oldest.PublicID, userID).Return(nil).Once()
+
+		tokenPair, err := newService(sessionRepo).CreateSession(userID, service.SessionMetadata{})
+
+		require.NoError(t, err)
+		assert.NotNil(t, tokenPair)
+		sessionRepo.AssertExpectations(t)
+		sessionRepo.AssertNotCalled(t, "InvalidateByPublicID", older.PublicID, userID)
+	})
+
+	t.Run("signs in even when eviction fails", func(t *testing.T) {
+		sessionRepo := new(mocks.MockSessionRepository)
+		sessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).
+			Run(func(args mock.Arguments) { args.Get(0).(*model.UserSession).ID = 3 }).
+			Return(nil).Once()
+		sessionRepo.On("FindRefreshableByUserID", userID).
+			Return([]*model.UserSession{oldest, older, {ID: 3, UserID: userID}}, nil).Once()
+		sessionRepo.On("InvalidateByPublicID", oldest.PublicID, userID).Return(errors.New("database error")).Once()
+		sessionRepo.On("InvalidateByPublicID", older.PublicID, userID).Return(nil).Once()
+
+		tokenPair, err := newService(sessionRepo).CreateSession(userID, service.SessionMetadata{})
+
+		require.NoError(t, err)
+		assert.NotNil(t, tokenPair)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects over the limit with the reject policy", func(t *testing.T) {
+		t.Setenv("SESSION_LIMIT_POLICY", "reject")
+		sessionRepo := new(mocks.MockSessionRepository)
+		sessionRepo.On("FindRefreshableByUserID", userID).Return([]*model.UserSession{oldest, older}, nil).Once()
+
+		tokenPair, err := newService(sessionRepo).CreateSession(userID, service.SessionMetadata{})
+
+		assert.ErrorIs(t, err, auth.ErrSessionLimitReached)
+		assert.Nil(t, tokenPair)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("creates below the limit with the reject policy", func(t *testing.T) {
+		t.Setenv("SESSION_LIMIT_POLICY", "reject")
+		sessionRepo := new(mocks.MockSessionRepository)
+		sessionRepo.On("FindRefreshableByUserID", userID).Return([]*model.UserSession{older}, nil).Once()
+		sessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).Return(nil).Once()
+
+		_, err := newService(sessionRepo).CreateSession(userID, service.SessionMetadata{})
+
+		require.NoError(t, err)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not count sessions without a limit", func(t *testing.T) {
+		t.Setenv("MAX_SESSIONS_PER_USER", "0")
+		sessionRepo := new(mocks.MockSessionRepository)
+		sessionRepo.On("Create", mock.AnythingOfType("*model.UserSession")).Return(nil).Once()
+
+		_, err := newService(sessionRepo).CreateSession(userID, service.SessionMetadata{})
+
+		require.NoError(t, err)
+		sessionRepo.AssertNotCalled(t, "FindRefreshableByUserID", userID)
+	})
+}
+
 func TestSessionServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(SessionServiceTestSuite))
 }
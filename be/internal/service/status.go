@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"strikepad-backend/internal/dto"
+
+	"gorm.io/gorm"
+)
+
+// Statuses reported for each component and for the service as a whole. The service is
+// degraded when some, but not all, components are down.
+const (
+	StatusOperational = "operational"
+	StatusDegraded    = "degraded"
+	StatusOutage      = "outage"
+)
+
+const (
+	// statusCacheTTL bounds how often components are probed, however often the status is polled
+	statusCacheTTL = 30 * time.Second
+	// statusProbeTimeout bounds how long a single component probe may take
+	statusProbeTimeout = 2 * time.Second
+)
+
+// componentProbe checks a single component and returns an error when it is unavailable
+type componentProbe struct {
+	check func(ctx context.Context) error
+	name  string
+}
+
+// StatusService reports the health of the service's components for the public status page.
+// Results are cached so that polling the status never puts load on the components.
+type StatusService struct {
+	checkedAt time.Time
+	cached    *dto.StatusResponse
+	probes    []componentProbe
+	mu        sync.Mutex
+}
+
+// NewStatusService creates a status service probing the database
+func NewStatusService(db *gorm.DB) StatusServiceInterface {
+	return &StatusService{
+		probes: []componentProbe{
+			{name: "database", check: databaseProbe(db)},
+		},
+	}
+}
+
+// databaseProbe pings the database connection pool
+func databaseProbe(db *gorm.DB) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	}
+}
+
+// GetStatus returns the cached component status, probing the components again once the cache expires
+func (s *StatusService) GetStatus() *dto.StatusResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && time.Since(s.checkedAt) < statusCacheTTL {
+		return s.cached
+	}
+
+	now := time.Now()
+	response := &dto.StatusResponse{
+		CheckedAt:  now.UTC(),
+		Components: make([]dto.ComponentStatus, 0, len(s.probes)),
+	}
+
+	down := 0
+	for _, probe := range s.probes {
+		ctx, cancel := context.WithTimeout(context.Background(), statusProbeTimeout)
+		err := probe.check(ctx)
+		cancel()
+
+		status := StatusOperational
+		if err != nil {
+			slog.Warn("Status probe failed", "component", probe.name, "error", err)
+			status = StatusOutage
+			down++
+		}
+		response.Components = append(response.Components, dto.ComponentStatus{Name: probe.name, Status: status})
+	}
+
+	switch {
+	case down == 0:
+		response.Status = StatusOperational
+	case down == len(s.probes):
+		response.Status = StatusOutage
+	default:
+		response.Status = StatusDegraded
+	}
+
+	s.cached = response
+	s.checkedAt = now
+	return response
+}
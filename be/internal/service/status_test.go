@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestStatusService_GetStatus(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		name               string
+		expectedStatus     string
+		expectedComponents []string
+		probes             []componentProbe
+	}{
+		{
+			name:               "all components operational",
+			probes:             []componentProbe{{name: "database", check: ok}, {name: "mailer", check: ok}},
+			expectedStatus:     StatusOperational,
+			expectedComponents: []string{StatusOperational, StatusOperational},
+		},
+		{
+			name:               "one component down",
+			probes:             []componentProbe{{name: "database", check: ok}, {name: "mailer", check: down}},
+			expectedStatus:     StatusDegraded,
+			expectedComponents: []string{StatusOperational, StatusOutage},
+		},
+		{
+			name:               "all components down",
+			probes:             []componentProbe{{name: "database", check: down}},
+			expectedStatus:     StatusOutage,
+			expectedComponents: []string{StatusOutage},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusService := &StatusService{probes: tt.probes}
+
+			result := statusService.GetStatus()
+
+			assert.Equal(t, tt.expectedStatus, result.Status)
+			require.Len(t, result.Components, len(tt.expectedComponents))
+			for i, expected := range tt.expectedComponents {
+				assert.Equal(t, tt.probes[i].name, result.Components[i].Name)
+				assert.Equal(t, expected, result.Components[i].Status)
+			}
+		})
+	}
+}
+
+func TestStatusService_CachesResults(t *testing.T) {
+	calls := 0
+	statusService := &StatusService{probes: []componentProbe{{
+		name: "database",
+		check: func(context.Context) error {
+			calls++
+			return nil
+		},
+	}}}
+
+	first := statusService.GetStatus()
+	second := statusService.GetStatus()
+
+	assert.Equal(t, 1, calls)
+	assert.Same(t, first, second)
+}
+
+func TestDatabaseProbe(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	mock.ExpectPing() // gorm pings once when opening
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+
+	mock.ExpectPing()
+	assert.NoError(t, databaseProbe(gormDB)(context.Background()))
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+	assert.Error(t, databaseProbe(gormDB)(context.Background()))
+}
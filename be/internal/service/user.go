@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"strikepad-backend/internal/avatar"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/storage"
+)
+
+const (
+	// MinUserSearchQueryLength is the number of characters a user search needs, so that one or two
+	// keystrokes do not list everyone
+	MinUserSearchQueryLength = 2
+	// DefaultUserSearchLimit is the number of users a search returns when no limit is given
+	DefaultUserSearchLimit = 10
+	// MaxUserSearchLimit is the most users a search returns
+	MaxUserSearchLimit = 20
+)
+
+// ErrSearchQueryTooShort is returned for user searches shorter than MinUserSearchQueryLength
+var ErrSearchQueryTooShort = fmt.Errorf("search query must be at least %d characters", MinUserSearchQueryLength)
+
+// UserService handles the current user's account: finding other users, privacy settings and
+// avatars
+type UserService struct {
+	userRepo repository.UserRepository
+	storage  storage.Storage
+}
+
+// NewUserService creates a new user service
+func NewUserService(userRepo repository.UserRepository, store storage.Storage) UserServiceInterface {
+	return &UserService{
+		userRepo: userRepo,
+		storage:  store,
+	}
+}
+
+// SearchUsers returns the users whose display name or email address starts with query, for
+// typeahead when sharing with other users. The searching user and users hidden from the search
+// are left out, and limit is clamped to MaxUserSearchLimit.
+func (s *UserService) SearchUsers(userID uint, query string, limit int) (*dto.UserSearchResponse, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < MinUserSearchQueryLength {
+		return nil, ErrSearchQueryTooShort
+	}
+	if limit < 1 {
+		limit = DefaultUserSearchLimit
+	}
+	limit = min(limit, MaxUserSearchLimit)
+
+	// One more user than asked for tells whether more match
+	users, err := s.userRepo.Search(repository.UserSearchParams{
+		Query:         query,
+		ExcludeUserID: userID,
+		Limit:         limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	meta := pagination.Meta{Page: 1, PerPage: limit, HasMore: len(users) > limit}
+	users = users[:min(len(users), limit)]
+
+	results := make([]dto.UserSearchResult, 0, len(users))
+	for _, user := range users {
+		results = append(results, dto.UserSearchResult{
+			ID:          user.PublicID,
+			DisplayName: user.DisplayName,
+			AvatarURL:   user.AvatarURL,
+		})
+	}
+	response := dto.UserSearchResponse(dto.NewListResponse(results, meta))
+	return &response, nil
+}
+
+// GetPrivacySettings returns the privacy settings of a user
+func (s *UserService) GetPrivacySettings(userID uint) (*dto.PrivacySettingsResponse, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return toPrivacySettingsResponse(user), nil
+}
+
+// UpdatePrivacySettings changes the privacy settings of a user. With req.Version set, it returns
+// repository.ErrStaleVersion unless that is still the version of the user.
+func (s *UserService) UpdatePrivacySettings(
+	userID uint,
+	req *dto.PrivacySettingsRequest,
+) (*dto.PrivacySettingsResponse, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if req.Version != nil && *req.Version != user.Version {
+		return nil, repository.ErrStaleVersion
+	}
+
+	if req.Discoverable != nil {
+		user.HideFromSearch = !*req.Discoverable
+	}
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, fmt.Errorf("failed to save privacy settings: %w", err)
+	}
+
+	slog.Info("Privacy settings updated", "user_id", userID, "discoverable", !user.HideFromSearch)
+	return toPrivacySettingsResponse(user), nil
+}
+
+// UploadAvatar validates and resizes an uploaded image (see avatar.Process) and stores it as the
+// user's avatar, replacing the previous one. The URL carries a hash of the image so that caches
+// and clients pick up the new avatar.
+func (s *UserService) UploadAvatar(ctx context.Context, userID uint, data []byte) (*dto.AvatarResponse, error) {
+	processed, err := avatar.Process(data)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	key := avatarKey(user)
+	if err := s.storage.Put(ctx, key, processed, avatar.ContentType); err != nil {
+		return nil, fmt.Errorf("failed to store avatar: %w", err)
+	}
+
+	sum := sha256.Sum256(processed)
+	avatarURL := s.storage.URL(key) + "?v=" + hex.EncodeToString(sum[:6])
+	user.AvatarURL = &avatarURL
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, fmt.Errorf("failed to save avatar URL: %w", err)
+	}
+
+	slog.Info("Avatar uploaded", "user_id", userID)
+	return &dto.AvatarResponse{AvatarURL: avatarURL}, nil
+}
+
+// DeleteAvatar removes the user's avatar. Removing a missing avatar is not an error.
+func (s *UserService) DeleteAvatar(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.AvatarURL == nil {
+		return nil
+	}
+
+	if err := s.storage.Delete(ctx, avatarKey(user)); err != nil {
+		return fmt.Errorf("failed to delete avatar: %w", err)
+	}
+	user.AvatarURL = nil
+	if err := s.userRepo.Update(user); err != nil {
+		return fmt.Errorf("failed to clear avatar URL: %w", err)
+	}
+
+	slog.Info("Avatar deleted", "user_id", userID)
+	return nil
+}
+
+// avatarKey is the storage key of a user's avatar. Each user has a single avatar that uploads
+// overwrite, so nothing is left behind to clean up.
+func avatarKey(user *model.User) string {
+	return "avatars/" + user.PublicID + ".png"
+}
+
+// toPrivacySettingsResponse converts a user to their privacy settings
+func toPrivacySettingsResponse(user *model.User) *dto.PrivacySettingsResponse {
+	return &dto.PrivacySettingsResponse{
+		Version:      user.Version,
+		Discoverable: !user.HideFromSearch,
+	}
+}
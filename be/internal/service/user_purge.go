@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/config"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/storage"
+
+	"gorm.io/gorm"
+)
+
+// userPurgeBatchSize is the number of deleted users purged per run
+const userPurgeBatchSize = 50
+
+// UserPurgeService irreversibly erases the personal data of deleted users once their retention
+// window has passed, or right away on request of an admin
+type UserPurgeService struct {
+	purgeRepo      repository.UserPurgeRepositoryInterface
+	userRepo       repository.UserRepository
+	sessionService SessionServiceInterface
+	storage        storage.Storage
+	interval       time.Duration
+	retention      time.Duration
+}
+
+// NewUserPurgeService creates a new user purge service. Users purged before they were deleted are
+// logged out through the session service, which also revokes stateless access tokens.
+func NewUserPurgeService(
+	purgeRepo repository.UserPurgeRepositoryInterface,
+	userRepo repository.UserRepository,
+	sessionService SessionServiceInterface,
+	store storage.Storage,
+) UserPurgeServiceInterface {
+	return &UserPurgeService{
+		purgeRepo:      purgeRepo,
+		userRepo:       userRepo.WithDeleted(),
+		sessionService: sessionService,
+		storage:        store,
+		interval:       time.Duration(config.GetEnvInt("USER_PURGE_INTERVAL_MINUTES", 60)) * time.Minute,
+		retention:      time.Duration(config.GetEnvInt("USER_PURGE_RETENTION_DAYS", 30)) * 24 * time.Hour,
+	}
+}
+
+// Run purges the users whose retention window has passed, every interval until ctx is canceled
+func (s *UserPurgeService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.PurgeDue(ctx); err != nil {
+			slog.Error("Failed to purge deleted users", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeDue purges up to one batch of users that were deleted longer than the retention window
+// ago, and returns how many were purged. A user that fails is retried on the next run.
+func (s *UserPurgeService) PurgeDue(ctx context.Context) (int, error) {
+	users, err := s.purgeRepo.ListDue(time.Now().Add(-s.retention), userPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for i := range users {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := s.purge(ctx, &users[i]); err != nil {
+			// Another instance purged the user in the meantime
+			if errors.Is(err, repository.ErrUserAlreadyPurged) {
+				continue
+			}
+			slog.Error("Failed to purge user", "error", err, "user_id", users[i].ID)
+			continue
+		}
+		purged++
+	}
+	if purged > 0 {
+		slog.Info("Deleted users purged", "count", purged)
+	}
+	return purged, nil
+}
+
+// PurgeUser purges the user with the public ID right away, deleting them first if they were not,
+// e.g. to honor an erasure request. It returns auth.ErrUserNotFound when the user does not exist
+// and repository.ErrUserAlreadyPurged when their data was already erased.
+func (s *UserPurgeService) PurgeUser(ctx context.Context, userPublicID string) error {
+	user, err := s.userRepo.GetByPublicID(userPublicID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return auth.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.PurgedAt != nil {
+		return repository.ErrUserAlreadyPurged
+	}
+	if !user.IsDeleted {
+		if err := s.sessionService.InvalidateAllUserSessions(user.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.purge(ctx, user); err != nil {
+		return err
+	}
+	slog.Info("User purged by admin", "user_id", user.ID)
+	return nil
+}
+
+// purge erases the personal data of user from the database, then deletes their files. The avatar
+// goes first, so that a failed purge leaves nothing the retry cannot find; export archives are
+// only known until the database purge commits.
+func (s *UserPurgeService) purge(ctx context.Context, user *model.User) error {
+	if err := s.storage.Delete(ctx, avatarKey(user)); err != nil {
+		return fmt.Errorf("failed to delete avatar: %w", err)
+	}
+
+	storageKeys, err := s.purgeRepo.Purge(user, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, key := range storageKeys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to delete data export archives: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	servicemocks "strikepad-backend/internal/service/mocks"
+	storagemocks "strikepad-backend/internal/storage/mocks"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type userPurgeMocks struct {
+	purgeRepo      *mocks.MockUserPurgeRepositoryInterface
+	userRepo       *mocks.MockUserRepository
+	sessionService *servicemocks.MockSessionServiceInterface
+	storage        *storagemocks.MockStorage
+}
+
+func newUserPurgeService(t *testing.T) (service.UserPurgeServiceInterface, *userPurgeMocks) {
+	t.Helper()
+
+	m := &userPurgeMocks{
+		purgeRepo:      mocks.NewMockUserPurgeRepositoryInterface(t),
+		userRepo:       mocks.NewMockUserRepository(t),
+		sessionService: &servicemocks.MockSessionServiceInterface{},
+		storage:        storagemocks.NewMockStorage(t),
+	}
+	m.userRepo.EXPECT().WithDeleted().Return(m.userRepo).Once()
+	t.Cleanup(func() { m.sessionService.AssertExpectations(t) })
+	return service.NewUserPurgeService(m.purgeRepo, m.userRepo, m.sessionService, m.storage), m
+}
+
+func TestUserPurgeService_PurgeDue(t *testing.T) {
+	users := []model.User{
+		*factory.NewUser().WithID(42).Deleted().Build(),
+		*factory.NewUser().WithID(43).WithPublicID("usr_0123456789abcdefghjk").Deleted().Build(),
+	}
+
+	purgeService, m := newUserPurgeService(t)
+	m.purgeRepo.EXPECT().ListDue(mock.MatchedBy(func(deletedBefore time.Time) bool {
+		return deletedBefore.Before(time.Now().Add(-29 * 24 * time.Hour))
+	}), 50).Return(users, nil).Once()
+	m.storage.EXPECT().Delete(mock.Anything, "avatars/"+factory.UserPublicID+".png").Return(nil).Once()
+	m.purgeRepo.EXPECT().Purge(&users[0], mock.Anything).
+		Return([]string{"private/exports/exp_9c4m1x7k2d5v8h0q3b6n.zip"}, nil).Once()
+	m.storage.EXPECT().Delete(mock.Anything, "private/exports/exp_9c4m1x7k2d5v8h0q3b6n.zip").Return(nil).Once()
+	// Purged by another instance in the meantime
+	m.storage.EXPECT().Delete(mock.Anything, "avatars/usr_0123456789abcdefghjk.png").Return(nil).Once()
+	m.purgeRepo.EXPECT().Purge(&users[1], mock.Anything).Return(nil, repository.ErrUserAlreadyPurged).Once()
+
+	purged, err := purgeService.PurgeDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+}
+
+func TestUserPurgeService_PurgeUser(t *testing.T) {
+	const userPublicID = factory.UserPublicID
+
+	t.Run("logs out and purges an active user", func(t *testing.T) {
+		purgeService, m := newUserPurgeService(t)
+		user := factory.NewUser().WithID(42).Build()
+		m.userRepo.EXPECT().GetByPublicID(userPublicID).Return(user, nil).Once()
+		m.sessionService.On("InvalidateAllUserSessions", uint(42)).Return(nil).Once()
+		m.storage.EXPECT().Delete(mock.Anything, "avatars/"+userPublicID+".png").Return(nil).Once()
+		m.purgeRepo.EXPECT().Purge(user, mock.Anything).Return(nil, nil).Once()
+
+		err := purgeService.PurgeUser(context.Background(), userPublicID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("already purged", func(t *testing.T) {
+		purgeService, m := newUserPurgeService(t)
+		m.userRepo.EXPECT().GetByPublicID(userPublicID).
+			Return(factory.NewUser().WithID(42).Purged(time.Now()).Build(), nil).Once()
+
+		err := purgeService.PurgeUser(context.Background(), userPublicID)
+
+		assert.ErrorIs(t, err, repository.ErrUserAlreadyPurged)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		purgeService, m := newUserPurgeService(t)
+		m.userRepo.EXPECT().GetByPublicID(userPublicID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		err := purgeService.PurgeUser(context.Background(), userPublicID)
+
+		assert.ErrorIs(t, err, auth.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,247 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/avatar"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	storagemocks "strikepad-backend/internal/storage/mocks"
+	"strikepad-backend/internal/testutil/factory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_SearchUsers(t *testing.T) {
+	tests := []struct {
+		repoErr       error
+		expectedErr   error
+		name          string
+		query         string
+		expectedQuery string
+		users         []model.User
+		limit         int
+		expectedLimit int
+	}{
+		{
+			name:          "returns matches without email addresses",
+			query:         "  jo ",
+			limit:         5,
+			expectedQuery: "jo",
+			expectedLimit: 6,
+			users: []model.User{
+				*factory.NewUser().WithID(2).WithPublicID("usr_2").WithDisplayName("John").
+					WithEmail("john@example.com").Build(),
+				*factory.NewUser().WithID(3).WithPublicID("usr_3").WithDisplayName("Joan").
+					WithAvatarURL("/uploads/avatars/usr_3.png?v=1").Build(),
+			},
+		},
+		{
+			name:          "more matches than the limit",
+			query:         "jo",
+			limit:         1,
+			expectedQuery: "jo",
+			expectedLimit: 2,
+			users: []model.User{
+				*factory.NewUser().WithID(2).WithPublicID("usr_2").WithDisplayName("John").Build(),
+				*factory.NewUser().WithID(3).WithPublicID("usr_3").WithDisplayName("Joan").Build(),
+			},
+		},
+		{
+			name:          "default limit",
+			query:         "jo",
+			expectedQuery: "jo",
+			expectedLimit: service.DefaultUserSearchLimit + 1,
+		},
+		{
+			name:          "limit is capped",
+			query:         "jo",
+			limit:         500,
+			expectedQuery: "jo",
+			expectedLimit: service.MaxUserSearchLimit + 1,
+		},
+		{
+			name:        "query too short",
+			query:       " j ",
+			expectedErr: service.ErrSearchQueryTooShort,
+		},
+		{
+			name:          "repository error",
+			query:         "jo",
+			expectedQuery: "jo",
+			expectedLimit: service.DefaultUserSearchLimit + 1,
+			repoErr:       assert.AnError,
+			expectedErr:   assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := mocks.NewMockUserRepository(t)
+			if tt.expectedQuery != "" {
+				userRepo.EXPECT().Search(repository.UserSearchParams{
+					Query:         tt.expectedQuery,
+					ExcludeUserID: 1,
+					Limit:         tt.expectedLimit,
+				}).Return(tt.users, tt.repoErr).Once()
+			}
+			userService := service.NewUserService(userRepo, nil)
+
+			response, err := userService.SearchUsers(1, tt.query, tt.limit)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, response)
+				return
+			}
+			require.NoError(t, err)
+			results := tt.users[:min(len(tt.users), tt.expectedLimit-1)]
+			assert.Equal(t, len(tt.users) > len(results), response.HasMore)
+			require.Len(t, response.Data, len(results))
+			for i, user := range results {
+				assert.Equal(t, dto.UserSearchResult{
+					ID: user.PublicID, DisplayName: user.DisplayName, AvatarURL: user.AvatarURL,
+				}, response.Data[i])
+			}
+		})
+	}
+}
+
+func TestUserService_PrivacySettings(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().HiddenFromSearch().Build(), nil).Once()
+
+		response, err := service.NewUserService(userRepo, nil).GetPrivacySettings(1)
+
+		require.NoError(t, err)
+		assert.False(t, response.Discoverable)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().Build(), nil).Once()
+		userRepo.EXPECT().Update(mock.MatchedBy(func(user *model.User) bool {
+			return user.HideFromSearch
+		})).Return(nil).Once()
+
+		discoverable := false
+		response, err := service.NewUserService(userRepo, nil).
+			UpdatePrivacySettings(1, &dto.PrivacySettingsRequest{Discoverable: &discoverable})
+
+		require.NoError(t, err)
+		assert.False(t, response.Discoverable)
+	})
+
+	t.Run("update fails", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().Build(), nil).Once()
+		userRepo.EXPECT().Update(mock.Anything).Return(assert.AnError).Once()
+
+		discoverable := true
+		response, err := service.NewUserService(userRepo, nil).
+			UpdatePrivacySettings(1, &dto.PrivacySettingsRequest{Discoverable: &discoverable})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, response)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().WithVersion(4).Build(), nil).Once()
+
+		discoverable, version := true, uint(3)
+		response, err := service.NewUserService(userRepo, nil).
+			UpdatePrivacySettings(1, &dto.PrivacySettingsRequest{Discoverable: &discoverable, Version: &version})
+
+		assert.ErrorIs(t, err, repository.ErrStaleVersion)
+		assert.Nil(t, response)
+	})
+}
+
+// avatarKey and avatarURL are where the avatar of users built by factory.NewUser is stored
+const (
+	avatarKey = "avatars/" + factory.UserPublicID + ".png"
+	avatarURL = "/uploads/" + avatarKey
+)
+
+func TestUserService_UploadAvatar(t *testing.T) {
+	var upload bytes.Buffer
+	require.NoError(t, png.Encode(&upload, image.NewGray(image.Rect(0, 0, 300, 200))))
+
+	t.Run("stores the processed image", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		store := storagemocks.NewMockStorage(t)
+		userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().Build(), nil).Once()
+		store.EXPECT().Put(mock.Anything, avatarKey, mock.Anything, avatar.ContentType).
+			RunAndReturn(func(_ context.Context, _ string, body []byte, _ string) error {
+				img, err := png.Decode(bytes.NewReader(body))
+				require.NoError(t, err)
+				assert.Equal(t, avatar.Size, img.Bounds().Dx())
+				return nil
+			}).Once()
+		store.EXPECT().URL(avatarKey).Return(avatarURL).Once()
+		userRepo.EXPECT().Update(mock.MatchedBy(func(user *model.User) bool {
+			return user.AvatarURL != nil && strings.HasPrefix(*user.AvatarURL, avatarURL+"?v=")
+		})).Return(nil).Once()
+
+		response, err := service.NewUserService(userRepo, store).UploadAvatar(context.Background(), 1, upload.Bytes())
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(response.AvatarURL, avatarURL+"?v="))
+	})
+
+	t.Run("rejects other files", func(t *testing.T) {
+		response, err := service.NewUserService(mocks.NewMockUserRepository(t), storagemocks.NewMockStorage(t)).
+			UploadAvatar(context.Background(), 1, []byte("%PDF-1.7"))
+
+		assert.ErrorIs(t, err, avatar.ErrUnsupportedImage)
+		assert.Nil(t, response)
+	})
+
+	t.Run("storage fails", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		store := storagemocks.NewMockStorage(t)
+		userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().Build(), nil).Once()
+		store.EXPECT().Put(mock.Anything, avatarKey, mock.Anything, avatar.ContentType).
+			Return(assert.AnError).Once()
+
+		response, err := service.NewUserService(userRepo, store).UploadAvatar(context.Background(), 1, upload.Bytes())
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, response)
+	})
+}
+
+func TestUserService_DeleteAvatar(t *testing.T) {
+	t.Run("deletes the avatar", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		store := storagemocks.NewMockStorage(t)
+		userRepo.EXPECT().GetByID(uint(1)).
+			Return(factory.NewUser().WithAvatarURL(avatarURL+"?v=1").Build(), nil).Once()
+		store.EXPECT().Delete(mock.Anything, avatarKey).Return(nil).Once()
+		userRepo.EXPECT().Update(mock.MatchedBy(func(user *model.User) bool {
+			return user.AvatarURL == nil
+		})).Return(nil).Once()
+
+		assert.NoError(t, service.NewUserService(userRepo, store).DeleteAvatar(context.Background(), 1))
+	})
+
+	t.Run("no avatar", func(t *testing.T) {
+		userRepo := mocks.NewMockUserRepository(t)
+		userRepo.EXPECT().GetByID(uint(1)).Return(factory.NewUser().Build(), nil).Once()
+
+		assert.NoError(t, service.NewUserService(userRepo, storagemocks.NewMockStorage(t)).
+			DeleteAvatar(context.Background(), 1))
+	})
+}
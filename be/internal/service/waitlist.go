@@ -0,0 +1,137 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/captcha"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+)
+
+// inviteCodeBytes is the amount of randomness in an invite code (16 base32 characters)
+const inviteCodeBytes = 10
+
+// WaitlistService handles the public waitlist and batch invitations
+type WaitlistService struct {
+	waitlistRepo repository.WaitlistRepositoryInterface
+	captcha      captcha.Verifier
+}
+
+// NewWaitlistService creates a new waitlist service
+func NewWaitlistService(
+	waitlistRepo repository.WaitlistRepositoryInterface,
+	captchaVerifier captcha.Verifier,
+) WaitlistServiceInterface {
+	return &WaitlistService{
+		waitlistRepo: waitlistRepo,
+		captcha:      captchaVerifier,
+	}
+}
+
+// Join adds an email address to the waitlist. Joining twice is not an error, so that
+// the endpoint does not reveal which addresses are already on the list.
+func (s *WaitlistService) Join(req *dto.WaitlistJoinRequest, remoteIP string) error {
+	if err := s.captcha.Verify(req.CaptchaToken, remoteIP); err != nil {
+		return fmt.Errorf("failed to verify captcha: %w", err)
+	}
+
+	email := auth.NormalizeEmail(req.Email)
+
+	_, err := s.waitlistRepo.FindByEmail(email)
+	if err == nil {
+		slog.Debug("Email already on waitlist", "email", email)
+		return nil
+	}
+	if !errors.Is(err, repository.ErrWaitlistEntryNotFound) {
+		return fmt.Errorf("failed to check waitlist: %w", err)
+	}
+
+	if err := s.waitlistRepo.Create(&model.WaitlistEntry{Email: email}); err != nil {
+		// A concurrent request may have inserted the same email after our lookup
+		if _, findErr := s.waitlistRepo.FindByEmail(email); findErr == nil {
+			return nil
+		}
+		return fmt.Errorf("failed to join waitlist: %w", err)
+	}
+
+	slog.Info("Email added to waitlist", "email", email)
+	return nil
+}
+
+// ListEntries returns a page of waitlist entries
+func (s *WaitlistService) ListEntries(params pagination.Params) (*dto.WaitlistListResponse, error) {
+	page, err := s.waitlistRepo.List(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist: %w", err)
+	}
+
+	entries := make([]dto.WaitlistEntryInfo, 0, len(page.Items))
+	for i := range page.Items {
+		entries = append(entries, toWaitlistEntryInfo(&page.Items[i]))
+	}
+
+	response := dto.WaitlistListResponse(dto.NewListResponse(entries, page.Meta(params)))
+	return &response, nil
+}
+
+// InviteBatch mints invite codes for the oldest pending entries, up to count of them
+func (s *WaitlistService) InviteBatch(count int) (*dto.WaitlistInviteResponse, error) {
+	entries, err := s.waitlistRepo.ListPending(count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending waitlist entries: %w", err)
+	}
+
+	now := time.Now()
+	for i := range entries {
+		code, err := generateInviteCode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate invite code: %w", err)
+		}
+		entries[i].InviteCode = &code
+		entries[i].InvitedAt = &now
+	}
+
+	if len(entries) > 0 {
+		if err := s.waitlistRepo.MarkInvited(entries); err != nil {
+			return nil, fmt.Errorf("failed to invite waitlist entries: %w", err)
+		}
+	}
+
+	response := &dto.WaitlistInviteResponse{
+		Invited: make([]dto.WaitlistEntryInfo, 0, len(entries)),
+	}
+	for i := range entries {
+		response.Invited = append(response.Invited, toWaitlistEntryInfo(&entries[i]))
+	}
+
+	slog.Info("Invited waitlist batch", "requested", count, "invited", len(entries))
+	return response, nil
+}
+
+// generateInviteCode returns a random, URL-safe invite code
+func generateInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf), nil
+}
+
+// toWaitlistEntryInfo converts a waitlist entry model to its response representation
+func toWaitlistEntryInfo(entry *model.WaitlistEntry) dto.WaitlistEntryInfo {
+	return dto.WaitlistEntryInfo{
+		ID:         entry.PublicID,
+		Email:      entry.Email,
+		InviteCode: entry.InviteCode,
+		InvitedAt:  entry.InvitedAt,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
@@ -0,0 +1,189 @@
+package service_test
+
+import (
+	"testing"
+
+	"strikepad-backend/internal/captcha"
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/pagination"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// stubCaptchaVerifier returns a fixed verification result
+type stubCaptchaVerifier struct {
+	err error
+}
+
+func (s stubCaptchaVerifier) Verify(_, _ string) error {
+	return s.err
+}
+
+type WaitlistServiceTestSuite struct {
+	suite.Suite
+	mockWaitlistRepo *mocks.MockWaitlistRepositoryInterface
+}
+
+func (suite *WaitlistServiceTestSuite) SetupTest() {
+	suite.mockWaitlistRepo = mocks.NewMockWaitlistRepositoryInterface(suite.T())
+}
+
+func (suite *WaitlistServiceTestSuite) TestJoin() {
+	testCases := []struct {
+		captchaErr  error
+		mockSetup   func()
+		expectedErr error
+		name        string
+		email       string
+		expectError bool
+	}{
+		{
+			name:  "New email is normalized and stored",
+			email: "  New@Example.com ",
+			mockSetup: func() {
+				suite.mockWaitlistRepo.EXPECT().FindByEmail("new@example.com").
+					Return(nil, repository.ErrWaitlistEntryNotFound).Once()
+				suite.mockWaitlistRepo.EXPECT().Create(mock.MatchedBy(func(e *model.WaitlistEntry) bool {
+					return e.Email == "new@example.com"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:  "Duplicate email is accepted without a new entry",
+			email: "existing@example.com",
+			mockSetup: func() {
+				suite.mockWaitlistRepo.EXPECT().FindByEmail("existing@example.com").
+					Return(&model.WaitlistEntry{ID: 1, Email: "existing@example.com"}, nil).Once()
+			},
+		},
+		{
+			name:  "Concurrent insert of the same email is accepted",
+			email: "race@example.com",
+			mockSetup: func() {
+				suite.mockWaitlistRepo.EXPECT().FindByEmail("race@example.com").
+					Return(nil, repository.ErrWaitlistEntryNotFound).Once()
+				suite.mockWaitlistRepo.EXPECT().Create(mock.Anything).Return(assert.AnError).Once()
+				suite.mockWaitlistRepo.EXPECT().FindByEmail("race@example.com").
+					Return(&model.WaitlistEntry{ID: 2, Email: "race@example.com"}, nil).Once()
+			},
+		},
+		{
+			name:        "Captcha failure",
+			email:       "bot@example.com",
+			captchaErr:  captcha.ErrVerificationFailed,
+			mockSetup:   func() {},
+			expectError: true,
+			expectedErr: captcha.ErrVerificationFailed,
+		},
+		{
+			name:  "Repository error",
+			email: "user@example.com",
+			mockSetup: func() {
+				suite.mockWaitlistRepo.EXPECT().FindByEmail("user@example.com").Return(nil, assert.AnError).Once()
+			},
+			expectError: true,
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+			waitlistService := service.NewWaitlistService(suite.mockWaitlistRepo, stubCaptchaVerifier{err: tc.captchaErr})
+
+			err := waitlistService.Join(&dto.WaitlistJoinRequest{Email: tc.email}, "203.0.113.1")
+
+			if tc.expectError {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (suite *WaitlistServiceTestSuite) TestInviteBatch() {
+	testCases := []struct {
+		mockSetup     func()
+		name          string
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name: "Mints unique codes for pending entries",
+			mockSetup: func() {
+				suite.mockWaitlistRepo.EXPECT().ListPending(2).Return([]model.WaitlistEntry{
+					{ID: 1, Email: "a@example.com"},
+					{ID: 2, Email: "b@example.com"},
+				}, nil).Once()
+				suite.mockWaitlistRepo.EXPECT().MarkInvited(mock.MatchedBy(func(entries []model.WaitlistEntry) bool {
+					return len(entries) == 2 &&
+						entries[0].InviteCode != nil && entries[0].InvitedAt != nil &&
+						*entries[0].InviteCode != *entries[1].InviteCode
+				})).Return(nil).Once()
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "No pending entries",
+			mockSetup: func() {
+				suite.mockWaitlistRepo.EXPECT().ListPending(2).Return([]model.WaitlistEntry{}, nil).Once()
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "Mark invited error",
+			mockSetup: func() {
+				suite.mockWaitlistRepo.EXPECT().ListPending(2).
+					Return([]model.WaitlistEntry{{ID: 1, Email: "a@example.com"}}, nil).Once()
+				suite.mockWaitlistRepo.EXPECT().MarkInvited(mock.Anything).Return(assert.AnError).Once()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+			waitlistService := service.NewWaitlistService(suite.mockWaitlistRepo, stubCaptchaVerifier{})
+
+			response, err := waitlistService.InviteBatch(2)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, response)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, response.Invited, tc.expectedCount)
+			for _, invited := range response.Invited {
+				assert.Len(t, *invited.InviteCode, 16)
+			}
+		})
+	}
+}
+
+func (suite *WaitlistServiceTestSuite) TestListEntries() {
+	params := pagination.Params{Page: 2, PerPage: 50}
+	suite.mockWaitlistRepo.EXPECT().List(params).Return(&pagination.Page[model.WaitlistEntry]{
+		Items:   []model.WaitlistEntry{{ID: 51, Email: "a@example.com"}},
+		HasMore: true,
+	}, nil).Once()
+	waitlistService := service.NewWaitlistService(suite.mockWaitlistRepo, stubCaptchaVerifier{})
+
+	response, err := waitlistService.ListEntries(params)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pagination.Meta{Page: 2, PerPage: 50, HasMore: true}, response.Meta)
+	assert.Equal(suite.T(), "a@example.com", response.Data[0].Email)
+}
+
+func TestWaitlistServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WaitlistServiceTestSuite))
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/webhook"
+)
+
+// webhookPayload is the outbox payload of a webhook delivery. The endpoint is looked up when the
+// delivery is made so that deleted endpoints stop receiving events.
+type webhookPayload struct {
+	EventID    string          `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	Body       json.RawMessage `json:"body"`
+	EndpointID uint            `json:"endpoint_id"`
+}
+
+// WebhookService manages webhook endpoints and queues event deliveries to them
+type WebhookService struct {
+	webhookRepo repository.WebhookRepositoryInterface
+	outboxRepo  repository.OutboxRepositoryInterface
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(
+	webhookRepo repository.WebhookRepositoryInterface,
+	outboxRepo repository.OutboxRepositoryInterface,
+) WebhookServiceInterface {
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		outboxRepo:  outboxRepo,
+	}
+}
+
+// CreateEndpoint registers a webhook endpoint. The returned signing secret is not shown again.
+func (s *WebhookService) CreateEndpoint(
+	createdBy *model.User, req *dto.CreateWebhookRequest,
+) (*dto.WebhookCreatedResponse, error) {
+	if err := webhook.ValidateURL(req.URL); err != nil {
+		return nil, err
+	}
+	for _, eventType := range req.EventTypes {
+		if eventType != events.All && !events.IsType(eventType) {
+			return nil, fmt.Errorf("%w: %s", events.ErrUnknownType, eventType)
+		}
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := &model.WebhookEndpoint{
+		URL:             req.URL,
+		Secret:          secret,
+		EventTypes:      strings.Join(req.EventTypes, ","),
+		CreatedByUserID: createdBy.ID,
+	}
+	if err := s.webhookRepo.Create(endpoint); err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	slog.Info("Webhook endpoint registered", "webhook_id", endpoint.PublicID, "created_by", createdBy.ID)
+	return &dto.WebhookCreatedResponse{
+		Secret:      secret,
+		WebhookInfo: toWebhookInfo(endpoint),
+	}, nil
+}
+
+// ListEndpoints returns all registered webhook endpoints
+func (s *WebhookService) ListEndpoints() (*dto.WebhookListResponse, error) {
+	endpoints, err := s.webhookRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+
+	webhooks := make([]dto.WebhookInfo, 0, len(endpoints))
+	for i := range endpoints {
+		webhooks = append(webhooks, toWebhookInfo(&endpoints[i]))
+	}
+
+	response := dto.WebhookListResponse(dto.NewCompleteListResponse(webhooks))
+	return &response, nil
+}
+
+// DeleteEndpoint removes the webhook endpoint with the public ID
+func (s *WebhookService) DeleteEndpoint(publicID string) error {
+	if err := s.webhookRepo.Delete(publicID); err != nil {
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+
+	slog.Info("Webhook endpoint deleted", "webhook_id", publicID)
+	return nil
+}
+
+// HandleEvent queues a delivery of event to every endpoint subscribed to its type. Subscribe it
+// to all events on the event bus.
+func (s *WebhookService) HandleEvent(_ context.Context, event events.Event) {
+	endpoints, err := s.webhookRepo.List()
+	if err != nil {
+		slog.Error("Failed to list webhook endpoints", "error", err, "event_id", event.ID)
+		return
+	}
+
+	var body []byte
+	for i := range endpoints {
+		endpoint := &endpoints[i]
+		if !endpoint.Subscribes(event.Type) {
+			continue
+		}
+		if body == nil {
+			if body, err = json.Marshal(event); err != nil {
+				slog.Error("Failed to encode event", "error", err, "event_id", event.ID)
+				return
+			}
+		}
+
+		message, err := newWebhookOutboxMessage(endpoint, event, body)
+		if err == nil {
+			err = s.outboxRepo.Enqueue(message)
+		}
+		if err != nil {
+			slog.Error("Failed to queue webhook delivery",
+				"error", err, "event_id", event.ID, "webhook_id", endpoint.PublicID)
+		}
+	}
+}
+
+// newWebhookOutboxMessage builds an outbox message that posts body, the encoded event, to endpoint
+func newWebhookOutboxMessage(
+	endpoint *model.WebhookEndpoint, event events.Event, body []byte,
+) (*model.OutboxMessage, error) {
+	payload, err := json.Marshal(webhookPayload{
+		EndpointID: endpoint.ID,
+		EventID:    event.ID,
+		EventType:  event.Type,
+		Body:       body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook delivery: %w", err)
+	}
+	return &model.OutboxMessage{
+		Kind:    model.OutboxKindWebhook,
+		Payload: string(payload),
+	}, nil
+}
+
+// toWebhookInfo converts a webhook endpoint model to the representation returned to administrators
+func toWebhookInfo(endpoint *model.WebhookEndpoint) dto.WebhookInfo {
+	return dto.WebhookInfo{
+		CreatedAt:  endpoint.CreatedAt,
+		ID:         endpoint.PublicID,
+		URL:        endpoint.URL,
+		EventTypes: endpoint.EventTypeList(),
+	}
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/dto"
+	"strikepad-backend/internal/events"
+	"strikepad-backend/internal/model"
+	"strikepad-backend/internal/repository"
+	"strikepad-backend/internal/repository/mocks"
+	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookService_CreateEndpoint(t *testing.T) {
+	createdBy := &model.User{ID: 1, PublicID: "usr_5k2d9x0q7m3hv8c1b4na"}
+
+	t.Run("registers the endpoint", func(t *testing.T) {
+		webhookRepo := mocks.NewMockWebhookRepositoryInterface(t)
+		var stored *model.WebhookEndpoint
+		webhookRepo.EXPECT().Create(mock.AnythingOfType("*model.WebhookEndpoint")).
+			Run(func(endpoint *model.WebhookEndpoint) {
+				endpoint.ID = 3
+				endpoint.PublicID = "whk_7d2k9m4q0x8h3v1c5b6n"
+				stored = endpoint
+			}).
+			Return(nil).Once()
+
+		webhookService := service.NewWebhookService(webhookRepo, nil)
+		response, err := webhookService.CreateEndpoint(createdBy, &dto.CreateWebhookRequest{
+			URL:        "https://hooks.example.com/strikepad",
+			EventTypes: []string{events.UserCreated, events.SessionRevoked},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "whk_7d2k9m4q0x8h3v1c5b6n", response.ID)
+		assert.Equal(t, []string{"user.created", "session.revoked"}, response.EventTypes)
+		assert.True(t, strings.HasPrefix(response.Secret, "whsec_"))
+		assert.Equal(t, response.Secret, stored.Secret)
+		assert.Equal(t, "user.created,session.revoked", stored.EventTypes)
+		assert.Equal(t, uint(1), stored.CreatedByUserID)
+	})
+
+	t.Run("rejects plain HTTP URLs", func(t *testing.T) {
+		webhookService := service.NewWebhookService(mocks.NewMockWebhookRepositoryInterface(t), nil)
+		_, err := webhookService.CreateEndpoint(createdBy, &dto.CreateWebhookRequest{
+			URL:        "http://hooks.example.com/strikepad",
+			EventTypes: []string{events.All},
+		})
+
+		assert.ErrorIs(t, err, webhook.ErrInvalidURL)
+	})
+
+	t.Run("rejects unknown event types", func(t *testing.T) {
+		webhookService := service.NewWebhookService(mocks.NewMockWebhookRepositoryInterface(t), nil)
+		_, err := webhookService.CreateEndpoint(createdBy, &dto.CreateWebhookRequest{
+			URL:        "https://hooks.example.com/strikepad",
+			EventTypes: []string{"user.renamed"},
+		})
+
+		assert.ErrorIs(t, err, events.ErrUnknownType)
+	})
+}
+
+func TestWebhookService_DeleteEndpoint(t *testing.T) {
+	webhookRepo := mocks.NewMockWebhookRepositoryInterface(t)
+	webhookRepo.EXPECT().Delete("whk_7d2k9m4q0x8h3v1c5b6n").Return(nil).Once()
+	webhookRepo.EXPECT().Delete("whk_0000000000000000000z").Return(repository.ErrWebhookNotFound).Once()
+
+	webhookService := service.NewWebhookService(webhookRepo, nil)
+
+	assert.NoError(t, webhookService.DeleteEndpoint("whk_7d2k9m4q0x8h3v1c5b6n"))
+	assert.ErrorIs(t, webhookService.DeleteEndpoint("whk_0000000000000000000z"), repository.ErrWebhookNotFound)
+}
+
+func TestWebhookService_HandleEvent(t *testing.T) {
+	webhookRepo := mocks.NewMockWebhookRepositoryInterface(t)
+	outboxRepo := mocks.NewMockOutboxRepositoryInterface(t)
+	webhookRepo.EXPECT().List().Return([]model.WebhookEndpoint{
+		{ID: 1, PublicID: "whk_7d2k9m4q0x8h3v1c5b6n", EventTypes: "user.created"},
+		{ID: 2, PublicID: "whk_0000000000000000000b", EventTypes: "session.revoked"},
+		{ID: 3, PublicID: "whk_0000000000000000000c", EventTypes: "*"},
+	}, nil).Once()
+
+	var queued []model.OutboxMessage
+	outboxRepo.EXPECT().Enqueue(mock.AnythingOfType("*model.OutboxMessage")).
+		Run(func(message *model.OutboxMessage) {
+			queued = append(queued, *message)
+		}).
+		Return(nil).Times(2)
+
+	event := events.New(events.UserCreated, events.UserData{ID: "usr_5k2d9x0q7m3hv8c1b4na"})
+	webhookService := service.NewWebhookService(webhookRepo, outboxRepo)
+	webhookService.HandleEvent(context.Background(), event)
+
+	require.Len(t, queued, 2)
+	var payload struct {
+		EventID    string          `json:"event_id"`
+		EventType  string          `json:"event_type"`
+		Body       json.RawMessage `json:"body"`
+		EndpointID uint            `json:"endpoint_id"`
+	}
+	for i, endpointID := range []uint{1, 3} {
+		assert.Equal(t, model.OutboxKindWebhook, queued[i].Kind)
+		require.NoError(t, json.Unmarshal([]byte(queued[i].Payload), &payload))
+		assert.Equal(t, endpointID, payload.EndpointID)
+		assert.Equal(t, event.ID, payload.EventID)
+		assert.Equal(t, events.UserCreated, payload.EventType)
+		assert.Contains(t, string(payload.Body), `"id":"usr_5k2d9x0q7m3hv8c1b4na"`)
+	}
+}
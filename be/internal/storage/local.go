@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage stores files in a directory on local disk. The files are served by the
+// application under the public URL.
+type LocalStorage struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalStorage creates a storage rooted at dir whose files are served under publicURL
+func NewLocalStorage(dir, publicURL string) *LocalStorage {
+	return &LocalStorage{
+		dir:       dir,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+// Path returns the path on disk of the file under key
+func (s *LocalStorage) Path(key string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// PublicURL returns the URL prefix the files are served under
+func (s *LocalStorage) PublicURL() string {
+	return s.publicURL
+}
+
+// Put writes body to a temporary file and renames it over the file under key, so readers never
+// see a partly written file
+func (s *LocalStorage) Put(_ context.Context, key string, body []byte, _ string) error {
+	target, err := s.Path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// Get reads the file under key
+func (s *LocalStorage) Get(_ context.Context, key string) ([]byte, error) {
+	target, err := s.Path(key)
+	if err != nil {
+		return nil, err
+	}
+	body, err := os.ReadFile(target)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return body, nil
+}
+
+// Delete removes the file under key
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	target, err := s.Path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+// URL returns the URL the application serves the file under key at
+func (s *LocalStorage) URL(key string) string {
+	return s.publicURL + "/" + key
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	store := NewLocalStorage(dir, "https://cdn.example.com/uploads/")
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "avatars/a.png", []byte("first"), "image/png"))
+	require.NoError(t, store.Put(ctx, "avatars/a.png", []byte("second"), "image/png"))
+
+	content, err := os.ReadFile(filepath.Join(dir, "avatars", "a.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(content))
+	entries, err := os.ReadDir(filepath.Join(dir, "avatars"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files are cleaned up")
+
+	assert.Equal(t, "https://cdn.example.com/uploads/avatars/a.png", store.URL("avatars/a.png"))
+	body, err := store.Get(ctx, "avatars/a.png")
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(body))
+
+	require.NoError(t, store.Delete(ctx, "avatars/a.png"))
+	_, err = os.Stat(filepath.Join(dir, "avatars", "a.png"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.NoError(t, store.Delete(ctx, "avatars/a.png"), "deleting a missing file succeeds")
+	_, err = store.Get(ctx, "avatars/a.png")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.ErrorIs(t, store.Put(ctx, "../escape.png", []byte("x"), "image/png"), ErrInvalidKey)
+	assert.ErrorIs(t, store.Delete(ctx, "../escape.png"), ErrInvalidKey)
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"strikepad-backend/internal/awssig"
+)
+
+// S3Config configures an S3Storage. Endpoint is the base URL of the S3-compatible API, such as
+// https://s3.us-east-1.amazonaws.com or a MinIO server; objects are addressed path-style. When
+// PublicURL is empty the objects are served from the endpoint.
+type S3Config struct {
+	Endpoint    string
+	Bucket      string
+	Region      string
+	PublicURL   string
+	Credentials awssig.Credentials
+}
+
+// S3Storage stores files as objects in an S3-compatible bucket
+type S3Storage struct {
+	httpClient  *http.Client
+	endpoint    string
+	bucket      string
+	region      string
+	publicURL   string
+	credentials awssig.Credentials
+}
+
+// NewS3Storage creates a storage for the bucket
+func NewS3Storage(cfg S3Config) *S3Storage {
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+	publicURL := strings.TrimSuffix(cfg.PublicURL, "/")
+	if publicURL == "" {
+		publicURL = endpoint + "/" + cfg.Bucket
+	}
+	return &S3Storage{
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+		endpoint:    endpoint,
+		bucket:      cfg.Bucket,
+		region:      cfg.Region,
+		publicURL:   publicURL,
+		credentials: cfg.Credentials,
+	}
+}
+
+// Put uploads body as the object under key
+func (s *S3Storage) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	_, err = s.do(req, body)
+	return err
+}
+
+// Get downloads the object under key
+func (s *S3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.do(req, nil)
+}
+
+// Delete removes the object under key. S3 reports success for missing objects too.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	_, err = s.do(req, nil)
+	return err
+}
+
+// URL returns the public URL of the object under key
+func (s *S3Storage) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+func (s *S3Storage) newRequest(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	objectURL := s.endpoint + "/" + url.PathEscape(s.bucket) + "/" + escapeKey(key)
+	req, err := http.NewRequestWithContext(ctx, method, objectURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	return req, nil
+}
+
+// do signs and sends req and returns the response body
+func (s *S3Storage) do(req *http.Request, body []byte) ([]byte, error) {
+	req.Header.Set("X-Amz-Content-Sha256", awssig.SHA256Hex(body))
+	awssig.Sign(req, body, s.region, "s3", s.credentials, time.Now())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && req.Method == http.MethodGet {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, req.URL.Path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("S3 returned status %d: %s", resp.StatusCode, message)
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read S3 response: %w", err)
+	}
+	return respBody, nil
+}
+
+// escapeKey escapes each segment of key, keeping the slashes between them
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"strikepad-backend/internal/awssig"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Storage(t *testing.T) {
+	type request struct {
+		method, path, contentType, contentSHA, authorization, body string
+	}
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{
+			method:        r.Method,
+			path:          r.URL.EscapedPath(),
+			contentType:   r.Header.Get("Content-Type"),
+			contentSHA:    r.Header.Get("X-Amz-Content-Sha256"),
+			authorization: r.Header.Get("Authorization"),
+			body:          string(body),
+		})
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if strings.Contains(r.URL.Path, "denied") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte("archive"))
+		}
+	}))
+	defer server.Close()
+
+	store := NewS3Storage(S3Config{
+		Endpoint:    server.URL + "/",
+		Bucket:      "uploads",
+		Region:      "eu-west-1",
+		Credentials: awssig.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"},
+	})
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "avatars/a b.png", []byte("image"), "image/png"))
+	require.NoError(t, store.Delete(ctx, "avatars/a b.png"))
+	err := store.Put(ctx, "denied.png", []byte("image"), "image/png")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.ErrorIs(t, store.Put(ctx, "../x.png", nil, ""), ErrInvalidKey)
+	body, err := store.Get(ctx, "private/exports/a.zip")
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(body))
+	_, err = store.Get(ctx, "private/exports/missing.zip")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.Len(t, requests, 5)
+	assert.Equal(t, http.MethodPut, requests[0].method)
+	assert.Equal(t, "/uploads/avatars/a%20b.png", requests[0].path)
+	assert.Equal(t, "image/png", requests[0].contentType)
+	assert.Equal(t, awssig.SHA256Hex([]byte("image")), requests[0].contentSHA)
+	assert.Equal(t, "image", requests[0].body)
+	assert.Contains(t, requests[0].authorization, "Credential=AKID/")
+	assert.Contains(t, requests[0].authorization, "/eu-west-1/s3/aws4_request")
+	assert.Contains(t, requests[0].authorization, "SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date")
+	assert.Equal(t, http.MethodDelete, requests[1].method)
+	assert.Equal(t, awssig.SHA256Hex(nil), requests[1].contentSHA)
+	assert.Equal(t, http.MethodGet, requests[3].method)
+	assert.Equal(t, "/uploads/private/exports/a.zip", requests[3].path)
+
+	assert.Equal(t, server.URL+"/uploads/avatars/a.png", store.URL("avatars/a.png"))
+	withPublicURL := NewS3Storage(S3Config{Endpoint: server.URL, Bucket: "uploads", PublicURL: "https://cdn.example.com/"})
+	assert.Equal(t, "https://cdn.example.com/avatars/a.png", withPublicURL.URL("avatars/a.png"))
+}
@@ -0,0 +1,103 @@
+// Package storage stores uploaded files, such as avatars, on local disk or in an S3-compatible
+// object store
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"strikepad-backend/internal/awssig"
+	"strikepad-backend/internal/config"
+)
+
+const (
+	// BackendLocal stores files on local disk and is the default, for development
+	BackendLocal = "local"
+	// BackendS3 stores files in an S3-compatible object store
+	BackendS3 = "s3"
+
+	// PrivatePrefix starts the keys of files that must not be served publicly, such as data
+	// exports. They are only read through Get; an object store must not make them public either.
+	PrivatePrefix = "private/"
+
+	defaultLocalDir       = "uploads"
+	defaultLocalPublicURL = "/uploads"
+)
+
+var (
+	// ErrInvalidKey is returned for keys that are empty, absolute or try to leave the storage root
+	ErrInvalidKey = errors.New("invalid storage key")
+	// ErrNotFound is returned by Get when there is no file under the key
+	ErrNotFound = errors.New("file not found")
+)
+
+// Storage stores files under slash-separated keys and gives out URLs to fetch them
+type Storage interface {
+	// Put stores body under key, replacing any existing file
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	// Get returns the content of the file under key
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the file under key. Deleting a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the public URL of the file under key. Files under PrivatePrefix have none.
+	URL(key string) string
+}
+
+// IsPrivate reports whether the file under key must not be served publicly
+func IsPrivate(key string) bool {
+	return strings.HasPrefix(key, PrivatePrefix)
+}
+
+// New returns the storage selected by STORAGE_BACKEND. When the S3 backend is selected but not
+// configured, files are stored on local disk instead.
+func New() Storage {
+	backend := strings.ToLower(config.GetEnv("STORAGE_BACKEND", BackendLocal))
+	switch backend {
+	case BackendLocal:
+		return newLocalStorageFromEnv()
+	case BackendS3:
+		bucket := config.GetEnv("S3_BUCKET", "")
+		if bucket == "" {
+			return unconfiguredBackend(backend, "S3_BUCKET is empty")
+		}
+		credentials := awssig.CredentialsFromEnv()
+		if credentials.Empty() {
+			return unconfiguredBackend(backend, "AWS credentials are empty")
+		}
+		region := config.GetEnv("S3_REGION", "us-east-1")
+		return NewS3Storage(S3Config{
+			Endpoint:    config.GetEnv("S3_ENDPOINT", "https://s3."+region+".amazonaws.com"),
+			Bucket:      bucket,
+			Region:      region,
+			PublicURL:   config.GetEnv("STORAGE_PUBLIC_URL", ""),
+			Credentials: credentials,
+		})
+	default:
+		return unconfiguredBackend(backend, "unknown backend")
+	}
+}
+
+func newLocalStorageFromEnv() *LocalStorage {
+	return NewLocalStorage(config.GetEnv("STORAGE_LOCAL_DIR", defaultLocalDir),
+		config.GetEnv("STORAGE_PUBLIC_URL", defaultLocalPublicURL))
+}
+
+// unconfiguredBackend falls back to local disk when the selected backend cannot be used
+func unconfiguredBackend(backend, reason string) Storage {
+	slog.Warn("Storage backend is not configured, files will be stored on local disk",
+		"backend", backend, "reason", reason)
+	return newLocalStorageFromEnv()
+}
+
+// cleanKey checks that key is a relative slash-separated path that stays inside the storage root
+func cleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) || path.Clean(key) != key ||
+		key == "." || key == ".." || strings.HasPrefix(key, "../") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	return key, nil
+}
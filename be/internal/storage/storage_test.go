@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		env    map[string]string
+		wantS3 bool
+	}{
+		{name: "default", env: map[string]string{}},
+		{name: "s3", env: map[string]string{
+			"STORAGE_BACKEND": "s3", "S3_BUCKET": "avatars",
+			"AWS_ACCESS_KEY_ID": "id", "AWS_SECRET_ACCESS_KEY": "secret",
+		}, wantS3: true},
+		{name: "s3 without bucket", env: map[string]string{
+			"STORAGE_BACKEND": "s3", "AWS_ACCESS_KEY_ID": "id", "AWS_SECRET_ACCESS_KEY": "secret",
+		}},
+		{name: "s3 without credentials", env: map[string]string{"STORAGE_BACKEND": "s3", "S3_BUCKET": "avatars"}},
+		{name: "unknown", env: map[string]string{"STORAGE_BACKEND": "ftp"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"STORAGE_BACKEND", "S3_BUCKET", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "STORAGE_PUBLIC_URL"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			store := New()
+
+			if tt.wantS3 {
+				s3, ok := store.(*S3Storage)
+				if assert.True(t, ok) {
+					assert.Equal(t, "https://s3.us-east-1.amazonaws.com/avatars/a/b.png", s3.URL("a/b.png"))
+				}
+				return
+			}
+			local, ok := store.(*LocalStorage)
+			if assert.True(t, ok) {
+				assert.Equal(t, "/uploads/a/b.png", local.URL("a/b.png"))
+			}
+		})
+	}
+}
+
+func TestCleanKey(t *testing.T) {
+	for _, key := range []string{"avatar.png", "avatars/abc.png", "a/b/c"} {
+		got, err := cleanKey(key)
+		assert.NoError(t, err, key)
+		assert.Equal(t, key, got)
+	}
+	for _, key := range []string{"", ".", "..", "../x", "/abs", "a/../b", "a//b", "a/", `a\b`} {
+		_, err := cleanKey(key)
+		assert.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+}
+
+func TestIsPrivate(t *testing.T) {
+	assert.True(t, IsPrivate("private/exports/exp_1.zip"))
+	assert.False(t, IsPrivate("avatars/usr_1.png"))
+	assert.False(t, IsPrivate("avatars/private/usr_1.png"))
+}
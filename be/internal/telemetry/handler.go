@@ -0,0 +1,98 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// Handler is a slog handler that applies a policy to the attributes of every record before
+// passing it to the handler of a sink
+type Handler struct {
+	next   slog.Handler
+	policy *Policy
+}
+
+// NewHandler returns a handler applying policy before next. A nil policy returns next unchanged.
+func NewHandler(next slog.Handler, policy *Policy) slog.Handler {
+	if policy == nil {
+		return next
+	}
+	return &Handler{next: next, policy: policy}
+}
+
+// Enabled reports whether the handler of the sink handles records at level
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle passes a copy of record with the policy applied to the handler of the sink
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	applied := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		applied.AddAttrs(h.policy.Apply(attr))
+		return true
+	})
+	return h.next.Handle(ctx, applied)
+}
+
+// WithAttrs returns a handler whose records carry attrs, with the policy applied
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	applied := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		applied = append(applied, h.policy.Apply(attr))
+	}
+	return &Handler{next: h.next.WithAttrs(applied), policy: h.policy}
+}
+
+// WithGroup returns a handler whose record attributes are nested in the group name
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{next: h.next.WithGroup(name), policy: h.policy}
+}
+
+// fanoutHandler passes every record to several sinks, each with its own handler
+type fanoutHandler []slog.Handler
+
+// NewFanoutHandler returns a handler passing every record to each of handlers
+func NewFanoutHandler(handlers ...slog.Handler) slog.Handler {
+	return fanoutHandler(handlers)
+}
+
+// Enabled reports whether any of the handlers handles records at level
+func (f fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range f {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handle passes record to each handler enabled at its level
+func (f fanoutHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for _, handler := range f {
+		if handler.Enabled(ctx, record.Level) {
+			errs = append(errs, handler.Handle(ctx, record.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WithAttrs returns a fanout handler whose handlers carry attrs
+func (f fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(fanoutHandler, 0, len(f))
+	for _, handler := range f {
+		handlers = append(handlers, handler.WithAttrs(attrs))
+	}
+	return handlers
+}
+
+// WithGroup returns a fanout handler whose handlers nest attributes in the group name
+func (f fanoutHandler) WithGroup(name string) slog.Handler {
+	handlers := make(fanoutHandler, 0, len(f))
+	for _, handler := range f {
+		handlers = append(handlers, handler.WithGroup(name))
+	}
+	return handlers
+}
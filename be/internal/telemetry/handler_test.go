@@ -0,0 +1,51 @@
+package telemetry_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"strikepad-backend/internal/telemetry"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	policy := telemetry.NewPolicy([]byte("salt"), "user_id")
+	var out bytes.Buffer
+	logger := slog.New(telemetry.NewHandler(slog.NewJSONHandler(&out, nil), policy))
+
+	logger.With("user_id", uint(7)).WithGroup("request").Info("Signed in", "user_id", uint(42), "path", "/api/me")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, policy.Hash("7"), entry["user_id"])
+	assert.Equal(t, map[string]any{"user_id": policy.Hash("42"), "path": "/api/me"}, entry["request"])
+	assert.NotContains(t, out.String(), `"user_id":42`)
+}
+
+func TestNewHandler_WithoutPolicy(t *testing.T) {
+	next := slog.NewTextHandler(&bytes.Buffer{}, nil)
+
+	assert.Same(t, next, telemetry.NewHandler(next, nil))
+}
+
+func TestFanoutHandler(t *testing.T) {
+	policy := telemetry.NewPolicy([]byte("salt"), "user_id")
+	var hashed, plain bytes.Buffer
+	logger := slog.New(telemetry.NewFanoutHandler(
+		telemetry.NewHandler(slog.NewTextHandler(&hashed, nil), policy),
+		slog.NewTextHandler(&plain, &slog.HandlerOptions{Level: slog.LevelWarn}),
+	))
+
+	logger.Info("Signed in", "user_id", 42)
+	logger.Warn("Signed out", "user_id", 42)
+
+	assert.Contains(t, hashed.String(), "Signed in")
+	assert.Contains(t, hashed.String(), "user_id="+policy.Hash("42"))
+	assert.NotContains(t, hashed.String(), "user_id=42")
+	assert.NotContains(t, plain.String(), "Signed in")
+	assert.Contains(t, plain.String(), "user_id=42")
+}
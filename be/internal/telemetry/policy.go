@@ -0,0 +1,107 @@
+// Package telemetry keeps user identifiers out of logs and other observability sinks by replacing
+// them with salted hashes before they leave the process. Hashes of the same value are equal, so
+// the events of one user can still be correlated.
+package telemetry
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+
+	"strikepad-backend/internal/config"
+)
+
+// Sinks whose hashing is configured by TELEMETRY_HASH_SINKS
+const (
+	SinkFile   = "file"
+	SinkStdout = "stdout"
+)
+
+// hashLength is the number of bytes of the HMAC kept in a hashed identifier
+const hashLength = 12
+
+// DefaultHashedKeys are the attribute keys whose values identify a user
+var DefaultHashedKeys = []string{"user_id", "email", "ip", "to", "created_by"}
+
+// Policy replaces the values of the attributes identifying a user with their salted hash
+type Policy struct {
+	keys map[string]bool
+	salt []byte
+}
+
+// NewPolicy returns a policy hashing the values of keys with salt
+func NewPolicy(salt []byte, keys ...string) *Policy {
+	policy := &Policy{salt: salt, keys: make(map[string]bool, len(keys))}
+	for _, key := range keys {
+		policy.keys[key] = true
+	}
+	return policy
+}
+
+// Policies holds the policy of each sink. Sinks without a policy get their values unchanged.
+type Policies map[string]*Policy
+
+// PoliciesFromEnv returns a policy with DefaultHashedKeys for each sink listed in
+// TELEMETRY_HASH_SINKS (comma-separated; all sinks in production and none elsewhere by default).
+// All sinks share the TELEMETRY_HASH_SALT secret, so their hashes can be correlated. Without a
+// salt a random one is used, whose hashes only match within the process.
+func PoliciesFromEnv() Policies {
+	defaultSinks := ""
+	if config.GetEnv("APP_ENV", "") == "production" {
+		defaultSinks = SinkFile + "," + SinkStdout
+	}
+
+	policies := make(Policies)
+	var salt []byte
+	for _, sink := range strings.Split(config.GetEnv("TELEMETRY_HASH_SINKS", defaultSinks), ",") {
+		sink = strings.TrimSpace(sink)
+		if sink == "" {
+			continue
+		}
+		if salt == nil {
+			salt = saltFromEnv()
+		}
+		policies[sink] = NewPolicy(salt, DefaultHashedKeys...)
+	}
+	return policies
+}
+
+// saltFromEnv returns TELEMETRY_HASH_SALT, or a random salt when it is not set
+func saltFromEnv() []byte {
+	if salt := config.GetEnv("TELEMETRY_HASH_SALT", ""); salt != "" {
+		return []byte(salt)
+	}
+
+	slog.Warn("TELEMETRY_HASH_SALT is not set; hashed identifiers will change on restart")
+	salt := make([]byte, sha256.Size)
+	_, _ = rand.Read(salt)
+	return salt
+}
+
+// Hash returns the salted hash of value
+func (p *Policy) Hash(value string) string {
+	mac := hmac.New(sha256.New, p.salt)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:hashLength])
+}
+
+// Apply returns attr with the values of the keys of the policy hashed, including those nested in groups
+func (p *Policy) Apply(attr slog.Attr) slog.Attr {
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() == slog.KindGroup {
+		group := attr.Value.Group()
+		applied := make([]slog.Attr, 0, len(group))
+		for _, member := range group {
+			applied = append(applied, p.Apply(member))
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(applied...)}
+	}
+
+	if !p.keys[attr.Key] || attr.Value.String() == "" {
+		return attr
+	}
+	return slog.String(attr.Key, p.Hash(attr.Value.String()))
+}
@@ -0,0 +1,62 @@
+package telemetry_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"strikepad-backend/internal/telemetry"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Hash(t *testing.T) {
+	policy := telemetry.NewPolicy([]byte("salt"), "user_id")
+
+	assert.Equal(t, policy.Hash("42"), policy.Hash("42"))
+	assert.NotEqual(t, policy.Hash("42"), policy.Hash("43"))
+	assert.NotEqual(t, policy.Hash("42"), telemetry.NewPolicy([]byte("other"), "user_id").Hash("42"))
+	assert.Len(t, policy.Hash("42"), 24)
+}
+
+func TestPolicy_Apply(t *testing.T) {
+	policy := telemetry.NewPolicy([]byte("salt"), "user_id", "email")
+
+	assert.Equal(t, slog.String("user_id", policy.Hash("42")), policy.Apply(slog.Uint64("user_id", 42)))
+	assert.Equal(t, slog.String("path", "/api/me"), policy.Apply(slog.String("path", "/api/me")))
+	assert.Equal(t, slog.String("email", ""), policy.Apply(slog.String("email", "")))
+
+	group := policy.Apply(slog.Group("request", slog.String("email", "a@example.com"), slog.Int("status", 200)))
+	assert.Equal(t, slog.Group("request", slog.String("email", policy.Hash("a@example.com")), slog.Int("status", 200)), group)
+}
+
+func TestPoliciesFromEnv(t *testing.T) {
+	t.Run("none outside production", func(t *testing.T) {
+		t.Setenv("APP_ENV", "development")
+		t.Setenv("TELEMETRY_HASH_SINKS", "")
+
+		assert.Empty(t, telemetry.PoliciesFromEnv())
+	})
+
+	t.Run("all sinks in production", func(t *testing.T) {
+		t.Setenv("APP_ENV", "production")
+		t.Setenv("TELEMETRY_HASH_SINKS", "")
+		t.Setenv("TELEMETRY_HASH_SALT", "secret")
+
+		policies := telemetry.PoliciesFromEnv()
+		require.Contains(t, policies, telemetry.SinkFile)
+		require.Contains(t, policies, telemetry.SinkStdout)
+		assert.Equal(t, policies[telemetry.SinkFile].Hash("42"), policies[telemetry.SinkStdout].Hash("42"))
+		assert.Equal(t, telemetry.NewPolicy([]byte("secret")).Hash("42"), policies[telemetry.SinkFile].Hash("42"))
+	})
+
+	t.Run("configured sinks", func(t *testing.T) {
+		t.Setenv("APP_ENV", "development")
+		t.Setenv("TELEMETRY_HASH_SINKS", " file ")
+		t.Setenv("TELEMETRY_HASH_SALT", "")
+
+		policies := telemetry.PoliciesFromEnv()
+		assert.Contains(t, policies, telemetry.SinkFile)
+		assert.NotContains(t, policies, telemetry.SinkStdout)
+	})
+}
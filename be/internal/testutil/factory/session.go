@@ -0,0 +1,120 @@
+package factory
+
+import (
+	"time"
+
+	"strikepad-backend/internal/auth"
+	"strikepad-backend/internal/model"
+)
+
+const (
+	// SessionID is the database ID of sessions built by NewSession
+	SessionID uint = 1
+	// SessionPublicID is the public ID of sessions built by NewSession
+	SessionPublicID = "ses_8h2m4q0x9d7c3v1k5b6n"
+	// AccessToken is the access token whose hash sessions built by NewSession store
+	AccessToken = "access-token"
+	// RefreshToken is the refresh token whose hash sessions built by NewSession store
+	RefreshToken = "refresh-token"
+)
+
+// SessionBuilder builds a model.UserSession
+type SessionBuilder struct {
+	session model.UserSession
+}
+
+// NewSession starts a session of the user with ID UserID that was created now, with an access
+// token valid for an hour and a refresh token valid for a week
+func NewSession() *SessionBuilder {
+	now := time.Now()
+	return &SessionBuilder{session: model.UserSession{
+		ID:                    SessionID,
+		PublicID:              SessionPublicID,
+		UserID:                UserID,
+		AccessTokenHash:       auth.HashToken(AccessToken),
+		RefreshTokenHash:      auth.HashToken(RefreshToken),
+		AccessTokenExpiresAt:  now.Add(time.Hour),
+		RefreshTokenExpiresAt: now.Add(7 * 24 * time.Hour),
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}}
+}
+
+// WithID sets the database ID
+func (b *SessionBuilder) WithID(id uint) *SessionBuilder {
+	b.session.ID = id
+	return b
+}
+
+// WithPublicID sets the public ID
+func (b *SessionBuilder) WithPublicID(publicID string) *SessionBuilder {
+	b.session.PublicID = publicID
+	return b
+}
+
+// WithUserID sets the ID of the session's user without loading the user
+func (b *SessionBuilder) WithUserID(userID uint) *SessionBuilder {
+	b.session.UserID = userID
+	return b
+}
+
+// ForUser makes the session belong to user, as if it was loaded with its user
+func (b *SessionBuilder) ForUser(user *model.User) *SessionBuilder {
+	b.session.UserID = user.ID
+	b.session.User = *user
+	return b
+}
+
+// WithTokens sets the hashes of the access and refresh tokens
+func (b *SessionBuilder) WithTokens(accessToken, refreshToken string) *SessionBuilder {
+	b.session.AccessTokenHash = auth.HashToken(accessToken)
+	b.session.RefreshTokenHash = auth.HashToken(refreshToken)
+	return b
+}
+
+// WithTokenPair sets the token hashes and expiry times from a generated token pair
+func (b *SessionBuilder) WithTokenPair(tokenPair *auth.TokenPair) *SessionBuilder {
+	b.session.AccessTokenHash = auth.HashToken(tokenPair.AccessToken)
+	b.session.RefreshTokenHash = auth.HashToken(tokenPair.RefreshToken)
+	b.session.AccessTokenExpiresAt = tokenPair.AccessTokenExpiresAt
+	b.session.RefreshTokenExpiresAt = tokenPair.RefreshTokenExpiresAt
+	return b
+}
+
+// WithDevice sets the client the session was created from
+func (b *SessionBuilder) WithDevice(ipAddress, userAgent string) *SessionBuilder {
+	b.session.IPAddress = ipAddress
+	b.session.UserAgent = userAgent
+	return b
+}
+
+// CreatedAt sets when the session was created and last updated
+func (b *SessionBuilder) CreatedAt(t time.Time) *SessionBuilder {
+	b.session.CreatedAt = t
+	b.session.UpdatedAt = t
+	return b
+}
+
+// AccessExpired makes the access token expired an hour ago
+func (b *SessionBuilder) AccessExpired() *SessionBuilder {
+	b.session.AccessTokenExpiresAt = time.Now().Add(-time.Hour)
+	return b
+}
+
+// RefreshExpired makes both tokens expired an hour ago
+func (b *SessionBuilder) RefreshExpired() *SessionBuilder {
+	b.session.RefreshTokenExpiresAt = time.Now().Add(-time.Hour)
+	return b.AccessExpired()
+}
+
+// Invalidated marks the session as logged out
+func (b *SessionBuilder) Invalidated() *SessionBuilder {
+	b.session.Invalidate()
+	return b
+}
+
+// Build returns a new session with the configured fields. The builder can be reused afterwards.
+func (b *SessionBuilder) Build() *model.UserSession {
+	session := b.session
+	return &session
+}
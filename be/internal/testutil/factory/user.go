@@ -0,0 +1,182 @@
+// Package factory builds models with sensible defaults for tests, so that a test only spells out
+// the fields it cares about:
+//
+//	user := factory.NewUser().WithGoogle().Deleted().Build()
+package factory
+
+import (
+	"time"
+
+	"strikepad-backend/internal/model"
+)
+
+const (
+	// UserID is the database ID of users built by NewUser
+	UserID uint = 1
+	// UserPublicID is the public ID of users built by NewUser
+	UserPublicID = "usr_5k2d9x0q7m3hv8c1b4na"
+	// UserEmail is the email of users built by NewUser
+	UserEmail = "test@example.com"
+	// GoogleUserID is the Google account ID set by WithGoogle
+	GoogleUserID = "google-123"
+)
+
+// UserBuilder builds a model.User
+type UserBuilder struct {
+	user model.User
+}
+
+// NewUser starts an active, unverified email/password user with ID UserID
+func NewUser() *UserBuilder {
+	email := UserEmail
+	return &UserBuilder{user: model.User{
+		ID:           UserID,
+		PublicID:     UserPublicID,
+		ProviderType: "email",
+		Email:        &email,
+		DisplayName:  "Test User",
+		Role:         model.RoleUser,
+	}}
+}
+
+// WithID sets the database ID
+func (b *UserBuilder) WithID(id uint) *UserBuilder {
+	b.user.ID = id
+	return b
+}
+
+// WithPublicID sets the public ID
+func (b *UserBuilder) WithPublicID(publicID string) *UserBuilder {
+	b.user.PublicID = publicID
+	return b
+}
+
+// Unsaved clears the database and public IDs, as of a user that was not created yet
+func (b *UserBuilder) Unsaved() *UserBuilder {
+	b.user.ID = 0
+	b.user.PublicID = ""
+	return b
+}
+
+// WithEmail sets the email address
+func (b *UserBuilder) WithEmail(email string) *UserBuilder {
+	b.user.Email = &email
+	return b
+}
+
+// WithoutEmail clears the email address
+func (b *UserBuilder) WithoutEmail() *UserBuilder {
+	b.user.Email = nil
+	return b
+}
+
+// WithEmailChangedAt records a change of the email address at t
+func (b *UserBuilder) WithEmailChangedAt(t time.Time) *UserBuilder {
+	b.user.EmailChangedAt = &t
+	return b
+}
+
+// WithPendingEmail starts a change of the email address to email, whose links carry nonce and
+// expire at expiresAt
+func (b *UserBuilder) WithPendingEmail(email, nonce string, expiresAt time.Time) *UserBuilder {
+	b.user.PendingEmail = &email
+	b.user.PendingEmailNonce = &nonce
+	b.user.PendingEmailExpiresAt = &expiresAt
+	return b
+}
+
+// WithDisplayName sets the display name
+func (b *UserBuilder) WithDisplayName(name string) *UserBuilder {
+	b.user.DisplayName = name
+	return b
+}
+
+// WithAvatarURL sets the URL of the avatar
+func (b *UserBuilder) WithAvatarURL(url string) *UserBuilder {
+	b.user.AvatarURL = &url
+	return b
+}
+
+// WithVersion sets the version the user was loaded at
+func (b *UserBuilder) WithVersion(version uint) *UserBuilder {
+	b.user.Version = version
+	return b
+}
+
+// CreatedAt sets when the user signed up
+func (b *UserBuilder) CreatedAt(t time.Time) *UserBuilder {
+	b.user.CreatedAt = t
+	b.user.UpdatedAt = t
+	return b
+}
+
+// WithPasswordHash sets the stored password hash
+func (b *UserBuilder) WithPasswordHash(hash string) *UserBuilder {
+	b.user.PasswordHash = &hash
+	return b
+}
+
+// WithPasswordChangedAt records a password change at t
+func (b *UserBuilder) WithPasswordChangedAt(t time.Time) *UserBuilder {
+	b.user.PasswordChangedAt = &t
+	return b
+}
+
+// WithProvider makes the user sign in with an OAuth provider account instead of a password
+func (b *UserBuilder) WithProvider(provider, providerUserID string) *UserBuilder {
+	b.user.ProviderType = provider
+	b.user.ProviderUserID = &providerUserID
+	b.user.PasswordHash = nil
+	return b
+}
+
+// WithGoogle makes the user a verified Google user with the account GoogleUserID
+func (b *UserBuilder) WithGoogle() *UserBuilder {
+	b.user.EmailVerified = true
+	return b.WithProvider("google", GoogleUserID)
+}
+
+// Verified marks the email address as verified
+func (b *UserBuilder) Verified() *UserBuilder {
+	b.user.EmailVerified = true
+	return b
+}
+
+// Admin gives the user the admin role
+func (b *UserBuilder) Admin() *UserBuilder {
+	b.user.Role = model.RoleAdmin
+	return b
+}
+
+// Disabled marks the user as disabled by an admin
+func (b *UserBuilder) Disabled() *UserBuilder {
+	b.user.IsDisabled = true
+	return b
+}
+
+// Deleted marks the user as deleted
+func (b *UserBuilder) Deleted() *UserBuilder {
+	deletedAt := time.Now()
+	b.user.IsDeleted = true
+	b.user.DeletedAt = &deletedAt
+	return b
+}
+
+// HiddenFromSearch leaves the user out of the user search
+func (b *UserBuilder) HiddenFromSearch() *UserBuilder {
+	b.user.HideFromSearch = true
+	return b
+}
+
+// Purged marks the user as deleted and their personal data as erased at t
+func (b *UserBuilder) Purged(t time.Time) *UserBuilder {
+	b.Deleted()
+	b.user.PurgedAt = &t
+	return b
+}
+
+// Build returns a new user with the configured fields. The builder can be reused afterwards.
+func (b *UserBuilder) Build() *model.User {
+	user := b.user
+	return &user
+}
@@ -0,0 +1,46 @@
+// Package golden compares test output against files checked in under testdata. Run the tests with
+// -update to rewrite the files after an intended change:
+//
+//	go test ./internal/handler -run Golden -update
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the actual output")
+
+// Path returns the path of the golden file with the name
+func Path(name string) string {
+	return filepath.Join("testdata", "golden", name+".golden.json")
+}
+
+// AssertJSON fails the test when the JSON document differs from the golden file with the name.
+// Both are compared indented, so that a failure shows a readable diff.
+func AssertJSON(t testing.TB, name string, actual []byte) {
+	t.Helper()
+
+	var indented bytes.Buffer
+	require.NoError(t, json.Indent(&indented, actual, "", "  "), "response is not valid JSON: %s", actual)
+	indented.WriteByte('\n')
+
+	path := Path(name)
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, indented.Bytes(), 0o644))
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err, "missing golden file, run the test with -update to create it")
+	assert.Equal(t, string(expected), indented.String(),
+		"response differs from %s, run the test with -update if the change is intended", path)
+}
@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"regexp"
+	"time"
+	// Embed the time zone database, so that timezone does not depend on the zoneinfo of the host
+	_ "time/tzdata"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Tags of the custom rules
+const (
+	UsernameTag       = "username"
+	SlugTag           = "slug"
+	TimezoneTag       = "timezone"
+	E164Tag           = "e164"
+	NoControlCharsTag = "no_control_chars"
+)
+
+var (
+	// usernamePattern matches 3 to 30 lowercase letters, digits and underscores, starting with a letter
+	usernamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{2,29}$`)
+	// slugPattern matches lowercase letters and digits in words joined by single hyphens
+	slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	// e164Pattern matches a phone number in E.164 format: a plus and up to 15 digits
+	e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+)
+
+// customRules are the rules registered by New in addition to those of go-playground/validator.
+// timezone and e164 replace the built-in rules of the same name.
+var customRules = map[string]validator.Func{
+	UsernameTag:       matches(usernamePattern),
+	SlugTag:           matches(slugPattern),
+	TimezoneTag:       validateTimezone,
+	E164Tag:           matches(e164Pattern),
+	NoControlCharsTag: validateNoControlChars,
+}
+
+// registerCustomRules registers customRules with v
+func registerCustomRules(v *validator.Validate) {
+	for tag, rule := range customRules {
+		if err := v.RegisterValidation(tag, rule); err != nil {
+			panic("Failed to register " + tag + " validation: " + err.Error())
+		}
+	}
+}
+
+// matches returns a rule accepting strings matching pattern
+func matches(pattern *regexp.Regexp) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return pattern.MatchString(fl.Field().String())
+	}
+}
+
+// validateTimezone accepts IANA time zone names such as Asia/Tokyo or UTC. Unlike the built-in
+// rule it rejects Local, which names the zone of the server rather than one of the user.
+func validateTimezone(fl validator.FieldLevel) bool {
+	name := fl.Field().String()
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// validateNoControlChars rejects strings containing control characters, including newlines and
+// tabs, for single-line text such as titles and names
+func validateNoControlChars(fl validator.FieldLevel) bool {
+	for _, r := range fl.Field().String() {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
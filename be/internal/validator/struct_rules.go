@@ -0,0 +1,28 @@
+package validator
+
+import (
+	"strings"
+
+	"strikepad-backend/internal/dto"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// registerStructRules registers the rules spanning several fields of a request. They run after
+// the field rules of the struct, wherever it is nested, and report their errors on one field.
+func registerStructRules(v *validator.Validate) {
+	v.RegisterStructValidation(validateSignupRequest, dto.SignupRequest{})
+}
+
+// validateSignupRequest rejects a password equal to the email address, which is the first guess
+// of anyone who knows the address
+func validateSignupRequest(sl validator.StructLevel) {
+	req, ok := sl.Current().Interface().(dto.SignupRequest)
+	if !ok || req.Password == "" {
+		return
+	}
+
+	if strings.EqualFold(req.Password, req.Email) {
+		sl.ReportError(req.Password, "password", "Password", "nefield", "email")
+	}
+}
@@ -59,6 +59,9 @@ func New() *Validator {
 		panic("Failed to register password_complex validation: " + err.Error())
 	}
 
+	registerCustomRules(v)
+	registerStructRules(v)
+
 	return &Validator{
 		validator: v,
 	}
@@ -103,7 +106,7 @@ func (v *Validator) Validate(s interface{}) error {
 
 	for _, err := range validationErrs {
 		ve := ValidationError{
-			Field:   err.Field(),
+			Field:   fieldPath(err),
 			Tag:     err.Tag(),
 			Value:   fmt.Sprintf("%v", err.Value()),
 			Message: getErrorMessage(err),
@@ -114,9 +117,19 @@ func (v *Validator) Validate(s interface{}) error {
 	return ValidationErrors{Errors: validationErrors}
 }
 
+// fieldPath returns the path of the field of fe from the validated struct, using JSON names, e.g.
+// items[2].name for the name of the third element of items
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+	return namespace
+}
+
 // getErrorMessage returns a human-readable error message for validation errors
 func getErrorMessage(fe validator.FieldError) string {
-	field := fe.Field()
+	field := fieldPath(fe)
 	tag := fe.Tag()
 
 	if msg := getBasicValidationMessage(field, tag); msg != "" {
@@ -141,7 +154,7 @@ func getErrorMessage(fe validator.FieldError) string {
 // getBasicValidationMessage handles basic validation messages
 func getBasicValidationMessage(field, tag string) string {
 	switch tag {
-	case RequiredTag:
+	case RequiredTag, "required_without":
 		return fmt.Sprintf("%s is required", field)
 	case EmailTag:
 		return fmt.Sprintf("%s must be a valid email address", field)
@@ -193,6 +206,10 @@ func getFormatValidationMessage(field, tag, param string) string {
 	switch tag {
 	case "oneof":
 		return fmt.Sprintf("%s must be one of: %s", field, param)
+	case "nefield", "necsfield":
+		return fmt.Sprintf("%s must not be the same as %s", field, param)
+	case "eqfield", "eqcsfield":
+		return fmt.Sprintf("%s must match %s", field, param)
 	case "alpha":
 		return fmt.Sprintf("%s must contain only alphabetic characters", field)
 	case "alphanum":
@@ -203,6 +220,17 @@ func getFormatValidationMessage(field, tag, param string) string {
 		return fmt.Sprintf("%s must be a valid URL", field)
 	case "uri":
 		return fmt.Sprintf("%s must be a valid URI", field)
+	case UsernameTag:
+		return fmt.Sprintf(
+			"%s must be 3 to 30 lowercase letters, digits or underscores, starting with a letter", field)
+	case SlugTag:
+		return fmt.Sprintf("%s must contain only lowercase letters, digits and single hyphens between them", field)
+	case TimezoneTag:
+		return fmt.Sprintf("%s must be an IANA time zone, such as Asia/Tokyo", field)
+	case E164Tag:
+		return fmt.Sprintf("%s must be a phone number in E.164 format, such as +81312345678", field)
+	case NoControlCharsTag:
+		return fmt.Sprintf("%s must not contain control characters", field)
 	default:
 		return ""
 	}
@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"strikepad-backend/internal/dto"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -35,6 +37,21 @@ type TestProduct struct {
 	Price       int    `json:"price" validate:"required,gt=0"`
 }
 
+type TestOrderItem struct {
+	Name     string `json:"name" validate:"required"`
+	Quantity int    `json:"quantity" validate:"gt=0"`
+}
+
+type TestAddress struct {
+	Country string `json:"country" validate:"required,len=2"`
+}
+
+type TestOrder struct {
+	Address *TestAddress    `json:"address" validate:"required"`
+	Items   []TestOrderItem `json:"items" validate:"required,min=1,dive"`
+	Tags    []string        `json:"tags" validate:"dive,max=5"`
+}
+
 func (suite *ValidatorTestSuite) TestValidatePasswordComplexity() {
 	testCases := []struct {
 		name     string
@@ -436,6 +453,142 @@ func (suite *ValidatorTestSuite) TestValidationErrorsAndFieldNames() {
 	}
 }
 
+func (suite *ValidatorTestSuite) TestValidateNestedStructs() {
+	testCases := []struct {
+		order    TestOrder
+		name     string
+		expected []ValidationError
+	}{
+		{
+			name: "valid order",
+			order: TestOrder{
+				Address: &TestAddress{Country: "JP"},
+				Items:   []TestOrderItem{{Name: "pad", Quantity: 1}},
+			},
+		},
+		{
+			name: "invalid elements and nested struct",
+			order: TestOrder{
+				Address: &TestAddress{Country: "JPN"},
+				Items:   []TestOrderItem{{Name: "pad", Quantity: 1}, {Name: "pen", Quantity: 1}, {Quantity: 0}},
+				Tags:    []string{"ok", "too long"},
+			},
+			expected: []ValidationError{
+				{
+					Field: "address.country", Tag: "len", Value: "JPN",
+					Message: "address.country must be exactly 2 characters long",
+				},
+				{Field: "items[2].name", Tag: RequiredTag, Value: "", Message: "items[2].name is required"},
+				{Field: "items[2].quantity", Tag: "gt", Value: "0", Message: "items[2].quantity must be greater than 0"},
+				{Field: "tags[1]", Tag: "max", Value: "too long", Message: "tags[1] must be at most 5 characters long"},
+			},
+		},
+		{
+			name:  "missing nested struct",
+			order: TestOrder{Items: []TestOrderItem{{Name: "pad", Quantity: 1}}},
+			expected: []ValidationError{
+				{Field: "address", Tag: RequiredTag, Value: "<nil>", Message: "address is required"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			err := suite.validator.Validate(&tc.order)
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Equal(t, ValidationErrors{Errors: tc.expected}, err)
+		})
+	}
+}
+
+func (suite *ValidatorTestSuite) TestValidateStructRules() {
+	testCases := []struct {
+		name     string
+		email    string
+		password string
+		valid    bool
+	}{
+		{name: "password differs from email", email: "user@example.com", password: "Password123!", valid: true},
+		{name: "password equals email", email: "User@Example.com", password: "User@Example.com"},
+		{name: "password equals email in another case", email: "user@example.com", password: "User@Example.com"},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			err := suite.validator.Validate(&dto.SignupRequest{
+				Email:       tc.email,
+				Password:    tc.password,
+				DisplayName: "User",
+			})
+			if tc.valid {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Equal(t, ValidationErrors{Errors: []ValidationError{{
+				Field:   testPasswordFieldConst,
+				Tag:     "nefield",
+				Value:   tc.password,
+				Message: "password must not be the same as email",
+			}}}, err)
+		})
+	}
+}
+
+type TestProfile struct {
+	Username string `json:"username" validate:"omitempty,username"`
+	Slug     string `json:"slug" validate:"omitempty,slug"`
+	Timezone string `json:"timezone" validate:"omitempty,timezone"`
+	Phone    string `json:"phone" validate:"omitempty,e164"`
+	Title    string `json:"title" validate:"no_control_chars"`
+}
+
+func (suite *ValidatorTestSuite) TestValidateCustomRules() {
+	testCases := []struct {
+		name    string
+		profile TestProfile
+		field   string
+		tag     string
+	}{
+		{name: "valid profile", profile: TestProfile{
+			Username: "jane_doe", Slug: "my-first-pad", Timezone: "Asia/Tokyo", Phone: "+81312345678", Title: "Notes",
+		}},
+		{name: "UTC", profile: TestProfile{Timezone: "UTC"}},
+		{name: "username with upper case", profile: TestProfile{Username: "Jane"}, field: "username", tag: UsernameTag},
+		{name: "username starting with a digit", profile: TestProfile{Username: "1jane"}, field: "username", tag: UsernameTag},
+		{name: "short username", profile: TestProfile{Username: "jd"}, field: "username", tag: UsernameTag},
+		{name: "slug with double hyphen", profile: TestProfile{Slug: "my--pad"}, field: "slug", tag: SlugTag},
+		{name: "slug with trailing hyphen", profile: TestProfile{Slug: "pad-"}, field: "slug", tag: SlugTag},
+		{name: "unknown timezone", profile: TestProfile{Timezone: "Mars/Olympus"}, field: "timezone", tag: TimezoneTag},
+		{name: "local timezone", profile: TestProfile{Timezone: "Local"}, field: "timezone", tag: TimezoneTag},
+		{name: "phone without plus", profile: TestProfile{Phone: "0312345678"}, field: "phone", tag: E164Tag},
+		{name: "phone too long", profile: TestProfile{Phone: "+1234567890123456"}, field: "phone", tag: E164Tag},
+		{name: "title with newline", profile: TestProfile{Title: "a\nb"}, field: "title", tag: NoControlCharsTag},
+		{name: "title with NUL", profile: TestProfile{Title: "a\x00b"}, field: "title", tag: NoControlCharsTag},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			err := suite.validator.Validate(&tc.profile)
+			if tc.tag == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			ve, ok := err.(ValidationErrors)
+			if assert.True(t, ok) && assert.Len(t, ve.Errors, 1) {
+				assert.Equal(t, tc.field, ve.Errors[0].Field)
+				assert.Equal(t, tc.tag, ve.Errors[0].Tag)
+				assert.NotEqual(t, tc.field+" is invalid", ve.Errors[0].Message)
+			}
+		})
+	}
+}
+
 func TestValidatorTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidatorTestSuite))
 }
@@ -0,0 +1,153 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"strikepad-backend/internal/config"
+)
+
+// Headers sent with every delivery
+const (
+	HeaderEvent     = "X-StrikePad-Event"
+	HeaderDelivery  = "X-StrikePad-Delivery"
+	HeaderSignature = "X-StrikePad-Signature"
+)
+
+// secretPrefix marks webhook signing secrets so they are recognizable when leaked
+const secretPrefix = "whsec_"
+
+var (
+	// ErrInvalidURL is returned for endpoint URLs that are not absolute HTTPS URLs
+	ErrInvalidURL = errors.New("webhook URL must be an absolute https URL")
+	// ErrPrivateNetwork is returned when an endpoint resolves to a loopback, private or link-local address
+	ErrPrivateNetwork = errors.New("webhook endpoint resolves to a private network address")
+)
+
+// GenerateSecret returns a new random signing secret
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// ValidateURL checks that rawURL can be registered as a webhook endpoint
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+// Sign returns the signature header value for body sent at timestamp:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>" keyed with the secret>".
+// Receivers recompute the HMAC and reject old timestamps to prevent replays.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Delivery is one event to post to one endpoint
+type Delivery struct {
+	URL       string
+	Secret    string
+	EventID   string
+	EventType string
+	Body      []byte
+}
+
+// Sender delivers webhook events
+type Sender interface {
+	Deliver(ctx context.Context, d Delivery) error
+}
+
+// Client posts signed deliveries to webhook endpoints
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a webhook client. Endpoints on private networks are refused unless
+// WEBHOOK_ALLOW_PRIVATE_NETWORKS is set, so that webhooks cannot be used to reach internal services.
+func NewClient() Sender {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !config.GetEnvBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS", false) {
+		dialer.Control = refusePrivateNetworks
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	return NewClientWithHTTPClient(&http.Client{
+		Transport: transport,
+		Timeout:   time.Duration(config.GetEnvInt("WEBHOOK_TIMEOUT_SECONDS", 10)) * time.Second,
+		// A redirect would send the signed payload to a URL that was never registered
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	})
+}
+
+// NewClientWithHTTPClient creates a webhook client that sends requests with httpClient
+func NewClientWithHTTPClient(httpClient *http.Client) *Client {
+	return &Client{httpClient: httpClient}
+}
+
+// Deliver posts the delivery and succeeds when the endpoint answers with a 2xx status
+func (c *Client) Deliver(ctx context.Context, d Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "StrikePad-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, d.EventType)
+	req.Header.Set(HeaderDelivery, d.EventID)
+	req.Header.Set(HeaderSignature, Sign(d.Secret, time.Now(), d.Body))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// refusePrivateNetworks is a net.Dialer Control function that rejects connections to
+// addresses that are not publicly routable
+func refusePrivateNetworks(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return fmt.Errorf("%w: %s", ErrPrivateNetwork, host)
+	}
+	return nil
+}
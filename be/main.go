@@ -2,18 +2,30 @@ package main
 
 import (
 	"context"
+	stderrors "errors"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"time"
 
+	"strikepad-backend/internal/config"
 	"strikepad-backend/internal/container"
+	"strikepad-backend/internal/events"
 	"strikepad-backend/internal/handler"
+	"strikepad-backend/internal/lifecycle"
 	authMiddleware "strikepad-backend/internal/middleware"
 	"strikepad-backend/internal/migrations"
+	"strikepad-backend/internal/openapi"
+	"strikepad-backend/internal/router"
+	"strikepad-backend/internal/seeds"
+	"strikepad-backend/internal/serializer"
 	"strikepad-backend/internal/service"
+	"strikepad-backend/internal/telemetry"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -33,46 +45,127 @@ func main() {
 	c := container.BuildContainer()
 
 	e := echo.New()
+	e.JSONSerializer = serializer.NewJSONSerializer()
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
 
-	e.Use(middleware.Logger())
+	e.Pre(authMiddleware.HeadAsGet())
+	e.Use(authMiddleware.AccessLog(authMiddleware.AccessLogConfigFromEnv()))
+	e.Use(authMiddleware.Locale())
+	e.Use(authMiddleware.SecurityHeaders(authMiddleware.SecurityHeadersConfigFromEnv()))
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
+	e.Use(authMiddleware.CSRF())
+	e.Use(authMiddleware.BodyLimit(int64(config.GetEnvInt("MAX_REQUEST_BODY_BYTES", authMiddleware.DefaultBodyLimit))))
+	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
+		// Small responses are not worth the compression overhead
+		MinLength: config.GetEnvInt("GZIP_MIN_LENGTH", 1024),
+	}))
+	e.Use(authMiddleware.MethodNotAllowed())
+	// Checks request and response bodies against the OpenAPI document (logs mismatches outside
+	// production by default); inside the gzip middleware, so that it sees uncompressed bodies
+	e.Use(authMiddleware.OpenAPIValidation(openapi.Build(openapi.Routes), authMiddleware.OpenAPIValidationModeFromEnv()))
 
 	e.GET("/", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Hello from StrikePad Backend!")
 	})
 
-	err := c.Invoke(
-		func(
-			healthHandler handler.HealthHandlerInterface,
-			apiHandler *handler.APIHandler,
-			authHandler handler.AuthHandlerInterface,
-			sessionService service.SessionServiceInterface,
-		) {
-			e.GET("/health", healthHandler.Check)
-			e.GET("/api/test", apiHandler.Test)
-
-			// Public auth endpoints (no JWT required)
-			e.POST("/api/auth/signup", authHandler.Signup)
-			e.POST("/api/auth/login", authHandler.Login)
-			e.POST("/api/auth/google/signup", authHandler.GoogleSignup)
-			e.POST("/api/auth/google/login", authHandler.GoogleLogin)
-
-			// Protected auth endpoints (JWT required)
-			protected := e.Group("/api/auth", authMiddleware.JWTMiddleware(sessionService))
-			protected.POST("/logout", authHandler.Logout)
+	// Register the routes of all handlers in the container
+	err := c.Invoke(func(registrar *router.Registrar) {
+		registrar.Register(e)
+	})
+	if err != nil {
+		slog.Error("Failed to register routes", "error", err)
+		os.Exit(1)
+	}
+
+	// Insert the seed data of the environment (SEED_DATA), for local development and e2e tests.
+	// There is no seed set for production, so seeding it fails.
+	if config.GetEnvBool("SEED_DATA", false) {
+		err = c.Invoke(func(seeder *seeds.Seeder) error {
+			_, err := seeder.Run(context.Background(), config.GetEnv("APP_ENV", "dev"))
+			return err
 		})
+		if err != nil {
+			slog.Error("Failed to insert seed data", "error", err)
+			os.Exit(1)
+		}
+	}
 
+	// Queue a webhook delivery for every published event; the outbox dispatcher sends them
+	err = c.Invoke(func(bus events.Bus, webhookService service.WebhookServiceInterface) {
+		bus.Subscribe(events.All, webhookService.HandleEvent)
+	})
 	if err != nil {
-		slog.Error("Failed to invoke handlers", "error", err)
+		slog.Error("Failed to subscribe webhooks to events", "error", err)
 		os.Exit(1)
 	}
 
-	slog.Info("Starting server", "port", 8080)
-	if err := e.Start(":8080"); err != nil {
-		slog.Error("Server failed to start", "error", err)
+	// Notify users of the events that concern them, on their notification streams
+	err = c.Invoke(func(bus events.Bus, notificationService service.NotificationServiceInterface) {
+		bus.Subscribe(events.SessionRevoked, notificationService.HandleEvent)
+	})
+	if err != nil {
+		slog.Error("Failed to subscribe notifications to events", "error", err)
 		os.Exit(1)
 	}
+
+	// Start the components of the container (background workers, cache warmup) and then the server;
+	// on SIGINT or SIGTERM they are stopped in reverse order: the server finishes its requests before
+	// the workers stop and the database and cache connections close
+	lc, err := container.Lifecycle(c)
+	if err != nil {
+		slog.Error("Failed to build the application lifecycle", "error", err)
+		os.Exit(1)
+	}
+	serverErrors := make(chan error, 1)
+	lc.Append(serverHook(e, ":8080", serverErrors))
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	if err := lc.Start(ctx); err != nil {
+		slog.Error("Failed to start", "error", err)
+		os.Exit(1)
+	}
+
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutting down")
+	case err := <-serverErrors:
+		slog.Error("Server failed", "error", err)
+	}
+
+	shutdownTimeout := time.Duration(config.GetEnvInt("SHUTDOWN_TIMEOUT_SECONDS", 30)) * time.Second
+	stopCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := lc.Stop(stopCtx); err != nil {
+		slog.Error("Failed to shut down cleanly", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Shutdown complete")
+}
+
+// serverHook starts e on address, and shuts it down gracefully, letting requests in flight finish.
+// The address is bound when the hook starts, so that a port in use fails the startup; errors of
+// the running server are sent to serverErrors.
+func serverHook(e *echo.Echo, address string, serverErrors chan<- error) lifecycle.Hook {
+	return lifecycle.Hook{
+		Name: "HTTP server",
+		OnStart: func(ctx context.Context) error {
+			listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+			e.Listener = listener
+			slog.Info("Starting server", "address", address)
+			go func() {
+				if err := e.Start(address); err != nil && !stderrors.Is(err, http.ErrServerClosed) {
+					serverErrors <- err
+				}
+			}()
+			return nil
+		},
+		OnStop: e.Shutdown,
+	}
 }
 
 // initLogger initializes the structured logger with file output and rotation
@@ -110,29 +203,28 @@ func initLogger() {
 		Compress:   true,
 	}
 
-	// Create combined writer for both file and stdout
-	var writer io.Writer
 	env := os.Getenv("APP_ENV")
 
-	if env == "production" {
-		// Production: only write to file
-		writer = logFile
-	} else {
-		// Development: write to both file and stdout
-		writer = io.MultiWriter(logFile, os.Stdout)
-	}
-
 	// Setup handler options
 	opts := &slog.HandlerOptions{
 		Level:     level,
 		AddSource: true, // Add source file and line number
 	}
 
-	var handler slog.Handler
-	if env == "production" {
-		handler = slog.NewJSONHandler(writer, opts)
-	} else {
-		handler = slog.NewTextHandler(writer, opts)
+	newHandler := func(writer io.Writer) slog.Handler {
+		if env == "production" {
+			return slog.NewJSONHandler(writer, opts)
+		}
+		return slog.NewTextHandler(writer, opts)
+	}
+
+	// Each sink hashes user identifiers according to its telemetry policy
+	policies := telemetry.PoliciesFromEnv()
+	handler := telemetry.NewHandler(newHandler(logFile), policies[telemetry.SinkFile])
+	if env != "production" {
+		// Development: write to both file and stdout
+		handler = telemetry.NewFanoutHandler(handler,
+			telemetry.NewHandler(newHandler(os.Stdout), policies[telemetry.SinkStdout]))
 	}
 
 	logger := slog.New(handler)
@@ -164,6 +256,14 @@ func runMigrations() error {
 		return err
 	}
 
+	// Hold the migration lock, so that replicas booting at the same time migrate one after the other
+	locker, err := migrations.NewLockerFromEnv()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = locker.Close() }()
+	runner.WithLocker(locker)
+
 	ctx := context.Background()
 
 	// Run migrations
@@ -0,0 +1,11 @@
+-- Add client metadata captured when a session is created
+ALTER TABLE user_sessions
+    ADD COLUMN ip_address character varying(45);
+ALTER TABLE user_sessions
+    ADD COLUMN user_agent text;
+ALTER TABLE user_sessions
+    ADD COLUMN device_name character varying(100);
+
+COMMENT ON COLUMN user_sessions.ip_address IS 'IPアドレス:IPアドレス';
+COMMENT ON COLUMN user_sessions.user_agent IS 'ユーザーエージェント:ユーザーエージェント';
+COMMENT ON COLUMN user_sessions.device_name IS 'デバイス名:デバイス名';
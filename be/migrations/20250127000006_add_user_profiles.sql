@@ -0,0 +1,27 @@
+-- Create "user_profiles" table for optional onboarding answers
+create table user_profiles (
+                               id serial not null
+    , user_id integer not null
+    , role character varying(50)
+    , team_size character varying(20)
+    , intended_use character varying(50)
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint user_profiles_PKC primary key (id)
+) ;
+
+alter table "user_profiles"
+    add constraint "user_profiles_FK1" foreign key ("user_id") references "users"("id")
+        on delete cascade
+        on update cascade;
+
+create unique index idx_user_profiles_user_id on user_profiles (user_id);
+
+comment on table user_profiles is 'ユーザープロフィール拡張情報';
+comment on column user_profiles.id is 'ID:ID';
+comment on column user_profiles.user_id is 'ユーザーID:ユーザーID';
+comment on column user_profiles.role is '役割:役割';
+comment on column user_profiles.team_size is 'チーム規模:チーム規模';
+comment on column user_profiles.intended_use is '利用目的:利用目的';
+comment on column user_profiles.created_at is '作成日';
+comment on column user_profiles.updated_at is '更新日';
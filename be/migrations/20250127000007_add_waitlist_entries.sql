@@ -0,0 +1,21 @@
+-- Create "waitlist_entries" table for the public waitlist and invite codes
+create table waitlist_entries (
+                                  id serial not null
+    , email character varying(255) not null
+    , invite_code character varying(32)
+    , invited_at timestamp
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint waitlist_entries_PKC primary key (id)
+) ;
+
+create unique index idx_waitlist_entries_email on waitlist_entries (email);
+create unique index idx_waitlist_entries_invite_code on waitlist_entries (invite_code);
+
+comment on table waitlist_entries is 'ウェイトリスト';
+comment on column waitlist_entries.id is 'ID:ID';
+comment on column waitlist_entries.email is 'Eメール:Eメール';
+comment on column waitlist_entries.invite_code is '招待コード:招待コード';
+comment on column waitlist_entries.invited_at is '招待日時:招待日時';
+comment on column waitlist_entries.created_at is '作成日';
+comment on column waitlist_entries.updated_at is '更新日';
@@ -0,0 +1,28 @@
+-- Create "user_identities" table for OAuth accounts linked to existing users
+create table user_identities (
+                                 id serial not null
+    , user_id integer not null
+    , provider character varying(20) not null
+    , provider_user_id character varying(255) not null
+    , email character varying(255)
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint user_identities_PKC primary key (id)
+) ;
+
+alter table "user_identities"
+    add constraint "user_identities_FK1" foreign key ("user_id") references "users"("id")
+        on delete cascade
+        on update cascade;
+
+create unique index idx_user_identities_provider_user_id on user_identities (provider, provider_user_id);
+create unique index idx_user_identities_user_id_provider on user_identities (user_id, provider);
+
+comment on table user_identities is 'ユーザー連携アカウント';
+comment on column user_identities.id is 'ID:ID';
+comment on column user_identities.user_id is 'ユーザーID:ユーザーID';
+comment on column user_identities.provider is 'プロバイダー種別:プロバイダー種別';
+comment on column user_identities.provider_user_id is 'プロバイダーユーザーID:プロバイダーユーザーID';
+comment on column user_identities.email is 'Eメール:Eメール';
+comment on column user_identities.created_at is '作成日';
+comment on column user_identities.updated_at is '更新日';
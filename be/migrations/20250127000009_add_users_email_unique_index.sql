@@ -0,0 +1,2 @@
+-- Active users must have distinct email addresses
+create unique index idx_users_email_active on users (email) where is_deleted = false;
@@ -0,0 +1,6 @@
+-- Add role and disabled flag to "users" for the admin API
+alter table users add column role character varying(20) default 'user' not null;
+alter table users add column is_disabled BOOLEAN default false not null;
+
+comment on column users.role is 'ロール:user または admin';
+comment on column users.is_disabled is '無効フラグ';
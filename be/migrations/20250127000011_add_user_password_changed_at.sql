@@ -0,0 +1,4 @@
+-- Add password change timestamp to "users" to force re-login after a password change
+alter table users add column password_changed_at timestamp;
+
+comment on column users.password_changed_at is 'パスワード変更日';
@@ -0,0 +1,31 @@
+-- Create "api_keys" table for service-to-service authentication
+create table api_keys (
+                          id serial not null
+    , name character varying(100) not null
+    , key_prefix character varying(16) not null
+    , key_hash character varying(64) not null
+    , created_by_user_id integer not null
+    , last_used_at timestamp
+    , revoked_at timestamp
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint api_keys_PKC primary key (id)
+) ;
+
+alter table "api_keys"
+    add constraint "api_keys_FK1" foreign key ("created_by_user_id") references "users"("id")
+        on delete cascade
+        on update cascade;
+
+create unique index idx_api_keys_key_hash on api_keys (key_hash);
+
+comment on table api_keys is 'APIキー';
+comment on column api_keys.id is 'ID:ID';
+comment on column api_keys.name is '名前:用途を表す名前';
+comment on column api_keys.key_prefix is 'キープレフィックス:識別用のキー先頭部分';
+comment on column api_keys.key_hash is 'キーハッシュ:キーのSHA-256ハッシュ';
+comment on column api_keys.created_by_user_id is '発行者ユーザーID:発行者ユーザーID';
+comment on column api_keys.last_used_at is '最終利用日';
+comment on column api_keys.revoked_at is '失効日';
+comment on column api_keys.created_at is '作成日';
+comment on column api_keys.updated_at is '更新日';
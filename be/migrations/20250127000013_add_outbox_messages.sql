@@ -0,0 +1,28 @@
+-- Create "outbox_messages" table for asynchronous delivery of emails and events
+create table outbox_messages (
+                                 id serial not null
+    , kind character varying(50) not null
+    , payload text not null
+    , status character varying(20) default 'pending' not null
+    , attempts integer default 0 not null
+    , last_error text
+    , next_attempt_at timestamp default CURRENT_TIMESTAMP not null
+    , sent_at timestamp
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint outbox_messages_PKC primary key (id)
+) ;
+
+create index idx_outbox_messages_status_next_attempt_at on outbox_messages (status, next_attempt_at);
+
+comment on table outbox_messages is 'アウトボックス';
+comment on column outbox_messages.id is 'ID:ID';
+comment on column outbox_messages.kind is '種別:メッセージの種別(email)';
+comment on column outbox_messages.payload is 'ペイロード:配信内容のJSON';
+comment on column outbox_messages.status is 'ステータス:pending, sent, failed';
+comment on column outbox_messages.attempts is '試行回数:配信を試みた回数';
+comment on column outbox_messages.last_error is '最終エラー:直近の配信失敗の内容';
+comment on column outbox_messages.next_attempt_at is '次回試行日時:次に配信を試みる日時';
+comment on column outbox_messages.sent_at is '配信日時';
+comment on column outbox_messages.created_at is '作成日';
+comment on column outbox_messages.updated_at is '更新日';
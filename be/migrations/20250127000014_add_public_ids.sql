@@ -0,0 +1,26 @@
+-- Add prefixed public IDs to the resources exposed by the API, so responses and URLs
+-- no longer reveal the sequential primary keys. Existing rows get random IDs.
+alter table users add column public_id character varying(32);
+alter table user_sessions add column public_id character varying(32);
+alter table api_keys add column public_id character varying(32);
+alter table waitlist_entries add column public_id character varying(32);
+
+update users set public_id = 'usr_' || substr(replace(gen_random_uuid()::text, '-', ''), 1, 20);
+update user_sessions set public_id = 'ses_' || substr(replace(gen_random_uuid()::text, '-', ''), 1, 20);
+update api_keys set public_id = 'key_' || substr(replace(gen_random_uuid()::text, '-', ''), 1, 20);
+update waitlist_entries set public_id = 'wle_' || substr(replace(gen_random_uuid()::text, '-', ''), 1, 20);
+
+alter table users alter column public_id set not null;
+alter table user_sessions alter column public_id set not null;
+alter table api_keys alter column public_id set not null;
+alter table waitlist_entries alter column public_id set not null;
+
+create unique index idx_users_public_id on users (public_id);
+create unique index idx_user_sessions_public_id on user_sessions (public_id);
+create unique index idx_api_keys_public_id on api_keys (public_id);
+create unique index idx_waitlist_entries_public_id on waitlist_entries (public_id);
+
+comment on column users.public_id is '公開ID:APIで公開するID(usr_)';
+comment on column user_sessions.public_id is '公開ID:APIで公開するID(ses_)';
+comment on column api_keys.public_id is '公開ID:APIで公開するID(key_)';
+comment on column waitlist_entries.public_id is '公開ID:APIで公開するID(wle_)';
@@ -0,0 +1,29 @@
+-- Create "webhook_endpoints" table for customer webhook subscriptions
+create table webhook_endpoints (
+                          id serial not null
+    , public_id character varying(32) not null
+    , url character varying(2048) not null
+    , secret character varying(100) not null
+    , event_types character varying(500) not null
+    , created_by_user_id integer not null
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint webhook_endpoints_PKC primary key (id)
+) ;
+
+alter table "webhook_endpoints"
+    add constraint "webhook_endpoints_FK1" foreign key ("created_by_user_id") references "users"("id")
+        on delete cascade
+        on update cascade;
+
+create unique index idx_webhook_endpoints_public_id on webhook_endpoints (public_id);
+
+comment on table webhook_endpoints is 'Webhookエンドポイント';
+comment on column webhook_endpoints.id is 'ID:ID';
+comment on column webhook_endpoints.public_id is '公開ID:APIで公開するID(whk_)';
+comment on column webhook_endpoints.url is 'URL:配信先のHTTPS URL';
+comment on column webhook_endpoints.secret is '署名シークレット:配信の署名に使うシークレット';
+comment on column webhook_endpoints.event_types is 'イベント種別:購読するイベント種別(カンマ区切り、*は全て)';
+comment on column webhook_endpoints.created_by_user_id is '登録者ユーザーID:登録者ユーザーID';
+comment on column webhook_endpoints.created_at is '作成日';
+comment on column webhook_endpoints.updated_at is '更新日';
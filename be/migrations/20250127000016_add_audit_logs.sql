@@ -0,0 +1,29 @@
+-- Create "audit_logs" table recording security-sensitive actions
+create table audit_logs (
+                          id serial not null
+    , public_id character varying(32) not null
+    , action character varying(50) not null
+    , actor_id character varying(32)
+    , target_id character varying(32)
+    , ip_address character varying(45)
+    , user_agent text
+    , metadata text default '{}' not null
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint audit_logs_PKC primary key (id)
+) ;
+
+create unique index idx_audit_logs_public_id on audit_logs (public_id);
+create index idx_audit_logs_action on audit_logs (action, id);
+create index idx_audit_logs_actor_id on audit_logs (actor_id, id);
+create index idx_audit_logs_target_id on audit_logs (target_id, id);
+
+comment on table audit_logs is '監査ログ';
+comment on column audit_logs.id is 'ID:ID';
+comment on column audit_logs.public_id is '公開ID:APIで公開するID(aud_)';
+comment on column audit_logs.action is '操作:記録した操作の種別(auth.login_succeeded など)';
+comment on column audit_logs.actor_id is '操作者ID:操作したユーザーまたはAPIキーの公開ID';
+comment on column audit_logs.target_id is '対象ID:操作対象の公開ID';
+comment on column audit_logs.ip_address is 'IPアドレス:IPアドレス';
+comment on column audit_logs.user_agent is 'ユーザーエージェント:ユーザーエージェント';
+comment on column audit_logs.metadata is 'メタデータ:操作の詳細のJSON';
+comment on column audit_logs.created_at is '作成日';
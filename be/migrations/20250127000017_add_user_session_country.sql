@@ -0,0 +1,7 @@
+-- Add the country a session was created from, as reported by the reverse proxy
+ALTER TABLE user_sessions
+    ADD COLUMN country character varying(2);
+
+create index idx_user_sessions_user_id_created_at on user_sessions (user_id, created_at);
+
+COMMENT ON COLUMN user_sessions.country IS '国:ISO 3166-1 alpha-2 国コード';
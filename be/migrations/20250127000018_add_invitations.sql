@@ -0,0 +1,37 @@
+-- Create "invitations" table for invitation-based signup
+create table invitations (
+                          id serial not null
+    , public_id character varying(32) not null
+    , email character varying(255) not null
+    , invited_by_user_id integer not null
+    , expires_at timestamp not null
+    , accepted_at timestamp
+    , accepted_user_id integer
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint invitations_PKC primary key (id)
+) ;
+
+alter table "invitations"
+    add constraint "invitations_FK1" foreign key ("invited_by_user_id") references "users"("id")
+        on delete cascade
+        on update cascade;
+
+alter table "invitations"
+    add constraint "invitations_FK2" foreign key ("accepted_user_id") references "users"("id")
+        on delete set null
+        on update cascade;
+
+create unique index idx_invitations_public_id on invitations (public_id);
+create index idx_invitations_email on invitations (email);
+
+comment on table invitations is '招待';
+comment on column invitations.id is 'ID:ID';
+comment on column invitations.public_id is '公開ID:APIで公開するID(inv_)';
+comment on column invitations.email is 'メールアドレス:招待先のメールアドレス(正規化済み)';
+comment on column invitations.invited_by_user_id is '招待者ユーザーID:招待者ユーザーID';
+comment on column invitations.expires_at is '有効期限:招待の有効期限';
+comment on column invitations.accepted_at is '受諾日時:招待でサインアップした日時';
+comment on column invitations.accepted_user_id is '受諾ユーザーID:招待でサインアップしたユーザーID';
+comment on column invitations.created_at is '作成日';
+comment on column invitations.updated_at is '更新日';
@@ -0,0 +1,27 @@
+-- Create "idempotency_keys" table storing the responses of requests sent with an Idempotency-Key header
+create table idempotency_keys (
+                          id serial not null
+    , scope character varying(300) not null
+    , idempotency_key character varying(255) not null
+    , request_hash character(64) not null
+    , response_status integer
+    , response_headers text default '{}' not null
+    , response_body text default '' not null
+    , expires_at timestamp not null
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint idempotency_keys_PKC primary key (id)
+) ;
+
+create unique index idx_idempotency_keys_scope_key on idempotency_keys (scope, idempotency_key);
+create index idx_idempotency_keys_expires_at on idempotency_keys (expires_at);
+
+comment on table idempotency_keys is '冪等キー';
+comment on column idempotency_keys.id is 'ID:ID';
+comment on column idempotency_keys.scope is 'スコープ:キーが有効な範囲(呼び出し元・メソッド・ルート)';
+comment on column idempotency_keys.idempotency_key is '冪等キー:Idempotency-Keyヘッダーの値';
+comment on column idempotency_keys.request_hash is 'リクエストハッシュ:最初のリクエストのSHA-256';
+comment on column idempotency_keys.response_status is 'レスポンスステータス:保存したレスポンスのステータス(処理中はNULL)';
+comment on column idempotency_keys.response_headers is 'レスポンスヘッダー:再送するヘッダーのJSON';
+comment on column idempotency_keys.response_body is 'レスポンスボディ:保存したレスポンスのボディ';
+comment on column idempotency_keys.expires_at is '有効期限:キーの有効期限';
+comment on column idempotency_keys.created_at is '作成日';
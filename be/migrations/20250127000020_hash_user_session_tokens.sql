@@ -0,0 +1,18 @@
+-- Store SHA-256 hashes of session tokens instead of the tokens, so that a leaked database does not
+-- hand out live bearer tokens. Existing sessions keep working: their tokens are hashed in place.
+alter table user_sessions add column access_token_hash character(64);
+alter table user_sessions add column refresh_token_hash character(64);
+
+update user_sessions set
+    access_token_hash = encode(sha256(convert_to(access_token, 'UTF8')), 'hex')
+  , refresh_token_hash = encode(sha256(convert_to(refresh_token, 'UTF8')), 'hex');
+
+alter table user_sessions alter column access_token_hash set not null;
+alter table user_sessions drop column access_token;
+alter table user_sessions drop column refresh_token;
+
+create index idx_user_sessions_access_token_hash on user_sessions (access_token_hash);
+create index idx_user_sessions_refresh_token_hash on user_sessions (refresh_token_hash);
+
+comment on column user_sessions.access_token_hash is 'アクセストークンハッシュ:アクセストークンのSHA-256';
+comment on column user_sessions.refresh_token_hash is 'リフレッシュトークンハッシュ:リフレッシュトークンのSHA-256';
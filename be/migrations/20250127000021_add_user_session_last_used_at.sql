@@ -0,0 +1,7 @@
+-- Record when each session was last used, for the session list and the idle timeout. Existing
+-- sessions start from their last refresh.
+alter table user_sessions add column last_used_at timestamp;
+
+update user_sessions set last_used_at = updated_at;
+
+comment on column user_sessions.last_used_at is '最終利用日時:セッションが最後にリクエストを認証した日時（一定間隔ごとに記録）';
@@ -0,0 +1,8 @@
+-- Let users opt out of the user search, and index the prefixes it matches on. text_pattern_ops
+-- lets LIKE 'prefix%' use the indexes whatever the database collation is.
+alter table users add column hide_from_search BOOLEAN default false not null;
+
+create index idx_users_display_name_prefix on users (lower(display_name) text_pattern_ops) where is_deleted = false;
+create index idx_users_email_prefix on users (email text_pattern_ops) where is_deleted = false;
+
+comment on column users.hide_from_search is '検索非公開フラグ:ユーザー検索の結果に表示しない';
@@ -0,0 +1,5 @@
+-- Avatar image URL. The image itself is kept in the storage backend under a key derived from the
+-- user's public ID; the URL carries a version so clients refetch it after each upload.
+alter table users add column avatar_url VARCHAR(1024);
+
+comment on column users.avatar_url is 'アバターURL:アバター画像のURL';
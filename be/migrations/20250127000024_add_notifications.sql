@@ -0,0 +1,26 @@
+-- Create "notifications" table for the events shown to users, replayed to reconnecting streams
+create table notifications (
+                          id serial not null
+    , public_id character varying(32) not null
+    , user_id integer not null
+    , type character varying(50) not null
+    , data text default '{}' not null
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint notifications_PKC primary key (id)
+) ;
+
+alter table "notifications"
+    add constraint "notifications_FK1" foreign key ("user_id") references "users"("id")
+        on delete cascade
+        on update cascade;
+
+create unique index idx_notifications_public_id on notifications (public_id);
+create index idx_notifications_user_id on notifications (user_id, id);
+
+comment on table notifications is '通知';
+comment on column notifications.id is 'ID:ID';
+comment on column notifications.public_id is '公開ID:APIで公開するID(ntf_)';
+comment on column notifications.user_id is 'ユーザーID:通知先のユーザーID';
+comment on column notifications.type is '種別:通知の種別(session.revoked など)';
+comment on column notifications.data is 'データ:通知の内容のJSON';
+comment on column notifications.created_at is '作成日';
@@ -0,0 +1,37 @@
+-- Create "data_exports" table for the archives of their data that users request
+create table data_exports (
+                          id serial not null
+    , public_id character varying(32) not null
+    , user_id integer not null
+    , status character varying(20) default 'pending' not null
+    , storage_key character varying(255)
+    , size_bytes bigint
+    , last_error text
+    , completed_at timestamp
+    , expires_at timestamp
+    , created_at timestamp default CURRENT_TIMESTAMP not null
+    , updated_at timestamp default CURRENT_TIMESTAMP not null
+    , constraint data_exports_PKC primary key (id)
+) ;
+
+alter table "data_exports"
+    add constraint "data_exports_FK1" foreign key ("user_id") references "users"("id")
+        on delete cascade
+        on update cascade;
+
+create unique index idx_data_exports_public_id on data_exports (public_id);
+create index idx_data_exports_user_id on data_exports (user_id);
+create index idx_data_exports_status on data_exports (status, updated_at);
+
+comment on table data_exports is 'データエクスポート';
+comment on column data_exports.id is 'ID:ID';
+comment on column data_exports.public_id is '公開ID:APIで公開するID(exp_)';
+comment on column data_exports.user_id is 'ユーザーID:データをエクスポートするユーザーID';
+comment on column data_exports.status is 'ステータス:pending, processing, ready, failed';
+comment on column data_exports.storage_key is 'ストレージキー:アーカイブを保存したストレージのキー';
+comment on column data_exports.size_bytes is 'サイズ:アーカイブのバイト数';
+comment on column data_exports.last_error is '最終エラー:作成失敗の内容';
+comment on column data_exports.completed_at is '完了日時:アーカイブを作成した日時';
+comment on column data_exports.expires_at is '有効期限:アーカイブを削除する日時';
+comment on column data_exports.created_at is '作成日';
+comment on column data_exports.updated_at is '更新日';
@@ -0,0 +1,6 @@
+-- Add purge timestamp to "users" recording when the personal data of a deleted user was erased
+alter table users add column purged_at timestamp;
+
+create index idx_users_purge on users (deleted_at) where is_deleted = true and purged_at is null;
+
+comment on column users.purged_at is '消去日:削除したユーザーの個人情報を消去した日時';
@@ -0,0 +1,2 @@
+-- Index "users" by creation time so that user listings sorted by it are paged by cursor without a sort
+create index idx_users_created_at on users (created_at, id);
@@ -0,0 +1,13 @@
+-- Add the pending email change of "users": the requested address, until when it can be confirmed,
+-- which addresses confirmed it, and whether the user's sessions end once it completes
+alter table users add column pending_email varchar(255);
+alter table users add column pending_email_expires_at timestamp;
+alter table users add column pending_email_old_confirmed boolean not null default false;
+alter table users add column pending_email_new_confirmed boolean not null default false;
+alter table users add column pending_email_sign_out boolean not null default false;
+
+comment on column users.pending_email is '変更予定Eメール:確認待ちの新しいEメール';
+comment on column users.pending_email_expires_at is '変更予定Eメール有効期限:確認リンクの有効期限';
+comment on column users.pending_email_old_confirmed is '旧Eメール確認フラグ:現在のEメールで変更を確認した';
+comment on column users.pending_email_new_confirmed is '新Eメール確認フラグ:新しいEメールで変更を確認した';
+comment on column users.pending_email_sign_out is '変更時ログアウトフラグ:変更完了時に全セッションを終了する';
@@ -0,0 +1,4 @@
+-- Add version to "user_profiles", incremented on every change, so that updates made from a stale copy are refused
+alter table user_profiles add column version integer not null default 1;
+
+comment on column user_profiles.version is 'バージョン:変更のたびに増える番号(楽観ロック用)';
@@ -0,0 +1,4 @@
+-- Add email_changed_at to "users", so that activity naming an address before it became the user's is not theirs
+alter table users add column email_changed_at timestamp;
+
+comment on column users.email_changed_at is 'Eメール変更日:Eメールを最後に変更した日時';
@@ -0,0 +1,5 @@
+-- Add the nonce of the pending email change of "users", which its confirmation links carry, so
+-- that the links of a replaced or cancelled change stop working even for the same address
+alter table users add column pending_email_nonce varchar(64);
+
+comment on column users.pending_email_nonce is '変更予定Eメールノンス:確認リンクが持つ変更依頼ごとの乱数';
@@ -0,0 +1,7 @@
+-- Delete the "idempotency_keys" stored before responses were encrypted and requests hashed with a
+-- server key, as their responses can hold tokens and secrets in plain text
+delete from idempotency_keys;
+
+comment on column idempotency_keys.request_hash is 'リクエストハッシュ:最初のリクエストのHMAC-SHA256';
+comment on column idempotency_keys.response_headers is 'レスポンスヘッダー:再送するヘッダーのJSON(暗号化)';
+comment on column idempotency_keys.response_body is 'レスポンスボディ:保存したレスポンスのボディ(暗号化)';
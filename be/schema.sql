@@ -10,7 +10,22 @@ CREATE TABLE users (
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     is_deleted BOOLEAN NOT NULL DEFAULT false,
-    deleted_at TIMESTAMP
+    deleted_at TIMESTAMP,
+    role VARCHAR(20) NOT NULL DEFAULT 'user',
+    is_disabled BOOLEAN NOT NULL DEFAULT false,
+    password_changed_at TIMESTAMP,
+    public_id VARCHAR(32) NOT NULL,
+    hide_from_search BOOLEAN NOT NULL DEFAULT false,
+    avatar_url VARCHAR(1024),
+    purged_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    pending_email VARCHAR(255),
+    pending_email_expires_at TIMESTAMP,
+    pending_email_old_confirmed BOOLEAN NOT NULL DEFAULT false,
+    pending_email_new_confirmed BOOLEAN NOT NULL DEFAULT false,
+    pending_email_sign_out BOOLEAN NOT NULL DEFAULT false,
+    pending_email_nonce VARCHAR(64),
+    email_changed_at TIMESTAMP
 );
 
 COMMENT ON TABLE users IS 'ユーザー情報';
@@ -25,6 +40,28 @@ COMMENT ON COLUMN users.created_at IS '作成日';
 COMMENT ON COLUMN users.updated_at IS '更新日';
 COMMENT ON COLUMN users.is_deleted IS '削除フラグ';
 COMMENT ON COLUMN users.deleted_at IS '削除日';
+COMMENT ON COLUMN users.role IS 'ロール:user または admin';
+COMMENT ON COLUMN users.is_disabled IS '無効フラグ';
+COMMENT ON COLUMN users.password_changed_at IS 'パスワード変更日';
+COMMENT ON COLUMN users.public_id IS '公開ID:APIで公開するID(usr_)';
+COMMENT ON COLUMN users.hide_from_search IS '検索非公開フラグ:ユーザー検索の結果に表示しない';
+COMMENT ON COLUMN users.avatar_url IS 'アバターURL:アバター画像のURL';
+COMMENT ON COLUMN users.purged_at IS '消去日:削除したユーザーの個人情報を消去した日時';
+COMMENT ON COLUMN users.version IS 'バージョン:変更のたびに増える番号(楽観ロック用)';
+COMMENT ON COLUMN users.pending_email IS '変更予定Eメール:確認待ちの新しいEメール';
+COMMENT ON COLUMN users.pending_email_expires_at IS '変更予定Eメール有効期限:確認リンクの有効期限';
+COMMENT ON COLUMN users.pending_email_old_confirmed IS '旧Eメール確認フラグ:現在のEメールで変更を確認した';
+COMMENT ON COLUMN users.pending_email_new_confirmed IS '新Eメール確認フラグ:新しいEメールで変更を確認した';
+COMMENT ON COLUMN users.pending_email_sign_out IS '変更時ログアウトフラグ:変更完了時に全セッションを終了する';
+COMMENT ON COLUMN users.pending_email_nonce IS '変更予定Eメールノンス:確認リンクが持つ変更依頼ごとの乱数';
+COMMENT ON COLUMN users.email_changed_at IS 'Eメール変更日:Eメールを最後に変更した日時';
+
+CREATE UNIQUE INDEX idx_users_email_active ON users(email) WHERE is_deleted = false;
+CREATE UNIQUE INDEX idx_users_public_id ON users(public_id);
+CREATE INDEX idx_users_display_name_prefix ON users(lower(display_name) text_pattern_ops) WHERE is_deleted = false;
+CREATE INDEX idx_users_email_prefix ON users(email text_pattern_ops) WHERE is_deleted = false;
+CREATE INDEX idx_users_purge ON users(deleted_at) WHERE is_deleted = true AND purged_at IS NULL;
+CREATE INDEX idx_users_created_at ON users(created_at, id);
 
 -- User sessions table
 CREATE TABLE user_sessions (
@@ -34,10 +71,14 @@ CREATE TABLE user_sessions (
     refresh_token            TEXT,
     access_token_expires_at  TIMESTAMP NOT NULL,
     refresh_token_expires_at TIMESTAMP NOT NULL,
+    ip_address               VARCHAR(45),
+    user_agent               TEXT,
+    device_name              VARCHAR(100),
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     is_deleted BOOLEAN NOT NULL DEFAULT false,
     deleted_at TIMESTAMP,
+    public_id VARCHAR(32) NOT NULL,
     CONSTRAINT fk_user_sessions_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
@@ -48,10 +89,14 @@ COMMENT ON COLUMN user_sessions.access_token IS 'アクセストークン:アク
 COMMENT ON COLUMN user_sessions.refresh_token IS 'リフレッシュトークン:リフレッシュトークン';
 COMMENT ON COLUMN user_sessions.access_token_expires_at IS 'アクセストークン有効期限:アクセストークン有効期限';
 COMMENT ON COLUMN user_sessions.refresh_token_expires_at IS 'リフレッシュトークン有効期限:リフレッシュトークン有効期限';
+COMMENT ON COLUMN user_sessions.ip_address IS 'IPアドレス:IPアドレス';
+COMMENT ON COLUMN user_sessions.user_agent IS 'ユーザーエージェント:ユーザーエージェント';
+COMMENT ON COLUMN user_sessions.device_name IS 'デバイス名:デバイス名';
 COMMENT ON COLUMN user_sessions.created_at IS '作成日';
 COMMENT ON COLUMN user_sessions.updated_at IS '更新日';
 COMMENT ON COLUMN user_sessions.is_deleted IS '削除フラグ';
 COMMENT ON COLUMN user_sessions.deleted_at IS '削除日';
+COMMENT ON COLUMN user_sessions.public_id IS '公開ID:APIで公開するID(ses_)';
 
 -- Create indexes
 CREATE INDEX idx_user_sessions_user_id ON user_sessions(user_id);
@@ -59,4 +104,208 @@ CREATE INDEX idx_user_sessions_access_token ON user_sessions (access_token);
 CREATE INDEX idx_user_sessions_refresh_token ON user_sessions (refresh_token);
 CREATE INDEX idx_user_sessions_access_expires_at ON user_sessions (access_token_expires_at);
 CREATE INDEX idx_user_sessions_refresh_expires_at ON user_sessions (refresh_token_expires_at);
-CREATE INDEX idx_user_sessions_is_deleted ON user_sessions(is_deleted);
\ No newline at end of file
+CREATE INDEX idx_user_sessions_is_deleted ON user_sessions(is_deleted);
+CREATE UNIQUE INDEX idx_user_sessions_public_id ON user_sessions(public_id);
+-- User profiles table (optional onboarding answers)
+CREATE TABLE user_profiles (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    role VARCHAR(50),
+    team_size VARCHAR(20),
+    intended_use VARCHAR(50),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    CONSTRAINT fk_user_profiles_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE user_profiles IS 'ユーザープロフィール拡張情報';
+COMMENT ON COLUMN user_profiles.id IS 'ID:ID';
+COMMENT ON COLUMN user_profiles.user_id IS 'ユーザーID:ユーザーID';
+COMMENT ON COLUMN user_profiles.role IS '役割:役割';
+COMMENT ON COLUMN user_profiles.team_size IS 'チーム規模:チーム規模';
+COMMENT ON COLUMN user_profiles.intended_use IS '利用目的:利用目的';
+COMMENT ON COLUMN user_profiles.created_at IS '作成日';
+COMMENT ON COLUMN user_profiles.updated_at IS '更新日';
+COMMENT ON COLUMN user_profiles.version IS 'バージョン:変更のたびに増える番号(楽観ロック用)';
+
+CREATE UNIQUE INDEX idx_user_profiles_user_id ON user_profiles(user_id);
+
+CREATE TABLE waitlist_entries (
+    id SERIAL PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    invite_code VARCHAR(32),
+    invited_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    public_id VARCHAR(32) NOT NULL
+);
+
+COMMENT ON TABLE waitlist_entries IS 'ウェイトリスト';
+COMMENT ON COLUMN waitlist_entries.id IS 'ID:ID';
+COMMENT ON COLUMN waitlist_entries.email IS 'Eメール:Eメール';
+COMMENT ON COLUMN waitlist_entries.invite_code IS '招待コード:招待コード';
+COMMENT ON COLUMN waitlist_entries.invited_at IS '招待日時:招待日時';
+COMMENT ON COLUMN waitlist_entries.created_at IS '作成日';
+COMMENT ON COLUMN waitlist_entries.updated_at IS '更新日';
+COMMENT ON COLUMN waitlist_entries.public_id IS '公開ID:APIで公開するID(wle_)';
+
+CREATE UNIQUE INDEX idx_waitlist_entries_email ON waitlist_entries(email);
+CREATE UNIQUE INDEX idx_waitlist_entries_invite_code ON waitlist_entries(invite_code);
+CREATE UNIQUE INDEX idx_waitlist_entries_public_id ON waitlist_entries(public_id);
+
+CREATE TABLE user_identities (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    provider VARCHAR(20) NOT NULL,
+    provider_user_id VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_user_identities_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE user_identities IS 'ユーザー連携アカウント';
+COMMENT ON COLUMN user_identities.id IS 'ID:ID';
+COMMENT ON COLUMN user_identities.user_id IS 'ユーザーID:ユーザーID';
+COMMENT ON COLUMN user_identities.provider IS 'プロバイダー種別:プロバイダー種別';
+COMMENT ON COLUMN user_identities.provider_user_id IS 'プロバイダーユーザーID:プロバイダーユーザーID';
+COMMENT ON COLUMN user_identities.email IS 'Eメール:Eメール';
+COMMENT ON COLUMN user_identities.created_at IS '作成日';
+COMMENT ON COLUMN user_identities.updated_at IS '更新日';
+
+CREATE UNIQUE INDEX idx_user_identities_provider_user_id ON user_identities(provider, provider_user_id);
+CREATE UNIQUE INDEX idx_user_identities_user_id_provider ON user_identities(user_id, provider);
+
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    created_by_user_id INTEGER NOT NULL,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    public_id VARCHAR(32) NOT NULL,
+    CONSTRAINT fk_api_keys_created_by_user_id FOREIGN KEY (created_by_user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE api_keys IS 'APIキー';
+COMMENT ON COLUMN api_keys.id IS 'ID:ID';
+COMMENT ON COLUMN api_keys.name IS '名前:用途を表す名前';
+COMMENT ON COLUMN api_keys.key_prefix IS 'キープレフィックス:識別用のキー先頭部分';
+COMMENT ON COLUMN api_keys.key_hash IS 'キーハッシュ:キーのSHA-256ハッシュ';
+COMMENT ON COLUMN api_keys.created_by_user_id IS '発行者ユーザーID:発行者ユーザーID';
+COMMENT ON COLUMN api_keys.last_used_at IS '最終利用日';
+COMMENT ON COLUMN api_keys.revoked_at IS '失効日';
+COMMENT ON COLUMN api_keys.created_at IS '作成日';
+COMMENT ON COLUMN api_keys.updated_at IS '更新日';
+COMMENT ON COLUMN api_keys.public_id IS '公開ID:APIで公開するID(key_)';
+
+CREATE UNIQUE INDEX idx_api_keys_key_hash ON api_keys(key_hash);
+CREATE UNIQUE INDEX idx_api_keys_public_id ON api_keys(public_id);
+
+CREATE TABLE outbox_messages (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE outbox_messages IS 'アウトボックス';
+COMMENT ON COLUMN outbox_messages.id IS 'ID:ID';
+COMMENT ON COLUMN outbox_messages.kind IS '種別:メッセージの種別(email)';
+COMMENT ON COLUMN outbox_messages.payload IS 'ペイロード:配信内容のJSON';
+COMMENT ON COLUMN outbox_messages.status IS 'ステータス:pending, sent, failed';
+COMMENT ON COLUMN outbox_messages.attempts IS '試行回数:配信を試みた回数';
+COMMENT ON COLUMN outbox_messages.last_error IS '最終エラー:直近の配信失敗の内容';
+COMMENT ON COLUMN outbox_messages.next_attempt_at IS '次回試行日時:次に配信を試みる日時';
+COMMENT ON COLUMN outbox_messages.sent_at IS '配信日時';
+COMMENT ON COLUMN outbox_messages.created_at IS '作成日';
+COMMENT ON COLUMN outbox_messages.updated_at IS '更新日';
+
+CREATE INDEX idx_outbox_messages_status_next_attempt_at ON outbox_messages(status, next_attempt_at);
+
+CREATE TABLE webhook_endpoints (
+    id SERIAL PRIMARY KEY,
+    public_id VARCHAR(32) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    event_types VARCHAR(500) NOT NULL,
+    created_by_user_id INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_webhook_endpoints_created_by_user_id FOREIGN KEY (created_by_user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE webhook_endpoints IS 'Webhookエンドポイント';
+COMMENT ON COLUMN webhook_endpoints.id IS 'ID:ID';
+COMMENT ON COLUMN webhook_endpoints.public_id IS '公開ID:APIで公開するID(whk_)';
+COMMENT ON COLUMN webhook_endpoints.url IS 'URL:配信先のHTTPS URL';
+COMMENT ON COLUMN webhook_endpoints.secret IS '署名シークレット:配信の署名に使うシークレット';
+COMMENT ON COLUMN webhook_endpoints.event_types IS 'イベント種別:購読するイベント種別(カンマ区切り、*は全て)';
+COMMENT ON COLUMN webhook_endpoints.created_by_user_id IS '登録者ユーザーID:登録者ユーザーID';
+COMMENT ON COLUMN webhook_endpoints.created_at IS '作成日';
+COMMENT ON COLUMN webhook_endpoints.updated_at IS '更新日';
+
+CREATE UNIQUE INDEX idx_webhook_endpoints_public_id ON webhook_endpoints(public_id);
+
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    public_id VARCHAR(32) NOT NULL,
+    user_id INTEGER NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    data TEXT NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_notifications_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE notifications IS '通知';
+COMMENT ON COLUMN notifications.id IS 'ID:ID';
+COMMENT ON COLUMN notifications.public_id IS '公開ID:APIで公開するID(ntf_)';
+COMMENT ON COLUMN notifications.user_id IS 'ユーザーID:通知先のユーザーID';
+COMMENT ON COLUMN notifications.type IS '種別:通知の種別(session.revoked など)';
+COMMENT ON COLUMN notifications.data IS 'データ:通知の内容のJSON';
+COMMENT ON COLUMN notifications.created_at IS '作成日';
+
+CREATE UNIQUE INDEX idx_notifications_public_id ON notifications(public_id);
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, id);
+
+CREATE TABLE data_exports (
+    id SERIAL PRIMARY KEY,
+    public_id VARCHAR(32) NOT NULL,
+    user_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    storage_key VARCHAR(255),
+    size_bytes BIGINT,
+    last_error TEXT,
+    completed_at TIMESTAMP,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_data_exports_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE data_exports IS 'データエクスポート';
+COMMENT ON COLUMN data_exports.id IS 'ID:ID';
+COMMENT ON COLUMN data_exports.public_id IS '公開ID:APIで公開するID(exp_)';
+COMMENT ON COLUMN data_exports.user_id IS 'ユーザーID:データをエクスポートするユーザーID';
+COMMENT ON COLUMN data_exports.status IS 'ステータス:pending, processing, ready, failed';
+COMMENT ON COLUMN data_exports.storage_key IS 'ストレージキー:アーカイブを保存したストレージのキー';
+COMMENT ON COLUMN data_exports.size_bytes IS 'サイズ:アーカイブのバイト数';
+COMMENT ON COLUMN data_exports.last_error IS '最終エラー:作成失敗の内容';
+COMMENT ON COLUMN data_exports.completed_at IS '完了日時:アーカイブを作成した日時';
+COMMENT ON COLUMN data_exports.expires_at IS '有効期限:アーカイブを削除する日時';
+COMMENT ON COLUMN data_exports.created_at IS '作成日';
+COMMENT ON COLUMN data_exports.updated_at IS '更新日';
+
+CREATE UNIQUE INDEX idx_data_exports_public_id ON data_exports(public_id);
+CREATE INDEX idx_data_exports_user_id ON data_exports(user_id);
+CREATE INDEX idx_data_exports_status ON data_exports(status, updated_at);
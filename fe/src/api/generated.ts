@@ -0,0 +1,667 @@
+// Code generated by cmd/clientgen from the OpenAPI document. DO NOT EDIT.
+
+/* eslint-disable */
+
+export interface APIKeyCreatedResponse {
+  created_at: string;
+  created_by_user_id: string;
+  id: string;
+  key: string;
+  key_prefix: string;
+  last_used_at?: string;
+  name: string;
+  revoked_at?: string;
+}
+
+export interface APIKeyInfo {
+  created_at: string;
+  created_by_user_id: string;
+  id: string;
+  key_prefix: string;
+  last_used_at?: string;
+  name: string;
+  revoked_at?: string;
+}
+
+export interface APIKeyListResponse {
+  data: APIKeyInfo[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface AdminUserInfo {
+  created_at: string;
+  display_name: string;
+  email?: string;
+  email_verified: boolean;
+  id: string;
+  is_disabled: boolean;
+  provider_type: string;
+  role: string;
+}
+
+export interface AdminUserListResponse {
+  data: AdminUserInfo[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface AuditLogInfo {
+  action: string;
+  actor_id?: string;
+  created_at: string;
+  id: string;
+  ip_address?: string;
+  metadata: Record<string, string>;
+  target_id?: string;
+  user_agent?: string;
+}
+
+export interface AuditLogListResponse {
+  data: AuditLogInfo[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface AuthResponse {
+  access_token: string;
+  created_at: string;
+  display_name: string;
+  email: string;
+  email_verified: boolean;
+  expires_at: string;
+  id: string;
+  refresh_token: string;
+}
+
+export interface AvatarResponse {
+  avatar_url: string;
+}
+
+export interface CSRFTokenResponse {
+  csrf_token: string;
+}
+
+export interface ComponentStatus {
+  name: string;
+  status: string;
+}
+
+export interface ConfirmEmailChangeRequest {
+  token: string;
+}
+
+export interface CreateAPIKeyRequest {
+  name: string;
+}
+
+export interface CreateInvitationRequest {
+  email: string;
+}
+
+export interface CreateWebhookRequest {
+  event_types: string[];
+  url: string;
+}
+
+export interface DataExportResponse {
+  completed_at?: string;
+  created_at: string;
+  download_url?: string;
+  download_url_expires_at?: string;
+  expires_at?: string;
+  id: string;
+  size_bytes?: number;
+  status: string;
+}
+
+export interface DependencyHealth {
+  name: string;
+  status: string;
+}
+
+export interface EmailChangeRequest {
+  email: string;
+  password?: string;
+  sign_out_everywhere: boolean;
+}
+
+export interface EmailChangeResponse {
+  completed: boolean;
+  current_address_confirmed: boolean;
+  email: string;
+  expires_at?: string;
+  new_address_confirmed: boolean;
+}
+
+export interface ErrorResponse {
+  code: string;
+  description?: string;
+  details?: ValidationError[];
+  message: string;
+}
+
+export interface GoogleLoginRequest {
+  access_token?: string;
+  device_name?: string;
+  id_token?: string;
+}
+
+export interface GoogleSignupRequest {
+  access_token?: string;
+  device_name?: string;
+  id_token?: string;
+  invite_token?: string;
+}
+
+export interface HealthResponse {
+  dependencies?: DependencyHealth[];
+  message: string;
+  status: string;
+}
+
+export interface IdentityInfo {
+  email?: string;
+  linked_at: string;
+  provider: string;
+}
+
+export interface InvitationInfo {
+  created_at: string;
+  email: string;
+  expires_at: string;
+  id: string;
+  invited_by_user_id: string;
+}
+
+export interface LinkGoogleIdentityRequest {
+  access_token?: string;
+  id_token?: string;
+}
+
+export interface LoginAttemptInfo {
+  created_at: string;
+  ip_address?: string;
+  method?: string;
+  reason?: string;
+  result: string;
+  user_agent?: string;
+}
+
+export interface LoginAttemptListResponse {
+  data: LoginAttemptInfo[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface LoginRequest {
+  device_name?: string;
+  email: string;
+  password: string;
+}
+
+export interface LoginResponse {
+  access_token: string;
+  avatar_url: string | null;
+  display_name: string;
+  email: string;
+  email_verified: boolean;
+  expires_at: string;
+  id: string;
+  refresh_token: string;
+}
+
+export interface Message {
+  message: string;
+}
+
+export interface Notification {
+  created_at: string;
+  data: Record<string, string>;
+  id: string;
+  type: string;
+}
+
+export interface OAuthRequest {
+  access_token?: string;
+  code?: string;
+  code_verifier?: string;
+  device_name?: string;
+  invite_token?: string;
+}
+
+export interface OnboardingRequest {
+  intended_use?: 'personal' | 'work' | 'education' | 'other';
+  role?: 'engineer' | 'designer' | 'manager' | 'founder' | 'student' | 'other';
+  team_size?: '1' | '2-10' | '11-50' | '51-200' | '201+';
+  version?: number;
+}
+
+export interface OnboardingResponse {
+  intended_use: string | null;
+  role: string | null;
+  team_size: string | null;
+  updated_at?: string;
+  version: number;
+}
+
+export interface OutboxStatsResponse {
+  failed: number;
+  oldest_pending_age_seconds: number;
+  oldest_pending_at?: string;
+  pending: number;
+}
+
+export interface PrivacySettingsRequest {
+  discoverable: boolean | null;
+  version?: number;
+}
+
+export interface PrivacySettingsResponse {
+  discoverable: boolean;
+  version: number;
+}
+
+export interface SecuritySettingsResponse {
+  has_password: boolean;
+  identities: IdentityInfo[];
+  password_changed_at?: string;
+  provider_type: string;
+}
+
+export interface SessionInfo {
+  created_at: string;
+  current: boolean;
+  device_name?: string;
+  id: string;
+  ip_address: string;
+  last_used_at: string;
+  user_agent: string;
+}
+
+export interface SessionListResponse {
+  data: SessionInfo[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface SignupRequest {
+  device_name?: string;
+  display_name: string;
+  email: string;
+  invite_token?: string;
+  password: string;
+}
+
+export interface StatusResponse {
+  checked_at: string;
+  components: ComponentStatus[];
+  status: string;
+}
+
+export interface UnlinkIdentityRequest {
+  password?: string;
+}
+
+export interface UserSearchResponse {
+  data: UserSearchResult[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface UserSearchResult {
+  avatar_url: string | null;
+  display_name: string;
+  id: string;
+}
+
+export interface ValidationError {
+  field: string;
+  message: string;
+  tag: string;
+  value: string;
+}
+
+export interface WaitlistEntryInfo {
+  created_at: string;
+  email: string;
+  id: string;
+  invite_code?: string;
+  invited_at?: string;
+}
+
+export interface WaitlistInviteRequest {
+  count: number;
+}
+
+export interface WaitlistInviteResponse {
+  invited: WaitlistEntryInfo[];
+}
+
+export interface WaitlistJoinRequest {
+  captcha_token?: string;
+  email: string;
+}
+
+export interface WaitlistListResponse {
+  data: WaitlistEntryInfo[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface WebhookCreatedResponse {
+  created_at: string;
+  event_types: string[];
+  id: string;
+  secret: string;
+  url: string;
+}
+
+export interface WebhookInfo {
+  created_at: string;
+  event_types: string[];
+  id: string;
+  url: string;
+}
+
+export interface WebhookListResponse {
+  data: WebhookInfo[];
+  has_more: boolean;
+  next_cursor?: string;
+  page?: number;
+  per_page: number;
+  total?: number;
+  total_estimated?: boolean;
+}
+
+export interface GoogleCallbackParams {
+  /** Authorization code issued by Google */
+  code?: string;
+  /** State passed to Google by the authorize endpoint */
+  state?: string;
+  /** Error reported by Google */
+  error?: string;
+}
+
+export interface ListAuditLogsParams {
+  /** Only list entries of this action, such as auth.login_failed */
+  action?: string;
+  /** Only list entries of the user or API key with this public ID */
+  actor_id?: string;
+  /** Only list entries about the resource with this public ID */
+  target_id?: string;
+  /** Only list entries recorded at or after this RFC 3339 time */
+  since?: string;
+  /** Only list entries recorded before this RFC 3339 time */
+  until?: string;
+  /** Continue after the page that returned this next_cursor, instead of page */
+  cursor?: string;
+  /** Page number, starting at 1 */
+  page?: number;
+  /** Number of items per page */
+  per_page?: number;
+  /** Count the total number of items */
+  include_total?: boolean;
+}
+
+export interface ListLoginsParams {
+  /** Continue after the page that returned this next_cursor, instead of page */
+  cursor?: string;
+  /** Page number, starting at 1 */
+  page?: number;
+  /** Number of items per page */
+  per_page?: number;
+  /** Count the total number of items */
+  include_total?: boolean;
+}
+
+export interface ListSessionsParams {
+  /** Continue after the page that returned this next_cursor, instead of page */
+  cursor?: string;
+  /** Page number, starting at 1 */
+  page?: number;
+  /** Number of items per page */
+  per_page?: number;
+  /** Count the total number of items */
+  include_total?: boolean;
+}
+
+export interface ListUserSessionsParams {
+  /** Continue after the page that returned this next_cursor, instead of page */
+  cursor?: string;
+  /** Page number, starting at 1 */
+  page?: number;
+  /** Number of items per page */
+  per_page?: number;
+  /** Count the total number of items */
+  include_total?: boolean;
+}
+
+export interface ListUsersParams {
+  /** Field to sort by (id, created_at, display_name or email), prefixed with - for descending order; cursors are only accepted when sorting by id or created_at */
+  sort?: string;
+  /** Only list users of this provider */
+  provider_type?: string;
+  /** Only list users whose email starts with this prefix */
+  email_prefix?: string;
+  /** Continue after the page that returned this next_cursor, instead of page */
+  cursor?: string;
+  /** Page number, starting at 1 */
+  page?: number;
+  /** Number of items per page */
+  per_page?: number;
+  /** Count the total number of items */
+  include_total?: boolean;
+}
+
+export interface ListWaitlistEntriesParams {
+  /** Page number, starting at 1 */
+  page?: number;
+  /** Number of items per page */
+  per_page?: number;
+  /** Count the total number of items */
+  include_total?: boolean;
+}
+
+export interface RevokeSessionByTokenParams {
+  /** The token from the link; it expires after 7 days */
+  token?: string;
+}
+
+export interface SearchUsersParams {
+  /** Start of the display name or email address, at least 2 characters */
+  q?: string;
+  /** Number of users to return, 10 by default and at most 20 */
+  limit?: number;
+}
+
+export interface ApiRequest {
+  method: 'GET' | 'POST' | 'PUT' | 'PATCH' | 'DELETE';
+  // path starts at the server root, e.g. /api/auth/login
+  path: string;
+  query?: object;
+  body?: unknown;
+}
+
+// Requester sends a request and resolves to its JSON response body
+export type Requester = <T>(request: ApiRequest) => Promise<T>;
+
+export const createClient = (request: Requester) => ({
+  /** Cancel the pending email change of the current user */
+  cancelEmailChange: () =>
+    request<Message>({method: 'DELETE', path: '/api/users/me/email'}),
+  /** Confirm an email change with the token of a link emailed to the current or the new address */
+  confirmEmailChange: (body: ConfirmEmailChangeRequest) =>
+    request<EmailChangeResponse>({method: 'POST', path: '/api/auth/email-change/confirm', body}),
+  /** Create an API key */
+  createAPIKey: (body: CreateAPIKeyRequest) =>
+    request<APIKeyCreatedResponse>({method: 'POST', path: '/api/admin/api-keys', body}),
+  /** Invite an email address to sign up */
+  createInvitation: (body: CreateInvitationRequest) =>
+    request<InvitationInfo>({method: 'POST', path: '/api/invitations', body}),
+  /** Register a webhook endpoint */
+  createWebhook: (body: CreateWebhookRequest) =>
+    request<WebhookCreatedResponse>({method: 'POST', path: '/api/admin/webhooks', body}),
+  /** Remove the avatar of the current user */
+  deleteAvatar: () =>
+    request<Message>({method: 'DELETE', path: '/api/users/me/avatar'}),
+  /** Delete a webhook endpoint */
+  deleteWebhook: (id: string) =>
+    request<Message>({method: 'DELETE', path: `/api/admin/webhooks/${encodeURIComponent(id)}`}),
+  /** Disable a user and end all of their sessions */
+  disableUser: (id: string) =>
+    request<AdminUserInfo>({method: 'POST', path: `/api/admin/users/${encodeURIComponent(id)}/disable`}),
+  /** Enable a disabled user */
+  enableUser: (id: string) =>
+    request<AdminUserInfo>({method: 'POST', path: `/api/admin/users/${encodeURIComponent(id)}/enable`}),
+  /** End all sessions of a user */
+  forceLogout: (id: string) =>
+    request<Message>({method: 'POST', path: `/api/admin/users/${encodeURIComponent(id)}/logout`}),
+  /** Get the CSRF token for requests authenticated by session cookies */
+  getCSRFToken: () =>
+    request<CSRFTokenResponse>({method: 'GET', path: '/api/auth/csrf'}),
+  /** Get the status of a data export, with a temporary download link once it is ready */
+  getDataExport: (id: string) =>
+    request<DataExportResponse>({method: 'GET', path: `/api/users/me/exports/${encodeURIComponent(id)}`}),
+  /** Check that the service and its database pools are up */
+  getHealth: () =>
+    request<HealthResponse>({method: 'GET', path: '/health'}),
+  /** Check that the process is up, without checking its dependencies */
+  getLiveness: () =>
+    request<HealthResponse>({method: 'GET', path: '/health/live'}),
+  /** Get the onboarding state of the current user */
+  getOnboarding: () =>
+    request<OnboardingResponse>({method: 'GET', path: '/api/profile/onboarding'}),
+  /** Get the state of the email outbox */
+  getOutboxStats: () =>
+    request<OutboxStatsResponse>({method: 'GET', path: '/api/admin/outbox'}),
+  /** Get the privacy settings of the current user */
+  getPrivacySettings: () =>
+    request<PrivacySettingsResponse>({method: 'GET', path: '/api/users/me/privacy'}),
+  /** Check that the service can serve requests */
+  getReadiness: () =>
+    request<HealthResponse>({method: 'GET', path: '/health/ready'}),
+  /** Get how the current user can sign in */
+  getSecuritySettings: () =>
+    request<SecuritySettingsResponse>({method: 'GET', path: '/api/users/me/security'}),
+  /** Get the public status of the service's components */
+  getStatus: () =>
+    request<StatusResponse>({method: 'GET', path: '/api/status'}),
+  /** Complete the Google authorization code flow and log in */
+  googleCallback: (query?: GoogleCallbackParams) =>
+    request<LoginResponse>({method: 'GET', path: '/api/auth/google/callback', query}),
+  /** Log in with a Google token */
+  googleLogin: (body: GoogleLoginRequest) =>
+    request<LoginResponse>({method: 'POST', path: '/api/auth/google/login', body}),
+  /** Create an account with a Google token */
+  googleSignup: (body: GoogleSignupRequest) =>
+    request<AuthResponse>({method: 'POST', path: '/api/auth/google/signup', body}),
+  /** Invite the oldest waitlist entries */
+  inviteWaitlistBatch: (body: WaitlistInviteRequest) =>
+    request<WaitlistInviteResponse>({method: 'POST', path: '/api/admin/waitlist/invite', body}),
+  /** Join the waitlist */
+  joinWaitlist: (body: WaitlistJoinRequest) =>
+    request<Message>({method: 'POST', path: '/api/waitlist', body}),
+  /** Link a Google account to the current user */
+  linkGoogleIdentity: (body: LinkGoogleIdentityRequest) =>
+    request<IdentityInfo>({method: 'POST', path: '/api/users/me/identities/google', body}),
+  /** List API keys */
+  listAPIKeys: () =>
+    request<APIKeyListResponse>({method: 'GET', path: '/api/admin/api-keys'}),
+  /** List audit log entries, newest first */
+  listAuditLogs: (query?: ListAuditLogsParams) =>
+    request<AuditLogListResponse>({method: 'GET', path: '/api/admin/audit-logs', query}),
+  /** List the sign-ins to the current user's account, including refused ones, newest first */
+  listLogins: (query?: ListLoginsParams) =>
+    request<LoginAttemptListResponse>({method: 'GET', path: '/api/users/me/logins', query}),
+  /** List the active sessions of the current user, newest first */
+  listSessions: (query?: ListSessionsParams) =>
+    request<SessionListResponse>({method: 'GET', path: '/api/auth/sessions', query}),
+  /** List the active sessions of a user, newest first */
+  listUserSessions: (id: string, query?: ListUserSessionsParams) =>
+    request<SessionListResponse>({method: 'GET', path: `/api/admin/users/${encodeURIComponent(id)}/sessions`, query}),
+  /** List users */
+  listUsers: (query?: ListUsersParams) =>
+    request<AdminUserListResponse>({method: 'GET', path: '/api/admin/users', query}),
+  /** List the waitlist entries */
+  listWaitlistEntries: (query?: ListWaitlistEntriesParams) =>
+    request<WaitlistListResponse>({method: 'GET', path: '/api/admin/waitlist', query}),
+  /** List webhook endpoints */
+  listWebhooks: () =>
+    request<WebhookListResponse>({method: 'GET', path: '/api/admin/webhooks'}),
+  /** Log in with email and password */
+  login: (body: LoginRequest) =>
+    request<LoginResponse>({method: 'POST', path: '/api/auth/login', body}),
+  /** End the current session */
+  logout: () =>
+    request<Message>({method: 'POST', path: '/api/auth/logout'}),
+  /** Log in with a registered OAuth provider */
+  providerLogin: (provider: string, body: OAuthRequest) =>
+    request<LoginResponse>({method: 'POST', path: `/api/auth/${encodeURIComponent(provider)}/login`, body}),
+  /** Create an account with a registered OAuth provider */
+  providerSignup: (provider: string, body: OAuthRequest) =>
+    request<AuthResponse>({method: 'POST', path: `/api/auth/${encodeURIComponent(provider)}/signup`, body}),
+  /** Irreversibly erase the personal data of a user */
+  purgeUser: (id: string) =>
+    request<Message>({method: 'POST', path: `/api/admin/users/${encodeURIComponent(id)}/purge`}),
+  /** Start assembling an archive of the current user's data, or return the one in progress */
+  requestDataExport: () =>
+    request<DataExportResponse>({method: 'POST', path: '/api/users/me/export'}),
+  /** Change the current user's email address once both the current and the new address confirm it */
+  requestEmailChange: (body: EmailChangeRequest) =>
+    request<EmailChangeResponse>({method: 'POST', path: '/api/users/me/email', body}),
+  /** Revoke an API key */
+  revokeAPIKey: (id: string) =>
+    request<Message>({method: 'DELETE', path: `/api/admin/api-keys/${encodeURIComponent(id)}`}),
+  /** Revoke one of the current user's sessions */
+  revokeSession: (id: string) =>
+    request<Message>({method: 'DELETE', path: `/api/auth/sessions/${encodeURIComponent(id)}`}),
+  /** Revoke the session named by the link in a new sign-in alert email */
+  revokeSessionByToken: (query?: RevokeSessionByTokenParams) =>
+    request<Message>({method: 'GET', path: '/api/auth/sessions/revoke', query}),
+  /** Find users by the start of their display name or email address */
+  searchUsers: (query?: SearchUsersParams) =>
+    request<UserSearchResponse>({method: 'GET', path: '/api/users/search', query}),
+  /** Create an account with email and password */
+  signup: (body: SignupRequest) =>
+    request<AuthResponse>({method: 'POST', path: '/api/auth/signup', body}),
+  /** Unlink a provider account from the current user */
+  unlinkIdentity: (provider: string, body: UnlinkIdentityRequest) =>
+    request<Message>({method: 'DELETE', path: `/api/users/me/identities/${encodeURIComponent(provider)}`, body}),
+  /** Update the onboarding state of the current user */
+  updateOnboarding: (body: OnboardingRequest) =>
+    request<OnboardingResponse>({method: 'PATCH', path: '/api/profile/onboarding', body}),
+  /** Change the privacy settings of the current user */
+  updatePrivacySettings: (body: PrivacySettingsRequest) =>
+    request<PrivacySettingsResponse>({method: 'PATCH', path: '/api/users/me/privacy', body}),
+});
+
+export type ApiClient = ReturnType<typeof createClient>;
@@ -8,6 +8,7 @@ import {
   SignupResponse,
   ErrorResponse
 } from '../types/auth';
+import { createClient } from '../api/generated';
 
 // Create axios instance with default config
 const api = axios.create({
@@ -157,4 +158,14 @@ export const healthAPI = {
   },
 };
 
+// Typed client generated from the backend's OpenAPI document (`make clients` in be/).
+// Its paths start at the server root, so requests are sent relative to the server URL.
+const serverURL = (api.defaults.baseURL ?? '').replace(/\/api\/?$/, '');
+
+export const apiClient = createClient(({ method, path, query, body }) =>
+  api
+    .request({ method, baseURL: serverURL, url: path, params: query, data: body, headers: getAuthHeaders() })
+    .then((response) => response.data),
+);
+
 export default api;
\ No newline at end of file